@@ -0,0 +1,354 @@
+package cmd
+
+import (
+	"cdp/internal"
+	"cdp/internal/utility"
+	"cdp/protocol/emulation"
+	"cdp/protocol/page"
+	"cdp/protocol/runtime"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var screenshotCmd = &cobra.Command{
+	Use:   "screenshot",
+	Short: "Capture a screenshot of a target and write it to a file",
+	Args:  cobra.NoArgs,
+	RunE:  runScreenshot,
+}
+
+var pdfCmd = &cobra.Command{
+	Use:   "pdf",
+	Short: "Print a target to PDF and write it to a file",
+	Args:  cobra.NoArgs,
+	RunE:  runPDF,
+}
+
+var (
+	captureName      string
+	captureWsURL     string
+	captureTarget    string
+	captureURL       string
+	captureWaitUntil string
+	captureTimeout   time.Duration
+
+	screenshotOut      string
+	screenshotFormat   string
+	screenshotQuality  int64
+	screenshotFullPage bool
+	screenshotClip     string
+	screenshotSelector string
+	screenshotScale    float64
+
+	pdfOut        string
+	pdfPaper      string
+	pdfLandscape  bool
+	pdfMargin     string
+	pdfHeader     string
+	pdfFooter     string
+	pdfBackground bool
+	pdfScale      float64
+	pdfRanges     string
+	pdfCSSSize    bool
+)
+
+var paperSizes = map[string][2]float64{
+	"letter":  {8.5, 11},
+	"legal":   {8.5, 14},
+	"tabloid": {11, 17},
+	"ledger":  {17, 11},
+	"a0":      {33.1, 46.8},
+	"a1":      {23.4, 33.1},
+	"a2":      {16.54, 23.4},
+	"a3":      {11.7, 16.54},
+	"a4":      {8.27, 11.7},
+	"a5":      {5.83, 8.27},
+	"a6":      {4.13, 5.83},
+}
+
+func init() {
+	for _, c := range []*cobra.Command{screenshotCmd, pdfCmd} {
+		c.Flags().StringVarP(&captureName, "name", "n", "", "Browser instance name (default: first available)")
+		c.Flags().StringVarP(&captureWsURL, "ws-url", "w", "", "Remote debugger URL (ws://..., http(s)://..., or host:port)")
+		c.Flags().StringVarP(&captureTarget, "target", "t", "first-page", "Target ID or selector")
+		c.Flags().StringVar(&captureURL, "url", "", "Navigate to this URL and wait for --wait-until first")
+		c.Flags().StringVar(&captureWaitUntil, "wait-until", "load", "Lifecycle event to wait for after --url")
+		c.Flags().DurationVar(&captureTimeout, "timeout", 30*time.Second, "Timeout for navigation and capture")
+	}
+	screenshotCmd.Flags().StringVarP(&screenshotOut, "out", "o", "", "Output file ('-' for stdout, default screenshot.<format>)")
+	screenshotCmd.Flags().StringVar(&screenshotFormat, "format", "png", "Image format: png, jpeg or webp")
+	screenshotCmd.Flags().Int64Var(&screenshotQuality, "quality", 0, "Compression quality 0-100 (jpeg and webp only)")
+	screenshotCmd.Flags().BoolVar(&screenshotFullPage, "full-page", false, "Capture the full scrollable page instead of the viewport")
+	screenshotCmd.Flags().StringVar(&screenshotClip, "clip", "", "Capture a region in CSS pixels as x,y,width,height")
+	screenshotCmd.Flags().StringVar(&screenshotSelector, "selector", "", "Capture the element matching this CSS selector")
+	screenshotCmd.Flags().Float64Var(&screenshotScale, "scale", 0, "Device scale factor to emulate while capturing (0 = unchanged)")
+	pdfCmd.Flags().StringVarP(&pdfOut, "out", "o", "page.pdf", "Output file ('-' for stdout)")
+	pdfCmd.Flags().StringVar(&pdfPaper, "paper", "letter", "Paper size: letter, legal, tabloid, ledger, a0-a6, or WIDTHxHEIGHT in inches")
+	pdfCmd.Flags().BoolVar(&pdfLandscape, "landscape", false, "Use landscape orientation")
+	pdfCmd.Flags().StringVar(&pdfMargin, "margin", "", "Margins in inches as one value or top,right,bottom,left (default ~0.4)")
+	pdfCmd.Flags().StringVar(&pdfHeader, "header-template", "", "HTML header template, or @file to read it from a file")
+	pdfCmd.Flags().StringVar(&pdfFooter, "footer-template", "", "HTML footer template, or @file to read it from a file")
+	pdfCmd.Flags().BoolVar(&pdfBackground, "background", false, "Print background graphics")
+	pdfCmd.Flags().Float64Var(&pdfScale, "scale", 0, "Scale of the webpage rendering, 0.1-2 (default 1)")
+	pdfCmd.Flags().StringVar(&pdfRanges, "page-ranges", "", "Pages to print, e.g. '1-5, 8'")
+	pdfCmd.Flags().BoolVar(&pdfCSSSize, "prefer-css-page-size", false, "Prefer the page size defined by CSS @page over --paper")
+	rootCmd.AddCommand(screenshotCmd)
+	rootCmd.AddCommand(pdfCmd)
+}
+
+func openCapture() (context.Context, *internal.Client, string, func(), error) {
+	err := checkLifecycleEvent(captureWaitUntil)
+	if err != nil {
+		return nil, nil, "", nil, err
+	}
+	ctx, c, sessionID, cleanup, err := openSession(captureName, captureWsURL, captureTarget, captureTimeout)
+	if err != nil {
+		return nil, nil, "", nil, err
+	}
+	if captureURL != "" {
+		_, err = navigate(ctx, c, sessionID, &page.NavigateParams{URL: captureURL}, captureWaitUntil)
+		if err != nil {
+			cleanup()
+			return nil, nil, "", nil, err
+		}
+	}
+	return ctx, c, sessionID, cleanup, nil
+}
+
+func runScreenshot(_ *cobra.Command, _ []string) error {
+	switch screenshotFormat {
+	case "png", "jpeg", "webp":
+	default:
+		return utility.ErrUser("invalid --format %q: expected png, jpeg or webp", screenshotFormat)
+	}
+	if screenshotQuality != 0 && screenshotFormat == "png" {
+		return utility.ErrUser("--quality applies to jpeg and webp only")
+	}
+	n := 0
+	for _, set := range []bool{screenshotFullPage, screenshotClip != "", screenshotSelector != ""} {
+		if set {
+			n++
+		}
+	}
+	if n > 1 {
+		return utility.ErrUser("--full-page, --clip and --selector are mutually exclusive")
+	}
+	var clip *page.Viewport
+	if screenshotClip != "" {
+		values, err := parseFloats(screenshotClip, 4)
+		if err != nil {
+			return utility.ErrUser("invalid --clip %q: expected x,y,width,height", screenshotClip)
+		}
+		clip = &page.Viewport{X: values[0], Y: values[1], Width: values[2], Height: values[3], Scale: 1}
+	}
+	ctx, c, sessionID, cleanup, err := openCapture()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	if screenshotScale > 0 {
+		metrics, err := page.GetLayoutMetrics(ctx, c, sessionID)
+		if err != nil {
+			return utility.ErrRuntime("getting layout metrics: %w", err)
+		}
+		err = emulation.SetDeviceMetricsOverride(ctx, c, sessionID, &emulation.SetDeviceMetricsOverrideParams{
+			Width:             metrics.CSSLayoutViewport.ClientWidth,
+			Height:            metrics.CSSLayoutViewport.ClientHeight,
+			DeviceScaleFactor: screenshotScale,
+		})
+		if err != nil {
+			return utility.ErrRuntime("setting device scale: %w", err)
+		}
+		defer func() {
+			clearCtx, clearCancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+			defer clearCancel()
+			_ = emulation.ClearDeviceMetricsOverride(clearCtx, c, sessionID)
+		}()
+	}
+	switch {
+	case screenshotFullPage:
+		metrics, err := page.GetLayoutMetrics(ctx, c, sessionID)
+		if err != nil {
+			return utility.ErrRuntime("getting layout metrics: %w", err)
+		}
+		size := metrics.CSSContentSize
+		clip = &page.Viewport{Width: size.Width, Height: size.Height, Scale: 1}
+	case screenshotSelector != "":
+		clip, err = elementClip(ctx, c, sessionID, screenshotSelector)
+		if err != nil {
+			return err
+		}
+	}
+	params := &page.CaptureScreenshotParams{Format: screenshotFormat, Clip: clip}
+	if screenshotQuality != 0 {
+		params.Quality = &screenshotQuality
+	}
+	if clip != nil {
+		beyond := true
+		params.CaptureBeyondViewport = &beyond
+	}
+	result, err := page.CaptureScreenshot(ctx, c, sessionID, params)
+	if err != nil {
+		return utility.ErrRuntime("capturing screenshot: %w", err)
+	}
+	out := screenshotOut
+	if out == "" {
+		out = "screenshot." + screenshotFormat
+	}
+	return writeBase64(out, result.Data)
+}
+
+func elementClip(ctx context.Context, c *internal.Client, sessionID, selector string) (*page.Viewport, error) {
+	quoted, _ := json.Marshal(selector)
+	expression := fmt.Sprintf(`(() => {
+	const el = document.querySelector(%s);
+	if (!el) return null;
+	el.scrollIntoView({block: "center", inline: "center"});
+	const r = el.getBoundingClientRect();
+	return {x: r.left + window.scrollX, y: r.top + window.scrollY, width: r.width, height: r.height};
+})()`, quoted)
+	returnByValue := true
+	result, err := runtime.Evaluate(ctx, c, sessionID, &runtime.EvaluateParams{Expression: expression, ReturnByValue: &returnByValue})
+	if err != nil {
+		return nil, utility.ErrRuntime("locating %s: %v", selector, err)
+	}
+	if result.ExceptionDetails != nil {
+		return nil, utility.ErrUser("locating %s: %s", selector, result.ExceptionDetails.Text)
+	}
+	var rect *page.Viewport
+	if len(result.Result.Value) > 0 {
+		_ = json.Unmarshal(result.Result.Value, &rect)
+	}
+	if rect == nil {
+		return nil, utility.ErrUser("no element matches selector %s", selector)
+	}
+	if rect.Width == 0 || rect.Height == 0 {
+		return nil, utility.ErrUser("element %s has no visible size", selector)
+	}
+	rect.Scale = 1
+	return rect, nil
+}
+
+func runPDF(_ *cobra.Command, _ []string) error {
+	params := &page.PrintToPDFParams{PageRanges: pdfRanges}
+	size, ok := paperSizes[strings.ToLower(pdfPaper)]
+	if !ok {
+		values, err := parseFloats(strings.Replace(strings.ToLower(pdfPaper), "x", ",", 1), 2)
+		if err != nil {
+			return utility.ErrUser("invalid --paper %q: expected a known size or WIDTHxHEIGHT in inches", pdfPaper)
+		}
+		size = [2]float64{values[0], values[1]}
+	}
+	params.PaperWidth = &size[0]
+	params.PaperHeight = &size[1]
+	if pdfMargin != "" {
+		values, err := parseFloats(pdfMargin, 1)
+		if err != nil {
+			values, err = parseFloats(pdfMargin, 4)
+		}
+		if err != nil {
+			return utility.ErrUser("invalid --margin %q: expected one value or top,right,bottom,left", pdfMargin)
+		}
+		if len(values) == 1 {
+			values = []float64{values[0], values[0], values[0], values[0]}
+		}
+		params.MarginTop, params.MarginRight, params.MarginBottom, params.MarginLeft = &values[0], &values[1], &values[2], &values[3]
+	}
+	var err error
+	params.HeaderTemplate, err = readTemplate(pdfHeader)
+	if err != nil {
+		return err
+	}
+	params.FooterTemplate, err = readTemplate(pdfFooter)
+	if err != nil {
+		return err
+	}
+	if params.HeaderTemplate != "" || params.FooterTemplate != "" {
+		display := true
+		params.DisplayHeaderFooter = &display
+		if params.HeaderTemplate == "" {
+			params.HeaderTemplate = "<span></span>"
+		}
+		if params.FooterTemplate == "" {
+			params.FooterTemplate = "<span></span>"
+		}
+	}
+	if pdfLandscape {
+		params.Landscape = &pdfLandscape
+	}
+	if pdfBackground {
+		params.PrintBackground = &pdfBackground
+	}
+	if pdfCSSSize {
+		params.PreferCSSPageSize = &pdfCSSSize
+	}
+	if pdfScale != 0 {
+		if pdfScale < 0.1 || pdfScale > 2 {
+			return utility.ErrUser("--scale must be between 0.1 and 2")
+		}
+		params.Scale = &pdfScale
+	}
+	ctx, c, sessionID, cleanup, err := openCapture()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	result, err := page.PrintToPDF(ctx, c, sessionID, params)
+	if err != nil {
+		return utility.ErrRuntime("printing to PDF: %w", err)
+	}
+	return writeBase64(pdfOut, result.Data)
+}
+
+func readTemplate(value string) (string, error) {
+	path, ok := strings.CutPrefix(value, "@")
+	if !ok {
+		return value, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", utility.ErrUser("reading template: %v", err)
+	}
+	return string(data), nil
+}
+
+func parseFloats(s string, n int) ([]float64, error) {
+	parts := strings.Split(s, ",")
+	if len(parts) != n {
+		return nil, fmt.Errorf("expected %d values", n)
+	}
+	values := make([]float64, n)
+	for i, part := range parts {
+		v, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+	return values, nil
+}
+
+func writeBase64(path, data string) error {
+	decoded, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return utility.ErrRuntime("decoding response: %v", err)
+	}
+	if path == "-" {
+		_, err = os.Stdout.Write(decoded)
+	} else {
+		err = os.WriteFile(path, decoded, 0644)
+	}
+	if err != nil {
+		return utility.ErrRuntime("writing %s: %v", path, err)
+	}
+	utility.Term.Info("wrote %d bytes to %s\n", len(decoded), path)
+	return nil
+}
@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"cdp/internal"
+	"cdp/internal/protocol"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+func registerCompletions(cmd *cobra.Command) {
+	for _, child := range cmd.Commands() {
+		registerCompletions(child)
+	}
+	if cmd != startCmd && hasOwnFlag(cmd, "name") {
+		_ = cmd.RegisterFlagCompletionFunc("name", completeInstanceNames)
+	}
+	if hasOwnFlag(cmd, "target") {
+		_ = cmd.RegisterFlagCompletionFunc("target", completeTargets)
+	}
+	if hasOwnFlag(cmd, "wait-until") {
+		_ = cmd.RegisterFlagCompletionFunc("wait-until", cobra.FixedCompletions(lifecycleEvents, cobra.ShellCompDirectiveNoFileComp))
+	}
+}
+
+func hasOwnFlag(cmd *cobra.Command, name string) bool {
+	return cmd.Flags().Lookup(name) != nil || cmd.PersistentFlags().Lookup(name) != nil
+}
+
+func completionWsURL(cmd *cobra.Command) (string, error) {
+	name, _ := cmd.Flags().GetString("name")
+	wsURL, _ := cmd.Flags().GetString("ws-url")
+	return resolveWsURL(name, wsURL)
+}
+
+func completionSchema(cmd *cobra.Command) *protocol.Protocol {
+	wsURL, err := completionWsURL(cmd)
+	if err == nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+		schema, err := protocol.Load(ctx, wsURL)
+		if err == nil {
+			return schema
+		}
+	}
+	schema, err := protocol.LoadCached()
+	if err != nil {
+		return nil
+	}
+	return schema
+}
+
+func completeMethods(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	schema := completionSchema(cmd)
+	if schema == nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	if !strings.Contains(toComplete, ".") {
+		var domains []string
+		for _, d := range schema.DomainNames() {
+			if strings.HasPrefix(d, toComplete) {
+				domains = append(domains, d+".")
+			}
+		}
+		return domains, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
+	}
+	var methods []string
+	for _, m := range schema.Methods() {
+		if strings.HasPrefix(m, toComplete) {
+			methods = append(methods, m)
+		}
+	}
+	return methods, cobra.ShellCompDirectiveNoFileComp
+}
+
+func completeDomains(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	schema := completionSchema(cmd)
+	if schema == nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	var domains []string
+	for _, d := range schema.Domains {
+		if len(d.Events) == 0 || !strings.HasPrefix(d.Domain, toComplete) || slices.Contains(args, d.Domain) {
+			continue
+		}
+		domains = append(domains, d.Domain)
+	}
+	return domains, cobra.ShellCompDirectiveNoFileComp
+}
+
+func completeTargetArg(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return completeTargets(cmd, args, toComplete)
+}
+
+func completeInstanceNames(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	instances, _, err := internal.ListInstances()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	var names []string
+	for _, inst := range instances {
+		if strings.HasPrefix(inst.Name, toComplete) {
+			names = append(names, fmt.Sprintf("%s\tport %d", inst.Name, inst.Port))
+		}
+	}
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
+
+func completeTargets(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	var ids []string
+	if cmd == sendCmd && strings.HasPrefix("browser", toComplete) {
+		ids = append(ids, "browser\tbrowser-level session")
+	}
+	for _, selector := range []string{"first-page\tfirst page target", "new\topen a blank tab"} {
+		if strings.HasPrefix(selector, toComplete) {
+			ids = append(ids, selector)
+		}
+	}
+	wsURL, err := completionWsURL(cmd)
+	if err != nil {
+		return ids, cobra.ShellCompDirectiveNoFileComp
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	targets, err := internal.ListJSONTargets(ctx, wsURL)
+	if err != nil {
+		return ids, cobra.ShellCompDirectiveNoFileComp
+	}
+	for _, t := range targets {
+		if strings.HasPrefix(t.ID, toComplete) {
+			ids = append(ids, fmt.Sprintf("%s\t%s: %s (%s)", t.ID, t.Type, t.Title, t.URL))
+		}
+	}
+	return ids, cobra.ShellCompDirectiveNoFileComp
+}
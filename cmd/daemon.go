@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"cdp/internal"
+	"cdp/internal/utility"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var daemonCmd = &cobra.Command{
+	Use:   "daemon",
+	Short: "Manage the background daemon that keeps CDP connections open",
+	Long: "Manage the background daemon that keeps CDP connections open.\n\n" +
+		"While the daemon is running, 'send' and 'listen' route through it, so attached\n" +
+		"sessions and enabled domains persist between invocations.",
+}
+
+var daemonStartCmd = &cobra.Command{
+	Use:   "start",
+	Short: "Start the daemon in the background",
+	Args:  cobra.NoArgs,
+	RunE:  runDaemonStart,
+}
+
+var daemonRunCmd = &cobra.Command{
+	Use:   "run",
+	Short: "Run the daemon in the foreground",
+	Args:  cobra.NoArgs,
+	RunE:  runDaemonRun,
+}
+
+var daemonStopCmd = &cobra.Command{
+	Use:   "stop",
+	Short: "Stop the running daemon",
+	Args:  cobra.NoArgs,
+	RunE:  runDaemonStop,
+}
+
+var daemonStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show connections and sessions held by the daemon",
+	Args:  cobra.NoArgs,
+	RunE:  runDaemonStatus,
+}
+
+func init() {
+	daemonCmd.AddCommand(daemonStartCmd, daemonRunCmd, daemonStopCmd, daemonStatusCmd)
+	rootCmd.AddCommand(daemonCmd)
+}
+
+func runDaemonStart(_ *cobra.Command, _ []string) error {
+	if internal.DaemonRunning() {
+		return utility.ErrUser("daemon already running on %s", utility.DaemonSocket)
+	}
+	exe, err := os.Executable()
+	if err != nil {
+		return utility.ErrRuntime("locating executable: %v", err)
+	}
+	err = os.MkdirAll(filepath.Dir(utility.DaemonLog), 0755)
+	if err != nil {
+		return utility.ErrRuntime("creating log dir: %v", err)
+	}
+	logFile, err := os.OpenFile(utility.DaemonLog, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return utility.ErrRuntime("opening daemon log: %v", err)
+	}
+	defer func() {
+		_ = logFile.Close()
+	}()
+	args := []string{"daemon", "run"}
+	if utility.Verbose {
+		args = append(args, "--verbose")
+	}
+	proc := exec.Command(exe, args...)
+	proc.Stdout = logFile
+	proc.Stderr = logFile
+	proc.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	err = proc.Start()
+	if err != nil {
+		return utility.ErrRuntime("starting daemon: %v", err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if internal.DaemonRunning() {
+			pid := proc.Process.Pid
+			_ = proc.Process.Release()
+			out, err := json.Marshal(map[string]any{"pid": pid, "socket": utility.DaemonSocket})
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(out))
+			return nil
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	_ = proc.Process.Kill()
+	return utility.ErrRuntime("timeout waiting for daemon (see %s)", utility.DaemonLog)
+}
+
+func runDaemonRun(_ *cobra.Command, _ []string) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-sigCh
+		cancel()
+	}()
+	return internal.RunDaemon(ctx)
+}
+
+func runDaemonStop(_ *cobra.Command, _ []string) error {
+	if !internal.DaemonRunning() {
+		return utility.ErrUser("daemon not running")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err := internal.StopDaemon(ctx)
+	if err != nil {
+		return err
+	}
+	utility.Term.Text("stopped daemon\n")
+	return nil
+}
+
+func runDaemonStatus(_ *cobra.Command, _ []string) error {
+	if !internal.DaemonRunning() {
+		return utility.ErrUser("daemon not running")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	status, err := internal.DaemonStatus(ctx)
+	if err != nil {
+		return err
+	}
+	out, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(out))
+	return nil
+}
@@ -0,0 +1,272 @@
+package cmd
+
+import (
+	"cdp/internal"
+	"cdp/internal/utility"
+	"cdp/protocol/dom"
+	"cdp/protocol/runtime"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var domCmd = &cobra.Command{
+	Use:   "dom",
+	Short: "Query the DOM of a target by CSS selector",
+}
+
+var domQueryCmd = &cobra.Command{
+	Use:   "query <selector>",
+	Short: "Print every element matching a selector as JSON",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runDOMQuery,
+}
+
+var domHTMLCmd = &cobra.Command{
+	Use:   "html <selector>",
+	Short: "Print the outer HTML of the first element matching a selector",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runDOMHTML,
+}
+
+var domAttrsCmd = &cobra.Command{
+	Use:   "attrs <selector>",
+	Short: "Print the attributes of the first element matching a selector as JSON",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runDOMAttrs,
+}
+
+var domTextCmd = &cobra.Command{
+	Use:   "text <selector>",
+	Short: "Print the rendered text of the first element matching a selector",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runDOMText,
+}
+
+var (
+	domName    string
+	domWsURL   string
+	domTarget  string
+	domWait    bool
+	domTimeout time.Duration
+)
+
+type domElement struct {
+	NodeID        dom.NodeID        `json:"nodeId"`
+	BackendNodeID dom.BackendNodeID `json:"backendNodeId"`
+	NodeName      string            `json:"nodeName"`
+	Attributes    map[string]string `json:"attributes,omitempty"`
+}
+
+func init() {
+	domCmd.AddCommand(domQueryCmd, domHTMLCmd, domAttrsCmd, domTextCmd)
+	for _, c := range domCmd.Commands() {
+		addDOMFlags(c)
+		addWaitFlag(c)
+	}
+	rootCmd.AddCommand(domCmd)
+}
+
+func addDOMFlags(c *cobra.Command) {
+	c.Flags().StringVarP(&domName, "name", "n", "", "Browser instance name (default: first available)")
+	c.Flags().StringVarP(&domWsURL, "ws-url", "w", "", "Remote debugger URL (ws://..., http(s)://..., or host:port)")
+	c.Flags().StringVarP(&domTarget, "target", "t", "first-page", "Target ID or selector")
+	c.Flags().DurationVar(&domTimeout, "timeout", 30*time.Second, "Timeout for waiting and acting")
+}
+
+func addWaitFlag(c *cobra.Command) {
+	c.Flags().BoolVar(&domWait, "wait", true, "Wait for the element to appear (and become visible, for input) until --timeout")
+}
+
+func querySelectorAll(ctx context.Context, c *internal.Client, sessionID, selector string) ([]dom.NodeID, error) {
+	depth := int64(0)
+	doc, err := dom.GetDocument(ctx, c, sessionID, &dom.GetDocumentParams{Depth: &depth})
+	if err != nil {
+		return nil, utility.ErrRuntime("getting document: %w", err)
+	}
+	result, err := dom.QuerySelectorAll(ctx, c, sessionID, &dom.QuerySelectorAllParams{NodeID: doc.Root.NodeID, Selector: selector})
+	if err != nil {
+		return nil, utility.ErrRuntime("querying %s: %w", selector, err)
+	}
+	return result.NodeIDs, nil
+}
+
+func waitForNodes(ctx context.Context, c *internal.Client, sessionID, selector string, visible bool) ([]dom.NodeID, error) {
+	var lastErr error
+	for {
+		nodeIDs, err := querySelectorAll(ctx, c, sessionID, selector)
+		if err != nil && !domWait {
+			return nil, err
+		}
+		if ctx.Err() == nil {
+			lastErr = err
+		}
+		for i, id := range nodeIDs {
+			if !visible || isVisible(ctx, c, sessionID, id) {
+				return nodeIDs[i:], nil
+			}
+		}
+		if !domWait {
+			if len(nodeIDs) > 0 {
+				return nil, utility.ErrUser("no visible element matches %s", selector)
+			}
+			return nil, utility.ErrUser("no element matches %s", selector)
+		}
+		select {
+		case <-ctx.Done():
+			if lastErr != nil {
+				return nil, utility.ErrRuntime("waiting for %s: %w", selector, lastErr)
+			}
+			if len(nodeIDs) > 0 {
+				return nil, utility.ErrRuntime("waiting for %s to become visible: %v", selector, ctx.Err())
+			}
+			return nil, utility.ErrRuntime("waiting for %s: %v", selector, ctx.Err())
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
+func waitForNode(ctx context.Context, c *internal.Client, sessionID, selector string, visible bool) (dom.NodeID, error) {
+	nodeIDs, err := waitForNodes(ctx, c, sessionID, selector, visible)
+	if err != nil {
+		return 0, err
+	}
+	return nodeIDs[0], nil
+}
+
+func isVisible(ctx context.Context, c *internal.Client, sessionID string, nodeID dom.NodeID) bool {
+	quads, err := dom.GetContentQuads(ctx, c, sessionID, &dom.GetContentQuadsParams{NodeID: &nodeID})
+	if err != nil {
+		return false
+	}
+	for _, q := range quads.Quads {
+		if len(q) == 8 && quadArea(q) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func quadArea(q dom.Quad) float64 {
+	area := 0.0
+	for i := 0; i < 4; i++ {
+		j := (i + 1) % 4
+		area += q[2*i]*q[2*j+1] - q[2*j]*q[2*i+1]
+	}
+	if area < 0 {
+		area = -area
+	}
+	return area / 2
+}
+
+func attributeMap(attrs []string) map[string]string {
+	m := make(map[string]string, len(attrs)/2)
+	for i := 0; i+1 < len(attrs); i += 2 {
+		m[attrs[i]] = attrs[i+1]
+	}
+	return m
+}
+
+func callOnNode(ctx context.Context, c *internal.Client, sessionID string, nodeID dom.NodeID, function string) (*runtime.RemoteObject, error) {
+	resolved, err := dom.ResolveNode(ctx, c, sessionID, &dom.ResolveNodeParams{NodeID: &nodeID})
+	if err != nil {
+		return nil, utility.ErrRuntime("resolving node: %w", err)
+	}
+	byValue := true
+	result, err := runtime.CallFunctionOn(ctx, c, sessionID, &runtime.CallFunctionOnParams{
+		FunctionDeclaration: function,
+		ObjectID:            resolved.Object.ObjectID,
+		ReturnByValue:       &byValue,
+	})
+	if err != nil {
+		return nil, utility.ErrRuntime("calling function on node: %w", err)
+	}
+	if result.ExceptionDetails != nil {
+		return nil, utility.ErrRuntime("%s", formatException(result.ExceptionDetails))
+	}
+	return &result.Result, nil
+}
+
+func runDOMQuery(_ *cobra.Command, args []string) error {
+	ctx, c, sessionID, cleanup, err := openSession(domName, domWsURL, domTarget, domTimeout)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	nodeIDs, err := waitForNodes(ctx, c, sessionID, args[0], false)
+	if err != nil {
+		return err
+	}
+	elements := make([]domElement, 0, len(nodeIDs))
+	for _, id := range nodeIDs {
+		desc, err := dom.DescribeNode(ctx, c, sessionID, &dom.DescribeNodeParams{NodeID: &id})
+		if err != nil {
+			return utility.ErrRuntime("describing node %d: %w", id, err)
+		}
+		elements = append(elements, domElement{
+			NodeID:        id,
+			BackendNodeID: desc.Node.BackendNodeID,
+			NodeName:      desc.Node.NodeName,
+			Attributes:    attributeMap(desc.Node.Attributes),
+		})
+	}
+	return printJSON(elements)
+}
+
+func runDOMHTML(_ *cobra.Command, args []string) error {
+	ctx, c, sessionID, cleanup, err := openSession(domName, domWsURL, domTarget, domTimeout)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	nodeID, err := waitForNode(ctx, c, sessionID, args[0], false)
+	if err != nil {
+		return err
+	}
+	result, err := dom.GetOuterHTML(ctx, c, sessionID, &dom.GetOuterHTMLParams{NodeID: &nodeID})
+	if err != nil {
+		return utility.ErrRuntime("getting outer HTML: %w", err)
+	}
+	fmt.Println(result.OuterHTML)
+	return nil
+}
+
+func runDOMAttrs(_ *cobra.Command, args []string) error {
+	ctx, c, sessionID, cleanup, err := openSession(domName, domWsURL, domTarget, domTimeout)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	nodeID, err := waitForNode(ctx, c, sessionID, args[0], false)
+	if err != nil {
+		return err
+	}
+	result, err := dom.GetAttributes(ctx, c, sessionID, &dom.GetAttributesParams{NodeID: nodeID})
+	if err != nil {
+		return utility.ErrRuntime("getting attributes: %w", err)
+	}
+	return printJSON(attributeMap(result.Attributes))
+}
+
+func runDOMText(_ *cobra.Command, args []string) error {
+	ctx, c, sessionID, cleanup, err := openSession(domName, domWsURL, domTarget, domTimeout)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	nodeID, err := waitForNode(ctx, c, sessionID, args[0], false)
+	if err != nil {
+		return err
+	}
+	obj, err := callOnNode(ctx, c, sessionID, nodeID, "function() { return this.innerText ?? this.textContent; }")
+	if err != nil {
+		return err
+	}
+	var text string
+	_ = json.Unmarshal(obj.Value, &text)
+	fmt.Println(text)
+	return nil
+}
@@ -0,0 +1,265 @@
+package cmd
+
+import (
+	"cdp/internal"
+	"cdp/internal/utility"
+	"cdp/protocol/page"
+	"cdp/protocol/runtime"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var evalCmd = &cobra.Command{
+	Use:   "eval [expression]",
+	Short: "Evaluate JavaScript in a target and print the result as JSON",
+	Long: `Evaluate JavaScript in a target and print the result as JSON.
+
+The expression comes from the argument, --file, or stdin ('-' or no argument).
+Promises are awaited and results are returned by value unless disabled.
+A thrown exception prints its stack trace and exits non-zero.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runEval,
+}
+
+var (
+	evalName    string
+	evalWsURL   string
+	evalTarget  string
+	evalFile    string
+	evalAwait   bool
+	evalByValue bool
+	evalRaw     bool
+	evalFrame   string
+	evalContext int64
+	evalTimeout time.Duration
+)
+
+func init() {
+	evalCmd.Flags().StringVarP(&evalName, "name", "n", "", "Browser instance name (default: first available)")
+	evalCmd.Flags().StringVarP(&evalWsURL, "ws-url", "w", "", "Remote debugger URL (ws://..., http(s)://..., or host:port)")
+	evalCmd.Flags().StringVarP(&evalTarget, "target", "t", "first-page", "Target ID or selector")
+	evalCmd.Flags().StringVarP(&evalFile, "file", "f", "", "Read the expression from a file")
+	evalCmd.Flags().BoolVar(&evalAwait, "await", true, "Await the result if it is a promise")
+	evalCmd.Flags().BoolVar(&evalByValue, "by-value", true, "Return the result by value; with --by-value=false print the RemoteObject")
+	evalCmd.Flags().BoolVarP(&evalRaw, "raw", "r", false, "Print string results without JSON quoting")
+	evalCmd.Flags().StringVar(&evalFrame, "frame", "", "Evaluate in the main world of this frame, by ID or URL glob")
+	evalCmd.Flags().Int64Var(&evalContext, "context", 0, "Evaluate in this execution context ID")
+	evalCmd.Flags().DurationVar(&evalTimeout, "timeout", 30*time.Second, "Evaluation timeout")
+	rootCmd.AddCommand(evalCmd)
+}
+
+func runEval(_ *cobra.Command, args []string) error {
+	expression, err := readExpression(args)
+	if err != nil {
+		return err
+	}
+	if evalFrame != "" && evalContext != 0 {
+		return utility.ErrUser("--frame and --context are mutually exclusive")
+	}
+	wsURL, err := resolveWsURL(evalName, evalWsURL)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), evalTimeout)
+	defer cancel()
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-sigCh
+		cancel()
+	}()
+	c, err := internal.NewClient(wsURL, false)
+	if err != nil {
+		return utility.ErrRuntime("connecting: %v", err)
+	}
+	defer c.Close()
+	sessionID, err := c.Attach(ctx, evalTarget)
+	if err != nil {
+		return err
+	}
+	params := &runtime.EvaluateParams{
+		Expression:    expression,
+		AwaitPromise:  &evalAwait,
+		ReturnByValue: &evalByValue,
+	}
+	if evalContext != 0 {
+		id := runtime.ExecutionContextID(evalContext)
+		params.ContextID = &id
+	}
+	if evalFrame != "" {
+		id, err := frameContext(ctx, c, sessionID, evalFrame)
+		if err != nil {
+			return err
+		}
+		params.ContextID = &id
+	}
+	result, err := evaluate(ctx, c, sessionID, params)
+	if err != nil {
+		return err
+	}
+	return printRemoteObject(&result.Result)
+}
+
+func readExpression(args []string) (string, error) {
+	if evalFile != "" {
+		if len(args) > 0 {
+			return "", utility.ErrUser("pass either an expression or --file, not both")
+		}
+		data, err := os.ReadFile(evalFile)
+		if err != nil {
+			return "", utility.ErrUser("reading expression: %v", err)
+		}
+		return string(data), nil
+	}
+	if len(args) == 1 && args[0] != "-" {
+		return args[0], nil
+	}
+	stat, _ := os.Stdin.Stat()
+	if len(args) == 0 && (stat.Mode()&os.ModeCharDevice) != 0 {
+		return "", utility.ErrUser("expected an expression, --file or stdin")
+	}
+	data, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return "", utility.ErrUser("reading stdin: %v", err)
+	}
+	if strings.TrimSpace(string(data)) == "" {
+		return "", utility.ErrUser("empty expression")
+	}
+	return string(data), nil
+}
+
+func evaluate(ctx context.Context, c *internal.Client, sessionID string, params *runtime.EvaluateParams) (*runtime.EvaluateResult, error) {
+	result, err := runtime.Evaluate(ctx, c, sessionID, params)
+	if err != nil {
+		return nil, utility.ErrRuntime("evaluating: %w", err)
+	}
+	if result.ExceptionDetails != nil {
+		return nil, utility.ErrRuntime("%s", formatException(result.ExceptionDetails))
+	}
+	return result, nil
+}
+
+func formatException(details *runtime.ExceptionDetails) string {
+	if details.Exception != nil && details.Exception.Description != "" {
+		description := details.Exception.Description
+		if strings.Contains(description, "\n    at ") || details.StackTrace == nil {
+			return "Uncaught " + description
+		}
+	}
+	var b strings.Builder
+	b.WriteString(details.Text)
+	if details.Exception != nil {
+		if details.Exception.Description != "" {
+			b.WriteString(" " + details.Exception.Description)
+		} else if len(details.Exception.Value) > 0 {
+			b.WriteString(" " + string(details.Exception.Value))
+		}
+	}
+	for trace := details.StackTrace; trace != nil; trace = trace.Parent {
+		if trace.Description != "" {
+			b.WriteString("\n    --- " + trace.Description + " ---")
+		}
+		for _, frame := range trace.CallFrames {
+			name := frame.FunctionName
+			if name == "" {
+				name = "<anonymous>"
+			}
+			fmt.Fprintf(&b, "\n    at %s (%s:%d:%d)", name, frame.URL, frame.LineNumber+1, frame.ColumnNumber+1)
+		}
+	}
+	if details.StackTrace == nil && details.URL != "" {
+		fmt.Fprintf(&b, "\n    at %s:%d:%d", details.URL, details.LineNumber+1, details.ColumnNumber+1)
+	}
+	return b.String()
+}
+
+func printRemoteObject(obj *runtime.RemoteObject) error {
+	if !evalByValue {
+		return printJSON(obj)
+	}
+	switch {
+	case obj.UnserializableValue != "":
+		fmt.Println(string(obj.UnserializableValue))
+	case obj.Type == "undefined":
+	case len(obj.Value) == 0:
+		fmt.Println("null")
+	default:
+		var s string
+		if evalRaw && json.Unmarshal(obj.Value, &s) == nil {
+			fmt.Println(s)
+			return nil
+		}
+		var v any
+		err := json.Unmarshal(obj.Value, &v)
+		if err != nil {
+			return utility.ErrRuntime("decoding result: %v", err)
+		}
+		return printJSON(v)
+	}
+	return nil
+}
+
+func frameContext(ctx context.Context, c *internal.Client, sessionID, frame string) (runtime.ExecutionContextID, error) {
+	tree, err := page.GetFrameTree(ctx, c, sessionID)
+	if err != nil {
+		return 0, utility.ErrRuntime("getting frame tree: %w", err)
+	}
+	frameID, err := findFrame(&tree.FrameTree, frame)
+	if err != nil {
+		return 0, err
+	}
+	events, unsubscribe := c.Subscribe(sessionID, runtime.EventExecutionContextCreated)
+	defer unsubscribe()
+	err = runtime.Enable(ctx, c, sessionID)
+	if err != nil {
+		return 0, utility.ErrRuntime("enabling Runtime: %w", err)
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return 0, utility.ErrRuntime("no execution context found for frame %s: %v", frameID, ctx.Err())
+		case event, ok := <-events:
+			if !ok {
+				return 0, utility.ErrRuntime("finding execution context: connection closed")
+			}
+			var created runtime.ExecutionContextCreatedEvent
+			if json.Unmarshal(event.Params, &created) != nil {
+				continue
+			}
+			aux := created.Context.AuxData
+			if aux["frameId"] == string(frameID) && aux["isDefault"] == true {
+				return created.Context.ID, nil
+			}
+		}
+	}
+}
+
+func findFrame(tree *page.FrameTree, frame string) (page.FrameID, error) {
+	pattern := utility.GlobRegexp(frame)
+	var matches []page.FrameID
+	var walk func(t *page.FrameTree)
+	walk = func(t *page.FrameTree) {
+		if string(t.Frame.ID) == frame {
+			matches = append([]page.FrameID{t.Frame.ID}, matches...)
+		} else if pattern.MatchString(t.Frame.URL) {
+			matches = append(matches, t.Frame.ID)
+		}
+		for i := range t.ChildFrames {
+			walk(&t.ChildFrames[i])
+		}
+	}
+	walk(tree)
+	if len(matches) == 0 {
+		return "", utility.ErrUser("no frame matches %s", frame)
+	}
+	return matches[0], nil
+}
@@ -0,0 +1,170 @@
+package cmd
+
+import (
+	"bytes"
+	"cdp/internal"
+	"cdp/internal/har"
+	"cdp/internal/utility"
+	"cdp/protocol/network"
+	"cdp/protocol/page"
+	"context"
+	"encoding/json"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var harCmd = &cobra.Command{
+	Use:   "har",
+	Short: "Capture network traffic from a target as a HAR 1.2 file",
+	Long: `Capture network traffic from a target as a HAR 1.2 file.
+
+Without --url, traffic is captured until interrupted (Ctrl-C). With --url, the
+target navigates there and capture stops once the page has loaded and the
+network has been idle for --idle. The HAR is written either way.`,
+	Args: cobra.NoArgs,
+	RunE: runHar,
+}
+
+var (
+	harName    string
+	harWsURL   string
+	harTarget  string
+	harOut     string
+	harURL     string
+	harPage    bool
+	harContent bool
+	harIdle    time.Duration
+	harTimeout time.Duration
+)
+
+func init() {
+	harCmd.Flags().StringVarP(&harName, "name", "n", "", "Browser instance name (default: first available)")
+	harCmd.Flags().StringVarP(&harWsURL, "ws-url", "w", "", "Remote debugger URL (ws://..., http(s)://..., or host:port)")
+	harCmd.Flags().StringVarP(&harTarget, "target", "t", "first-page", "Target ID or selector")
+	harCmd.Flags().StringVarP(&harOut, "out", "o", "-", "Output HAR file ('-' for stdout)")
+	harCmd.Flags().StringVar(&harURL, "url", "", "Navigate to this URL and stop once the page has loaded")
+	harCmd.Flags().BoolVar(&harPage, "page", false, "Also enable Page to record page load timings (implied by --url)")
+	harCmd.Flags().BoolVar(&harContent, "content", false, "Fetch response bodies into the HAR")
+	harCmd.Flags().DurationVar(&harIdle, "idle", time.Second, "With --url, stop after the network has been idle this long after load")
+	harCmd.Flags().DurationVar(&harTimeout, "timeout", 30*time.Second, "Timeout for setup commands and, with --url, the whole capture")
+	rootCmd.AddCommand(harCmd)
+}
+
+func runHar(_ *cobra.Command, _ []string) error {
+	wsURL, err := resolveWsURL(harName, harWsURL)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-sigCh
+		cancel()
+	}()
+	c, err := internal.NewClient(wsURL, false)
+	if err != nil {
+		return utility.ErrRuntime("connecting: %v", err)
+	}
+	defer c.Close()
+	setupCtx, setupCancel := context.WithTimeout(ctx, harTimeout)
+	defer setupCancel()
+	sessionID, err := c.Attach(setupCtx, harTarget)
+	if err != nil {
+		return err
+	}
+	events, unsubscribe := c.Subscribe(sessionID, "")
+	defer unsubscribe()
+	err = network.Enable(setupCtx, c, sessionID, nil)
+	if err != nil {
+		return utility.ErrRuntime("enabling Network: %w", err)
+	}
+	if harPage || harURL != "" {
+		err = page.Enable(setupCtx, c, sessionID)
+		if err != nil {
+			return utility.ErrRuntime("enabling Page: %w", err)
+		}
+	}
+	if harURL != "" {
+		var deadlineCancel context.CancelFunc
+		ctx, deadlineCancel = context.WithTimeout(ctx, harTimeout)
+		defer deadlineCancel()
+		result, err := page.Navigate(setupCtx, c, sessionID, &page.NavigateParams{URL: harURL})
+		if err != nil {
+			return utility.ErrRuntime("navigating: %w", err)
+		}
+		if result.ErrorText != "" {
+			return utility.ErrRuntime("navigating to %s: %s", harURL, result.ErrorText)
+		}
+	}
+	b := har.NewBuilder()
+	capture(ctx, c, sessionID, b, events)
+	return writeHAR(b.Build())
+}
+
+func capture(ctx context.Context, c *internal.Client, sessionID string, b *har.Builder, events <-chan *internal.CDPMessage) {
+	idle := time.NewTimer(harIdle)
+	idle.Stop()
+	defer idle.Stop()
+	loaded := false
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-idle.C:
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			requestID, finished := b.Observe(event)
+			if finished && harContent {
+				fetchBody(ctx, c, sessionID, b, requestID)
+			}
+			if event.Method == page.EventLoadEventFired {
+				loaded = true
+			}
+			idle.Stop()
+			if harURL != "" && loaded && b.Pending() == 0 {
+				idle.Reset(harIdle)
+			}
+		}
+	}
+}
+
+func fetchBody(ctx context.Context, c *internal.Client, sessionID string, b *har.Builder, requestID network.RequestID) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), harTimeout)
+	defer cancel()
+	body, err := network.GetResponseBody(ctx, c, sessionID, &network.GetResponseBodyParams{RequestID: requestID})
+	if err != nil {
+		utility.Term.Info("fetching body for %s: %v\n", requestID, err)
+		return
+	}
+	b.SetBody(requestID, body.Body, body.Base64Encoded)
+}
+
+func writeHAR(h *har.HAR) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	err := enc.Encode(h)
+	if err != nil {
+		return utility.ErrRuntime("encoding HAR: %v", err)
+	}
+	if harOut == "-" {
+		_, err = os.Stdout.Write(buf.Bytes())
+	} else {
+		err = os.WriteFile(harOut, buf.Bytes(), 0644)
+	}
+	if err != nil {
+		return utility.ErrRuntime("writing HAR: %v", err)
+	}
+	utility.Term.Info("wrote %d entries to %s\n", len(h.Log.Entries), harOut)
+	return nil
+}
@@ -0,0 +1,335 @@
+package cmd
+
+import (
+	"cdp/internal"
+	"cdp/internal/utility"
+	"cdp/protocol/dom"
+	"cdp/protocol/input"
+	"context"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/spf13/cobra"
+)
+
+var clickCmd = &cobra.Command{
+	Use:   "click <selector>",
+	Short: "Scroll an element into view and click its center",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runClick,
+}
+
+var typeCmd = &cobra.Command{
+	Use:   "type <selector> <text>",
+	Short: "Focus an element and type text into it key by key",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runType,
+}
+
+var pressCmd = &cobra.Command{
+	Use:   "press <key>",
+	Short: "Press a key or chord in the focused element, e.g. Enter or Control+A",
+	Long: `Press a key or chord in the focused element.
+
+Keys are single characters or names such as Enter, Tab, Escape, Backspace,
+Delete, Space, ArrowUp/Down/Left/Right, Home, End, PageUp and PageDown.
+Prefix Alt+, Control+, Meta+ or Shift+ to hold modifiers, e.g. Control+A.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPress,
+}
+
+var (
+	clickButton string
+	clickCount  int64
+	typeClear   bool
+	typeDelay   time.Duration
+)
+
+type keyDefinition struct {
+	key     string
+	code    string
+	text    string
+	keyCode int64
+}
+
+type physicalKey struct {
+	code    string
+	keyCode int64
+	shift   bool
+}
+
+var namedKeys = map[string]keyDefinition{
+	"enter":      {"Enter", "Enter", "\r", 13},
+	"tab":        {"Tab", "Tab", "", 9},
+	"escape":     {"Escape", "Escape", "", 27},
+	"backspace":  {"Backspace", "Backspace", "", 8},
+	"delete":     {"Delete", "Delete", "", 46},
+	"insert":     {"Insert", "Insert", "", 45},
+	"space":      {" ", "Space", " ", 32},
+	"arrowleft":  {"ArrowLeft", "ArrowLeft", "", 37},
+	"arrowup":    {"ArrowUp", "ArrowUp", "", 38},
+	"arrowright": {"ArrowRight", "ArrowRight", "", 39},
+	"arrowdown":  {"ArrowDown", "ArrowDown", "", 40},
+	"home":       {"Home", "Home", "", 36},
+	"end":        {"End", "End", "", 35},
+	"pageup":     {"PageUp", "PageUp", "", 33},
+	"pagedown":   {"PageDown", "PageDown", "", 34},
+}
+
+var punctuationKeys = map[rune]physicalKey{
+	'`': {"Backquote", 192, false}, '~': {"Backquote", 192, true},
+	'-': {"Minus", 189, false}, '_': {"Minus", 189, true},
+	'=': {"Equal", 187, false}, '+': {"Equal", 187, true},
+	'[': {"BracketLeft", 219, false}, '{': {"BracketLeft", 219, true},
+	']': {"BracketRight", 221, false}, '}': {"BracketRight", 221, true},
+	'\\': {"Backslash", 220, false}, '|': {"Backslash", 220, true},
+	';': {"Semicolon", 186, false}, ':': {"Semicolon", 186, true},
+	'\'': {"Quote", 222, false}, '"': {"Quote", 222, true},
+	',': {"Comma", 188, false}, '<': {"Comma", 188, true},
+	'.': {"Period", 190, false}, '>': {"Period", 190, true},
+	'/': {"Slash", 191, false}, '?': {"Slash", 191, true},
+	'!': {"Digit1", 49, true}, '@': {"Digit2", 50, true},
+	'#': {"Digit3", 51, true}, '$': {"Digit4", 52, true},
+	'%': {"Digit5", 53, true}, '^': {"Digit6", 54, true},
+	'&': {"Digit7", 55, true}, '*': {"Digit8", 56, true},
+	'(': {"Digit9", 57, true}, ')': {"Digit0", 48, true},
+}
+
+var modifierBits = map[string]int64{"alt": 1, "control": 2, "ctrl": 2, "meta": 4, "cmd": 4, "shift": 8}
+
+func init() {
+	clickCmd.Flags().StringVar(&clickButton, "button", "left", "Mouse button: left, middle or right")
+	clickCmd.Flags().Int64Var(&clickCount, "count", 1, "Number of clicks, e.g. 2 for a double click")
+	typeCmd.Flags().BoolVar(&typeClear, "clear", false, "Clear the element's value before typing")
+	typeCmd.Flags().DurationVar(&typeDelay, "delay", 0, "Delay between keystrokes")
+	for _, c := range []*cobra.Command{clickCmd, typeCmd, pressCmd} {
+		addDOMFlags(c)
+		rootCmd.AddCommand(c)
+	}
+	addWaitFlag(clickCmd)
+	addWaitFlag(typeCmd)
+}
+
+func runClick(_ *cobra.Command, args []string) error {
+	switch input.MouseButton(clickButton) {
+	case input.MouseButtonLeft, input.MouseButtonMiddle, input.MouseButtonRight:
+	default:
+		return utility.ErrUser("invalid --button %q: expected left, middle or right", clickButton)
+	}
+	if clickCount < 1 {
+		return utility.ErrUser("--count must be at least 1")
+	}
+	ctx, c, sessionID, cleanup, err := openSession(domName, domWsURL, domTarget, domTimeout)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	nodeID, err := waitForNode(ctx, c, sessionID, args[0], true)
+	if err != nil {
+		return err
+	}
+	x, y, err := elementCenter(ctx, c, sessionID, nodeID)
+	if err != nil {
+		return err
+	}
+	err = input.DispatchMouseEvent(ctx, c, sessionID, &input.DispatchMouseEventParams{Type: "mouseMoved", X: x, Y: y})
+	if err != nil {
+		return utility.ErrRuntime("moving mouse: %w", err)
+	}
+	for i := int64(1); i <= clickCount; i++ {
+		for _, kind := range []string{"mousePressed", "mouseReleased"} {
+			count := i
+			err = input.DispatchMouseEvent(ctx, c, sessionID, &input.DispatchMouseEventParams{
+				Type:       kind,
+				X:          x,
+				Y:          y,
+				Button:     input.MouseButton(clickButton),
+				ClickCount: &count,
+			})
+			if err != nil {
+				return utility.ErrRuntime("clicking: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+func elementCenter(ctx context.Context, c *internal.Client, sessionID string, nodeID dom.NodeID) (float64, float64, error) {
+	err := dom.ScrollIntoViewIfNeeded(ctx, c, sessionID, &dom.ScrollIntoViewIfNeededParams{NodeID: &nodeID})
+	if err != nil {
+		return 0, 0, utility.ErrRuntime("scrolling into view: %w", err)
+	}
+	quads, err := dom.GetContentQuads(ctx, c, sessionID, &dom.GetContentQuadsParams{NodeID: &nodeID})
+	if err != nil {
+		return 0, 0, utility.ErrRuntime("getting content quads: %w", err)
+	}
+	for _, q := range quads.Quads {
+		if len(q) != 8 || quadArea(q) == 0 {
+			continue
+		}
+		return (q[0] + q[2] + q[4] + q[6]) / 4, (q[1] + q[3] + q[5] + q[7]) / 4, nil
+	}
+	return 0, 0, utility.ErrRuntime("element has no visible box")
+}
+
+func runType(_ *cobra.Command, args []string) error {
+	ctx, c, sessionID, cleanup, err := openSession(domName, domWsURL, domTarget, domTimeout)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	nodeID, err := waitForNode(ctx, c, sessionID, args[0], true)
+	if err != nil {
+		return err
+	}
+	err = dom.ScrollIntoViewIfNeeded(ctx, c, sessionID, &dom.ScrollIntoViewIfNeededParams{NodeID: &nodeID})
+	if err != nil {
+		return utility.ErrRuntime("scrolling into view: %w", err)
+	}
+	err = dom.Focus(ctx, c, sessionID, &dom.FocusParams{NodeID: &nodeID})
+	if err != nil {
+		return utility.ErrRuntime("focusing: %w", err)
+	}
+	if typeClear {
+		_, err = callOnNode(ctx, c, sessionID, nodeID, `function() {
+	if ('value' in this) { this.value = ''; } else if (this.isContentEditable) { this.textContent = ''; }
+	this.dispatchEvent(new Event('input', { bubbles: true }));
+}`)
+		if err != nil {
+			return err
+		}
+	}
+	for i, r := range args[1] {
+		if i > 0 && typeDelay > 0 {
+			select {
+			case <-ctx.Done():
+				return utility.ErrRuntime("typing: %v", ctx.Err())
+			case <-time.After(typeDelay):
+			}
+		}
+		def, modifiers := runeKey(r)
+		err = pressKey(ctx, c, sessionID, def, modifiers)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func runPress(_ *cobra.Command, args []string) error {
+	def, modifiers, err := parseChord(args[0])
+	if err != nil {
+		return err
+	}
+	ctx, c, sessionID, cleanup, err := openSession(domName, domWsURL, domTarget, domTimeout)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	return pressKey(ctx, c, sessionID, def, modifiers)
+}
+
+func parseChord(chord string) (keyDefinition, int64, error) {
+	parts := strings.Split(chord, "+")
+	if strings.HasSuffix(chord, "++") || chord == "+" {
+		parts = append(parts[:len(parts)-2], "+")
+	}
+	var modifiers int64
+	for _, p := range parts[:len(parts)-1] {
+		bit, ok := modifierBits[strings.ToLower(p)]
+		if !ok {
+			return keyDefinition{}, 0, utility.ErrUser("unknown modifier %q in %s", p, chord)
+		}
+		modifiers |= bit
+	}
+	name := parts[len(parts)-1]
+	if def, ok := namedKeys[strings.ToLower(name)]; ok {
+		return def, modifiers, nil
+	}
+	runes := []rune(name)
+	if len(runes) != 1 {
+		return keyDefinition{}, 0, utility.ErrUser("unknown key %q", name)
+	}
+	r := runes[0]
+	if modifiers&modifierBits["shift"] != 0 {
+		r = unicode.ToUpper(r)
+	}
+	def, shift := runeKey(r)
+	return def, modifiers | shift, nil
+}
+
+func runeKey(r rune) (keyDefinition, int64) {
+	switch r {
+	case '\n', '\r':
+		return namedKeys["enter"], 0
+	case '\t':
+		return namedKeys["tab"], 0
+	case ' ':
+		return namedKeys["space"], 0
+	}
+	def := keyDefinition{key: string(r), text: string(r)}
+	shift := false
+	upper := unicode.ToUpper(r)
+	switch {
+	case upper >= 'A' && upper <= 'Z':
+		def.code = "Key" + string(upper)
+		def.keyCode = int64(upper)
+		shift = r == upper
+	case r >= '0' && r <= '9':
+		def.code = "Digit" + string(r)
+		def.keyCode = int64(r)
+	default:
+		if k, ok := punctuationKeys[r]; ok {
+			def.code = k.code
+			def.keyCode = k.keyCode
+			shift = k.shift
+		}
+	}
+	if shift {
+		return def, modifierBits["shift"]
+	}
+	return def, 0
+}
+
+func unshiftedText(text string) string {
+	runes := []rune(text)
+	if len(runes) != 1 {
+		return text
+	}
+	k, ok := punctuationKeys[runes[0]]
+	if !ok || !k.shift {
+		return string(unicode.ToLower(runes[0]))
+	}
+	if digit, ok := strings.CutPrefix(k.code, "Digit"); ok {
+		return digit
+	}
+	for r, base := range punctuationKeys {
+		if base.code == k.code && !base.shift {
+			return string(r)
+		}
+	}
+	return text
+}
+
+func pressKey(ctx context.Context, c *internal.Client, sessionID string, def keyDefinition, modifiers int64) error {
+	down := &input.DispatchKeyEventParams{Type: "rawKeyDown", Key: def.key, Code: def.code, Modifiers: &modifiers}
+	if def.keyCode != 0 {
+		down.WindowsVirtualKeyCode = &def.keyCode
+	}
+	if def.text != "" && modifiers&^modifierBits["shift"] == 0 {
+		down.Type = "keyDown"
+		down.Text = def.text
+		down.UnmodifiedText = unshiftedText(def.text)
+	}
+	err := input.DispatchKeyEvent(ctx, c, sessionID, down)
+	if err != nil {
+		return utility.ErrRuntime("pressing %s: %w", def.key, err)
+	}
+	up := &input.DispatchKeyEventParams{Type: "keyUp", Key: def.key, Code: def.code, Modifiers: &modifiers, WindowsVirtualKeyCode: down.WindowsVirtualKeyCode}
+	err = input.DispatchKeyEvent(ctx, c, sessionID, up)
+	if err != nil {
+		return utility.ErrRuntime("releasing %s: %w", def.key, err)
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"cdp/internal"
+	"cdp/internal/cdptest"
+	"cdp/internal/utility"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestParseChord(t *testing.T) {
+	for _, tc := range []struct {
+		chord     string
+		key       string
+		code      string
+		keyCode   int64
+		modifiers int64
+	}{
+		{"Enter", "Enter", "Enter", 13, 0},
+		{"a", "a", "KeyA", 65, 0},
+		{"A", "A", "KeyA", 65, 8},
+		{"Shift+a", "A", "KeyA", 65, 8},
+		{"Control+A", "A", "KeyA", 65, 10},
+		{"ctrl+shift+tab", "Tab", "Tab", 9, 10},
+		{"Meta+Alt+1", "1", "Digit1", 49, 5},
+		{"Shift+1", "1", "Digit1", 49, 8},
+		{"?", "?", "Slash", 191, 8},
+		{"Control++", "+", "Equal", 187, 10},
+		{"+", "+", "Equal", 187, 8},
+		{"cmd+ArrowLeft", "ArrowLeft", "ArrowLeft", 37, 4},
+	} {
+		def, modifiers, err := parseChord(tc.chord)
+		if err != nil {
+			t.Errorf("%s: %v", tc.chord, err)
+			continue
+		}
+		if def.key != tc.key || def.code != tc.code || def.keyCode != tc.keyCode || modifiers != tc.modifiers {
+			t.Errorf("%s = %+v modifiers %d, want %s %s %d modifiers %d", tc.chord, def, modifiers, tc.key, tc.code, tc.keyCode, tc.modifiers)
+		}
+	}
+	for _, chord := range []string{"Hyper+a", "F13", "Control+ab"} {
+		_, _, err := parseChord(chord)
+		if !utility.IsUserError(err) {
+			t.Errorf("%s: err = %v, want a user error", chord, err)
+		}
+	}
+}
+
+func TestRuneKey(t *testing.T) {
+	for _, tc := range []struct {
+		r          rune
+		code       string
+		keyCode    int64
+		shift      bool
+		unmodified string
+	}{
+		{'a', "KeyA", 65, false, "a"},
+		{'Z', "KeyZ", 90, true, "z"},
+		{'7', "Digit7", 55, false, "7"},
+		{'!', "Digit1", 49, true, "1"},
+		{')', "Digit0", 48, true, "0"},
+		{'-', "Minus", 189, false, "-"},
+		{'_', "Minus", 189, true, "-"},
+		{'"', "Quote", 222, true, "'"},
+		{'é', "", 0, false, "é"},
+	} {
+		def, modifiers := runeKey(tc.r)
+		if def.key != string(tc.r) || def.text != string(tc.r) || def.code != tc.code || def.keyCode != tc.keyCode || (modifiers == modifierBits["shift"]) != tc.shift {
+			t.Errorf("%q = %+v modifiers %d", tc.r, def, modifiers)
+		}
+		if got := unshiftedText(def.text); got != tc.unmodified {
+			t.Errorf("unshifted %q = %q, want %q", tc.r, got, tc.unmodified)
+		}
+	}
+	for r, name := range map[rune]string{'\n': "Enter", '\t': "Tab", ' ': "Space"} {
+		def, modifiers := runeKey(r)
+		if def.code != name || modifiers != 0 {
+			t.Errorf("%q = %+v", r, def)
+		}
+	}
+}
+
+func TestPressKey(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	s.Handle("Input.dispatchKeyEvent", func(*cdptest.Request) (any, error) {
+		return nil, nil
+	})
+	c, err := internal.NewClient(s.WsURL, false)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer c.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	for _, chord := range []string{"A", "!", "Control+a"} {
+		def, modifiers, err := parseChord(chord)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = pressKey(ctx, c, "", def, modifiers)
+		if err != nil {
+			t.Fatalf("%s: %v", chord, err)
+		}
+	}
+	var events []map[string]any
+	for _, req := range s.Requests() {
+		if req.Method == "Input.dispatchKeyEvent" {
+			var params map[string]any
+			_ = json.Unmarshal(req.Params, &params)
+			events = append(events, params)
+		}
+	}
+	want := []map[string]any{
+		{"type": "keyDown", "key": "A", "code": "KeyA", "text": "A", "unmodifiedText": "a", "modifiers": 8.0, "windowsVirtualKeyCode": 65.0},
+		{"type": "keyUp", "key": "A", "code": "KeyA", "modifiers": 8.0, "windowsVirtualKeyCode": 65.0},
+		{"type": "keyDown", "key": "!", "code": "Digit1", "text": "!", "unmodifiedText": "1", "modifiers": 8.0, "windowsVirtualKeyCode": 49.0},
+		{"type": "keyUp", "key": "!", "code": "Digit1", "modifiers": 8.0, "windowsVirtualKeyCode": 49.0},
+		{"type": "rawKeyDown", "key": "a", "code": "KeyA", "modifiers": 2.0, "windowsVirtualKeyCode": 65.0},
+		{"type": "keyUp", "key": "a", "code": "KeyA", "modifiers": 2.0, "windowsVirtualKeyCode": 65.0},
+	}
+	if len(events) != len(want) {
+		t.Fatalf("dispatched %d key events, want %d: %v", len(events), len(want), events)
+	}
+	for i := range want {
+		got, _ := json.Marshal(events[i])
+		exp, _ := json.Marshal(want[i])
+		if string(got) != string(exp) {
+			t.Errorf("event %d = %s, want %s", i, got, exp)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package cmd
+
+import (
+	"cdp/internal"
+	"cdp/internal/intercept"
+	"cdp/internal/utility"
+	"cdp/protocol/fetch"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var interceptCmd = &cobra.Command{
+	Use:   "intercept",
+	Short: "Intercept and mock requests with Fetch domain rules",
+	Long: `Intercept and mock requests with Fetch domain rules.
+
+Rules are read from a YAML file and tried in order; the first rule whose url
+glob, method, resourceType and stage match a paused request decides it:
+
+  rules:
+    - name: users
+      url: "*/api/users*"
+      method: GET
+      status: 200
+      headers: {Content-Type: application/json}
+      file: users.json        # or body: '...'
+    - url: "*/api/slow*"
+      delay: 2s               # then continue
+    - url: "*.png"
+      fail: BlockedByClient
+    - url: "*/api/*"
+      stage: response
+      headers: {Cache-Control: no-store}
+      removeHeaders: [Set-Cookie]
+
+Requests matched by no rule are continued unchanged. Every decision is logged
+as one JSON line. Use --target browser to intercept requests from all tabs.
+Runs until interrupted.`,
+	Args: cobra.NoArgs,
+	RunE: runIntercept,
+}
+
+var (
+	interceptName    string
+	interceptWsURL   string
+	interceptTarget  string
+	interceptRules   string
+	interceptLog     string
+	interceptTimeout time.Duration
+	interceptRecord  string
+)
+
+func init() {
+	interceptCmd.Flags().StringVarP(&interceptName, "name", "n", "", "Browser instance name (default: first available)")
+	interceptCmd.Flags().StringVarP(&interceptWsURL, "ws-url", "w", "", "Remote debugger URL (ws://..., http(s)://..., or host:port)")
+	interceptCmd.Flags().StringVarP(&interceptTarget, "target", "t", "first-page", "Target ID or selector ('browser' for all tabs)")
+	interceptCmd.Flags().StringVarP(&interceptRules, "rules", "r", "", "YAML rules file")
+	interceptCmd.Flags().StringVarP(&interceptLog, "log", "o", "-", "NDJSON decision log ('-' for stdout)")
+	interceptCmd.Flags().DurationVar(&interceptTimeout, "timeout", 30*time.Second, "Timeout for setup and for each Fetch command")
+	interceptCmd.Flags().StringVar(&interceptRecord, "record", "", "Record every CDP frame to a JSONL file for cdp replay")
+	rootCmd.AddCommand(interceptCmd)
+}
+
+func runIntercept(_ *cobra.Command, _ []string) error {
+	if interceptRules == "" {
+		return utility.ErrUser("--rules is required")
+	}
+	rules, err := intercept.Load(interceptRules)
+	if err != nil {
+		return err
+	}
+	wsURL, err := resolveWsURL(interceptName, interceptWsURL)
+	if err != nil {
+		return err
+	}
+	var out io.Writer = os.Stdout
+	if interceptLog != "-" {
+		f, err := os.OpenFile(interceptLog, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+		if err != nil {
+			return utility.ErrUser("opening log: %v", err)
+		}
+		defer func() {
+			_ = f.Close()
+		}()
+		out = f
+	}
+	stopRecording, err := startRecording(interceptRecord)
+	if err != nil {
+		return err
+	}
+	defer stopRecording()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-sigCh
+		cancel()
+	}()
+	c, err := internal.NewClient(wsURL, false)
+	if err != nil {
+		return utility.ErrRuntime("connecting: %v", err)
+	}
+	defer c.Close()
+	setupCtx, setupCancel := context.WithTimeout(ctx, interceptTimeout)
+	defer setupCancel()
+	sessionID, err := c.Attach(setupCtx, interceptTarget)
+	if err != nil {
+		return err
+	}
+	events, unsubscribe := c.Subscribe(sessionID, fetch.EventRequestPaused)
+	defer unsubscribe()
+	err = fetch.Enable(setupCtx, c, sessionID, &fetch.EnableParams{Patterns: intercept.Patterns(rules)})
+	if err != nil {
+		return utility.ErrRuntime("enabling Fetch: %w", err)
+	}
+	utility.Term.Info("intercepting with %d rule(s), Ctrl-C to stop\n", len(rules))
+	var mu sync.Mutex
+	enc := json.NewEncoder(out)
+	enc.SetEscapeHTML(false)
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	for {
+		select {
+		case <-ctx.Done():
+			disableCtx, disableCancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer disableCancel()
+			_ = fetch.Disable(disableCtx, c, sessionID)
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return utility.ErrRuntime("connection closed")
+			}
+			var paused fetch.RequestPausedEvent
+			err := json.Unmarshal(event.Params, &paused)
+			if err != nil {
+				utility.Term.Info("decoding %s: %v\n", event.Method, err)
+				continue
+			}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				callCtx, callCancel := context.WithTimeout(ctx, interceptTimeout)
+				defer callCancel()
+				d := intercept.Apply(callCtx, c, sessionID, rules, &paused)
+				mu.Lock()
+				defer mu.Unlock()
+				_ = enc.Encode(d)
+			}()
+		}
+	}
+}
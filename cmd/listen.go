@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"regexp"
+	"slices"
 	"strings"
 	"syscall"
 
@@ -15,48 +17,85 @@ import (
 )
 
 var listenCmd = &cobra.Command{
-	Use:   "listen <domain>",
+	Use:   "listen <domain>...",
 	Short: "Subscribe to CDP events and stream them",
-	Args:  cobra.ExactArgs(1),
+	Args:  cobra.MinimumNArgs(1),
 	RunE:  runListen,
+
+	ValidArgsFunction: completeDomains,
 }
 
 var (
-	listenName   string
-	listenTarget string
-	listenFilter string
-	listenCount  int
-	listenWsURL  string
+	listenName     string
+	listenTarget   string
+	listenFilter   []string
+	listenExclude  []string
+	listenCount    int
+	listenWsURL    string
+	listenAuto     bool
+	listenEnable   []string
+	listenPre      []string
+	listenDelivery string
+	listenBuffer   int
+	listenQueueMem int64
+	listenRetry    bool
+	listenAttempts int
+	listenRecord   string
 )
 
 func init() {
 	listenCmd.Flags().StringVarP(&listenName, "name", "n", "", "Browser instance name (default: first available)")
 	listenCmd.Flags().StringVarP(&listenWsURL, "ws-url", "w", "", "Remote debugger URL (ws://..., http(s)://..., or host:port)")
-	listenCmd.Flags().StringVarP(&listenTarget, "target", "t", "", "Target ID")
-	listenCmd.Flags().StringVarP(&listenFilter, "filter", "f", "", "Event name filter (e.g., Page.loadEventFired)")
+	listenCmd.Flags().StringVarP(&listenTarget, "target", "t", "", "Target ID or selector (url:<pattern>, title:<pattern>, type:<type>, first-page, index:<n>, new)")
+	listenCmd.Flags().StringArrayVarP(&listenFilter, "filter", "f", nil, "Only emit events matching this prefix, glob (Network.*Response*) or /regex/ (repeatable)")
+	listenCmd.Flags().StringArrayVar(&listenExclude, "exclude", nil, "Drop events matching this prefix, glob or /regex/ (repeatable)")
 	listenCmd.Flags().IntVarP(&listenCount, "count", "c", 0, "Exit after N events (0 = unlimited)")
+	listenCmd.Flags().StringArrayVar(&listenEnable, "enable-params", nil, "JSON params for <domain>.enable, as '{...}' for every domain or 'Domain={...}' (repeatable)")
+	listenCmd.Flags().StringArrayVar(&listenPre, "pre", nil, "Command to run on the session before streaming, as '<method> [params]' (repeatable)")
+	listenCmd.Flags().StringVar(&listenDelivery, "delivery", string(internal.DeliveryQueue), "Event delivery when output falls behind: block (backpressure), queue (buffer in memory) or drop")
+	listenCmd.Flags().IntVar(&listenBuffer, "buffer", internal.DefaultEventBuffer, "Number of events buffered between the connection and the output")
+	listenCmd.Flags().Int64Var(&listenQueueMem, "max-queue-mem", internal.DefaultMaxQueueMem>>20, "Memory cap in MiB for queued events before dropping (0 = unlimited)")
+	listenCmd.Flags().BoolVar(&listenRetry, "reconnect", false, "Reconnect with backoff when the connection drops, restoring sessions and enabled domains")
+	listenCmd.Flags().IntVar(&listenAttempts, "reconnect-attempts", 0, "Give up after N failed reconnect attempts (0 = unlimited)")
+	listenCmd.Flags().BoolVar(&listenAuto, "auto-attach", false, "Also listen in child targets (iframes, workers) attached automatically")
+	listenCmd.Flags().StringVar(&listenRecord, "record", "", "Record every CDP frame to a JSONL file for cdp replay")
 	rootCmd.AddCommand(listenCmd)
 }
 
 func runListen(_ *cobra.Command, args []string) error {
-	domain := args[0]
-	if listenWsURL != "" && listenName != "" {
-		return utility.ErrUser("--ws-url and --name are mutually exclusive")
-	}
-	wsURL := listenWsURL
-	if wsURL != "" {
-		var err error
-		wsURL, err = internal.ResolveWsURL(wsURL)
-		if err != nil {
-			return err
-		}
-	} else {
-		inst, err := internal.ResolveInstance(listenName)
-		if err != nil {
-			return err
-		}
-		wsURL = inst.WsURL
+	include, err := compileEventPatterns(listenFilter)
+	if err != nil {
+		return err
+	}
+	exclude, err := compileEventPatterns(listenExclude)
+	if err != nil {
+		return err
+	}
+	enableParams, err := parseEnableParams(args, listenEnable)
+	if err != nil {
+		return err
+	}
+	pre, err := parsePreCommands(listenPre)
+	if err != nil {
+		return err
+	}
+	delivery, err := internal.ParseDelivery(listenDelivery)
+	if err != nil {
+		return err
+	}
+	maxQueueMem := listenQueueMem << 20
+	if listenQueueMem == 0 {
+		maxQueueMem = -1
+	}
+	wsURL, err := resolveWsURL(listenName, listenWsURL)
+	if err != nil {
+		return err
+	}
+	stopRecording, err := startRecording(listenRecord)
+	if err != nil {
+		return err
 	}
+	defer stopRecording()
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 	sigCh := make(chan os.Signal, 1)
@@ -65,10 +104,32 @@ func runListen(_ *cobra.Command, args []string) error {
 		<-sigCh
 		cancel()
 	}()
-	eventCh := make(chan *internal.CDPMessage, 100)
+	opts := internal.ListenOptions{
+		Target:       listenTarget,
+		Domains:      args,
+		EnableParams: enableParams,
+		Pre:          pre,
+		AutoAttach:   listenAuto,
+		Client: internal.ClientOptions{
+			Delivery:    delivery,
+			Buffer:      listenBuffer,
+			MaxQueueMem: maxQueueMem,
+		},
+	}
+	if listenRetry {
+		opts.Client.Reconnect = &internal.ReconnectOptions{
+			Resolve:     func() (string, error) { return resolveWsURL(listenName, listenWsURL) },
+			MaxAttempts: listenAttempts,
+		}
+	}
+	eventCh := make(chan *internal.ListenEvent, listenBuffer)
 	errCh := make(chan error, 1)
 	go func() {
-		errCh <- internal.Listen(ctx, wsURL, listenTarget, domain, eventCh)
+		if internal.DaemonRunning() && !opts.AutoAttach && !listenRetry && listenRecord == "" {
+			errCh <- internal.DaemonListen(ctx, wsURL, opts, eventCh)
+			return
+		}
+		errCh <- internal.Listen(ctx, wsURL, opts, eventCh)
 	}()
 	count := 0
 	for {
@@ -76,23 +137,18 @@ func runListen(_ *cobra.Command, args []string) error {
 		case err := <-errCh:
 			return err
 		case event := <-eventCh:
-			if listenFilter != "" && !strings.HasPrefix(event.Method, listenFilter) {
+			marker := event.Method == internal.EventsDroppedMethod || event.Method == internal.ReconnectedMethod
+			if !marker && (len(include) > 0 && !matchesAny(include, event.Method) || matchesAny(exclude, event.Method)) {
 				continue
 			}
-			out := map[string]any{"method": event.Method}
-			if event.Params != nil {
-				var params any
-				err := json.Unmarshal(event.Params, &params)
-				if err != nil {
-					return err
-				}
-				out["params"] = params
-			}
-			data, err := json.Marshal(out)
+			data, err := marshalEvent(event.CDPMessage, event.Target, listenAuto)
 			if err != nil {
 				return err
 			}
 			fmt.Println(string(data))
+			if marker {
+				continue
+			}
 			count++
 			if listenCount > 0 && count >= listenCount {
 				return nil
@@ -102,3 +158,99 @@ func runListen(_ *cobra.Command, args []string) error {
 		}
 	}
 }
+
+func parseEnableParams(domains, values []string) (map[string]json.RawMessage, error) {
+	params := make(map[string]json.RawMessage)
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		targets := domains
+		raw := value
+		if !strings.HasPrefix(value, "{") {
+			domain, rest, ok := strings.Cut(value, "=")
+			if !ok {
+				return nil, utility.ErrUser("invalid --enable-params %q: expected '{...}' or 'Domain={...}'", value)
+			}
+			if !slices.Contains(domains, domain) {
+				return nil, utility.ErrUser("--enable-params for %s, which is not being listened to", domain)
+			}
+			targets = []string{domain}
+			raw = rest
+		}
+		var obj map[string]any
+		err := json.Unmarshal([]byte(raw), &obj)
+		if err != nil {
+			return nil, utility.ErrUser("invalid --enable-params %q: must be a JSON object", value)
+		}
+		for _, domain := range targets {
+			params[domain] = json.RawMessage(raw)
+		}
+	}
+	return params, nil
+}
+
+func parsePreCommands(values []string) ([]internal.Command, error) {
+	cmds := make([]internal.Command, 0, len(values))
+	for _, value := range values {
+		method, raw, _ := strings.Cut(strings.TrimSpace(value), " ")
+		if !strings.Contains(method, ".") {
+			return nil, utility.ErrUser("invalid --pre %q: expected '<Domain.method> [params]'", value)
+		}
+		cmd := internal.Command{Method: method}
+		raw = strings.TrimSpace(raw)
+		if raw != "" {
+			if !json.Valid([]byte(raw)) {
+				return nil, utility.ErrUser("invalid --pre %q: params must be valid JSON", value)
+			}
+			cmd.Params = json.RawMessage(raw)
+		}
+		cmds = append(cmds, cmd)
+	}
+	return cmds, nil
+}
+
+func compileEventPatterns(patterns []string) ([]*regexp.Regexp, error) {
+	res := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		switch {
+		case len(pattern) > 1 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/"):
+			re, err := regexp.Compile(pattern[1 : len(pattern)-1])
+			if err != nil {
+				return nil, utility.ErrUser("invalid event pattern %s: %v", pattern, err)
+			}
+			res = append(res, re)
+		case strings.ContainsAny(pattern, "*?"):
+			res = append(res, utility.GlobRegexp(pattern))
+		default:
+			res = append(res, regexp.MustCompile("^"+regexp.QuoteMeta(pattern)))
+		}
+	}
+	return res, nil
+}
+
+func matchesAny(res []*regexp.Regexp, s string) bool {
+	for _, re := range res {
+		if re.MatchString(s) {
+			return true
+		}
+	}
+	return false
+}
+
+func marshalEvent(event *internal.CDPMessage, target *internal.TargetInfo, withSession bool) ([]byte, error) {
+	out := map[string]any{"method": event.Method}
+	if event.Params != nil {
+		var params any
+		err := json.Unmarshal(event.Params, &params)
+		if err != nil {
+			return nil, err
+		}
+		out["params"] = params
+	}
+	if withSession && event.SessionID != "" {
+		out["sessionId"] = event.SessionID
+	}
+	if target != nil {
+		out["target"] = map[string]any{"targetId": target.TargetID, "type": target.Type, "url": target.URL}
+	}
+	return json.Marshal(out)
+}
@@ -0,0 +1,170 @@
+package cmd
+
+import (
+	"cdp/internal"
+	"cdp/internal/utility"
+	"cdp/protocol/network"
+	"cdp/protocol/page"
+	"context"
+	"encoding/json"
+	"math"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var navigateCmd = &cobra.Command{
+	Use:   "navigate <url>",
+	Short: "Navigate a target and wait for a lifecycle event",
+	Long: `Navigate a target and wait for a lifecycle event.
+
+--wait-until is one of load, DOMContentLoaded, networkIdle, networkAlmostIdle
+or firstMeaningfulPaint. The result reports the frame and loader IDs, the final
+URL, the HTTP status and the milliseconds from navigation start to each
+lifecycle event seen on the way.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runNavigate,
+}
+
+var (
+	navigateName      string
+	navigateWsURL     string
+	navigateTarget    string
+	navigateWaitUntil string
+	navigateReferrer  string
+	navigateTimeout   time.Duration
+)
+
+var lifecycleEvents = []string{"load", "DOMContentLoaded", "networkIdle", "networkAlmostIdle", "firstMeaningfulPaint"}
+
+type navigation struct {
+	FrameID    page.FrameID       `json:"frameId"`
+	LoaderID   string             `json:"loaderId,omitempty"`
+	URL        string             `json:"url"`
+	Status     int64              `json:"status,omitempty"`
+	StatusText string             `json:"statusText,omitempty"`
+	Timings    map[string]float64 `json:"timings"`
+	Elapsed    float64            `json:"elapsed"`
+}
+
+func init() {
+	navigateCmd.Flags().StringVarP(&navigateName, "name", "n", "", "Browser instance name (default: first available)")
+	navigateCmd.Flags().StringVarP(&navigateWsURL, "ws-url", "w", "", "Remote debugger URL (ws://..., http(s)://..., or host:port)")
+	navigateCmd.Flags().StringVarP(&navigateTarget, "target", "t", "first-page", "Target ID or selector")
+	navigateCmd.Flags().StringVar(&navigateWaitUntil, "wait-until", "load", "Lifecycle event to wait for")
+	navigateCmd.Flags().StringVar(&navigateReferrer, "referrer", "", "Referrer URL")
+	navigateCmd.Flags().DurationVar(&navigateTimeout, "timeout", 30*time.Second, "Navigation timeout")
+	rootCmd.AddCommand(navigateCmd)
+}
+
+func runNavigate(_ *cobra.Command, args []string) error {
+	err := checkLifecycleEvent(navigateWaitUntil)
+	if err != nil {
+		return err
+	}
+	ctx, c, sessionID, cleanup, err := openSession(navigateName, navigateWsURL, navigateTarget, navigateTimeout)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	nav, err := navigate(ctx, c, sessionID, &page.NavigateParams{URL: args[0], Referrer: navigateReferrer}, navigateWaitUntil)
+	if err != nil {
+		return err
+	}
+	return printJSON(nav)
+}
+
+func checkLifecycleEvent(name string) error {
+	for _, event := range lifecycleEvents {
+		if name == event {
+			return nil
+		}
+	}
+	return utility.ErrUser("invalid --wait-until %q: expected one of load, DOMContentLoaded, networkIdle, networkAlmostIdle, firstMeaningfulPaint", name)
+}
+
+func navigate(ctx context.Context, c *internal.Client, sessionID string, params *page.NavigateParams, waitUntil string) (*navigation, error) {
+	events, unsubscribe := c.Subscribe(sessionID, "")
+	defer unsubscribe()
+	err := page.Enable(ctx, c, sessionID)
+	if err != nil {
+		return nil, utility.ErrRuntime("enabling Page: %w", err)
+	}
+	err = page.SetLifecycleEventsEnabled(ctx, c, sessionID, &page.SetLifecycleEventsEnabledParams{Enabled: true})
+	if err != nil {
+		return nil, utility.ErrRuntime("enabling lifecycle events: %w", err)
+	}
+	err = network.Enable(ctx, c, sessionID, nil)
+	if err != nil {
+		return nil, utility.ErrRuntime("enabling Network: %w", err)
+	}
+	start := time.Now()
+	result, err := page.Navigate(ctx, c, sessionID, params)
+	if err != nil {
+		return nil, utility.ErrRuntime("navigating: %w", err)
+	}
+	if result.ErrorText != "" {
+		return nil, utility.ErrRuntime("navigating to %s: %s", params.URL, result.ErrorText)
+	}
+	nav := &navigation{FrameID: result.FrameID, LoaderID: result.LoaderID, URL: params.URL, Timings: map[string]float64{}}
+	if result.LoaderID == "" {
+		nav.Elapsed = milliseconds(time.Since(start).Seconds())
+		return nav, nil
+	}
+	stamps := map[string]float64{}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, utility.ErrRuntime("waiting for %s: %v", waitUntil, ctx.Err())
+		case event, ok := <-events:
+			if !ok {
+				return nil, utility.ErrRuntime("waiting for %s: connection closed", waitUntil)
+			}
+			name := observeNavigation(nav, stamps, event)
+			if name == waitUntil {
+				nav.Elapsed = milliseconds(time.Since(start).Seconds())
+				base, ok := stamps["init"]
+				if !ok {
+					base = math.Inf(1)
+					for _, ts := range stamps {
+						base = math.Min(base, ts)
+					}
+				}
+				for name, ts := range stamps {
+					nav.Timings[name] = milliseconds(ts - base)
+				}
+				return nav, nil
+			}
+		}
+	}
+}
+
+func observeNavigation(nav *navigation, stamps map[string]float64, event *internal.CDPMessage) string {
+	switch event.Method {
+	case page.EventLifecycleEvent:
+		var e page.LifecycleEventEvent
+		if json.Unmarshal(event.Params, &e) != nil || e.FrameID != nav.FrameID || e.LoaderID != nav.LoaderID {
+			return ""
+		}
+		if _, seen := stamps[e.Name]; !seen {
+			stamps[e.Name] = e.Timestamp
+		}
+		return e.Name
+	case page.EventFrameNavigated:
+		var e page.FrameNavigatedEvent
+		if json.Unmarshal(event.Params, &e) == nil && e.Frame.ID == nav.FrameID && e.Frame.LoaderID == nav.LoaderID {
+			nav.URL = e.Frame.URL + e.Frame.URLFragment
+		}
+	case network.EventResponseReceived:
+		var e network.ResponseReceivedEvent
+		if json.Unmarshal(event.Params, &e) == nil && string(e.RequestID) == nav.LoaderID && e.Type == network.ResourceTypeDocument {
+			nav.Status = e.Response.Status
+			nav.StatusText = e.Response.StatusText
+		}
+	}
+	return ""
+}
+
+func milliseconds(seconds float64) float64 {
+	return math.Round(seconds*1e6) / 1e3
+}
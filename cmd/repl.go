@@ -0,0 +1,281 @@
+package cmd
+
+import (
+	"bufio"
+	"cdp/internal"
+	"cdp/internal/utility"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const replHelp = `Enter one command per line as "<method> [json params]", e.g.:
+
+  Page.navigate {"url":"https://example.com"}
+
+Responses and events are printed as NDJSON. Meta-commands:
+
+  .attach <target>     attach to a target ID or selector and switch to its session
+  .session [id]        show or switch the current session
+  .sessions            list sessions attached in this repl
+  .browser             switch to the browser-level session
+  .detach              detach from the current session
+  .help                show this help
+  .exit                close the connection and exit
+`
+
+var replCmd = &cobra.Command{
+	Use:     "repl",
+	Aliases: []string{"session"},
+	Short:   "Keep one CDP connection open and send commands line by line",
+	Long:    "Keep one CDP connection open and send commands line by line.\n\n" + replHelp,
+	Args:    cobra.NoArgs,
+	RunE:    runRepl,
+}
+
+var (
+	replName    string
+	replWsURL   string
+	replTarget  string
+	replTimeout time.Duration
+	replRecord  string
+)
+
+func init() {
+	replCmd.Flags().StringVarP(&replName, "name", "n", "", "Browser instance name (default: first available)")
+	replCmd.Flags().StringVarP(&replWsURL, "ws-url", "w", "", "Remote debugger URL (ws://..., http(s)://..., or host:port)")
+	replCmd.Flags().StringVarP(&replTarget, "target", "t", "", "Target ID or selector to attach to on start")
+	replCmd.Flags().DurationVar(&replTimeout, "timeout", 30*time.Second, "Response timeout per command")
+	replCmd.Flags().StringVar(&replRecord, "record", "", "Record every CDP frame to a JSONL file for cdp replay")
+	rootCmd.AddCommand(replCmd)
+}
+
+type repl struct {
+	client   *internal.Client
+	session  string
+	sessions map[string]string
+	mu       sync.Mutex
+}
+
+func runRepl(_ *cobra.Command, _ []string) error {
+	wsURL, err := resolveWsURL(replName, replWsURL)
+	if err != nil {
+		return err
+	}
+	stopRecording, err := startRecording(replRecord)
+	if err != nil {
+		return err
+	}
+	defer stopRecording()
+	client, err := internal.NewClient(wsURL, true)
+	if err != nil {
+		return utility.ErrRuntime("connecting: %v", err)
+	}
+	defer client.Close()
+	r := &repl{client: client, sessions: make(map[string]string)}
+	if replTarget != "" {
+		err = r.attach(replTarget)
+		if err != nil {
+			return err
+		}
+	}
+	closed := make(chan struct{})
+	go func() {
+		for event := range client.Events {
+			data, err := marshalEvent(event, nil, true)
+			if err != nil {
+				utility.Term.Info("encoding event: %v\n", err)
+				continue
+			}
+			r.println(data)
+		}
+		close(closed)
+	}()
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+	lines := make(chan string)
+	go func() {
+		scanner := bufio.NewScanner(os.Stdin)
+		scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+		err := scanner.Err()
+		if err != nil {
+			utility.Term.Error("error: reading stdin: %v\n", err)
+		}
+		close(lines)
+	}()
+	stat, _ := os.Stdin.Stat()
+	interactive := (stat.Mode() & os.ModeCharDevice) != 0
+	for {
+		if interactive {
+			r.prompt()
+		}
+		select {
+		case <-sigCh:
+			return nil
+		case <-closed:
+			return utility.ErrRuntime("connection closed")
+		case line, ok := <-lines:
+			if !ok {
+				return nil
+			}
+			exit, err := r.exec(line)
+			if err != nil {
+				utility.Term.Error("error: %v\n", err)
+			}
+			if exit {
+				return nil
+			}
+		}
+	}
+}
+
+func (r *repl) println(data []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	fmt.Println(string(data))
+}
+
+func (r *repl) prompt() {
+	label := "browser"
+	if r.session != "" {
+		label = r.session
+		target, ok := r.sessions[r.session]
+		if ok {
+			label = target
+		}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	utility.Term.Error("%s> ", label)
+}
+
+func (r *repl) exec(line string) (bool, error) {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return false, nil
+	}
+	if strings.HasPrefix(line, ".") {
+		return r.meta(line)
+	}
+	method, params, _ := strings.Cut(line, " ")
+	params = strings.TrimSpace(params)
+	var paramsJSON json.RawMessage
+	if params != "" {
+		if !json.Valid([]byte(params)) {
+			return false, utility.ErrUser("invalid JSON params")
+		}
+		paramsJSON = json.RawMessage(params)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), replTimeout)
+	defer cancel()
+	resp, err := r.client.Send(ctx, method, paramsJSON, r.session)
+	if err != nil {
+		return false, utility.ErrRuntime("sending command: %v", err)
+	}
+	out := map[string]any{"id": resp.ID}
+	if resp.Error != nil {
+		out["error"] = resp.Error
+	} else if resp.Result != nil {
+		out["result"] = resp.Result
+	} else {
+		out["result"] = json.RawMessage("{}")
+	}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return false, err
+	}
+	r.println(data)
+	return false, nil
+}
+
+func (r *repl) meta(line string) (bool, error) {
+	fields := strings.Fields(line)
+	switch fields[0] {
+	case ".exit", ".quit":
+		return true, nil
+	case ".help":
+		utility.Term.Error("%s", replHelp)
+	case ".attach":
+		if len(fields) != 2 {
+			return false, utility.ErrUser("usage: .attach <target>")
+		}
+		return false, r.attach(fields[1])
+	case ".session":
+		if len(fields) > 2 {
+			return false, utility.ErrUser("usage: .session [sessionId]")
+		}
+		if len(fields) == 2 {
+			r.session = fields[1]
+		}
+		return false, r.printJSON(map[string]any{"sessionId": r.session})
+	case ".sessions":
+		ids := make([]string, 0, len(r.sessions))
+		for id := range r.sessions {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		list := make([]map[string]any, 0, len(ids))
+		for _, id := range ids {
+			list = append(list, map[string]any{"sessionId": id, "targetId": r.sessions[id], "current": id == r.session})
+		}
+		return false, r.printJSON(list)
+	case ".browser":
+		r.session = ""
+	case ".detach":
+		if r.session == "" {
+			return false, utility.ErrUser("no session attached")
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), replTimeout)
+		defer cancel()
+		err := r.client.DetachFromTarget(ctx, r.session)
+		if err != nil {
+			return false, utility.ErrRuntime("detaching: %v", err)
+		}
+		delete(r.sessions, r.session)
+		r.session = ""
+	default:
+		return false, utility.ErrUser("unknown meta-command %s (try .help)", fields[0])
+	}
+	return false, nil
+}
+
+func (r *repl) attach(target string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), replTimeout)
+	defer cancel()
+	targetID, err := r.client.ResolveTarget(ctx, target)
+	if err != nil {
+		return err
+	}
+	if targetID == "" {
+		r.session = ""
+		return r.printJSON(map[string]any{"sessionId": ""})
+	}
+	sessionID, err := r.client.AttachToTarget(ctx, targetID)
+	if err != nil {
+		return utility.ErrRuntime("attaching to target: %w", err)
+	}
+	r.sessions[sessionID] = targetID
+	r.session = sessionID
+	return r.printJSON(map[string]any{"sessionId": sessionID, "targetId": targetID})
+}
+
+func (r *repl) printJSON(v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	r.println(data)
+	return nil
+}
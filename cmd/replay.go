@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"cdp/internal"
+	"cdp/internal/utility"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+)
+
+var replayCmd = &cobra.Command{
+	Use:   "replay <recording.jsonl>",
+	Short: "Serve a recording made with --record from a local fake CDP endpoint",
+	Long: `Serve a recording made with --record from a local fake CDP endpoint.
+
+Each command a client sends is matched, in order, against the next recorded
+command with the same method. The recorded response is returned with the
+client's ID, and recorded events are replayed in their original order.
+Commands missing from the recording fail with a "wasn't found" error.
+
+Point any cdp command at the printed address with --ws-url.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runReplay,
+}
+
+var replayAddr string
+
+func init() {
+	replayCmd.Flags().StringVar(&replayAddr, "addr", "127.0.0.1:0", "Address to listen on (port 0 picks a free port)")
+	rootCmd.AddCommand(replayCmd)
+}
+
+func runReplay(_ *cobra.Command, args []string) error {
+	frames, err := internal.LoadRecording(args[0])
+	if err != nil {
+		return err
+	}
+	srv, err := internal.NewReplayServer(frames, replayAddr)
+	if err != nil {
+		return err
+	}
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-sigCh
+		_ = srv.Close()
+	}()
+	utility.Term.Text("replaying %d frames from %s on %s\n", len(frames), args[0], srv.WsURL())
+	err = srv.Serve()
+	if err != nil {
+		return utility.ErrRuntime("serving replay: %v", err)
+	}
+	return nil
+}
@@ -1,36 +1,139 @@
 package cmd
 
 import (
+	"cdp/internal"
 	"cdp/internal/utility"
+	"context"
+	"encoding/json"
 	"fmt"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/spf13/cobra"
 )
 
 var rootCmd = &cobra.Command{
-	Use:           "cdp",
-	Short:         "Chrome DevTools Protocol CLI",
+	Use:   "cdp",
+	Short: "Chrome DevTools Protocol CLI",
+	Long: `Chrome DevTools Protocol CLI.
+
+Exit codes:
+  0  success
+  1  usage error (bad flags, arguments or input)
+  2  runtime error (connection, timeout, I/O)
+  3  CDP error -32601: method not found
+  4  CDP error -32602: invalid params
+  5  CDP error -32000: server error
+  6  any other CDP error code
+
+With --error-format json, errors are written to stderr as a single JSON object:
+  {"error":{"kind":"protocol","message":"...","method":"...","code":-32601,"exitCode":3}}`,
 	SilenceErrors: true,
 	SilenceUsage:  true,
+	PersistentPreRunE: func(_ *cobra.Command, _ []string) error {
+		if rootErrorFormat != "text" && rootErrorFormat != "json" {
+			return utility.ErrUser("--error-format must be text or json, got %q", rootErrorFormat)
+		}
+		return nil
+	},
 }
 
+var rootErrorFormat string
+
 func init() {
-	rootCmd.CompletionOptions.DisableDefaultCmd = true
 	rootCmd.PersistentFlags().BoolVarP(&utility.Verbose, "verbose", "v", false, "Enable debug output")
+	rootCmd.PersistentFlags().StringVar(&rootErrorFormat, "error-format", "text", "Error output format: text or json")
 }
 
 func Execute() {
+	registerCompletions(rootCmd)
 	err := rootCmd.Execute()
 	if err != nil {
-		_, _ = fmt.Fprintln(os.Stderr, "error:", err)
-		switch {
-		case utility.IsUserError(err):
-			os.Exit(1)
-		case utility.IsRuntimeError(err):
-			os.Exit(2)
-		default:
-			os.Exit(1)
+		code := utility.ExitCode(err)
+		if rootErrorFormat == "json" {
+			printErrorJSON(err, code)
+		} else {
+			_, _ = fmt.Fprintln(os.Stderr, "error:", err)
+		}
+		os.Exit(code)
+	}
+}
+
+func printErrorJSON(err error, code int) {
+	out := map[string]any{"message": err.Error(), "exitCode": code}
+	if e, ok := utility.AsProtocolError(err); ok {
+		out["kind"] = "protocol"
+		out["method"] = e.Method
+		out["code"] = e.Code
+		if e.Data != "" {
+			out["data"] = e.Data
+		}
+	} else if utility.IsRuntimeError(err) {
+		out["kind"] = "runtime"
+	} else {
+		out["kind"] = "user"
+	}
+	data, _ := json.Marshal(map[string]any{"error": out})
+	_, _ = fmt.Fprintln(os.Stderr, string(data))
+}
+
+func startRecording(path string) (func(), error) {
+	if path == "" {
+		return func() {}, nil
+	}
+	r, err := internal.StartRecording(path)
+	if err != nil {
+		return nil, err
+	}
+	return func() {
+		err := r.Close()
+		if err != nil {
+			utility.Term.Error("%v\n", err)
 		}
+	}, nil
+}
+
+func resolveWsURL(name, wsURL string) (string, error) {
+	if wsURL != "" && name != "" {
+		return "", utility.ErrUser("--ws-url and --name are mutually exclusive")
+	}
+	if wsURL != "" {
+		return internal.ResolveWsURL(wsURL)
+	}
+	inst, err := internal.ResolveInstance(name)
+	if err != nil {
+		return "", err
+	}
+	return inst.WsURL, nil
+}
+
+func openSession(name, wsURL, target string, timeout time.Duration) (context.Context, *internal.Client, string, func(), error) {
+	wsURL, err := resolveWsURL(name, wsURL)
+	if err != nil {
+		return nil, nil, "", nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-sigCh
+		cancel()
+	}()
+	c, err := internal.NewClient(wsURL, false)
+	if err != nil {
+		cancel()
+		return nil, nil, "", nil, utility.ErrRuntime("connecting: %v", err)
+	}
+	cleanup := func() {
+		c.Close()
+		cancel()
+	}
+	sessionID, err := c.Attach(ctx, target)
+	if err != nil {
+		cleanup()
+		return nil, nil, "", nil, err
 	}
+	return ctx, c, sessionID, cleanup, nil
 }
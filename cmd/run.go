@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"cdp/internal"
+	"cdp/internal/script"
+	"cdp/internal/utility"
+	"context"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var runCmd = &cobra.Command{
+	Use:   "run <script>",
+	Short: "Run a YAML or JSON script of CDP steps and print a JSON report",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runRun,
+}
+
+var (
+	runName    string
+	runWsURL   string
+	runTarget  string
+	runTimeout time.Duration
+	runRecord  string
+	runVars    []string
+)
+
+func init() {
+	runCmd.Flags().StringVarP(&runName, "name", "n", "", "Browser instance name (default: first available)")
+	runCmd.Flags().StringVarP(&runWsURL, "ws-url", "w", "", "Remote debugger URL (ws://..., http(s)://..., or host:port)")
+	runCmd.Flags().StringVarP(&runTarget, "target", "t", "first-page", "Target ID or selector (overrides the script's target)")
+	runCmd.Flags().DurationVar(&runTimeout, "timeout", 30*time.Second, "Default timeout for each step")
+	runCmd.Flags().StringArrayVar(&runVars, "var", nil, "Set a script variable as key=value (repeatable)")
+	runCmd.Flags().StringVar(&runRecord, "record", "", "Record every CDP frame to a JSONL file for cdp replay")
+	rootCmd.AddCommand(runCmd)
+}
+
+func runRun(cmd *cobra.Command, args []string) error {
+	s, err := script.Load(args[0])
+	if err != nil {
+		return err
+	}
+	vars := make(map[string]any)
+	for _, v := range runVars {
+		key, value, ok := strings.Cut(v, "=")
+		if !ok || key == "" {
+			return utility.ErrUser("invalid --var %q: expected key=value", v)
+		}
+		vars[key] = value
+	}
+	wsURL, err := resolveWsURL(runName, runWsURL)
+	if err != nil {
+		return err
+	}
+	target := runTarget
+	if s.Target != "" && !cmd.Flags().Changed("target") {
+		target = s.Target
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-sigCh
+		cancel()
+	}()
+	stopRecording, err := startRecording(runRecord)
+	if err != nil {
+		return err
+	}
+	defer stopRecording()
+	c, err := internal.NewClient(wsURL, false)
+	if err != nil {
+		return utility.ErrRuntime("connecting: %v", err)
+	}
+	defer c.Close()
+	attachCtx, attachCancel := context.WithTimeout(ctx, runTimeout)
+	sessionID, err := c.Attach(attachCtx, target)
+	attachCancel()
+	if err != nil {
+		return err
+	}
+	runner := &script.Runner{Client: c, SessionID: sessionID, Timeout: runTimeout}
+	report := runner.Run(ctx, s, vars)
+	err = printJSON(report)
+	if err != nil {
+		return err
+	}
+	if !report.OK {
+		if _, ok := utility.AsProtocolError(report.Err); ok || utility.IsUserError(report.Err) {
+			return report.Err
+		}
+		return utility.ErrRuntime("%w", report.Err)
+	}
+	return nil
+}
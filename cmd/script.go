@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"cdp/internal"
+	"cdp/internal/script"
+	"cdp/internal/utility"
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var scriptCmd = &cobra.Command{
+	Use:   "script <file.js> [args...]",
+	Short: "Run a JavaScript file against a target using an embedded engine",
+	Long: `Run a JavaScript file against a target using an embedded engine.
+
+The script sees a global cdp object bound to the attached session:
+  cdp.send(method, params?, {sessionId}?)  send a command and return its result
+  cdp.on(pattern, fn)                      call fn for matching events; returns an unsubscribe function
+  cdp.waitFor(pattern, predicate?, ms?)    block until a matching event arrives and return it
+  cdp.targets()                            list targets
+  cdp.sleep(ms)                            pause while still dispatching events
+  cdp.exit()                               stop the script
+  cdp.sessionId, cdp.args                  attached session ID and extra command-line arguments
+
+Event patterns accept globs (Page.*). console.log writes to stdout.
+Once the script body returns, events keep being dispatched to cdp.on handlers
+until cdp.exit() is called, every handler is removed, the connection closes
+or the command is interrupted.`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runScript,
+}
+
+var (
+	scriptName    string
+	scriptWsURL   string
+	scriptTarget  string
+	scriptTimeout time.Duration
+	scriptRecord  string
+)
+
+func init() {
+	scriptCmd.Flags().StringVarP(&scriptName, "name", "n", "", "Browser instance name (default: first available)")
+	scriptCmd.Flags().StringVarP(&scriptWsURL, "ws-url", "w", "", "Remote debugger URL (ws://..., http(s)://..., or host:port)")
+	scriptCmd.Flags().StringVarP(&scriptTarget, "target", "t", "first-page", "Target ID or selector")
+	scriptCmd.Flags().DurationVar(&scriptTimeout, "timeout", 30*time.Second, "Default timeout for each send and waitFor")
+	scriptCmd.Flags().StringVar(&scriptRecord, "record", "", "Record every CDP frame to a JSONL file for cdp replay")
+	rootCmd.AddCommand(scriptCmd)
+}
+
+func runScript(_ *cobra.Command, args []string) error {
+	wsURL, err := resolveWsURL(scriptName, scriptWsURL)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-sigCh
+		cancel()
+	}()
+	stopRecording, err := startRecording(scriptRecord)
+	if err != nil {
+		return err
+	}
+	defer stopRecording()
+	c, err := internal.NewClient(wsURL, false)
+	if err != nil {
+		return utility.ErrRuntime("connecting: %v", err)
+	}
+	defer c.Close()
+	attachCtx, attachCancel := context.WithTimeout(ctx, scriptTimeout)
+	sessionID, err := c.Attach(attachCtx, scriptTarget)
+	attachCancel()
+	if err != nil {
+		return err
+	}
+	runner := &script.JSRunner{
+		Client:    c,
+		SessionID: sessionID,
+		Timeout:   scriptTimeout,
+		Stdout:    os.Stdout,
+		Stderr:    os.Stderr,
+	}
+	return runner.Run(ctx, args[0], args[1:])
+}
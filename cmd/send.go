@@ -3,11 +3,13 @@ package cmd
 import (
 	"bufio"
 	"cdp/internal"
+	"cdp/internal/protocol"
 	"cdp/internal/utility"
 	"context"
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -16,8 +18,10 @@ import (
 var sendCmd = &cobra.Command{
 	Use:   "send <method>",
 	Short: "Send a CDP command and return the response",
-	Args:  cobra.ExactArgs(1),
+	Args:  sendArgs,
 	RunE:  runSend,
+
+	ValidArgsFunction: completeMethods,
 }
 
 var (
@@ -26,17 +30,32 @@ var (
 	sendParams  string
 	sendTimeout time.Duration
 	sendWsURL   string
+	sendNoCheck bool
+	sendStrict  bool
+	sendBatch   string
+	sendRecord  string
 )
 
 func init() {
 	sendCmd.Flags().StringVarP(&sendName, "name", "n", "", "Browser instance name (default: first available)")
 	sendCmd.Flags().StringVarP(&sendWsURL, "ws-url", "w", "", "Remote debugger URL (ws://..., http(s)://..., or host:port)")
-	sendCmd.Flags().StringVarP(&sendTarget, "target", "t", "", "Target ID or 'browser' for browser-level commands")
+	sendCmd.Flags().StringVarP(&sendTarget, "target", "t", "", "Target ID, selector (url:<pattern>, title:<pattern>, type:<type>, first-page, index:<n>, new) or 'browser'")
 	sendCmd.Flags().StringVarP(&sendParams, "params", "p", "", "JSON params (or pipe via stdin)")
 	sendCmd.Flags().DurationVar(&sendTimeout, "timeout", 30*time.Second, "Response timeout")
+	sendCmd.Flags().BoolVar(&sendNoCheck, "no-validate", false, "Skip validating the method and params against the protocol schema")
+	sendCmd.Flags().BoolVar(&sendStrict, "strict", false, "Reject params the protocol schema does not define instead of warning")
+	sendCmd.Flags().StringVar(&sendBatch, "batch", "", "Pipeline commands from an NDJSON file of {\"method\",\"params\"} lines ('-' for stdin)")
+	sendCmd.Flags().StringVar(&sendRecord, "record", "", "Record every CDP frame to a JSONL file for cdp replay")
 	rootCmd.AddCommand(sendCmd)
 }
 
+func sendArgs(cmd *cobra.Command, args []string) error {
+	if sendBatch != "" {
+		return cobra.NoArgs(cmd, args)
+	}
+	return cobra.ExactArgs(1)(cmd, args)
+}
+
 func readParamsFromStdin() (string, error) {
 	stat, _ := os.Stdin.Stat()
 	if (stat.Mode() & os.ModeCharDevice) != 0 {
@@ -51,27 +70,21 @@ func readParamsFromStdin() (string, error) {
 }
 
 func runSend(_ *cobra.Command, args []string) error {
-	method := args[0]
-	if sendWsURL != "" && sendName != "" {
-		return utility.ErrUser("--ws-url and --name are mutually exclusive")
+	wsURL, err := resolveWsURL(sendName, sendWsURL)
+	if err != nil {
+		return err
 	}
-	wsURL := sendWsURL
-	if wsURL != "" {
-		var err error
-		wsURL, err = internal.ResolveWsURL(wsURL)
-		if err != nil {
-			return err
-		}
-	} else {
-		inst, err := internal.ResolveInstance(sendName)
-		if err != nil {
-			return err
-		}
-		wsURL = inst.WsURL
+	stopRecording, err := startRecording(sendRecord)
+	if err != nil {
+		return err
+	}
+	defer stopRecording()
+	if sendBatch != "" {
+		return runSendBatch(wsURL)
 	}
+	method := args[0]
 	params := sendParams
 	if params == "" {
-		var err error
 		params, err = readParamsFromStdin()
 		if err != nil {
 			return utility.ErrUser("reading stdin: %v", err)
@@ -86,19 +99,141 @@ func runSend(_ *cobra.Command, args []string) error {
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), sendTimeout)
 	defer cancel()
-	resp, err := internal.Send(ctx, wsURL, sendTarget, method, paramsJSON)
+	if !sendNoCheck {
+		err = validateCommand(ctx, wsURL, method, paramsJSON)
+		if err != nil {
+			return err
+		}
+	}
+	var resp *internal.CDPMessage
+	if internal.DaemonRunning() && sendRecord == "" {
+		resp, err = internal.DaemonSend(ctx, wsURL, sendTarget, method, paramsJSON)
+	} else {
+		resp, err = internal.Send(ctx, wsURL, sendTarget, method, paramsJSON)
+	}
 	if err != nil {
 		return err
 	}
+	if resp.Error != nil {
+		return resp.Error.Protocol(method)
+	}
+	printResponse(resp)
+	return nil
+}
+
+func runSendBatch(wsURL string) error {
+	cmds, err := readBatch(sendBatch)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), sendTimeout)
+	defer cancel()
+	if !sendNoCheck {
+		schema, err := protocol.Load(ctx, wsURL)
+		if err != nil {
+			utility.Term.Info("skipping validation, protocol unavailable: %v\n", err)
+		} else {
+			for i, cmd := range cmds {
+				err = checkParams(schema, cmd.Method, cmd.Params)
+				if err != nil {
+					return utility.ErrUser("command %d: %v", i+1, err)
+				}
+			}
+		}
+	}
+	var resps []*internal.CDPMessage
+	if internal.DaemonRunning() && sendRecord == "" {
+		resps, err = internal.DaemonSendBatch(ctx, wsURL, sendTarget, cmds)
+	} else {
+		resps, err = internal.SendBatch(ctx, wsURL, sendTarget, cmds)
+	}
+	if err != nil {
+		return err
+	}
+	var firstErr error
+	for i, resp := range resps {
+		printResponse(resp)
+		if resp.Error != nil && firstErr == nil {
+			firstErr = resp.Error.Protocol(cmds[i].Method)
+		}
+	}
+	return firstErr
+}
+
+func readBatch(path string) ([]internal.Command, error) {
+	in := os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, utility.ErrUser("opening batch file: %v", err)
+		}
+		defer func() {
+			_ = f.Close()
+		}()
+		in = f
+	}
+	var cmds []internal.Command
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		var cmd internal.Command
+		err := json.Unmarshal([]byte(text), &cmd)
+		if err != nil {
+			return nil, utility.ErrUser("batch line %d: %v", line, err)
+		}
+		if cmd.Method == "" {
+			return nil, utility.ErrUser("batch line %d: missing method", line)
+		}
+		cmds = append(cmds, cmd)
+	}
+	err := scanner.Err()
+	if err != nil {
+		return nil, utility.ErrUser("reading batch: %v", err)
+	}
+	if len(cmds) == 0 {
+		return nil, utility.ErrUser("batch is empty")
+	}
+	return cmds, nil
+}
+
+func printResponse(resp *internal.CDPMessage) {
 	if resp.Error != nil {
 		errJSON, _ := json.Marshal(map[string]any{"error": resp.Error})
 		fmt.Println(string(errJSON))
-		return nil
+		return
 	}
 	if resp.Result != nil {
 		fmt.Println(string(resp.Result))
 	} else {
 		fmt.Println("{}")
 	}
+}
+
+func validateCommand(ctx context.Context, wsURL, method string, params json.RawMessage) error {
+	schema, err := protocol.Load(ctx, wsURL)
+	if err != nil {
+		utility.Term.Info("skipping validation, protocol unavailable: %v\n", err)
+		return nil
+	}
+	return checkParams(schema, method, params)
+}
+
+func checkParams(schema *protocol.Protocol, method string, params json.RawMessage) error {
+	warnings, err := schema.Validate(method, params)
+	if err != nil {
+		return err
+	}
+	for _, warning := range warnings {
+		if sendStrict {
+			return utility.ErrUser("%s", warning)
+		}
+		utility.Term.Error("warning: %s\n", warning)
+	}
 	return nil
 }
@@ -0,0 +1,188 @@
+package cmd
+
+import (
+	"cdp/internal"
+	"cdp/internal/utility"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var targetsCmd = &cobra.Command{
+	Use:   "targets",
+	Short: "List and manage browser targets (tabs, workers, iframes)",
+}
+
+var targetsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List targets",
+	Args:  cobra.NoArgs,
+	RunE:  runTargetsList,
+}
+
+var targetsNewCmd = &cobra.Command{
+	Use:   "new [url]",
+	Short: "Open a new tab",
+	Args:  cobra.MaximumNArgs(1),
+	RunE:  runTargetsNew,
+}
+
+var targetsActivateCmd = &cobra.Command{
+	Use:   "activate <target>",
+	Short: "Bring a target to the foreground",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runTargetsActivate,
+
+	ValidArgsFunction: completeTargetArg,
+}
+
+var targetsCloseCmd = &cobra.Command{
+	Use:   "close <target>",
+	Short: "Close a target",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runTargetsClose,
+
+	ValidArgsFunction: completeTargetArg,
+}
+
+var targetsInfoCmd = &cobra.Command{
+	Use:   "info <target>",
+	Short: "Show information about a target",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runTargetsInfo,
+
+	ValidArgsFunction: completeTargetArg,
+}
+
+var (
+	targetsName       string
+	targetsWsURL      string
+	targetsTimeout    time.Duration
+	targetsTypes      []string
+	targetsURL        string
+	targetsTitle      string
+	targetsNewWindow  bool
+	targetsBackground bool
+)
+
+func init() {
+	targetsCmd.PersistentFlags().StringVarP(&targetsName, "name", "n", "", "Browser instance name (default: first available)")
+	targetsCmd.PersistentFlags().StringVarP(&targetsWsURL, "ws-url", "w", "", "Remote debugger URL (ws://..., http(s)://..., or host:port)")
+	targetsCmd.PersistentFlags().DurationVar(&targetsTimeout, "timeout", 30*time.Second, "Response timeout")
+	targetsListCmd.Flags().StringSliceVar(&targetsTypes, "type", nil, "Only include targets of these types (page, iframe, worker, service_worker, ...)")
+	targetsListCmd.Flags().StringVar(&targetsURL, "url", "", "Only include targets whose URL matches (substring or glob)")
+	targetsListCmd.Flags().StringVar(&targetsTitle, "title", "", "Only include targets whose title matches (substring or glob)")
+	targetsNewCmd.Flags().BoolVar(&targetsNewWindow, "new-window", false, "Open in a new window")
+	targetsNewCmd.Flags().BoolVar(&targetsBackground, "background", false, "Open without activating")
+	targetsCmd.AddCommand(targetsListCmd, targetsNewCmd, targetsActivateCmd, targetsCloseCmd, targetsInfoCmd)
+	rootCmd.AddCommand(targetsCmd)
+}
+
+func withTargetsClient(fn func(ctx context.Context, c *internal.Client) error) error {
+	wsURL, err := resolveWsURL(targetsName, targetsWsURL)
+	if err != nil {
+		return err
+	}
+	c, err := internal.NewClient(wsURL, false)
+	if err != nil {
+		return utility.ErrRuntime("connecting: %v", err)
+	}
+	defer c.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), targetsTimeout)
+	defer cancel()
+	return fn(ctx, c)
+}
+
+func printJSON(v any) error {
+	out, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(out))
+	return nil
+}
+
+func runTargetsList(_ *cobra.Command, _ []string) error {
+	return withTargetsClient(func(ctx context.Context, c *internal.Client) error {
+		targets, err := c.GetTargets(ctx)
+		if err != nil {
+			return utility.ErrRuntime("listing targets: %w", err)
+		}
+		filter := internal.TargetFilter{Types: targetsTypes, URL: targetsURL, Title: targetsTitle}
+		return printJSON(internal.FilterTargets(targets, filter))
+	})
+}
+
+func runTargetsNew(_ *cobra.Command, args []string) error {
+	opts := internal.CreateTargetOptions{NewWindow: targetsNewWindow, Background: targetsBackground}
+	if len(args) > 0 {
+		opts.URL = args[0]
+	}
+	return withTargetsClient(func(ctx context.Context, c *internal.Client) error {
+		targetID, err := c.CreateTarget(ctx, opts)
+		if err != nil {
+			return utility.ErrRuntime("creating target: %w", err)
+		}
+		info, err := c.GetTargetInfo(ctx, targetID)
+		if err != nil {
+			return printJSON(map[string]any{"targetId": targetID})
+		}
+		return printJSON(info)
+	})
+}
+
+func runTargetsActivate(_ *cobra.Command, args []string) error {
+	return withTargetsClient(func(ctx context.Context, c *internal.Client) error {
+		targetID, err := resolveTargetArg(ctx, c, args[0])
+		if err != nil {
+			return err
+		}
+		err = c.ActivateTarget(ctx, targetID)
+		if err != nil {
+			return utility.ErrRuntime("activating target: %w", err)
+		}
+		return printJSON(map[string]any{"targetId": targetID})
+	})
+}
+
+func runTargetsClose(_ *cobra.Command, args []string) error {
+	return withTargetsClient(func(ctx context.Context, c *internal.Client) error {
+		targetID, err := resolveTargetArg(ctx, c, args[0])
+		if err != nil {
+			return err
+		}
+		err = c.CloseTarget(ctx, targetID)
+		if err != nil {
+			return utility.ErrRuntime("closing target: %w", err)
+		}
+		return printJSON(map[string]any{"targetId": targetID})
+	})
+}
+
+func runTargetsInfo(_ *cobra.Command, args []string) error {
+	return withTargetsClient(func(ctx context.Context, c *internal.Client) error {
+		targetID, err := resolveTargetArg(ctx, c, args[0])
+		if err != nil {
+			return err
+		}
+		info, err := c.GetTargetInfo(ctx, targetID)
+		if err != nil {
+			return utility.ErrRuntime("getting target info: %w", err)
+		}
+		return printJSON(info)
+	})
+}
+
+func resolveTargetArg(ctx context.Context, c *internal.Client, selector string) (string, error) {
+	targetID, err := c.ResolveTarget(ctx, selector)
+	if err != nil {
+		return "", err
+	}
+	if targetID == "" {
+		return "", utility.ErrUser("%s does not name a target", selector)
+	}
+	return targetID, nil
+}
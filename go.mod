@@ -2,10 +2,18 @@ module cdp
 
 go 1.25.5
 
-require github.com/spf13/cobra v1.10.2
+require (
+	github.com/dop251/goja v0.0.0-20260106131823-651366fbe6e3
+	github.com/gorilla/websocket v1.5.3
+	github.com/spf13/cobra v1.10.2
+	gopkg.in/yaml.v3 v3.0.1
+)
 
 require (
-	github.com/gorilla/websocket v1.5.3 // indirect
+	github.com/dlclark/regexp2 v1.11.4 // indirect
+	github.com/go-sourcemap/sourcemap v2.1.3+incompatible // indirect
+	github.com/google/pprof v0.0.0-20230207041349-798e818bf904 // indirect
 	github.com/inconshreveable/mousetrap v1.1.0 // indirect
 	github.com/spf13/pflag v1.0.10 // indirect
+	golang.org/x/text v0.21.0 // indirect
 )
@@ -2,6 +2,7 @@ package internal
 
 import (
 	"cdp/internal/install"
+	"cdp/internal/protocol"
 	"cdp/internal/utility"
 	"context"
 	"crypto/rand"
@@ -119,6 +120,42 @@ func ResolveWsURL(debuggerURL string) (string, error) {
 	return "", utility.ErrUser("unsupported debugger url: %s", debuggerURL)
 }
 
+type JSONTarget struct {
+	ID    string `json:"id"`
+	Type  string `json:"type"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+func ListJSONTargets(ctx context.Context, wsURL string) ([]JSONTarget, error) {
+	base, err := protocol.HTTPBase(wsURL)
+	if err != nil {
+		return nil, err
+	}
+	url := base + "/json/list"
+	utility.Term.Info("fetching targets from %s\n", url)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching %s: %s", url, resp.Status)
+	}
+	var targets []JSONTarget
+	err = json.NewDecoder(resp.Body).Decode(&targets)
+	if err != nil {
+		return nil, err
+	}
+	return targets, nil
+}
+
 func SaveInstance(inst *Instance) error {
 	err := os.MkdirAll(utility.InstancesDir, 0755)
 	if err != nil {
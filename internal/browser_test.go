@@ -0,0 +1,98 @@
+package internal_test
+
+import (
+	"cdp/internal"
+	"cdp/internal/cdptest"
+	"cdp/internal/utility"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+func useInstancesDir(t *testing.T) {
+	previous := utility.InstancesDir
+	utility.InstancesDir = t.TempDir()
+	t.Cleanup(func() {
+		utility.InstancesDir = previous
+	})
+}
+
+func TestStopInstanceClosesBrowserOverCDP(t *testing.T) {
+	useInstancesDir(t)
+	s := cdptest.NewServer()
+	defer s.Close()
+	proc := exec.Command("sleep", "30")
+	err := proc.Start()
+	if err != nil {
+		t.Skipf("starting stand-in browser process: %v", err)
+	}
+	s.Handle("Browser.close", func(*cdptest.Request) (any, error) {
+		return nil, proc.Process.Kill()
+	})
+	dataDir, err := os.MkdirTemp("", "cdptest-profile-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(dataDir)
+	}()
+	err = internal.SaveInstance(&internal.Instance{Name: "test", PID: proc.Process.Pid, WsURL: s.WsURL, UserDataDir: dataDir})
+	if err != nil {
+		t.Fatalf("saving instance: %v", err)
+	}
+	err = internal.StopInstance("test")
+	if err != nil {
+		t.Fatalf("stop: %v", err)
+	}
+	closed := false
+	for _, req := range s.Requests() {
+		closed = closed || req.Method == "Browser.close"
+	}
+	if !closed {
+		t.Fatal("Browser.close was not sent")
+	}
+	if internal.IsProcessAlive(proc.Process.Pid) {
+		t.Fatal("browser process still running")
+	}
+	if _, err := internal.LoadInstance("test"); err == nil {
+		t.Fatal("instance record not removed")
+	}
+	if _, err := os.Stat(dataDir); !os.IsNotExist(err) {
+		t.Fatalf("user data dir not removed: %v", err)
+	}
+}
+
+func TestStopInstanceUnknown(t *testing.T) {
+	useInstancesDir(t)
+	err := internal.StopInstance("missing")
+	if !utility.IsUserError(err) {
+		t.Fatalf("error %v is not a user error", err)
+	}
+}
+
+func TestListJSONTargets(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	targets, err := internal.ListJSONTargets(testContext(t), s.WsURL)
+	if err != nil {
+		t.Fatalf("listing targets: %v", err)
+	}
+	if len(targets) != 1 || targets[0].ID != "page-1" || targets[0].Type != "page" {
+		t.Fatalf("targets = %+v", targets)
+	}
+}
+
+func TestListJSONTargetsRejectsErrorStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "[]", http.StatusNotFound)
+	}))
+	defer srv.Close()
+	wsURL := "ws://" + strings.TrimPrefix(srv.URL, "http://") + "/devtools/browser/x"
+	_, err := internal.ListJSONTargets(testContext(t), wsURL)
+	if err == nil || !strings.Contains(err.Error(), "404") {
+		t.Fatalf("err = %v, want a 404 error", err)
+	}
+}
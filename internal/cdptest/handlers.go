@@ -0,0 +1,102 @@
+package cdptest
+
+import (
+	"cdp/internal"
+	"encoding/json"
+	"fmt"
+)
+
+func (s *Server) registerDefaults() {
+	s.handlers["Browser.getVersion"] = func(*Request) (any, error) {
+		return map[string]any{
+			"protocolVersion": "1.3",
+			"product":         "cdptest",
+			"revision":        "0",
+			"userAgent":       "cdptest",
+			"jsVersion":       "0",
+		}, nil
+	}
+	s.handlers["Browser.close"] = func(req *Request) (any, error) {
+		req.after = s.Disconnect
+		return nil, nil
+	}
+	s.handlers["Target.getTargets"] = func(*Request) (any, error) {
+		return map[string]any{"targetInfos": s.Targets()}, nil
+	}
+	s.handlers["Target.getTargetInfo"] = func(req *Request) (any, error) {
+		var params struct {
+			TargetID string `json:"targetId"`
+		}
+		_ = json.Unmarshal(req.Params, &params)
+		targetID := params.TargetID
+		if targetID == "" {
+			targetID = req.Server.Sessions()[req.SessionID]
+		}
+		info, ok := s.target(targetID)
+		if !ok {
+			return nil, &internal.CDPError{Code: -32602, Message: "No target with given id found"}
+		}
+		return map[string]any{"targetInfo": info}, nil
+	}
+	s.handlers["Target.attachToTarget"] = func(req *Request) (any, error) {
+		var params struct {
+			TargetID string `json:"targetId"`
+		}
+		_ = json.Unmarshal(req.Params, &params)
+		if _, ok := s.target(params.TargetID); !ok {
+			return nil, &internal.CDPError{Code: -32602, Message: fmt.Sprintf("No target with given id %s found", params.TargetID)}
+		}
+		s.mu.Lock()
+		sessionID := s.newSessionLocked(params.TargetID, req.conn)
+		s.mu.Unlock()
+		return map[string]any{"sessionId": sessionID}, nil
+	}
+	s.handlers["Target.detachFromTarget"] = func(req *Request) (any, error) {
+		var params struct {
+			SessionID string `json:"sessionId"`
+		}
+		_ = json.Unmarshal(req.Params, &params)
+		if _, ok := s.Sessions()[params.SessionID]; !ok {
+			return nil, &internal.CDPError{Code: -32602, Message: "No session with given id"}
+		}
+		req.after = func() {
+			s.Detach(params.SessionID)
+		}
+		return nil, nil
+	}
+	s.handlers["Target.createTarget"] = func(req *Request) (any, error) {
+		var params struct {
+			URL string `json:"url"`
+		}
+		_ = json.Unmarshal(req.Params, &params)
+		info := s.AddTarget(internal.TargetInfo{Type: "page", URL: params.URL, Title: params.URL})
+		return map[string]any{"targetId": info.TargetID}, nil
+	}
+	s.handlers["Target.closeTarget"] = func(req *Request) (any, error) {
+		var params struct {
+			TargetID string `json:"targetId"`
+		}
+		_ = json.Unmarshal(req.Params, &params)
+		if _, ok := s.target(params.TargetID); !ok {
+			return nil, &internal.CDPError{Code: -32602, Message: "No target with given id found"}
+		}
+		req.after = func() {
+			s.RemoveTarget(params.TargetID)
+		}
+		return map[string]any{"success": true}, nil
+	}
+	for _, method := range []string{"Target.activateTarget", "Target.setAutoAttach", "Target.setDiscoverTargets"} {
+		s.handlers[method] = func(*Request) (any, error) {
+			return nil, nil
+		}
+	}
+}
+
+func (s *Server) target(targetID string) (internal.TargetInfo, bool) {
+	for _, t := range s.Targets() {
+		if t.TargetID == targetID {
+			return t, true
+		}
+	}
+	return internal.TargetInfo{}, false
+}
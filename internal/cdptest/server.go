@@ -0,0 +1,363 @@
+package cdptest
+
+import (
+	"cdp/internal"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/gorilla/websocket"
+)
+
+type Handler func(req *Request) (any, error)
+
+type Request struct {
+	Method    string
+	Params    json.RawMessage
+	SessionID string
+	Server    *Server
+	conn      *conn
+	after     func()
+}
+
+func (r *Request) Emit(method string, params any) error {
+	return r.conn.emit(r.SessionID, method, params)
+}
+
+type Server struct {
+	URL      string
+	WsURL    string
+	ln       net.Listener
+	srv      *http.Server
+	mu       sync.Mutex
+	handlers map[string]Handler
+	targets  []internal.TargetInfo
+	sessions map[string]*session
+	conns    map[*conn]bool
+	requests []Request
+	nextID   int
+}
+
+type session struct {
+	targetID string
+	conn     *conn
+}
+
+type conn struct {
+	ws *websocket.Conn
+	mu sync.Mutex
+}
+
+func NewServer() *Server {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(fmt.Sprintf("cdptest: listening: %v", err))
+	}
+	s := &Server{
+		URL:      "http://" + ln.Addr().String(),
+		WsURL:    "ws://" + ln.Addr().String() + "/devtools/browser/cdptest",
+		ln:       ln,
+		handlers: make(map[string]Handler),
+		sessions: make(map[string]*session),
+		conns:    make(map[*conn]bool),
+	}
+	s.targets = append(s.targets, internal.TargetInfo{TargetID: "page-1", Type: "page", Title: "about:blank", URL: "about:blank"})
+	s.registerDefaults()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/json/version", s.handleVersion)
+	mux.HandleFunc("/json/list", s.handleList)
+	mux.HandleFunc("/json", s.handleList)
+	mux.HandleFunc("/devtools/", s.handleWebSocket)
+	s.srv = &http.Server{Handler: mux}
+	go func() {
+		_ = s.srv.Serve(ln)
+	}()
+	return s
+}
+
+func (s *Server) Close() {
+	_ = s.srv.Close()
+	s.Disconnect()
+}
+
+func (s *Server) Handle(method string, h Handler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[method] = h
+}
+
+func (s *Server) AddTarget(info internal.TargetInfo) internal.TargetInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if info.TargetID == "" {
+		s.nextID++
+		info.TargetID = fmt.Sprintf("target-%d", s.nextID)
+	}
+	if info.Type == "" {
+		info.Type = "page"
+	}
+	s.targets = append(s.targets, info)
+	return info
+}
+
+func (s *Server) Targets() []internal.TargetInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	targets := make([]internal.TargetInfo, len(s.targets))
+	copy(targets, s.targets)
+	for i := range targets {
+		targets[i].Attached = s.attachedLocked(targets[i].TargetID)
+	}
+	return targets
+}
+
+func (s *Server) RemoveTarget(targetID string) {
+	s.mu.Lock()
+	for i, t := range s.targets {
+		if t.TargetID == targetID {
+			s.targets = append(s.targets[:i], s.targets[i+1:]...)
+			break
+		}
+	}
+	var detached []string
+	for id, sess := range s.sessions {
+		if sess.targetID == targetID {
+			detached = append(detached, id)
+		}
+	}
+	s.mu.Unlock()
+	for _, id := range detached {
+		s.Detach(id)
+	}
+	s.Emit("", "Target.targetDestroyed", map[string]any{"targetId": targetID})
+}
+
+func (s *Server) AutoAttach(parentSessionID string, info internal.TargetInfo, waitingForDebugger bool) string {
+	info = s.AddTarget(info)
+	s.mu.Lock()
+	parent := s.sessions[parentSessionID]
+	var c *conn
+	if parent != nil {
+		c = parent.conn
+	} else {
+		for c = range s.conns {
+			break
+		}
+	}
+	if c == nil {
+		s.mu.Unlock()
+		return ""
+	}
+	sessionID := s.newSessionLocked(info.TargetID, c)
+	s.mu.Unlock()
+	info.Attached = true
+	_ = c.emit(parentSessionID, "Target.attachedToTarget", map[string]any{
+		"sessionId":          sessionID,
+		"targetInfo":         info,
+		"waitingForDebugger": waitingForDebugger,
+	})
+	return sessionID
+}
+
+func (s *Server) Detach(sessionID string) {
+	s.mu.Lock()
+	sess, ok := s.sessions[sessionID]
+	delete(s.sessions, sessionID)
+	s.mu.Unlock()
+	if !ok {
+		return
+	}
+	_ = sess.conn.emit("", "Target.detachedFromTarget", map[string]any{"sessionId": sessionID, "targetId": sess.targetID})
+}
+
+func (s *Server) Sessions() map[string]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sessions := make(map[string]string, len(s.sessions))
+	for id, sess := range s.sessions {
+		sessions[id] = sess.targetID
+	}
+	return sessions
+}
+
+func (s *Server) Emit(sessionID, method string, params any) {
+	s.mu.Lock()
+	var conns []*conn
+	if sess, ok := s.sessions[sessionID]; ok {
+		conns = append(conns, sess.conn)
+	} else if sessionID == "" {
+		for c := range s.conns {
+			conns = append(conns, c)
+		}
+	}
+	s.mu.Unlock()
+	for _, c := range conns {
+		_ = c.emit(sessionID, method, params)
+	}
+}
+
+func (s *Server) Disconnect() {
+	s.mu.Lock()
+	conns := s.conns
+	s.conns = make(map[*conn]bool)
+	s.sessions = make(map[string]*session)
+	s.mu.Unlock()
+	for c := range conns {
+		_ = c.ws.Close()
+	}
+}
+
+func (s *Server) Connections() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.conns)
+}
+
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	requests := make([]Request, len(s.requests))
+	copy(requests, s.requests)
+	return requests
+}
+
+func (s *Server) attachedLocked(targetID string) bool {
+	for _, sess := range s.sessions {
+		if sess.targetID == targetID {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) newSessionLocked(targetID string, c *conn) string {
+	s.nextID++
+	sessionID := fmt.Sprintf("session-%d", s.nextID)
+	s.sessions[sessionID] = &session{targetID: targetID, conn: c}
+	return sessionID
+}
+
+func (s *Server) handleVersion(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, map[string]string{
+		"Browser":              "cdptest",
+		"Protocol-Version":     "1.3",
+		"webSocketDebuggerUrl": s.WsURL,
+	})
+}
+
+func (s *Server) handleList(w http.ResponseWriter, _ *http.Request) {
+	host := strings.TrimPrefix(s.URL, "http://")
+	list := []map[string]string{}
+	for _, t := range s.Targets() {
+		list = append(list, map[string]string{
+			"id":                   t.TargetID,
+			"type":                 t.Type,
+			"title":                t.Title,
+			"url":                  t.URL,
+			"webSocketDebuggerUrl": "ws://" + host + "/devtools/" + t.Type + "/" + t.TargetID,
+		})
+	}
+	writeJSON(w, list)
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+var upgrader = websocket.Upgrader{CheckOrigin: func(*http.Request) bool { return true }}
+
+func (s *Server) handleWebSocket(w http.ResponseWriter, r *http.Request) {
+	ws, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	c := &conn{ws: ws}
+	s.mu.Lock()
+	s.conns[c] = true
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.conns, c)
+		for id, sess := range s.sessions {
+			if sess.conn == c {
+				delete(s.sessions, id)
+			}
+		}
+		s.mu.Unlock()
+		_ = ws.Close()
+	}()
+	for {
+		_, data, err := ws.ReadMessage()
+		if err != nil {
+			return
+		}
+		var msg internal.CDPMessage
+		err = json.Unmarshal(data, &msg)
+		if err != nil {
+			continue
+		}
+		s.dispatch(c, &msg)
+	}
+}
+
+func (s *Server) dispatch(c *conn, msg *internal.CDPMessage) {
+	req := &Request{Method: msg.Method, Params: msg.Params, SessionID: msg.SessionID, Server: s, conn: c}
+	s.mu.Lock()
+	s.requests = append(s.requests, *req)
+	h, ok := s.handlers[msg.Method]
+	_, known := s.sessions[msg.SessionID]
+	s.mu.Unlock()
+	reply := internal.CDPMessage{ID: msg.ID, SessionID: msg.SessionID}
+	switch {
+	case msg.SessionID != "" && !known:
+		reply.Error = &internal.CDPError{Code: -32001, Message: "Session with given id not found."}
+	case !ok:
+		reply.Error = &internal.CDPError{Code: -32601, Message: fmt.Sprintf("'%s' wasn't found", msg.Method)}
+	default:
+		result, err := h(req)
+		if err != nil {
+			var cdpErr *internal.CDPError
+			if !errors.As(err, &cdpErr) {
+				cdpErr = &internal.CDPError{Code: -32000, Message: err.Error()}
+			}
+			reply.Error = cdpErr
+			break
+		}
+		if result == nil {
+			result = map[string]any{}
+		}
+		data, err := json.Marshal(result)
+		if err != nil {
+			reply.Error = &internal.CDPError{Code: -32603, Message: err.Error()}
+			break
+		}
+		reply.Result = data
+	}
+	_ = c.write(reply)
+	if req.after != nil {
+		req.after()
+	}
+}
+
+func (c *conn) emit(sessionID, method string, params any) error {
+	data, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	return c.write(internal.CDPMessage{Method: method, Params: data, SessionID: sessionID})
+}
+
+func (c *conn) write(msg internal.CDPMessage) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ws.WriteMessage(websocket.TextMessage, data)
+}
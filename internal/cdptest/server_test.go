@@ -0,0 +1,195 @@
+package cdptest_test
+
+import (
+	"cdp/internal"
+	"cdp/internal/cdptest"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func getJSON(t *testing.T, url string, v any) {
+	t.Helper()
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Fatalf("GET %s: %v", url, err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	err = json.NewDecoder(resp.Body).Decode(v)
+	if err != nil {
+		t.Fatalf("decoding %s: %v", url, err)
+	}
+}
+
+type rawConn struct {
+	t  *testing.T
+	ws *websocket.Conn
+	id int64
+}
+
+func connect(t *testing.T, s *cdptest.Server) *rawConn {
+	ws, _, err := websocket.DefaultDialer.Dial(s.WsURL, nil)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = ws.Close()
+	})
+	return &rawConn{t: t, ws: ws}
+}
+
+func (c *rawConn) send(method, sessionID string, params any) int64 {
+	c.t.Helper()
+	c.id++
+	data, _ := json.Marshal(params)
+	err := c.ws.WriteJSON(internal.CDPMessage{ID: c.id, Method: method, SessionID: sessionID, Params: data})
+	if err != nil {
+		c.t.Fatalf("writing %s: %v", method, err)
+	}
+	return c.id
+}
+
+func (c *rawConn) read() *internal.CDPMessage {
+	c.t.Helper()
+	_ = c.ws.SetReadDeadline(time.Now().Add(5 * time.Second))
+	var msg internal.CDPMessage
+	err := c.ws.ReadJSON(&msg)
+	if err != nil {
+		c.t.Fatalf("reading: %v", err)
+	}
+	return &msg
+}
+
+func (c *rawConn) call(method, sessionID string, params any) *internal.CDPMessage {
+	c.t.Helper()
+	id := c.send(method, sessionID, params)
+	for {
+		msg := c.read()
+		if msg.ID == id {
+			return msg
+		}
+	}
+}
+
+func TestHTTPEndpoints(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	var version map[string]string
+	getJSON(t, s.URL+"/json/version", &version)
+	if version["webSocketDebuggerUrl"] != s.WsURL {
+		t.Fatalf("webSocketDebuggerUrl = %q, want %q", version["webSocketDebuggerUrl"], s.WsURL)
+	}
+	s.AddTarget(internal.TargetInfo{TargetID: "page-2", URL: "https://example.com"})
+	var list []map[string]string
+	getJSON(t, s.URL+"/json/list", &list)
+	if len(list) != 2 || list[0]["id"] != "page-1" || list[1]["url"] != "https://example.com" {
+		t.Fatalf("unexpected target list %v", list)
+	}
+	wsURL, err := internal.ResolveWsURL(s.URL)
+	if err != nil || wsURL != s.WsURL {
+		t.Fatalf("ResolveWsURL = %q, %v", wsURL, err)
+	}
+}
+
+func TestHandlersAndErrors(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	s.Handle("Test.echo", func(req *cdptest.Request) (any, error) {
+		return req.Params, nil
+	})
+	s.Handle("Test.fail", func(*cdptest.Request) (any, error) {
+		return nil, &internal.CDPError{Code: -32602, Message: "bad params"}
+	})
+	c := connect(t, s)
+	resp := c.call("Test.echo", "", map[string]int{"x": 1})
+	if string(resp.Result) != `{"x":1}` {
+		t.Fatalf("echo result = %s", resp.Result)
+	}
+	resp = c.call("Test.fail", "", nil)
+	if resp.Error == nil || resp.Error.Code != -32602 {
+		t.Fatalf("fail response = %+v", resp)
+	}
+	resp = c.call("Test.unknown", "", nil)
+	if resp.Error == nil || resp.Error.Code != -32601 {
+		t.Fatalf("unknown method response = %+v", resp)
+	}
+	resp = c.call("Test.echo", "no-such-session", nil)
+	if resp.Error == nil || resp.Error.Code != -32001 {
+		t.Fatalf("unknown session response = %+v", resp)
+	}
+	requests := s.Requests()
+	if len(requests) != 4 || requests[0].Method != "Test.echo" || requests[3].SessionID != "no-such-session" {
+		t.Fatalf("unexpected request log %+v", requests)
+	}
+}
+
+func TestSessionsAndEvents(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	c := connect(t, s)
+	resp := c.call("Target.attachToTarget", "", map[string]any{"targetId": "page-1", "flatten": true})
+	var attached struct {
+		SessionID string `json:"sessionId"`
+	}
+	_ = json.Unmarshal(resp.Result, &attached)
+	if s.Sessions()[attached.SessionID] != "page-1" {
+		t.Fatalf("sessions = %v", s.Sessions())
+	}
+	s.Emit(attached.SessionID, "Page.loadEventFired", map[string]any{"timestamp": 1})
+	msg := c.read()
+	if msg.Method != "Page.loadEventFired" || msg.SessionID != attached.SessionID {
+		t.Fatalf("unexpected event %+v", msg)
+	}
+	child := s.AutoAttach(attached.SessionID, internal.TargetInfo{Type: "iframe"}, true)
+	msg = c.read()
+	var event struct {
+		SessionID          string              `json:"sessionId"`
+		TargetInfo         internal.TargetInfo `json:"targetInfo"`
+		WaitingForDebugger bool                `json:"waitingForDebugger"`
+	}
+	_ = json.Unmarshal(msg.Params, &event)
+	if msg.Method != "Target.attachedToTarget" || msg.SessionID != attached.SessionID || event.SessionID != child || !event.WaitingForDebugger {
+		t.Fatalf("unexpected attach event %+v %s", msg, msg.Params)
+	}
+	s.Detach(child)
+	msg = c.read()
+	if msg.Method != "Target.detachedFromTarget" {
+		t.Fatalf("unexpected detach event %+v", msg)
+	}
+	if _, ok := s.Sessions()[child]; ok {
+		t.Fatal("child session still registered after detach")
+	}
+}
+
+func TestDisconnect(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	c, err := internal.NewClient(s.WsURL, false)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer c.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err = c.Attach(ctx, "first-page")
+	if err != nil {
+		t.Fatalf("attach: %v", err)
+	}
+	if s.Connections() != 1 || len(s.Sessions()) != 1 {
+		t.Fatalf("connections = %d, sessions = %v", s.Connections(), s.Sessions())
+	}
+	s.Disconnect()
+	if s.Connections() != 0 || len(s.Sessions()) != 0 {
+		t.Fatalf("after disconnect: connections = %d, sessions = %v", s.Connections(), s.Sessions())
+	}
+	_, err = c.Send(ctx, "Browser.getVersion", nil, "")
+	if err == nil {
+		t.Fatal("send succeeded on a disconnected client")
+	}
+}
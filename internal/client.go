@@ -20,112 +20,380 @@ type CDPMessage struct {
 	Error     *CDPError       `json:"error,omitempty"`
 }
 
+type Command struct {
+	Method    string          `json:"method"`
+	Params    json.RawMessage `json:"params,omitempty"`
+	SessionID string          `json:"sessionId,omitempty"`
+}
+
 type CDPError struct {
 	Code    int    `json:"code"`
 	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+func (e *CDPError) Error() string {
+	return fmt.Sprintf("%s (%d)", e.Message, e.Code)
+}
+
+func (e *CDPError) Protocol(method string) error {
+	return utility.ProtocolError{Method: method, Code: e.Code, Message: e.Message, Data: e.Data}
+}
+
+type Delivery string
+
+const (
+	DeliveryBlock Delivery = "block"
+	DeliveryQueue Delivery = "queue"
+	DeliveryDrop  Delivery = "drop"
+)
+
+const (
+	DefaultEventBuffer = 100
+	DefaultMaxQueueMem = 256 << 20
+)
+
+type ClientOptions struct {
+	Events      bool
+	Delivery    Delivery
+	Buffer      int
+	MaxQueueMem int64
+	Reconnect   *ReconnectOptions
 }
 
 type Client struct {
-	Conn    *websocket.Conn
-	NextID  int64
-	Pending map[int64]chan *CDPMessage
-	Events  chan *CDPMessage
-	Mu      sync.Mutex
-	Closed  bool
+	Conn         *websocket.Conn
+	NextID       int64
+	Pending      map[int64]chan *CDPMessage
+	Events       chan *CDPMessage
+	Mu           sync.Mutex
+	Closed       bool
+	delivery     Delivery
+	queue        *eventQueue
+	dropped      int64
+	eventsMu     sync.Mutex
+	eventsClosed bool
+	done         chan struct{}
+	once         sync.Once
+	stopped      chan struct{}
+	stopOnce     sync.Once
+	subsMu       sync.Mutex
+	subs         map[int]*subscription
+	nextSub      int
+	writes       chan writeRequest
+	wsURL        string
+	reconnect    *ReconnectOptions
+	attached     map[string]string
+	enabled      []recordedCall
+	aliases      map[string]string
+	originals    map[string]string
+	recorder     *Recorder
+}
+
+func ParseDelivery(s string) (Delivery, error) {
+	switch d := Delivery(s); d {
+	case DeliveryBlock, DeliveryQueue, DeliveryDrop:
+		return d, nil
+	}
+	return "", utility.ErrUser("invalid delivery policy %q: expected block, queue or drop", s)
 }
 
 func NewClient(wsURL string, withEvents bool) (*Client, error) {
+	return Dial(wsURL, ClientOptions{Events: withEvents})
+}
+
+func Dial(wsURL string, opts ClientOptions) (*Client, error) {
 	utility.Term.Info("connecting to CDP: %s\n", wsURL)
 	conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
 	if err != nil {
 		return nil, err
 	}
 	c := &Client{
-		Conn:    conn,
-		NextID:  1,
-		Pending: make(map[int64]chan *CDPMessage),
+		Conn:      conn,
+		NextID:    1,
+		Pending:   make(map[int64]chan *CDPMessage),
+		delivery:  opts.Delivery,
+		done:      make(chan struct{}),
+		stopped:   make(chan struct{}),
+		subs:      make(map[int]*subscription),
+		writes:    make(chan writeRequest),
+		wsURL:     wsURL,
+		reconnect: opts.Reconnect,
+		attached:  make(map[string]string),
+		aliases:   make(map[string]string),
+		originals: make(map[string]string),
+		recorder:  activeRecorder(),
 	}
-	if withEvents {
-		c.Events = make(chan *CDPMessage, 100)
+	if opts.Events {
+		buffer := opts.Buffer
+		if buffer <= 0 {
+			buffer = DefaultEventBuffer
+		}
+		c.Events = make(chan *CDPMessage, buffer)
+		if c.delivery == "" {
+			c.delivery = DeliveryQueue
+		}
+		switch c.delivery {
+		case DeliveryQueue:
+			maxMem := opts.MaxQueueMem
+			if maxMem == 0 {
+				maxMem = DefaultMaxQueueMem
+			}
+			c.queue = newEventQueue(maxMem, 0)
+		case DeliveryDrop:
+			c.queue = newEventQueue(0, buffer)
+		}
+		if c.queue != nil {
+			go c.queue.pump(c.Events, c.done)
+		}
 	}
-	go c.readLoop()
+	go c.readLoop(conn, nil)
+	go c.writeLoop()
 	return c, nil
 }
 
-func (c *Client) readLoop() {
+func (c *Client) Dropped() int64 {
+	return atomic.LoadInt64(&c.dropped)
+}
+
+func (c *Client) readLoop(conn *websocket.Conn, held []*CDPMessage) {
+	for _, msg := range held {
+		c.dispatch(msg)
+	}
 	for {
-		_, data, err := c.Conn.ReadMessage()
+		msg, err := c.readMessage(conn)
 		if err != nil {
-			if !c.Closed {
-				utility.Term.Info("ws read error: %v\n", err)
+			if c.closing() {
+				c.shutdown()
+				return
 			}
-			c.Mu.Lock()
-			c.Closed = true
-			for _, ch := range c.Pending {
-				close(ch)
+			utility.Term.Info("ws read error: %v\n", err)
+			if c.reconnect != nil {
+				go c.reconnectLoop()
+				return
 			}
-			c.Pending = nil
-			if c.Events != nil {
-				close(c.Events)
-			}
-			c.Mu.Unlock()
+			c.shutdown()
 			return
 		}
-		utility.Term.Info("<- %s\n", string(data))
-		var msg CDPMessage
-		err = json.Unmarshal(data, &msg)
-		if err != nil {
-			utility.Term.Info("json decode error: %v\n", err)
-			continue
+		if msg != nil {
+			c.dispatch(msg)
+		}
+	}
+}
+
+func (c *Client) readMessage(conn *websocket.Conn) (*CDPMessage, error) {
+	_, data, err := conn.ReadMessage()
+	if err != nil {
+		return nil, err
+	}
+	utility.Term.Info("<- %s\n", string(data))
+	c.recorder.record(FrameRecv, data)
+	var msg CDPMessage
+	err = json.Unmarshal(data, &msg)
+	if err != nil {
+		utility.Term.Info("json decode error: %v\n", err)
+		return nil, nil
+	}
+	return &msg, nil
+}
+
+func (c *Client) dispatch(msg *CDPMessage) {
+	if msg.SessionID != "" {
+		c.Mu.Lock()
+		original, ok := c.originals[msg.SessionID]
+		c.Mu.Unlock()
+		if ok {
+			msg.SessionID = original
 		}
-		if msg.ID != 0 {
+	}
+	if msg.ID != 0 {
+		c.Mu.Lock()
+		ch, ok := c.Pending[msg.ID]
+		if ok {
+			ch <- msg
+			delete(c.Pending, msg.ID)
+		}
+		c.Mu.Unlock()
+	} else if msg.Method != "" {
+		c.observe(msg)
+		c.publish(msg)
+		if c.Events != nil {
+			c.deliver(msg)
+		}
+	}
+}
+
+func (c *Client) closing() bool {
+	select {
+	case <-c.done:
+		return true
+	default:
+		return false
+	}
+}
+
+func (c *Client) shutdown() {
+	c.Mu.Lock()
+	c.Closed = true
+	for _, ch := range c.Pending {
+		close(ch)
+	}
+	c.Pending = nil
+	c.Mu.Unlock()
+	c.closeSubscriptions()
+	c.closeEvents()
+	c.stopOnce.Do(func() {
+		close(c.stopped)
+	})
+}
+
+func (c *Client) deliver(msg *CDPMessage) {
+	c.eventsMu.Lock()
+	defer c.eventsMu.Unlock()
+	if c.eventsClosed {
+		return
+	}
+	if c.queue == nil {
+		select {
+		case c.Events <- msg:
+		case <-c.done:
+		}
+		return
+	}
+	if !c.queue.push(msg) {
+		atomic.AddInt64(&c.dropped, 1)
+		utility.Term.Info("event buffer full, dropping: %s\n", msg.Method)
+	}
+}
+
+func (c *Client) closeEvents() {
+	if c.Events == nil {
+		return
+	}
+	c.eventsMu.Lock()
+	defer c.eventsMu.Unlock()
+	if c.eventsClosed {
+		return
+	}
+	c.eventsClosed = true
+	if c.queue != nil {
+		c.queue.close()
+		return
+	}
+	close(c.Events)
+}
+
+type writeRequest struct {
+	data []byte
+	err  chan error
+}
+
+func (c *Client) writeLoop() {
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-c.stopped:
+			return
+		case req := <-c.writes:
 			c.Mu.Lock()
-			ch, ok := c.Pending[msg.ID]
-			if ok {
-				ch <- &msg
-				delete(c.Pending, msg.ID)
-			}
+			conn := c.Conn
 			c.Mu.Unlock()
-		} else if msg.Method != "" && c.Events != nil {
+			req.err <- conn.WriteMessage(websocket.TextMessage, req.data)
+		}
+	}
+}
+
+func (c *Client) write(ctx context.Context, data []byte) error {
+	req := writeRequest{data: data, err: make(chan error, 1)}
+	select {
+	case c.writes <- req:
+	case <-c.done:
+		return fmt.Errorf("connection closed")
+	case <-c.stopped:
+		return fmt.Errorf("connection closed")
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	select {
+	case err := <-req.err:
+		return err
+	case <-c.done:
+		return fmt.Errorf("connection closed")
+	case <-c.stopped:
+		return fmt.Errorf("connection closed")
+	}
+}
+
+func (c *Client) Send(ctx context.Context, method string, params json.RawMessage, sessionID string) (*CDPMessage, error) {
+	id, ch, err := c.start(ctx, Command{Method: method, Params: params, SessionID: sessionID})
+	if err != nil {
+		return nil, err
+	}
+	return c.await(ctx, id, ch)
+}
+
+func (c *Client) SendBatch(ctx context.Context, cmds []Command) ([]*CDPMessage, error) {
+	ids := make([]int64, 0, len(cmds))
+	chs := make([]chan *CDPMessage, 0, len(cmds))
+	for _, cmd := range cmds {
+		id, ch, err := c.start(ctx, cmd)
+		if err != nil {
 			c.Mu.Lock()
-			if !c.Closed {
-				select {
-				case c.Events <- &msg:
-				default:
-					utility.Term.Info("event buffer full, dropping: %s\n", msg.Method)
-				}
+			for _, id := range ids {
+				delete(c.Pending, id)
 			}
 			c.Mu.Unlock()
+			return nil, err
 		}
+		ids = append(ids, id)
+		chs = append(chs, ch)
 	}
+	resps := make([]*CDPMessage, len(cmds))
+	for i := range cmds {
+		resp, err := c.await(ctx, ids[i], chs[i])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", cmds[i].Method, err)
+		}
+		resps[i] = resp
+	}
+	return resps, nil
 }
 
-func (c *Client) Send(ctx context.Context, method string, params json.RawMessage, sessionID string) (*CDPMessage, error) {
+func (c *Client) start(ctx context.Context, cmd Command) (int64, chan *CDPMessage, error) {
 	id := atomic.AddInt64(&c.NextID, 1)
-	msg := CDPMessage{ID: id, Method: method, Params: params}
-	if sessionID != "" {
-		msg.SessionID = sessionID
+	c.Mu.Lock()
+	c.record(cmd.Method, cmd.Params, cmd.SessionID)
+	msg := CDPMessage{ID: id, Method: cmd.Method, Params: cmd.Params, SessionID: cmd.SessionID}
+	if alias, ok := c.aliases[cmd.SessionID]; ok {
+		msg.SessionID = alias
 	}
+	c.Mu.Unlock()
 	data, err := json.Marshal(msg)
 	if err != nil {
-		return nil, err
+		return 0, nil, err
 	}
 	utility.Term.Info("-> %s\n", string(data))
+	c.recorder.record(FrameSend, data)
 	ch := make(chan *CDPMessage, 1)
 	c.Mu.Lock()
 	if c.Pending == nil {
 		c.Mu.Unlock()
-		return nil, fmt.Errorf("connection closed")
+		return 0, nil, fmt.Errorf("connection closed")
 	}
 	c.Pending[id] = ch
 	c.Mu.Unlock()
-	err = c.Conn.WriteMessage(websocket.TextMessage, data)
+	err = c.write(ctx, data)
 	if err != nil {
 		c.Mu.Lock()
 		delete(c.Pending, id)
 		c.Mu.Unlock()
-		return nil, err
+		return 0, nil, err
 	}
+	return id, ch, nil
+}
+
+func (c *Client) await(ctx context.Context, id int64, ch chan *CDPMessage) (*CDPMessage, error) {
 	select {
 	case resp, ok := <-ch:
 		if !ok {
@@ -140,83 +408,128 @@ func (c *Client) Send(ctx context.Context, method string, params json.RawMessage
 	}
 }
 
+func (c *Client) Call(ctx context.Context, method string, params any, sessionID string, result any) error {
+	var paramsJSON json.RawMessage
+	if params != nil {
+		data, err := json.Marshal(params)
+		if err != nil {
+			return err
+		}
+		paramsJSON = data
+	}
+	resp, err := c.Send(ctx, method, paramsJSON, sessionID)
+	if err != nil {
+		return err
+	}
+	if resp.Error != nil {
+		return resp.Error.Protocol(method)
+	}
+	if result == nil || resp.Result == nil {
+		return nil
+	}
+	return json.Unmarshal(resp.Result, result)
+}
+
 func (c *Client) Close() {
-	_ = c.Conn.Close()
+	c.once.Do(func() {
+		close(c.done)
+	})
+	c.Mu.Lock()
+	conn := c.Conn
+	c.Mu.Unlock()
+	_ = conn.Close()
 }
 
 func (c *Client) AttachToTarget(ctx context.Context, targetID string) (string, error) {
+	sessionID, err := c.attach(ctx, targetID)
+	if err != nil {
+		return "", err
+	}
+	c.Mu.Lock()
+	c.attached[targetID] = sessionID
+	c.Mu.Unlock()
+	return sessionID, nil
+}
+
+func (c *Client) attach(ctx context.Context, targetID string) (string, error) {
 	attachParams, _ := json.Marshal(map[string]any{"targetId": targetID, "flatten": true})
 	attachResp, err := c.Send(ctx, "Target.attachToTarget", attachParams, "")
 	if err != nil {
 		return "", err
 	}
+	return attachResult(attachResp)
+}
+
+func attachResult(attachResp *CDPMessage) (string, error) {
 	if attachResp.Error != nil {
-		return "", fmt.Errorf("attach error: %s", attachResp.Error.Message)
+		return "", attachResp.Error.Protocol("Target.attachToTarget")
 	}
 	var result struct {
 		SessionID string `json:"sessionId"`
 	}
-	err = json.Unmarshal(attachResp.Result, &result)
+	err := json.Unmarshal(attachResp.Result, &result)
 	if err != nil {
 		return "", err
 	}
 	return result.SessionID, nil
 }
 
-func Send(ctx context.Context, wsURL, target, method string, params json.RawMessage) (*CDPMessage, error) {
+func (c *Client) DetachFromTarget(ctx context.Context, sessionID string) error {
+	c.Mu.Lock()
+	current := sessionID
+	if alias, ok := c.aliases[sessionID]; ok {
+		current = alias
+	}
+	c.Mu.Unlock()
+	detachParams, _ := json.Marshal(map[string]any{"sessionId": current})
+	detachResp, err := c.Send(ctx, "Target.detachFromTarget", detachParams, "")
+	if err != nil {
+		return err
+	}
+	if detachResp.Error != nil {
+		return fmt.Errorf("detach error: %s", detachResp.Error.Message)
+	}
+	c.Mu.Lock()
+	c.forget(sessionID)
+	c.Mu.Unlock()
+	return nil
+}
+
+func SendBatch(ctx context.Context, wsURL, target string, cmds []Command) ([]*CDPMessage, error) {
 	conn, err := NewClient(wsURL, false)
 	if err != nil {
 		return nil, utility.ErrRuntime("connecting: %v", err)
 	}
 	defer conn.Close()
-	var sessionID string
-	if target != "" && target != "browser" {
-		sessionID, err = conn.AttachToTarget(ctx, target)
-		if err != nil {
-			return nil, utility.ErrRuntime("attaching to target: %v", err)
+	sessionID, err := conn.Attach(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+	for i := range cmds {
+		if cmds[i].SessionID == "" {
+			cmds[i].SessionID = sessionID
 		}
 	}
-	resp, err := conn.Send(ctx, method, params, sessionID)
+	resps, err := conn.SendBatch(ctx, cmds)
 	if err != nil {
-		return nil, utility.ErrRuntime("sending command: %v", err)
+		return nil, utility.ErrRuntime("sending batch: %v", err)
 	}
-	return resp, nil
+	return resps, nil
 }
 
-func Listen(ctx context.Context, wsURL, target, domain string, eventCh chan<- *CDPMessage) error {
-	conn, err := NewClient(wsURL, true)
+func Send(ctx context.Context, wsURL, target, method string, params json.RawMessage) (*CDPMessage, error) {
+	conn, err := NewClient(wsURL, false)
 	if err != nil {
-		return utility.ErrRuntime("connecting: %v", err)
+		return nil, utility.ErrRuntime("connecting: %v", err)
 	}
 	defer conn.Close()
-	var sessionID string
-	if target != "" {
-		sessionID, err = conn.AttachToTarget(ctx, target)
-		if err != nil {
-			return utility.ErrRuntime("attaching to target: %v", err)
-		}
-	}
-	enableMethod := domain + ".enable"
-	enableResp, err := conn.Send(ctx, enableMethod, nil, sessionID)
+	sessionID, err := conn.Attach(ctx, target)
 	if err != nil {
-		return utility.ErrRuntime("enabling %s: %v", domain, err)
-	}
-	if enableResp.Error != nil {
-		return utility.ErrUser("enable error: %s", enableResp.Error.Message)
+		return nil, err
 	}
-	for {
-		select {
-		case <-ctx.Done():
-			return nil
-		case event, ok := <-conn.Events:
-			if !ok {
-				return nil
-			}
-			select {
-			case eventCh <- event:
-			case <-ctx.Done():
-				return nil
-			}
-		}
+	resp, err := conn.Send(ctx, method, params, sessionID)
+	if err != nil {
+		return nil, utility.ErrRuntime("sending command: %v", err)
 	}
+	return resp, nil
 }
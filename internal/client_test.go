@@ -0,0 +1,420 @@
+package internal_test
+
+import (
+	"cdp/internal"
+	"cdp/internal/cdptest"
+	"cdp/internal/utility"
+	"context"
+	"encoding/json"
+	"fmt"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func testContext(t *testing.T) context.Context {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+	return ctx
+}
+
+func dial(t *testing.T, s *cdptest.Server, opts internal.ClientOptions) *internal.Client {
+	c, err := internal.Dial(s.WsURL, opts)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(c.Close)
+	return c
+}
+
+func next(t *testing.T, ch <-chan *internal.CDPMessage) *internal.CDPMessage {
+	t.Helper()
+	select {
+	case msg, ok := <-ch:
+		if !ok {
+			t.Fatal("event channel closed")
+		}
+		return msg
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for event")
+	}
+	return nil
+}
+
+func eventually(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func emitting(method string, n int) cdptest.Handler {
+	return func(req *cdptest.Request) (any, error) {
+		for i := 0; i < n; i++ {
+			err := req.Emit(method, map[string]any{"index": i})
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	}
+}
+
+func index(t *testing.T, msg *internal.CDPMessage) int {
+	t.Helper()
+	var params struct {
+		Index int `json:"index"`
+	}
+	err := json.Unmarshal(msg.Params, &params)
+	if err != nil {
+		t.Fatalf("decoding %s: %v", msg.Method, err)
+	}
+	return params.Index
+}
+
+func TestSendAttachedSession(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	s.Handle("Runtime.evaluate", func(req *cdptest.Request) (any, error) {
+		return map[string]any{"echo": req.Params, "sessionId": req.SessionID}, nil
+	})
+	c := dial(t, s, internal.ClientOptions{})
+	ctx := testContext(t)
+	sessionID, err := c.Attach(ctx, "first-page")
+	if err != nil {
+		t.Fatalf("attach: %v", err)
+	}
+	var result struct {
+		Echo      map[string]string `json:"echo"`
+		SessionID string            `json:"sessionId"`
+	}
+	err = c.Call(ctx, "Runtime.evaluate", map[string]string{"expression": "1"}, sessionID, &result)
+	if err != nil {
+		t.Fatalf("call: %v", err)
+	}
+	if result.Echo["expression"] != "1" || result.SessionID != sessionID {
+		t.Fatalf("unexpected result %+v for session %s", result, sessionID)
+	}
+	if target := s.Sessions()[sessionID]; target != "page-1" {
+		t.Fatalf("session %s attached to %q, want page-1", sessionID, target)
+	}
+}
+
+func TestSendHelper(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	s.Handle("Page.reload", func(*cdptest.Request) (any, error) {
+		return map[string]bool{"ok": true}, nil
+	})
+	resp, err := internal.Send(testContext(t), s.WsURL, "page-1", "Page.reload", nil)
+	if err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	if string(resp.Result) != `{"ok":true}` {
+		t.Fatalf("result = %s", resp.Result)
+	}
+}
+
+func TestCallReturnsProtocolError(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	c := dial(t, s, internal.ClientOptions{})
+	err := c.Call(testContext(t), "Bad.method", nil, "", nil)
+	e, ok := utility.AsProtocolError(err)
+	if !ok {
+		t.Fatalf("error %v is not a protocol error", err)
+	}
+	if e.Method != "Bad.method" || e.Code != -32601 {
+		t.Fatalf("unexpected protocol error %+v", e)
+	}
+	if code := utility.ExitCode(err); code != utility.ExitMethodNotFound {
+		t.Fatalf("exit code = %d, want %d", code, utility.ExitMethodNotFound)
+	}
+}
+
+func TestAttachUnknownTargetIsProtocolError(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	c := dial(t, s, internal.ClientOptions{})
+	_, err := c.AttachToTarget(testContext(t), "missing")
+	e, ok := utility.AsProtocolError(err)
+	if !ok || e.Method != "Target.attachToTarget" || e.Code != -32602 {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestSendBatch(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	s.Handle("Test.echo", func(req *cdptest.Request) (any, error) {
+		return req.Params, nil
+	})
+	c := dial(t, s, internal.ClientOptions{})
+	var cmds []internal.Command
+	for i := 0; i < 5; i++ {
+		cmds = append(cmds, internal.Command{Method: "Test.echo", Params: json.RawMessage(fmt.Sprintf(`{"i":%d}`, i))})
+	}
+	cmds = append(cmds, internal.Command{Method: "Test.missing"})
+	resps, err := c.SendBatch(testContext(t), cmds)
+	if err != nil {
+		t.Fatalf("batch: %v", err)
+	}
+	if len(resps) != len(cmds) {
+		t.Fatalf("got %d responses, want %d", len(resps), len(cmds))
+	}
+	for i := 0; i < 5; i++ {
+		if want := fmt.Sprintf(`{"i":%d}`, i); string(resps[i].Result) != want {
+			t.Fatalf("response %d = %s, want %s", i, resps[i].Result, want)
+		}
+	}
+	if resps[5].Error == nil || resps[5].Error.Code != -32601 {
+		t.Fatalf("missing method response = %+v", resps[5])
+	}
+}
+
+func TestDeliveryBlock(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	s.Handle("Test.emit", emitting("Test.event", 50))
+	c := dial(t, s, internal.ClientOptions{Events: true, Delivery: internal.DeliveryBlock, Buffer: 1})
+	ctx := testContext(t)
+	done := make(chan error, 1)
+	go func() {
+		_, err := c.Send(ctx, "Test.emit", nil, "")
+		done <- err
+	}()
+	for i := 0; i < 50; i++ {
+		if got := index(t, next(t, c.Events)); got != i {
+			t.Fatalf("event %d has index %d", i, got)
+		}
+	}
+	err := <-done
+	if err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	if c.Dropped() != 0 {
+		t.Fatalf("dropped %d events", c.Dropped())
+	}
+}
+
+func TestDeliveryQueue(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	s.Handle("Test.emit", emitting("Test.event", 500))
+	c := dial(t, s, internal.ClientOptions{Events: true, Delivery: internal.DeliveryQueue, Buffer: 1})
+	_, err := c.Send(testContext(t), "Test.emit", nil, "")
+	if err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	for i := 0; i < 500; i++ {
+		if got := index(t, next(t, c.Events)); got != i {
+			t.Fatalf("event %d has index %d", i, got)
+		}
+	}
+	if c.Dropped() != 0 {
+		t.Fatalf("dropped %d events", c.Dropped())
+	}
+}
+
+func TestDeliveryDrop(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	s.Handle("Test.emit", emitting("Test.event", 20))
+	c := dial(t, s, internal.ClientOptions{Events: true, Delivery: internal.DeliveryDrop, Buffer: 2})
+	_, err := c.Send(testContext(t), "Test.emit", nil, "")
+	if err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	received, reported := 0, int64(0)
+	last := -1
+	for received+int(reported) < 20 {
+		msg := next(t, c.Events)
+		if msg.Method == internal.EventsDroppedMethod {
+			var params struct {
+				Count int64 `json:"count"`
+			}
+			_ = json.Unmarshal(msg.Params, &params)
+			reported += params.Count
+			continue
+		}
+		i := index(t, msg)
+		if i <= last {
+			t.Fatalf("event %d delivered after %d", i, last)
+		}
+		last = i
+		received++
+	}
+	if reported == 0 || reported != c.Dropped() {
+		t.Fatalf("markers reported %d dropped, client counted %d", reported, c.Dropped())
+	}
+}
+
+func TestCloseDuringBlockedDelivery(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	c := dial(t, s, internal.ClientOptions{Events: true, Delivery: internal.DeliveryBlock, Buffer: 1})
+	eventually(t, "the connection", func() bool { return s.Connections() == 1 })
+	for i := 0; i < 10; i++ {
+		s.Emit("", "Test.event", map[string]any{"index": i})
+	}
+	eventually(t, "a full event buffer", func() bool { return len(c.Events) == cap(c.Events) })
+	c.Close()
+	deadline := time.After(5 * time.Second)
+	for {
+		select {
+		case _, ok := <-c.Events:
+			if !ok {
+				return
+			}
+		case <-deadline:
+			t.Fatal("events channel not closed after Close")
+		}
+	}
+}
+
+func TestReconnect(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	s.Handle("Page.enable", func(*cdptest.Request) (any, error) {
+		return nil, nil
+	})
+	c := dial(t, s, internal.ClientOptions{
+		Events:    true,
+		Reconnect: &internal.ReconnectOptions{MinBackoff: 10 * time.Millisecond, MaxAttempts: 5},
+	})
+	ctx := testContext(t)
+	sessionID, err := c.AttachToTarget(ctx, "page-1")
+	if err != nil {
+		t.Fatalf("attach: %v", err)
+	}
+	err = c.Call(ctx, "Page.enable", nil, sessionID, nil)
+	if err != nil {
+		t.Fatalf("enable: %v", err)
+	}
+	s.Disconnect()
+	msg := next(t, c.Events)
+	if msg.Method != internal.ReconnectedMethod {
+		t.Fatalf("first event after disconnect is %s", msg.Method)
+	}
+	var reconnected struct {
+		Attempts    int      `json:"attempts"`
+		LostTargets []string `json:"lostTargets"`
+	}
+	_ = json.Unmarshal(msg.Params, &reconnected)
+	if reconnected.Attempts < 1 || len(reconnected.LostTargets) != 0 {
+		t.Fatalf("unexpected reconnect marker %s", msg.Params)
+	}
+	var current string
+	eventually(t, "Page.enable to be re-issued", func() bool {
+		for _, req := range s.Requests() {
+			if req.Method == "Page.enable" && req.SessionID != sessionID {
+				current = req.SessionID
+				return true
+			}
+		}
+		return false
+	})
+	s.Emit(current, "Page.loadEventFired", map[string]any{})
+	msg = next(t, c.Events)
+	if msg.Method != "Page.loadEventFired" || msg.SessionID != sessionID {
+		t.Fatalf("event %s on %s, want Page.loadEventFired on original session %s", msg.Method, msg.SessionID, sessionID)
+	}
+	err = c.Call(ctx, "Page.enable", nil, sessionID, nil)
+	if err != nil {
+		t.Fatalf("call on original session after reconnect: %v", err)
+	}
+}
+
+func TestReconnectReportsLostTargets(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	extra := s.AddTarget(internal.TargetInfo{URL: "https://example.com"})
+	c := dial(t, s, internal.ClientOptions{
+		Events:    true,
+		Reconnect: &internal.ReconnectOptions{MinBackoff: 200 * time.Millisecond, MaxAttempts: 5},
+	})
+	_, err := c.AttachToTarget(testContext(t), extra.TargetID)
+	if err != nil {
+		t.Fatalf("attach: %v", err)
+	}
+	s.Disconnect()
+	s.RemoveTarget(extra.TargetID)
+	msg := next(t, c.Events)
+	if msg.Method != internal.ReconnectedMethod {
+		t.Fatalf("first event after disconnect is %s", msg.Method)
+	}
+	var reconnected struct {
+		LostTargets []string `json:"lostTargets"`
+	}
+	_ = json.Unmarshal(msg.Params, &reconnected)
+	if len(reconnected.LostTargets) != 1 || reconnected.LostTargets[0] != extra.TargetID {
+		t.Fatalf("lost targets = %v, want [%s]", reconnected.LostTargets, extra.TargetID)
+	}
+}
+
+func TestReconnectReplaysCallsInOrder(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	for _, method := range []string{"Runtime.enable", "Network.enable", "Page.enable", "Page.disable"} {
+		s.Handle(method, func(*cdptest.Request) (any, error) {
+			return nil, nil
+		})
+	}
+	c := dial(t, s, internal.ClientOptions{
+		Events:    true,
+		Reconnect: &internal.ReconnectOptions{MinBackoff: 10 * time.Millisecond, MaxAttempts: 5},
+	})
+	ctx := testContext(t)
+	sessionID, err := c.AttachToTarget(ctx, "page-1")
+	if err != nil {
+		t.Fatalf("attach: %v", err)
+	}
+	calls := []string{"Runtime.enable", "Network.enable", "Page.enable", "Target.setAutoAttach", "Runtime.enable", "Page.disable", "Page.enable"}
+	for _, method := range calls {
+		err = c.Call(ctx, method, json.RawMessage(`{"autoAttach":true,"waitForDebuggerOnStart":true,"flatten":true}`), sessionID, nil)
+		if err != nil {
+			t.Fatalf("%s: %v", method, err)
+		}
+	}
+	before := len(s.Requests())
+	s.Disconnect()
+	if msg := next(t, c.Events); msg.Method != internal.ReconnectedMethod {
+		t.Fatalf("first event after disconnect is %s", msg.Method)
+	}
+	want := []string{"Runtime.enable", "Network.enable", "Target.setAutoAttach", "Page.enable"}
+	var replayed []string
+	eventually(t, "recorded calls to be re-issued", func() bool {
+		replayed = replayed[:0]
+		for _, req := range s.Requests()[before:] {
+			if req.SessionID != "" {
+				replayed = append(replayed, req.Method)
+			}
+		}
+		return len(replayed) >= len(want)
+	})
+	if fmt.Sprint(replayed) != fmt.Sprint(want) {
+		t.Fatalf("replayed %v, want %v", replayed, want)
+	}
+}
+
+func TestLostConnectionStopsClientGoroutines(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	baseline := runtime.NumGoroutine()
+	c, err := internal.Dial(s.WsURL, internal.ClientOptions{})
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	s.Disconnect()
+	eventually(t, "the client goroutines to exit", func() bool {
+		return runtime.NumGoroutine() <= baseline
+	})
+	_, err = c.Send(testContext(t), "Browser.getVersion", nil, "")
+	if err == nil {
+		t.Fatal("send on a lost connection succeeded")
+	}
+}
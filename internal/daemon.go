@@ -0,0 +1,534 @@
+package internal
+
+import (
+	"cdp/internal/utility"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+type DaemonRequest struct {
+	Op           string                     `json:"op"`
+	WsURL        string                     `json:"wsUrl,omitempty"`
+	Target       string                     `json:"target,omitempty"`
+	Method       string                     `json:"method,omitempty"`
+	Params       json.RawMessage            `json:"params,omitempty"`
+	Domains      []string                   `json:"domains,omitempty"`
+	EnableParams map[string]json.RawMessage `json:"enableParams,omitempty"`
+	Pre          []Command                  `json:"pre,omitempty"`
+	Commands     []Command                  `json:"commands,omitempty"`
+}
+
+type DaemonReply struct {
+	Message   *CDPMessage        `json:"message,omitempty"`
+	Messages  []*CDPMessage      `json:"messages,omitempty"`
+	Status    []DaemonConnection `json:"status,omitempty"`
+	Error     string             `json:"error,omitempty"`
+	UserError bool               `json:"userError,omitempty"`
+	Method    string             `json:"method,omitempty"`
+	Code      int                `json:"code,omitempty"`
+	Data      string             `json:"data,omitempty"`
+}
+
+type DaemonConnection struct {
+	WsURL     string            `json:"wsUrl"`
+	Sessions  map[string]string `json:"sessions"`
+	Listeners int               `json:"listeners"`
+}
+
+type Daemon struct {
+	mu      sync.Mutex
+	conns   map[string]*daemonConn
+	dialing map[string]*pendingDial
+	cancel  context.CancelFunc
+}
+
+type pendingDial struct {
+	done chan struct{}
+	dc   *daemonConn
+	err  error
+}
+
+type pendingAttach struct {
+	done      chan struct{}
+	sessionID string
+	err       error
+}
+
+type daemonConn struct {
+	wsURL     string
+	client    *Client
+	mu        sync.Mutex
+	sessions  map[string]string
+	attaching map[string]*pendingAttach
+	listeners int
+}
+
+func RunDaemon(ctx context.Context) error {
+	if DaemonRunning() {
+		return utility.ErrUser("daemon already running on %s", utility.DaemonSocket)
+	}
+	err := os.MkdirAll(filepath.Dir(utility.DaemonSocket), 0755)
+	if err != nil {
+		return utility.ErrRuntime("creating socket dir: %v", err)
+	}
+	_ = os.Remove(utility.DaemonSocket)
+	ln, err := net.Listen("unix", utility.DaemonSocket)
+	if err != nil {
+		return utility.ErrRuntime("listening on %s: %v", utility.DaemonSocket, err)
+	}
+	defer func() {
+		_ = os.Remove(utility.DaemonSocket)
+	}()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	d := &Daemon{conns: make(map[string]*daemonConn), dialing: make(map[string]*pendingDial), cancel: cancel}
+	go func() {
+		<-ctx.Done()
+		_ = ln.Close()
+	}()
+	utility.Term.Info("daemon listening on %s\n", utility.DaemonSocket)
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+			utility.Term.Info("accept error: %v\n", err)
+			continue
+		}
+		go d.serve(ctx, conn)
+	}
+	d.mu.Lock()
+	for _, dc := range d.conns {
+		dc.client.Close()
+	}
+	d.mu.Unlock()
+	return nil
+}
+
+func (d *Daemon) serve(ctx context.Context, conn net.Conn) {
+	defer func() {
+		_ = conn.Close()
+	}()
+	var req DaemonRequest
+	err := json.NewDecoder(conn).Decode(&req)
+	if err != nil {
+		utility.Term.Info("daemon request decode error: %v\n", err)
+		return
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		_, _ = io.Copy(io.Discard, conn)
+		cancel()
+	}()
+	enc := json.NewEncoder(conn)
+	reply := func(r DaemonReply) bool {
+		return enc.Encode(r) == nil
+	}
+	fail := func(err error) {
+		if e, ok := utility.AsProtocolError(err); ok {
+			_ = reply(DaemonReply{Error: e.Message, Method: e.Method, Code: e.Code, Data: e.Data})
+			return
+		}
+		_ = reply(DaemonReply{Error: err.Error(), UserError: utility.IsUserError(err)})
+	}
+	utility.Term.Info("daemon request: %s %s %s\n", req.Op, req.Target, req.Method+strings.Join(req.Domains, ","))
+	switch req.Op {
+	case "status":
+		_ = reply(DaemonReply{Status: d.status()})
+	case "shutdown":
+		_ = reply(DaemonReply{})
+		d.cancel()
+	case "send":
+		dc, sessionID, err := d.session(ctx, req.WsURL, req.Target)
+		if err != nil {
+			fail(err)
+			return
+		}
+		resp, err := dc.client.Send(ctx, req.Method, req.Params, sessionID)
+		if err != nil {
+			fail(utility.ErrRuntime("sending command: %v", err))
+			return
+		}
+		_ = reply(DaemonReply{Message: resp})
+	case "batch":
+		dc, sessionID, err := d.session(ctx, req.WsURL, req.Target)
+		if err != nil {
+			fail(err)
+			return
+		}
+		for i := range req.Commands {
+			if req.Commands[i].SessionID == "" {
+				req.Commands[i].SessionID = sessionID
+			}
+		}
+		resps, err := dc.client.SendBatch(ctx, req.Commands)
+		if err != nil {
+			fail(utility.ErrRuntime("sending batch: %v", err))
+			return
+		}
+		_ = reply(DaemonReply{Messages: resps})
+	case "listen":
+		dc, sessionID, err := d.session(ctx, req.WsURL, req.Target)
+		if err != nil {
+			fail(err)
+			return
+		}
+		ch, unsubscribe := dc.subscribe(sessionID)
+		defer unsubscribe()
+		err = prepareSession(ctx, dc.client.Send, sessionID, req.Domains, req.EnableParams, req.Pre)
+		if err != nil {
+			fail(err)
+			return
+		}
+		if !reply(DaemonReply{}) {
+			return
+		}
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-ch:
+				if !ok {
+					return
+				}
+				if !inDomains(event.Method, req.Domains) {
+					continue
+				}
+				if !reply(DaemonReply{Message: event}) {
+					return
+				}
+			}
+		}
+	default:
+		fail(utility.ErrUser("unknown daemon op: %s", req.Op))
+	}
+}
+
+func (d *Daemon) status() []DaemonConnection {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	status := make([]DaemonConnection, 0, len(d.conns))
+	for _, dc := range d.conns {
+		dc.mu.Lock()
+		sessions := make(map[string]string, len(dc.sessions))
+		for target, sessionID := range dc.sessions {
+			sessions[target] = sessionID
+		}
+		status = append(status, DaemonConnection{WsURL: dc.wsURL, Sessions: sessions, Listeners: dc.listeners})
+		dc.mu.Unlock()
+	}
+	sort.Slice(status, func(i, j int) bool { return status[i].WsURL < status[j].WsURL })
+	return status
+}
+
+func (d *Daemon) connection(wsURL string) (*daemonConn, error) {
+	d.mu.Lock()
+	if dc, ok := d.conns[wsURL]; ok {
+		d.mu.Unlock()
+		return dc, nil
+	}
+	if p, ok := d.dialing[wsURL]; ok {
+		d.mu.Unlock()
+		<-p.done
+		return p.dc, p.err
+	}
+	p := &pendingDial{done: make(chan struct{})}
+	d.dialing[wsURL] = p
+	d.mu.Unlock()
+	defer close(p.done)
+	client, err := NewClient(wsURL, false)
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.dialing, wsURL)
+	if err != nil {
+		p.err = utility.ErrRuntime("connecting: %v", err)
+		return nil, p.err
+	}
+	dc := &daemonConn{
+		wsURL:     wsURL,
+		client:    client,
+		sessions:  make(map[string]string),
+		attaching: make(map[string]*pendingAttach),
+	}
+	d.conns[wsURL] = dc
+	p.dc = dc
+	go func() {
+		dc.dispatch()
+		d.mu.Lock()
+		if d.conns[wsURL] == dc {
+			delete(d.conns, wsURL)
+		}
+		d.mu.Unlock()
+		utility.Term.Info("daemon dropped connection to %s\n", wsURL)
+	}()
+	return dc, nil
+}
+
+func (d *Daemon) session(ctx context.Context, wsURL, target string) (*daemonConn, string, error) {
+	dc, err := d.connection(wsURL)
+	if err != nil {
+		return nil, "", err
+	}
+	targetID, err := dc.client.ResolveTarget(ctx, target)
+	if err != nil {
+		return nil, "", err
+	}
+	if targetID == "" {
+		return dc, "", nil
+	}
+	dc.mu.Lock()
+	if sessionID, ok := dc.sessions[targetID]; ok {
+		dc.mu.Unlock()
+		return dc, sessionID, nil
+	}
+	if p, ok := dc.attaching[targetID]; ok {
+		dc.mu.Unlock()
+		select {
+		case <-p.done:
+			if p.err != nil {
+				return nil, "", p.err
+			}
+			return dc, p.sessionID, nil
+		case <-ctx.Done():
+			return nil, "", ctx.Err()
+		}
+	}
+	p := &pendingAttach{done: make(chan struct{})}
+	dc.attaching[targetID] = p
+	dc.mu.Unlock()
+	defer close(p.done)
+	sessionID, err := dc.client.AttachToTarget(ctx, targetID)
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	delete(dc.attaching, targetID)
+	if err != nil {
+		p.err = utility.ErrRuntime("attaching to target: %w", err)
+		return nil, "", p.err
+	}
+	dc.sessions[targetID] = sessionID
+	p.sessionID = sessionID
+	return dc, sessionID, nil
+}
+
+func (dc *daemonConn) subscribe(sessionID string) (<-chan *CDPMessage, func()) {
+	ch, cancel := dc.client.Subscribe(sessionID, "")
+	dc.mu.Lock()
+	dc.listeners++
+	dc.mu.Unlock()
+	return ch, func() {
+		cancel()
+		dc.mu.Lock()
+		dc.listeners--
+		dc.mu.Unlock()
+	}
+}
+
+func inDomains(method string, domains []string) bool {
+	domain, _, _ := strings.Cut(method, ".")
+	for _, d := range domains {
+		if d == domain {
+			return true
+		}
+	}
+	return false
+}
+
+func (dc *daemonConn) dispatch() {
+	detached, cancel := dc.client.Subscribe(AnySession, "Target.detachedFromTarget")
+	defer cancel()
+	for event := range detached {
+		var params struct {
+			SessionID string `json:"sessionId"`
+		}
+		_ = json.Unmarshal(event.Params, &params)
+		dc.mu.Lock()
+		for target, sessionID := range dc.sessions {
+			if sessionID == params.SessionID {
+				delete(dc.sessions, target)
+			}
+		}
+		dc.mu.Unlock()
+	}
+}
+
+func DaemonRunning() bool {
+	conn, err := net.DialTimeout("unix", utility.DaemonSocket, 200*time.Millisecond)
+	if err != nil {
+		return false
+	}
+	_ = conn.Close()
+	return true
+}
+
+func dialDaemon(ctx context.Context, req DaemonRequest) (net.Conn, *json.Decoder, error) {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "unix", utility.DaemonSocket)
+	if err != nil {
+		return nil, nil, utility.ErrRuntime("connecting to daemon: %v", err)
+	}
+	err = json.NewEncoder(conn).Encode(req)
+	if err != nil {
+		_ = conn.Close()
+		return nil, nil, utility.ErrRuntime("writing daemon request: %v", err)
+	}
+	return conn, json.NewDecoder(conn), nil
+}
+
+func readDaemonReply(ctx context.Context, dec *json.Decoder) (*DaemonReply, error) {
+	var r DaemonReply
+	err := dec.Decode(&r)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if errors.Is(err, io.EOF) {
+			return nil, io.EOF
+		}
+		return nil, utility.ErrRuntime("reading daemon reply: %v", err)
+	}
+	if r.Error != "" {
+		if r.Code != 0 {
+			return nil, utility.ProtocolError{Method: r.Method, Code: r.Code, Message: r.Error, Data: r.Data}
+		}
+		if r.UserError {
+			return nil, utility.ErrUser("%s", r.Error)
+		}
+		return nil, utility.ErrRuntime("%s", r.Error)
+	}
+	return &r, nil
+}
+
+func DaemonSend(ctx context.Context, wsURL, target, method string, params json.RawMessage) (*CDPMessage, error) {
+	conn, dec, err := dialDaemon(ctx, DaemonRequest{Op: "send", WsURL: wsURL, Target: target, Method: method, Params: params})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+	stop := context.AfterFunc(ctx, func() {
+		_ = conn.Close()
+	})
+	defer stop()
+	r, err := readDaemonReply(ctx, dec)
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, utility.ErrRuntime("daemon closed connection")
+		}
+		if ctx.Err() != nil {
+			return nil, utility.ErrRuntime("sending command: %v", err)
+		}
+		return nil, err
+	}
+	return r.Message, nil
+}
+
+func DaemonSendBatch(ctx context.Context, wsURL, target string, cmds []Command) ([]*CDPMessage, error) {
+	conn, dec, err := dialDaemon(ctx, DaemonRequest{Op: "batch", WsURL: wsURL, Target: target, Commands: cmds})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+	stop := context.AfterFunc(ctx, func() {
+		_ = conn.Close()
+	})
+	defer stop()
+	r, err := readDaemonReply(ctx, dec)
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, utility.ErrRuntime("daemon closed connection")
+		}
+		if ctx.Err() != nil {
+			return nil, utility.ErrRuntime("sending batch: %v", err)
+		}
+		return nil, err
+	}
+	return r.Messages, nil
+}
+
+func DaemonListen(ctx context.Context, wsURL string, opts ListenOptions, eventCh chan<- *ListenEvent) error {
+	if opts.AutoAttach {
+		return utility.ErrUser("auto-attach is not supported through the daemon")
+	}
+	conn, dec, err := dialDaemon(ctx, DaemonRequest{Op: "listen", WsURL: wsURL, Target: opts.Target, Domains: opts.Domains, EnableParams: opts.EnableParams, Pre: opts.Pre})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+	stop := context.AfterFunc(ctx, func() {
+		_ = conn.Close()
+	})
+	defer stop()
+	_, err = readDaemonReply(ctx, dec)
+	if err != nil {
+		if ctx.Err() != nil || errors.Is(err, io.EOF) {
+			return nil
+		}
+		return err
+	}
+	for {
+		r, err := readDaemonReply(ctx, dec)
+		if err != nil {
+			if ctx.Err() != nil || errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if r.Message == nil {
+			continue
+		}
+		select {
+		case eventCh <- &ListenEvent{CDPMessage: r.Message}:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func DaemonStatus(ctx context.Context) ([]DaemonConnection, error) {
+	conn, dec, err := dialDaemon(ctx, DaemonRequest{Op: "status"})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+	r, err := readDaemonReply(ctx, dec)
+	if err != nil {
+		return nil, err
+	}
+	if r.Status == nil {
+		return []DaemonConnection{}, nil
+	}
+	return r.Status, nil
+}
+
+func StopDaemon(ctx context.Context) error {
+	conn, dec, err := dialDaemon(ctx, DaemonRequest{Op: "shutdown"})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+	_, err = readDaemonReply(ctx, dec)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package internal_test
+
+import (
+	"cdp/internal"
+	"cdp/internal/cdptest"
+	"cdp/internal/utility"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func startDaemon(t *testing.T) {
+	dir, err := os.MkdirTemp("", "cdpd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	previous := utility.DaemonSocket
+	utility.DaemonSocket = filepath.Join(dir, "d.sock")
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- internal.RunDaemon(ctx)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+		utility.DaemonSocket = previous
+		_ = os.RemoveAll(dir)
+	})
+	eventually(t, "the daemon socket", internal.DaemonRunning)
+}
+
+func TestDaemonListenOnlyStreamsRequestedDomains(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	for _, method := range []string{"Network.enable", "Page.enable"} {
+		s.Handle(method, func(*cdptest.Request) (any, error) {
+			return nil, nil
+		})
+	}
+	startDaemon(t)
+	ctx := testContext(t)
+	resp, err := internal.DaemonSend(ctx, s.WsURL, "page-1", "Network.enable", nil)
+	if err != nil || resp.Error != nil {
+		t.Fatalf("enabling Network: %v %+v", err, resp)
+	}
+	listenCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	events := make(chan *internal.ListenEvent, 10)
+	done := make(chan error, 1)
+	go func() {
+		done <- internal.DaemonListen(listenCtx, s.WsURL, internal.ListenOptions{Target: "page-1", Domains: []string{"Page"}}, events)
+	}()
+	var sessionID string
+	eventually(t, "Page.enable", func() bool {
+		for _, req := range s.Requests() {
+			if req.Method == "Page.enable" {
+				sessionID = req.SessionID
+				return true
+			}
+		}
+		return false
+	})
+	s.Emit(sessionID, "Network.requestWillBeSent", map[string]any{})
+	s.Emit(sessionID, "Target.targetInfoChanged", map[string]any{})
+	s.Emit(sessionID, "Page.loadEventFired", map[string]any{})
+	select {
+	case event := <-events:
+		if event.Method != "Page.loadEventFired" {
+			t.Fatalf("first event through the daemon is %s, want Page.loadEventFired", event.Method)
+		}
+	case err := <-done:
+		t.Fatalf("listen returned: %v", err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for event")
+	}
+}
+
+func TestDaemonListenKeepsProtocolErrors(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	startDaemon(t)
+	ctx := testContext(t)
+	err := internal.DaemonListen(ctx, s.WsURL, internal.ListenOptions{Target: "page-1", Domains: []string{"Foo"}}, make(chan *internal.ListenEvent, 1))
+	e, ok := utility.AsProtocolError(err)
+	if !ok || e.Method != "Foo.enable" || e.Code != -32601 {
+		t.Fatalf("err = %#v, want a Foo.enable -32601 protocol error", err)
+	}
+	if code := utility.ExitCode(err); code != utility.ExitMethodNotFound {
+		t.Fatalf("exit code = %d, want %d", code, utility.ExitMethodNotFound)
+	}
+}
+
+func TestDaemonAttachesOncePerTarget(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	s.Handle("Runtime.evaluate", func(*cdptest.Request) (any, error) {
+		return map[string]any{}, nil
+	})
+	startDaemon(t)
+	ctx := testContext(t)
+	errs := make(chan error, 8)
+	for range 8 {
+		go func() {
+			_, err := internal.DaemonSend(ctx, s.WsURL, "page-1", "Runtime.evaluate", nil)
+			errs <- err
+		}()
+	}
+	for range 8 {
+		if err := <-errs; err != nil {
+			t.Fatal(err)
+		}
+	}
+	attaches := 0
+	for _, req := range s.Requests() {
+		if req.Method == "Target.attachToTarget" {
+			attaches++
+		}
+	}
+	if attaches != 1 || len(s.Sessions()) != 1 {
+		t.Fatalf("%d attaches and %d sessions for concurrent requests, want 1", attaches, len(s.Sessions()))
+	}
+}
@@ -0,0 +1,503 @@
+package har
+
+import (
+	"cdp/internal"
+	"cdp/protocol/network"
+	"cdp/protocol/page"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/url"
+	"runtime/debug"
+	"sort"
+	"strings"
+	"time"
+)
+
+type HAR struct {
+	Log Log `json:"log"`
+}
+
+type Log struct {
+	Version string  `json:"version"`
+	Creator Creator `json:"creator"`
+	Pages   []Page  `json:"pages"`
+	Entries []Entry `json:"entries"`
+}
+
+type Creator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type Page struct {
+	StartedDateTime string      `json:"startedDateTime"`
+	ID              string      `json:"id"`
+	Title           string      `json:"title"`
+	PageTimings     PageTimings `json:"pageTimings"`
+}
+
+type PageTimings struct {
+	OnContentLoad float64 `json:"onContentLoad"`
+	OnLoad        float64 `json:"onLoad"`
+}
+
+type Entry struct {
+	Pageref         string   `json:"pageref,omitempty"`
+	StartedDateTime string   `json:"startedDateTime"`
+	Time            float64  `json:"time"`
+	Request         Request  `json:"request"`
+	Response        Response `json:"response"`
+	Cache           struct{} `json:"cache"`
+	Timings         Timings  `json:"timings"`
+	ServerIPAddress string   `json:"serverIPAddress,omitempty"`
+	Connection      string   `json:"connection,omitempty"`
+	ResourceType    string   `json:"_resourceType,omitempty"`
+	TransferSize    int64    `json:"_transferSize,omitempty"`
+	Error           string   `json:"_error,omitempty"`
+}
+
+type Request struct {
+	Method      string      `json:"method"`
+	URL         string      `json:"url"`
+	HTTPVersion string      `json:"httpVersion"`
+	Cookies     []Cookie    `json:"cookies"`
+	Headers     []NameValue `json:"headers"`
+	QueryString []NameValue `json:"queryString"`
+	PostData    *PostData   `json:"postData,omitempty"`
+	HeadersSize int64       `json:"headersSize"`
+	BodySize    int64       `json:"bodySize"`
+}
+
+type Response struct {
+	Status      int64       `json:"status"`
+	StatusText  string      `json:"statusText"`
+	HTTPVersion string      `json:"httpVersion"`
+	Cookies     []Cookie    `json:"cookies"`
+	Headers     []NameValue `json:"headers"`
+	Content     Content     `json:"content"`
+	RedirectURL string      `json:"redirectURL"`
+	HeadersSize int64       `json:"headersSize"`
+	BodySize    int64       `json:"bodySize"`
+}
+
+type Cookie struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type NameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type PostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+type Content struct {
+	Size     int64  `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text,omitempty"`
+	Encoding string `json:"encoding,omitempty"`
+}
+
+type Timings struct {
+	Blocked float64 `json:"blocked"`
+	DNS     float64 `json:"dns"`
+	Connect float64 `json:"connect"`
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+	SSL     float64 `json:"ssl"`
+}
+
+type entry struct {
+	requestID   network.RequestID
+	pageref     string
+	wallTime    float64
+	started     float64
+	responded   float64
+	ended       float64
+	request     network.Request
+	response    *network.Response
+	resource    string
+	redirectURL string
+	dataLength  int64
+	bodyLength  int64
+	transfer    float64
+	body        *Content
+	failed      string
+	done        bool
+}
+
+type pageState struct {
+	Page
+	frameID page.FrameID
+	started float64
+}
+
+type Builder struct {
+	entries []*entry
+	active  map[network.RequestID]*entry
+	pages   []*pageState
+}
+
+func NewBuilder() *Builder {
+	return &Builder{active: make(map[network.RequestID]*entry)}
+}
+
+func (b *Builder) Pending() int {
+	return len(b.active)
+}
+
+func (b *Builder) Observe(msg *internal.CDPMessage) (network.RequestID, bool) {
+	switch msg.Method {
+	case network.EventRequestWillBeSent:
+		var ev network.RequestWillBeSentEvent
+		if json.Unmarshal(msg.Params, &ev) != nil {
+			return "", false
+		}
+		if prev, ok := b.active[ev.RequestID]; ok && ev.RedirectResponse != nil {
+			prev.response = ev.RedirectResponse
+			prev.responded = float64(ev.Timestamp)
+			prev.ended = float64(ev.Timestamp)
+			prev.redirectURL = ev.Request.URL
+			prev.done = true
+		}
+		if ev.Type == network.ResourceTypeDocument && string(ev.LoaderID) == string(ev.RequestID) && ev.RedirectResponse == nil {
+			b.startPage(&ev)
+		}
+		e := &entry{
+			requestID: ev.RequestID,
+			wallTime:  float64(ev.WallTime),
+			started:   float64(ev.Timestamp),
+			request:   ev.Request,
+			resource:  string(ev.Type),
+		}
+		if len(b.pages) > 0 {
+			e.pageref = b.pages[len(b.pages)-1].ID
+		}
+		b.entries = append(b.entries, e)
+		b.active[ev.RequestID] = e
+	case network.EventResponseReceived:
+		var ev network.ResponseReceivedEvent
+		if json.Unmarshal(msg.Params, &ev) != nil {
+			return "", false
+		}
+		if e, ok := b.active[ev.RequestID]; ok {
+			e.response = &ev.Response
+			e.responded = float64(ev.Timestamp)
+			if e.resource == "" {
+				e.resource = string(ev.Type)
+			}
+		}
+	case network.EventDataReceived:
+		var ev network.DataReceivedEvent
+		if json.Unmarshal(msg.Params, &ev) != nil {
+			return "", false
+		}
+		if e, ok := b.active[ev.RequestID]; ok {
+			e.dataLength += ev.DataLength
+			e.bodyLength += ev.EncodedDataLength
+		}
+	case network.EventLoadingFinished:
+		var ev network.LoadingFinishedEvent
+		if json.Unmarshal(msg.Params, &ev) != nil {
+			return "", false
+		}
+		if e, ok := b.active[ev.RequestID]; ok {
+			e.ended = float64(ev.Timestamp)
+			e.transfer = ev.EncodedDataLength
+			e.done = true
+			delete(b.active, ev.RequestID)
+			return ev.RequestID, true
+		}
+	case network.EventLoadingFailed:
+		var ev network.LoadingFailedEvent
+		if json.Unmarshal(msg.Params, &ev) != nil {
+			return "", false
+		}
+		if e, ok := b.active[ev.RequestID]; ok {
+			e.ended = float64(ev.Timestamp)
+			e.failed = ev.ErrorText
+			e.done = true
+			delete(b.active, ev.RequestID)
+		}
+	case page.EventDOMContentEventFired, page.EventLoadEventFired:
+		var ev page.LoadEventFiredEvent
+		if json.Unmarshal(msg.Params, &ev) != nil || len(b.pages) == 0 {
+			return "", false
+		}
+		p := b.pages[len(b.pages)-1]
+		ms := round((ev.Timestamp - p.started) * 1000)
+		if msg.Method == page.EventLoadEventFired {
+			p.PageTimings.OnLoad = ms
+		} else {
+			p.PageTimings.OnContentLoad = ms
+		}
+	}
+	return "", false
+}
+
+func (b *Builder) SetBody(requestID network.RequestID, body string, base64Encoded bool) {
+	for i := len(b.entries) - 1; i >= 0; i-- {
+		e := b.entries[i]
+		if e.requestID != requestID {
+			continue
+		}
+		content := &Content{Size: int64(len(body)), Text: body}
+		if base64Encoded {
+			content.Encoding = "base64"
+			content.Size = int64(base64.StdEncoding.DecodedLen(len(body)))
+			if data, err := base64.StdEncoding.DecodeString(body); err == nil {
+				content.Size = int64(len(data))
+			}
+		}
+		e.body = content
+		return
+	}
+}
+
+func (b *Builder) startPage(ev *network.RequestWillBeSentEvent) {
+	if len(b.pages) > 0 && b.pages[0].frameID != ev.FrameID {
+		return
+	}
+	b.pages = append(b.pages, &pageState{
+		Page: Page{
+			StartedDateTime: formatTime(float64(ev.WallTime)),
+			ID:              fmt.Sprintf("page_%d", len(b.pages)+1),
+			Title:           ev.Request.URL,
+			PageTimings:     PageTimings{OnContentLoad: -1, OnLoad: -1},
+		},
+		frameID: ev.FrameID,
+		started: float64(ev.Timestamp),
+	})
+}
+
+func (b *Builder) Build() *HAR {
+	version := "devel"
+	if info, ok := debug.ReadBuildInfo(); ok && info.Main.Version != "" && info.Main.Version != "(devel)" {
+		version = info.Main.Version
+	}
+	h := &HAR{Log: Log{
+		Version: "1.2",
+		Creator: Creator{Name: "cdp", Version: version},
+		Pages:   []Page{},
+		Entries: []Entry{},
+	}}
+	for _, p := range b.pages {
+		h.Log.Pages = append(h.Log.Pages, p.Page)
+	}
+	for _, e := range b.entries {
+		h.Log.Entries = append(h.Log.Entries, e.build())
+	}
+	sort.SliceStable(h.Log.Entries, func(i, j int) bool {
+		return h.Log.Entries[i].StartedDateTime < h.Log.Entries[j].StartedDateTime
+	})
+	return h
+}
+
+func (e *entry) build() Entry {
+	out := Entry{
+		Pageref:         e.pageref,
+		StartedDateTime: formatTime(e.wallTime),
+		ResourceType:    strings.ToLower(e.resource),
+		TransferSize:    int64(e.transfer),
+		Error:           e.failed,
+	}
+	requestHeaders := e.request.Headers
+	if e.response != nil && len(e.response.RequestHeaders) > 0 {
+		requestHeaders = e.response.RequestHeaders
+	}
+	out.Request = Request{
+		Method:      e.request.Method,
+		URL:         e.request.URL + e.request.URLFragment,
+		HTTPVersion: "HTTP/1.1",
+		Cookies:     requestCookies(requestHeaders),
+		Headers:     headerList(requestHeaders),
+		QueryString: queryString(e.request.URL),
+		HeadersSize: -1,
+		BodySize:    int64(len(e.request.PostData)),
+	}
+	if e.request.PostData != "" {
+		out.Request.PostData = &PostData{MimeType: headerValue(requestHeaders, "Content-Type"), Text: e.request.PostData}
+	}
+	out.Response = Response{
+		Cookies:     []Cookie{},
+		Headers:     []NameValue{},
+		Content:     Content{Size: e.dataLength, MimeType: "x-unknown"},
+		RedirectURL: e.redirectURL,
+		HeadersSize: -1,
+		BodySize:    -1,
+	}
+	if e.response != nil {
+		version := httpVersion(e.response.Protocol)
+		out.Request.HTTPVersion = version
+		out.Response.Status = e.response.Status
+		out.Response.StatusText = e.response.StatusText
+		out.Response.HTTPVersion = version
+		out.Response.Cookies = responseCookies(e.response.Headers)
+		out.Response.Headers = headerList(e.response.Headers)
+		if e.response.MimeType != "" {
+			out.Response.Content.MimeType = e.response.MimeType
+		}
+		if e.redirectURL == "" {
+			out.Response.RedirectURL = headerValue(e.response.Headers, "Location")
+		}
+		if e.bodyLength > 0 {
+			out.Response.BodySize = e.bodyLength
+		} else if e.done && e.failed == "" {
+			out.Response.BodySize = 0
+		}
+		out.ServerIPAddress = strings.Trim(e.response.RemoteIPAddress, "[]")
+		if e.response.ConnectionID != 0 {
+			out.Connection = fmt.Sprint(int64(e.response.ConnectionID))
+		}
+	}
+	if e.body != nil {
+		out.Response.Content.Size = e.body.Size
+		out.Response.Content.Text = e.body.Text
+		out.Response.Content.Encoding = e.body.Encoding
+	}
+	out.Timings = e.timings()
+	for _, v := range []float64{out.Timings.Blocked, out.Timings.DNS, out.Timings.Connect, out.Timings.Send, out.Timings.Wait, out.Timings.Receive} {
+		if v > 0 {
+			out.Time += v
+		}
+	}
+	out.Time = round(out.Time)
+	return out
+}
+
+func (e *entry) timings() Timings {
+	t := Timings{Blocked: -1, DNS: -1, Connect: -1, SSL: -1}
+	if e.response == nil || e.response.Timing == nil {
+		if e.responded > 0 {
+			t.Wait = round((e.responded - e.started) * 1000)
+			if e.ended > e.responded {
+				t.Receive = round((e.ended - e.responded) * 1000)
+			}
+		} else if e.ended > 0 {
+			t.Wait = round((e.ended - e.started) * 1000)
+		}
+		return t
+	}
+	timing := e.response.Timing
+	queued := math.Max(0, (timing.RequestTime-e.started)*1000)
+	t.Blocked = round(queued + firstNonNegative(timing.DnsStart, timing.ConnectStart, timing.SendStart))
+	t.DNS = span(timing.DnsStart, timing.DnsEnd)
+	t.Connect = span(timing.ConnectStart, timing.ConnectEnd)
+	t.SSL = span(timing.SSLStart, timing.SSLEnd)
+	t.Send = round(math.Max(0, timing.SendEnd-timing.SendStart))
+	t.Wait = round(math.Max(0, timing.ReceiveHeadersEnd-timing.SendEnd))
+	if e.ended > 0 {
+		t.Receive = round(math.Max(0, (e.ended-timing.RequestTime)*1000-timing.ReceiveHeadersEnd))
+	}
+	return t
+}
+
+func firstNonNegative(values ...float64) float64 {
+	for _, v := range values {
+		if v >= 0 {
+			return v
+		}
+	}
+	return 0
+}
+
+func span(start, end float64) float64 {
+	if start < 0 || end < 0 {
+		return -1
+	}
+	return round(end - start)
+}
+
+func round(v float64) float64 {
+	return math.Round(v*1000) / 1000
+}
+
+func formatTime(epochSeconds float64) string {
+	return time.UnixMilli(int64(math.Round(epochSeconds * 1000))).UTC().Format("2006-01-02T15:04:05.000Z")
+}
+
+func httpVersion(protocol string) string {
+	switch strings.ToLower(protocol) {
+	case "":
+		return "HTTP/1.1"
+	case "h2", "http/2", "http/2.0":
+		return "HTTP/2.0"
+	case "h3", "http/3", "http/3.0":
+		return "HTTP/3.0"
+	}
+	return strings.ToUpper(protocol)
+}
+
+func headerList(headers network.Headers) []NameValue {
+	list := []NameValue{}
+	for name, value := range headers {
+		for _, v := range strings.Split(fmt.Sprint(value), "\n") {
+			list = append(list, NameValue{Name: name, Value: v})
+		}
+	}
+	sort.SliceStable(list, func(i, j int) bool {
+		return strings.ToLower(list[i].Name) < strings.ToLower(list[j].Name)
+	})
+	return list
+}
+
+func headerValue(headers network.Headers, name string) string {
+	for key, value := range headers {
+		if strings.EqualFold(key, name) {
+			return fmt.Sprint(value)
+		}
+	}
+	return ""
+}
+
+func queryString(rawURL string) []NameValue {
+	list := []NameValue{}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return list
+	}
+	for _, pair := range strings.Split(u.RawQuery, "&") {
+		if pair == "" {
+			continue
+		}
+		name, value, _ := strings.Cut(pair, "=")
+		if unescaped, err := url.QueryUnescape(name); err == nil {
+			name = unescaped
+		}
+		if unescaped, err := url.QueryUnescape(value); err == nil {
+			value = unescaped
+		}
+		list = append(list, NameValue{Name: name, Value: value})
+	}
+	return list
+}
+
+func requestCookies(headers network.Headers) []Cookie {
+	cookies := []Cookie{}
+	for _, pair := range strings.Split(headerValue(headers, "Cookie"), ";") {
+		name, value, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if ok {
+			cookies = append(cookies, Cookie{Name: name, Value: value})
+		}
+	}
+	return cookies
+}
+
+func responseCookies(headers network.Headers) []Cookie {
+	cookies := []Cookie{}
+	for _, line := range strings.Split(headerValue(headers, "Set-Cookie"), "\n") {
+		first, _, _ := strings.Cut(line, ";")
+		name, value, ok := strings.Cut(strings.TrimSpace(first), "=")
+		if ok {
+			cookies = append(cookies, Cookie{Name: name, Value: value})
+		}
+	}
+	return cookies
+}
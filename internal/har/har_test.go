@@ -0,0 +1,141 @@
+package har_test
+
+import (
+	"cdp/internal"
+	"cdp/internal/har"
+	"encoding/json"
+	"testing"
+)
+
+func event(t *testing.T, method string, params map[string]any) *internal.CDPMessage {
+	t.Helper()
+	data, err := json.Marshal(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &internal.CDPMessage{Method: method, Params: data}
+}
+
+func request(id, url, typ string, timestamp float64, extra map[string]any) map[string]any {
+	params := map[string]any{
+		"requestId":   id,
+		"loaderId":    "doc",
+		"documentURL": "https://example.com/",
+		"frameId":     "main",
+		"timestamp":   timestamp,
+		"wallTime":    1700000000 + timestamp,
+		"type":        typ,
+		"initiator":   map[string]any{"type": "other"},
+		"request": map[string]any{
+			"url":             url,
+			"method":          "GET",
+			"headers":         map[string]any{"Cookie": "a=1; b=2"},
+			"initialPriority": "High",
+			"referrerPolicy":  "no-referrer",
+		},
+	}
+	for key, value := range extra {
+		params[key] = value
+	}
+	return params
+}
+
+func response(url string, status int, headers map[string]any) map[string]any {
+	return map[string]any{
+		"url":               url,
+		"status":            status,
+		"statusText":        "OK",
+		"headers":           headers,
+		"mimeType":          "text/html",
+		"connectionReused":  false,
+		"connectionId":      7,
+		"encodedDataLength": 100,
+		"securityState":     "secure",
+		"protocol":          "h2",
+		"remoteIPAddress":   "[::1]",
+	}
+}
+
+func TestBuilder(t *testing.T) {
+	b := har.NewBuilder()
+	for _, msg := range []*internal.CDPMessage{
+		event(t, "Network.requestWillBeSent", request("doc", "http://example.com/?q=a%20b&x", "Document", 1, nil)),
+		event(t, "Network.requestWillBeSent", request("doc", "https://example.com/", "Document", 1.1, map[string]any{
+			"redirectResponse": response("http://example.com/?q=a%20b&x", 301, map[string]any{"Location": "https://example.com/"}),
+		})),
+		event(t, "Network.responseReceived", map[string]any{
+			"requestId": "doc", "loaderId": "doc", "timestamp": 1.3, "type": "Document",
+			"response": response("https://example.com/", 200, map[string]any{"Set-Cookie": "s=1; Path=/\nt=2", "Content-Type": "text/html"}),
+		}),
+		event(t, "Network.dataReceived", map[string]any{"requestId": "doc", "timestamp": 1.35, "dataLength": 10, "encodedDataLength": 6}),
+		event(t, "Network.requestWillBeSent", request("img", "https://example.com/a.png", "Image", 1.4, nil)),
+		event(t, "Network.loadingFailed", map[string]any{"requestId": "img", "timestamp": 1.45, "type": "Image", "errorText": "net::ERR_FAILED"}),
+		event(t, "Page.domContentEventFired", map[string]any{"timestamp": 1.5}),
+		event(t, "Page.loadEventFired", map[string]any{"timestamp": 2}),
+	} {
+		if _, done := b.Observe(msg); done {
+			t.Fatalf("%s finished a request", msg.Method)
+		}
+	}
+	if b.Pending() != 1 {
+		t.Fatalf("pending = %d, want 1", b.Pending())
+	}
+	id, done := b.Observe(event(t, "Network.loadingFinished", map[string]any{"requestId": "doc", "timestamp": 1.6, "encodedDataLength": 120}))
+	if !done || id != "doc" || b.Pending() != 0 {
+		t.Fatalf("loadingFinished = %s %v, pending %d", id, done, b.Pending())
+	}
+	b.SetBody("doc", "aGVsbG8=", true)
+	h := b.Build()
+	if h.Log.Version != "1.2" || h.Log.Creator.Name != "cdp" {
+		t.Fatalf("log header = %+v", h.Log)
+	}
+	if len(h.Log.Pages) != 1 {
+		t.Fatalf("pages = %+v", h.Log.Pages)
+	}
+	p := h.Log.Pages[0]
+	if p.ID != "page_1" || p.PageTimings.OnContentLoad != 500 || p.PageTimings.OnLoad != 1000 || p.StartedDateTime != "2023-11-14T22:13:21.000Z" {
+		t.Fatalf("page = %+v", p)
+	}
+	if len(h.Log.Entries) != 3 {
+		t.Fatalf("entries = %d, want 3", len(h.Log.Entries))
+	}
+	redirect, doc, img := h.Log.Entries[0], h.Log.Entries[1], h.Log.Entries[2]
+	if redirect.Response.Status != 301 || redirect.Response.RedirectURL != "https://example.com/" || redirect.Pageref != "page_1" {
+		t.Fatalf("redirect entry = %+v", redirect)
+	}
+	if len(redirect.Request.QueryString) != 2 || redirect.Request.QueryString[0] != (har.NameValue{Name: "q", Value: "a b"}) {
+		t.Fatalf("query string = %+v", redirect.Request.QueryString)
+	}
+	if len(redirect.Request.Cookies) != 2 || redirect.Request.Cookies[1] != (har.Cookie{Name: "b", Value: "2"}) {
+		t.Fatalf("request cookies = %+v", redirect.Request.Cookies)
+	}
+	if doc.Response.Status != 200 || doc.Response.HTTPVersion != "HTTP/2.0" || doc.ServerIPAddress != "::1" || doc.Connection != "7" {
+		t.Fatalf("document response = %+v", doc)
+	}
+	if len(doc.Response.Cookies) != 2 || doc.Response.Cookies[0] != (har.Cookie{Name: "s", Value: "1"}) {
+		t.Fatalf("response cookies = %+v", doc.Response.Cookies)
+	}
+	if doc.Response.Content != (har.Content{Size: 5, MimeType: "text/html", Text: "aGVsbG8=", Encoding: "base64"}) {
+		t.Fatalf("content = %+v", doc.Response.Content)
+	}
+	if doc.Response.BodySize != 6 || doc.TransferSize != 120 || doc.ResourceType != "document" {
+		t.Fatalf("document sizes = %+v", doc)
+	}
+	if doc.Timings.Wait != 200 || doc.Timings.Receive != 300 || doc.Time != 500 || doc.Timings.DNS != -1 {
+		t.Fatalf("document timings = %+v (time %v)", doc.Timings, doc.Time)
+	}
+	if img.Error != "net::ERR_FAILED" || img.Response.Status != 0 || img.Timings.Wait != 50 {
+		t.Fatalf("failed entry = %+v", img)
+	}
+}
+
+func TestBuilderIgnoresUnknownRequests(t *testing.T) {
+	b := har.NewBuilder()
+	_, done := b.Observe(event(t, "Network.loadingFinished", map[string]any{"requestId": "x", "timestamp": 1, "encodedDataLength": 1}))
+	b.Observe(event(t, "Page.loadEventFired", map[string]any{"timestamp": 1}))
+	b.SetBody("x", "body", false)
+	h := b.Build()
+	if done || len(h.Log.Entries) != 0 || len(h.Log.Pages) != 0 {
+		t.Fatalf("done %v, log %+v", done, h.Log)
+	}
+}
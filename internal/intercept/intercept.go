@@ -0,0 +1,334 @@
+package intercept
+
+import (
+	"cdp/internal"
+	"cdp/internal/utility"
+	"cdp/protocol/fetch"
+	"cdp/protocol/network"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	ActionContinue = "continue"
+	ActionFulfill  = "fulfill"
+	ActionFail     = "fail"
+
+	StageRequest  = "request"
+	StageResponse = "response"
+)
+
+var errorReasons = []network.ErrorReason{
+	network.ErrorReasonFailed,
+	network.ErrorReasonAborted,
+	network.ErrorReasonTimedOut,
+	network.ErrorReasonAccessDenied,
+	network.ErrorReasonConnectionClosed,
+	network.ErrorReasonConnectionReset,
+	network.ErrorReasonConnectionRefused,
+	network.ErrorReasonConnectionAborted,
+	network.ErrorReasonConnectionFailed,
+	network.ErrorReasonNameNotResolved,
+	network.ErrorReasonInternetDisconnected,
+	network.ErrorReasonAddressUnreachable,
+	network.ErrorReasonBlockedByClient,
+	network.ErrorReasonBlockedByResponse,
+}
+
+type Rules struct {
+	Rules []*Rule `yaml:"rules"`
+}
+
+type Rule struct {
+	Name          string            `yaml:"name"`
+	URL           string            `yaml:"url"`
+	Method        string            `yaml:"method"`
+	ResourceType  string            `yaml:"resourceType"`
+	Stage         string            `yaml:"stage"`
+	Delay         string            `yaml:"delay"`
+	Status        int64             `yaml:"status"`
+	Body          string            `yaml:"body"`
+	File          string            `yaml:"file"`
+	Headers       map[string]string `yaml:"headers"`
+	RemoveHeaders []string          `yaml:"removeHeaders"`
+	Fail          string            `yaml:"fail"`
+
+	action string
+	url    *regexp.Regexp
+	delay  time.Duration
+	body   []byte
+}
+
+type Decision struct {
+	Time         string `json:"time"`
+	SessionID    string `json:"sessionId,omitempty"`
+	RequestID    string `json:"requestId"`
+	Method       string `json:"method"`
+	URL          string `json:"url"`
+	ResourceType string `json:"resourceType,omitempty"`
+	Stage        string `json:"stage"`
+	Rule         string `json:"rule,omitempty"`
+	Action       string `json:"action"`
+	Status       int64  `json:"status,omitempty"`
+	Delay        int64  `json:"delayMs,omitempty"`
+	Error        string `json:"error,omitempty"`
+}
+
+func Load(path string) ([]*Rule, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, utility.ErrUser("reading rules: %v", err)
+	}
+	var rules Rules
+	err = yaml.Unmarshal(data, &rules)
+	if err != nil {
+		return nil, utility.ErrUser("parsing rules %s: %v", path, err)
+	}
+	if len(rules.Rules) == 0 {
+		return nil, utility.ErrUser("rules file %s has no rules", path)
+	}
+	for i, r := range rules.Rules {
+		if r.Name == "" {
+			r.Name = fmt.Sprintf("rule %d", i+1)
+		}
+		err = r.compile(filepath.Dir(path))
+		if err != nil {
+			return nil, utility.ErrUser("%s: %v", r.Name, err)
+		}
+	}
+	return rules.Rules, nil
+}
+
+func (r *Rule) compile(dir string) error {
+	if r.URL == "" {
+		r.URL = "*"
+	}
+	r.url = utility.GlobRegexp(r.URL)
+	r.Method = strings.ToUpper(r.Method)
+	switch r.Stage {
+	case "":
+		r.Stage = StageRequest
+	case StageRequest, StageResponse:
+	default:
+		return fmt.Errorf("stage must be request or response, got %q", r.Stage)
+	}
+	if r.Delay != "" {
+		d, err := time.ParseDuration(r.Delay)
+		if err != nil || d < 0 {
+			return fmt.Errorf("invalid delay %q", r.Delay)
+		}
+		r.delay = d
+	}
+	fulfill := r.Status != 0 || r.Body != "" || r.File != ""
+	switch {
+	case r.Fail != "" && (fulfill || r.Headers != nil || r.RemoveHeaders != nil):
+		return fmt.Errorf("fail cannot be combined with status, body, file or headers")
+	case r.Fail != "":
+		if !slices.Contains(errorReasons, network.ErrorReason(r.Fail)) {
+			return fmt.Errorf("unknown fail reason %q", r.Fail)
+		}
+		r.action = ActionFail
+	case fulfill:
+		if r.Body != "" && r.File != "" {
+			return fmt.Errorf("body and file are mutually exclusive")
+		}
+		r.body = []byte(r.Body)
+		if r.File != "" {
+			path := r.File
+			if !filepath.IsAbs(path) {
+				path = filepath.Join(dir, path)
+			}
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return fmt.Errorf("reading body file: %v", err)
+			}
+			r.body = data
+		}
+		r.action = ActionFulfill
+	default:
+		r.action = ActionContinue
+	}
+	return nil
+}
+
+func Patterns(rules []*Rule) []fetch.RequestPattern {
+	var patterns []fetch.RequestPattern
+	for _, r := range rules {
+		p := fetch.RequestPattern{URLPattern: r.URL, ResourceType: network.ResourceType(r.ResourceType)}
+		if r.Stage == StageResponse {
+			p.RequestStage = fetch.RequestStageResponse
+		}
+		if !slices.Contains(patterns, p) {
+			patterns = append(patterns, p)
+		}
+	}
+	return patterns
+}
+
+func Stage(e *fetch.RequestPausedEvent) string {
+	if e.ResponseStatusCode != nil || e.ResponseErrorReason != "" {
+		return StageResponse
+	}
+	return StageRequest
+}
+
+func Match(rules []*Rule, e *fetch.RequestPausedEvent) *Rule {
+	stage := Stage(e)
+	for _, r := range rules {
+		switch {
+		case r.Stage != stage:
+		case !r.url.MatchString(e.Request.URL):
+		case r.Method != "" && r.Method != e.Request.Method:
+		case r.ResourceType != "" && r.ResourceType != string(e.ResourceType):
+		default:
+			return r
+		}
+	}
+	return nil
+}
+
+func Apply(ctx context.Context, c *internal.Client, sessionID string, rules []*Rule, e *fetch.RequestPausedEvent) Decision {
+	d := Decision{
+		SessionID:    sessionID,
+		RequestID:    string(e.RequestID),
+		Method:       e.Request.Method,
+		URL:          e.Request.URL,
+		ResourceType: string(e.ResourceType),
+		Stage:        Stage(e),
+		Action:       ActionContinue,
+	}
+	r := Match(rules, e)
+	var err error
+	if r == nil {
+		err = fetch.ContinueRequest(ctx, c, sessionID, &fetch.ContinueRequestParams{RequestID: e.RequestID})
+	} else {
+		d.Rule = r.Name
+		d.Action = r.action
+		d.Delay = r.delay.Milliseconds()
+		err = r.apply(ctx, c, sessionID, e, &d)
+	}
+	if err != nil {
+		d.Error = err.Error()
+	}
+	d.Time = time.Now().UTC().Format(time.RFC3339Nano)
+	return d
+}
+
+func (r *Rule) apply(ctx context.Context, c *internal.Client, sessionID string, e *fetch.RequestPausedEvent, d *Decision) error {
+	if r.delay > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(r.delay):
+		}
+	}
+	switch r.action {
+	case ActionFail:
+		return fetch.FailRequest(ctx, c, sessionID, &fetch.FailRequestParams{RequestID: e.RequestID, ErrorReason: network.ErrorReason(r.Fail)})
+	case ActionFulfill:
+		status := r.Status
+		if status == 0 && e.ResponseStatusCode != nil {
+			status = *e.ResponseStatusCode
+		}
+		if status == 0 {
+			status = 200
+		}
+		d.Status = status
+		original := e.ResponseHeaders
+		body := r.body
+		if r.Stage == StageResponse {
+			original = slices.DeleteFunc(slices.Clone(original), func(h fetch.HeaderEntry) bool {
+				return strings.EqualFold(h.Name, "Content-Length") || strings.EqualFold(h.Name, "Content-Encoding")
+			})
+			if r.Body == "" && r.File == "" {
+				var err error
+				body, err = responseBody(ctx, c, sessionID, e)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		headers := r.editHeaders(original)
+		if r.File != "" && !hasHeader(headers, "Content-Type") {
+			if ct := mime.TypeByExtension(filepath.Ext(r.File)); ct != "" {
+				headers = append(headers, fetch.HeaderEntry{Name: "Content-Type", Value: ct})
+			}
+		}
+		params := &fetch.FulfillRequestParams{RequestID: e.RequestID, ResponseCode: status, ResponseHeaders: headers}
+		if len(body) > 0 {
+			params.Body = base64.StdEncoding.EncodeToString(body)
+		}
+		return fetch.FulfillRequest(ctx, c, sessionID, params)
+	}
+	if r.Headers == nil && r.RemoveHeaders == nil {
+		return fetch.ContinueRequest(ctx, c, sessionID, &fetch.ContinueRequestParams{RequestID: e.RequestID})
+	}
+	if r.Stage == StageResponse {
+		return fetch.ContinueResponse(ctx, c, sessionID, &fetch.ContinueResponseParams{RequestID: e.RequestID, ResponseHeaders: r.editHeaders(e.ResponseHeaders)})
+	}
+	var original []fetch.HeaderEntry
+	for name, value := range e.Request.Headers {
+		original = append(original, fetch.HeaderEntry{Name: name, Value: fmt.Sprint(value)})
+	}
+	slices.SortFunc(original, func(a, b fetch.HeaderEntry) int { return strings.Compare(a.Name, b.Name) })
+	return fetch.ContinueRequest(ctx, c, sessionID, &fetch.ContinueRequestParams{RequestID: e.RequestID, Headers: r.editHeaders(original)})
+}
+
+func responseBody(ctx context.Context, c *internal.Client, sessionID string, e *fetch.RequestPausedEvent) ([]byte, error) {
+	if e.ResponseStatusCode == nil || *e.ResponseStatusCode >= 300 && *e.ResponseStatusCode < 400 {
+		return nil, nil
+	}
+	result, err := fetch.GetResponseBody(ctx, c, sessionID, &fetch.GetResponseBodyParams{RequestID: e.RequestID})
+	if err != nil {
+		return nil, fmt.Errorf("getting response body: %w", err)
+	}
+	if !result.Base64Encoded {
+		return []byte(result.Body), nil
+	}
+	return base64.StdEncoding.DecodeString(result.Body)
+}
+
+func (r *Rule) editHeaders(original []fetch.HeaderEntry) []fetch.HeaderEntry {
+	var headers []fetch.HeaderEntry
+	for _, h := range original {
+		if r.Headers != nil && hasKey(r.Headers, h.Name) {
+			continue
+		}
+		if slices.ContainsFunc(r.RemoveHeaders, func(name string) bool { return strings.EqualFold(name, h.Name) }) {
+			continue
+		}
+		headers = append(headers, h)
+	}
+	names := make([]string, 0, len(r.Headers))
+	for name := range r.Headers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		headers = append(headers, fetch.HeaderEntry{Name: name, Value: r.Headers[name]})
+	}
+	return headers
+}
+
+func hasKey(m map[string]string, name string) bool {
+	for k := range m {
+		if strings.EqualFold(k, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasHeader(headers []fetch.HeaderEntry, name string) bool {
+	return slices.ContainsFunc(headers, func(h fetch.HeaderEntry) bool { return strings.EqualFold(h.Name, name) })
+}
@@ -0,0 +1,219 @@
+package intercept_test
+
+import (
+	"cdp/internal"
+	"cdp/internal/cdptest"
+	"cdp/internal/intercept"
+	"cdp/protocol/fetch"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testRules = `
+rules:
+  - name: block-ads
+    url: "*://ads.example.com/*"
+    fail: BlockedByClient
+  - name: mock-api
+    url: "*/api/*"
+    method: post
+    status: 201
+    body: '{"ok":true}'
+    headers: {Content-Type: application/json}
+  - name: stub-image
+    url: "*.png"
+    resourceType: Image
+    file: pixel.png
+  - name: tag-requests
+    url: "https://example.com/*"
+    headers: {X-Test: "1"}
+    removeHeaders: [Cookie]
+  - name: rewrite-response
+    url: "https://example.com/page"
+    stage: response
+    headers: {X-Mocked: "yes"}
+  - name: patch-response
+    url: "https://example.com/data"
+    stage: response
+    status: 203
+`
+
+func loadRules(t *testing.T, content string) ([]*intercept.Rule, error) {
+	t.Helper()
+	dir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dir, "pixel.png"), []byte("PNG"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "rules.yaml")
+	err = os.WriteFile(path, []byte(content), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return intercept.Load(path)
+}
+
+func paused(t *testing.T, raw string) *fetch.RequestPausedEvent {
+	t.Helper()
+	var e fetch.RequestPausedEvent
+	err := json.Unmarshal([]byte(raw), &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &e
+}
+
+func canonical(t *testing.T, raw []byte) string {
+	t.Helper()
+	var v any
+	err := json.Unmarshal(raw, &v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ := json.Marshal(v)
+	return string(data)
+}
+
+func TestMatch(t *testing.T) {
+	rules, err := loadRules(t, testRules)
+	if err != nil {
+		t.Fatalf("loading rules: %v", err)
+	}
+	for _, tc := range []struct {
+		event string
+		rule  string
+	}{
+		{`{"request":{"url":"https://ads.example.com/x.js","method":"GET"},"resourceType":"Script"}`, "block-ads"},
+		{`{"request":{"url":"https://example.com/api/items","method":"POST"},"resourceType":"XHR"}`, "mock-api"},
+		{`{"request":{"url":"https://example.com/api/items","method":"GET"},"resourceType":"XHR"}`, "tag-requests"},
+		{`{"request":{"url":"https://cdn.example.org/a.png","method":"GET"},"resourceType":"Image"}`, "stub-image"},
+		{`{"request":{"url":"https://cdn.example.org/a.png","method":"GET"},"resourceType":"Fetch"}`, ""},
+		{`{"request":{"url":"https://example.com/page","method":"GET"},"resourceType":"Document","responseStatusCode":200}`, "rewrite-response"},
+		{`{"request":{"url":"https://example.com/other","method":"GET"},"resourceType":"Document","responseStatusCode":200}`, ""},
+		{`{"request":{"url":"https://example.org/","method":"GET"},"resourceType":"Document"}`, ""},
+	} {
+		r := intercept.Match(rules, paused(t, tc.event))
+		got := ""
+		if r != nil {
+			got = r.Name
+		}
+		if got != tc.rule {
+			t.Errorf("%s matched %q, want %q", tc.event, got, tc.rule)
+		}
+	}
+	patterns := intercept.Patterns(rules)
+	if len(patterns) != 6 || patterns[4].RequestStage != fetch.RequestStageResponse || patterns[2].ResourceType != "Image" {
+		t.Errorf("patterns = %+v", patterns)
+	}
+}
+
+func TestLoadRejectsInvalidRules(t *testing.T) {
+	for content, want := range map[string]string{
+		"rules: []\n":                                   "has no rules",
+		"rules:\n  - {stage: later}\n":                  "stage must be request or response",
+		"rules:\n  - {delay: soon}\n":                   `invalid delay "soon"`,
+		"rules:\n  - {fail: Failed, status: 500}\n":     "fail cannot be combined",
+		"rules:\n  - {fail: Broken}\n":                  `unknown fail reason "Broken"`,
+		"rules:\n  - {body: x, file: pixel.png}\n":      "mutually exclusive",
+		"rules:\n  - {name: missing, file: nope.png}\n": "missing: reading body file",
+	} {
+		_, err := loadRules(t, content)
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("%q: err = %v, want %q", content, err, want)
+		}
+	}
+}
+
+func TestApply(t *testing.T) {
+	rules, err := loadRules(t, testRules)
+	if err != nil {
+		t.Fatalf("loading rules: %v", err)
+	}
+	s := cdptest.NewServer()
+	defer s.Close()
+	calls := make(chan cdptest.Request, 1)
+	for _, method := range []string{"Fetch.continueRequest", "Fetch.continueResponse", "Fetch.fulfillRequest", "Fetch.failRequest"} {
+		s.Handle(method, func(req *cdptest.Request) (any, error) {
+			calls <- *req
+			return nil, nil
+		})
+	}
+	s.Handle("Fetch.getResponseBody", func(*cdptest.Request) (any, error) {
+		return map[string]any{"body": base64.StdEncoding.EncodeToString([]byte("upstream")), "base64Encoded": true}, nil
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	c, err := internal.NewClient(s.WsURL, false)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer c.Close()
+	for _, tc := range []struct {
+		event  string
+		method string
+		params string
+		action string
+		status int64
+	}{
+		{
+			event:  `{"requestId":"1","request":{"url":"https://ads.example.com/a.js","method":"GET"},"resourceType":"Script"}`,
+			method: "Fetch.failRequest",
+			params: `{"errorReason":"BlockedByClient","requestId":"1"}`,
+			action: intercept.ActionFail,
+		},
+		{
+			event:  `{"requestId":"2","request":{"url":"https://example.com/api/x","method":"POST"},"resourceType":"XHR"}`,
+			method: "Fetch.fulfillRequest",
+			params: `{"body":"eyJvayI6dHJ1ZX0=","requestId":"2","responseCode":201,"responseHeaders":[{"name":"Content-Type","value":"application/json"}]}`,
+			action: intercept.ActionFulfill,
+			status: 201,
+		},
+		{
+			event:  `{"requestId":"3","request":{"url":"https://cdn.example.org/a.png","method":"GET"},"resourceType":"Image"}`,
+			method: "Fetch.fulfillRequest",
+			params: `{"body":"UE5H","requestId":"3","responseCode":200,"responseHeaders":[{"name":"Content-Type","value":"image/png"}]}`,
+			action: intercept.ActionFulfill,
+			status: 200,
+		},
+		{
+			event:  `{"requestId":"4","request":{"url":"https://example.com/","method":"GET","headers":{"Cookie":"a=1","Accept":"*/*"}},"resourceType":"Document"}`,
+			method: "Fetch.continueRequest",
+			params: `{"headers":[{"name":"Accept","value":"*/*"},{"name":"X-Test","value":"1"}],"requestId":"4"}`,
+			action: intercept.ActionContinue,
+		},
+		{
+			event:  `{"requestId":"5","request":{"url":"https://example.com/page","method":"GET"},"resourceType":"Document","responseStatusCode":200,"responseHeaders":[{"name":"x-mocked","value":"no"},{"name":"Date","value":"today"}]}`,
+			method: "Fetch.continueResponse",
+			params: `{"requestId":"5","responseHeaders":[{"name":"Date","value":"today"},{"name":"X-Mocked","value":"yes"}]}`,
+			action: intercept.ActionContinue,
+		},
+		{
+			event:  `{"requestId":"6","request":{"url":"https://example.com/data","method":"GET"},"resourceType":"XHR","responseStatusCode":200,"responseHeaders":[{"name":"Content-Encoding","value":"gzip"},{"name":"Content-Length","value":"20"},{"name":"ETag","value":"x"}]}`,
+			method: "Fetch.fulfillRequest",
+			params: `{"body":"dXBzdHJlYW0=","requestId":"6","responseCode":203,"responseHeaders":[{"name":"ETag","value":"x"}]}`,
+			action: intercept.ActionFulfill,
+			status: 203,
+		},
+		{
+			event:  `{"requestId":"7","request":{"url":"https://example.org/","method":"GET"},"resourceType":"Document"}`,
+			method: "Fetch.continueRequest",
+			params: `{"requestId":"7"}`,
+			action: intercept.ActionContinue,
+		},
+	} {
+		d := intercept.Apply(ctx, c, "", rules, paused(t, tc.event))
+		if d.Error != "" || d.Action != tc.action || d.Status != tc.status {
+			t.Errorf("%s: decision %+v", tc.event, d)
+		}
+		call := <-calls
+		if call.Method != tc.method || canonical(t, call.Params) != canonical(t, []byte(tc.params)) {
+			t.Errorf("%s: sent %s %s, want %s %s", tc.event, call.Method, call.Params, tc.method, tc.params)
+		}
+	}
+}
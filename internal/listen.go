@@ -0,0 +1,201 @@
+package internal
+
+import (
+	"cdp/internal/utility"
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"sync"
+)
+
+type ListenOptions struct {
+	Target       string
+	Domains      []string
+	EnableParams map[string]json.RawMessage
+	Pre          []Command
+	AutoAttach   bool
+	Client       ClientOptions
+}
+
+type ListenEvent struct {
+	*CDPMessage
+	Target *TargetInfo
+}
+
+type attachedEvent struct {
+	SessionID          string     `json:"sessionId"`
+	TargetInfo         TargetInfo `json:"targetInfo"`
+	WaitingForDebugger bool       `json:"waitingForDebugger"`
+}
+
+type listenSetup struct {
+	sessionID string
+	info      *TargetInfo
+	err       error
+}
+
+func Listen(ctx context.Context, wsURL string, opts ListenOptions, eventCh chan<- *ListenEvent) error {
+	var children sync.WaitGroup
+	defer children.Wait()
+	clientOpts := opts.Client
+	clientOpts.Events = true
+	conn, err := Dial(wsURL, clientOpts)
+	if err != nil {
+		return utility.ErrRuntime("connecting: %v", err)
+	}
+	defer conn.Close()
+	setupCh := make(chan listenSetup, 1)
+	go func() {
+		setupCh <- setupListen(ctx, conn, opts)
+	}()
+	targets := make(map[string]*TargetInfo)
+	emit := func(event *CDPMessage) bool {
+		if opts.AutoAttach && strings.HasPrefix(event.Method, "Target.") {
+			switch event.Method {
+			case "Target.attachedToTarget":
+				var attached attachedEvent
+				err := json.Unmarshal(event.Params, &attached)
+				if err != nil {
+					utility.Term.Info("decoding %s: %v\n", event.Method, err)
+					return true
+				}
+				targets[attached.SessionID] = &attached.TargetInfo
+				children.Go(func() {
+					setupChild(ctx, conn, opts, attached)
+				})
+			case "Target.detachedFromTarget":
+				var detached struct {
+					SessionID string `json:"sessionId"`
+				}
+				_ = json.Unmarshal(event.Params, &detached)
+				delete(targets, detached.SessionID)
+			}
+			if !slices.Contains(opts.Domains, "Target") {
+				return true
+			}
+		}
+		info := targets[event.SessionID]
+		select {
+		case eventCh <- &ListenEvent{CDPMessage: event, Target: info}:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	var held []*CDPMessage
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case setup := <-setupCh:
+			if setup.err != nil {
+				return setup.err
+			}
+			if setup.info != nil {
+				targets[setup.sessionID] = setup.info
+			}
+			setupCh = nil
+			for _, event := range held {
+				if !emit(event) {
+					return nil
+				}
+			}
+			held = nil
+		case event, ok := <-conn.Events:
+			if !ok {
+				if setupCh != nil {
+					return (<-setupCh).err
+				}
+				return nil
+			}
+			if setupCh != nil {
+				held = append(held, event)
+				continue
+			}
+			if !emit(event) {
+				return nil
+			}
+		}
+	}
+}
+
+func setupListen(ctx context.Context, conn *Client, opts ListenOptions) listenSetup {
+	targetID, err := conn.ResolveTarget(ctx, opts.Target)
+	if err != nil {
+		return listenSetup{err: err}
+	}
+	var setup listenSetup
+	if targetID != "" {
+		setup.sessionID, err = conn.AttachToTarget(ctx, targetID)
+		if err != nil {
+			return listenSetup{err: utility.ErrRuntime("attaching to target: %w", err)}
+		}
+	}
+	if opts.AutoAttach && targetID != "" {
+		setup.info, err = conn.GetTargetInfo(ctx, targetID)
+		if err != nil {
+			utility.Term.Info("getting target info: %v\n", err)
+		}
+	}
+	err = prepareSession(ctx, conn.Send, setup.sessionID, opts.Domains, opts.EnableParams, opts.Pre)
+	if err != nil {
+		return listenSetup{err: err}
+	}
+	if opts.AutoAttach {
+		err = setAutoAttach(ctx, conn, setup.sessionID)
+		if err != nil {
+			return listenSetup{err: utility.ErrRuntime("enabling auto-attach: %v", err)}
+		}
+	}
+	return setup
+}
+
+func setAutoAttach(ctx context.Context, conn *Client, sessionID string) error {
+	params := map[string]any{"autoAttach": true, "waitForDebuggerOnStart": true, "flatten": true}
+	return conn.Call(ctx, "Target.setAutoAttach", params, sessionID, nil)
+}
+
+func setupChild(ctx context.Context, conn *Client, opts ListenOptions, attached attachedEvent) {
+	utility.Term.Info("auto-attached %s %s (%s)\n", attached.TargetInfo.Type, attached.TargetInfo.URL, attached.SessionID)
+	for _, domain := range opts.Domains {
+		err := prepareSession(ctx, conn.Send, attached.SessionID, []string{domain}, opts.EnableParams, nil)
+		if err != nil {
+			utility.Term.Info("enabling %s in child session %s: %v\n", domain, attached.SessionID, err)
+		}
+	}
+	err := setAutoAttach(ctx, conn, attached.SessionID)
+	if err != nil {
+		utility.Term.Info("auto-attach in child session %s: %v\n", attached.SessionID, err)
+	}
+	if attached.WaitingForDebugger {
+		err = conn.Call(ctx, "Runtime.runIfWaitingForDebugger", nil, attached.SessionID, nil)
+		if err != nil {
+			utility.Term.Info("resuming child session %s: %v\n", attached.SessionID, err)
+		}
+	}
+}
+
+type sendFunc func(ctx context.Context, method string, params json.RawMessage, sessionID string) (*CDPMessage, error)
+
+func prepareSession(ctx context.Context, send sendFunc, sessionID string, domains []string, enableParams map[string]json.RawMessage, pre []Command) error {
+	for _, domain := range domains {
+		resp, err := send(ctx, domain+".enable", enableParams[domain], sessionID)
+		if err != nil {
+			return utility.ErrRuntime("enabling %s: %v", domain, err)
+		}
+		if resp.Error != nil {
+			return resp.Error.Protocol(domain + ".enable")
+		}
+	}
+	for _, cmd := range pre {
+		resp, err := send(ctx, cmd.Method, cmd.Params, sessionID)
+		if err != nil {
+			return utility.ErrRuntime("sending %s: %v", cmd.Method, err)
+		}
+		if resp.Error != nil {
+			return resp.Error.Protocol(cmd.Method)
+		}
+	}
+	return nil
+}
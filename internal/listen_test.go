@@ -0,0 +1,179 @@
+package internal_test
+
+import (
+	"cdp/internal"
+	"cdp/internal/cdptest"
+	"cdp/internal/utility"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func listen(t *testing.T, s *cdptest.Server, opts internal.ListenOptions) (<-chan *internal.ListenEvent, <-chan error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	events := make(chan *internal.ListenEvent)
+	done := make(chan error, 1)
+	go func() {
+		done <- internal.Listen(ctx, s.WsURL, opts, events)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Error("listen did not return after cancel")
+		}
+	})
+	return events, done
+}
+
+func nextListenEvent(t *testing.T, events <-chan *internal.ListenEvent, done <-chan error) *internal.ListenEvent {
+	t.Helper()
+	select {
+	case event := <-events:
+		return event
+	case err := <-done:
+		t.Fatalf("listen returned early: %v", err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for event")
+	}
+	return nil
+}
+
+func TestListenBlockDeliveryDuringEnable(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	s.Handle("Network.enable", emitting("Network.requestWillBeSent", 500))
+	events, done := listen(t, s, internal.ListenOptions{
+		Target:  "first-page",
+		Domains: []string{"Network"},
+		Client:  internal.ClientOptions{Delivery: internal.DeliveryBlock, Buffer: 1},
+	})
+	for i := 0; i < 500; i++ {
+		event := nextListenEvent(t, events, done)
+		if event.Method != "Network.requestWillBeSent" {
+			t.Fatalf("unexpected event %s", event.Method)
+		}
+		var params struct {
+			Index int `json:"index"`
+		}
+		_ = json.Unmarshal(event.Params, &params)
+		if params.Index != i {
+			t.Fatalf("event %d has index %d", i, params.Index)
+		}
+	}
+}
+
+func TestListenPreErrorIsProtocolError(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	s.Handle("Page.enable", func(*cdptest.Request) (any, error) {
+		return nil, nil
+	})
+	err := internal.Listen(testContext(t), s.WsURL, internal.ListenOptions{
+		Target:  "first-page",
+		Domains: []string{"Page"},
+		Pre:     []internal.Command{{Method: "Page.missing"}},
+	}, make(chan *internal.ListenEvent))
+	e, ok := utility.AsProtocolError(err)
+	if !ok || e.Method != "Page.missing" || e.Code != -32601 {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestListenEnableErrorIsProtocolError(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	err := internal.Listen(testContext(t), s.WsURL, internal.ListenOptions{Target: "first-page", Domains: []string{"Bogus"}}, make(chan *internal.ListenEvent))
+	e, ok := utility.AsProtocolError(err)
+	if !ok || e.Method != "Bogus.enable" {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestListenAutoAttach(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	for _, method := range []string{"Page.enable", "Runtime.runIfWaitingForDebugger"} {
+		s.Handle(method, func(*cdptest.Request) (any, error) {
+			return nil, nil
+		})
+	}
+	events, done := listen(t, s, internal.ListenOptions{Target: "first-page", Domains: []string{"Page"}, AutoAttach: true})
+	var parent string
+	eventually(t, "auto-attach on the page session", func() bool {
+		for _, req := range s.Requests() {
+			if req.Method == "Target.setAutoAttach" {
+				parent = req.SessionID
+				return true
+			}
+		}
+		return false
+	})
+	child := s.AutoAttach(parent, internal.TargetInfo{Type: "iframe", URL: "https://frame.example"}, true)
+	eventually(t, "the child session to resume", func() bool {
+		for _, req := range s.Requests() {
+			if req.Method == "Runtime.runIfWaitingForDebugger" && req.SessionID == child {
+				return true
+			}
+		}
+		return false
+	})
+	s.Emit(child, "Page.frameNavigated", map[string]any{})
+	event := nextListenEvent(t, events, done)
+	if event.Method != "Page.frameNavigated" || event.SessionID != child {
+		t.Fatalf("event %s on %s, want Page.frameNavigated on %s", event.Method, event.SessionID, child)
+	}
+	if event.Target == nil || event.Target.URL != "https://frame.example" {
+		t.Fatalf("event target = %+v", event.Target)
+	}
+	s.Emit(parent, "Page.loadEventFired", map[string]any{})
+	event = nextListenEvent(t, events, done)
+	if event.Target == nil || event.Target.TargetID != "page-1" {
+		t.Fatalf("parent event target = %+v", event.Target)
+	}
+}
+
+func TestListenAutoAttachBurstWithBlockDelivery(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	for _, method := range []string{"Page.enable", "Runtime.runIfWaitingForDebugger"} {
+		s.Handle(method, func(*cdptest.Request) (any, error) {
+			return nil, nil
+		})
+	}
+	events, done := listen(t, s, internal.ListenOptions{
+		Target:     "first-page",
+		Domains:    []string{"Page"},
+		AutoAttach: true,
+		Client:     internal.ClientOptions{Delivery: internal.DeliveryBlock, Buffer: 1},
+	})
+	var parent string
+	eventually(t, "auto-attach on the page session", func() bool {
+		for _, req := range s.Requests() {
+			if req.Method == "Target.setAutoAttach" {
+				parent = req.SessionID
+				return true
+			}
+		}
+		return false
+	})
+	const children = 250
+	for range children {
+		s.AutoAttach(parent, internal.TargetInfo{Type: "iframe"}, true)
+	}
+	eventually(t, "every child session to resume", func() bool {
+		resumed := 0
+		for _, req := range s.Requests() {
+			if req.Method == "Runtime.runIfWaitingForDebugger" {
+				resumed++
+			}
+		}
+		return resumed == children
+	})
+	s.Emit(parent, "Page.loadEventFired", map[string]any{})
+	if event := nextListenEvent(t, events, done); event.Method != "Page.loadEventFired" {
+		t.Fatalf("event %s, want Page.loadEventFired", event.Method)
+	}
+}
@@ -0,0 +1,147 @@
+package protocol
+
+import (
+	"cdp/internal/utility"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func HTTPBase(wsURL string) (string, error) {
+	u, err := url.Parse(wsURL)
+	if err != nil {
+		return "", err
+	}
+	switch u.Scheme {
+	case "ws", "http":
+		u.Scheme = "http"
+	case "wss", "https":
+		u.Scheme = "https"
+	default:
+		return "", fmt.Errorf("unsupported debugger url: %s", wsURL)
+	}
+	return u.Scheme + "://" + u.Host, nil
+}
+
+func BrowserVersion(ctx context.Context, httpBase string) (string, error) {
+	url := httpBase + "/json/version"
+	utility.Term.Info("fetching browser version from %s\n", url)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	var info struct {
+		Browser string `json:"Browser"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&info)
+	if err != nil {
+		return "", err
+	}
+	return info.Browser, nil
+}
+
+func Load(ctx context.Context, wsURL string) (*Protocol, error) {
+	base, err := HTTPBase(wsURL)
+	if err != nil {
+		return nil, err
+	}
+	version, err := BrowserVersion(ctx, base)
+	if err != nil {
+		return nil, err
+	}
+	path := cachePath(version)
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err == nil {
+			p, err := Parse(data)
+			if err == nil {
+				utility.Term.Info("using cached protocol %s\n", path)
+				return p, nil
+			}
+			utility.Term.Info("ignoring corrupt protocol cache %s: %v\n", path, err)
+		}
+	}
+	p, err := Fetch(ctx, base)
+	if err != nil {
+		return nil, err
+	}
+	if path != "" {
+		err = save(path, p)
+		if err != nil {
+			utility.Term.Info("caching protocol: %v\n", err)
+		}
+	}
+	return p, nil
+}
+
+func LoadCached() (*Protocol, error) {
+	entries, err := os.ReadDir(utility.ProtocolDir)
+	if err != nil {
+		return nil, err
+	}
+	var newest string
+	var newestTime int64
+	for _, e := range entries {
+		if filepath.Ext(e.Name()) != ".json" {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		if info.ModTime().UnixNano() > newestTime {
+			newest = e.Name()
+			newestTime = info.ModTime().UnixNano()
+		}
+	}
+	if newest == "" {
+		return nil, fmt.Errorf("no cached protocol in %s", utility.ProtocolDir)
+	}
+	data, err := os.ReadFile(filepath.Join(utility.ProtocolDir, newest))
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data)
+}
+
+func cachePath(version string) string {
+	if version == "" {
+		return ""
+	}
+	name := strings.Map(func(r rune) rune {
+		if r == '/' || r == '\\' || r == ' ' || r == ':' {
+			return '_'
+		}
+		return r
+	}, version)
+	return filepath.Join(utility.ProtocolDir, name+".json")
+}
+
+func save(path string, p *Protocol) error {
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	err = os.WriteFile(tmp, data, 0644)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
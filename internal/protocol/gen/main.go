@@ -0,0 +1,535 @@
+package main
+
+import (
+	"bytes"
+	"cdp/internal/protocol"
+	"context"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var (
+	inFlag     = flag.String("in", "../reference/devtools-protocol/json/browser_protocol.json,../reference/devtools-protocol/json/js_protocol.json", "Comma-separated protocol JSON files, or the http:// address of a running browser")
+	outFlag    = flag.String("out", ".", "Output directory for the generated domain packages")
+	importFlag = flag.String("import", "cdp/protocol", "Import path of the output directory")
+)
+
+func main() {
+	flag.Parse()
+	p, err := load(*inFlag)
+	if err != nil {
+		fatal("loading protocol: %v", err)
+	}
+	g := newGenerator(p, *importFlag)
+	for _, d := range p.Domains {
+		src, err := g.domain(&d)
+		if err != nil {
+			fatal("generating %s: %v", d.Domain, err)
+		}
+		dir := filepath.Join(*outFlag, packageName(d.Domain))
+		err = os.MkdirAll(dir, 0755)
+		if err != nil {
+			fatal("%v", err)
+		}
+		err = os.WriteFile(filepath.Join(dir, packageName(d.Domain)+".go"), src, 0644)
+		if err != nil {
+			fatal("%v", err)
+		}
+	}
+}
+
+func fatal(format string, args ...any) {
+	_, _ = fmt.Fprintf(os.Stderr, "gen: "+format+"\n", args...)
+	os.Exit(1)
+}
+
+func load(in string) (*protocol.Protocol, error) {
+	if strings.HasPrefix(in, "http://") || strings.HasPrefix(in, "https://") {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		return protocol.Fetch(ctx, in)
+	}
+	return protocol.LoadFiles(strings.Split(in, ",")...)
+}
+
+type generator struct {
+	proto      *protocol.Protocol
+	importPath string
+	imports    map[string]map[string]bool
+}
+
+func newGenerator(p *protocol.Protocol, importPath string) *generator {
+	g := &generator{proto: p, importPath: importPath, imports: make(map[string]map[string]bool)}
+	g.planImports()
+	return g
+}
+
+// planImports decides which cross-domain references become package imports.
+// Domain packages may not import each other cyclically, so references that
+// would close a cycle are inlined as their underlying Go type instead.
+// Edges carrying object types are placed first so that the edges dropped are
+// mostly plain string and number aliases.
+func (g *generator) planImports() {
+	objectEdges := make(map[[2]string]bool)
+	plainEdges := make(map[[2]string]bool)
+	for _, d := range g.proto.Domains {
+		g.imports[d.Domain] = make(map[string]bool)
+		g.walkRefs(&d, func(ref string) {
+			name, _, ok := strings.Cut(ref, ".")
+			if !ok || name == d.Domain {
+				return
+			}
+			_, t := g.proto.ResolveRef(d.Domain, ref)
+			edge := [2]string{d.Domain, name}
+			if t != nil && (t.Type == "object" && len(t.Properties) > 0 || t.Type == "array") {
+				objectEdges[edge] = true
+			} else {
+				plainEdges[edge] = true
+			}
+		})
+	}
+	for _, edges := range []map[[2]string]bool{objectEdges, plainEdges} {
+		sorted := make([][2]string, 0, len(edges))
+		for edge := range edges {
+			sorted = append(sorted, edge)
+		}
+		sort.Slice(sorted, func(i, j int) bool {
+			if sorted[i][0] != sorted[j][0] {
+				return sorted[i][0] < sorted[j][0]
+			}
+			return sorted[i][1] < sorted[j][1]
+		})
+		for _, edge := range sorted {
+			if !g.reaches(edge[1], edge[0], make(map[string]bool)) {
+				g.imports[edge[0]][edge[1]] = true
+			}
+		}
+	}
+}
+
+func (g *generator) reaches(from, to string, seen map[string]bool) bool {
+	if from == to {
+		return true
+	}
+	seen[from] = true
+	for next := range g.imports[from] {
+		if !seen[next] && g.reaches(next, to, seen) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *generator) walkRefs(d *protocol.Domain, fn func(string)) {
+	var walk func(p *protocol.Property)
+	walk = func(p *protocol.Property) {
+		if p == nil {
+			return
+		}
+		if p.Ref != "" {
+			fn(p.Ref)
+		}
+		walk(p.Items)
+	}
+	walkAll := func(props []protocol.Property) {
+		for i := range props {
+			walk(&props[i])
+		}
+	}
+	for _, t := range d.Types {
+		walkAll(t.Properties)
+		walk(t.Items)
+	}
+	for _, c := range d.Commands {
+		walkAll(c.Parameters)
+		walkAll(c.Returns)
+	}
+	for _, e := range d.Events {
+		walkAll(e.Parameters)
+	}
+}
+
+type file struct {
+	domain  string
+	buf     bytes.Buffer
+	imports map[string]string
+	names   map[string]bool
+	events  [][2]string
+}
+
+func (f *file) printf(format string, args ...any) {
+	_, _ = fmt.Fprintf(&f.buf, format, args...)
+}
+
+func (f *file) comment(indent, text string, notes ...string) {
+	var lines []string
+	if text != "" {
+		lines = append(lines, strings.Split(strings.TrimSpace(text), "\n")...)
+	}
+	for _, note := range notes {
+		if note == "" {
+			continue
+		}
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, note)
+	}
+	for _, line := range lines {
+		line = strings.TrimRight(line, " \t")
+		if line == "" {
+			f.printf("%s//\n", indent)
+		} else {
+			f.printf("%s// %s\n", indent, line)
+		}
+	}
+}
+
+func (f *file) name(want string) string {
+	name := want
+	for i := 2; f.names[name]; i++ {
+		name = fmt.Sprintf("%s%d", want, i)
+	}
+	f.names[name] = true
+	return name
+}
+
+func notes(experimental, deprecated bool) []string {
+	var out []string
+	if experimental {
+		out = append(out, "Experimental.")
+	}
+	if deprecated {
+		out = append(out, "Deprecated: this item is deprecated in the protocol.")
+	}
+	return out
+}
+
+func (g *generator) domain(d *protocol.Domain) ([]byte, error) {
+	f := &file{domain: d.Domain, imports: make(map[string]string), names: make(map[string]bool)}
+	for _, t := range d.Types {
+		f.names[goName(t.ID)] = true
+	}
+	for _, t := range d.Types {
+		g.typeDecl(f, d, &t)
+	}
+	for _, c := range d.Commands {
+		g.command(f, d, &c)
+	}
+	for _, e := range d.Events {
+		g.event(f, d, &e)
+	}
+	if len(d.Events) > 0 {
+		g.parseEvent(f, d)
+	}
+	var out bytes.Buffer
+	pkg := packageName(d.Domain)
+	_, _ = fmt.Fprintf(&out, "// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.\n\n")
+	desc := fmt.Sprintf("Package %s provides typed bindings for the CDP %s domain.", pkg, d.Domain)
+	if d.Description != "" {
+		desc += "\n\n" + strings.TrimSpace(d.Description)
+	}
+	for _, line := range strings.Split(desc, "\n") {
+		line = strings.TrimRight(line, " \t")
+		if line == "" {
+			out.WriteString("//\n")
+		} else {
+			_, _ = fmt.Fprintf(&out, "// %s\n", line)
+		}
+	}
+	_, _ = fmt.Fprintf(&out, "package %s\n\n", pkg)
+	paths := make([]string, 0, len(f.imports))
+	for p := range f.imports {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	if len(paths) > 0 {
+		out.WriteString("import (\n")
+		for _, p := range paths {
+			_, _ = fmt.Fprintf(&out, "\t%q\n", p)
+		}
+		out.WriteString(")\n\n")
+	}
+	out.Write(f.buf.Bytes())
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		return out.Bytes(), err
+	}
+	return src, nil
+}
+
+func (g *generator) typeDecl(f *file, d *protocol.Domain, t *protocol.Type) {
+	name := goName(t.ID)
+	f.comment("", describe(name+" is the "+d.Domain+"."+t.ID+" type.", t.Description), notes(t.Experimental, t.Deprecated)...)
+	switch {
+	case t.Type == "object" && len(t.Properties) > 0:
+		f.printf("type %s struct {\n", name)
+		g.fields(f, d, t.Properties)
+		f.printf("}\n\n")
+	case t.Type == "object":
+		f.printf("type %s map[string]any\n\n", name)
+	case t.Type == "array":
+		f.printf("type %s []%s\n\n", name, g.goType(f, d.Domain, d.Domain, t.Items, false))
+	case t.Type == "any":
+		f.imports["encoding/json"] = "json"
+		f.printf("type %s = json.RawMessage\n\n", name)
+	default:
+		f.printf("type %s %s\n\n", name, primitive(t.Type))
+		if len(t.Enum) > 0 {
+			f.printf("const (\n")
+			for _, v := range t.Enum {
+				f.printf("\t%s %s = %q\n", f.name(name+enumName(v)), name, v)
+			}
+			f.printf(")\n\n")
+		}
+	}
+}
+
+func (g *generator) fields(f *file, d *protocol.Domain, props []protocol.Property) {
+	for i := range props {
+		p := &props[i]
+		f.comment("\t", p.Description, notes(p.Experimental, p.Deprecated)...)
+		tag := p.Name
+		if p.Optional {
+			tag += ",omitempty"
+		}
+		f.printf("\t%s %s `json:%q`\n", goName(p.Name), g.goType(f, d.Domain, d.Domain, p, p.Optional), tag)
+	}
+}
+
+func (g *generator) goType(f *file, domain, scope string, p *protocol.Property, optional bool) string {
+	if p.Ref != "" {
+		refDomain, t := g.proto.ResolveRef(scope, p.Ref)
+		if t == nil {
+			f.imports["encoding/json"] = "json"
+			return "json.RawMessage"
+		}
+		ptr := ""
+		if optional && (t.Type == "object" && len(t.Properties) > 0 || isScalar(t.Type)) {
+			ptr = "*"
+		}
+		if refDomain.Domain == domain {
+			return ptr + goName(t.ID)
+		}
+		if g.imports[domain][refDomain.Domain] {
+			pkg := packageName(refDomain.Domain)
+			f.imports[path.Join(g.importPath, pkg)] = pkg
+			return ptr + pkg + "." + goName(t.ID)
+		}
+		switch {
+		case t.Type == "object" && len(t.Properties) > 0, t.Type == "any":
+			f.imports["encoding/json"] = "json"
+			return "json.RawMessage"
+		case t.Type == "object":
+			return "map[string]any"
+		case t.Type == "array":
+			return "[]" + g.goType(f, domain, refDomain.Domain, t.Items, false)
+		}
+		return ptr + primitive(t.Type)
+	}
+	switch p.Type {
+	case "array":
+		return "[]" + g.goType(f, domain, scope, p.Items, false)
+	case "object":
+		return "map[string]any"
+	case "any", "":
+		f.imports["encoding/json"] = "json"
+		return "json.RawMessage"
+	}
+	if optional && isScalar(p.Type) {
+		return "*" + primitive(p.Type)
+	}
+	return primitive(p.Type)
+}
+
+func (g *generator) command(f *file, d *protocol.Domain, c *protocol.Command) {
+	f.imports["context"] = "context"
+	f.imports["cdp/internal"] = "internal"
+	method := d.Domain + "." + c.Name
+	name := f.name(goName(c.Name))
+	var paramsName, resultName string
+	if len(c.Parameters) > 0 {
+		paramsName = f.name(name + "Params")
+		f.printf("// %s holds the parameters of %s.\n", paramsName, method)
+		f.printf("type %s struct {\n", paramsName)
+		g.fields(f, d, c.Parameters)
+		f.printf("}\n\n")
+	}
+	if len(c.Returns) > 0 {
+		resultName = f.name(name + "Result")
+		f.printf("// %s holds the result of %s.\n", resultName, method)
+		f.printf("type %s struct {\n", resultName)
+		g.fields(f, d, c.Returns)
+		f.printf("}\n\n")
+	}
+	var extra []string
+	if c.Redirect != "" {
+		extra = append(extra, fmt.Sprintf("Redirects to %s.", c.Redirect))
+	}
+	f.comment("", describe(name+" calls "+method+".", c.Description), append(notes(c.Experimental, c.Deprecated), extra...)...)
+	args := "ctx context.Context, c *internal.Client, sessionID string"
+	if paramsName != "" {
+		args += ", params *" + paramsName
+	}
+	if resultName == "" {
+		f.printf("func %s(%s) error {\n", name, args)
+	} else {
+		f.printf("func %s(%s) (*%s, error) {\n", name, args, resultName)
+	}
+	callParams := "nil"
+	if paramsName != "" {
+		f.printf("\tif params == nil {\n\t\tparams = &%s{}\n\t}\n", paramsName)
+		callParams = "params"
+	}
+	if resultName == "" {
+		f.printf("\treturn c.Call(ctx, %q, %s, sessionID, nil)\n}\n\n", method, callParams)
+		return
+	}
+	f.printf("\tvar result %s\n", resultName)
+	f.printf("\terr := c.Call(ctx, %q, %s, sessionID, &result)\n", method, callParams)
+	f.printf("\tif err != nil {\n\t\treturn nil, err\n\t}\n\treturn &result, nil\n}\n\n")
+}
+
+func (g *generator) event(f *file, d *protocol.Domain, e *protocol.Event) {
+	name := f.name(goName(e.Name) + "Event")
+	constName := f.name("Event" + goName(e.Name))
+	f.events = append(f.events, [2]string{constName, name})
+	f.printf("// %s is the method name of the %s event.\n", constName, d.Domain+"."+e.Name)
+	f.printf("const %s = %q\n\n", constName, d.Domain+"."+e.Name)
+	f.comment("", describe(name+" is the payload of the "+d.Domain+"."+e.Name+" event.", e.Description), notes(e.Experimental, e.Deprecated)...)
+	if len(e.Parameters) == 0 {
+		f.printf("type %s struct{}\n\n", name)
+		return
+	}
+	f.printf("type %s struct {\n", name)
+	g.fields(f, d, e.Parameters)
+	f.printf("}\n\n")
+}
+
+func (g *generator) parseEvent(f *file, d *protocol.Domain) {
+	f.imports["encoding/json"] = "json"
+	f.imports["fmt"] = "fmt"
+	f.printf("// ParseEvent decodes a %s event message into its typed event struct.\n", d.Domain)
+	f.printf("func ParseEvent(msg *internal.CDPMessage) (any, error) {\n")
+	f.printf("\tvar v any\n\tswitch msg.Method {\n")
+	for _, e := range f.events {
+		f.printf("\tcase %s:\n\t\tv = &%s{}\n", e[0], e[1])
+	}
+	f.printf("\tdefault:\n\t\treturn nil, fmt.Errorf(\"unknown %s event: %%s\", msg.Method)\n\t}\n", d.Domain)
+	f.printf("\tif msg.Params == nil {\n\t\treturn v, nil\n\t}\n")
+	f.printf("\terr := json.Unmarshal(msg.Params, v)\n\tif err != nil {\n\t\treturn nil, err\n\t}\n\treturn v, nil\n}\n\n")
+	f.imports["cdp/internal"] = "internal"
+}
+
+func describe(header, description string) string {
+	description = strings.TrimSpace(description)
+	if description == "" {
+		return header
+	}
+	return header + "\n\n" + description
+}
+
+func isScalar(t string) bool {
+	return t == "integer" || t == "number" || t == "boolean"
+}
+
+func primitive(t string) string {
+	switch t {
+	case "integer":
+		return "int64"
+	case "number":
+		return "float64"
+	case "boolean":
+		return "bool"
+	}
+	return "string"
+}
+
+func packageName(domain string) string {
+	return strings.ToLower(domain)
+}
+
+var initialisms = map[string]string{
+	"api": "API", "cpu": "CPU", "css": "CSS", "dom": "DOM", "gpu": "GPU",
+	"html": "HTML", "http": "HTTP", "https": "HTTPS", "id": "ID", "ids": "IDs",
+	"ip": "IP", "js": "JS", "json": "JSON", "ssl": "SSL", "tls": "TLS",
+	"ui": "UI", "uri": "URI", "url": "URL", "urls": "URLs", "uuid": "UUID", "xml": "XML",
+}
+
+func words(s string) []string {
+	var out []string
+	runes := []rune(s)
+	start := 0
+	for start < len(runes) && !unicode.IsLetter(runes[start]) && !unicode.IsDigit(runes[start]) {
+		start++
+	}
+	for i := start + 1; i <= len(runes); i++ {
+		if i == len(runes) {
+			if i > start {
+				out = append(out, string(runes[start:i]))
+			}
+			break
+		}
+		prev, cur := runes[i-1], runes[i]
+		split := false
+		switch {
+		case !unicode.IsLetter(cur) && !unicode.IsDigit(cur):
+			if i > start {
+				out = append(out, string(runes[start:i]))
+			}
+			start = i + 1
+			continue
+		case unicode.IsLower(prev) && unicode.IsUpper(cur):
+			split = true
+		case unicode.IsUpper(prev) && unicode.IsUpper(cur) && i+1 < len(runes) && unicode.IsLower(runes[i+1]):
+			split = true
+		}
+		if split && i > start {
+			out = append(out, string(runes[start:i]))
+			start = i
+		}
+	}
+	return out
+}
+
+func camel(s string) string {
+	var b strings.Builder
+	for _, w := range words(s) {
+		upper, ok := initialisms[strings.ToLower(w)]
+		if ok && (w == strings.ToLower(w) || w == strings.ToUpper(w) || w[1:] == strings.ToLower(w[1:])) {
+			b.WriteString(upper)
+			continue
+		}
+		r := []rune(w)
+		r[0] = unicode.ToUpper(r[0])
+		b.WriteString(string(r))
+	}
+	return b.String()
+}
+
+func goName(s string) string {
+	name := camel(s)
+	if name == "" || unicode.IsDigit([]rune(name)[0]) {
+		name = "X" + name
+	}
+	return name
+}
+
+func enumName(v string) string {
+	name := camel(v)
+	if strings.HasPrefix(v, "-") {
+		name = "Minus" + name
+	}
+	if name == "" {
+		return "Empty"
+	}
+	return name
+}
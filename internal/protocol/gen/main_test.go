@@ -0,0 +1,134 @@
+package main
+
+import (
+	"cdp/internal/protocol"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+const testProtocol = `{
+	"version": {"major": "1", "minor": "3"},
+	"domains": [
+		{
+			"domain": "Alpha",
+			"description": "Alpha things.",
+			"types": [
+				{"id": "Node", "type": "object", "properties": [
+					{"name": "nodeId", "type": "integer"},
+					{"name": "beta", "$ref": "Beta.Frame", "optional": true}
+				]},
+				{"id": "Mode", "type": "string", "enum": ["fast", "slow-ish", "-1"]}
+			],
+			"commands": [
+				{"name": "getNode", "parameters": [{"name": "nodeId", "type": "integer"}], "returns": [{"name": "node", "$ref": "Node"}]},
+				{"name": "enable"}
+			],
+			"events": [
+				{"name": "nodeAdded", "parameters": [{"name": "node", "$ref": "Node"}]}
+			]
+		},
+		{
+			"domain": "Beta",
+			"types": [
+				{"id": "Frame", "type": "object", "properties": [{"name": "url", "type": "string"}]},
+				{"id": "FrameId", "type": "string"}
+			],
+			"commands": [
+				{"name": "reload", "parameters": [
+					{"name": "mode", "$ref": "Alpha.Mode", "optional": true},
+					{"name": "ignoreCache", "type": "boolean", "optional": true}
+				]}
+			]
+		}
+	]
+}`
+
+var spaces = regexp.MustCompile(`[ \t]+`)
+
+func generate(t *testing.T) map[string]string {
+	t.Helper()
+	p, err := protocol.Parse([]byte(testProtocol))
+	if err != nil {
+		t.Fatalf("parsing: %v", err)
+	}
+	g := newGenerator(p, "example/protocol")
+	out := make(map[string]string)
+	for _, d := range p.Domains {
+		src, err := g.domain(&d)
+		if err != nil {
+			t.Fatalf("generating %s: %v\n%s", d.Domain, err, src)
+		}
+		out[d.Domain] = spaces.ReplaceAllString(string(src), " ")
+	}
+	return out
+}
+
+func TestDomain(t *testing.T) {
+	src := generate(t)
+	for domain, want := range map[string][]string{
+		"Alpha": {
+			"// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.",
+			"package alpha",
+			`"example/protocol/beta"`,
+			"Beta *beta.Frame `json:\"beta,omitempty\"`",
+			`ModeSlowIsh Mode = "slow-ish"`,
+			`ModeMinus1 Mode = "-1"`,
+			"func GetNode(ctx context.Context, c *internal.Client, sessionID string, params *GetNodeParams) (*GetNodeResult, error) {",
+			"func Enable(ctx context.Context, c *internal.Client, sessionID string) error {",
+			`const EventNodeAdded = "Alpha.nodeAdded"`,
+			"case EventNodeAdded:",
+		},
+		"Beta": {
+			"package beta",
+			"Mode string `json:\"mode,omitempty\"`",
+			"IgnoreCache *bool `json:\"ignoreCache,omitempty\"`",
+			"type FrameID string",
+		},
+	} {
+		for _, line := range want {
+			if !strings.Contains(src[domain], line) {
+				t.Errorf("%s output is missing %s\n%s", domain, line, src[domain])
+			}
+		}
+	}
+	if strings.Contains(src["Beta"], "example/protocol/alpha") {
+		t.Errorf("Beta imports Alpha, closing an import cycle")
+	}
+	if strings.Contains(src["Beta"], "ParseEvent") {
+		t.Errorf("Beta has no events but got ParseEvent")
+	}
+}
+
+func TestGoName(t *testing.T) {
+	for in, want := range map[string]string{
+		"nodeId":            "NodeID",
+		"backendNodeIds":    "BackendNodeIDs",
+		"DOMStorage":        "DOMStorage",
+		"requestURL":        "RequestURL",
+		"getHTMLContent":    "GetHTMLContent",
+		"Api":               "API",
+		"3d":                "X3d",
+		"cache-control":     "CacheControl",
+		"setCPUThrottling":  "SetCPUThrottling",
+		"isolatedContextId": "IsolatedContextID",
+	} {
+		if got := goName(in); got != want {
+			t.Errorf("goName(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestEnumName(t *testing.T) {
+	for in, want := range map[string]string{
+		"":          "Empty",
+		"-0":        "Minus0",
+		"x-large":   "XLarge",
+		"trailing-": "Trailing",
+		"Infinity":  "Infinity",
+	} {
+		if got := enumName(in); got != want {
+			t.Errorf("enumName(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
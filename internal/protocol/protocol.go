@@ -0,0 +1,172 @@
+package protocol
+
+import (
+	"cdp/internal/utility"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+)
+
+type Protocol struct {
+	Version Version  `json:"version"`
+	Domains []Domain `json:"domains"`
+}
+
+type Version struct {
+	Major string `json:"major"`
+	Minor string `json:"minor"`
+}
+
+type Domain struct {
+	Domain       string    `json:"domain"`
+	Description  string    `json:"description,omitempty"`
+	Experimental bool      `json:"experimental,omitempty"`
+	Deprecated   bool      `json:"deprecated,omitempty"`
+	Dependencies []string  `json:"dependencies,omitempty"`
+	Types        []Type    `json:"types,omitempty"`
+	Commands     []Command `json:"commands,omitempty"`
+	Events       []Event   `json:"events,omitempty"`
+}
+
+type Type struct {
+	ID           string     `json:"id"`
+	Description  string     `json:"description,omitempty"`
+	Experimental bool       `json:"experimental,omitempty"`
+	Deprecated   bool       `json:"deprecated,omitempty"`
+	Type         string     `json:"type"`
+	Enum         []string   `json:"enum,omitempty"`
+	Properties   []Property `json:"properties,omitempty"`
+	Items        *Property  `json:"items,omitempty"`
+}
+
+type Property struct {
+	Name         string    `json:"name,omitempty"`
+	Description  string    `json:"description,omitempty"`
+	Experimental bool      `json:"experimental,omitempty"`
+	Deprecated   bool      `json:"deprecated,omitempty"`
+	Optional     bool      `json:"optional,omitempty"`
+	Type         string    `json:"type,omitempty"`
+	Ref          string    `json:"$ref,omitempty"`
+	Enum         []string  `json:"enum,omitempty"`
+	Items        *Property `json:"items,omitempty"`
+}
+
+type Command struct {
+	Name         string     `json:"name"`
+	Description  string     `json:"description,omitempty"`
+	Experimental bool       `json:"experimental,omitempty"`
+	Deprecated   bool       `json:"deprecated,omitempty"`
+	Redirect     string     `json:"redirect,omitempty"`
+	Parameters   []Property `json:"parameters,omitempty"`
+	Returns      []Property `json:"returns,omitempty"`
+}
+
+type Event struct {
+	Name         string     `json:"name"`
+	Description  string     `json:"description,omitempty"`
+	Experimental bool       `json:"experimental,omitempty"`
+	Deprecated   bool       `json:"deprecated,omitempty"`
+	Parameters   []Property `json:"parameters,omitempty"`
+}
+
+func Parse(data []byte) (*Protocol, error) {
+	var p Protocol
+	err := json.Unmarshal(data, &p)
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+func LoadFiles(paths ...string) (*Protocol, error) {
+	merged := &Protocol{}
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		p, err := Parse(data)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %v", path, err)
+		}
+		merged.Merge(p)
+	}
+	return merged, nil
+}
+
+func Fetch(ctx context.Context, debuggerURL string) (*Protocol, error) {
+	url := strings.TrimSuffix(debuggerURL, "/")
+	if !strings.HasSuffix(url, "/json/protocol") {
+		url += "/json/protocol"
+	}
+	utility.Term.Info("fetching protocol from %s\n", url)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching %s: %s", url, resp.Status)
+	}
+	var p Protocol
+	err = json.NewDecoder(resp.Body).Decode(&p)
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+func (p *Protocol) Merge(other *Protocol) {
+	if p.Version.Major == "" {
+		p.Version = other.Version
+	}
+	p.Domains = append(p.Domains, other.Domains...)
+}
+
+func (p *Protocol) Domain(name string) *Domain {
+	for i := range p.Domains {
+		if p.Domains[i].Domain == name {
+			return &p.Domains[i]
+		}
+	}
+	return nil
+}
+
+func (d *Domain) Type(id string) *Type {
+	for i := range d.Types {
+		if d.Types[i].ID == id {
+			return &d.Types[i]
+		}
+	}
+	return nil
+}
+
+func (d *Domain) Command(name string) *Command {
+	for i := range d.Commands {
+		if d.Commands[i].Name == name {
+			return &d.Commands[i]
+		}
+	}
+	return nil
+}
+
+func (p *Protocol) ResolveRef(domain, ref string) (*Domain, *Type) {
+	name, id, ok := strings.Cut(ref, ".")
+	if !ok {
+		name, id = domain, ref
+	}
+	d := p.Domain(name)
+	if d == nil {
+		return nil, nil
+	}
+	return d, d.Type(id)
+}
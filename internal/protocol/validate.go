@@ -0,0 +1,202 @@
+package protocol
+
+import (
+	"bytes"
+	"cdp/internal/utility"
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+)
+
+func (p *Protocol) Methods() []string {
+	var methods []string
+	for _, d := range p.Domains {
+		for _, c := range d.Commands {
+			methods = append(methods, d.Domain+"."+c.Name)
+		}
+	}
+	sort.Strings(methods)
+	return methods
+}
+
+func (p *Protocol) DomainNames() []string {
+	names := make([]string, 0, len(p.Domains))
+	for _, d := range p.Domains {
+		names = append(names, d.Domain)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (p *Protocol) Validate(method string, params json.RawMessage) ([]string, error) {
+	domainName, commandName, ok := strings.Cut(method, ".")
+	if !ok || domainName == "" || commandName == "" {
+		return nil, withSuggestion(utility.ErrUser("invalid method %q: expected Domain.method", method), method, p.Methods())
+	}
+	d := p.Domain(domainName)
+	if d == nil {
+		return nil, withSuggestion(utility.ErrUser("unknown method %s", method), method, p.Methods())
+	}
+	c := d.Command(commandName)
+	if c == nil {
+		return nil, withSuggestion(utility.ErrUser("unknown method %s", method), method, p.Methods())
+	}
+	params = bytes.TrimSpace(params)
+	if len(params) == 0 || string(params) == "null" {
+		params = json.RawMessage("{}")
+	}
+	var obj map[string]json.RawMessage
+	err := json.Unmarshal(params, &obj)
+	if err != nil {
+		return nil, utility.ErrUser("%s: params must be a JSON object", method)
+	}
+	v := validator{proto: p, method: method}
+	err = v.object(d.Domain, "", c.Parameters, obj)
+	if err != nil {
+		return nil, err
+	}
+	return v.warnings, nil
+}
+
+func withSuggestion(err error, word string, candidates []string) error {
+	suggestion := utility.Suggest(word, candidates)
+	if suggestion == "" {
+		return err
+	}
+	return utility.ErrUser("%v (did you mean %s?)", err, suggestion)
+}
+
+type validator struct {
+	proto    *Protocol
+	method   string
+	warnings []string
+}
+
+func (v *validator) fail(path, format string, args ...any) error {
+	return utility.ErrUser("%s: param %s: %s", v.method, path, fmt.Sprintf(format, args...))
+}
+
+func (v *validator) object(domain, prefix string, props []Property, obj map[string]json.RawMessage) error {
+	names := make([]string, 0, len(props))
+	for _, prop := range props {
+		names = append(names, prop.Name)
+		raw, ok := obj[prop.Name]
+		if !ok {
+			if !prop.Optional {
+				return v.fail(prefix+prop.Name, "required but missing")
+			}
+			continue
+		}
+		err := v.value(domain, prefix+prop.Name, &prop, raw)
+		if err != nil {
+			return err
+		}
+	}
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if !slices.Contains(names, key) {
+			v.warnings = append(v.warnings, withSuggestion(v.fail(prefix+key, "unknown parameter"), key, names).Error())
+		}
+	}
+	return nil
+}
+
+func (v *validator) value(domain, path string, prop *Property, raw json.RawMessage) error {
+	if prop.Ref != "" {
+		refDomain, t := v.proto.ResolveRef(domain, prop.Ref)
+		if t == nil {
+			return nil
+		}
+		return v.typed(refDomain.Domain, path, t.Type, t.Enum, t.Properties, t.Items, raw)
+	}
+	return v.typed(domain, path, prop.Type, prop.Enum, nil, prop.Items, raw)
+}
+
+func (v *validator) typed(domain, path, typ string, enum []string, props []Property, items *Property, raw json.RawMessage) error {
+	var value any
+	err := json.Unmarshal(raw, &value)
+	if err != nil {
+		return v.fail(path, "invalid JSON")
+	}
+	switch typ {
+	case "string":
+		s, ok := value.(string)
+		if !ok {
+			return v.fail(path, "expected string, got %s", jsonKind(value))
+		}
+		if len(enum) > 0 && !slices.Contains(enum, s) {
+			return withSuggestion(v.fail(path, "invalid value %q, expected one of: %s", s, strings.Join(enum, ", ")), s, enum)
+		}
+	case "integer":
+		n, ok := value.(float64)
+		if !ok || n != math.Trunc(n) {
+			return v.fail(path, "expected integer, got %s", jsonKind(value))
+		}
+	case "number":
+		_, ok := value.(float64)
+		if !ok {
+			return v.fail(path, "expected number, got %s", jsonKind(value))
+		}
+	case "boolean":
+		_, ok := value.(bool)
+		if !ok {
+			return v.fail(path, "expected boolean, got %s", jsonKind(value))
+		}
+	case "array":
+		list, ok := value.([]any)
+		if !ok {
+			return v.fail(path, "expected array, got %s", jsonKind(value))
+		}
+		if items == nil {
+			return nil
+		}
+		var rawItems []json.RawMessage
+		_ = json.Unmarshal(raw, &rawItems)
+		for i := range list {
+			err := v.value(domain, fmt.Sprintf("%s[%d]", path, i), items, rawItems[i])
+			if err != nil {
+				return err
+			}
+		}
+	case "object":
+		_, ok := value.(map[string]any)
+		if !ok {
+			return v.fail(path, "expected object, got %s", jsonKind(value))
+		}
+		if len(props) == 0 {
+			return nil
+		}
+		var obj map[string]json.RawMessage
+		_ = json.Unmarshal(raw, &obj)
+		return v.object(domain, path+".", props, obj)
+	}
+	return nil
+}
+
+func jsonKind(value any) string {
+	switch n := value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case float64:
+		if n == math.Trunc(n) {
+			return "integer"
+		}
+		return "number"
+	case bool:
+		return "boolean"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	}
+	return "unknown"
+}
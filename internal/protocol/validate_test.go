@@ -0,0 +1,99 @@
+package protocol_test
+
+import (
+	"cdp/internal/protocol"
+	"cdp/internal/utility"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+const testSchema = `{
+	"version": {"major": "1", "minor": "3"},
+	"domains": [
+		{
+			"domain": "DOM",
+			"types": [
+				{"id": "NodeId", "type": "integer"},
+				{"id": "Rect", "type": "object", "properties": [
+					{"name": "x", "type": "number"},
+					{"name": "y", "type": "number"}
+				]}
+			],
+			"commands": [
+				{"name": "enable"},
+				{"name": "focus", "parameters": [
+					{"name": "nodeId", "$ref": "NodeId"},
+					{"name": "selector", "type": "string", "optional": true}
+				]},
+				{"name": "highlight", "parameters": [
+					{"name": "rects", "type": "array", "items": {"$ref": "Rect"}},
+					{"name": "mode", "type": "string", "enum": ["fill", "outline"], "optional": true},
+					{"name": "visible", "type": "boolean", "optional": true}
+				]}
+			]
+		},
+		{
+			"domain": "Page",
+			"commands": [
+				{"name": "navigate", "parameters": [
+					{"name": "url", "type": "string"},
+					{"name": "frameId", "$ref": "DOM.NodeId", "optional": true}
+				]}
+			]
+		}
+	]
+}`
+
+func TestValidate(t *testing.T) {
+	schema, err := protocol.Parse([]byte(testSchema))
+	if err != nil {
+		t.Fatalf("parsing schema: %v", err)
+	}
+	for _, tc := range []struct {
+		method  string
+		params  string
+		err     string
+		warning string
+	}{
+		{method: "DOM.enable"},
+		{method: "DOM.enable", params: "null"},
+		{method: "DOM.focus", params: `{"nodeId": 3}`},
+		{method: "DOM.focus", params: `{"nodeId": 3, "selector": "a"}`},
+		{method: "Page.navigate", params: `{"url": "https://example.com", "frameId": 1}`},
+		{method: "DOM.highlight", params: `{"rects": [{"x": 1, "y": 2.5}], "mode": "fill"}`},
+		{method: "DOM", err: `invalid method "DOM": expected Domain.method`},
+		{method: "Foo.enable", err: "unknown method Foo.enable"},
+		{method: "DOM.enabel", err: "unknown method DOM.enabel (did you mean DOM.enable?)"},
+		{method: "DOM.focus", params: `[1]`, err: "DOM.focus: params must be a JSON object"},
+		{method: "DOM.focus", params: `{}`, err: "DOM.focus: param nodeId: required but missing"},
+		{method: "DOM.focus", params: `{"nodeId": 1.5}`, err: "param nodeId: expected integer, got number"},
+		{method: "DOM.focus", params: `{"nodeId": "1"}`, err: "param nodeId: expected integer, got string"},
+		{method: "DOM.focus", params: `{"nodeId": 1, "selector": 2}`, err: "param selector: expected string, got integer"},
+		{method: "Page.navigate", params: `{"url": "x", "frameId": true}`, err: "param frameId: expected integer, got boolean"},
+		{method: "DOM.highlight", params: `{"rects": {}}`, err: "param rects: expected array, got object"},
+		{method: "DOM.highlight", params: `{"rects": [{"x": 1}]}`, err: "param rects[0].y: required but missing"},
+		{method: "DOM.highlight", params: `{"rects": [{"x": "1", "y": 2}]}`, err: "param rects[0].x: expected number, got string"},
+		{method: "DOM.highlight", params: `{"rects": [], "mode": "fil"}`, err: `param mode: invalid value "fil", expected one of: fill, outline (did you mean fill?)`},
+		{method: "DOM.highlight", params: `{"rects": [], "visible": "yes"}`, err: "param visible: expected boolean, got string"},
+		{method: "DOM.focus", params: `{"nodeId": 1, "selectr": "a"}`, warning: "DOM.focus: param selectr: unknown parameter (did you mean selector?)"},
+		{method: "DOM.highlight", params: `{"rects": [{"x": 1, "y": 2, "z": 3}]}`, warning: "param rects[0].z: unknown parameter"},
+	} {
+		warnings, err := schema.Validate(tc.method, json.RawMessage(tc.params))
+		name := tc.method + " " + tc.params
+		switch {
+		case tc.err == "" && err != nil:
+			t.Errorf("%s: unexpected error %v", name, err)
+		case tc.err != "" && (err == nil || !strings.Contains(err.Error(), tc.err)):
+			t.Errorf("%s: error %v, want %q", name, err, tc.err)
+		case tc.err != "" && !utility.IsUserError(err):
+			t.Errorf("%s: error %v is not a user error", name, err)
+		}
+		switch {
+		case tc.warning == "" && len(warnings) > 0:
+			t.Errorf("%s: unexpected warnings %v", name, warnings)
+		case tc.warning != "" && (len(warnings) != 1 || !strings.Contains(warnings[0], tc.warning)):
+			t.Errorf("%s: warnings %v, want %q", name, warnings, tc.warning)
+		}
+	}
+}
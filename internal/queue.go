@@ -0,0 +1,117 @@
+package internal
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+const EventsDroppedMethod = "cdp.eventsDropped"
+
+type eventQueue struct {
+	mu      sync.Mutex
+	items   []*CDPMessage
+	size    int64
+	maxSize int64
+	maxLen  int
+	dropped int64
+	closed  bool
+	notify  chan struct{}
+}
+
+func newEventQueue(maxSize int64, maxLen int) *eventQueue {
+	return &eventQueue{maxSize: maxSize, maxLen: maxLen, notify: make(chan struct{}, 1)}
+}
+
+func (q *eventQueue) push(msg *CDPMessage) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return false
+	}
+	size := messageSize(msg)
+	if q.maxSize > 0 && q.size+size > q.maxSize || q.maxLen > 0 && len(q.items) >= q.maxLen {
+		q.dropped++
+		return false
+	}
+	q.flushDropped()
+	q.items = append(q.items, msg)
+	q.size += size
+	q.signal()
+	return true
+}
+
+func (q *eventQueue) close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.flushDropped()
+	q.closed = true
+	q.signal()
+}
+
+func (q *eventQueue) flushDropped() {
+	if q.dropped == 0 {
+		return
+	}
+	marker := droppedMarker(q.dropped)
+	q.items = append(q.items, marker)
+	q.size += messageSize(marker)
+	q.dropped = 0
+}
+
+func (q *eventQueue) signal() {
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (q *eventQueue) pop() (*CDPMessage, bool) {
+	for {
+		q.mu.Lock()
+		if len(q.items) > 0 {
+			msg := q.items[0]
+			q.items[0] = nil
+			q.items = q.items[1:]
+			q.size -= messageSize(msg)
+			q.mu.Unlock()
+			return msg, true
+		}
+		if q.dropped > 0 {
+			msg := droppedMarker(q.dropped)
+			q.dropped = 0
+			q.mu.Unlock()
+			return msg, true
+		}
+		closed := q.closed
+		q.mu.Unlock()
+		if closed {
+			return nil, false
+		}
+		<-q.notify
+	}
+}
+
+func (q *eventQueue) pump(out chan<- *CDPMessage, done <-chan struct{}) {
+	defer close(out)
+	for {
+		msg, ok := q.pop()
+		if !ok {
+			return
+		}
+		select {
+		case out <- msg:
+		case <-done:
+			return
+		}
+	}
+}
+
+func messageSize(msg *CDPMessage) int64 {
+	return int64(len(msg.Method) + len(msg.SessionID) + len(msg.Params) + len(msg.Result) + 64)
+}
+
+func droppedMarker(count int64) *CDPMessage {
+	params := json.RawMessage(fmt.Sprintf(`{"count":%d}`, count))
+	return &CDPMessage{Method: EventsDroppedMethod, Params: params}
+}
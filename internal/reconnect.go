@@ -0,0 +1,279 @@
+package internal
+
+import (
+	"cdp/internal/protocol"
+	"cdp/internal/utility"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const ReconnectedMethod = "cdp.reconnected"
+
+type ReconnectOptions struct {
+	Resolve     func() (string, error)
+	MaxAttempts int
+	MinBackoff  time.Duration
+	MaxBackoff  time.Duration
+}
+
+type recordedCall struct {
+	method    string
+	params    json.RawMessage
+	sessionID string
+}
+
+func (c *Client) record(method string, params json.RawMessage, sessionID string) {
+	// Only sessions attached through this client are recorded. Children
+	// auto-attached by the browser come back as new sessions once
+	// Target.setAutoAttach is re-issued on their parent.
+	if sessionID != "" && !c.isAttached(sessionID) {
+		return
+	}
+	domain, name, _ := strings.Cut(method, ".")
+	switch {
+	case name == "enable" || method == "Target.setAutoAttach" || method == "Target.setDiscoverTargets":
+		call := recordedCall{method: method, params: params, sessionID: sessionID}
+		if i := c.recorded(sessionID, method); i >= 0 {
+			c.enabled[i] = call
+			return
+		}
+		c.enabled = append(c.enabled, call)
+	case name == "disable":
+		if i := c.recorded(sessionID, domain+".enable"); i >= 0 {
+			c.enabled = slices.Delete(c.enabled, i, i+1)
+		}
+	}
+}
+
+func (c *Client) recorded(sessionID, method string) int {
+	return slices.IndexFunc(c.enabled, func(call recordedCall) bool {
+		return call.sessionID == sessionID && call.method == method
+	})
+}
+
+func (c *Client) isAttached(sessionID string) bool {
+	for _, id := range c.attached {
+		if id == sessionID {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Client) forget(sessionID string) {
+	for targetID, id := range c.attached {
+		if id == sessionID {
+			delete(c.attached, targetID)
+		}
+	}
+	c.enabled = slices.DeleteFunc(c.enabled, func(call recordedCall) bool {
+		return call.sessionID == sessionID
+	})
+	if alias, ok := c.aliases[sessionID]; ok {
+		delete(c.originals, alias)
+		delete(c.aliases, sessionID)
+	}
+}
+
+func (c *Client) observe(msg *CDPMessage) {
+	if msg.Method != "Target.detachedFromTarget" {
+		return
+	}
+	var params struct {
+		SessionID string `json:"sessionId"`
+	}
+	_ = json.Unmarshal(msg.Params, &params)
+	c.Mu.Lock()
+	defer c.Mu.Unlock()
+	sessionID := params.SessionID
+	if original, ok := c.originals[sessionID]; ok {
+		sessionID = original
+	}
+	c.forget(sessionID)
+}
+
+func (c *Client) failPending() {
+	c.Mu.Lock()
+	for _, ch := range c.Pending {
+		close(ch)
+	}
+	c.Pending = make(map[int64]chan *CDPMessage)
+	c.Mu.Unlock()
+}
+
+func (c *Client) reconnectLoop() {
+	c.failPending()
+	opts := c.reconnect
+	backoff := opts.MinBackoff
+	if backoff <= 0 {
+		backoff = 500 * time.Millisecond
+	}
+	maxBackoff := opts.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = 30 * time.Second
+	}
+	for attempt := 1; opts.MaxAttempts == 0 || attempt <= opts.MaxAttempts; attempt++ {
+		utility.Term.Info("reconnecting in %s (attempt %d)\n", backoff, attempt)
+		select {
+		case <-c.done:
+			c.shutdown()
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, maxBackoff)
+		wsURL, err := c.resolveReconnectURL()
+		if err != nil {
+			utility.Term.Info("resolving debugger url: %v\n", err)
+			continue
+		}
+		conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+		if err != nil {
+			utility.Term.Info("reconnecting to %s: %v\n", wsURL, err)
+			continue
+		}
+		c.Mu.Lock()
+		c.Conn = conn
+		c.wsURL = wsURL
+		c.Mu.Unlock()
+		if c.closing() {
+			_ = conn.Close()
+			c.shutdown()
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		lost, held, err := c.reattach(ctx, conn)
+		if err != nil {
+			cancel()
+			utility.Term.Info("re-attaching after reconnect: %v\n", err)
+			_ = conn.Close()
+			if c.closing() {
+				c.shutdown()
+				return
+			}
+			c.failPending()
+			continue
+		}
+		utility.Term.Info("reconnected to %s after %d attempt(s)\n", wsURL, attempt)
+		params, _ := json.Marshal(map[string]any{"attempts": attempt, "wsUrl": wsURL, "lostTargets": lost})
+		msg := &CDPMessage{Method: ReconnectedMethod, Params: params}
+		c.publish(msg)
+		if c.Events != nil {
+			c.deliver(msg)
+		}
+		go c.readLoop(conn, held)
+		c.reenable(ctx)
+		cancel()
+		return
+	}
+	utility.Term.Info("giving up reconnecting after %d attempts\n", opts.MaxAttempts)
+	c.shutdown()
+}
+
+func (c *Client) resolveReconnectURL() (string, error) {
+	c.Mu.Lock()
+	wsURL := c.wsURL
+	c.Mu.Unlock()
+	if c.reconnect.Resolve != nil {
+		resolved, err := c.reconnect.Resolve()
+		if err != nil {
+			return "", err
+		}
+		wsURL = resolved
+	}
+	u, err := url.Parse(wsURL)
+	if err != nil || !strings.HasPrefix(u.Path, "/devtools/browser/") {
+		return wsURL, nil
+	}
+	base, err := protocol.HTTPBase(wsURL)
+	if err != nil {
+		return wsURL, nil
+	}
+	current, err := ResolveWsURL(base)
+	if err != nil {
+		return "", err
+	}
+	return current, nil
+}
+
+func (c *Client) reattach(ctx context.Context, conn *websocket.Conn) ([]string, []*CDPMessage, error) {
+	c.Mu.Lock()
+	attached := make(map[string]string, len(c.attached))
+	for targetID, sessionID := range c.attached {
+		attached[targetID] = sessionID
+	}
+	c.aliases = make(map[string]string)
+	c.originals = make(map[string]string)
+	c.Mu.Unlock()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetReadDeadline(deadline)
+		defer func() {
+			_ = conn.SetReadDeadline(time.Time{})
+		}()
+	}
+	lost := []string{}
+	var held []*CDPMessage
+	for targetID, original := range attached {
+		params, _ := json.Marshal(map[string]any{"targetId": targetID, "flatten": true})
+		_, ch, err := c.start(ctx, Command{Method: "Target.attachToTarget", Params: params})
+		if err != nil {
+			return nil, nil, err
+		}
+		var resp *CDPMessage
+		for resp == nil {
+			msg, err := c.readMessage(conn)
+			if err != nil {
+				return nil, nil, err
+			}
+			switch {
+			case msg == nil:
+			case msg.ID == 0:
+				held = append(held, msg)
+			default:
+				c.dispatch(msg)
+			}
+			select {
+			case r, ok := <-ch:
+				if !ok {
+					return nil, nil, fmt.Errorf("connection closed")
+				}
+				resp = r
+			default:
+			}
+		}
+		sessionID, err := attachResult(resp)
+		if err != nil {
+			utility.Term.Info("re-attaching to %s: %v\n", targetID, err)
+			lost = append(lost, targetID)
+			c.Mu.Lock()
+			c.forget(original)
+			c.Mu.Unlock()
+			continue
+		}
+		c.Mu.Lock()
+		c.aliases[original] = sessionID
+		c.originals[sessionID] = original
+		c.Mu.Unlock()
+	}
+	return lost, held, nil
+}
+
+func (c *Client) reenable(ctx context.Context) {
+	c.Mu.Lock()
+	calls := slices.Clone(c.enabled)
+	c.Mu.Unlock()
+	for _, call := range calls {
+		resp, err := c.Send(ctx, call.method, call.params, call.sessionID)
+		if err != nil {
+			utility.Term.Info("re-issuing %s: %v\n", call.method, err)
+		} else if resp.Error != nil {
+			utility.Term.Info("re-issuing %s: %s\n", call.method, resp.Error.Message)
+		}
+	}
+}
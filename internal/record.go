@@ -0,0 +1,133 @@
+package internal
+
+import (
+	"bufio"
+	"cdp/internal/utility"
+	"encoding/json"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	FrameSend = "send"
+	FrameRecv = "recv"
+)
+
+type Frame struct {
+	Time      time.Time       `json:"time"`
+	Direction string          `json:"dir"`
+	SessionID string          `json:"sessionId,omitempty"`
+	Message   json.RawMessage `json:"message"`
+}
+
+type Recorder struct {
+	mu  sync.Mutex
+	f   *os.File
+	w   *bufio.Writer
+	err error
+}
+
+var (
+	recorderMu sync.Mutex
+	recorder   *Recorder
+)
+
+func StartRecording(path string) (*Recorder, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, utility.ErrUser("creating recording: %v", err)
+	}
+	r := &Recorder{f: f, w: bufio.NewWriter(f)}
+	recorderMu.Lock()
+	recorder = r
+	recorderMu.Unlock()
+	return r, nil
+}
+
+func activeRecorder() *Recorder {
+	recorderMu.Lock()
+	defer recorderMu.Unlock()
+	return recorder
+}
+
+func (r *Recorder) record(dir string, data []byte) {
+	if r == nil {
+		return
+	}
+	var head struct {
+		SessionID string `json:"sessionId"`
+	}
+	_ = json.Unmarshal(data, &head)
+	line, err := json.Marshal(Frame{Time: time.Now().UTC(), Direction: dir, SessionID: head.SessionID, Message: data})
+	if err != nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return
+	}
+	_, r.err = r.w.Write(append(line, '\n'))
+	if r.err == nil {
+		r.err = r.w.Flush()
+	}
+	if r.err != nil {
+		utility.Term.Error("recording failed: %v\n", r.err)
+	}
+}
+
+func (r *Recorder) Close() error {
+	recorderMu.Lock()
+	if recorder == r {
+		recorder = nil
+	}
+	recorderMu.Unlock()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	err := r.w.Flush()
+	closeErr := r.f.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return utility.ErrRuntime("writing recording: %v", err)
+	}
+	return nil
+}
+
+func LoadRecording(path string) ([]Frame, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, utility.ErrUser("opening recording: %v", err)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	var frames []Frame
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 256*1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		var frame Frame
+		err = json.Unmarshal([]byte(text), &frame)
+		if err != nil {
+			return nil, utility.ErrUser("recording line %d: %v", line, err)
+		}
+		if frame.Direction != FrameSend && frame.Direction != FrameRecv {
+			return nil, utility.ErrUser("recording line %d: invalid direction %q", line, frame.Direction)
+		}
+		frames = append(frames, frame)
+	}
+	err = scanner.Err()
+	if err != nil {
+		return nil, utility.ErrUser("reading recording: %v", err)
+	}
+	return frames, nil
+}
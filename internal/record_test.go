@@ -0,0 +1,132 @@
+package internal_test
+
+import (
+	"cdp/internal"
+	"cdp/internal/cdptest"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRecordReplayRoundTrip(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	s.Handle("Page.navigate", func(req *cdptest.Request) (any, error) {
+		err := req.Emit("Page.frameNavigated", map[string]any{"url": "https://example.com"})
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"frameId": "main"}, nil
+	})
+	path := filepath.Join(t.TempDir(), "session.jsonl")
+	rec, err := internal.StartRecording(path)
+	if err != nil {
+		t.Fatalf("recording: %v", err)
+	}
+	exchange := func(wsURL string) (string, *internal.CDPMessage, *internal.CDPMessage) {
+		t.Helper()
+		c, err := internal.Dial(wsURL, internal.ClientOptions{Events: true})
+		if err != nil {
+			t.Fatalf("dial: %v", err)
+		}
+		defer c.Close()
+		ctx := testContext(t)
+		sessionID, err := c.AttachToTarget(ctx, "page-1")
+		if err != nil {
+			t.Fatalf("attach: %v", err)
+		}
+		resp, err := c.Send(ctx, "Page.navigate", json.RawMessage(`{"url":"https://example.com"}`), sessionID)
+		if err != nil {
+			t.Fatalf("navigate: %v", err)
+		}
+		return sessionID, resp, next(t, c.Events)
+	}
+	sessionID, recorded, recordedEvent := exchange(s.WsURL)
+	err = rec.Close()
+	if err != nil {
+		t.Fatalf("closing recording: %v", err)
+	}
+	frames, err := internal.LoadRecording(path)
+	if err != nil {
+		t.Fatalf("loading recording: %v", err)
+	}
+	var sends, recvs int
+	for _, frame := range frames {
+		switch frame.Direction {
+		case internal.FrameSend:
+			sends++
+		case internal.FrameRecv:
+			recvs++
+		}
+		if frame.Time.IsZero() {
+			t.Fatalf("frame without a timestamp: %s", frame.Message)
+		}
+	}
+	if sends < 2 || recvs < 3 {
+		t.Fatalf("recorded %d sends and %d receives", sends, recvs)
+	}
+	srv, err := internal.NewReplayServer(frames, "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("replay server: %v", err)
+	}
+	defer func() {
+		_ = srv.Close()
+	}()
+	go func() {
+		_ = srv.Serve()
+	}()
+	replayedSession, replayed, replayedEvent := exchange(srv.WsURL())
+	if replayedSession != sessionID {
+		t.Fatalf("replayed session %s, recorded %s", replayedSession, sessionID)
+	}
+	if string(replayed.Result) != string(recorded.Result) || replayed.ID != recorded.ID {
+		t.Fatalf("replayed response %+v, recorded %+v", replayed, recorded)
+	}
+	if replayedEvent.Method != recordedEvent.Method || string(replayedEvent.Params) != string(recordedEvent.Params) {
+		t.Fatalf("replayed event %+v, recorded %+v", replayedEvent, recordedEvent)
+	}
+}
+
+func TestReplayUnknownCommand(t *testing.T) {
+	srv, err := internal.NewReplayServer(nil, "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("replay server: %v", err)
+	}
+	defer func() {
+		_ = srv.Close()
+	}()
+	go func() {
+		_ = srv.Serve()
+	}()
+	c, err := internal.NewClient(srv.WsURL(), false)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer c.Close()
+	resp, err := c.Send(testContext(t), "Page.reload", nil, "")
+	if err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	if resp.Error == nil || resp.Error.Code != -32601 {
+		t.Fatalf("response %+v, want a -32601 error", resp)
+	}
+}
+
+func TestLoadRecordingRejectsBadFrames(t *testing.T) {
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"json":      "{not json}\n",
+		"direction": `{"time":"2026-01-01T00:00:00Z","dir":"sideways","message":{}}` + "\n",
+	} {
+		path := filepath.Join(dir, name)
+		err := os.WriteFile(path, []byte(content), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = internal.LoadRecording(path)
+		if err == nil {
+			t.Errorf("%s: loading succeeded", name)
+		}
+	}
+}
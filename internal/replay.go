@@ -0,0 +1,242 @@
+package internal
+
+import (
+	"cdp/internal/utility"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/websocket"
+)
+
+type ReplayServer struct {
+	frames   []Frame
+	messages []CDPMessage
+	ln       net.Listener
+	srv      *http.Server
+}
+
+func NewReplayServer(frames []Frame, addr string) (*ReplayServer, error) {
+	s := &ReplayServer{frames: frames, messages: make([]CDPMessage, len(frames))}
+	for i, frame := range frames {
+		err := json.Unmarshal(frame.Message, &s.messages[i])
+		if err != nil {
+			return nil, utility.ErrUser("recording frame %d: %v", i+1, err)
+		}
+	}
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, utility.ErrRuntime("listening on %s: %v", addr, err)
+	}
+	s.ln = ln
+	mux := http.NewServeMux()
+	mux.HandleFunc("/json/version", s.handleVersion)
+	mux.HandleFunc("/json/list", s.handleList)
+	mux.HandleFunc("/json", s.handleList)
+	mux.HandleFunc("/devtools/", s.handleWebSocket)
+	s.srv = &http.Server{Handler: mux}
+	return s, nil
+}
+
+func (s *ReplayServer) Addr() string {
+	return s.ln.Addr().String()
+}
+
+func (s *ReplayServer) WsURL() string {
+	return "ws://" + s.Addr() + "/devtools/browser/replay"
+}
+
+func (s *ReplayServer) Serve() error {
+	err := s.srv.Serve(s.ln)
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+func (s *ReplayServer) Close() error {
+	return s.srv.Close()
+}
+
+func (s *ReplayServer) handleVersion(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, map[string]string{
+		"Browser":              "cdp-replay",
+		"Protocol-Version":     "1.3",
+		"webSocketDebuggerUrl": s.WsURL(),
+	})
+}
+
+func (s *ReplayServer) handleList(w http.ResponseWriter, _ *http.Request) {
+	targets := []map[string]string{}
+	for i, msg := range s.messages {
+		if s.frames[i].Direction != FrameRecv || msg.Result == nil {
+			continue
+		}
+		var result struct {
+			TargetInfos []TargetInfo `json:"targetInfos"`
+		}
+		if json.Unmarshal(msg.Result, &result) != nil || result.TargetInfos == nil {
+			continue
+		}
+		for _, t := range result.TargetInfos {
+			targets = append(targets, map[string]string{
+				"id":                   t.TargetID,
+				"type":                 t.Type,
+				"title":                t.Title,
+				"url":                  t.URL,
+				"webSocketDebuggerUrl": "ws://" + s.Addr() + "/devtools/" + t.Type + "/" + t.TargetID,
+			})
+		}
+		break
+	}
+	writeJSON(w, targets)
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+var replayUpgrader = websocket.Upgrader{CheckOrigin: func(*http.Request) bool { return true }}
+
+func (s *ReplayServer) handleWebSocket(w http.ResponseWriter, r *http.Request) {
+	conn, err := replayUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+	utility.Term.Info("replay: client connected from %s\n", r.RemoteAddr)
+	rs := &replaySession{
+		server:  s,
+		conn:    conn,
+		matched: make([]bool, len(s.frames)),
+		emitted: make([]bool, len(s.frames)),
+		ids:     make(map[int64]int64),
+	}
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		var msg CDPMessage
+		err = json.Unmarshal(data, &msg)
+		if err != nil {
+			continue
+		}
+		err = rs.handle(&msg)
+		if err != nil {
+			utility.Term.Info("replay: %v\n", err)
+			return
+		}
+	}
+}
+
+type replaySession struct {
+	server  *ReplayServer
+	conn    *websocket.Conn
+	cursor  int
+	matched []bool
+	emitted []bool
+	ids     map[int64]int64
+}
+
+func (rs *replaySession) handle(msg *CDPMessage) error {
+	index := rs.match(msg)
+	if index < 0 {
+		utility.Term.Info("replay: no recorded frame for %s\n", msg.Method)
+		return rs.write(CDPMessage{ID: msg.ID, SessionID: msg.SessionID, Error: &CDPError{
+			Code:    -32601,
+			Message: fmt.Sprintf("'%s' wasn't found in recording", msg.Method),
+		}})
+	}
+	recordedID := rs.server.messages[index].ID
+	rs.matched[index] = true
+	rs.ids[recordedID] = msg.ID
+	err := rs.advance()
+	if err != nil {
+		return err
+	}
+	for i := index + 1; i < len(rs.server.frames); i++ {
+		if rs.server.frames[i].Direction == FrameRecv && rs.server.messages[i].ID == recordedID {
+			if !rs.emitted[i] {
+				return rs.emit(i)
+			}
+			break
+		}
+	}
+	return nil
+}
+
+func (rs *replaySession) match(msg *CDPMessage) int {
+	fallback := -1
+	for i := rs.cursor; i < len(rs.server.frames); i++ {
+		recorded := &rs.server.messages[i]
+		if rs.server.frames[i].Direction != FrameSend || rs.matched[i] || recorded.Method != msg.Method {
+			continue
+		}
+		if recorded.SessionID == msg.SessionID {
+			return i
+		}
+		if fallback < 0 {
+			fallback = i
+		}
+	}
+	return fallback
+}
+
+func (rs *replaySession) advance() error {
+	for ; rs.cursor < len(rs.server.frames); rs.cursor++ {
+		i := rs.cursor
+		if rs.server.frames[i].Direction == FrameSend {
+			if !rs.matched[i] {
+				return nil
+			}
+			continue
+		}
+		if rs.emitted[i] {
+			continue
+		}
+		if id := rs.server.messages[i].ID; id != 0 {
+			if _, ok := rs.ids[id]; !ok {
+				return nil
+			}
+		}
+		err := rs.emit(i)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (rs *replaySession) emit(i int) error {
+	rs.emitted[i] = true
+	data := rs.server.frames[i].Message
+	if id := rs.server.messages[i].ID; id != 0 {
+		var fields map[string]json.RawMessage
+		err := json.Unmarshal(data, &fields)
+		if err != nil {
+			return err
+		}
+		fields["id"], _ = json.Marshal(rs.ids[id])
+		data, err = json.Marshal(fields)
+		if err != nil {
+			return err
+		}
+	}
+	utility.Term.Info("replay <- %s\n", strings.TrimSpace(string(data)))
+	return rs.conn.WriteMessage(websocket.TextMessage, data)
+}
+
+func (rs *replaySession) write(msg CDPMessage) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return rs.conn.WriteMessage(websocket.TextMessage, data)
+}
@@ -0,0 +1,348 @@
+package script
+
+import (
+	"cdp/internal"
+	"cdp/internal/utility"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/dop251/goja"
+)
+
+type JSRunner struct {
+	Client    *internal.Client
+	SessionID string
+	Timeout   time.Duration
+	Stdout    io.Writer
+	Stderr    io.Writer
+	vm        *goja.Runtime
+	ctx       context.Context
+	events    <-chan *internal.CDPMessage
+	handlers  map[int]*jsHandler
+	nextID    int
+	backlog   []*internal.CDPMessage
+	exited    bool
+}
+
+const jsBacklog = 1000
+
+var errExit = errors.New("cdp.exit")
+
+type jsHandler struct {
+	pattern *regexp.Regexp
+	fn      goja.Callable
+}
+
+func (r *JSRunner) Run(ctx context.Context, path string, args []string) error {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return utility.ErrUser("reading script: %v", err)
+	}
+	events, cancel := r.Client.Subscribe(internal.AnySession, "")
+	defer cancel()
+	r.ctx = ctx
+	r.events = events
+	r.handlers = make(map[int]*jsHandler)
+	r.vm = goja.New()
+	r.vm.SetFieldNameMapper(goja.TagFieldNameMapper("json", true))
+	stop := context.AfterFunc(ctx, func() {
+		r.vm.Interrupt(ctx.Err())
+	})
+	defer stop()
+	r.install(args)
+	_, err = r.vm.RunScript(path, string(src))
+	if err == nil {
+		err = r.loop()
+	}
+	var interrupted *goja.InterruptedError
+	if errors.As(err, &interrupted) && interrupted.Value() == errExit {
+		return nil
+	}
+	if err != nil {
+		var exception *goja.Exception
+		if errors.As(err, &exception) {
+			return utility.ErrRuntime("%s", strings.TrimSpace(exception.String()))
+		}
+		return utility.ErrRuntime("%v", err)
+	}
+	return nil
+}
+
+func (r *JSRunner) install(args []string) {
+	cdp := r.vm.NewObject()
+	_ = cdp.Set("sessionId", r.SessionID)
+	_ = cdp.Set("args", args)
+	_ = cdp.Set("send", r.send)
+	_ = cdp.Set("on", r.on)
+	_ = cdp.Set("waitFor", r.waitFor)
+	_ = cdp.Set("targets", r.targets)
+	_ = cdp.Set("sleep", r.sleep)
+	_ = cdp.Set("exit", r.exit)
+	_ = r.vm.Set("cdp", cdp)
+	console := r.vm.NewObject()
+	_ = console.Set("log", r.logger(r.Stdout))
+	_ = console.Set("info", r.logger(r.Stdout))
+	_ = console.Set("warn", r.logger(r.Stderr))
+	_ = console.Set("error", r.logger(r.Stderr))
+	_ = r.vm.Set("console", console)
+}
+
+func (r *JSRunner) throw(err error) {
+	panic(r.vm.NewGoError(err))
+}
+
+func (r *JSRunner) send(call goja.FunctionCall) goja.Value {
+	method := call.Argument(0).String()
+	var params json.RawMessage
+	if arg := call.Argument(1); !goja.IsUndefined(arg) && !goja.IsNull(arg) {
+		data, err := json.Marshal(arg.Export())
+		if err != nil {
+			r.throw(fmt.Errorf("%s: encoding params: %w", method, err))
+		}
+		params = data
+	}
+	sessionID := r.SessionID
+	if opts := call.Argument(2); !goja.IsUndefined(opts) && !goja.IsNull(opts) {
+		if v := opts.ToObject(r.vm).Get("sessionId"); v != nil && !goja.IsUndefined(v) {
+			sessionID = v.String()
+		}
+	}
+	ctx, cancel := context.WithTimeout(r.ctx, r.Timeout)
+	defer cancel()
+	type reply struct {
+		resp *internal.CDPMessage
+		err  error
+	}
+	done := make(chan reply, 1)
+	go func() {
+		resp, err := r.Client.Send(ctx, method, params, sessionID)
+		done <- reply{resp, err}
+	}()
+	for {
+		select {
+		case rep := <-done:
+			if rep.err != nil {
+				r.throw(fmt.Errorf("%s: %w", method, rep.err))
+			}
+			if rep.resp.Error != nil {
+				obj := r.vm.NewGoError(fmt.Errorf("%s: %s", method, rep.resp.Error.Message))
+				_ = obj.Set("code", rep.resp.Error.Code)
+				panic(obj)
+			}
+			return r.decode(rep.resp.Result)
+		case event, ok := <-r.events:
+			if !ok {
+				r.events = nil
+				continue
+			}
+			r.hold(event)
+		}
+	}
+}
+
+func (r *JSRunner) on(call goja.FunctionCall) goja.Value {
+	pattern := call.Argument(0).String()
+	fn, ok := goja.AssertFunction(call.Argument(1))
+	if !ok {
+		panic(r.vm.NewTypeError("cdp.on: handler must be a function"))
+	}
+	r.nextID++
+	id := r.nextID
+	r.handlers[id] = &jsHandler{pattern: utility.GlobRegexp(pattern), fn: fn}
+	return r.vm.ToValue(func() {
+		delete(r.handlers, id)
+	})
+}
+
+func (r *JSRunner) waitFor(call goja.FunctionCall) goja.Value {
+	method := call.Argument(0).String()
+	pattern := utility.GlobRegexp(method)
+	var predicate goja.Callable
+	if arg := call.Argument(1); !goja.IsUndefined(arg) && !goja.IsNull(arg) {
+		fn, ok := goja.AssertFunction(arg)
+		if !ok {
+			panic(r.vm.NewTypeError("cdp.waitFor: predicate must be a function"))
+		}
+		predicate = fn
+	}
+	timeout := r.Timeout
+	if arg := call.Argument(2); !goja.IsUndefined(arg) && !goja.IsNull(arg) {
+		timeout = time.Duration(arg.ToInteger()) * time.Millisecond
+	}
+	for len(r.backlog) > 0 {
+		event := r.backlog[0]
+		r.backlog = r.backlog[1:]
+		if value, ok := r.matchEvent(event, pattern, predicate); ok {
+			return value
+		}
+	}
+	if r.events == nil {
+		r.throw(fmt.Errorf("waiting for %s: connection closed", method))
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		select {
+		case <-timer.C:
+			r.throw(fmt.Errorf("waiting for %s: timed out after %s", method, timeout))
+		case <-r.ctx.Done():
+			r.throw(fmt.Errorf("waiting for %s: %w", method, r.ctx.Err()))
+		case event, ok := <-r.events:
+			if !ok {
+				r.throw(fmt.Errorf("waiting for %s: connection closed", method))
+			}
+			r.dispatch(event)
+			if value, ok := r.matchEvent(event, pattern, predicate); ok {
+				return value
+			}
+		}
+	}
+}
+
+func (r *JSRunner) matchEvent(event *internal.CDPMessage, pattern *regexp.Regexp, predicate goja.Callable) (goja.Value, bool) {
+	if !pattern.MatchString(event.Method) {
+		return nil, false
+	}
+	value := r.eventValue(event)
+	if predicate != nil {
+		match, err := predicate(goja.Undefined(), value)
+		if err != nil {
+			panic(err)
+		}
+		if !match.ToBoolean() {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+func (r *JSRunner) targets(goja.FunctionCall) goja.Value {
+	ctx, cancel := context.WithTimeout(r.ctx, r.Timeout)
+	defer cancel()
+	targets, err := r.Client.GetTargets(ctx)
+	if err != nil {
+		r.throw(fmt.Errorf("listing targets: %w", err))
+	}
+	data, _ := json.Marshal(targets)
+	return r.decode(data)
+}
+
+func (r *JSRunner) sleep(call goja.FunctionCall) goja.Value {
+	timer := time.NewTimer(time.Duration(call.Argument(0).ToInteger()) * time.Millisecond)
+	defer timer.Stop()
+	for {
+		select {
+		case <-timer.C:
+			return goja.Undefined()
+		case <-r.ctx.Done():
+			r.throw(r.ctx.Err())
+		case event, ok := <-r.events:
+			if !ok {
+				r.events = nil
+				continue
+			}
+			r.hold(event)
+		}
+	}
+}
+
+func (r *JSRunner) hold(event *internal.CDPMessage) {
+	r.dispatch(event)
+	if len(r.backlog) == jsBacklog {
+		r.backlog = r.backlog[1:]
+	}
+	r.backlog = append(r.backlog, event)
+}
+
+func (r *JSRunner) dispatch(event *internal.CDPMessage) {
+	var value goja.Value
+	for _, h := range r.handlers {
+		if !h.pattern.MatchString(event.Method) {
+			continue
+		}
+		if value == nil {
+			value = r.eventValue(event)
+		}
+		_, err := h.fn(goja.Undefined(), value)
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
+func (r *JSRunner) exit(goja.FunctionCall) goja.Value {
+	r.exited = true
+	r.vm.Interrupt(errExit)
+	return goja.Undefined()
+}
+
+func (r *JSRunner) loop() (err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			e, ok := v.(error)
+			if !ok {
+				panic(v)
+			}
+			err = e
+		}
+	}()
+	for !r.exited && len(r.handlers) > 0 && r.events != nil {
+		select {
+		case <-r.ctx.Done():
+			return nil
+		case event, ok := <-r.events:
+			if !ok {
+				r.events = nil
+				return nil
+			}
+			r.dispatch(event)
+		}
+	}
+	return nil
+}
+
+func (r *JSRunner) eventValue(event *internal.CDPMessage) goja.Value {
+	var params any = map[string]any{}
+	if event.Params != nil {
+		_ = json.Unmarshal(event.Params, &params)
+	}
+	return r.vm.ToValue(map[string]any{"method": event.Method, "params": params, "sessionId": event.SessionID})
+}
+
+func (r *JSRunner) decode(data json.RawMessage) goja.Value {
+	var v any = map[string]any{}
+	if data != nil {
+		err := json.Unmarshal(data, &v)
+		if err != nil {
+			r.throw(err)
+		}
+	}
+	return r.vm.ToValue(v)
+}
+
+func (r *JSRunner) logger(w io.Writer) func(call goja.FunctionCall) goja.Value {
+	return func(call goja.FunctionCall) goja.Value {
+		parts := make([]string, 0, len(call.Arguments))
+		for _, arg := range call.Arguments {
+			if s, ok := arg.Export().(string); ok {
+				parts = append(parts, s)
+				continue
+			}
+			data, err := json.Marshal(arg.Export())
+			if err != nil {
+				parts = append(parts, arg.String())
+				continue
+			}
+			parts = append(parts, string(data))
+		}
+		_, _ = fmt.Fprintln(w, strings.Join(parts, " "))
+		return goja.Undefined()
+	}
+}
@@ -0,0 +1,147 @@
+package script_test
+
+import (
+	"bytes"
+	"cdp/internal"
+	"cdp/internal/cdptest"
+	"cdp/internal/script"
+	"cdp/internal/utility"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func runJS(t *testing.T, s *cdptest.Server, ctx context.Context, source string, args ...string) (string, error) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "script.js")
+	err := os.WriteFile(path, []byte(source), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := internal.NewClient(s.WsURL, false)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer c.Close()
+	sessionID, err := c.AttachToTarget(ctx, "page-1")
+	if err != nil {
+		t.Fatalf("attach: %v", err)
+	}
+	var stdout bytes.Buffer
+	r := &script.JSRunner{Client: c, SessionID: sessionID, Timeout: 2 * time.Second, Stdout: &stdout, Stderr: &stdout}
+	err = r.Run(ctx, path, args)
+	return stdout.String(), err
+}
+
+func testContext(t *testing.T) context.Context {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+	return ctx
+}
+
+func TestJSRunner(t *testing.T) {
+	out, err := runJS(t, echoServer(t), testContext(t), `
+const res = cdp.send("Echo.call", {text: cdp.args[0]});
+console.log(res.echo.text, cdp.sessionId !== "");
+const seen = [];
+const off = cdp.on("Page.frame*", e => seen.push(e.params.frame.url));
+cdp.send("Page.navigate", {url: "https://example.com"});
+const nav = cdp.waitFor("Page.frameNavigated", e => e.params.frame.url.startsWith("https"));
+console.log(nav.params.frame.url, seen.join(","));
+off();
+try {
+	cdp.send("Missing.method");
+} catch (e) {
+	console.log("caught", e.code);
+}
+console.log(cdp.targets().length);
+`, "hello")
+	if err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	want := "hello true\nhttps://example.com about:blank,https://example.com\ncaught -32601\n1\n"
+	if out != want {
+		t.Fatalf("output %q, want %q", out, want)
+	}
+}
+
+func TestJSRunnerDispatchesHandlersUntilExit(t *testing.T) {
+	s := echoServer(t)
+	done := make(chan error, 1)
+	var out string
+	go func() {
+		var err error
+		out, err = runJS(t, s, testContext(t), `
+let n = 0;
+cdp.on("Page.loadEventFired", e => {
+	console.log("load", ++n);
+	if (n === 2) cdp.exit();
+});
+console.log("ready");
+`)
+		done <- err
+	}()
+	var sessionID string
+	for sessionID == "" {
+		for id := range s.Sessions() {
+			sessionID = id
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	time.Sleep(100 * time.Millisecond)
+	for range 3 {
+		s.Emit(sessionID, "Page.loadEventFired", map[string]any{})
+	}
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("run: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("script did not exit")
+	}
+	if out != "ready\nload 1\nload 2\n" {
+		t.Fatalf("output %q", out)
+	}
+}
+
+func TestJSRunnerReturnsOnceHandlersAreRemoved(t *testing.T) {
+	out, err := runJS(t, echoServer(t), testContext(t), `
+const off = cdp.on("Page.*", () => {});
+off();
+console.log("done");
+`)
+	if err != nil || out != "done\n" {
+		t.Fatalf("output %q, err %v", out, err)
+	}
+}
+
+func TestJSRunnerStopsOnInterrupt(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	_, err := runJS(t, echoServer(t), ctx, `cdp.on("Page.*", () => {});`)
+	if err != nil {
+		t.Fatalf("run: %v", err)
+	}
+}
+
+func TestJSRunnerErrors(t *testing.T) {
+	for source, want := range map[string]string{
+		`throw new Error("boom")`:                      "boom",
+		`cdp.waitFor("Page.loadEventFired", null, 50)`: "waiting for Page.loadEventFired: timed out",
+		`cdp.on("Page.*", 1)`:                          "handler must be a function",
+		`cdp.exit(); throw new Error("unreachable")`:   "",
+		`cdp.send("Echo.call", {f: () => 1})`:          "encoding params",
+	} {
+		_, err := runJS(t, echoServer(t), testContext(t), source)
+		switch {
+		case want == "" && err != nil:
+			t.Errorf("%s: unexpected error %v", source, err)
+		case want != "" && (err == nil || !strings.Contains(err.Error(), want) || !utility.IsRuntimeError(err)):
+			t.Errorf("%s: err = %v, want runtime error %q", source, err, want)
+		}
+	}
+}
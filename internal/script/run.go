@@ -0,0 +1,313 @@
+package script
+
+import (
+	"cdp/internal"
+	"cdp/internal/utility"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+type Report struct {
+	OK         bool         `json:"ok"`
+	DurationMs int64        `json:"durationMs"`
+	Steps      []StepReport `json:"steps"`
+	Error      string       `json:"error,omitempty"`
+	Err        error        `json:"-"`
+}
+
+type StepReport struct {
+	Step       string `json:"step"`
+	Type       string `json:"type"`
+	Method     string `json:"method,omitempty"`
+	OK         bool   `json:"ok"`
+	DurationMs int64  `json:"durationMs"`
+	Result     any    `json:"result,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+type Runner struct {
+	Client    *internal.Client
+	SessionID string
+	Timeout   time.Duration
+	events    <-chan *internal.CDPMessage
+	vars      map[string]any
+	steps     map[string]any
+	report    *Report
+}
+
+func (r *Runner) Run(ctx context.Context, s *Script, vars map[string]any) *Report {
+	start := time.Now()
+	r.report = &Report{Steps: []StepReport{}}
+	r.vars = make(map[string]any)
+	for key, value := range s.Vars {
+		r.vars[key] = normalize(value)
+	}
+	for key, value := range vars {
+		r.vars[key] = value
+	}
+	r.steps = make(map[string]any)
+	events, cancel := r.Client.Subscribe(r.SessionID, "")
+	defer cancel()
+	r.events = events
+	err := r.runSteps(ctx, s.Steps, "")
+	r.report.OK = err == nil
+	if err != nil {
+		r.report.Error = err.Error()
+		r.report.Err = err
+	}
+	r.report.DurationMs = time.Since(start).Milliseconds()
+	return r.report
+}
+
+func (r *Runner) scope() map[string]any {
+	return map[string]any{"vars": r.vars, "steps": r.steps}
+}
+
+func (r *Runner) runSteps(ctx context.Context, steps []Step, prefix string) error {
+	for i := range steps {
+		step := &steps[i]
+		name := stepName(prefix, i, step)
+		if step.Loop != nil {
+			err := r.runLoop(ctx, step, name)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		start := time.Now()
+		entry := StepReport{Step: name, Type: step.Kind(), Method: step.Send + step.Wait}
+		result, err := r.runStep(ctx, step)
+		entry.DurationMs = time.Since(start).Milliseconds()
+		entry.Result = result
+		entry.OK = err == nil
+		if err != nil {
+			entry.Error = err.Error()
+		}
+		r.report.Steps = append(r.report.Steps, entry)
+		if err != nil {
+			return fmt.Errorf("step %s: %w", name, err)
+		}
+		if step.ID != "" && result != nil {
+			r.steps[step.ID] = result
+		}
+	}
+	return nil
+}
+
+func (r *Runner) runLoop(ctx context.Context, step *Step, name string) error {
+	var items []any
+	if step.Loop.Over != nil {
+		over, err := Interpolate(step.Loop.Over, r.scope())
+		if err != nil {
+			return fmt.Errorf("step %s: %w", name, err)
+		}
+		list, ok := normalize(over).([]any)
+		if !ok {
+			return fmt.Errorf("step %s: loop over must be a list", name)
+		}
+		items = list
+	} else {
+		for i := range step.Loop.Times {
+			items = append(items, i)
+		}
+	}
+	as := step.Loop.As
+	if as == "" {
+		as = "item"
+	}
+	for _, key := range []string{as, "index"} {
+		previous, ok := r.vars[key]
+		defer func() {
+			if ok {
+				r.vars[key] = previous
+			} else {
+				delete(r.vars, key)
+			}
+		}()
+	}
+	for i, item := range items {
+		r.vars[as] = normalize(item)
+		r.vars["index"] = normalize(i)
+		err := r.runSteps(ctx, step.Loop.Steps, name+"["+strconv.Itoa(i)+"].")
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Runner) runStep(ctx context.Context, step *Step) (any, error) {
+	timeout := r.Timeout
+	if step.Timeout != "" {
+		d, err := time.ParseDuration(step.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timeout %q", step.Timeout)
+		}
+		timeout = d
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	switch step.Kind() {
+	case "send":
+		return r.send(ctx, step)
+	case "wait":
+		return r.wait(ctx, step)
+	case "assert":
+		return nil, r.assert(step.Assert)
+	case "set":
+		values, err := Interpolate(normalize(step.Set), r.scope())
+		if err != nil {
+			return nil, err
+		}
+		for key, value := range values.(map[string]any) {
+			r.vars[key] = value
+		}
+		return values, nil
+	default:
+		d, err := time.ParseDuration(step.Sleep)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sleep duration %q", step.Sleep)
+		}
+		select {
+		case <-time.After(d):
+			return nil, nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+func (r *Runner) send(ctx context.Context, step *Step) (any, error) {
+	var params json.RawMessage
+	if step.Params != nil {
+		resolved, err := Interpolate(normalize(step.Params), r.scope())
+		if err != nil {
+			return nil, err
+		}
+		params, err = json.Marshal(resolved)
+		if err != nil {
+			return nil, err
+		}
+	}
+	resp, err := r.Client.Send(ctx, step.Send, params, r.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Error != nil {
+		return map[string]any{"error": resp.Error}, resp.Error.Protocol(step.Send)
+	}
+	var result any = map[string]any{}
+	if resp.Result != nil {
+		err = json.Unmarshal(resp.Result, &result)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return map[string]any{"result": result}, nil
+}
+
+func (r *Runner) wait(ctx context.Context, step *Step) (any, error) {
+	where, err := Interpolate(normalize(step.Where), r.scope())
+	if err != nil {
+		return nil, err
+	}
+	pattern := utility.GlobRegexp(step.Wait)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("waiting for %s: %w", step.Wait, ctx.Err())
+		case event, ok := <-r.events:
+			if !ok {
+				return nil, fmt.Errorf("waiting for %s: connection closed", step.Wait)
+			}
+			if !pattern.MatchString(event.Method) {
+				continue
+			}
+			var params any = map[string]any{}
+			if event.Params != nil {
+				_ = json.Unmarshal(event.Params, &params)
+			}
+			if !matchesWhere(params, where) {
+				continue
+			}
+			return map[string]any{"method": event.Method, "params": params}, nil
+		}
+	}
+}
+
+func matchesWhere(params any, where any) bool {
+	conditions, _ := where.(map[string]any)
+	for path, want := range conditions {
+		scope, ok := params.(map[string]any)
+		if !ok {
+			return false
+		}
+		got, err := Lookup(scope, path)
+		if err != nil || !reflect.DeepEqual(got, want) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *Runner) assert(a *Assertion) error {
+	got, lookupErr := Lookup(r.scope(), a.Path)
+	fail := func(format string, args ...any) error {
+		if a.Message != "" {
+			return utility.ErrUser("%s", a.Message)
+		}
+		return utility.ErrUser("assert %s: %s", a.Path, fmt.Sprintf(format, args...))
+	}
+	if a.Exists != nil {
+		if *a.Exists && lookupErr != nil {
+			return fail("expected to exist")
+		}
+		if !*a.Exists && lookupErr == nil {
+			return fail("expected not to exist")
+		}
+	} else if lookupErr != nil {
+		return fail("%v", lookupErr)
+	}
+	if a.Equals != nil {
+		want, err := Interpolate(normalize(a.Equals), r.scope())
+		if err != nil {
+			return err
+		}
+		if !reflect.DeepEqual(got, want) {
+			return fail("expected %s, got %s", jsonString(want), jsonString(got))
+		}
+	}
+	if a.NotEquals != nil {
+		unwanted, err := Interpolate(normalize(a.NotEquals), r.scope())
+		if err != nil {
+			return err
+		}
+		if reflect.DeepEqual(got, unwanted) {
+			return fail("expected value other than %s", jsonString(unwanted))
+		}
+	}
+	if a.Matches != "" {
+		re, err := regexp.Compile(a.Matches)
+		if err != nil {
+			return fmt.Errorf("invalid matches pattern: %v", err)
+		}
+		s, ok := got.(string)
+		if !ok {
+			s = jsonString(got)
+		}
+		if !re.MatchString(s) {
+			return fail("%s does not match %s", jsonString(got), a.Matches)
+		}
+	}
+	return nil
+}
+
+func jsonString(v any) string {
+	data, _ := json.Marshal(v)
+	return string(data)
+}
@@ -0,0 +1,188 @@
+package script_test
+
+import (
+	"cdp/internal"
+	"cdp/internal/cdptest"
+	"cdp/internal/script"
+	"cdp/internal/utility"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func load(t *testing.T, source string) (*script.Script, error) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "script.yaml")
+	err := os.WriteFile(path, []byte(source), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return script.Load(path)
+}
+
+func run(t *testing.T, s *cdptest.Server, source string, vars map[string]any) *script.Report {
+	t.Helper()
+	parsed, err := load(t, source)
+	if err != nil {
+		t.Fatalf("loading script: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	c, err := internal.NewClient(s.WsURL, false)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer c.Close()
+	sessionID, err := c.AttachToTarget(ctx, "page-1")
+	if err != nil {
+		t.Fatalf("attach: %v", err)
+	}
+	r := &script.Runner{Client: c, SessionID: sessionID, Timeout: 2 * time.Second}
+	return r.Run(ctx, parsed, vars)
+}
+
+func echoServer(t *testing.T) *cdptest.Server {
+	s := cdptest.NewServer()
+	t.Cleanup(s.Close)
+	s.Handle("Echo.call", func(req *cdptest.Request) (any, error) {
+		var params map[string]any
+		_ = json.Unmarshal(req.Params, &params)
+		return map[string]any{"echo": params}, nil
+	})
+	s.Handle("Page.navigate", func(req *cdptest.Request) (any, error) {
+		var params struct {
+			URL string `json:"url"`
+		}
+		_ = json.Unmarshal(req.Params, &params)
+		_ = req.Emit("Page.frameNavigated", map[string]any{"frame": map[string]any{"url": "about:blank"}})
+		_ = req.Emit("Page.frameNavigated", map[string]any{"frame": map[string]any{"url": params.URL}})
+		return map[string]any{"frameId": "main"}, nil
+	})
+	return s
+}
+
+func TestRunnerSteps(t *testing.T) {
+	s := echoServer(t)
+	report := run(t, s, `
+vars:
+  greeting: hello
+steps:
+  - id: echo
+    send: Echo.call
+    params: {text: "${vars.greeting} ${vars.name}", count: 2}
+  - assert: {path: steps.echo.result.echo.text, equals: hello world}
+  - assert: {path: steps.echo.result.echo.count, equals: 2}
+  - send: Page.navigate
+    params: {url: "https://example.com"}
+  - id: nav
+    wait: Page.frame*
+    where: {frame.url: "https://example.com"}
+  - assert: {path: steps.nav.params.frame.url, matches: "^https://"}
+  - set: {seen: []}
+  - loop:
+      over: [a, b, c]
+      as: letter
+      steps:
+        - id: each
+          send: Echo.call
+          params: {letter: "${vars.letter}", index: "${vars.index}"}
+  - assert: {path: steps.each.result.echo, equals: {letter: c, index: 2}}
+  - assert: {path: vars.letter, exists: false}
+  - assert: {path: vars.index, exists: false}
+`, map[string]any{"name": "world"})
+	if !report.OK {
+		t.Fatalf("report failed: %s", report.Error)
+	}
+	var names []string
+	for _, step := range report.Steps {
+		names = append(names, step.Step)
+	}
+	want := "echo 2 3 4 nav 6 7 8[0].each 8[1].each 8[2].each 9 10 11"
+	if strings.Join(names, " ") != want {
+		t.Fatalf("steps = %v, want %s", names, want)
+	}
+}
+
+func TestRunnerLoopRestoresOuterVariables(t *testing.T) {
+	report := run(t, echoServer(t), `
+vars:
+  item: outer
+steps:
+  - loop:
+      times: 2
+      steps:
+        - assert: {path: vars.item, equals: "${vars.index}"}
+  - assert: {path: vars.item, equals: outer}
+`, nil)
+	if !report.OK {
+		t.Fatalf("report failed: %s", report.Error)
+	}
+}
+
+func TestRunnerErrors(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		source string
+		check  func(error) bool
+		err    string
+	}{
+		{
+			name:   "protocol error",
+			source: "steps:\n  - send: Missing.method\n",
+			check: func(err error) bool {
+				e, ok := utility.AsProtocolError(err)
+				return ok && e.Code == -32601 && e.Method == "Missing.method"
+			},
+			err: "step 1: Missing.method",
+		},
+		{
+			name:   "failed assertion",
+			source: "vars: {a: 1}\nsteps:\n  - assert: {path: vars.a, equals: 2}\n",
+			check:  utility.IsUserError,
+			err:    "assert vars.a: expected 2, got 1",
+		},
+		{
+			name:   "assertion message",
+			source: "steps:\n  - assert: {path: vars.missing, message: custom failure}\n",
+			check:  utility.IsUserError,
+			err:    "custom failure",
+		},
+		{
+			name:   "wait timeout",
+			source: "steps:\n  - wait: Page.loadEventFired\n    timeout: 50ms\n",
+			check:  func(err error) bool { return err != nil },
+			err:    "waiting for Page.loadEventFired",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			report := run(t, echoServer(t), tc.source, nil)
+			if report.OK || !tc.check(report.Err) || !strings.Contains(report.Error, tc.err) {
+				t.Fatalf("report ok=%v err=%v, want %q", report.OK, report.Err, tc.err)
+			}
+			last := report.Steps[len(report.Steps)-1]
+			if last.OK || last.Error == "" {
+				t.Fatalf("last step %+v should record the failure", last)
+			}
+		})
+	}
+}
+
+func TestLoadRejectsInvalidScripts(t *testing.T) {
+	for source, want := range map[string]string{
+		"steps: []\n":                               "has no steps",
+		"steps:\n  - {send: A.b, wait: C.d}\n":      "expected exactly one of",
+		"steps:\n  - assert: {equals: 1}\n":         "assert requires a path",
+		"steps:\n  - loop: {steps: []}\n":           "loop requires times or over",
+		"steps:\n  - loop: {times: 1, as: index}\n": "clashes with the loop index",
+		"steps: [\n":                                "parsing script",
+	} {
+		_, err := load(t, source)
+		if err == nil || !utility.IsUserError(err) || !strings.Contains(err.Error(), want) {
+			t.Errorf("%q: err = %v, want %q", source, err, want)
+		}
+	}
+}
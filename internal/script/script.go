@@ -0,0 +1,225 @@
+package script
+
+import (
+	"cdp/internal/utility"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+type Script struct {
+	Target string         `yaml:"target"`
+	Vars   map[string]any `yaml:"vars"`
+	Steps  []Step         `yaml:"steps"`
+}
+
+type Step struct {
+	ID      string         `yaml:"id"`
+	Send    string         `yaml:"send"`
+	Params  any            `yaml:"params"`
+	Wait    string         `yaml:"wait"`
+	Where   map[string]any `yaml:"where"`
+	Assert  *Assertion     `yaml:"assert"`
+	Set     map[string]any `yaml:"set"`
+	Loop    *Loop          `yaml:"loop"`
+	Sleep   string         `yaml:"sleep"`
+	Timeout string         `yaml:"timeout"`
+}
+
+type Assertion struct {
+	Path      string `yaml:"path"`
+	Equals    any    `yaml:"equals"`
+	NotEquals any    `yaml:"notEquals"`
+	Matches   string `yaml:"matches"`
+	Exists    *bool  `yaml:"exists"`
+	Message   string `yaml:"message"`
+}
+
+type Loop struct {
+	Times int    `yaml:"times"`
+	Over  any    `yaml:"over"`
+	As    string `yaml:"as"`
+	Steps []Step `yaml:"steps"`
+}
+
+func Load(path string) (*Script, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, utility.ErrUser("reading script: %v", err)
+	}
+	var s Script
+	err = yaml.Unmarshal(data, &s)
+	if err != nil {
+		return nil, utility.ErrUser("parsing script %s: %v", path, err)
+	}
+	if len(s.Steps) == 0 {
+		return nil, utility.ErrUser("script %s has no steps", path)
+	}
+	err = validateSteps(s.Steps, "")
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func validateSteps(steps []Step, prefix string) error {
+	for i := range steps {
+		step := &steps[i]
+		name := stepName(prefix, i, step)
+		n := 0
+		for _, set := range []bool{step.Send != "", step.Wait != "", step.Assert != nil, step.Set != nil, step.Loop != nil, step.Sleep != ""} {
+			if set {
+				n++
+			}
+		}
+		if n != 1 {
+			return utility.ErrUser("step %s: expected exactly one of send, wait, assert, set, loop, sleep", name)
+		}
+		if step.Assert != nil && step.Assert.Path == "" {
+			return utility.ErrUser("step %s: assert requires a path", name)
+		}
+		if step.Loop != nil {
+			if step.Loop.Times == 0 && step.Loop.Over == nil {
+				return utility.ErrUser("step %s: loop requires times or over", name)
+			}
+			if step.Loop.As == "index" {
+				return utility.ErrUser("step %s: loop as %q clashes with the loop index variable", name, step.Loop.As)
+			}
+			err := validateSteps(step.Loop.Steps, name+".")
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Step) Kind() string {
+	switch {
+	case s.Send != "":
+		return "send"
+	case s.Wait != "":
+		return "wait"
+	case s.Assert != nil:
+		return "assert"
+	case s.Set != nil:
+		return "set"
+	case s.Loop != nil:
+		return "loop"
+	default:
+		return "sleep"
+	}
+}
+
+func stepName(prefix string, index int, step *Step) string {
+	if step.ID != "" {
+		return prefix + step.ID
+	}
+	return prefix + strconv.Itoa(index+1)
+}
+
+var placeholder = regexp.MustCompile(`\$\{([^}]+)\}`)
+
+func Interpolate(v any, scope map[string]any) (any, error) {
+	switch v := v.(type) {
+	case string:
+		return interpolateString(v, scope)
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for key, value := range v {
+			resolved, err := Interpolate(value, scope)
+			if err != nil {
+				return nil, err
+			}
+			out[key] = resolved
+		}
+		return out, nil
+	case []any:
+		out := make([]any, len(v))
+		for i, value := range v {
+			resolved, err := Interpolate(value, scope)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = resolved
+		}
+		return out, nil
+	}
+	return v, nil
+}
+
+func interpolateString(s string, scope map[string]any) (any, error) {
+	m := placeholder.FindStringSubmatchIndex(s)
+	if m != nil && m[0] == 0 && m[1] == len(s) {
+		return Lookup(scope, strings.TrimSpace(s[m[2]:m[3]]))
+	}
+	var err error
+	out := placeholder.ReplaceAllStringFunc(s, func(match string) string {
+		value, lookupErr := Lookup(scope, strings.TrimSpace(match[2:len(match)-1]))
+		if lookupErr != nil {
+			err = lookupErr
+			return ""
+		}
+		if str, ok := value.(string); ok {
+			return str
+		}
+		data, _ := json.Marshal(value)
+		return string(data)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func Lookup(scope map[string]any, path string) (any, error) {
+	var cur any = scope
+	for _, part := range splitPath(path) {
+		switch node := cur.(type) {
+		case map[string]any:
+			value, ok := node[part]
+			if !ok {
+				return nil, fmt.Errorf("%s: no field %q", path, part)
+			}
+			cur = value
+		case []any:
+			i, err := strconv.Atoi(part)
+			if err != nil || i < 0 || i >= len(node) {
+				return nil, fmt.Errorf("%s: invalid index %q", path, part)
+			}
+			cur = node[i]
+		default:
+			return nil, fmt.Errorf("%s: cannot index %q", path, part)
+		}
+	}
+	return cur, nil
+}
+
+func splitPath(path string) []string {
+	path = strings.NewReplacer("[", ".", "]", "").Replace(path)
+	var parts []string
+	for _, part := range strings.Split(path, ".") {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return parts
+}
+
+func normalize(v any) any {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+	var out any
+	err = json.Unmarshal(data, &out)
+	if err != nil {
+		return v
+	}
+	return out
+}
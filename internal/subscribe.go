@@ -0,0 +1,100 @@
+package internal
+
+import (
+	"cdp/internal/utility"
+	"context"
+	"fmt"
+	"regexp"
+	"sync"
+)
+
+const AnySession = "*"
+
+type subscription struct {
+	sessionID string
+	pattern   *regexp.Regexp
+	queue     *eventQueue
+	done      chan struct{}
+	once      sync.Once
+}
+
+func (s *subscription) matches(msg *CDPMessage) bool {
+	if s.sessionID != AnySession && s.sessionID != msg.SessionID {
+		return false
+	}
+	return s.pattern == nil || s.pattern.MatchString(msg.Method)
+}
+
+func (s *subscription) close() {
+	s.once.Do(func() {
+		close(s.done)
+		s.queue.close()
+	})
+}
+
+func (c *Client) Subscribe(sessionID, methodPattern string) (<-chan *CDPMessage, func()) {
+	sub := &subscription{
+		sessionID: sessionID,
+		queue:     newEventQueue(0, 0),
+		done:      make(chan struct{}),
+	}
+	if methodPattern != "" {
+		sub.pattern = utility.GlobRegexp(methodPattern)
+	}
+	ch := make(chan *CDPMessage)
+	go sub.queue.pump(ch, sub.done)
+	c.subsMu.Lock()
+	if c.subs == nil {
+		c.subsMu.Unlock()
+		sub.close()
+		return ch, func() {}
+	}
+	c.nextSub++
+	id := c.nextSub
+	c.subs[id] = sub
+	c.subsMu.Unlock()
+	cancel := func() {
+		c.subsMu.Lock()
+		delete(c.subs, id)
+		c.subsMu.Unlock()
+		sub.close()
+	}
+	return ch, cancel
+}
+
+func (c *Client) WaitFor(ctx context.Context, sessionID, method string, predicate func(*CDPMessage) bool) (*CDPMessage, error) {
+	ch, cancel := c.Subscribe(sessionID, method)
+	defer cancel()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("waiting for %s: %w", method, ctx.Err())
+		case msg, ok := <-ch:
+			if !ok {
+				return nil, fmt.Errorf("waiting for %s: connection closed", method)
+			}
+			if predicate == nil || predicate(msg) {
+				return msg, nil
+			}
+		}
+	}
+}
+
+func (c *Client) publish(msg *CDPMessage) {
+	c.subsMu.Lock()
+	defer c.subsMu.Unlock()
+	for _, sub := range c.subs {
+		if sub.matches(msg) {
+			sub.queue.push(msg)
+		}
+	}
+}
+
+func (c *Client) closeSubscriptions() {
+	c.subsMu.Lock()
+	defer c.subsMu.Unlock()
+	for _, sub := range c.subs {
+		sub.queue.close()
+	}
+	c.subs = nil
+}
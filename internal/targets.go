@@ -0,0 +1,180 @@
+package internal
+
+import (
+	"cdp/internal/utility"
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type TargetInfo struct {
+	TargetID         string `json:"targetId"`
+	Type             string `json:"type"`
+	Title            string `json:"title"`
+	URL              string `json:"url"`
+	Attached         bool   `json:"attached"`
+	OpenerID         string `json:"openerId,omitempty"`
+	BrowserContextID string `json:"browserContextId,omitempty"`
+}
+
+type TargetFilter struct {
+	Types []string
+	URL   string
+	Title string
+}
+
+func (f TargetFilter) Match(t TargetInfo) bool {
+	if len(f.Types) > 0 && !slices.Contains(f.Types, t.Type) {
+		return false
+	}
+	if f.URL != "" && !utility.MatchPattern(f.URL, t.URL) {
+		return false
+	}
+	if f.Title != "" && !utility.MatchPattern(f.Title, t.Title) {
+		return false
+	}
+	return true
+}
+
+func FilterTargets(targets []TargetInfo, filter TargetFilter) []TargetInfo {
+	matched := []TargetInfo{}
+	for _, t := range targets {
+		if filter.Match(t) {
+			matched = append(matched, t)
+		}
+	}
+	return matched
+}
+
+func (c *Client) GetTargets(ctx context.Context) ([]TargetInfo, error) {
+	var result struct {
+		TargetInfos []TargetInfo `json:"targetInfos"`
+	}
+	err := c.Call(ctx, "Target.getTargets", nil, "", &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.TargetInfos, nil
+}
+
+func (c *Client) GetTargetInfo(ctx context.Context, targetID string) (*TargetInfo, error) {
+	var result struct {
+		TargetInfo TargetInfo `json:"targetInfo"`
+	}
+	err := c.Call(ctx, "Target.getTargetInfo", map[string]any{"targetId": targetID}, "", &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.TargetInfo, nil
+}
+
+type CreateTargetOptions struct {
+	URL        string
+	NewWindow  bool
+	Background bool
+}
+
+func (c *Client) CreateTarget(ctx context.Context, opts CreateTargetOptions) (string, error) {
+	url := opts.URL
+	if url == "" {
+		url = "about:blank"
+	}
+	params := map[string]any{"url": url}
+	if opts.NewWindow {
+		params["newWindow"] = true
+	}
+	if opts.Background {
+		params["background"] = true
+	}
+	var result struct {
+		TargetID string `json:"targetId"`
+	}
+	err := c.Call(ctx, "Target.createTarget", params, "", &result)
+	if err != nil {
+		return "", err
+	}
+	return result.TargetID, nil
+}
+
+func (c *Client) ActivateTarget(ctx context.Context, targetID string) error {
+	return c.Call(ctx, "Target.activateTarget", map[string]any{"targetId": targetID}, "", nil)
+}
+
+func (c *Client) CloseTarget(ctx context.Context, targetID string) error {
+	return c.Call(ctx, "Target.closeTarget", map[string]any{"targetId": targetID}, "", nil)
+}
+
+func (c *Client) ResolveTarget(ctx context.Context, selector string) (string, error) {
+	if selector == "" || selector == "browser" {
+		return "", nil
+	}
+	if selector == "new" {
+		targetID, err := c.CreateTarget(ctx, CreateTargetOptions{})
+		if err != nil {
+			return "", utility.ErrRuntime("creating target: %v", err)
+		}
+		return targetID, nil
+	}
+	kind, value, _ := strings.Cut(selector, ":")
+	var filter TargetFilter
+	index := -1
+	switch kind {
+	case "url":
+		filter.URL = value
+	case "title":
+		filter.Title = value
+	case "type":
+		filter.Types = []string{value}
+	case "first-page":
+		filter.Types = []string{"page"}
+		index = 0
+	case "index":
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			return "", utility.ErrUser("invalid target selector %s: index must be a non-negative integer", selector)
+		}
+		filter.Types = []string{"page"}
+		index = n
+	default:
+		return selector, nil
+	}
+	targets, err := c.GetTargets(ctx)
+	if err != nil {
+		return "", utility.ErrRuntime("listing targets: %v", err)
+	}
+	matched := FilterTargets(targets, filter)
+	if index >= 0 {
+		if index >= len(matched) {
+			return "", utility.ErrUser("no target matches %s (%d page target(s) open)", selector, len(matched))
+		}
+		return matched[index].TargetID, nil
+	}
+	switch len(matched) {
+	case 0:
+		return "", utility.ErrUser("no target matches %s", selector)
+	case 1:
+		return matched[0].TargetID, nil
+	}
+	var candidates []string
+	for _, t := range matched {
+		candidates = append(candidates, fmt.Sprintf("%s (%s %s)", t.TargetID, t.Type, t.URL))
+	}
+	return "", utility.ErrUser("%d targets match %s, refine the selector: %s", len(matched), selector, strings.Join(candidates, ", "))
+}
+
+func (c *Client) Attach(ctx context.Context, selector string) (string, error) {
+	targetID, err := c.ResolveTarget(ctx, selector)
+	if err != nil {
+		return "", err
+	}
+	if targetID == "" {
+		return "", nil
+	}
+	sessionID, err := c.AttachToTarget(ctx, targetID)
+	if err != nil {
+		return "", utility.ErrRuntime("attaching to target: %w", err)
+	}
+	return sessionID, nil
+}
@@ -9,5 +9,8 @@ var (
 	BaseDir      = filepath.Join(os.Getenv("HOME"), ".cdp")
 	ChromeDir    = filepath.Join(BaseDir, "chrome")
 	InstancesDir = filepath.Join(BaseDir, "instances")
+	ProtocolDir  = filepath.Join(BaseDir, "protocol")
+	DaemonSocket = filepath.Join(BaseDir, "daemon.sock")
+	DaemonLog    = filepath.Join(BaseDir, "daemon.log")
 	Verbose      bool
 )
@@ -5,14 +5,41 @@ import (
 	"fmt"
 )
 
+const (
+	ExitUser           = 1
+	ExitRuntime        = 2
+	ExitMethodNotFound = 3
+	ExitInvalidParams  = 4
+	ExitServerError    = 5
+	ExitProtocol       = 6
+)
+
 type UserError struct{ Err error }
 type RuntimeError struct{ Err error }
 
+type ProtocolError struct {
+	Method  string
+	Code    int
+	Message string
+	Data    string
+}
+
 func (e UserError) Error() string    { return e.Err.Error() }
 func (e RuntimeError) Error() string { return e.Err.Error() }
 func (e UserError) Unwrap() error    { return e.Err }
 func (e RuntimeError) Unwrap() error { return e.Err }
 
+func (e ProtocolError) Error() string {
+	msg := fmt.Sprintf("%s (%d)", e.Message, e.Code)
+	if e.Data != "" {
+		msg += ": " + e.Data
+	}
+	if e.Method != "" {
+		msg = e.Method + ": " + msg
+	}
+	return msg
+}
+
 func ErrUser(format string, args ...any) error {
 	return UserError{Err: fmt.Errorf(format, args...)}
 }
@@ -29,3 +56,32 @@ func IsRuntimeError(err error) bool {
 	var e RuntimeError
 	return errors.As(err, &e)
 }
+
+func AsProtocolError(err error) (ProtocolError, bool) {
+	var e ProtocolError
+	ok := errors.As(err, &e)
+	return e, ok
+}
+
+func ExitCode(err error) int {
+	if e, ok := AsProtocolError(err); ok {
+		switch e.Code {
+		case -32601:
+			return ExitMethodNotFound
+		case -32602:
+			return ExitInvalidParams
+		case -32000:
+			return ExitServerError
+		default:
+			return ExitProtocol
+		}
+	}
+	switch {
+	case IsUserError(err):
+		return ExitUser
+	case IsRuntimeError(err):
+		return ExitRuntime
+	default:
+		return ExitUser
+	}
+}
@@ -0,0 +1,30 @@
+package utility
+
+import (
+	"regexp"
+	"strings"
+)
+
+func MatchPattern(pattern, s string) bool {
+	if !strings.ContainsAny(pattern, "*?") {
+		return strings.Contains(s, pattern)
+	}
+	return GlobRegexp(pattern).MatchString(s)
+}
+
+func GlobRegexp(pattern string) *regexp.Regexp {
+	var b strings.Builder
+	b.WriteString("^")
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	b.WriteString("$")
+	return regexp.MustCompile(b.String())
+}
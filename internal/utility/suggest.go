@@ -0,0 +1,41 @@
+package utility
+
+import "strings"
+
+func Suggest(word string, candidates []string) string {
+	best := ""
+	bestDist := len(word)/3 + 2
+	lower := strings.ToLower(word)
+	for _, c := range candidates {
+		if strings.ToLower(c) == lower {
+			return c
+		}
+		d := levenshtein(lower, strings.ToLower(c))
+		if d < bestDist {
+			best = c
+			bestDist = d
+		}
+	}
+	return best
+}
+
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
@@ -0,0 +1,484 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package accessibility provides typed bindings for the CDP Accessibility domain.
+package accessibility
+
+import (
+	"cdp/internal"
+	"cdp/protocol/dom"
+	"cdp/protocol/page"
+	"cdp/protocol/runtime"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// AXNodeID is the Accessibility.AXNodeId type.
+//
+// Unique accessibility node identifier.
+type AXNodeID string
+
+// AXValueType is the Accessibility.AXValueType type.
+//
+// Enum of possible property types.
+type AXValueType string
+
+const (
+	AXValueTypeBoolean            AXValueType = "boolean"
+	AXValueTypeTristate           AXValueType = "tristate"
+	AXValueTypeBooleanOrUndefined AXValueType = "booleanOrUndefined"
+	AXValueTypeIdref              AXValueType = "idref"
+	AXValueTypeIdrefList          AXValueType = "idrefList"
+	AXValueTypeInteger            AXValueType = "integer"
+	AXValueTypeNode               AXValueType = "node"
+	AXValueTypeNodeList           AXValueType = "nodeList"
+	AXValueTypeNumber             AXValueType = "number"
+	AXValueTypeString             AXValueType = "string"
+	AXValueTypeComputedString     AXValueType = "computedString"
+	AXValueTypeToken              AXValueType = "token"
+	AXValueTypeTokenList          AXValueType = "tokenList"
+	AXValueTypeDOMRelation        AXValueType = "domRelation"
+	AXValueTypeRole               AXValueType = "role"
+	AXValueTypeInternalRole       AXValueType = "internalRole"
+	AXValueTypeValueUndefined     AXValueType = "valueUndefined"
+)
+
+// AXValueSourceType is the Accessibility.AXValueSourceType type.
+//
+// Enum of possible property sources.
+type AXValueSourceType string
+
+const (
+	AXValueSourceTypeAttribute      AXValueSourceType = "attribute"
+	AXValueSourceTypeImplicit       AXValueSourceType = "implicit"
+	AXValueSourceTypeStyle          AXValueSourceType = "style"
+	AXValueSourceTypeContents       AXValueSourceType = "contents"
+	AXValueSourceTypePlaceholder    AXValueSourceType = "placeholder"
+	AXValueSourceTypeRelatedElement AXValueSourceType = "relatedElement"
+)
+
+// AXValueNativeSourceType is the Accessibility.AXValueNativeSourceType type.
+//
+// Enum of possible native property sources (as a subtype of a particular AXValueSourceType).
+type AXValueNativeSourceType string
+
+const (
+	AXValueNativeSourceTypeDescription    AXValueNativeSourceType = "description"
+	AXValueNativeSourceTypeFigcaption     AXValueNativeSourceType = "figcaption"
+	AXValueNativeSourceTypeLabel          AXValueNativeSourceType = "label"
+	AXValueNativeSourceTypeLabelfor       AXValueNativeSourceType = "labelfor"
+	AXValueNativeSourceTypeLabelwrapped   AXValueNativeSourceType = "labelwrapped"
+	AXValueNativeSourceTypeLegend         AXValueNativeSourceType = "legend"
+	AXValueNativeSourceTypeRubyannotation AXValueNativeSourceType = "rubyannotation"
+	AXValueNativeSourceTypeTablecaption   AXValueNativeSourceType = "tablecaption"
+	AXValueNativeSourceTypeTitle          AXValueNativeSourceType = "title"
+	AXValueNativeSourceTypeOther          AXValueNativeSourceType = "other"
+)
+
+// AXValueSource is the Accessibility.AXValueSource type.
+//
+// A single source for a computed AX property.
+type AXValueSource struct {
+	// What type of source this is.
+	Type AXValueSourceType `json:"type"`
+	// The value of this property source.
+	Value *AXValue `json:"value,omitempty"`
+	// The name of the relevant attribute, if any.
+	Attribute string `json:"attribute,omitempty"`
+	// The value of the relevant attribute, if any.
+	AttributeValue *AXValue `json:"attributeValue,omitempty"`
+	// Whether this source is superseded by a higher priority source.
+	Superseded *bool `json:"superseded,omitempty"`
+	// The native markup source for this value, e.g. a `<label>` element.
+	NativeSource AXValueNativeSourceType `json:"nativeSource,omitempty"`
+	// The value, such as a node or node list, of the native source.
+	NativeSourceValue *AXValue `json:"nativeSourceValue,omitempty"`
+	// Whether the value for this property is invalid.
+	Invalid *bool `json:"invalid,omitempty"`
+	// Reason for the value being invalid, if it is.
+	InvalidReason string `json:"invalidReason,omitempty"`
+}
+
+// AXRelatedNode is the Accessibility.AXRelatedNode type.
+type AXRelatedNode struct {
+	// The BackendNodeId of the related DOM node.
+	BackendDOMNodeID dom.BackendNodeID `json:"backendDOMNodeId"`
+	// The IDRef value provided, if any.
+	Idref string `json:"idref,omitempty"`
+	// The text alternative of this node in the current context.
+	Text string `json:"text,omitempty"`
+}
+
+// AXProperty is the Accessibility.AXProperty type.
+type AXProperty struct {
+	// The name of this property.
+	Name AXPropertyName `json:"name"`
+	// The value of this property.
+	Value AXValue `json:"value"`
+}
+
+// AXValue is the Accessibility.AXValue type.
+//
+// A single computed AX property.
+type AXValue struct {
+	// The type of this value.
+	Type AXValueType `json:"type"`
+	// The computed value of this property.
+	Value json.RawMessage `json:"value,omitempty"`
+	// One or more related nodes, if applicable.
+	RelatedNodes []AXRelatedNode `json:"relatedNodes,omitempty"`
+	// The sources which contributed to the computation of this property.
+	Sources []AXValueSource `json:"sources,omitempty"`
+}
+
+// AXPropertyName is the Accessibility.AXPropertyName type.
+//
+// Values of AXProperty name:
+// - from 'busy' to 'roledescription': states which apply to every AX node
+// - from 'live' to 'root': attributes which apply to nodes in live regions
+// - from 'autocomplete' to 'valuetext': attributes which apply to widgets
+// - from 'checked' to 'selected': states which apply to widgets
+// - from 'activedescendant' to 'owns' - relationships between elements other than parent/child/sibling.
+type AXPropertyName string
+
+const (
+	AXPropertyNameBusy             AXPropertyName = "busy"
+	AXPropertyNameDisabled         AXPropertyName = "disabled"
+	AXPropertyNameEditable         AXPropertyName = "editable"
+	AXPropertyNameFocusable        AXPropertyName = "focusable"
+	AXPropertyNameFocused          AXPropertyName = "focused"
+	AXPropertyNameHidden           AXPropertyName = "hidden"
+	AXPropertyNameHiddenRoot       AXPropertyName = "hiddenRoot"
+	AXPropertyNameInvalid          AXPropertyName = "invalid"
+	AXPropertyNameKeyshortcuts     AXPropertyName = "keyshortcuts"
+	AXPropertyNameSettable         AXPropertyName = "settable"
+	AXPropertyNameRoledescription  AXPropertyName = "roledescription"
+	AXPropertyNameLive             AXPropertyName = "live"
+	AXPropertyNameAtomic           AXPropertyName = "atomic"
+	AXPropertyNameRelevant         AXPropertyName = "relevant"
+	AXPropertyNameRoot             AXPropertyName = "root"
+	AXPropertyNameAutocomplete     AXPropertyName = "autocomplete"
+	AXPropertyNameHasPopup         AXPropertyName = "hasPopup"
+	AXPropertyNameLevel            AXPropertyName = "level"
+	AXPropertyNameMultiselectable  AXPropertyName = "multiselectable"
+	AXPropertyNameOrientation      AXPropertyName = "orientation"
+	AXPropertyNameMultiline        AXPropertyName = "multiline"
+	AXPropertyNameReadonly         AXPropertyName = "readonly"
+	AXPropertyNameRequired         AXPropertyName = "required"
+	AXPropertyNameValuemin         AXPropertyName = "valuemin"
+	AXPropertyNameValuemax         AXPropertyName = "valuemax"
+	AXPropertyNameValuetext        AXPropertyName = "valuetext"
+	AXPropertyNameChecked          AXPropertyName = "checked"
+	AXPropertyNameExpanded         AXPropertyName = "expanded"
+	AXPropertyNameModal            AXPropertyName = "modal"
+	AXPropertyNamePressed          AXPropertyName = "pressed"
+	AXPropertyNameSelected         AXPropertyName = "selected"
+	AXPropertyNameActivedescendant AXPropertyName = "activedescendant"
+	AXPropertyNameControls         AXPropertyName = "controls"
+	AXPropertyNameDescribedby      AXPropertyName = "describedby"
+	AXPropertyNameDetails          AXPropertyName = "details"
+	AXPropertyNameErrormessage     AXPropertyName = "errormessage"
+	AXPropertyNameFlowto           AXPropertyName = "flowto"
+	AXPropertyNameLabelledby       AXPropertyName = "labelledby"
+	AXPropertyNameOwns             AXPropertyName = "owns"
+	AXPropertyNameURL              AXPropertyName = "url"
+)
+
+// AXNode is the Accessibility.AXNode type.
+//
+// A node in the accessibility tree.
+type AXNode struct {
+	// Unique identifier for this node.
+	NodeID AXNodeID `json:"nodeId"`
+	// Whether this node is ignored for accessibility
+	Ignored bool `json:"ignored"`
+	// Collection of reasons why this node is hidden.
+	IgnoredReasons []AXProperty `json:"ignoredReasons,omitempty"`
+	// This `Node`'s role, whether explicit or implicit.
+	Role *AXValue `json:"role,omitempty"`
+	// This `Node`'s Chrome raw role.
+	ChromeRole *AXValue `json:"chromeRole,omitempty"`
+	// The accessible name for this `Node`.
+	Name *AXValue `json:"name,omitempty"`
+	// The accessible description for this `Node`.
+	Description *AXValue `json:"description,omitempty"`
+	// The value for this `Node`.
+	Value *AXValue `json:"value,omitempty"`
+	// All other properties
+	Properties []AXProperty `json:"properties,omitempty"`
+	// ID for this node's parent.
+	ParentID AXNodeID `json:"parentId,omitempty"`
+	// IDs for each of this node's child nodes.
+	ChildIDs []AXNodeID `json:"childIds,omitempty"`
+	// The backend ID for the associated DOM node, if any.
+	BackendDOMNodeID *dom.BackendNodeID `json:"backendDOMNodeId,omitempty"`
+	// The frame ID for the frame associated with this nodes document.
+	FrameID page.FrameID `json:"frameId,omitempty"`
+}
+
+// Disable calls Accessibility.disable.
+//
+// Disables the accessibility domain.
+func Disable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Accessibility.disable", nil, sessionID, nil)
+}
+
+// Enable calls Accessibility.enable.
+//
+// Enables the accessibility domain which causes `AXNodeId`s to remain consistent between method calls.
+// This turns on accessibility for the page, which can impact performance until accessibility is disabled.
+func Enable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Accessibility.enable", nil, sessionID, nil)
+}
+
+// GetPartialAXTreeParams holds the parameters of Accessibility.getPartialAXTree.
+type GetPartialAXTreeParams struct {
+	// Identifier of the node to get the partial accessibility tree for.
+	NodeID *dom.NodeID `json:"nodeId,omitempty"`
+	// Identifier of the backend node to get the partial accessibility tree for.
+	BackendNodeID *dom.BackendNodeID `json:"backendNodeId,omitempty"`
+	// JavaScript object id of the node wrapper to get the partial accessibility tree for.
+	ObjectID runtime.RemoteObjectID `json:"objectId,omitempty"`
+	// Whether to fetch this node's ancestors, siblings and children. Defaults to true.
+	FetchRelatives *bool `json:"fetchRelatives,omitempty"`
+}
+
+// GetPartialAXTreeResult holds the result of Accessibility.getPartialAXTree.
+type GetPartialAXTreeResult struct {
+	// The `Accessibility.AXNode` for this DOM node, if it exists, plus its ancestors, siblings and
+	// children, if requested.
+	Nodes []AXNode `json:"nodes"`
+}
+
+// GetPartialAXTree calls Accessibility.getPartialAXTree.
+//
+// Fetches the accessibility node and partial accessibility tree for this DOM node, if it exists.
+//
+// Experimental.
+func GetPartialAXTree(ctx context.Context, c *internal.Client, sessionID string, params *GetPartialAXTreeParams) (*GetPartialAXTreeResult, error) {
+	if params == nil {
+		params = &GetPartialAXTreeParams{}
+	}
+	var result GetPartialAXTreeResult
+	err := c.Call(ctx, "Accessibility.getPartialAXTree", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetFullAXTreeParams holds the parameters of Accessibility.getFullAXTree.
+type GetFullAXTreeParams struct {
+	// The maximum depth at which descendants of the root node should be retrieved.
+	// If omitted, the full tree is returned.
+	Depth *int64 `json:"depth,omitempty"`
+	// The frame for whose document the AX tree should be retrieved.
+	// If omitted, the root frame is used.
+	FrameID page.FrameID `json:"frameId,omitempty"`
+}
+
+// GetFullAXTreeResult holds the result of Accessibility.getFullAXTree.
+type GetFullAXTreeResult struct {
+	Nodes []AXNode `json:"nodes"`
+}
+
+// GetFullAXTree calls Accessibility.getFullAXTree.
+//
+// # Fetches the entire accessibility tree for the root Document
+//
+// Experimental.
+func GetFullAXTree(ctx context.Context, c *internal.Client, sessionID string, params *GetFullAXTreeParams) (*GetFullAXTreeResult, error) {
+	if params == nil {
+		params = &GetFullAXTreeParams{}
+	}
+	var result GetFullAXTreeResult
+	err := c.Call(ctx, "Accessibility.getFullAXTree", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetRootAXNodeParams holds the parameters of Accessibility.getRootAXNode.
+type GetRootAXNodeParams struct {
+	// The frame in whose document the node resides.
+	// If omitted, the root frame is used.
+	FrameID page.FrameID `json:"frameId,omitempty"`
+}
+
+// GetRootAXNodeResult holds the result of Accessibility.getRootAXNode.
+type GetRootAXNodeResult struct {
+	Node AXNode `json:"node"`
+}
+
+// GetRootAXNode calls Accessibility.getRootAXNode.
+//
+// Fetches the root node.
+// Requires `enable()` to have been called previously.
+//
+// Experimental.
+func GetRootAXNode(ctx context.Context, c *internal.Client, sessionID string, params *GetRootAXNodeParams) (*GetRootAXNodeResult, error) {
+	if params == nil {
+		params = &GetRootAXNodeParams{}
+	}
+	var result GetRootAXNodeResult
+	err := c.Call(ctx, "Accessibility.getRootAXNode", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetAXNodeAndAncestorsParams holds the parameters of Accessibility.getAXNodeAndAncestors.
+type GetAXNodeAndAncestorsParams struct {
+	// Identifier of the node to get.
+	NodeID *dom.NodeID `json:"nodeId,omitempty"`
+	// Identifier of the backend node to get.
+	BackendNodeID *dom.BackendNodeID `json:"backendNodeId,omitempty"`
+	// JavaScript object id of the node wrapper to get.
+	ObjectID runtime.RemoteObjectID `json:"objectId,omitempty"`
+}
+
+// GetAXNodeAndAncestorsResult holds the result of Accessibility.getAXNodeAndAncestors.
+type GetAXNodeAndAncestorsResult struct {
+	Nodes []AXNode `json:"nodes"`
+}
+
+// GetAXNodeAndAncestors calls Accessibility.getAXNodeAndAncestors.
+//
+// Fetches a node and all ancestors up to and including the root.
+// Requires `enable()` to have been called previously.
+//
+// Experimental.
+func GetAXNodeAndAncestors(ctx context.Context, c *internal.Client, sessionID string, params *GetAXNodeAndAncestorsParams) (*GetAXNodeAndAncestorsResult, error) {
+	if params == nil {
+		params = &GetAXNodeAndAncestorsParams{}
+	}
+	var result GetAXNodeAndAncestorsResult
+	err := c.Call(ctx, "Accessibility.getAXNodeAndAncestors", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetChildAXNodesParams holds the parameters of Accessibility.getChildAXNodes.
+type GetChildAXNodesParams struct {
+	ID AXNodeID `json:"id"`
+	// The frame in whose document the node resides.
+	// If omitted, the root frame is used.
+	FrameID page.FrameID `json:"frameId,omitempty"`
+}
+
+// GetChildAXNodesResult holds the result of Accessibility.getChildAXNodes.
+type GetChildAXNodesResult struct {
+	Nodes []AXNode `json:"nodes"`
+}
+
+// GetChildAXNodes calls Accessibility.getChildAXNodes.
+//
+// Fetches a particular accessibility node by AXNodeId.
+// Requires `enable()` to have been called previously.
+//
+// Experimental.
+func GetChildAXNodes(ctx context.Context, c *internal.Client, sessionID string, params *GetChildAXNodesParams) (*GetChildAXNodesResult, error) {
+	if params == nil {
+		params = &GetChildAXNodesParams{}
+	}
+	var result GetChildAXNodesResult
+	err := c.Call(ctx, "Accessibility.getChildAXNodes", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// QueryAXTreeParams holds the parameters of Accessibility.queryAXTree.
+type QueryAXTreeParams struct {
+	// Identifier of the node for the root to query.
+	NodeID *dom.NodeID `json:"nodeId,omitempty"`
+	// Identifier of the backend node for the root to query.
+	BackendNodeID *dom.BackendNodeID `json:"backendNodeId,omitempty"`
+	// JavaScript object id of the node wrapper for the root to query.
+	ObjectID runtime.RemoteObjectID `json:"objectId,omitempty"`
+	// Find nodes with this computed name.
+	AccessibleName string `json:"accessibleName,omitempty"`
+	// Find nodes with this computed role.
+	Role string `json:"role,omitempty"`
+}
+
+// QueryAXTreeResult holds the result of Accessibility.queryAXTree.
+type QueryAXTreeResult struct {
+	// A list of `Accessibility.AXNode` matching the specified attributes,
+	// including nodes that are ignored for accessibility.
+	Nodes []AXNode `json:"nodes"`
+}
+
+// QueryAXTree calls Accessibility.queryAXTree.
+//
+// Query a DOM node's accessibility subtree for accessible name and role.
+// This command computes the name and role for all nodes in the subtree, including those that are
+// ignored for accessibility, and returns those that match the specified name and role. If no DOM
+// node is specified, or the DOM node does not exist, the command returns an error. If neither
+// `accessibleName` or `role` is specified, it returns all the accessibility nodes in the subtree.
+//
+// Experimental.
+func QueryAXTree(ctx context.Context, c *internal.Client, sessionID string, params *QueryAXTreeParams) (*QueryAXTreeResult, error) {
+	if params == nil {
+		params = &QueryAXTreeParams{}
+	}
+	var result QueryAXTreeResult
+	err := c.Call(ctx, "Accessibility.queryAXTree", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// EventLoadComplete is the method name of the Accessibility.loadComplete event.
+const EventLoadComplete = "Accessibility.loadComplete"
+
+// LoadCompleteEvent is the payload of the Accessibility.loadComplete event.
+//
+// The loadComplete event mirrors the load complete event sent by the browser to assistive
+// technology when the web page has finished loading.
+//
+// Experimental.
+type LoadCompleteEvent struct {
+	// New document root node.
+	Root AXNode `json:"root"`
+}
+
+// EventNodesUpdated is the method name of the Accessibility.nodesUpdated event.
+const EventNodesUpdated = "Accessibility.nodesUpdated"
+
+// NodesUpdatedEvent is the payload of the Accessibility.nodesUpdated event.
+//
+// The nodesUpdated event is sent every time a previously requested node has changed the in tree.
+//
+// Experimental.
+type NodesUpdatedEvent struct {
+	// Updated node data.
+	Nodes []AXNode `json:"nodes"`
+}
+
+// ParseEvent decodes a Accessibility event message into its typed event struct.
+func ParseEvent(msg *internal.CDPMessage) (any, error) {
+	var v any
+	switch msg.Method {
+	case EventLoadComplete:
+		v = &LoadCompleteEvent{}
+	case EventNodesUpdated:
+		v = &NodesUpdatedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Accessibility event: %s", msg.Method)
+	}
+	if msg.Params == nil {
+		return v, nil
+	}
+	err := json.Unmarshal(msg.Params, v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,357 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package animation provides typed bindings for the CDP Animation domain.
+package animation
+
+import (
+	"cdp/internal"
+	"cdp/protocol/dom"
+	"cdp/protocol/runtime"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Animation is the Animation.Animation type.
+//
+// Animation instance.
+type Animation struct {
+	// `Animation`'s id.
+	ID string `json:"id"`
+	// `Animation`'s name.
+	Name string `json:"name"`
+	// `Animation`'s internal paused state.
+	PausedState bool `json:"pausedState"`
+	// `Animation`'s play state.
+	PlayState string `json:"playState"`
+	// `Animation`'s playback rate.
+	PlaybackRate float64 `json:"playbackRate"`
+	// `Animation`'s start time.
+	// Milliseconds for time based animations and
+	// percentage [0 - 100] for scroll driven animations
+	// (i.e. when viewOrScrollTimeline exists).
+	StartTime float64 `json:"startTime"`
+	// `Animation`'s current time.
+	CurrentTime float64 `json:"currentTime"`
+	// Animation type of `Animation`.
+	Type string `json:"type"`
+	// `Animation`'s source animation node.
+	Source *AnimationEffect `json:"source,omitempty"`
+	// A unique ID for `Animation` representing the sources that triggered this CSS
+	// animation/transition.
+	CSSID string `json:"cssId,omitempty"`
+	// View or scroll timeline
+	ViewOrScrollTimeline *ViewOrScrollTimeline `json:"viewOrScrollTimeline,omitempty"`
+}
+
+// ViewOrScrollTimeline is the Animation.ViewOrScrollTimeline type.
+//
+// Timeline instance
+type ViewOrScrollTimeline struct {
+	// Scroll container node
+	SourceNodeID *dom.BackendNodeID `json:"sourceNodeId,omitempty"`
+	// Represents the starting scroll position of the timeline
+	// as a length offset in pixels from scroll origin.
+	StartOffset *float64 `json:"startOffset,omitempty"`
+	// Represents the ending scroll position of the timeline
+	// as a length offset in pixels from scroll origin.
+	EndOffset *float64 `json:"endOffset,omitempty"`
+	// The element whose principal box's visibility in the
+	// scrollport defined the progress of the timeline.
+	// Does not exist for animations with ScrollTimeline
+	SubjectNodeID *dom.BackendNodeID `json:"subjectNodeId,omitempty"`
+	// Orientation of the scroll
+	Axis dom.ScrollOrientation `json:"axis"`
+}
+
+// AnimationEffect is the Animation.AnimationEffect type.
+//
+// AnimationEffect instance
+type AnimationEffect struct {
+	// `AnimationEffect`'s delay.
+	Delay float64 `json:"delay"`
+	// `AnimationEffect`'s end delay.
+	EndDelay float64 `json:"endDelay"`
+	// `AnimationEffect`'s iteration start.
+	IterationStart float64 `json:"iterationStart"`
+	// `AnimationEffect`'s iterations.
+	Iterations float64 `json:"iterations"`
+	// `AnimationEffect`'s iteration duration.
+	// Milliseconds for time based animations and
+	// percentage [0 - 100] for scroll driven animations
+	// (i.e. when viewOrScrollTimeline exists).
+	Duration float64 `json:"duration"`
+	// `AnimationEffect`'s playback direction.
+	Direction string `json:"direction"`
+	// `AnimationEffect`'s fill mode.
+	Fill string `json:"fill"`
+	// `AnimationEffect`'s target node.
+	BackendNodeID *dom.BackendNodeID `json:"backendNodeId,omitempty"`
+	// `AnimationEffect`'s keyframes.
+	KeyframesRule *KeyframesRule `json:"keyframesRule,omitempty"`
+	// `AnimationEffect`'s timing function.
+	Easing string `json:"easing"`
+}
+
+// KeyframesRule is the Animation.KeyframesRule type.
+//
+// Keyframes Rule
+type KeyframesRule struct {
+	// CSS keyframed animation's name.
+	Name string `json:"name,omitempty"`
+	// List of animation keyframes.
+	Keyframes []KeyframeStyle `json:"keyframes"`
+}
+
+// KeyframeStyle is the Animation.KeyframeStyle type.
+//
+// Keyframe Style
+type KeyframeStyle struct {
+	// Keyframe's time offset.
+	Offset string `json:"offset"`
+	// `AnimationEffect`'s timing function.
+	Easing string `json:"easing"`
+}
+
+// Disable calls Animation.disable.
+//
+// Disables animation domain notifications.
+func Disable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Animation.disable", nil, sessionID, nil)
+}
+
+// Enable calls Animation.enable.
+//
+// Enables animation domain notifications.
+func Enable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Animation.enable", nil, sessionID, nil)
+}
+
+// GetCurrentTimeParams holds the parameters of Animation.getCurrentTime.
+type GetCurrentTimeParams struct {
+	// Id of animation.
+	ID string `json:"id"`
+}
+
+// GetCurrentTimeResult holds the result of Animation.getCurrentTime.
+type GetCurrentTimeResult struct {
+	// Current time of the page.
+	CurrentTime float64 `json:"currentTime"`
+}
+
+// GetCurrentTime calls Animation.getCurrentTime.
+//
+// Returns the current time of the an animation.
+func GetCurrentTime(ctx context.Context, c *internal.Client, sessionID string, params *GetCurrentTimeParams) (*GetCurrentTimeResult, error) {
+	if params == nil {
+		params = &GetCurrentTimeParams{}
+	}
+	var result GetCurrentTimeResult
+	err := c.Call(ctx, "Animation.getCurrentTime", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetPlaybackRateResult holds the result of Animation.getPlaybackRate.
+type GetPlaybackRateResult struct {
+	// Playback rate for animations on page.
+	PlaybackRate float64 `json:"playbackRate"`
+}
+
+// GetPlaybackRate calls Animation.getPlaybackRate.
+//
+// Gets the playback rate of the document timeline.
+func GetPlaybackRate(ctx context.Context, c *internal.Client, sessionID string) (*GetPlaybackRateResult, error) {
+	var result GetPlaybackRateResult
+	err := c.Call(ctx, "Animation.getPlaybackRate", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ReleaseAnimationsParams holds the parameters of Animation.releaseAnimations.
+type ReleaseAnimationsParams struct {
+	// List of animation ids to seek.
+	Animations []string `json:"animations"`
+}
+
+// ReleaseAnimations calls Animation.releaseAnimations.
+//
+// Releases a set of animations to no longer be manipulated.
+func ReleaseAnimations(ctx context.Context, c *internal.Client, sessionID string, params *ReleaseAnimationsParams) error {
+	if params == nil {
+		params = &ReleaseAnimationsParams{}
+	}
+	return c.Call(ctx, "Animation.releaseAnimations", params, sessionID, nil)
+}
+
+// ResolveAnimationParams holds the parameters of Animation.resolveAnimation.
+type ResolveAnimationParams struct {
+	// Animation id.
+	AnimationID string `json:"animationId"`
+}
+
+// ResolveAnimationResult holds the result of Animation.resolveAnimation.
+type ResolveAnimationResult struct {
+	// Corresponding remote object.
+	RemoteObject runtime.RemoteObject `json:"remoteObject"`
+}
+
+// ResolveAnimation calls Animation.resolveAnimation.
+//
+// Gets the remote object of the Animation.
+func ResolveAnimation(ctx context.Context, c *internal.Client, sessionID string, params *ResolveAnimationParams) (*ResolveAnimationResult, error) {
+	if params == nil {
+		params = &ResolveAnimationParams{}
+	}
+	var result ResolveAnimationResult
+	err := c.Call(ctx, "Animation.resolveAnimation", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SeekAnimationsParams holds the parameters of Animation.seekAnimations.
+type SeekAnimationsParams struct {
+	// List of animation ids to seek.
+	Animations []string `json:"animations"`
+	// Set the current time of each animation.
+	CurrentTime float64 `json:"currentTime"`
+}
+
+// SeekAnimations calls Animation.seekAnimations.
+//
+// Seek a set of animations to a particular time within each animation.
+func SeekAnimations(ctx context.Context, c *internal.Client, sessionID string, params *SeekAnimationsParams) error {
+	if params == nil {
+		params = &SeekAnimationsParams{}
+	}
+	return c.Call(ctx, "Animation.seekAnimations", params, sessionID, nil)
+}
+
+// SetPausedParams holds the parameters of Animation.setPaused.
+type SetPausedParams struct {
+	// Animations to set the pause state of.
+	Animations []string `json:"animations"`
+	// Paused state to set to.
+	Paused bool `json:"paused"`
+}
+
+// SetPaused calls Animation.setPaused.
+//
+// Sets the paused state of a set of animations.
+func SetPaused(ctx context.Context, c *internal.Client, sessionID string, params *SetPausedParams) error {
+	if params == nil {
+		params = &SetPausedParams{}
+	}
+	return c.Call(ctx, "Animation.setPaused", params, sessionID, nil)
+}
+
+// SetPlaybackRateParams holds the parameters of Animation.setPlaybackRate.
+type SetPlaybackRateParams struct {
+	// Playback rate for animations on page
+	PlaybackRate float64 `json:"playbackRate"`
+}
+
+// SetPlaybackRate calls Animation.setPlaybackRate.
+//
+// Sets the playback rate of the document timeline.
+func SetPlaybackRate(ctx context.Context, c *internal.Client, sessionID string, params *SetPlaybackRateParams) error {
+	if params == nil {
+		params = &SetPlaybackRateParams{}
+	}
+	return c.Call(ctx, "Animation.setPlaybackRate", params, sessionID, nil)
+}
+
+// SetTimingParams holds the parameters of Animation.setTiming.
+type SetTimingParams struct {
+	// Animation id.
+	AnimationID string `json:"animationId"`
+	// Duration of the animation.
+	Duration float64 `json:"duration"`
+	// Delay of the animation.
+	Delay float64 `json:"delay"`
+}
+
+// SetTiming calls Animation.setTiming.
+//
+// Sets the timing of an animation node.
+func SetTiming(ctx context.Context, c *internal.Client, sessionID string, params *SetTimingParams) error {
+	if params == nil {
+		params = &SetTimingParams{}
+	}
+	return c.Call(ctx, "Animation.setTiming", params, sessionID, nil)
+}
+
+// EventAnimationCanceled is the method name of the Animation.animationCanceled event.
+const EventAnimationCanceled = "Animation.animationCanceled"
+
+// AnimationCanceledEvent is the payload of the Animation.animationCanceled event.
+//
+// Event for when an animation has been cancelled.
+type AnimationCanceledEvent struct {
+	// Id of the animation that was cancelled.
+	ID string `json:"id"`
+}
+
+// EventAnimationCreated is the method name of the Animation.animationCreated event.
+const EventAnimationCreated = "Animation.animationCreated"
+
+// AnimationCreatedEvent is the payload of the Animation.animationCreated event.
+//
+// Event for each animation that has been created.
+type AnimationCreatedEvent struct {
+	// Id of the animation that was created.
+	ID string `json:"id"`
+}
+
+// EventAnimationStarted is the method name of the Animation.animationStarted event.
+const EventAnimationStarted = "Animation.animationStarted"
+
+// AnimationStartedEvent is the payload of the Animation.animationStarted event.
+//
+// Event for animation that has been started.
+type AnimationStartedEvent struct {
+	// Animation that was started.
+	Animation Animation `json:"animation"`
+}
+
+// EventAnimationUpdated is the method name of the Animation.animationUpdated event.
+const EventAnimationUpdated = "Animation.animationUpdated"
+
+// AnimationUpdatedEvent is the payload of the Animation.animationUpdated event.
+//
+// Event for animation that has been updated.
+type AnimationUpdatedEvent struct {
+	// Animation that was updated.
+	Animation Animation `json:"animation"`
+}
+
+// ParseEvent decodes a Animation event message into its typed event struct.
+func ParseEvent(msg *internal.CDPMessage) (any, error) {
+	var v any
+	switch msg.Method {
+	case EventAnimationCanceled:
+		v = &AnimationCanceledEvent{}
+	case EventAnimationCreated:
+		v = &AnimationCreatedEvent{}
+	case EventAnimationStarted:
+		v = &AnimationStartedEvent{}
+	case EventAnimationUpdated:
+		v = &AnimationUpdatedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Animation event: %s", msg.Method)
+	}
+	if msg.Params == nil {
+		return v, nil
+	}
+	err := json.Unmarshal(msg.Params, v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,808 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package audits provides typed bindings for the CDP Audits domain.
+//
+// Audits domain allows investigation of page violations and possible improvements.
+package audits
+
+import (
+	"cdp/internal"
+	"cdp/protocol/dom"
+	"cdp/protocol/network"
+	"cdp/protocol/page"
+	"cdp/protocol/runtime"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// AffectedCookie is the Audits.AffectedCookie type.
+//
+// Information about a cookie that is affected by an inspector issue.
+type AffectedCookie struct {
+	// The following three properties uniquely identify a cookie
+	Name   string `json:"name"`
+	Path   string `json:"path"`
+	Domain string `json:"domain"`
+}
+
+// AffectedRequest is the Audits.AffectedRequest type.
+//
+// Information about a request that is affected by an inspector issue.
+type AffectedRequest struct {
+	// The unique request id.
+	RequestID network.RequestID `json:"requestId"`
+	URL       string            `json:"url,omitempty"`
+}
+
+// AffectedFrame is the Audits.AffectedFrame type.
+//
+// Information about the frame affected by an inspector issue.
+type AffectedFrame struct {
+	FrameID page.FrameID `json:"frameId"`
+}
+
+// CookieExclusionReason is the Audits.CookieExclusionReason type.
+type CookieExclusionReason string
+
+const (
+	CookieExclusionReasonExcludeSameSiteUnspecifiedTreatedAsLax        CookieExclusionReason = "ExcludeSameSiteUnspecifiedTreatedAsLax"
+	CookieExclusionReasonExcludeSameSiteNoneInsecure                   CookieExclusionReason = "ExcludeSameSiteNoneInsecure"
+	CookieExclusionReasonExcludeSameSiteLax                            CookieExclusionReason = "ExcludeSameSiteLax"
+	CookieExclusionReasonExcludeSameSiteStrict                         CookieExclusionReason = "ExcludeSameSiteStrict"
+	CookieExclusionReasonExcludeInvalidSameParty                       CookieExclusionReason = "ExcludeInvalidSameParty"
+	CookieExclusionReasonExcludeSamePartyCrossPartyContext             CookieExclusionReason = "ExcludeSamePartyCrossPartyContext"
+	CookieExclusionReasonExcludeDomainNonASCII                         CookieExclusionReason = "ExcludeDomainNonASCII"
+	CookieExclusionReasonExcludeThirdPartyCookieBlockedInFirstPartySet CookieExclusionReason = "ExcludeThirdPartyCookieBlockedInFirstPartySet"
+	CookieExclusionReasonExcludeThirdPartyPhaseout                     CookieExclusionReason = "ExcludeThirdPartyPhaseout"
+)
+
+// CookieWarningReason is the Audits.CookieWarningReason type.
+type CookieWarningReason string
+
+const (
+	CookieWarningReasonWarnSameSiteUnspecifiedCrossSiteContext        CookieWarningReason = "WarnSameSiteUnspecifiedCrossSiteContext"
+	CookieWarningReasonWarnSameSiteNoneInsecure                       CookieWarningReason = "WarnSameSiteNoneInsecure"
+	CookieWarningReasonWarnSameSiteUnspecifiedLaxAllowUnsafe          CookieWarningReason = "WarnSameSiteUnspecifiedLaxAllowUnsafe"
+	CookieWarningReasonWarnSameSiteStrictLaxDowngradeStrict           CookieWarningReason = "WarnSameSiteStrictLaxDowngradeStrict"
+	CookieWarningReasonWarnSameSiteStrictCrossDowngradeStrict         CookieWarningReason = "WarnSameSiteStrictCrossDowngradeStrict"
+	CookieWarningReasonWarnSameSiteStrictCrossDowngradeLax            CookieWarningReason = "WarnSameSiteStrictCrossDowngradeLax"
+	CookieWarningReasonWarnSameSiteLaxCrossDowngradeStrict            CookieWarningReason = "WarnSameSiteLaxCrossDowngradeStrict"
+	CookieWarningReasonWarnSameSiteLaxCrossDowngradeLax               CookieWarningReason = "WarnSameSiteLaxCrossDowngradeLax"
+	CookieWarningReasonWarnAttributeValueExceedsMaxSize               CookieWarningReason = "WarnAttributeValueExceedsMaxSize"
+	CookieWarningReasonWarnDomainNonASCII                             CookieWarningReason = "WarnDomainNonASCII"
+	CookieWarningReasonWarnThirdPartyPhaseout                         CookieWarningReason = "WarnThirdPartyPhaseout"
+	CookieWarningReasonWarnCrossSiteRedirectDowngradeChangesInclusion CookieWarningReason = "WarnCrossSiteRedirectDowngradeChangesInclusion"
+)
+
+// CookieOperation is the Audits.CookieOperation type.
+type CookieOperation string
+
+const (
+	CookieOperationSetCookie  CookieOperation = "SetCookie"
+	CookieOperationReadCookie CookieOperation = "ReadCookie"
+)
+
+// CookieIssueDetails is the Audits.CookieIssueDetails type.
+//
+// This information is currently necessary, as the front-end has a difficult
+// time finding a specific cookie. With this, we can convey specific error
+// information without the cookie.
+type CookieIssueDetails struct {
+	// If AffectedCookie is not set then rawCookieLine contains the raw
+	// Set-Cookie header string. This hints at a problem where the
+	// cookie line is syntactically or semantically malformed in a way
+	// that no valid cookie could be created.
+	Cookie                 *AffectedCookie         `json:"cookie,omitempty"`
+	RawCookieLine          string                  `json:"rawCookieLine,omitempty"`
+	CookieWarningReasons   []CookieWarningReason   `json:"cookieWarningReasons"`
+	CookieExclusionReasons []CookieExclusionReason `json:"cookieExclusionReasons"`
+	// Optionally identifies the site-for-cookies and the cookie url, which
+	// may be used by the front-end as additional context.
+	Operation      CookieOperation  `json:"operation"`
+	SiteForCookies string           `json:"siteForCookies,omitempty"`
+	CookieURL      string           `json:"cookieUrl,omitempty"`
+	Request        *AffectedRequest `json:"request,omitempty"`
+}
+
+// MixedContentResolutionStatus is the Audits.MixedContentResolutionStatus type.
+type MixedContentResolutionStatus string
+
+const (
+	MixedContentResolutionStatusMixedContentBlocked               MixedContentResolutionStatus = "MixedContentBlocked"
+	MixedContentResolutionStatusMixedContentAutomaticallyUpgraded MixedContentResolutionStatus = "MixedContentAutomaticallyUpgraded"
+	MixedContentResolutionStatusMixedContentWarning               MixedContentResolutionStatus = "MixedContentWarning"
+)
+
+// MixedContentResourceType is the Audits.MixedContentResourceType type.
+type MixedContentResourceType string
+
+const (
+	MixedContentResourceTypeAttributionSrc   MixedContentResourceType = "AttributionSrc"
+	MixedContentResourceTypeAudio            MixedContentResourceType = "Audio"
+	MixedContentResourceTypeBeacon           MixedContentResourceType = "Beacon"
+	MixedContentResourceTypeCSPReport        MixedContentResourceType = "CSPReport"
+	MixedContentResourceTypeDownload         MixedContentResourceType = "Download"
+	MixedContentResourceTypeEventSource      MixedContentResourceType = "EventSource"
+	MixedContentResourceTypeFavicon          MixedContentResourceType = "Favicon"
+	MixedContentResourceTypeFont             MixedContentResourceType = "Font"
+	MixedContentResourceTypeForm             MixedContentResourceType = "Form"
+	MixedContentResourceTypeFrame            MixedContentResourceType = "Frame"
+	MixedContentResourceTypeImage            MixedContentResourceType = "Image"
+	MixedContentResourceTypeImport           MixedContentResourceType = "Import"
+	MixedContentResourceTypeJSON             MixedContentResourceType = "JSON"
+	MixedContentResourceTypeManifest         MixedContentResourceType = "Manifest"
+	MixedContentResourceTypePing             MixedContentResourceType = "Ping"
+	MixedContentResourceTypePluginData       MixedContentResourceType = "PluginData"
+	MixedContentResourceTypePluginResource   MixedContentResourceType = "PluginResource"
+	MixedContentResourceTypePrefetch         MixedContentResourceType = "Prefetch"
+	MixedContentResourceTypeResource         MixedContentResourceType = "Resource"
+	MixedContentResourceTypeScript           MixedContentResourceType = "Script"
+	MixedContentResourceTypeServiceWorker    MixedContentResourceType = "ServiceWorker"
+	MixedContentResourceTypeSharedWorker     MixedContentResourceType = "SharedWorker"
+	MixedContentResourceTypeSpeculationRules MixedContentResourceType = "SpeculationRules"
+	MixedContentResourceTypeStylesheet       MixedContentResourceType = "Stylesheet"
+	MixedContentResourceTypeTrack            MixedContentResourceType = "Track"
+	MixedContentResourceTypeVideo            MixedContentResourceType = "Video"
+	MixedContentResourceTypeWorker           MixedContentResourceType = "Worker"
+	MixedContentResourceTypeXMLHTTPRequest   MixedContentResourceType = "XMLHttpRequest"
+	MixedContentResourceTypeXSLT             MixedContentResourceType = "XSLT"
+)
+
+// MixedContentIssueDetails is the Audits.MixedContentIssueDetails type.
+type MixedContentIssueDetails struct {
+	// The type of resource causing the mixed content issue (css, js, iframe,
+	// form,...). Marked as optional because it is mapped to from
+	// blink::mojom::RequestContextType, which will be replaced
+	// by network::mojom::RequestDestination
+	ResourceType MixedContentResourceType `json:"resourceType,omitempty"`
+	// The way the mixed content issue is being resolved.
+	ResolutionStatus MixedContentResolutionStatus `json:"resolutionStatus"`
+	// The unsafe http url causing the mixed content issue.
+	InsecureURL string `json:"insecureURL"`
+	// The url responsible for the call to an unsafe url.
+	MainResourceURL string `json:"mainResourceURL"`
+	// The mixed content request.
+	// Does not always exist (e.g. for unsafe form submission urls).
+	Request *AffectedRequest `json:"request,omitempty"`
+	// Optional because not every mixed content issue is necessarily linked to a frame.
+	Frame *AffectedFrame `json:"frame,omitempty"`
+}
+
+// BlockedByResponseReason is the Audits.BlockedByResponseReason type.
+//
+// Enum indicating the reason a response has been blocked. These reasons are
+// refinements of the net error BLOCKED_BY_RESPONSE.
+type BlockedByResponseReason string
+
+const (
+	BlockedByResponseReasonCoepFrameResourceNeedsCoepHeader                        BlockedByResponseReason = "CoepFrameResourceNeedsCoepHeader"
+	BlockedByResponseReasonCoopSandboxedIFrameCannotNavigateToCoopPage             BlockedByResponseReason = "CoopSandboxedIFrameCannotNavigateToCoopPage"
+	BlockedByResponseReasonCorpNotSameOrigin                                       BlockedByResponseReason = "CorpNotSameOrigin"
+	BlockedByResponseReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoep       BlockedByResponseReason = "CorpNotSameOriginAfterDefaultedToSameOriginByCoep"
+	BlockedByResponseReasonCorpNotSameOriginAfterDefaultedToSameOriginByDip        BlockedByResponseReason = "CorpNotSameOriginAfterDefaultedToSameOriginByDip"
+	BlockedByResponseReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip BlockedByResponseReason = "CorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip"
+	BlockedByResponseReasonCorpNotSameSite                                         BlockedByResponseReason = "CorpNotSameSite"
+)
+
+// BlockedByResponseIssueDetails is the Audits.BlockedByResponseIssueDetails type.
+//
+// Details for a request that has been blocked with the BLOCKED_BY_RESPONSE
+// code. Currently only used for COEP/COOP, but may be extended to include
+// some CSP errors in the future.
+type BlockedByResponseIssueDetails struct {
+	Request      AffectedRequest         `json:"request"`
+	ParentFrame  *AffectedFrame          `json:"parentFrame,omitempty"`
+	BlockedFrame *AffectedFrame          `json:"blockedFrame,omitempty"`
+	Reason       BlockedByResponseReason `json:"reason"`
+}
+
+// HeavyAdResolutionStatus is the Audits.HeavyAdResolutionStatus type.
+type HeavyAdResolutionStatus string
+
+const (
+	HeavyAdResolutionStatusHeavyAdBlocked HeavyAdResolutionStatus = "HeavyAdBlocked"
+	HeavyAdResolutionStatusHeavyAdWarning HeavyAdResolutionStatus = "HeavyAdWarning"
+)
+
+// HeavyAdReason is the Audits.HeavyAdReason type.
+type HeavyAdReason string
+
+const (
+	HeavyAdReasonNetworkTotalLimit HeavyAdReason = "NetworkTotalLimit"
+	HeavyAdReasonCPUTotalLimit     HeavyAdReason = "CpuTotalLimit"
+	HeavyAdReasonCPUPeakLimit      HeavyAdReason = "CpuPeakLimit"
+)
+
+// HeavyAdIssueDetails is the Audits.HeavyAdIssueDetails type.
+type HeavyAdIssueDetails struct {
+	// The resolution status, either blocking the content or warning.
+	Resolution HeavyAdResolutionStatus `json:"resolution"`
+	// The reason the ad was blocked, total network or cpu or peak cpu.
+	Reason HeavyAdReason `json:"reason"`
+	// The frame that was blocked.
+	Frame AffectedFrame `json:"frame"`
+}
+
+// ContentSecurityPolicyViolationType is the Audits.ContentSecurityPolicyViolationType type.
+type ContentSecurityPolicyViolationType string
+
+const (
+	ContentSecurityPolicyViolationTypeKInlineViolation             ContentSecurityPolicyViolationType = "kInlineViolation"
+	ContentSecurityPolicyViolationTypeKEvalViolation               ContentSecurityPolicyViolationType = "kEvalViolation"
+	ContentSecurityPolicyViolationTypeKURLViolation                ContentSecurityPolicyViolationType = "kURLViolation"
+	ContentSecurityPolicyViolationTypeKTrustedTypesSinkViolation   ContentSecurityPolicyViolationType = "kTrustedTypesSinkViolation"
+	ContentSecurityPolicyViolationTypeKTrustedTypesPolicyViolation ContentSecurityPolicyViolationType = "kTrustedTypesPolicyViolation"
+	ContentSecurityPolicyViolationTypeKWasmEvalViolation           ContentSecurityPolicyViolationType = "kWasmEvalViolation"
+)
+
+// SourceCodeLocation is the Audits.SourceCodeLocation type.
+type SourceCodeLocation struct {
+	ScriptID     runtime.ScriptID `json:"scriptId,omitempty"`
+	URL          string           `json:"url"`
+	LineNumber   int64            `json:"lineNumber"`
+	ColumnNumber int64            `json:"columnNumber"`
+}
+
+// ContentSecurityPolicyIssueDetails is the Audits.ContentSecurityPolicyIssueDetails type.
+type ContentSecurityPolicyIssueDetails struct {
+	// The url not included in allowed sources.
+	BlockedURL string `json:"blockedURL,omitempty"`
+	// Specific directive that is violated, causing the CSP issue.
+	ViolatedDirective                  string                             `json:"violatedDirective"`
+	IsReportOnly                       bool                               `json:"isReportOnly"`
+	ContentSecurityPolicyViolationType ContentSecurityPolicyViolationType `json:"contentSecurityPolicyViolationType"`
+	FrameAncestor                      *AffectedFrame                     `json:"frameAncestor,omitempty"`
+	SourceCodeLocation                 *SourceCodeLocation                `json:"sourceCodeLocation,omitempty"`
+	ViolatingNodeID                    *dom.BackendNodeID                 `json:"violatingNodeId,omitempty"`
+}
+
+// SharedArrayBufferIssueType is the Audits.SharedArrayBufferIssueType type.
+type SharedArrayBufferIssueType string
+
+const (
+	SharedArrayBufferIssueTypeTransferIssue SharedArrayBufferIssueType = "TransferIssue"
+	SharedArrayBufferIssueTypeCreationIssue SharedArrayBufferIssueType = "CreationIssue"
+)
+
+// SharedArrayBufferIssueDetails is the Audits.SharedArrayBufferIssueDetails type.
+//
+// Details for a issue arising from an SAB being instantiated in, or
+// transferred to a context that is not cross-origin isolated.
+type SharedArrayBufferIssueDetails struct {
+	SourceCodeLocation SourceCodeLocation         `json:"sourceCodeLocation"`
+	IsWarning          bool                       `json:"isWarning"`
+	Type               SharedArrayBufferIssueType `json:"type"`
+}
+
+// LowTextContrastIssueDetails is the Audits.LowTextContrastIssueDetails type.
+type LowTextContrastIssueDetails struct {
+	ViolatingNodeID       dom.BackendNodeID `json:"violatingNodeId"`
+	ViolatingNodeSelector string            `json:"violatingNodeSelector"`
+	ContrastRatio         float64           `json:"contrastRatio"`
+	ThresholdAA           float64           `json:"thresholdAA"`
+	ThresholdAAA          float64           `json:"thresholdAAA"`
+	FontSize              string            `json:"fontSize"`
+	FontWeight            string            `json:"fontWeight"`
+}
+
+// CorsIssueDetails is the Audits.CorsIssueDetails type.
+//
+// Details for a CORS related issue, e.g. a warning or error related to
+// CORS RFC1918 enforcement.
+type CorsIssueDetails struct {
+	CorsErrorStatus        network.CorsErrorStatus      `json:"corsErrorStatus"`
+	IsWarning              bool                         `json:"isWarning"`
+	Request                AffectedRequest              `json:"request"`
+	Location               *SourceCodeLocation          `json:"location,omitempty"`
+	InitiatorOrigin        string                       `json:"initiatorOrigin,omitempty"`
+	ResourceIPAddressSpace network.IPAddressSpace       `json:"resourceIPAddressSpace,omitempty"`
+	ClientSecurityState    *network.ClientSecurityState `json:"clientSecurityState,omitempty"`
+}
+
+// AttributionReportingIssueType is the Audits.AttributionReportingIssueType type.
+type AttributionReportingIssueType string
+
+const (
+	AttributionReportingIssueTypePermissionPolicyDisabled                             AttributionReportingIssueType = "PermissionPolicyDisabled"
+	AttributionReportingIssueTypeUntrustworthyReportingOrigin                         AttributionReportingIssueType = "UntrustworthyReportingOrigin"
+	AttributionReportingIssueTypeInsecureContext                                      AttributionReportingIssueType = "InsecureContext"
+	AttributionReportingIssueTypeInvalidHeader                                        AttributionReportingIssueType = "InvalidHeader"
+	AttributionReportingIssueTypeInvalidRegisterTriggerHeader                         AttributionReportingIssueType = "InvalidRegisterTriggerHeader"
+	AttributionReportingIssueTypeSourceAndTriggerHeaders                              AttributionReportingIssueType = "SourceAndTriggerHeaders"
+	AttributionReportingIssueTypeSourceIgnored                                        AttributionReportingIssueType = "SourceIgnored"
+	AttributionReportingIssueTypeTriggerIgnored                                       AttributionReportingIssueType = "TriggerIgnored"
+	AttributionReportingIssueTypeOsSourceIgnored                                      AttributionReportingIssueType = "OsSourceIgnored"
+	AttributionReportingIssueTypeOsTriggerIgnored                                     AttributionReportingIssueType = "OsTriggerIgnored"
+	AttributionReportingIssueTypeInvalidRegisterOsSourceHeader                        AttributionReportingIssueType = "InvalidRegisterOsSourceHeader"
+	AttributionReportingIssueTypeInvalidRegisterOsTriggerHeader                       AttributionReportingIssueType = "InvalidRegisterOsTriggerHeader"
+	AttributionReportingIssueTypeWebAndOsHeaders                                      AttributionReportingIssueType = "WebAndOsHeaders"
+	AttributionReportingIssueTypeNoWebOrOsSupport                                     AttributionReportingIssueType = "NoWebOrOsSupport"
+	AttributionReportingIssueTypeNavigationRegistrationWithoutTransientUserActivation AttributionReportingIssueType = "NavigationRegistrationWithoutTransientUserActivation"
+	AttributionReportingIssueTypeInvalidInfoHeader                                    AttributionReportingIssueType = "InvalidInfoHeader"
+	AttributionReportingIssueTypeNoRegisterSourceHeader                               AttributionReportingIssueType = "NoRegisterSourceHeader"
+	AttributionReportingIssueTypeNoRegisterTriggerHeader                              AttributionReportingIssueType = "NoRegisterTriggerHeader"
+	AttributionReportingIssueTypeNoRegisterOsSourceHeader                             AttributionReportingIssueType = "NoRegisterOsSourceHeader"
+	AttributionReportingIssueTypeNoRegisterOsTriggerHeader                            AttributionReportingIssueType = "NoRegisterOsTriggerHeader"
+	AttributionReportingIssueTypeNavigationRegistrationUniqueScopeAlreadySet          AttributionReportingIssueType = "NavigationRegistrationUniqueScopeAlreadySet"
+)
+
+// SharedDictionaryError is the Audits.SharedDictionaryError type.
+type SharedDictionaryError string
+
+const (
+	SharedDictionaryErrorUseErrorCrossOriginNoCorsRequest          SharedDictionaryError = "UseErrorCrossOriginNoCorsRequest"
+	SharedDictionaryErrorUseErrorDictionaryLoadFailure             SharedDictionaryError = "UseErrorDictionaryLoadFailure"
+	SharedDictionaryErrorUseErrorMatchingDictionaryNotUsed         SharedDictionaryError = "UseErrorMatchingDictionaryNotUsed"
+	SharedDictionaryErrorUseErrorUnexpectedContentDictionaryHeader SharedDictionaryError = "UseErrorUnexpectedContentDictionaryHeader"
+	SharedDictionaryErrorWriteErrorCossOriginNoCorsRequest         SharedDictionaryError = "WriteErrorCossOriginNoCorsRequest"
+	SharedDictionaryErrorWriteErrorDisallowedBySettings            SharedDictionaryError = "WriteErrorDisallowedBySettings"
+	SharedDictionaryErrorWriteErrorExpiredResponse                 SharedDictionaryError = "WriteErrorExpiredResponse"
+	SharedDictionaryErrorWriteErrorFeatureDisabled                 SharedDictionaryError = "WriteErrorFeatureDisabled"
+	SharedDictionaryErrorWriteErrorInsufficientResources           SharedDictionaryError = "WriteErrorInsufficientResources"
+	SharedDictionaryErrorWriteErrorInvalidMatchField               SharedDictionaryError = "WriteErrorInvalidMatchField"
+	SharedDictionaryErrorWriteErrorInvalidStructuredHeader         SharedDictionaryError = "WriteErrorInvalidStructuredHeader"
+	SharedDictionaryErrorWriteErrorNavigationRequest               SharedDictionaryError = "WriteErrorNavigationRequest"
+	SharedDictionaryErrorWriteErrorNoMatchField                    SharedDictionaryError = "WriteErrorNoMatchField"
+	SharedDictionaryErrorWriteErrorNonListMatchDestField           SharedDictionaryError = "WriteErrorNonListMatchDestField"
+	SharedDictionaryErrorWriteErrorNonSecureContext                SharedDictionaryError = "WriteErrorNonSecureContext"
+	SharedDictionaryErrorWriteErrorNonStringIDField                SharedDictionaryError = "WriteErrorNonStringIdField"
+	SharedDictionaryErrorWriteErrorNonStringInMatchDestList        SharedDictionaryError = "WriteErrorNonStringInMatchDestList"
+	SharedDictionaryErrorWriteErrorNonStringMatchField             SharedDictionaryError = "WriteErrorNonStringMatchField"
+	SharedDictionaryErrorWriteErrorNonTokenTypeField               SharedDictionaryError = "WriteErrorNonTokenTypeField"
+	SharedDictionaryErrorWriteErrorRequestAborted                  SharedDictionaryError = "WriteErrorRequestAborted"
+	SharedDictionaryErrorWriteErrorShuttingDown                    SharedDictionaryError = "WriteErrorShuttingDown"
+	SharedDictionaryErrorWriteErrorTooLongIDField                  SharedDictionaryError = "WriteErrorTooLongIdField"
+	SharedDictionaryErrorWriteErrorUnsupportedType                 SharedDictionaryError = "WriteErrorUnsupportedType"
+)
+
+// AttributionReportingIssueDetails is the Audits.AttributionReportingIssueDetails type.
+//
+// Details for issues around "Attribution Reporting API" usage.
+// Explainer: https://github.com/WICG/attribution-reporting-api
+type AttributionReportingIssueDetails struct {
+	ViolationType    AttributionReportingIssueType `json:"violationType"`
+	Request          *AffectedRequest              `json:"request,omitempty"`
+	ViolatingNodeID  *dom.BackendNodeID            `json:"violatingNodeId,omitempty"`
+	InvalidParameter string                        `json:"invalidParameter,omitempty"`
+}
+
+// QuirksModeIssueDetails is the Audits.QuirksModeIssueDetails type.
+//
+// Details for issues about documents in Quirks Mode
+// or Limited Quirks Mode that affects page layouting.
+type QuirksModeIssueDetails struct {
+	// If false, it means the document's mode is "quirks"
+	// instead of "limited-quirks".
+	IsLimitedQuirksMode bool              `json:"isLimitedQuirksMode"`
+	DocumentNodeID      dom.BackendNodeID `json:"documentNodeId"`
+	URL                 string            `json:"url"`
+	FrameID             page.FrameID      `json:"frameId"`
+	LoaderID            network.LoaderID  `json:"loaderId"`
+}
+
+// NavigatorUserAgentIssueDetails is the Audits.NavigatorUserAgentIssueDetails type.
+//
+// Deprecated: this item is deprecated in the protocol.
+type NavigatorUserAgentIssueDetails struct {
+	URL      string              `json:"url"`
+	Location *SourceCodeLocation `json:"location,omitempty"`
+}
+
+// SharedDictionaryIssueDetails is the Audits.SharedDictionaryIssueDetails type.
+type SharedDictionaryIssueDetails struct {
+	SharedDictionaryError SharedDictionaryError `json:"sharedDictionaryError"`
+	Request               AffectedRequest       `json:"request"`
+}
+
+// GenericIssueErrorType is the Audits.GenericIssueErrorType type.
+type GenericIssueErrorType string
+
+const (
+	GenericIssueErrorTypeFormLabelForNameError                                      GenericIssueErrorType = "FormLabelForNameError"
+	GenericIssueErrorTypeFormDuplicateIDForInputError                               GenericIssueErrorType = "FormDuplicateIdForInputError"
+	GenericIssueErrorTypeFormInputWithNoLabelError                                  GenericIssueErrorType = "FormInputWithNoLabelError"
+	GenericIssueErrorTypeFormAutocompleteAttributeEmptyError                        GenericIssueErrorType = "FormAutocompleteAttributeEmptyError"
+	GenericIssueErrorTypeFormEmptyIDAndNameAttributesForInputError                  GenericIssueErrorType = "FormEmptyIdAndNameAttributesForInputError"
+	GenericIssueErrorTypeFormAriaLabelledByToNonExistingID                          GenericIssueErrorType = "FormAriaLabelledByToNonExistingId"
+	GenericIssueErrorTypeFormInputAssignedAutocompleteValueToIDOrNameAttributeError GenericIssueErrorType = "FormInputAssignedAutocompleteValueToIdOrNameAttributeError"
+	GenericIssueErrorTypeFormLabelHasNeitherForNorNestedInput                       GenericIssueErrorType = "FormLabelHasNeitherForNorNestedInput"
+	GenericIssueErrorTypeFormLabelForMatchesNonExistingIDError                      GenericIssueErrorType = "FormLabelForMatchesNonExistingIdError"
+	GenericIssueErrorTypeFormInputHasWrongButWellIntendedAutocompleteValueError     GenericIssueErrorType = "FormInputHasWrongButWellIntendedAutocompleteValueError"
+	GenericIssueErrorTypeResponseWasBlockedByORB                                    GenericIssueErrorType = "ResponseWasBlockedByORB"
+)
+
+// GenericIssueDetails is the Audits.GenericIssueDetails type.
+//
+// Depending on the concrete errorType, different properties are set.
+type GenericIssueDetails struct {
+	// Issues with the same errorType are aggregated in the frontend.
+	ErrorType              GenericIssueErrorType `json:"errorType"`
+	FrameID                page.FrameID          `json:"frameId,omitempty"`
+	ViolatingNodeID        *dom.BackendNodeID    `json:"violatingNodeId,omitempty"`
+	ViolatingNodeAttribute string                `json:"violatingNodeAttribute,omitempty"`
+	Request                *AffectedRequest      `json:"request,omitempty"`
+}
+
+// DeprecationIssueDetails is the Audits.DeprecationIssueDetails type.
+//
+// This issue tracks information needed to print a deprecation message.
+// https://source.chromium.org/chromium/chromium/src/+/main:third_party/blink/renderer/core/frame/third_party/blink/renderer/core/frame/deprecation/README.md
+type DeprecationIssueDetails struct {
+	AffectedFrame      *AffectedFrame     `json:"affectedFrame,omitempty"`
+	SourceCodeLocation SourceCodeLocation `json:"sourceCodeLocation"`
+	// One of the deprecation names from third_party/blink/renderer/core/frame/deprecation/deprecation.json5
+	Type string `json:"type"`
+}
+
+// BounceTrackingIssueDetails is the Audits.BounceTrackingIssueDetails type.
+//
+// This issue warns about sites in the redirect chain of a finished navigation
+// that may be flagged as trackers and have their state cleared if they don't
+// receive a user interaction. Note that in this context 'site' means eTLD+1.
+// For example, if the URL `https://example.test:80/bounce` was in the
+// redirect chain, the site reported would be `example.test`.
+type BounceTrackingIssueDetails struct {
+	TrackingSites []string `json:"trackingSites"`
+}
+
+// CookieDeprecationMetadataIssueDetails is the Audits.CookieDeprecationMetadataIssueDetails type.
+//
+// This issue warns about third-party sites that are accessing cookies on the
+// current page, and have been permitted due to having a global metadata grant.
+// Note that in this context 'site' means eTLD+1. For example, if the URL
+// `https://example.test:80/web_page` was accessing cookies, the site reported
+// would be `example.test`.
+type CookieDeprecationMetadataIssueDetails struct {
+	AllowedSites     []string        `json:"allowedSites"`
+	OptOutPercentage float64         `json:"optOutPercentage"`
+	IsOptOutTopLevel bool            `json:"isOptOutTopLevel"`
+	Operation        CookieOperation `json:"operation"`
+}
+
+// ClientHintIssueReason is the Audits.ClientHintIssueReason type.
+type ClientHintIssueReason string
+
+const (
+	ClientHintIssueReasonMetaTagAllowListInvalidOrigin ClientHintIssueReason = "MetaTagAllowListInvalidOrigin"
+	ClientHintIssueReasonMetaTagModifiedHTML           ClientHintIssueReason = "MetaTagModifiedHTML"
+)
+
+// FederatedAuthRequestIssueDetails is the Audits.FederatedAuthRequestIssueDetails type.
+type FederatedAuthRequestIssueDetails struct {
+	FederatedAuthRequestIssueReason FederatedAuthRequestIssueReason `json:"federatedAuthRequestIssueReason"`
+}
+
+// FederatedAuthRequestIssueReason is the Audits.FederatedAuthRequestIssueReason type.
+//
+// Represents the failure reason when a federated authentication reason fails.
+// Should be updated alongside RequestIdTokenStatus in
+// third_party/blink/public/mojom/devtools/inspector_issue.mojom to include
+// all cases except for success.
+type FederatedAuthRequestIssueReason string
+
+const (
+	FederatedAuthRequestIssueReasonShouldEmbargo                    FederatedAuthRequestIssueReason = "ShouldEmbargo"
+	FederatedAuthRequestIssueReasonTooManyRequests                  FederatedAuthRequestIssueReason = "TooManyRequests"
+	FederatedAuthRequestIssueReasonWellKnownHTTPNotFound            FederatedAuthRequestIssueReason = "WellKnownHttpNotFound"
+	FederatedAuthRequestIssueReasonWellKnownNoResponse              FederatedAuthRequestIssueReason = "WellKnownNoResponse"
+	FederatedAuthRequestIssueReasonWellKnownInvalidResponse         FederatedAuthRequestIssueReason = "WellKnownInvalidResponse"
+	FederatedAuthRequestIssueReasonWellKnownListEmpty               FederatedAuthRequestIssueReason = "WellKnownListEmpty"
+	FederatedAuthRequestIssueReasonWellKnownInvalidContentType      FederatedAuthRequestIssueReason = "WellKnownInvalidContentType"
+	FederatedAuthRequestIssueReasonConfigNotInWellKnown             FederatedAuthRequestIssueReason = "ConfigNotInWellKnown"
+	FederatedAuthRequestIssueReasonWellKnownTooBig                  FederatedAuthRequestIssueReason = "WellKnownTooBig"
+	FederatedAuthRequestIssueReasonConfigHTTPNotFound               FederatedAuthRequestIssueReason = "ConfigHttpNotFound"
+	FederatedAuthRequestIssueReasonConfigNoResponse                 FederatedAuthRequestIssueReason = "ConfigNoResponse"
+	FederatedAuthRequestIssueReasonConfigInvalidResponse            FederatedAuthRequestIssueReason = "ConfigInvalidResponse"
+	FederatedAuthRequestIssueReasonConfigInvalidContentType         FederatedAuthRequestIssueReason = "ConfigInvalidContentType"
+	FederatedAuthRequestIssueReasonClientMetadataHTTPNotFound       FederatedAuthRequestIssueReason = "ClientMetadataHttpNotFound"
+	FederatedAuthRequestIssueReasonClientMetadataNoResponse         FederatedAuthRequestIssueReason = "ClientMetadataNoResponse"
+	FederatedAuthRequestIssueReasonClientMetadataInvalidResponse    FederatedAuthRequestIssueReason = "ClientMetadataInvalidResponse"
+	FederatedAuthRequestIssueReasonClientMetadataInvalidContentType FederatedAuthRequestIssueReason = "ClientMetadataInvalidContentType"
+	FederatedAuthRequestIssueReasonIdpNotPotentiallyTrustworthy     FederatedAuthRequestIssueReason = "IdpNotPotentiallyTrustworthy"
+	FederatedAuthRequestIssueReasonDisabledInSettings               FederatedAuthRequestIssueReason = "DisabledInSettings"
+	FederatedAuthRequestIssueReasonDisabledInFlags                  FederatedAuthRequestIssueReason = "DisabledInFlags"
+	FederatedAuthRequestIssueReasonErrorFetchingSignin              FederatedAuthRequestIssueReason = "ErrorFetchingSignin"
+	FederatedAuthRequestIssueReasonInvalidSigninResponse            FederatedAuthRequestIssueReason = "InvalidSigninResponse"
+	FederatedAuthRequestIssueReasonAccountsHTTPNotFound             FederatedAuthRequestIssueReason = "AccountsHttpNotFound"
+	FederatedAuthRequestIssueReasonAccountsNoResponse               FederatedAuthRequestIssueReason = "AccountsNoResponse"
+	FederatedAuthRequestIssueReasonAccountsInvalidResponse          FederatedAuthRequestIssueReason = "AccountsInvalidResponse"
+	FederatedAuthRequestIssueReasonAccountsListEmpty                FederatedAuthRequestIssueReason = "AccountsListEmpty"
+	FederatedAuthRequestIssueReasonAccountsInvalidContentType       FederatedAuthRequestIssueReason = "AccountsInvalidContentType"
+	FederatedAuthRequestIssueReasonIDTokenHTTPNotFound              FederatedAuthRequestIssueReason = "IdTokenHttpNotFound"
+	FederatedAuthRequestIssueReasonIDTokenNoResponse                FederatedAuthRequestIssueReason = "IdTokenNoResponse"
+	FederatedAuthRequestIssueReasonIDTokenInvalidResponse           FederatedAuthRequestIssueReason = "IdTokenInvalidResponse"
+	FederatedAuthRequestIssueReasonIDTokenIdpErrorResponse          FederatedAuthRequestIssueReason = "IdTokenIdpErrorResponse"
+	FederatedAuthRequestIssueReasonIDTokenCrossSiteIdpErrorResponse FederatedAuthRequestIssueReason = "IdTokenCrossSiteIdpErrorResponse"
+	FederatedAuthRequestIssueReasonIDTokenInvalidRequest            FederatedAuthRequestIssueReason = "IdTokenInvalidRequest"
+	FederatedAuthRequestIssueReasonIDTokenInvalidContentType        FederatedAuthRequestIssueReason = "IdTokenInvalidContentType"
+	FederatedAuthRequestIssueReasonErrorIDToken                     FederatedAuthRequestIssueReason = "ErrorIdToken"
+	FederatedAuthRequestIssueReasonCanceled                         FederatedAuthRequestIssueReason = "Canceled"
+	FederatedAuthRequestIssueReasonRpPageNotVisible                 FederatedAuthRequestIssueReason = "RpPageNotVisible"
+	FederatedAuthRequestIssueReasonSilentMediationFailure           FederatedAuthRequestIssueReason = "SilentMediationFailure"
+	FederatedAuthRequestIssueReasonThirdPartyCookiesBlocked         FederatedAuthRequestIssueReason = "ThirdPartyCookiesBlocked"
+	FederatedAuthRequestIssueReasonNotSignedInWithIdp               FederatedAuthRequestIssueReason = "NotSignedInWithIdp"
+	FederatedAuthRequestIssueReasonMissingTransientUserActivation   FederatedAuthRequestIssueReason = "MissingTransientUserActivation"
+	FederatedAuthRequestIssueReasonReplacedByButtonMode             FederatedAuthRequestIssueReason = "ReplacedByButtonMode"
+	FederatedAuthRequestIssueReasonInvalidFieldsSpecified           FederatedAuthRequestIssueReason = "InvalidFieldsSpecified"
+	FederatedAuthRequestIssueReasonRelyingPartyOriginIsOpaque       FederatedAuthRequestIssueReason = "RelyingPartyOriginIsOpaque"
+	FederatedAuthRequestIssueReasonTypeNotMatching                  FederatedAuthRequestIssueReason = "TypeNotMatching"
+)
+
+// FederatedAuthUserInfoRequestIssueDetails is the Audits.FederatedAuthUserInfoRequestIssueDetails type.
+type FederatedAuthUserInfoRequestIssueDetails struct {
+	FederatedAuthUserInfoRequestIssueReason FederatedAuthUserInfoRequestIssueReason `json:"federatedAuthUserInfoRequestIssueReason"`
+}
+
+// FederatedAuthUserInfoRequestIssueReason is the Audits.FederatedAuthUserInfoRequestIssueReason type.
+//
+// Represents the failure reason when a getUserInfo() call fails.
+// Should be updated alongside FederatedAuthUserInfoRequestResult in
+// third_party/blink/public/mojom/devtools/inspector_issue.mojom.
+type FederatedAuthUserInfoRequestIssueReason string
+
+const (
+	FederatedAuthUserInfoRequestIssueReasonNotSameOrigin                      FederatedAuthUserInfoRequestIssueReason = "NotSameOrigin"
+	FederatedAuthUserInfoRequestIssueReasonNotIframe                          FederatedAuthUserInfoRequestIssueReason = "NotIframe"
+	FederatedAuthUserInfoRequestIssueReasonNotPotentiallyTrustworthy          FederatedAuthUserInfoRequestIssueReason = "NotPotentiallyTrustworthy"
+	FederatedAuthUserInfoRequestIssueReasonNoAPIPermission                    FederatedAuthUserInfoRequestIssueReason = "NoApiPermission"
+	FederatedAuthUserInfoRequestIssueReasonNotSignedInWithIdp                 FederatedAuthUserInfoRequestIssueReason = "NotSignedInWithIdp"
+	FederatedAuthUserInfoRequestIssueReasonNoAccountSharingPermission         FederatedAuthUserInfoRequestIssueReason = "NoAccountSharingPermission"
+	FederatedAuthUserInfoRequestIssueReasonInvalidConfigOrWellKnown           FederatedAuthUserInfoRequestIssueReason = "InvalidConfigOrWellKnown"
+	FederatedAuthUserInfoRequestIssueReasonInvalidAccountsResponse            FederatedAuthUserInfoRequestIssueReason = "InvalidAccountsResponse"
+	FederatedAuthUserInfoRequestIssueReasonNoReturningUserFromFetchedAccounts FederatedAuthUserInfoRequestIssueReason = "NoReturningUserFromFetchedAccounts"
+)
+
+// ClientHintIssueDetails is the Audits.ClientHintIssueDetails type.
+//
+// This issue tracks client hints related issues. It's used to deprecate old
+// features, encourage the use of new ones, and provide general guidance.
+type ClientHintIssueDetails struct {
+	SourceCodeLocation    SourceCodeLocation    `json:"sourceCodeLocation"`
+	ClientHintIssueReason ClientHintIssueReason `json:"clientHintIssueReason"`
+}
+
+// FailedRequestInfo is the Audits.FailedRequestInfo type.
+type FailedRequestInfo struct {
+	// The URL that failed to load.
+	URL string `json:"url"`
+	// The failure message for the failed request.
+	FailureMessage string            `json:"failureMessage"`
+	RequestID      network.RequestID `json:"requestId,omitempty"`
+}
+
+// StyleSheetLoadingIssueReason is the Audits.StyleSheetLoadingIssueReason type.
+type StyleSheetLoadingIssueReason string
+
+const (
+	StyleSheetLoadingIssueReasonLateImportRule StyleSheetLoadingIssueReason = "LateImportRule"
+	StyleSheetLoadingIssueReasonRequestFailed  StyleSheetLoadingIssueReason = "RequestFailed"
+)
+
+// StylesheetLoadingIssueDetails is the Audits.StylesheetLoadingIssueDetails type.
+//
+// This issue warns when a referenced stylesheet couldn't be loaded.
+type StylesheetLoadingIssueDetails struct {
+	// Source code position that referenced the failing stylesheet.
+	SourceCodeLocation SourceCodeLocation `json:"sourceCodeLocation"`
+	// Reason why the stylesheet couldn't be loaded.
+	StyleSheetLoadingIssueReason StyleSheetLoadingIssueReason `json:"styleSheetLoadingIssueReason"`
+	// Contains additional info when the failure was due to a request.
+	FailedRequestInfo *FailedRequestInfo `json:"failedRequestInfo,omitempty"`
+}
+
+// PropertyRuleIssueReason is the Audits.PropertyRuleIssueReason type.
+type PropertyRuleIssueReason string
+
+const (
+	PropertyRuleIssueReasonInvalidSyntax       PropertyRuleIssueReason = "InvalidSyntax"
+	PropertyRuleIssueReasonInvalidInitialValue PropertyRuleIssueReason = "InvalidInitialValue"
+	PropertyRuleIssueReasonInvalidInherits     PropertyRuleIssueReason = "InvalidInherits"
+	PropertyRuleIssueReasonInvalidName         PropertyRuleIssueReason = "InvalidName"
+)
+
+// PropertyRuleIssueDetails is the Audits.PropertyRuleIssueDetails type.
+//
+// This issue warns about errors in property rules that lead to property
+// registrations being ignored.
+type PropertyRuleIssueDetails struct {
+	// Source code position of the property rule.
+	SourceCodeLocation SourceCodeLocation `json:"sourceCodeLocation"`
+	// Reason why the property rule was discarded.
+	PropertyRuleIssueReason PropertyRuleIssueReason `json:"propertyRuleIssueReason"`
+	// The value of the property rule property that failed to parse
+	PropertyValue string `json:"propertyValue,omitempty"`
+}
+
+// InspectorIssueCode is the Audits.InspectorIssueCode type.
+//
+// A unique identifier for the type of issue. Each type may use one of the
+// optional fields in InspectorIssueDetails to convey more specific
+// information about the kind of issue.
+type InspectorIssueCode string
+
+const (
+	InspectorIssueCodeCookieIssue                       InspectorIssueCode = "CookieIssue"
+	InspectorIssueCodeMixedContentIssue                 InspectorIssueCode = "MixedContentIssue"
+	InspectorIssueCodeBlockedByResponseIssue            InspectorIssueCode = "BlockedByResponseIssue"
+	InspectorIssueCodeHeavyAdIssue                      InspectorIssueCode = "HeavyAdIssue"
+	InspectorIssueCodeContentSecurityPolicyIssue        InspectorIssueCode = "ContentSecurityPolicyIssue"
+	InspectorIssueCodeSharedArrayBufferIssue            InspectorIssueCode = "SharedArrayBufferIssue"
+	InspectorIssueCodeLowTextContrastIssue              InspectorIssueCode = "LowTextContrastIssue"
+	InspectorIssueCodeCorsIssue                         InspectorIssueCode = "CorsIssue"
+	InspectorIssueCodeAttributionReportingIssue         InspectorIssueCode = "AttributionReportingIssue"
+	InspectorIssueCodeQuirksModeIssue                   InspectorIssueCode = "QuirksModeIssue"
+	InspectorIssueCodeNavigatorUserAgentIssue           InspectorIssueCode = "NavigatorUserAgentIssue"
+	InspectorIssueCodeGenericIssue                      InspectorIssueCode = "GenericIssue"
+	InspectorIssueCodeDeprecationIssue                  InspectorIssueCode = "DeprecationIssue"
+	InspectorIssueCodeClientHintIssue                   InspectorIssueCode = "ClientHintIssue"
+	InspectorIssueCodeFederatedAuthRequestIssue         InspectorIssueCode = "FederatedAuthRequestIssue"
+	InspectorIssueCodeBounceTrackingIssue               InspectorIssueCode = "BounceTrackingIssue"
+	InspectorIssueCodeCookieDeprecationMetadataIssue    InspectorIssueCode = "CookieDeprecationMetadataIssue"
+	InspectorIssueCodeStylesheetLoadingIssue            InspectorIssueCode = "StylesheetLoadingIssue"
+	InspectorIssueCodeFederatedAuthUserInfoRequestIssue InspectorIssueCode = "FederatedAuthUserInfoRequestIssue"
+	InspectorIssueCodePropertyRuleIssue                 InspectorIssueCode = "PropertyRuleIssue"
+	InspectorIssueCodeSharedDictionaryIssue             InspectorIssueCode = "SharedDictionaryIssue"
+)
+
+// InspectorIssueDetails is the Audits.InspectorIssueDetails type.
+//
+// This struct holds a list of optional fields with additional information
+// specific to the kind of issue. When adding a new issue code, please also
+// add a new optional field to this type.
+type InspectorIssueDetails struct {
+	CookieIssueDetails                *CookieIssueDetails                `json:"cookieIssueDetails,omitempty"`
+	MixedContentIssueDetails          *MixedContentIssueDetails          `json:"mixedContentIssueDetails,omitempty"`
+	BlockedByResponseIssueDetails     *BlockedByResponseIssueDetails     `json:"blockedByResponseIssueDetails,omitempty"`
+	HeavyAdIssueDetails               *HeavyAdIssueDetails               `json:"heavyAdIssueDetails,omitempty"`
+	ContentSecurityPolicyIssueDetails *ContentSecurityPolicyIssueDetails `json:"contentSecurityPolicyIssueDetails,omitempty"`
+	SharedArrayBufferIssueDetails     *SharedArrayBufferIssueDetails     `json:"sharedArrayBufferIssueDetails,omitempty"`
+	LowTextContrastIssueDetails       *LowTextContrastIssueDetails       `json:"lowTextContrastIssueDetails,omitempty"`
+	CorsIssueDetails                  *CorsIssueDetails                  `json:"corsIssueDetails,omitempty"`
+	AttributionReportingIssueDetails  *AttributionReportingIssueDetails  `json:"attributionReportingIssueDetails,omitempty"`
+	QuirksModeIssueDetails            *QuirksModeIssueDetails            `json:"quirksModeIssueDetails,omitempty"`
+	// Deprecated: this item is deprecated in the protocol.
+	NavigatorUserAgentIssueDetails           *NavigatorUserAgentIssueDetails           `json:"navigatorUserAgentIssueDetails,omitempty"`
+	GenericIssueDetails                      *GenericIssueDetails                      `json:"genericIssueDetails,omitempty"`
+	DeprecationIssueDetails                  *DeprecationIssueDetails                  `json:"deprecationIssueDetails,omitempty"`
+	ClientHintIssueDetails                   *ClientHintIssueDetails                   `json:"clientHintIssueDetails,omitempty"`
+	FederatedAuthRequestIssueDetails         *FederatedAuthRequestIssueDetails         `json:"federatedAuthRequestIssueDetails,omitempty"`
+	BounceTrackingIssueDetails               *BounceTrackingIssueDetails               `json:"bounceTrackingIssueDetails,omitempty"`
+	CookieDeprecationMetadataIssueDetails    *CookieDeprecationMetadataIssueDetails    `json:"cookieDeprecationMetadataIssueDetails,omitempty"`
+	StylesheetLoadingIssueDetails            *StylesheetLoadingIssueDetails            `json:"stylesheetLoadingIssueDetails,omitempty"`
+	PropertyRuleIssueDetails                 *PropertyRuleIssueDetails                 `json:"propertyRuleIssueDetails,omitempty"`
+	FederatedAuthUserInfoRequestIssueDetails *FederatedAuthUserInfoRequestIssueDetails `json:"federatedAuthUserInfoRequestIssueDetails,omitempty"`
+	SharedDictionaryIssueDetails             *SharedDictionaryIssueDetails             `json:"sharedDictionaryIssueDetails,omitempty"`
+}
+
+// IssueID is the Audits.IssueId type.
+//
+// A unique id for a DevTools inspector issue. Allows other entities (e.g.
+// exceptions, CDP message, console messages, etc.) to reference an issue.
+type IssueID string
+
+// InspectorIssue is the Audits.InspectorIssue type.
+//
+// An inspector issue reported from the back-end.
+type InspectorIssue struct {
+	Code    InspectorIssueCode    `json:"code"`
+	Details InspectorIssueDetails `json:"details"`
+	// A unique id for this issue. May be omitted if no other entity (e.g.
+	// exception, CDP message, etc.) is referencing this issue.
+	IssueID IssueID `json:"issueId,omitempty"`
+}
+
+// GetEncodedResponseParams holds the parameters of Audits.getEncodedResponse.
+type GetEncodedResponseParams struct {
+	// Identifier of the network request to get content for.
+	RequestID network.RequestID `json:"requestId"`
+	// The encoding to use.
+	Encoding string `json:"encoding"`
+	// The quality of the encoding (0-1). (defaults to 1)
+	Quality *float64 `json:"quality,omitempty"`
+	// Whether to only return the size information (defaults to false).
+	SizeOnly *bool `json:"sizeOnly,omitempty"`
+}
+
+// GetEncodedResponseResult holds the result of Audits.getEncodedResponse.
+type GetEncodedResponseResult struct {
+	// The encoded body as a base64 string. Omitted if sizeOnly is true. (Encoded as a base64 string when passed over JSON)
+	Body string `json:"body,omitempty"`
+	// Size before re-encoding.
+	OriginalSize int64 `json:"originalSize"`
+	// Size after re-encoding.
+	EncodedSize int64 `json:"encodedSize"`
+}
+
+// GetEncodedResponse calls Audits.getEncodedResponse.
+//
+// Returns the response body and size if it were re-encoded with the specified settings. Only
+// applies to images.
+func GetEncodedResponse(ctx context.Context, c *internal.Client, sessionID string, params *GetEncodedResponseParams) (*GetEncodedResponseResult, error) {
+	if params == nil {
+		params = &GetEncodedResponseParams{}
+	}
+	var result GetEncodedResponseResult
+	err := c.Call(ctx, "Audits.getEncodedResponse", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Disable calls Audits.disable.
+//
+// Disables issues domain, prevents further issues from being reported to the client.
+func Disable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Audits.disable", nil, sessionID, nil)
+}
+
+// Enable calls Audits.enable.
+//
+// Enables issues domain, sends the issues collected so far to the client by means of the
+// `issueAdded` event.
+func Enable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Audits.enable", nil, sessionID, nil)
+}
+
+// CheckContrastParams holds the parameters of Audits.checkContrast.
+type CheckContrastParams struct {
+	// Whether to report WCAG AAA level issues. Default is false.
+	ReportAAA *bool `json:"reportAAA,omitempty"`
+}
+
+// CheckContrast calls Audits.checkContrast.
+//
+// Runs the contrast check for the target page. Found issues are reported
+// using Audits.issueAdded event.
+func CheckContrast(ctx context.Context, c *internal.Client, sessionID string, params *CheckContrastParams) error {
+	if params == nil {
+		params = &CheckContrastParams{}
+	}
+	return c.Call(ctx, "Audits.checkContrast", params, sessionID, nil)
+}
+
+// CheckFormsIssuesResult holds the result of Audits.checkFormsIssues.
+type CheckFormsIssuesResult struct {
+	FormIssues []GenericIssueDetails `json:"formIssues"`
+}
+
+// CheckFormsIssues calls Audits.checkFormsIssues.
+//
+// Runs the form issues check for the target page. Found issues are reported
+// using Audits.issueAdded event.
+func CheckFormsIssues(ctx context.Context, c *internal.Client, sessionID string) (*CheckFormsIssuesResult, error) {
+	var result CheckFormsIssuesResult
+	err := c.Call(ctx, "Audits.checkFormsIssues", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// EventIssueAdded is the method name of the Audits.issueAdded event.
+const EventIssueAdded = "Audits.issueAdded"
+
+// IssueAddedEvent is the payload of the Audits.issueAdded event.
+type IssueAddedEvent struct {
+	Issue InspectorIssue `json:"issue"`
+}
+
+// ParseEvent decodes a Audits event message into its typed event struct.
+func ParseEvent(msg *internal.CDPMessage) (any, error) {
+	var v any
+	switch msg.Method {
+	case EventIssueAdded:
+		v = &IssueAddedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Audits event: %s", msg.Method)
+	}
+	if msg.Params == nil {
+		return v, nil
+	}
+	err := json.Unmarshal(msg.Params, v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
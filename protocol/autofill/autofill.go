@@ -0,0 +1,177 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package autofill provides typed bindings for the CDP Autofill domain.
+//
+// Defines commands and events for Autofill.
+package autofill
+
+import (
+	"cdp/internal"
+	"cdp/protocol/dom"
+	"cdp/protocol/page"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// CreditCard is the Autofill.CreditCard type.
+type CreditCard struct {
+	// 16-digit credit card number.
+	Number string `json:"number"`
+	// Name of the credit card owner.
+	Name string `json:"name"`
+	// 2-digit expiry month.
+	ExpiryMonth string `json:"expiryMonth"`
+	// 4-digit expiry year.
+	ExpiryYear string `json:"expiryYear"`
+	// 3-digit card verification code.
+	Cvc string `json:"cvc"`
+}
+
+// AddressField is the Autofill.AddressField type.
+type AddressField struct {
+	// address field name, for example GIVEN_NAME.
+	Name string `json:"name"`
+	// address field value, for example Jon Doe.
+	Value string `json:"value"`
+}
+
+// AddressFields is the Autofill.AddressFields type.
+//
+// A list of address fields.
+type AddressFields struct {
+	Fields []AddressField `json:"fields"`
+}
+
+// Address is the Autofill.Address type.
+type Address struct {
+	// fields and values defining an address.
+	Fields []AddressField `json:"fields"`
+}
+
+// AddressUI is the Autofill.AddressUI type.
+//
+// Defines how an address can be displayed like in chrome://settings/addresses.
+// Address UI is a two dimensional array, each inner array is an "address information line", and when rendered in a UI surface should be displayed as such.
+// The following address UI for instance:
+// [[{name: "GIVE_NAME", value: "Jon"}, {name: "FAMILY_NAME", value: "Doe"}], [{name: "CITY", value: "Munich"}, {name: "ZIP", value: "81456"}]]
+// should allow the receiver to render:
+// Jon Doe
+// Munich 81456
+type AddressUI struct {
+	// A two dimension array containing the representation of values from an address profile.
+	AddressFields []AddressFields `json:"addressFields"`
+}
+
+// FillingStrategy is the Autofill.FillingStrategy type.
+//
+// Specified whether a filled field was done so by using the html autocomplete attribute or autofill heuristics.
+type FillingStrategy string
+
+const (
+	FillingStrategyAutocompleteAttribute FillingStrategy = "autocompleteAttribute"
+	FillingStrategyAutofillInferred      FillingStrategy = "autofillInferred"
+)
+
+// FilledField is the Autofill.FilledField type.
+type FilledField struct {
+	// The type of the field, e.g text, password etc.
+	HTMLType string `json:"htmlType"`
+	// the html id
+	ID string `json:"id"`
+	// the html name
+	Name string `json:"name"`
+	// the field value
+	Value string `json:"value"`
+	// The actual field type, e.g FAMILY_NAME
+	AutofillType string `json:"autofillType"`
+	// The filling strategy
+	FillingStrategy FillingStrategy `json:"fillingStrategy"`
+	// The frame the field belongs to
+	FrameID page.FrameID `json:"frameId"`
+	// The form field's DOM node
+	FieldID dom.BackendNodeID `json:"fieldId"`
+}
+
+// TriggerParams holds the parameters of Autofill.trigger.
+type TriggerParams struct {
+	// Identifies a field that serves as an anchor for autofill.
+	FieldID dom.BackendNodeID `json:"fieldId"`
+	// Identifies the frame that field belongs to.
+	FrameID page.FrameID `json:"frameId,omitempty"`
+	// Credit card information to fill out the form. Credit card data is not saved.
+	Card CreditCard `json:"card"`
+}
+
+// Trigger calls Autofill.trigger.
+//
+// Trigger autofill on a form identified by the fieldId.
+// If the field and related form cannot be autofilled, returns an error.
+func Trigger(ctx context.Context, c *internal.Client, sessionID string, params *TriggerParams) error {
+	if params == nil {
+		params = &TriggerParams{}
+	}
+	return c.Call(ctx, "Autofill.trigger", params, sessionID, nil)
+}
+
+// SetAddressesParams holds the parameters of Autofill.setAddresses.
+type SetAddressesParams struct {
+	Addresses []Address `json:"addresses"`
+}
+
+// SetAddresses calls Autofill.setAddresses.
+//
+// Set addresses so that developers can verify their forms implementation.
+func SetAddresses(ctx context.Context, c *internal.Client, sessionID string, params *SetAddressesParams) error {
+	if params == nil {
+		params = &SetAddressesParams{}
+	}
+	return c.Call(ctx, "Autofill.setAddresses", params, sessionID, nil)
+}
+
+// Disable calls Autofill.disable.
+//
+// Disables autofill domain notifications.
+func Disable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Autofill.disable", nil, sessionID, nil)
+}
+
+// Enable calls Autofill.enable.
+//
+// Enables autofill domain notifications.
+func Enable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Autofill.enable", nil, sessionID, nil)
+}
+
+// EventAddressFormFilled is the method name of the Autofill.addressFormFilled event.
+const EventAddressFormFilled = "Autofill.addressFormFilled"
+
+// AddressFormFilledEvent is the payload of the Autofill.addressFormFilled event.
+//
+// Emitted when an address form is filled.
+type AddressFormFilledEvent struct {
+	// Information about the fields that were filled
+	FilledFields []FilledField `json:"filledFields"`
+	// An UI representation of the address used to fill the form.
+	// Consists of a 2D array where each child represents an address/profile line.
+	AddressUI AddressUI `json:"addressUi"`
+}
+
+// ParseEvent decodes a Autofill event message into its typed event struct.
+func ParseEvent(msg *internal.CDPMessage) (any, error) {
+	var v any
+	switch msg.Method {
+	case EventAddressFormFilled:
+		v = &AddressFormFilledEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Autofill event: %s", msg.Method)
+	}
+	if msg.Params == nil {
+		return v, nil
+	}
+	err := json.Unmarshal(msg.Params, v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,163 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package backgroundservice provides typed bindings for the CDP BackgroundService domain.
+//
+// Defines events for background web platform features.
+package backgroundservice
+
+import (
+	"cdp/internal"
+	"cdp/protocol/network"
+	"cdp/protocol/serviceworker"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// ServiceName is the BackgroundService.ServiceName type.
+//
+// The Background Service that will be associated with the commands/events.
+// Every Background Service operates independently, but they share the same
+// API.
+type ServiceName string
+
+const (
+	ServiceNameBackgroundFetch        ServiceName = "backgroundFetch"
+	ServiceNameBackgroundSync         ServiceName = "backgroundSync"
+	ServiceNamePushMessaging          ServiceName = "pushMessaging"
+	ServiceNameNotifications          ServiceName = "notifications"
+	ServiceNamePaymentHandler         ServiceName = "paymentHandler"
+	ServiceNamePeriodicBackgroundSync ServiceName = "periodicBackgroundSync"
+)
+
+// EventMetadata is the BackgroundService.EventMetadata type.
+//
+// A key-value pair for additional event information to pass along.
+type EventMetadata struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// BackgroundServiceEvent is the BackgroundService.BackgroundServiceEvent type.
+type BackgroundServiceEvent struct {
+	// Timestamp of the event (in seconds).
+	Timestamp network.TimeSinceEpoch `json:"timestamp"`
+	// The origin this event belongs to.
+	Origin string `json:"origin"`
+	// The Service Worker ID that initiated the event.
+	ServiceWorkerRegistrationID serviceworker.RegistrationID `json:"serviceWorkerRegistrationId"`
+	// The Background Service this event belongs to.
+	Service ServiceName `json:"service"`
+	// A description of the event.
+	EventName string `json:"eventName"`
+	// An identifier that groups related events together.
+	InstanceID string `json:"instanceId"`
+	// A list of event-specific information.
+	EventMetadata []EventMetadata `json:"eventMetadata"`
+	// Storage key this event belongs to.
+	StorageKey string `json:"storageKey"`
+}
+
+// StartObservingParams holds the parameters of BackgroundService.startObserving.
+type StartObservingParams struct {
+	Service ServiceName `json:"service"`
+}
+
+// StartObserving calls BackgroundService.startObserving.
+//
+// Enables event updates for the service.
+func StartObserving(ctx context.Context, c *internal.Client, sessionID string, params *StartObservingParams) error {
+	if params == nil {
+		params = &StartObservingParams{}
+	}
+	return c.Call(ctx, "BackgroundService.startObserving", params, sessionID, nil)
+}
+
+// StopObservingParams holds the parameters of BackgroundService.stopObserving.
+type StopObservingParams struct {
+	Service ServiceName `json:"service"`
+}
+
+// StopObserving calls BackgroundService.stopObserving.
+//
+// Disables event updates for the service.
+func StopObserving(ctx context.Context, c *internal.Client, sessionID string, params *StopObservingParams) error {
+	if params == nil {
+		params = &StopObservingParams{}
+	}
+	return c.Call(ctx, "BackgroundService.stopObserving", params, sessionID, nil)
+}
+
+// SetRecordingParams holds the parameters of BackgroundService.setRecording.
+type SetRecordingParams struct {
+	ShouldRecord bool        `json:"shouldRecord"`
+	Service      ServiceName `json:"service"`
+}
+
+// SetRecording calls BackgroundService.setRecording.
+//
+// Set the recording state for the service.
+func SetRecording(ctx context.Context, c *internal.Client, sessionID string, params *SetRecordingParams) error {
+	if params == nil {
+		params = &SetRecordingParams{}
+	}
+	return c.Call(ctx, "BackgroundService.setRecording", params, sessionID, nil)
+}
+
+// ClearEventsParams holds the parameters of BackgroundService.clearEvents.
+type ClearEventsParams struct {
+	Service ServiceName `json:"service"`
+}
+
+// ClearEvents calls BackgroundService.clearEvents.
+//
+// Clears all stored data for the service.
+func ClearEvents(ctx context.Context, c *internal.Client, sessionID string, params *ClearEventsParams) error {
+	if params == nil {
+		params = &ClearEventsParams{}
+	}
+	return c.Call(ctx, "BackgroundService.clearEvents", params, sessionID, nil)
+}
+
+// EventRecordingStateChanged is the method name of the BackgroundService.recordingStateChanged event.
+const EventRecordingStateChanged = "BackgroundService.recordingStateChanged"
+
+// RecordingStateChangedEvent is the payload of the BackgroundService.recordingStateChanged event.
+//
+// Called when the recording state for the service has been updated.
+type RecordingStateChangedEvent struct {
+	IsRecording bool        `json:"isRecording"`
+	Service     ServiceName `json:"service"`
+}
+
+// EventBackgroundServiceEventReceived is the method name of the BackgroundService.backgroundServiceEventReceived event.
+const EventBackgroundServiceEventReceived = "BackgroundService.backgroundServiceEventReceived"
+
+// BackgroundServiceEventReceivedEvent is the payload of the BackgroundService.backgroundServiceEventReceived event.
+//
+// Called with all existing backgroundServiceEvents when enabled, and all new
+// events afterwards if enabled and recording.
+type BackgroundServiceEventReceivedEvent struct {
+	BackgroundServiceEvent BackgroundServiceEvent `json:"backgroundServiceEvent"`
+}
+
+// ParseEvent decodes a BackgroundService event message into its typed event struct.
+func ParseEvent(msg *internal.CDPMessage) (any, error) {
+	var v any
+	switch msg.Method {
+	case EventRecordingStateChanged:
+		v = &RecordingStateChangedEvent{}
+	case EventBackgroundServiceEventReceived:
+		v = &BackgroundServiceEventReceivedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown BackgroundService event: %s", msg.Method)
+	}
+	if msg.Params == nil {
+		return v, nil
+	}
+	err := json.Unmarshal(msg.Params, v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
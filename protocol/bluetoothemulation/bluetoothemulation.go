@@ -0,0 +1,117 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package bluetoothemulation provides typed bindings for the CDP BluetoothEmulation domain.
+//
+// This domain allows configuring virtual Bluetooth devices to test
+// the web-bluetooth API.
+package bluetoothemulation
+
+import (
+	"cdp/internal"
+	"context"
+)
+
+// CentralState is the BluetoothEmulation.CentralState type.
+//
+// Indicates the various states of Central.
+type CentralState string
+
+const (
+	CentralStateAbsent     CentralState = "absent"
+	CentralStatePoweredOff CentralState = "powered-off"
+	CentralStatePoweredOn  CentralState = "powered-on"
+)
+
+// ManufacturerData is the BluetoothEmulation.ManufacturerData type.
+//
+// Stores the manufacturer data
+type ManufacturerData struct {
+	// Company identifier
+	// https://bitbucket.org/bluetooth-SIG/public/src/main/assigned_numbers/company_identifiers/company_identifiers.yaml
+	// https://usb.org/developers
+	Key int64 `json:"key"`
+	// Manufacturer-specific data (Encoded as a base64 string when passed over JSON)
+	Data string `json:"data"`
+}
+
+// ScanRecord is the BluetoothEmulation.ScanRecord type.
+//
+// Stores the byte data of the advertisement packet sent by a Bluetooth device.
+type ScanRecord struct {
+	Name  string   `json:"name,omitempty"`
+	Uuids []string `json:"uuids,omitempty"`
+	// Stores the external appearance description of the device.
+	Appearance *int64 `json:"appearance,omitempty"`
+	// Stores the transmission power of a broadcasting device.
+	TxPower *int64 `json:"txPower,omitempty"`
+	// Key is the company identifier and the value is an array of bytes of
+	// manufacturer specific data.
+	ManufacturerData []ManufacturerData `json:"manufacturerData,omitempty"`
+}
+
+// ScanEntry is the BluetoothEmulation.ScanEntry type.
+//
+// Stores the advertisement packet information that is sent by a Bluetooth device.
+type ScanEntry struct {
+	DeviceAddress string     `json:"deviceAddress"`
+	Rssi          int64      `json:"rssi"`
+	ScanRecord    ScanRecord `json:"scanRecord"`
+}
+
+// EnableParams holds the parameters of BluetoothEmulation.enable.
+type EnableParams struct {
+	// State of the simulated central.
+	State CentralState `json:"state"`
+}
+
+// Enable calls BluetoothEmulation.enable.
+//
+// Enable the BluetoothEmulation domain.
+func Enable(ctx context.Context, c *internal.Client, sessionID string, params *EnableParams) error {
+	if params == nil {
+		params = &EnableParams{}
+	}
+	return c.Call(ctx, "BluetoothEmulation.enable", params, sessionID, nil)
+}
+
+// Disable calls BluetoothEmulation.disable.
+//
+// Disable the BluetoothEmulation domain.
+func Disable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "BluetoothEmulation.disable", nil, sessionID, nil)
+}
+
+// SimulatePreconnectedPeripheralParams holds the parameters of BluetoothEmulation.simulatePreconnectedPeripheral.
+type SimulatePreconnectedPeripheralParams struct {
+	Address           string             `json:"address"`
+	Name              string             `json:"name"`
+	ManufacturerData  []ManufacturerData `json:"manufacturerData"`
+	KnownServiceUuids []string           `json:"knownServiceUuids"`
+}
+
+// SimulatePreconnectedPeripheral calls BluetoothEmulation.simulatePreconnectedPeripheral.
+//
+// Simulates a peripheral with |address|, |name| and |knownServiceUuids|
+// that has already been connected to the system.
+func SimulatePreconnectedPeripheral(ctx context.Context, c *internal.Client, sessionID string, params *SimulatePreconnectedPeripheralParams) error {
+	if params == nil {
+		params = &SimulatePreconnectedPeripheralParams{}
+	}
+	return c.Call(ctx, "BluetoothEmulation.simulatePreconnectedPeripheral", params, sessionID, nil)
+}
+
+// SimulateAdvertisementParams holds the parameters of BluetoothEmulation.simulateAdvertisement.
+type SimulateAdvertisementParams struct {
+	Entry ScanEntry `json:"entry"`
+}
+
+// SimulateAdvertisement calls BluetoothEmulation.simulateAdvertisement.
+//
+// Simulates an advertisement packet described in |entry| being received by
+// the central.
+func SimulateAdvertisement(ctx context.Context, c *internal.Client, sessionID string, params *SimulateAdvertisementParams) error {
+	if params == nil {
+		params = &SimulateAdvertisementParams{}
+	}
+	return c.Call(ctx, "BluetoothEmulation.simulateAdvertisement", params, sessionID, nil)
+}
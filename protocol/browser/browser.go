@@ -0,0 +1,609 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package browser provides typed bindings for the CDP Browser domain.
+//
+// The Browser domain defines methods and events for browser managing.
+package browser
+
+import (
+	"cdp/internal"
+	"cdp/protocol/page"
+	"cdp/protocol/target"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// BrowserContextID is the Browser.BrowserContextID type.
+//
+// Experimental.
+type BrowserContextID string
+
+// WindowID is the Browser.WindowID type.
+//
+// Experimental.
+type WindowID int64
+
+// WindowState is the Browser.WindowState type.
+//
+// The state of the browser window.
+//
+// Experimental.
+type WindowState string
+
+const (
+	WindowStateNormal     WindowState = "normal"
+	WindowStateMinimized  WindowState = "minimized"
+	WindowStateMaximized  WindowState = "maximized"
+	WindowStateFullscreen WindowState = "fullscreen"
+)
+
+// Bounds is the Browser.Bounds type.
+//
+// # Browser window bounds information
+//
+// Experimental.
+type Bounds struct {
+	// The offset from the left edge of the screen to the window in pixels.
+	Left *int64 `json:"left,omitempty"`
+	// The offset from the top edge of the screen to the window in pixels.
+	Top *int64 `json:"top,omitempty"`
+	// The window width in pixels.
+	Width *int64 `json:"width,omitempty"`
+	// The window height in pixels.
+	Height *int64 `json:"height,omitempty"`
+	// The window state. Default to normal.
+	WindowState WindowState `json:"windowState,omitempty"`
+}
+
+// PermissionType is the Browser.PermissionType type.
+//
+// Experimental.
+type PermissionType string
+
+const (
+	PermissionTypeAccessibilityEvents      PermissionType = "accessibilityEvents"
+	PermissionTypeAudioCapture             PermissionType = "audioCapture"
+	PermissionTypeBackgroundSync           PermissionType = "backgroundSync"
+	PermissionTypeBackgroundFetch          PermissionType = "backgroundFetch"
+	PermissionTypeCapturedSurfaceControl   PermissionType = "capturedSurfaceControl"
+	PermissionTypeClipboardReadWrite       PermissionType = "clipboardReadWrite"
+	PermissionTypeClipboardSanitizedWrite  PermissionType = "clipboardSanitizedWrite"
+	PermissionTypeDisplayCapture           PermissionType = "displayCapture"
+	PermissionTypeDurableStorage           PermissionType = "durableStorage"
+	PermissionTypeFlash                    PermissionType = "flash"
+	PermissionTypeGeolocation              PermissionType = "geolocation"
+	PermissionTypeIdleDetection            PermissionType = "idleDetection"
+	PermissionTypeLocalFonts               PermissionType = "localFonts"
+	PermissionTypeMidi                     PermissionType = "midi"
+	PermissionTypeMidiSysex                PermissionType = "midiSysex"
+	PermissionTypeNfc                      PermissionType = "nfc"
+	PermissionTypeNotifications            PermissionType = "notifications"
+	PermissionTypePaymentHandler           PermissionType = "paymentHandler"
+	PermissionTypePeriodicBackgroundSync   PermissionType = "periodicBackgroundSync"
+	PermissionTypeProtectedMediaIdentifier PermissionType = "protectedMediaIdentifier"
+	PermissionTypeSensors                  PermissionType = "sensors"
+	PermissionTypeStorageAccess            PermissionType = "storageAccess"
+	PermissionTypeSpeakerSelection         PermissionType = "speakerSelection"
+	PermissionTypeTopLevelStorageAccess    PermissionType = "topLevelStorageAccess"
+	PermissionTypeVideoCapture             PermissionType = "videoCapture"
+	PermissionTypeVideoCapturePanTiltZoom  PermissionType = "videoCapturePanTiltZoom"
+	PermissionTypeWakeLockScreen           PermissionType = "wakeLockScreen"
+	PermissionTypeWakeLockSystem           PermissionType = "wakeLockSystem"
+	PermissionTypeWebAppInstallation       PermissionType = "webAppInstallation"
+	PermissionTypeWindowManagement         PermissionType = "windowManagement"
+)
+
+// PermissionSetting is the Browser.PermissionSetting type.
+//
+// Experimental.
+type PermissionSetting string
+
+const (
+	PermissionSettingGranted PermissionSetting = "granted"
+	PermissionSettingDenied  PermissionSetting = "denied"
+	PermissionSettingPrompt  PermissionSetting = "prompt"
+)
+
+// PermissionDescriptor is the Browser.PermissionDescriptor type.
+//
+// Definition of PermissionDescriptor defined in the Permissions API:
+// https://w3c.github.io/permissions/#dom-permissiondescriptor.
+//
+// Experimental.
+type PermissionDescriptor struct {
+	// Name of permission.
+	// See https://cs.chromium.org/chromium/src/third_party/blink/renderer/modules/permissions/permission_descriptor.idl for valid permission names.
+	Name string `json:"name"`
+	// For "midi" permission, may also specify sysex control.
+	Sysex *bool `json:"sysex,omitempty"`
+	// For "push" permission, may specify userVisibleOnly.
+	// Note that userVisibleOnly = true is the only currently supported type.
+	UserVisibleOnly *bool `json:"userVisibleOnly,omitempty"`
+	// For "clipboard" permission, may specify allowWithoutSanitization.
+	AllowWithoutSanitization *bool `json:"allowWithoutSanitization,omitempty"`
+	// For "fullscreen" permission, must specify allowWithoutGesture:true.
+	AllowWithoutGesture *bool `json:"allowWithoutGesture,omitempty"`
+	// For "camera" permission, may specify panTiltZoom.
+	PanTiltZoom *bool `json:"panTiltZoom,omitempty"`
+}
+
+// BrowserCommandID is the Browser.BrowserCommandId type.
+//
+// Browser command ids used by executeBrowserCommand.
+//
+// Experimental.
+type BrowserCommandID string
+
+const (
+	BrowserCommandIDOpenTabSearch  BrowserCommandID = "openTabSearch"
+	BrowserCommandIDCloseTabSearch BrowserCommandID = "closeTabSearch"
+)
+
+// Bucket is the Browser.Bucket type.
+//
+// Chrome histogram bucket.
+//
+// Experimental.
+type Bucket struct {
+	// Minimum value (inclusive).
+	Low int64 `json:"low"`
+	// Maximum value (exclusive).
+	High int64 `json:"high"`
+	// Number of samples.
+	Count int64 `json:"count"`
+}
+
+// Histogram is the Browser.Histogram type.
+//
+// Chrome histogram.
+//
+// Experimental.
+type Histogram struct {
+	// Name.
+	Name string `json:"name"`
+	// Sum of sample values.
+	Sum int64 `json:"sum"`
+	// Total number of samples.
+	Count int64 `json:"count"`
+	// Buckets.
+	Buckets []Bucket `json:"buckets"`
+}
+
+// SetPermissionParams holds the parameters of Browser.setPermission.
+type SetPermissionParams struct {
+	// Descriptor of permission to override.
+	Permission PermissionDescriptor `json:"permission"`
+	// Setting of the permission.
+	Setting PermissionSetting `json:"setting"`
+	// Origin the permission applies to, all origins if not specified.
+	Origin string `json:"origin,omitempty"`
+	// Context to override. When omitted, default browser context is used.
+	BrowserContextID BrowserContextID `json:"browserContextId,omitempty"`
+}
+
+// SetPermission calls Browser.setPermission.
+//
+// Set permission settings for given origin.
+//
+// Experimental.
+func SetPermission(ctx context.Context, c *internal.Client, sessionID string, params *SetPermissionParams) error {
+	if params == nil {
+		params = &SetPermissionParams{}
+	}
+	return c.Call(ctx, "Browser.setPermission", params, sessionID, nil)
+}
+
+// GrantPermissionsParams holds the parameters of Browser.grantPermissions.
+type GrantPermissionsParams struct {
+	Permissions []PermissionType `json:"permissions"`
+	// Origin the permission applies to, all origins if not specified.
+	Origin string `json:"origin,omitempty"`
+	// BrowserContext to override permissions. When omitted, default browser context is used.
+	BrowserContextID BrowserContextID `json:"browserContextId,omitempty"`
+}
+
+// GrantPermissions calls Browser.grantPermissions.
+//
+// Grant specific permissions to the given origin and reject all others.
+//
+// Experimental.
+func GrantPermissions(ctx context.Context, c *internal.Client, sessionID string, params *GrantPermissionsParams) error {
+	if params == nil {
+		params = &GrantPermissionsParams{}
+	}
+	return c.Call(ctx, "Browser.grantPermissions", params, sessionID, nil)
+}
+
+// ResetPermissionsParams holds the parameters of Browser.resetPermissions.
+type ResetPermissionsParams struct {
+	// BrowserContext to reset permissions. When omitted, default browser context is used.
+	BrowserContextID BrowserContextID `json:"browserContextId,omitempty"`
+}
+
+// ResetPermissions calls Browser.resetPermissions.
+//
+// Reset all permission management for all origins.
+func ResetPermissions(ctx context.Context, c *internal.Client, sessionID string, params *ResetPermissionsParams) error {
+	if params == nil {
+		params = &ResetPermissionsParams{}
+	}
+	return c.Call(ctx, "Browser.resetPermissions", params, sessionID, nil)
+}
+
+// SetDownloadBehaviorParams holds the parameters of Browser.setDownloadBehavior.
+type SetDownloadBehaviorParams struct {
+	// Whether to allow all or deny all download requests, or use default Chrome behavior if
+	// available (otherwise deny). |allowAndName| allows download and names files according to
+	// their download guids.
+	Behavior string `json:"behavior"`
+	// BrowserContext to set download behavior. When omitted, default browser context is used.
+	BrowserContextID BrowserContextID `json:"browserContextId,omitempty"`
+	// The default path to save downloaded files to. This is required if behavior is set to 'allow'
+	// or 'allowAndName'.
+	DownloadPath string `json:"downloadPath,omitempty"`
+	// Whether to emit download events (defaults to false).
+	EventsEnabled *bool `json:"eventsEnabled,omitempty"`
+}
+
+// SetDownloadBehavior calls Browser.setDownloadBehavior.
+//
+// Set the behavior when downloading a file.
+//
+// Experimental.
+func SetDownloadBehavior(ctx context.Context, c *internal.Client, sessionID string, params *SetDownloadBehaviorParams) error {
+	if params == nil {
+		params = &SetDownloadBehaviorParams{}
+	}
+	return c.Call(ctx, "Browser.setDownloadBehavior", params, sessionID, nil)
+}
+
+// CancelDownloadParams holds the parameters of Browser.cancelDownload.
+type CancelDownloadParams struct {
+	// Global unique identifier of the download.
+	Guid string `json:"guid"`
+	// BrowserContext to perform the action in. When omitted, default browser context is used.
+	BrowserContextID BrowserContextID `json:"browserContextId,omitempty"`
+}
+
+// CancelDownload calls Browser.cancelDownload.
+//
+// # Cancel a download if in progress
+//
+// Experimental.
+func CancelDownload(ctx context.Context, c *internal.Client, sessionID string, params *CancelDownloadParams) error {
+	if params == nil {
+		params = &CancelDownloadParams{}
+	}
+	return c.Call(ctx, "Browser.cancelDownload", params, sessionID, nil)
+}
+
+// Close calls Browser.close.
+//
+// Close browser gracefully.
+func Close(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Browser.close", nil, sessionID, nil)
+}
+
+// Crash calls Browser.crash.
+//
+// Crashes browser on the main thread.
+//
+// Experimental.
+func Crash(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Browser.crash", nil, sessionID, nil)
+}
+
+// CrashGPUProcess calls Browser.crashGpuProcess.
+//
+// Crashes GPU process.
+//
+// Experimental.
+func CrashGPUProcess(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Browser.crashGpuProcess", nil, sessionID, nil)
+}
+
+// GetVersionResult holds the result of Browser.getVersion.
+type GetVersionResult struct {
+	// Protocol version.
+	ProtocolVersion string `json:"protocolVersion"`
+	// Product name.
+	Product string `json:"product"`
+	// Product revision.
+	Revision string `json:"revision"`
+	// User-Agent.
+	UserAgent string `json:"userAgent"`
+	// V8 version.
+	JSVersion string `json:"jsVersion"`
+}
+
+// GetVersion calls Browser.getVersion.
+//
+// Returns version information.
+func GetVersion(ctx context.Context, c *internal.Client, sessionID string) (*GetVersionResult, error) {
+	var result GetVersionResult
+	err := c.Call(ctx, "Browser.getVersion", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetBrowserCommandLineResult holds the result of Browser.getBrowserCommandLine.
+type GetBrowserCommandLineResult struct {
+	// Commandline parameters
+	Arguments []string `json:"arguments"`
+}
+
+// GetBrowserCommandLine calls Browser.getBrowserCommandLine.
+//
+// Returns the command line switches for the browser process if, and only if
+// --enable-automation is on the commandline.
+//
+// Experimental.
+func GetBrowserCommandLine(ctx context.Context, c *internal.Client, sessionID string) (*GetBrowserCommandLineResult, error) {
+	var result GetBrowserCommandLineResult
+	err := c.Call(ctx, "Browser.getBrowserCommandLine", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetHistogramsParams holds the parameters of Browser.getHistograms.
+type GetHistogramsParams struct {
+	// Requested substring in name. Only histograms which have query as a
+	// substring in their name are extracted. An empty or absent query returns
+	// all histograms.
+	Query string `json:"query,omitempty"`
+	// If true, retrieve delta since last delta call.
+	Delta *bool `json:"delta,omitempty"`
+}
+
+// GetHistogramsResult holds the result of Browser.getHistograms.
+type GetHistogramsResult struct {
+	// Histograms.
+	Histograms []Histogram `json:"histograms"`
+}
+
+// GetHistograms calls Browser.getHistograms.
+//
+// Get Chrome histograms.
+//
+// Experimental.
+func GetHistograms(ctx context.Context, c *internal.Client, sessionID string, params *GetHistogramsParams) (*GetHistogramsResult, error) {
+	if params == nil {
+		params = &GetHistogramsParams{}
+	}
+	var result GetHistogramsResult
+	err := c.Call(ctx, "Browser.getHistograms", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetHistogramParams holds the parameters of Browser.getHistogram.
+type GetHistogramParams struct {
+	// Requested histogram name.
+	Name string `json:"name"`
+	// If true, retrieve delta since last delta call.
+	Delta *bool `json:"delta,omitempty"`
+}
+
+// GetHistogramResult holds the result of Browser.getHistogram.
+type GetHistogramResult struct {
+	// Histogram.
+	Histogram Histogram `json:"histogram"`
+}
+
+// GetHistogram calls Browser.getHistogram.
+//
+// Get a Chrome histogram by name.
+//
+// Experimental.
+func GetHistogram(ctx context.Context, c *internal.Client, sessionID string, params *GetHistogramParams) (*GetHistogramResult, error) {
+	if params == nil {
+		params = &GetHistogramParams{}
+	}
+	var result GetHistogramResult
+	err := c.Call(ctx, "Browser.getHistogram", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetWindowBoundsParams holds the parameters of Browser.getWindowBounds.
+type GetWindowBoundsParams struct {
+	// Browser window id.
+	WindowID WindowID `json:"windowId"`
+}
+
+// GetWindowBoundsResult holds the result of Browser.getWindowBounds.
+type GetWindowBoundsResult struct {
+	// Bounds information of the window. When window state is 'minimized', the restored window
+	// position and size are returned.
+	Bounds Bounds `json:"bounds"`
+}
+
+// GetWindowBounds calls Browser.getWindowBounds.
+//
+// Get position and size of the browser window.
+//
+// Experimental.
+func GetWindowBounds(ctx context.Context, c *internal.Client, sessionID string, params *GetWindowBoundsParams) (*GetWindowBoundsResult, error) {
+	if params == nil {
+		params = &GetWindowBoundsParams{}
+	}
+	var result GetWindowBoundsResult
+	err := c.Call(ctx, "Browser.getWindowBounds", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetWindowForTargetParams holds the parameters of Browser.getWindowForTarget.
+type GetWindowForTargetParams struct {
+	// Devtools agent host id. If called as a part of the session, associated targetId is used.
+	TargetID target.TargetID `json:"targetId,omitempty"`
+}
+
+// GetWindowForTargetResult holds the result of Browser.getWindowForTarget.
+type GetWindowForTargetResult struct {
+	// Browser window id.
+	WindowID WindowID `json:"windowId"`
+	// Bounds information of the window. When window state is 'minimized', the restored window
+	// position and size are returned.
+	Bounds Bounds `json:"bounds"`
+}
+
+// GetWindowForTarget calls Browser.getWindowForTarget.
+//
+// Get the browser window that contains the devtools target.
+//
+// Experimental.
+func GetWindowForTarget(ctx context.Context, c *internal.Client, sessionID string, params *GetWindowForTargetParams) (*GetWindowForTargetResult, error) {
+	if params == nil {
+		params = &GetWindowForTargetParams{}
+	}
+	var result GetWindowForTargetResult
+	err := c.Call(ctx, "Browser.getWindowForTarget", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SetWindowBoundsParams holds the parameters of Browser.setWindowBounds.
+type SetWindowBoundsParams struct {
+	// Browser window id.
+	WindowID WindowID `json:"windowId"`
+	// New window bounds. The 'minimized', 'maximized' and 'fullscreen' states cannot be combined
+	// with 'left', 'top', 'width' or 'height'. Leaves unspecified fields unchanged.
+	Bounds Bounds `json:"bounds"`
+}
+
+// SetWindowBounds calls Browser.setWindowBounds.
+//
+// Set position and/or size of the browser window.
+//
+// Experimental.
+func SetWindowBounds(ctx context.Context, c *internal.Client, sessionID string, params *SetWindowBoundsParams) error {
+	if params == nil {
+		params = &SetWindowBoundsParams{}
+	}
+	return c.Call(ctx, "Browser.setWindowBounds", params, sessionID, nil)
+}
+
+// SetDockTileParams holds the parameters of Browser.setDockTile.
+type SetDockTileParams struct {
+	BadgeLabel string `json:"badgeLabel,omitempty"`
+	// Png encoded image. (Encoded as a base64 string when passed over JSON)
+	Image string `json:"image,omitempty"`
+}
+
+// SetDockTile calls Browser.setDockTile.
+//
+// Set dock tile details, platform-specific.
+//
+// Experimental.
+func SetDockTile(ctx context.Context, c *internal.Client, sessionID string, params *SetDockTileParams) error {
+	if params == nil {
+		params = &SetDockTileParams{}
+	}
+	return c.Call(ctx, "Browser.setDockTile", params, sessionID, nil)
+}
+
+// ExecuteBrowserCommandParams holds the parameters of Browser.executeBrowserCommand.
+type ExecuteBrowserCommandParams struct {
+	CommandID BrowserCommandID `json:"commandId"`
+}
+
+// ExecuteBrowserCommand calls Browser.executeBrowserCommand.
+//
+// Invoke custom browser commands used by telemetry.
+//
+// Experimental.
+func ExecuteBrowserCommand(ctx context.Context, c *internal.Client, sessionID string, params *ExecuteBrowserCommandParams) error {
+	if params == nil {
+		params = &ExecuteBrowserCommandParams{}
+	}
+	return c.Call(ctx, "Browser.executeBrowserCommand", params, sessionID, nil)
+}
+
+// AddPrivacySandboxEnrollmentOverrideParams holds the parameters of Browser.addPrivacySandboxEnrollmentOverride.
+type AddPrivacySandboxEnrollmentOverrideParams struct {
+	URL string `json:"url"`
+}
+
+// AddPrivacySandboxEnrollmentOverride calls Browser.addPrivacySandboxEnrollmentOverride.
+//
+// Allows a site to use privacy sandbox features that require enrollment
+// without the site actually being enrolled. Only supported on page targets.
+func AddPrivacySandboxEnrollmentOverride(ctx context.Context, c *internal.Client, sessionID string, params *AddPrivacySandboxEnrollmentOverrideParams) error {
+	if params == nil {
+		params = &AddPrivacySandboxEnrollmentOverrideParams{}
+	}
+	return c.Call(ctx, "Browser.addPrivacySandboxEnrollmentOverride", params, sessionID, nil)
+}
+
+// EventDownloadWillBegin is the method name of the Browser.downloadWillBegin event.
+const EventDownloadWillBegin = "Browser.downloadWillBegin"
+
+// DownloadWillBeginEvent is the payload of the Browser.downloadWillBegin event.
+//
+// Fired when page is about to start a download.
+//
+// Experimental.
+type DownloadWillBeginEvent struct {
+	// Id of the frame that caused the download to begin.
+	FrameID page.FrameID `json:"frameId"`
+	// Global unique identifier of the download.
+	Guid string `json:"guid"`
+	// URL of the resource being downloaded.
+	URL string `json:"url"`
+	// Suggested file name of the resource (the actual name of the file saved on disk may differ).
+	SuggestedFilename string `json:"suggestedFilename"`
+}
+
+// EventDownloadProgress is the method name of the Browser.downloadProgress event.
+const EventDownloadProgress = "Browser.downloadProgress"
+
+// DownloadProgressEvent is the payload of the Browser.downloadProgress event.
+//
+// Fired when download makes progress. Last call has |done| == true.
+//
+// Experimental.
+type DownloadProgressEvent struct {
+	// Global unique identifier of the download.
+	Guid string `json:"guid"`
+	// Total expected bytes to download.
+	TotalBytes float64 `json:"totalBytes"`
+	// Total bytes received.
+	ReceivedBytes float64 `json:"receivedBytes"`
+	// Download status.
+	State string `json:"state"`
+}
+
+// ParseEvent decodes a Browser event message into its typed event struct.
+func ParseEvent(msg *internal.CDPMessage) (any, error) {
+	var v any
+	switch msg.Method {
+	case EventDownloadWillBegin:
+		v = &DownloadWillBeginEvent{}
+	case EventDownloadProgress:
+		v = &DownloadProgressEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Browser event: %s", msg.Method)
+	}
+	if msg.Params == nil {
+		return v, nil
+	}
+	err := json.Unmarshal(msg.Params, v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,214 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package cachestorage provides typed bindings for the CDP CacheStorage domain.
+package cachestorage
+
+import (
+	"cdp/internal"
+	"cdp/protocol/storage"
+	"context"
+)
+
+// CacheID is the CacheStorage.CacheId type.
+//
+// Unique identifier of the Cache object.
+type CacheID string
+
+// CachedResponseType is the CacheStorage.CachedResponseType type.
+//
+// type of HTTP response cached
+type CachedResponseType string
+
+const (
+	CachedResponseTypeBasic          CachedResponseType = "basic"
+	CachedResponseTypeCors           CachedResponseType = "cors"
+	CachedResponseTypeDefault        CachedResponseType = "default"
+	CachedResponseTypeError          CachedResponseType = "error"
+	CachedResponseTypeOpaqueResponse CachedResponseType = "opaqueResponse"
+	CachedResponseTypeOpaqueRedirect CachedResponseType = "opaqueRedirect"
+)
+
+// DataEntry is the CacheStorage.DataEntry type.
+//
+// Data entry.
+type DataEntry struct {
+	// Request URL.
+	RequestURL string `json:"requestURL"`
+	// Request method.
+	RequestMethod string `json:"requestMethod"`
+	// Request headers
+	RequestHeaders []Header `json:"requestHeaders"`
+	// Number of seconds since epoch.
+	ResponseTime float64 `json:"responseTime"`
+	// HTTP response status code.
+	ResponseStatus int64 `json:"responseStatus"`
+	// HTTP response status text.
+	ResponseStatusText string `json:"responseStatusText"`
+	// HTTP response type
+	ResponseType CachedResponseType `json:"responseType"`
+	// Response headers
+	ResponseHeaders []Header `json:"responseHeaders"`
+}
+
+// Cache is the CacheStorage.Cache type.
+//
+// Cache identifier.
+type Cache struct {
+	// An opaque unique id of the cache.
+	CacheID CacheID `json:"cacheId"`
+	// Security origin of the cache.
+	SecurityOrigin string `json:"securityOrigin"`
+	// Storage key of the cache.
+	StorageKey string `json:"storageKey"`
+	// Storage bucket of the cache.
+	StorageBucket *storage.StorageBucket `json:"storageBucket,omitempty"`
+	// The name of the cache.
+	CacheName string `json:"cacheName"`
+}
+
+// Header is the CacheStorage.Header type.
+type Header struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// CachedResponse is the CacheStorage.CachedResponse type.
+//
+// Cached response
+type CachedResponse struct {
+	// Entry content, base64-encoded. (Encoded as a base64 string when passed over JSON)
+	Body string `json:"body"`
+}
+
+// DeleteCacheParams holds the parameters of CacheStorage.deleteCache.
+type DeleteCacheParams struct {
+	// Id of cache for deletion.
+	CacheID CacheID `json:"cacheId"`
+}
+
+// DeleteCache calls CacheStorage.deleteCache.
+//
+// Deletes a cache.
+func DeleteCache(ctx context.Context, c *internal.Client, sessionID string, params *DeleteCacheParams) error {
+	if params == nil {
+		params = &DeleteCacheParams{}
+	}
+	return c.Call(ctx, "CacheStorage.deleteCache", params, sessionID, nil)
+}
+
+// DeleteEntryParams holds the parameters of CacheStorage.deleteEntry.
+type DeleteEntryParams struct {
+	// Id of cache where the entry will be deleted.
+	CacheID CacheID `json:"cacheId"`
+	// URL spec of the request.
+	Request string `json:"request"`
+}
+
+// DeleteEntry calls CacheStorage.deleteEntry.
+//
+// Deletes a cache entry.
+func DeleteEntry(ctx context.Context, c *internal.Client, sessionID string, params *DeleteEntryParams) error {
+	if params == nil {
+		params = &DeleteEntryParams{}
+	}
+	return c.Call(ctx, "CacheStorage.deleteEntry", params, sessionID, nil)
+}
+
+// RequestCacheNamesParams holds the parameters of CacheStorage.requestCacheNames.
+type RequestCacheNamesParams struct {
+	// At least and at most one of securityOrigin, storageKey, storageBucket must be specified.
+	// Security origin.
+	SecurityOrigin string `json:"securityOrigin,omitempty"`
+	// Storage key.
+	StorageKey string `json:"storageKey,omitempty"`
+	// Storage bucket. If not specified, it uses the default bucket.
+	StorageBucket *storage.StorageBucket `json:"storageBucket,omitempty"`
+}
+
+// RequestCacheNamesResult holds the result of CacheStorage.requestCacheNames.
+type RequestCacheNamesResult struct {
+	// Caches for the security origin.
+	Caches []Cache `json:"caches"`
+}
+
+// RequestCacheNames calls CacheStorage.requestCacheNames.
+//
+// Requests cache names.
+func RequestCacheNames(ctx context.Context, c *internal.Client, sessionID string, params *RequestCacheNamesParams) (*RequestCacheNamesResult, error) {
+	if params == nil {
+		params = &RequestCacheNamesParams{}
+	}
+	var result RequestCacheNamesResult
+	err := c.Call(ctx, "CacheStorage.requestCacheNames", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// RequestCachedResponseParams holds the parameters of CacheStorage.requestCachedResponse.
+type RequestCachedResponseParams struct {
+	// Id of cache that contains the entry.
+	CacheID CacheID `json:"cacheId"`
+	// URL spec of the request.
+	RequestURL string `json:"requestURL"`
+	// headers of the request.
+	RequestHeaders []Header `json:"requestHeaders"`
+}
+
+// RequestCachedResponseResult holds the result of CacheStorage.requestCachedResponse.
+type RequestCachedResponseResult struct {
+	// Response read from the cache.
+	Response CachedResponse `json:"response"`
+}
+
+// RequestCachedResponse calls CacheStorage.requestCachedResponse.
+//
+// Fetches cache entry.
+func RequestCachedResponse(ctx context.Context, c *internal.Client, sessionID string, params *RequestCachedResponseParams) (*RequestCachedResponseResult, error) {
+	if params == nil {
+		params = &RequestCachedResponseParams{}
+	}
+	var result RequestCachedResponseResult
+	err := c.Call(ctx, "CacheStorage.requestCachedResponse", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// RequestEntriesParams holds the parameters of CacheStorage.requestEntries.
+type RequestEntriesParams struct {
+	// ID of cache to get entries from.
+	CacheID CacheID `json:"cacheId"`
+	// Number of records to skip.
+	SkipCount *int64 `json:"skipCount,omitempty"`
+	// Number of records to fetch.
+	PageSize *int64 `json:"pageSize,omitempty"`
+	// If present, only return the entries containing this substring in the path
+	PathFilter string `json:"pathFilter,omitempty"`
+}
+
+// RequestEntriesResult holds the result of CacheStorage.requestEntries.
+type RequestEntriesResult struct {
+	// Array of object store data entries.
+	CacheDataEntries []DataEntry `json:"cacheDataEntries"`
+	// Count of returned entries from this storage. If pathFilter is empty, it
+	// is the count of all entries from this storage.
+	ReturnCount float64 `json:"returnCount"`
+}
+
+// RequestEntries calls CacheStorage.requestEntries.
+//
+// Requests data from cache.
+func RequestEntries(ctx context.Context, c *internal.Client, sessionID string, params *RequestEntriesParams) (*RequestEntriesResult, error) {
+	if params == nil {
+		params = &RequestEntriesParams{}
+	}
+	var result RequestEntriesResult
+	err := c.Call(ctx, "CacheStorage.requestEntries", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
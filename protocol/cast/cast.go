@@ -0,0 +1,153 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package cast provides typed bindings for the CDP Cast domain.
+//
+// A domain for interacting with Cast, Presentation API, and Remote Playback API
+// functionalities.
+package cast
+
+import (
+	"cdp/internal"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Sink is the Cast.Sink type.
+type Sink struct {
+	Name string `json:"name"`
+	ID   string `json:"id"`
+	// Text describing the current session. Present only if there is an active
+	// session on the sink.
+	Session string `json:"session,omitempty"`
+}
+
+// EnableParams holds the parameters of Cast.enable.
+type EnableParams struct {
+	PresentationURL string `json:"presentationUrl,omitempty"`
+}
+
+// Enable calls Cast.enable.
+//
+// Starts observing for sinks that can be used for tab mirroring, and if set,
+// sinks compatible with |presentationUrl| as well. When sinks are found, a
+// |sinksUpdated| event is fired.
+// Also starts observing for issue messages. When an issue is added or removed,
+// an |issueUpdated| event is fired.
+func Enable(ctx context.Context, c *internal.Client, sessionID string, params *EnableParams) error {
+	if params == nil {
+		params = &EnableParams{}
+	}
+	return c.Call(ctx, "Cast.enable", params, sessionID, nil)
+}
+
+// Disable calls Cast.disable.
+//
+// Stops observing for sinks and issues.
+func Disable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Cast.disable", nil, sessionID, nil)
+}
+
+// SetSinkToUseParams holds the parameters of Cast.setSinkToUse.
+type SetSinkToUseParams struct {
+	SinkName string `json:"sinkName"`
+}
+
+// SetSinkToUse calls Cast.setSinkToUse.
+//
+// Sets a sink to be used when the web page requests the browser to choose a
+// sink via Presentation API, Remote Playback API, or Cast SDK.
+func SetSinkToUse(ctx context.Context, c *internal.Client, sessionID string, params *SetSinkToUseParams) error {
+	if params == nil {
+		params = &SetSinkToUseParams{}
+	}
+	return c.Call(ctx, "Cast.setSinkToUse", params, sessionID, nil)
+}
+
+// StartDesktopMirroringParams holds the parameters of Cast.startDesktopMirroring.
+type StartDesktopMirroringParams struct {
+	SinkName string `json:"sinkName"`
+}
+
+// StartDesktopMirroring calls Cast.startDesktopMirroring.
+//
+// Starts mirroring the desktop to the sink.
+func StartDesktopMirroring(ctx context.Context, c *internal.Client, sessionID string, params *StartDesktopMirroringParams) error {
+	if params == nil {
+		params = &StartDesktopMirroringParams{}
+	}
+	return c.Call(ctx, "Cast.startDesktopMirroring", params, sessionID, nil)
+}
+
+// StartTabMirroringParams holds the parameters of Cast.startTabMirroring.
+type StartTabMirroringParams struct {
+	SinkName string `json:"sinkName"`
+}
+
+// StartTabMirroring calls Cast.startTabMirroring.
+//
+// Starts mirroring the tab to the sink.
+func StartTabMirroring(ctx context.Context, c *internal.Client, sessionID string, params *StartTabMirroringParams) error {
+	if params == nil {
+		params = &StartTabMirroringParams{}
+	}
+	return c.Call(ctx, "Cast.startTabMirroring", params, sessionID, nil)
+}
+
+// StopCastingParams holds the parameters of Cast.stopCasting.
+type StopCastingParams struct {
+	SinkName string `json:"sinkName"`
+}
+
+// StopCasting calls Cast.stopCasting.
+//
+// Stops the active Cast session on the sink.
+func StopCasting(ctx context.Context, c *internal.Client, sessionID string, params *StopCastingParams) error {
+	if params == nil {
+		params = &StopCastingParams{}
+	}
+	return c.Call(ctx, "Cast.stopCasting", params, sessionID, nil)
+}
+
+// EventSinksUpdated is the method name of the Cast.sinksUpdated event.
+const EventSinksUpdated = "Cast.sinksUpdated"
+
+// SinksUpdatedEvent is the payload of the Cast.sinksUpdated event.
+//
+// This is fired whenever the list of available sinks changes. A sink is a
+// device or a software surface that you can cast to.
+type SinksUpdatedEvent struct {
+	Sinks []Sink `json:"sinks"`
+}
+
+// EventIssueUpdated is the method name of the Cast.issueUpdated event.
+const EventIssueUpdated = "Cast.issueUpdated"
+
+// IssueUpdatedEvent is the payload of the Cast.issueUpdated event.
+//
+// This is fired whenever the outstanding issue/error message changes.
+// |issueMessage| is empty if there is no issue.
+type IssueUpdatedEvent struct {
+	IssueMessage string `json:"issueMessage"`
+}
+
+// ParseEvent decodes a Cast event message into its typed event struct.
+func ParseEvent(msg *internal.CDPMessage) (any, error) {
+	var v any
+	switch msg.Method {
+	case EventSinksUpdated:
+		v = &SinksUpdatedEvent{}
+	case EventIssueUpdated:
+		v = &IssueUpdatedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Cast event: %s", msg.Method)
+	}
+	if msg.Params == nil {
+		return v, nil
+	}
+	err := json.Unmarshal(msg.Params, v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,83 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package console provides typed bindings for the CDP Console domain.
+//
+// This domain is deprecated - use Runtime or Log instead.
+package console
+
+import (
+	"cdp/internal"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// ConsoleMessage is the Console.ConsoleMessage type.
+//
+// Console message.
+type ConsoleMessage struct {
+	// Message source.
+	Source string `json:"source"`
+	// Message severity.
+	Level string `json:"level"`
+	// Message text.
+	Text string `json:"text"`
+	// URL of the message origin.
+	URL string `json:"url,omitempty"`
+	// Line number in the resource that generated this message (1-based).
+	Line *int64 `json:"line,omitempty"`
+	// Column number in the resource that generated this message (1-based).
+	Column *int64 `json:"column,omitempty"`
+}
+
+// ClearMessages calls Console.clearMessages.
+//
+// Does nothing.
+func ClearMessages(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Console.clearMessages", nil, sessionID, nil)
+}
+
+// Disable calls Console.disable.
+//
+// Disables console domain, prevents further console messages from being reported to the client.
+func Disable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Console.disable", nil, sessionID, nil)
+}
+
+// Enable calls Console.enable.
+//
+// Enables console domain, sends the messages collected so far to the client by means of the
+// `messageAdded` notification.
+func Enable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Console.enable", nil, sessionID, nil)
+}
+
+// EventMessageAdded is the method name of the Console.messageAdded event.
+const EventMessageAdded = "Console.messageAdded"
+
+// MessageAddedEvent is the payload of the Console.messageAdded event.
+//
+// Issued when new console message is added.
+type MessageAddedEvent struct {
+	// Console message that has been added.
+	Message ConsoleMessage `json:"message"`
+}
+
+// ParseEvent decodes a Console event message into its typed event struct.
+func ParseEvent(msg *internal.CDPMessage) (any, error) {
+	var v any
+	switch msg.Method {
+	case EventMessageAdded:
+		v = &MessageAddedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Console event: %s", msg.Method)
+	}
+	if msg.Params == nil {
+		return v, nil
+	}
+	err := json.Unmarshal(msg.Params, v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
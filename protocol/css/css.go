@@ -0,0 +1,1477 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package css provides typed bindings for the CDP CSS domain.
+//
+// This domain exposes CSS read/write operations. All CSS objects (stylesheets, rules, and styles)
+// have an associated `id` used in subsequent operations on the related object. Each object type has
+// a specific `id` structure, and those are not interchangeable between objects of different kinds.
+// CSS objects can be loaded using the `get*ForNode()` calls (which accept a DOM node id). A client
+// can also keep track of stylesheets via the `styleSheetAdded`/`styleSheetRemoved` events and
+// subsequently load the required stylesheet contents using the `getStyleSheet[Text]()` methods.
+package css
+
+import (
+	"cdp/internal"
+	"cdp/protocol/dom"
+	"cdp/protocol/page"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// StyleSheetID is the CSS.StyleSheetId type.
+type StyleSheetID string
+
+// StyleSheetOrigin is the CSS.StyleSheetOrigin type.
+//
+// Stylesheet type: "injected" for stylesheets injected via extension, "user-agent" for user-agent
+// stylesheets, "inspector" for stylesheets created by the inspector (i.e. those holding the "via
+// inspector" rules), "regular" for regular stylesheets.
+type StyleSheetOrigin string
+
+const (
+	StyleSheetOriginInjected  StyleSheetOrigin = "injected"
+	StyleSheetOriginUserAgent StyleSheetOrigin = "user-agent"
+	StyleSheetOriginInspector StyleSheetOrigin = "inspector"
+	StyleSheetOriginRegular   StyleSheetOrigin = "regular"
+)
+
+// PseudoElementMatches is the CSS.PseudoElementMatches type.
+//
+// CSS rule collection for a single pseudo style.
+type PseudoElementMatches struct {
+	// Pseudo element type.
+	PseudoType dom.PseudoType `json:"pseudoType"`
+	// Pseudo element custom ident.
+	PseudoIdentifier string `json:"pseudoIdentifier,omitempty"`
+	// Matches of CSS rules applicable to the pseudo style.
+	Matches []RuleMatch `json:"matches"`
+}
+
+// InheritedStyleEntry is the CSS.InheritedStyleEntry type.
+//
+// Inherited CSS rule collection from ancestor node.
+type InheritedStyleEntry struct {
+	// The ancestor node's inline style, if any, in the style inheritance chain.
+	InlineStyle *CSSStyle `json:"inlineStyle,omitempty"`
+	// Matches of CSS rules matching the ancestor node in the style inheritance chain.
+	MatchedCSSRules []RuleMatch `json:"matchedCSSRules"`
+}
+
+// InheritedPseudoElementMatches is the CSS.InheritedPseudoElementMatches type.
+//
+// Inherited pseudo element matches from pseudos of an ancestor node.
+type InheritedPseudoElementMatches struct {
+	// Matches of pseudo styles from the pseudos of an ancestor node.
+	PseudoElements []PseudoElementMatches `json:"pseudoElements"`
+}
+
+// RuleMatch is the CSS.RuleMatch type.
+//
+// Match data for a CSS rule.
+type RuleMatch struct {
+	// CSS rule in the match.
+	Rule CSSRule `json:"rule"`
+	// Matching selector indices in the rule's selectorList selectors (0-based).
+	MatchingSelectors []int64 `json:"matchingSelectors"`
+}
+
+// Value is the CSS.Value type.
+//
+// Data for a simple selector (these are delimited by commas in a selector list).
+type Value struct {
+	// Value text.
+	Text string `json:"text"`
+	// Value range in the underlying resource (if available).
+	Range *SourceRange `json:"range,omitempty"`
+	// Specificity of the selector.
+	//
+	// Experimental.
+	Specificity *Specificity `json:"specificity,omitempty"`
+}
+
+// Specificity is the CSS.Specificity type.
+//
+// Specificity:
+// https://drafts.csswg.org/selectors/#specificity-rules
+//
+// Experimental.
+type Specificity struct {
+	// The a component, which represents the number of ID selectors.
+	A int64 `json:"a"`
+	// The b component, which represents the number of class selectors, attributes selectors, and
+	// pseudo-classes.
+	B int64 `json:"b"`
+	// The c component, which represents the number of type selectors and pseudo-elements.
+	C int64 `json:"c"`
+}
+
+// SelectorList is the CSS.SelectorList type.
+//
+// Selector list data.
+type SelectorList struct {
+	// Selectors in the list.
+	Selectors []Value `json:"selectors"`
+	// Rule selector text.
+	Text string `json:"text"`
+}
+
+// CSSStyleSheetHeader is the CSS.CSSStyleSheetHeader type.
+//
+// CSS stylesheet metainformation.
+type CSSStyleSheetHeader struct {
+	// The stylesheet identifier.
+	StyleSheetID StyleSheetID `json:"styleSheetId"`
+	// Owner frame identifier.
+	FrameID page.FrameID `json:"frameId"`
+	// Stylesheet resource URL. Empty if this is a constructed stylesheet created using
+	// new CSSStyleSheet() (but non-empty if this is a constructed stylesheet imported
+	// as a CSS module script).
+	SourceURL string `json:"sourceURL"`
+	// URL of source map associated with the stylesheet (if any).
+	SourceMapURL string `json:"sourceMapURL,omitempty"`
+	// Stylesheet origin.
+	Origin StyleSheetOrigin `json:"origin"`
+	// Stylesheet title.
+	Title string `json:"title"`
+	// The backend id for the owner node of the stylesheet.
+	OwnerNode *dom.BackendNodeID `json:"ownerNode,omitempty"`
+	// Denotes whether the stylesheet is disabled.
+	Disabled bool `json:"disabled"`
+	// Whether the sourceURL field value comes from the sourceURL comment.
+	HasSourceURL *bool `json:"hasSourceURL,omitempty"`
+	// Whether this stylesheet is created for STYLE tag by parser. This flag is not set for
+	// document.written STYLE tags.
+	IsInline bool `json:"isInline"`
+	// Whether this stylesheet is mutable. Inline stylesheets become mutable
+	// after they have been modified via CSSOM API.
+	// `<link>` element's stylesheets become mutable only if DevTools modifies them.
+	// Constructed stylesheets (new CSSStyleSheet()) are mutable immediately after creation.
+	IsMutable bool `json:"isMutable"`
+	// True if this stylesheet is created through new CSSStyleSheet() or imported as a
+	// CSS module script.
+	IsConstructed bool `json:"isConstructed"`
+	// Line offset of the stylesheet within the resource (zero based).
+	StartLine float64 `json:"startLine"`
+	// Column offset of the stylesheet within the resource (zero based).
+	StartColumn float64 `json:"startColumn"`
+	// Size of the content (in characters).
+	Length float64 `json:"length"`
+	// Line offset of the end of the stylesheet within the resource (zero based).
+	EndLine float64 `json:"endLine"`
+	// Column offset of the end of the stylesheet within the resource (zero based).
+	EndColumn float64 `json:"endColumn"`
+	// If the style sheet was loaded from a network resource, this indicates when the resource failed to load
+	//
+	// Experimental.
+	LoadingFailed *bool `json:"loadingFailed,omitempty"`
+}
+
+// CSSRule is the CSS.CSSRule type.
+//
+// CSS rule representation.
+type CSSRule struct {
+	// The css style sheet identifier (absent for user agent stylesheet and user-specified
+	// stylesheet rules) this rule came from.
+	StyleSheetID StyleSheetID `json:"styleSheetId,omitempty"`
+	// Rule selector data.
+	SelectorList SelectorList `json:"selectorList"`
+	// Array of selectors from ancestor style rules, sorted by distance from the current rule.
+	//
+	// Experimental.
+	NestingSelectors []string `json:"nestingSelectors,omitempty"`
+	// Parent stylesheet's origin.
+	Origin StyleSheetOrigin `json:"origin"`
+	// Associated style declaration.
+	Style CSSStyle `json:"style"`
+	// Media list array (for rules involving media queries). The array enumerates media queries
+	// starting with the innermost one, going outwards.
+	Media []CSSMedia `json:"media,omitempty"`
+	// Container query list array (for rules involving container queries).
+	// The array enumerates container queries starting with the innermost one, going outwards.
+	//
+	// Experimental.
+	ContainerQueries []CSSContainerQuery `json:"containerQueries,omitempty"`
+	// @supports CSS at-rule array.
+	// The array enumerates @supports at-rules starting with the innermost one, going outwards.
+	//
+	// Experimental.
+	Supports []CSSSupports `json:"supports,omitempty"`
+	// Cascade layer array. Contains the layer hierarchy that this rule belongs to starting
+	// with the innermost layer and going outwards.
+	//
+	// Experimental.
+	Layers []CSSLayer `json:"layers,omitempty"`
+	// @scope CSS at-rule array.
+	// The array enumerates @scope at-rules starting with the innermost one, going outwards.
+	//
+	// Experimental.
+	Scopes []CSSScope `json:"scopes,omitempty"`
+	// The array keeps the types of ancestor CSSRules from the innermost going outwards.
+	//
+	// Experimental.
+	RuleTypes []CSSRuleType `json:"ruleTypes,omitempty"`
+}
+
+// CSSRuleType is the CSS.CSSRuleType type.
+//
+// Enum indicating the type of a CSS rule, used to represent the order of a style rule's ancestors.
+// This list only contains rule types that are collected during the ancestor rule collection.
+//
+// Experimental.
+type CSSRuleType string
+
+const (
+	CSSRuleTypeMediaRule     CSSRuleType = "MediaRule"
+	CSSRuleTypeSupportsRule  CSSRuleType = "SupportsRule"
+	CSSRuleTypeContainerRule CSSRuleType = "ContainerRule"
+	CSSRuleTypeLayerRule     CSSRuleType = "LayerRule"
+	CSSRuleTypeScopeRule     CSSRuleType = "ScopeRule"
+	CSSRuleTypeStyleRule     CSSRuleType = "StyleRule"
+)
+
+// RuleUsage is the CSS.RuleUsage type.
+//
+// CSS coverage information.
+type RuleUsage struct {
+	// The css style sheet identifier (absent for user agent stylesheet and user-specified
+	// stylesheet rules) this rule came from.
+	StyleSheetID StyleSheetID `json:"styleSheetId"`
+	// Offset of the start of the rule (including selector) from the beginning of the stylesheet.
+	StartOffset float64 `json:"startOffset"`
+	// Offset of the end of the rule body from the beginning of the stylesheet.
+	EndOffset float64 `json:"endOffset"`
+	// Indicates whether the rule was actually used by some element in the page.
+	Used bool `json:"used"`
+}
+
+// SourceRange is the CSS.SourceRange type.
+//
+// Text range within a resource. All numbers are zero-based.
+type SourceRange struct {
+	// Start line of range.
+	StartLine int64 `json:"startLine"`
+	// Start column of range (inclusive).
+	StartColumn int64 `json:"startColumn"`
+	// End line of range
+	EndLine int64 `json:"endLine"`
+	// End column of range (exclusive).
+	EndColumn int64 `json:"endColumn"`
+}
+
+// ShorthandEntry is the CSS.ShorthandEntry type.
+type ShorthandEntry struct {
+	// Shorthand name.
+	Name string `json:"name"`
+	// Shorthand value.
+	Value string `json:"value"`
+	// Whether the property has "!important" annotation (implies `false` if absent).
+	Important *bool `json:"important,omitempty"`
+}
+
+// CSSComputedStyleProperty is the CSS.CSSComputedStyleProperty type.
+type CSSComputedStyleProperty struct {
+	// Computed style property name.
+	Name string `json:"name"`
+	// Computed style property value.
+	Value string `json:"value"`
+}
+
+// CSSStyle is the CSS.CSSStyle type.
+//
+// CSS style representation.
+type CSSStyle struct {
+	// The css style sheet identifier (absent for user agent stylesheet and user-specified
+	// stylesheet rules) this rule came from.
+	StyleSheetID StyleSheetID `json:"styleSheetId,omitempty"`
+	// CSS properties in the style.
+	CSSProperties []CSSProperty `json:"cssProperties"`
+	// Computed values for all shorthands found in the style.
+	ShorthandEntries []ShorthandEntry `json:"shorthandEntries"`
+	// Style declaration text (if available).
+	CSSText string `json:"cssText,omitempty"`
+	// Style declaration range in the enclosing stylesheet (if available).
+	Range *SourceRange `json:"range,omitempty"`
+}
+
+// CSSProperty is the CSS.CSSProperty type.
+//
+// CSS property declaration data.
+type CSSProperty struct {
+	// The property name.
+	Name string `json:"name"`
+	// The property value.
+	Value string `json:"value"`
+	// Whether the property has "!important" annotation (implies `false` if absent).
+	Important *bool `json:"important,omitempty"`
+	// Whether the property is implicit (implies `false` if absent).
+	Implicit *bool `json:"implicit,omitempty"`
+	// The full property text as specified in the style.
+	Text string `json:"text,omitempty"`
+	// Whether the property is understood by the browser (implies `true` if absent).
+	ParsedOk *bool `json:"parsedOk,omitempty"`
+	// Whether the property is disabled by the user (present for source-based properties only).
+	Disabled *bool `json:"disabled,omitempty"`
+	// The entire property range in the enclosing style declaration (if available).
+	Range *SourceRange `json:"range,omitempty"`
+	// Parsed longhand components of this property if it is a shorthand.
+	// This field will be empty if the given property is not a shorthand.
+	//
+	// Experimental.
+	LonghandProperties []CSSProperty `json:"longhandProperties,omitempty"`
+}
+
+// CSSMedia is the CSS.CSSMedia type.
+//
+// CSS media rule descriptor.
+type CSSMedia struct {
+	// Media query text.
+	Text string `json:"text"`
+	// Source of the media query: "mediaRule" if specified by a @media rule, "importRule" if
+	// specified by an @import rule, "linkedSheet" if specified by a "media" attribute in a linked
+	// stylesheet's LINK tag, "inlineSheet" if specified by a "media" attribute in an inline
+	// stylesheet's STYLE tag.
+	Source string `json:"source"`
+	// URL of the document containing the media query description.
+	SourceURL string `json:"sourceURL,omitempty"`
+	// The associated rule (@media or @import) header range in the enclosing stylesheet (if
+	// available).
+	Range *SourceRange `json:"range,omitempty"`
+	// Identifier of the stylesheet containing this object (if exists).
+	StyleSheetID StyleSheetID `json:"styleSheetId,omitempty"`
+	// Array of media queries.
+	MediaList []MediaQuery `json:"mediaList,omitempty"`
+}
+
+// MediaQuery is the CSS.MediaQuery type.
+//
+// Media query descriptor.
+type MediaQuery struct {
+	// Array of media query expressions.
+	Expressions []MediaQueryExpression `json:"expressions"`
+	// Whether the media query condition is satisfied.
+	Active bool `json:"active"`
+}
+
+// MediaQueryExpression is the CSS.MediaQueryExpression type.
+//
+// Media query expression descriptor.
+type MediaQueryExpression struct {
+	// Media query expression value.
+	Value float64 `json:"value"`
+	// Media query expression units.
+	Unit string `json:"unit"`
+	// Media query expression feature.
+	Feature string `json:"feature"`
+	// The associated range of the value text in the enclosing stylesheet (if available).
+	ValueRange *SourceRange `json:"valueRange,omitempty"`
+	// Computed length of media query expression (if applicable).
+	ComputedLength *float64 `json:"computedLength,omitempty"`
+}
+
+// CSSContainerQuery is the CSS.CSSContainerQuery type.
+//
+// CSS container query rule descriptor.
+//
+// Experimental.
+type CSSContainerQuery struct {
+	// Container query text.
+	Text string `json:"text"`
+	// The associated rule header range in the enclosing stylesheet (if
+	// available).
+	Range *SourceRange `json:"range,omitempty"`
+	// Identifier of the stylesheet containing this object (if exists).
+	StyleSheetID StyleSheetID `json:"styleSheetId,omitempty"`
+	// Optional name for the container.
+	Name string `json:"name,omitempty"`
+	// Optional physical axes queried for the container.
+	PhysicalAxes dom.PhysicalAxes `json:"physicalAxes,omitempty"`
+	// Optional logical axes queried for the container.
+	LogicalAxes dom.LogicalAxes `json:"logicalAxes,omitempty"`
+}
+
+// CSSSupports is the CSS.CSSSupports type.
+//
+// CSS Supports at-rule descriptor.
+//
+// Experimental.
+type CSSSupports struct {
+	// Supports rule text.
+	Text string `json:"text"`
+	// Whether the supports condition is satisfied.
+	Active bool `json:"active"`
+	// The associated rule header range in the enclosing stylesheet (if
+	// available).
+	Range *SourceRange `json:"range,omitempty"`
+	// Identifier of the stylesheet containing this object (if exists).
+	StyleSheetID StyleSheetID `json:"styleSheetId,omitempty"`
+}
+
+// CSSScope is the CSS.CSSScope type.
+//
+// CSS Scope at-rule descriptor.
+//
+// Experimental.
+type CSSScope struct {
+	// Scope rule text.
+	Text string `json:"text"`
+	// The associated rule header range in the enclosing stylesheet (if
+	// available).
+	Range *SourceRange `json:"range,omitempty"`
+	// Identifier of the stylesheet containing this object (if exists).
+	StyleSheetID StyleSheetID `json:"styleSheetId,omitempty"`
+}
+
+// CSSLayer is the CSS.CSSLayer type.
+//
+// CSS Layer at-rule descriptor.
+//
+// Experimental.
+type CSSLayer struct {
+	// Layer name.
+	Text string `json:"text"`
+	// The associated rule header range in the enclosing stylesheet (if
+	// available).
+	Range *SourceRange `json:"range,omitempty"`
+	// Identifier of the stylesheet containing this object (if exists).
+	StyleSheetID StyleSheetID `json:"styleSheetId,omitempty"`
+}
+
+// CSSLayerData is the CSS.CSSLayerData type.
+//
+// CSS Layer data.
+//
+// Experimental.
+type CSSLayerData struct {
+	// Layer name.
+	Name string `json:"name"`
+	// Direct sub-layers
+	SubLayers []CSSLayerData `json:"subLayers,omitempty"`
+	// Layer order. The order determines the order of the layer in the cascade order.
+	// A higher number has higher priority in the cascade order.
+	Order float64 `json:"order"`
+}
+
+// PlatformFontUsage is the CSS.PlatformFontUsage type.
+//
+// Information about amount of glyphs that were rendered with given font.
+type PlatformFontUsage struct {
+	// Font's family name reported by platform.
+	FamilyName string `json:"familyName"`
+	// Font's PostScript name reported by platform.
+	PostScriptName string `json:"postScriptName"`
+	// Indicates if the font was downloaded or resolved locally.
+	IsCustomFont bool `json:"isCustomFont"`
+	// Amount of glyphs that were rendered with this font.
+	GlyphCount float64 `json:"glyphCount"`
+}
+
+// FontVariationAxis is the CSS.FontVariationAxis type.
+//
+// Information about font variation axes for variable fonts
+type FontVariationAxis struct {
+	// The font-variation-setting tag (a.k.a. "axis tag").
+	Tag string `json:"tag"`
+	// Human-readable variation name in the default language (normally, "en").
+	Name string `json:"name"`
+	// The minimum value (inclusive) the font supports for this tag.
+	MinValue float64 `json:"minValue"`
+	// The maximum value (inclusive) the font supports for this tag.
+	MaxValue float64 `json:"maxValue"`
+	// The default value.
+	DefaultValue float64 `json:"defaultValue"`
+}
+
+// FontFace is the CSS.FontFace type.
+//
+// Properties of a web font: https://www.w3.org/TR/2008/REC-CSS2-20080411/fonts.html#font-descriptions
+// and additional information such as platformFontFamily and fontVariationAxes.
+type FontFace struct {
+	// The font-family.
+	FontFamily string `json:"fontFamily"`
+	// The font-style.
+	FontStyle string `json:"fontStyle"`
+	// The font-variant.
+	FontVariant string `json:"fontVariant"`
+	// The font-weight.
+	FontWeight string `json:"fontWeight"`
+	// The font-stretch.
+	FontStretch string `json:"fontStretch"`
+	// The font-display.
+	FontDisplay string `json:"fontDisplay"`
+	// The unicode-range.
+	UnicodeRange string `json:"unicodeRange"`
+	// The src.
+	Src string `json:"src"`
+	// The resolved platform font family
+	PlatformFontFamily string `json:"platformFontFamily"`
+	// Available variation settings (a.k.a. "axes").
+	FontVariationAxes []FontVariationAxis `json:"fontVariationAxes,omitempty"`
+}
+
+// CSSTryRule is the CSS.CSSTryRule type.
+//
+// CSS try rule representation.
+type CSSTryRule struct {
+	// The css style sheet identifier (absent for user agent stylesheet and user-specified
+	// stylesheet rules) this rule came from.
+	StyleSheetID StyleSheetID `json:"styleSheetId,omitempty"`
+	// Parent stylesheet's origin.
+	Origin StyleSheetOrigin `json:"origin"`
+	// Associated style declaration.
+	Style CSSStyle `json:"style"`
+}
+
+// CSSPositionTryRule is the CSS.CSSPositionTryRule type.
+//
+// CSS @position-try rule representation.
+type CSSPositionTryRule struct {
+	// The prelude dashed-ident name
+	Name Value `json:"name"`
+	// The css style sheet identifier (absent for user agent stylesheet and user-specified
+	// stylesheet rules) this rule came from.
+	StyleSheetID StyleSheetID `json:"styleSheetId,omitempty"`
+	// Parent stylesheet's origin.
+	Origin StyleSheetOrigin `json:"origin"`
+	// Associated style declaration.
+	Style  CSSStyle `json:"style"`
+	Active bool     `json:"active"`
+}
+
+// CSSKeyframesRule is the CSS.CSSKeyframesRule type.
+//
+// CSS keyframes rule representation.
+type CSSKeyframesRule struct {
+	// Animation name.
+	AnimationName Value `json:"animationName"`
+	// List of keyframes.
+	Keyframes []CSSKeyframeRule `json:"keyframes"`
+}
+
+// CSSPropertyRegistration is the CSS.CSSPropertyRegistration type.
+//
+// Representation of a custom property registration through CSS.registerProperty
+type CSSPropertyRegistration struct {
+	PropertyName string `json:"propertyName"`
+	InitialValue *Value `json:"initialValue,omitempty"`
+	Inherits     bool   `json:"inherits"`
+	Syntax       string `json:"syntax"`
+}
+
+// CSSFontPaletteValuesRule is the CSS.CSSFontPaletteValuesRule type.
+//
+// CSS font-palette-values rule representation.
+type CSSFontPaletteValuesRule struct {
+	// The css style sheet identifier (absent for user agent stylesheet and user-specified
+	// stylesheet rules) this rule came from.
+	StyleSheetID StyleSheetID `json:"styleSheetId,omitempty"`
+	// Parent stylesheet's origin.
+	Origin StyleSheetOrigin `json:"origin"`
+	// Associated font palette name.
+	FontPaletteName Value `json:"fontPaletteName"`
+	// Associated style declaration.
+	Style CSSStyle `json:"style"`
+}
+
+// CSSPropertyRule is the CSS.CSSPropertyRule type.
+//
+// CSS property at-rule representation.
+type CSSPropertyRule struct {
+	// The css style sheet identifier (absent for user agent stylesheet and user-specified
+	// stylesheet rules) this rule came from.
+	StyleSheetID StyleSheetID `json:"styleSheetId,omitempty"`
+	// Parent stylesheet's origin.
+	Origin StyleSheetOrigin `json:"origin"`
+	// Associated property name.
+	PropertyName Value `json:"propertyName"`
+	// Associated style declaration.
+	Style CSSStyle `json:"style"`
+}
+
+// CSSKeyframeRule is the CSS.CSSKeyframeRule type.
+//
+// CSS keyframe rule representation.
+type CSSKeyframeRule struct {
+	// The css style sheet identifier (absent for user agent stylesheet and user-specified
+	// stylesheet rules) this rule came from.
+	StyleSheetID StyleSheetID `json:"styleSheetId,omitempty"`
+	// Parent stylesheet's origin.
+	Origin StyleSheetOrigin `json:"origin"`
+	// Associated key text.
+	KeyText Value `json:"keyText"`
+	// Associated style declaration.
+	Style CSSStyle `json:"style"`
+}
+
+// StyleDeclarationEdit is the CSS.StyleDeclarationEdit type.
+//
+// A descriptor of operation to mutate style declaration text.
+type StyleDeclarationEdit struct {
+	// The css style sheet identifier.
+	StyleSheetID StyleSheetID `json:"styleSheetId"`
+	// The range of the style text in the enclosing stylesheet.
+	Range SourceRange `json:"range"`
+	// New style text.
+	Text string `json:"text"`
+}
+
+// AddRuleParams holds the parameters of CSS.addRule.
+type AddRuleParams struct {
+	// The css style sheet identifier where a new rule should be inserted.
+	StyleSheetID StyleSheetID `json:"styleSheetId"`
+	// The text of a new rule.
+	RuleText string `json:"ruleText"`
+	// Text position of a new rule in the target style sheet.
+	Location SourceRange `json:"location"`
+	// NodeId for the DOM node in whose context custom property declarations for registered properties should be
+	// validated. If omitted, declarations in the new rule text can only be validated statically, which may produce
+	// incorrect results if the declaration contains a var() for example.
+	//
+	// Experimental.
+	NodeForPropertySyntaxValidation *dom.NodeID `json:"nodeForPropertySyntaxValidation,omitempty"`
+}
+
+// AddRuleResult holds the result of CSS.addRule.
+type AddRuleResult struct {
+	// The newly created rule.
+	Rule CSSRule `json:"rule"`
+}
+
+// AddRule calls CSS.addRule.
+//
+// Inserts a new rule with the given `ruleText` in a stylesheet with given `styleSheetId`, at the
+// position specified by `location`.
+func AddRule(ctx context.Context, c *internal.Client, sessionID string, params *AddRuleParams) (*AddRuleResult, error) {
+	if params == nil {
+		params = &AddRuleParams{}
+	}
+	var result AddRuleResult
+	err := c.Call(ctx, "CSS.addRule", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CollectClassNamesParams holds the parameters of CSS.collectClassNames.
+type CollectClassNamesParams struct {
+	StyleSheetID StyleSheetID `json:"styleSheetId"`
+}
+
+// CollectClassNamesResult holds the result of CSS.collectClassNames.
+type CollectClassNamesResult struct {
+	// Class name list.
+	ClassNames []string `json:"classNames"`
+}
+
+// CollectClassNames calls CSS.collectClassNames.
+//
+// Returns all class names from specified stylesheet.
+func CollectClassNames(ctx context.Context, c *internal.Client, sessionID string, params *CollectClassNamesParams) (*CollectClassNamesResult, error) {
+	if params == nil {
+		params = &CollectClassNamesParams{}
+	}
+	var result CollectClassNamesResult
+	err := c.Call(ctx, "CSS.collectClassNames", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CreateStyleSheetParams holds the parameters of CSS.createStyleSheet.
+type CreateStyleSheetParams struct {
+	// Identifier of the frame where "via-inspector" stylesheet should be created.
+	FrameID page.FrameID `json:"frameId"`
+}
+
+// CreateStyleSheetResult holds the result of CSS.createStyleSheet.
+type CreateStyleSheetResult struct {
+	// Identifier of the created "via-inspector" stylesheet.
+	StyleSheetID StyleSheetID `json:"styleSheetId"`
+}
+
+// CreateStyleSheet calls CSS.createStyleSheet.
+//
+// Creates a new special "via-inspector" stylesheet in the frame with given `frameId`.
+func CreateStyleSheet(ctx context.Context, c *internal.Client, sessionID string, params *CreateStyleSheetParams) (*CreateStyleSheetResult, error) {
+	if params == nil {
+		params = &CreateStyleSheetParams{}
+	}
+	var result CreateStyleSheetResult
+	err := c.Call(ctx, "CSS.createStyleSheet", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Disable calls CSS.disable.
+//
+// Disables the CSS agent for the given page.
+func Disable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "CSS.disable", nil, sessionID, nil)
+}
+
+// Enable calls CSS.enable.
+//
+// Enables the CSS agent for the given page. Clients should not assume that the CSS agent has been
+// enabled until the result of this command is received.
+func Enable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "CSS.enable", nil, sessionID, nil)
+}
+
+// ForcePseudoStateParams holds the parameters of CSS.forcePseudoState.
+type ForcePseudoStateParams struct {
+	// The element id for which to force the pseudo state.
+	NodeID dom.NodeID `json:"nodeId"`
+	// Element pseudo classes to force when computing the element's style.
+	ForcedPseudoClasses []string `json:"forcedPseudoClasses"`
+}
+
+// ForcePseudoState calls CSS.forcePseudoState.
+//
+// Ensures that the given node will have specified pseudo-classes whenever its style is computed by
+// the browser.
+func ForcePseudoState(ctx context.Context, c *internal.Client, sessionID string, params *ForcePseudoStateParams) error {
+	if params == nil {
+		params = &ForcePseudoStateParams{}
+	}
+	return c.Call(ctx, "CSS.forcePseudoState", params, sessionID, nil)
+}
+
+// GetBackgroundColorsParams holds the parameters of CSS.getBackgroundColors.
+type GetBackgroundColorsParams struct {
+	// Id of the node to get background colors for.
+	NodeID dom.NodeID `json:"nodeId"`
+}
+
+// GetBackgroundColorsResult holds the result of CSS.getBackgroundColors.
+type GetBackgroundColorsResult struct {
+	// The range of background colors behind this element, if it contains any visible text. If no
+	// visible text is present, this will be undefined. In the case of a flat background color,
+	// this will consist of simply that color. In the case of a gradient, this will consist of each
+	// of the color stops. For anything more complicated, this will be an empty array. Images will
+	// be ignored (as if the image had failed to load).
+	BackgroundColors []string `json:"backgroundColors,omitempty"`
+	// The computed font size for this node, as a CSS computed value string (e.g. '12px').
+	ComputedFontSize string `json:"computedFontSize,omitempty"`
+	// The computed font weight for this node, as a CSS computed value string (e.g. 'normal' or
+	// '100').
+	ComputedFontWeight string `json:"computedFontWeight,omitempty"`
+}
+
+// GetBackgroundColors calls CSS.getBackgroundColors.
+func GetBackgroundColors(ctx context.Context, c *internal.Client, sessionID string, params *GetBackgroundColorsParams) (*GetBackgroundColorsResult, error) {
+	if params == nil {
+		params = &GetBackgroundColorsParams{}
+	}
+	var result GetBackgroundColorsResult
+	err := c.Call(ctx, "CSS.getBackgroundColors", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetComputedStyleForNodeParams holds the parameters of CSS.getComputedStyleForNode.
+type GetComputedStyleForNodeParams struct {
+	NodeID dom.NodeID `json:"nodeId"`
+}
+
+// GetComputedStyleForNodeResult holds the result of CSS.getComputedStyleForNode.
+type GetComputedStyleForNodeResult struct {
+	// Computed style for the specified DOM node.
+	ComputedStyle []CSSComputedStyleProperty `json:"computedStyle"`
+}
+
+// GetComputedStyleForNode calls CSS.getComputedStyleForNode.
+//
+// Returns the computed style for a DOM node identified by `nodeId`.
+func GetComputedStyleForNode(ctx context.Context, c *internal.Client, sessionID string, params *GetComputedStyleForNodeParams) (*GetComputedStyleForNodeResult, error) {
+	if params == nil {
+		params = &GetComputedStyleForNodeParams{}
+	}
+	var result GetComputedStyleForNodeResult
+	err := c.Call(ctx, "CSS.getComputedStyleForNode", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetInlineStylesForNodeParams holds the parameters of CSS.getInlineStylesForNode.
+type GetInlineStylesForNodeParams struct {
+	NodeID dom.NodeID `json:"nodeId"`
+}
+
+// GetInlineStylesForNodeResult holds the result of CSS.getInlineStylesForNode.
+type GetInlineStylesForNodeResult struct {
+	// Inline style for the specified DOM node.
+	InlineStyle *CSSStyle `json:"inlineStyle,omitempty"`
+	// Attribute-defined element style (e.g. resulting from "width=20 height=100%").
+	AttributesStyle *CSSStyle `json:"attributesStyle,omitempty"`
+}
+
+// GetInlineStylesForNode calls CSS.getInlineStylesForNode.
+//
+// Returns the styles defined inline (explicitly in the "style" attribute and implicitly, using DOM
+// attributes) for a DOM node identified by `nodeId`.
+func GetInlineStylesForNode(ctx context.Context, c *internal.Client, sessionID string, params *GetInlineStylesForNodeParams) (*GetInlineStylesForNodeResult, error) {
+	if params == nil {
+		params = &GetInlineStylesForNodeParams{}
+	}
+	var result GetInlineStylesForNodeResult
+	err := c.Call(ctx, "CSS.getInlineStylesForNode", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetMatchedStylesForNodeParams holds the parameters of CSS.getMatchedStylesForNode.
+type GetMatchedStylesForNodeParams struct {
+	NodeID dom.NodeID `json:"nodeId"`
+}
+
+// GetMatchedStylesForNodeResult holds the result of CSS.getMatchedStylesForNode.
+type GetMatchedStylesForNodeResult struct {
+	// Inline style for the specified DOM node.
+	InlineStyle *CSSStyle `json:"inlineStyle,omitempty"`
+	// Attribute-defined element style (e.g. resulting from "width=20 height=100%").
+	AttributesStyle *CSSStyle `json:"attributesStyle,omitempty"`
+	// CSS rules matching this node, from all applicable stylesheets.
+	MatchedCSSRules []RuleMatch `json:"matchedCSSRules,omitempty"`
+	// Pseudo style matches for this node.
+	PseudoElements []PseudoElementMatches `json:"pseudoElements,omitempty"`
+	// A chain of inherited styles (from the immediate node parent up to the DOM tree root).
+	Inherited []InheritedStyleEntry `json:"inherited,omitempty"`
+	// A chain of inherited pseudo element styles (from the immediate node parent up to the DOM tree root).
+	InheritedPseudoElements []InheritedPseudoElementMatches `json:"inheritedPseudoElements,omitempty"`
+	// A list of CSS keyframed animations matching this node.
+	CSSKeyframesRules []CSSKeyframesRule `json:"cssKeyframesRules,omitempty"`
+	// A list of CSS @position-try rules matching this node, based on the position-try-fallbacks property.
+	CSSPositionTryRules []CSSPositionTryRule `json:"cssPositionTryRules,omitempty"`
+	// Index of the active fallback in the applied position-try-fallback property,
+	// will not be set if there is no active position-try fallback.
+	ActivePositionFallbackIndex *int64 `json:"activePositionFallbackIndex,omitempty"`
+	// A list of CSS at-property rules matching this node.
+	CSSPropertyRules []CSSPropertyRule `json:"cssPropertyRules,omitempty"`
+	// A list of CSS property registrations matching this node.
+	CSSPropertyRegistrations []CSSPropertyRegistration `json:"cssPropertyRegistrations,omitempty"`
+	// A font-palette-values rule matching this node.
+	CSSFontPaletteValuesRule *CSSFontPaletteValuesRule `json:"cssFontPaletteValuesRule,omitempty"`
+	// Id of the first parent element that does not have display: contents.
+	//
+	// Experimental.
+	ParentLayoutNodeID *dom.NodeID `json:"parentLayoutNodeId,omitempty"`
+}
+
+// GetMatchedStylesForNode calls CSS.getMatchedStylesForNode.
+//
+// Returns requested styles for a DOM node identified by `nodeId`.
+func GetMatchedStylesForNode(ctx context.Context, c *internal.Client, sessionID string, params *GetMatchedStylesForNodeParams) (*GetMatchedStylesForNodeResult, error) {
+	if params == nil {
+		params = &GetMatchedStylesForNodeParams{}
+	}
+	var result GetMatchedStylesForNodeResult
+	err := c.Call(ctx, "CSS.getMatchedStylesForNode", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetMediaQueriesResult holds the result of CSS.getMediaQueries.
+type GetMediaQueriesResult struct {
+	Medias []CSSMedia `json:"medias"`
+}
+
+// GetMediaQueries calls CSS.getMediaQueries.
+//
+// Returns all media queries parsed by the rendering engine.
+func GetMediaQueries(ctx context.Context, c *internal.Client, sessionID string) (*GetMediaQueriesResult, error) {
+	var result GetMediaQueriesResult
+	err := c.Call(ctx, "CSS.getMediaQueries", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetPlatformFontsForNodeParams holds the parameters of CSS.getPlatformFontsForNode.
+type GetPlatformFontsForNodeParams struct {
+	NodeID dom.NodeID `json:"nodeId"`
+}
+
+// GetPlatformFontsForNodeResult holds the result of CSS.getPlatformFontsForNode.
+type GetPlatformFontsForNodeResult struct {
+	// Usage statistics for every employed platform font.
+	Fonts []PlatformFontUsage `json:"fonts"`
+}
+
+// GetPlatformFontsForNode calls CSS.getPlatformFontsForNode.
+//
+// Requests information about platform fonts which we used to render child TextNodes in the given
+// node.
+func GetPlatformFontsForNode(ctx context.Context, c *internal.Client, sessionID string, params *GetPlatformFontsForNodeParams) (*GetPlatformFontsForNodeResult, error) {
+	if params == nil {
+		params = &GetPlatformFontsForNodeParams{}
+	}
+	var result GetPlatformFontsForNodeResult
+	err := c.Call(ctx, "CSS.getPlatformFontsForNode", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetStyleSheetTextParams holds the parameters of CSS.getStyleSheetText.
+type GetStyleSheetTextParams struct {
+	StyleSheetID StyleSheetID `json:"styleSheetId"`
+}
+
+// GetStyleSheetTextResult holds the result of CSS.getStyleSheetText.
+type GetStyleSheetTextResult struct {
+	// The stylesheet text.
+	Text string `json:"text"`
+}
+
+// GetStyleSheetText calls CSS.getStyleSheetText.
+//
+// Returns the current textual content for a stylesheet.
+func GetStyleSheetText(ctx context.Context, c *internal.Client, sessionID string, params *GetStyleSheetTextParams) (*GetStyleSheetTextResult, error) {
+	if params == nil {
+		params = &GetStyleSheetTextParams{}
+	}
+	var result GetStyleSheetTextResult
+	err := c.Call(ctx, "CSS.getStyleSheetText", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetLayersForNodeParams holds the parameters of CSS.getLayersForNode.
+type GetLayersForNodeParams struct {
+	NodeID dom.NodeID `json:"nodeId"`
+}
+
+// GetLayersForNodeResult holds the result of CSS.getLayersForNode.
+type GetLayersForNodeResult struct {
+	RootLayer CSSLayerData `json:"rootLayer"`
+}
+
+// GetLayersForNode calls CSS.getLayersForNode.
+//
+// Returns all layers parsed by the rendering engine for the tree scope of a node.
+// Given a DOM element identified by nodeId, getLayersForNode returns the root
+// layer for the nearest ancestor document or shadow root. The layer root contains
+// the full layer tree for the tree scope and their ordering.
+//
+// Experimental.
+func GetLayersForNode(ctx context.Context, c *internal.Client, sessionID string, params *GetLayersForNodeParams) (*GetLayersForNodeResult, error) {
+	if params == nil {
+		params = &GetLayersForNodeParams{}
+	}
+	var result GetLayersForNodeResult
+	err := c.Call(ctx, "CSS.getLayersForNode", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetLocationForSelectorParams holds the parameters of CSS.getLocationForSelector.
+type GetLocationForSelectorParams struct {
+	StyleSheetID StyleSheetID `json:"styleSheetId"`
+	SelectorText string       `json:"selectorText"`
+}
+
+// GetLocationForSelectorResult holds the result of CSS.getLocationForSelector.
+type GetLocationForSelectorResult struct {
+	Ranges []SourceRange `json:"ranges"`
+}
+
+// GetLocationForSelector calls CSS.getLocationForSelector.
+//
+// Given a CSS selector text and a style sheet ID, getLocationForSelector
+// returns an array of locations of the CSS selector in the style sheet.
+//
+// Experimental.
+func GetLocationForSelector(ctx context.Context, c *internal.Client, sessionID string, params *GetLocationForSelectorParams) (*GetLocationForSelectorResult, error) {
+	if params == nil {
+		params = &GetLocationForSelectorParams{}
+	}
+	var result GetLocationForSelectorResult
+	err := c.Call(ctx, "CSS.getLocationForSelector", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// TrackComputedStyleUpdatesParams holds the parameters of CSS.trackComputedStyleUpdates.
+type TrackComputedStyleUpdatesParams struct {
+	PropertiesToTrack []CSSComputedStyleProperty `json:"propertiesToTrack"`
+}
+
+// TrackComputedStyleUpdates calls CSS.trackComputedStyleUpdates.
+//
+// Starts tracking the given computed styles for updates. The specified array of properties
+// replaces the one previously specified. Pass empty array to disable tracking.
+// Use takeComputedStyleUpdates to retrieve the list of nodes that had properties modified.
+// The changes to computed style properties are only tracked for nodes pushed to the front-end
+// by the DOM agent. If no changes to the tracked properties occur after the node has been pushed
+// to the front-end, no updates will be issued for the node.
+//
+// Experimental.
+func TrackComputedStyleUpdates(ctx context.Context, c *internal.Client, sessionID string, params *TrackComputedStyleUpdatesParams) error {
+	if params == nil {
+		params = &TrackComputedStyleUpdatesParams{}
+	}
+	return c.Call(ctx, "CSS.trackComputedStyleUpdates", params, sessionID, nil)
+}
+
+// TakeComputedStyleUpdatesResult holds the result of CSS.takeComputedStyleUpdates.
+type TakeComputedStyleUpdatesResult struct {
+	// The list of node Ids that have their tracked computed styles updated.
+	NodeIDs []dom.NodeID `json:"nodeIds"`
+}
+
+// TakeComputedStyleUpdates calls CSS.takeComputedStyleUpdates.
+//
+// Polls the next batch of computed style updates.
+//
+// Experimental.
+func TakeComputedStyleUpdates(ctx context.Context, c *internal.Client, sessionID string) (*TakeComputedStyleUpdatesResult, error) {
+	var result TakeComputedStyleUpdatesResult
+	err := c.Call(ctx, "CSS.takeComputedStyleUpdates", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SetEffectivePropertyValueForNodeParams holds the parameters of CSS.setEffectivePropertyValueForNode.
+type SetEffectivePropertyValueForNodeParams struct {
+	// The element id for which to set property.
+	NodeID       dom.NodeID `json:"nodeId"`
+	PropertyName string     `json:"propertyName"`
+	Value        string     `json:"value"`
+}
+
+// SetEffectivePropertyValueForNode calls CSS.setEffectivePropertyValueForNode.
+//
+// Find a rule with the given active property for the given node and set the new value for this
+// property
+func SetEffectivePropertyValueForNode(ctx context.Context, c *internal.Client, sessionID string, params *SetEffectivePropertyValueForNodeParams) error {
+	if params == nil {
+		params = &SetEffectivePropertyValueForNodeParams{}
+	}
+	return c.Call(ctx, "CSS.setEffectivePropertyValueForNode", params, sessionID, nil)
+}
+
+// SetPropertyRulePropertyNameParams holds the parameters of CSS.setPropertyRulePropertyName.
+type SetPropertyRulePropertyNameParams struct {
+	StyleSheetID StyleSheetID `json:"styleSheetId"`
+	Range        SourceRange  `json:"range"`
+	PropertyName string       `json:"propertyName"`
+}
+
+// SetPropertyRulePropertyNameResult holds the result of CSS.setPropertyRulePropertyName.
+type SetPropertyRulePropertyNameResult struct {
+	// The resulting key text after modification.
+	PropertyName Value `json:"propertyName"`
+}
+
+// SetPropertyRulePropertyName calls CSS.setPropertyRulePropertyName.
+//
+// Modifies the property rule property name.
+func SetPropertyRulePropertyName(ctx context.Context, c *internal.Client, sessionID string, params *SetPropertyRulePropertyNameParams) (*SetPropertyRulePropertyNameResult, error) {
+	if params == nil {
+		params = &SetPropertyRulePropertyNameParams{}
+	}
+	var result SetPropertyRulePropertyNameResult
+	err := c.Call(ctx, "CSS.setPropertyRulePropertyName", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SetKeyframeKeyParams holds the parameters of CSS.setKeyframeKey.
+type SetKeyframeKeyParams struct {
+	StyleSheetID StyleSheetID `json:"styleSheetId"`
+	Range        SourceRange  `json:"range"`
+	KeyText      string       `json:"keyText"`
+}
+
+// SetKeyframeKeyResult holds the result of CSS.setKeyframeKey.
+type SetKeyframeKeyResult struct {
+	// The resulting key text after modification.
+	KeyText Value `json:"keyText"`
+}
+
+// SetKeyframeKey calls CSS.setKeyframeKey.
+//
+// Modifies the keyframe rule key text.
+func SetKeyframeKey(ctx context.Context, c *internal.Client, sessionID string, params *SetKeyframeKeyParams) (*SetKeyframeKeyResult, error) {
+	if params == nil {
+		params = &SetKeyframeKeyParams{}
+	}
+	var result SetKeyframeKeyResult
+	err := c.Call(ctx, "CSS.setKeyframeKey", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SetMediaTextParams holds the parameters of CSS.setMediaText.
+type SetMediaTextParams struct {
+	StyleSheetID StyleSheetID `json:"styleSheetId"`
+	Range        SourceRange  `json:"range"`
+	Text         string       `json:"text"`
+}
+
+// SetMediaTextResult holds the result of CSS.setMediaText.
+type SetMediaTextResult struct {
+	// The resulting CSS media rule after modification.
+	Media CSSMedia `json:"media"`
+}
+
+// SetMediaText calls CSS.setMediaText.
+//
+// Modifies the rule selector.
+func SetMediaText(ctx context.Context, c *internal.Client, sessionID string, params *SetMediaTextParams) (*SetMediaTextResult, error) {
+	if params == nil {
+		params = &SetMediaTextParams{}
+	}
+	var result SetMediaTextResult
+	err := c.Call(ctx, "CSS.setMediaText", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SetContainerQueryTextParams holds the parameters of CSS.setContainerQueryText.
+type SetContainerQueryTextParams struct {
+	StyleSheetID StyleSheetID `json:"styleSheetId"`
+	Range        SourceRange  `json:"range"`
+	Text         string       `json:"text"`
+}
+
+// SetContainerQueryTextResult holds the result of CSS.setContainerQueryText.
+type SetContainerQueryTextResult struct {
+	// The resulting CSS container query rule after modification.
+	ContainerQuery CSSContainerQuery `json:"containerQuery"`
+}
+
+// SetContainerQueryText calls CSS.setContainerQueryText.
+//
+// Modifies the expression of a container query.
+//
+// Experimental.
+func SetContainerQueryText(ctx context.Context, c *internal.Client, sessionID string, params *SetContainerQueryTextParams) (*SetContainerQueryTextResult, error) {
+	if params == nil {
+		params = &SetContainerQueryTextParams{}
+	}
+	var result SetContainerQueryTextResult
+	err := c.Call(ctx, "CSS.setContainerQueryText", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SetSupportsTextParams holds the parameters of CSS.setSupportsText.
+type SetSupportsTextParams struct {
+	StyleSheetID StyleSheetID `json:"styleSheetId"`
+	Range        SourceRange  `json:"range"`
+	Text         string       `json:"text"`
+}
+
+// SetSupportsTextResult holds the result of CSS.setSupportsText.
+type SetSupportsTextResult struct {
+	// The resulting CSS Supports rule after modification.
+	Supports CSSSupports `json:"supports"`
+}
+
+// SetSupportsText calls CSS.setSupportsText.
+//
+// Modifies the expression of a supports at-rule.
+//
+// Experimental.
+func SetSupportsText(ctx context.Context, c *internal.Client, sessionID string, params *SetSupportsTextParams) (*SetSupportsTextResult, error) {
+	if params == nil {
+		params = &SetSupportsTextParams{}
+	}
+	var result SetSupportsTextResult
+	err := c.Call(ctx, "CSS.setSupportsText", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SetScopeTextParams holds the parameters of CSS.setScopeText.
+type SetScopeTextParams struct {
+	StyleSheetID StyleSheetID `json:"styleSheetId"`
+	Range        SourceRange  `json:"range"`
+	Text         string       `json:"text"`
+}
+
+// SetScopeTextResult holds the result of CSS.setScopeText.
+type SetScopeTextResult struct {
+	// The resulting CSS Scope rule after modification.
+	Scope CSSScope `json:"scope"`
+}
+
+// SetScopeText calls CSS.setScopeText.
+//
+// Modifies the expression of a scope at-rule.
+//
+// Experimental.
+func SetScopeText(ctx context.Context, c *internal.Client, sessionID string, params *SetScopeTextParams) (*SetScopeTextResult, error) {
+	if params == nil {
+		params = &SetScopeTextParams{}
+	}
+	var result SetScopeTextResult
+	err := c.Call(ctx, "CSS.setScopeText", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SetRuleSelectorParams holds the parameters of CSS.setRuleSelector.
+type SetRuleSelectorParams struct {
+	StyleSheetID StyleSheetID `json:"styleSheetId"`
+	Range        SourceRange  `json:"range"`
+	Selector     string       `json:"selector"`
+}
+
+// SetRuleSelectorResult holds the result of CSS.setRuleSelector.
+type SetRuleSelectorResult struct {
+	// The resulting selector list after modification.
+	SelectorList SelectorList `json:"selectorList"`
+}
+
+// SetRuleSelector calls CSS.setRuleSelector.
+//
+// Modifies the rule selector.
+func SetRuleSelector(ctx context.Context, c *internal.Client, sessionID string, params *SetRuleSelectorParams) (*SetRuleSelectorResult, error) {
+	if params == nil {
+		params = &SetRuleSelectorParams{}
+	}
+	var result SetRuleSelectorResult
+	err := c.Call(ctx, "CSS.setRuleSelector", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SetStyleSheetTextParams holds the parameters of CSS.setStyleSheetText.
+type SetStyleSheetTextParams struct {
+	StyleSheetID StyleSheetID `json:"styleSheetId"`
+	Text         string       `json:"text"`
+}
+
+// SetStyleSheetTextResult holds the result of CSS.setStyleSheetText.
+type SetStyleSheetTextResult struct {
+	// URL of source map associated with script (if any).
+	SourceMapURL string `json:"sourceMapURL,omitempty"`
+}
+
+// SetStyleSheetText calls CSS.setStyleSheetText.
+//
+// Sets the new stylesheet text.
+func SetStyleSheetText(ctx context.Context, c *internal.Client, sessionID string, params *SetStyleSheetTextParams) (*SetStyleSheetTextResult, error) {
+	if params == nil {
+		params = &SetStyleSheetTextParams{}
+	}
+	var result SetStyleSheetTextResult
+	err := c.Call(ctx, "CSS.setStyleSheetText", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SetStyleTextsParams holds the parameters of CSS.setStyleTexts.
+type SetStyleTextsParams struct {
+	Edits []StyleDeclarationEdit `json:"edits"`
+	// NodeId for the DOM node in whose context custom property declarations for registered properties should be
+	// validated. If omitted, declarations in the new rule text can only be validated statically, which may produce
+	// incorrect results if the declaration contains a var() for example.
+	//
+	// Experimental.
+	NodeForPropertySyntaxValidation *dom.NodeID `json:"nodeForPropertySyntaxValidation,omitempty"`
+}
+
+// SetStyleTextsResult holds the result of CSS.setStyleTexts.
+type SetStyleTextsResult struct {
+	// The resulting styles after modification.
+	Styles []CSSStyle `json:"styles"`
+}
+
+// SetStyleTexts calls CSS.setStyleTexts.
+//
+// Applies specified style edits one after another in the given order.
+func SetStyleTexts(ctx context.Context, c *internal.Client, sessionID string, params *SetStyleTextsParams) (*SetStyleTextsResult, error) {
+	if params == nil {
+		params = &SetStyleTextsParams{}
+	}
+	var result SetStyleTextsResult
+	err := c.Call(ctx, "CSS.setStyleTexts", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// StartRuleUsageTracking calls CSS.startRuleUsageTracking.
+//
+// Enables the selector recording.
+func StartRuleUsageTracking(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "CSS.startRuleUsageTracking", nil, sessionID, nil)
+}
+
+// StopRuleUsageTrackingResult holds the result of CSS.stopRuleUsageTracking.
+type StopRuleUsageTrackingResult struct {
+	RuleUsage []RuleUsage `json:"ruleUsage"`
+}
+
+// StopRuleUsageTracking calls CSS.stopRuleUsageTracking.
+//
+// Stop tracking rule usage and return the list of rules that were used since last call to
+// `takeCoverageDelta` (or since start of coverage instrumentation).
+func StopRuleUsageTracking(ctx context.Context, c *internal.Client, sessionID string) (*StopRuleUsageTrackingResult, error) {
+	var result StopRuleUsageTrackingResult
+	err := c.Call(ctx, "CSS.stopRuleUsageTracking", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// TakeCoverageDeltaResult holds the result of CSS.takeCoverageDelta.
+type TakeCoverageDeltaResult struct {
+	Coverage []RuleUsage `json:"coverage"`
+	// Monotonically increasing time, in seconds.
+	Timestamp float64 `json:"timestamp"`
+}
+
+// TakeCoverageDelta calls CSS.takeCoverageDelta.
+//
+// Obtain list of rules that became used since last call to this method (or since start of coverage
+// instrumentation).
+func TakeCoverageDelta(ctx context.Context, c *internal.Client, sessionID string) (*TakeCoverageDeltaResult, error) {
+	var result TakeCoverageDeltaResult
+	err := c.Call(ctx, "CSS.takeCoverageDelta", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SetLocalFontsEnabledParams holds the parameters of CSS.setLocalFontsEnabled.
+type SetLocalFontsEnabledParams struct {
+	// Whether rendering of local fonts is enabled.
+	Enabled bool `json:"enabled"`
+}
+
+// SetLocalFontsEnabled calls CSS.setLocalFontsEnabled.
+//
+// Enables/disables rendering of local CSS fonts (enabled by default).
+//
+// Experimental.
+func SetLocalFontsEnabled(ctx context.Context, c *internal.Client, sessionID string, params *SetLocalFontsEnabledParams) error {
+	if params == nil {
+		params = &SetLocalFontsEnabledParams{}
+	}
+	return c.Call(ctx, "CSS.setLocalFontsEnabled", params, sessionID, nil)
+}
+
+// EventFontsUpdated is the method name of the CSS.fontsUpdated event.
+const EventFontsUpdated = "CSS.fontsUpdated"
+
+// FontsUpdatedEvent is the payload of the CSS.fontsUpdated event.
+//
+// Fires whenever a web font is updated.  A non-empty font parameter indicates a successfully loaded
+// web font.
+type FontsUpdatedEvent struct {
+	// The web font that has loaded.
+	Font *FontFace `json:"font,omitempty"`
+}
+
+// EventMediaQueryResultChanged is the method name of the CSS.mediaQueryResultChanged event.
+const EventMediaQueryResultChanged = "CSS.mediaQueryResultChanged"
+
+// MediaQueryResultChangedEvent is the payload of the CSS.mediaQueryResultChanged event.
+//
+// Fires whenever a MediaQuery result changes (for example, after a browser window has been
+// resized.) The current implementation considers only viewport-dependent media features.
+type MediaQueryResultChangedEvent struct{}
+
+// EventStyleSheetAdded is the method name of the CSS.styleSheetAdded event.
+const EventStyleSheetAdded = "CSS.styleSheetAdded"
+
+// StyleSheetAddedEvent is the payload of the CSS.styleSheetAdded event.
+//
+// Fired whenever an active document stylesheet is added.
+type StyleSheetAddedEvent struct {
+	// Added stylesheet metainfo.
+	Header CSSStyleSheetHeader `json:"header"`
+}
+
+// EventStyleSheetChanged is the method name of the CSS.styleSheetChanged event.
+const EventStyleSheetChanged = "CSS.styleSheetChanged"
+
+// StyleSheetChangedEvent is the payload of the CSS.styleSheetChanged event.
+//
+// Fired whenever a stylesheet is changed as a result of the client operation.
+type StyleSheetChangedEvent struct {
+	StyleSheetID StyleSheetID `json:"styleSheetId"`
+}
+
+// EventStyleSheetRemoved is the method name of the CSS.styleSheetRemoved event.
+const EventStyleSheetRemoved = "CSS.styleSheetRemoved"
+
+// StyleSheetRemovedEvent is the payload of the CSS.styleSheetRemoved event.
+//
+// Fired whenever an active document stylesheet is removed.
+type StyleSheetRemovedEvent struct {
+	// Identifier of the removed stylesheet.
+	StyleSheetID StyleSheetID `json:"styleSheetId"`
+}
+
+// ParseEvent decodes a CSS event message into its typed event struct.
+func ParseEvent(msg *internal.CDPMessage) (any, error) {
+	var v any
+	switch msg.Method {
+	case EventFontsUpdated:
+		v = &FontsUpdatedEvent{}
+	case EventMediaQueryResultChanged:
+		v = &MediaQueryResultChangedEvent{}
+	case EventStyleSheetAdded:
+		v = &StyleSheetAddedEvent{}
+	case EventStyleSheetChanged:
+		v = &StyleSheetChangedEvent{}
+	case EventStyleSheetRemoved:
+		v = &StyleSheetRemovedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown CSS event: %s", msg.Method)
+	}
+	if msg.Params == nil {
+		return v, nil
+	}
+	err := json.Unmarshal(msg.Params, v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,130 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package database provides typed bindings for the CDP Database domain.
+package database
+
+import (
+	"cdp/internal"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// DatabaseID is the Database.DatabaseId type.
+//
+// Unique identifier of Database object.
+type DatabaseID string
+
+// Database is the Database.Database type.
+//
+// Database object.
+type Database struct {
+	// Database ID.
+	ID DatabaseID `json:"id"`
+	// Database domain.
+	Domain string `json:"domain"`
+	// Database name.
+	Name string `json:"name"`
+	// Database version.
+	Version string `json:"version"`
+}
+
+// Error is the Database.Error type.
+//
+// Database error.
+type Error struct {
+	// Error message.
+	Message string `json:"message"`
+	// Error code.
+	Code int64 `json:"code"`
+}
+
+// Disable calls Database.disable.
+//
+// Disables database tracking, prevents database events from being sent to the client.
+func Disable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Database.disable", nil, sessionID, nil)
+}
+
+// Enable calls Database.enable.
+//
+// Enables database tracking, database events will now be delivered to the client.
+func Enable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Database.enable", nil, sessionID, nil)
+}
+
+// ExecuteSQLParams holds the parameters of Database.executeSQL.
+type ExecuteSQLParams struct {
+	DatabaseID DatabaseID `json:"databaseId"`
+	Query      string     `json:"query"`
+}
+
+// ExecuteSQLResult holds the result of Database.executeSQL.
+type ExecuteSQLResult struct {
+	ColumnNames []string          `json:"columnNames,omitempty"`
+	Values      []json.RawMessage `json:"values,omitempty"`
+	SqlError    *Error            `json:"sqlError,omitempty"`
+}
+
+// ExecuteSQL calls Database.executeSQL.
+func ExecuteSQL(ctx context.Context, c *internal.Client, sessionID string, params *ExecuteSQLParams) (*ExecuteSQLResult, error) {
+	if params == nil {
+		params = &ExecuteSQLParams{}
+	}
+	var result ExecuteSQLResult
+	err := c.Call(ctx, "Database.executeSQL", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetDatabaseTableNamesParams holds the parameters of Database.getDatabaseTableNames.
+type GetDatabaseTableNamesParams struct {
+	DatabaseID DatabaseID `json:"databaseId"`
+}
+
+// GetDatabaseTableNamesResult holds the result of Database.getDatabaseTableNames.
+type GetDatabaseTableNamesResult struct {
+	TableNames []string `json:"tableNames"`
+}
+
+// GetDatabaseTableNames calls Database.getDatabaseTableNames.
+func GetDatabaseTableNames(ctx context.Context, c *internal.Client, sessionID string, params *GetDatabaseTableNamesParams) (*GetDatabaseTableNamesResult, error) {
+	if params == nil {
+		params = &GetDatabaseTableNamesParams{}
+	}
+	var result GetDatabaseTableNamesResult
+	err := c.Call(ctx, "Database.getDatabaseTableNames", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// EventAddDatabase is the method name of the Database.addDatabase event.
+const EventAddDatabase = "Database.addDatabase"
+
+// AddDatabaseEvent is the payload of the Database.addDatabase event.
+type AddDatabaseEvent struct {
+	Database Database `json:"database"`
+}
+
+// ParseEvent decodes a Database event message into its typed event struct.
+func ParseEvent(msg *internal.CDPMessage) (any, error) {
+	var v any
+	switch msg.Method {
+	case EventAddDatabase:
+		v = &AddDatabaseEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Database event: %s", msg.Method)
+	}
+	if msg.Params == nil {
+		return v, nil
+	}
+	err := json.Unmarshal(msg.Params, v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,1187 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package debugger provides typed bindings for the CDP Debugger domain.
+//
+// Debugger domain exposes JavaScript debugging capabilities. It allows setting and removing
+// breakpoints, stepping through execution, exploring stack traces, etc.
+package debugger
+
+import (
+	"cdp/internal"
+	"cdp/protocol/runtime"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// BreakpointID is the Debugger.BreakpointId type.
+//
+// Breakpoint identifier.
+type BreakpointID string
+
+// CallFrameID is the Debugger.CallFrameId type.
+//
+// Call frame identifier.
+type CallFrameID string
+
+// Location is the Debugger.Location type.
+//
+// Location in the source code.
+type Location struct {
+	// Script identifier as reported in the `Debugger.scriptParsed`.
+	ScriptID runtime.ScriptID `json:"scriptId"`
+	// Line number in the script (0-based).
+	LineNumber int64 `json:"lineNumber"`
+	// Column number in the script (0-based).
+	ColumnNumber *int64 `json:"columnNumber,omitempty"`
+}
+
+// ScriptPosition is the Debugger.ScriptPosition type.
+//
+// Location in the source code.
+//
+// Experimental.
+type ScriptPosition struct {
+	LineNumber   int64 `json:"lineNumber"`
+	ColumnNumber int64 `json:"columnNumber"`
+}
+
+// LocationRange is the Debugger.LocationRange type.
+//
+// Location range within one script.
+//
+// Experimental.
+type LocationRange struct {
+	ScriptID runtime.ScriptID `json:"scriptId"`
+	Start    ScriptPosition   `json:"start"`
+	End      ScriptPosition   `json:"end"`
+}
+
+// CallFrame is the Debugger.CallFrame type.
+//
+// JavaScript call frame. Array of call frames form the call stack.
+type CallFrame struct {
+	// Call frame identifier. This identifier is only valid while the virtual machine is paused.
+	CallFrameID CallFrameID `json:"callFrameId"`
+	// Name of the JavaScript function called on this call frame.
+	FunctionName string `json:"functionName"`
+	// Location in the source code.
+	FunctionLocation *Location `json:"functionLocation,omitempty"`
+	// Location in the source code.
+	Location Location `json:"location"`
+	// JavaScript script name or url.
+	// Deprecated in favor of using the `location.scriptId` to resolve the URL via a previously
+	// sent `Debugger.scriptParsed` event.
+	//
+	// Deprecated: this item is deprecated in the protocol.
+	URL string `json:"url"`
+	// Scope chain for this call frame.
+	ScopeChain []Scope `json:"scopeChain"`
+	// `this` object for this call frame.
+	This runtime.RemoteObject `json:"this"`
+	// The value being returned, if the function is at return point.
+	ReturnValue *runtime.RemoteObject `json:"returnValue,omitempty"`
+	// Valid only while the VM is paused and indicates whether this frame
+	// can be restarted or not. Note that a `true` value here does not
+	// guarantee that Debugger#restartFrame with this CallFrameId will be
+	// successful, but it is very likely.
+	//
+	// Experimental.
+	CanBeRestarted *bool `json:"canBeRestarted,omitempty"`
+}
+
+// Scope is the Debugger.Scope type.
+//
+// Scope description.
+type Scope struct {
+	// Scope type.
+	Type string `json:"type"`
+	// Object representing the scope. For `global` and `with` scopes it represents the actual
+	// object; for the rest of the scopes, it is artificial transient object enumerating scope
+	// variables as its properties.
+	Object runtime.RemoteObject `json:"object"`
+	Name   string               `json:"name,omitempty"`
+	// Location in the source code where scope starts
+	StartLocation *Location `json:"startLocation,omitempty"`
+	// Location in the source code where scope ends
+	EndLocation *Location `json:"endLocation,omitempty"`
+}
+
+// SearchMatch is the Debugger.SearchMatch type.
+//
+// Search match for resource.
+type SearchMatch struct {
+	// Line number in resource content.
+	LineNumber float64 `json:"lineNumber"`
+	// Line with match content.
+	LineContent string `json:"lineContent"`
+}
+
+// BreakLocation is the Debugger.BreakLocation type.
+type BreakLocation struct {
+	// Script identifier as reported in the `Debugger.scriptParsed`.
+	ScriptID runtime.ScriptID `json:"scriptId"`
+	// Line number in the script (0-based).
+	LineNumber int64 `json:"lineNumber"`
+	// Column number in the script (0-based).
+	ColumnNumber *int64 `json:"columnNumber,omitempty"`
+	Type         string `json:"type,omitempty"`
+}
+
+// WasmDisassemblyChunk is the Debugger.WasmDisassemblyChunk type.
+//
+// Experimental.
+type WasmDisassemblyChunk struct {
+	// The next chunk of disassembled lines.
+	Lines []string `json:"lines"`
+	// The bytecode offsets describing the start of each line.
+	BytecodeOffsets []int64 `json:"bytecodeOffsets"`
+}
+
+// ScriptLanguage is the Debugger.ScriptLanguage type.
+//
+// Enum of possible script languages.
+type ScriptLanguage string
+
+const (
+	ScriptLanguageJavaScript  ScriptLanguage = "JavaScript"
+	ScriptLanguageWebAssembly ScriptLanguage = "WebAssembly"
+)
+
+// DebugSymbols is the Debugger.DebugSymbols type.
+//
+// Debug symbols available for a wasm script.
+type DebugSymbols struct {
+	// Type of the debug symbols.
+	Type string `json:"type"`
+	// URL of the external symbol source.
+	ExternalURL string `json:"externalURL,omitempty"`
+}
+
+// ContinueToLocationParams holds the parameters of Debugger.continueToLocation.
+type ContinueToLocationParams struct {
+	// Location to continue to.
+	Location         Location `json:"location"`
+	TargetCallFrames string   `json:"targetCallFrames,omitempty"`
+}
+
+// ContinueToLocation calls Debugger.continueToLocation.
+//
+// Continues execution until specific location is reached.
+func ContinueToLocation(ctx context.Context, c *internal.Client, sessionID string, params *ContinueToLocationParams) error {
+	if params == nil {
+		params = &ContinueToLocationParams{}
+	}
+	return c.Call(ctx, "Debugger.continueToLocation", params, sessionID, nil)
+}
+
+// Disable calls Debugger.disable.
+//
+// Disables debugger for given page.
+func Disable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Debugger.disable", nil, sessionID, nil)
+}
+
+// EnableParams holds the parameters of Debugger.enable.
+type EnableParams struct {
+	// The maximum size in bytes of collected scripts (not referenced by other heap objects)
+	// the debugger can hold. Puts no limit if parameter is omitted.
+	//
+	// Experimental.
+	MaxScriptsCacheSize *float64 `json:"maxScriptsCacheSize,omitempty"`
+}
+
+// EnableResult holds the result of Debugger.enable.
+type EnableResult struct {
+	// Unique identifier of the debugger.
+	//
+	// Experimental.
+	DebuggerID runtime.UniqueDebuggerID `json:"debuggerId"`
+}
+
+// Enable calls Debugger.enable.
+//
+// Enables debugger for the given page. Clients should not assume that the debugging has been
+// enabled until the result for this command is received.
+func Enable(ctx context.Context, c *internal.Client, sessionID string, params *EnableParams) (*EnableResult, error) {
+	if params == nil {
+		params = &EnableParams{}
+	}
+	var result EnableResult
+	err := c.Call(ctx, "Debugger.enable", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// EvaluateOnCallFrameParams holds the parameters of Debugger.evaluateOnCallFrame.
+type EvaluateOnCallFrameParams struct {
+	// Call frame identifier to evaluate on.
+	CallFrameID CallFrameID `json:"callFrameId"`
+	// Expression to evaluate.
+	Expression string `json:"expression"`
+	// String object group name to put result into (allows rapid releasing resulting object handles
+	// using `releaseObjectGroup`).
+	ObjectGroup string `json:"objectGroup,omitempty"`
+	// Specifies whether command line API should be available to the evaluated expression, defaults
+	// to false.
+	IncludeCommandLineAPI *bool `json:"includeCommandLineAPI,omitempty"`
+	// In silent mode exceptions thrown during evaluation are not reported and do not pause
+	// execution. Overrides `setPauseOnException` state.
+	Silent *bool `json:"silent,omitempty"`
+	// Whether the result is expected to be a JSON object that should be sent by value.
+	ReturnByValue *bool `json:"returnByValue,omitempty"`
+	// Whether preview should be generated for the result.
+	//
+	// Experimental.
+	GeneratePreview *bool `json:"generatePreview,omitempty"`
+	// Whether to throw an exception if side effect cannot be ruled out during evaluation.
+	ThrowOnSideEffect *bool `json:"throwOnSideEffect,omitempty"`
+	// Terminate execution after timing out (number of milliseconds).
+	//
+	// Experimental.
+	Timeout *runtime.TimeDelta `json:"timeout,omitempty"`
+}
+
+// EvaluateOnCallFrameResult holds the result of Debugger.evaluateOnCallFrame.
+type EvaluateOnCallFrameResult struct {
+	// Object wrapper for the evaluation result.
+	Result runtime.RemoteObject `json:"result"`
+	// Exception details.
+	ExceptionDetails *runtime.ExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// EvaluateOnCallFrame calls Debugger.evaluateOnCallFrame.
+//
+// Evaluates expression on a given call frame.
+func EvaluateOnCallFrame(ctx context.Context, c *internal.Client, sessionID string, params *EvaluateOnCallFrameParams) (*EvaluateOnCallFrameResult, error) {
+	if params == nil {
+		params = &EvaluateOnCallFrameParams{}
+	}
+	var result EvaluateOnCallFrameResult
+	err := c.Call(ctx, "Debugger.evaluateOnCallFrame", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetPossibleBreakpointsParams holds the parameters of Debugger.getPossibleBreakpoints.
+type GetPossibleBreakpointsParams struct {
+	// Start of range to search possible breakpoint locations in.
+	Start Location `json:"start"`
+	// End of range to search possible breakpoint locations in (excluding). When not specified, end
+	// of scripts is used as end of range.
+	End *Location `json:"end,omitempty"`
+	// Only consider locations which are in the same (non-nested) function as start.
+	RestrictToFunction *bool `json:"restrictToFunction,omitempty"`
+}
+
+// GetPossibleBreakpointsResult holds the result of Debugger.getPossibleBreakpoints.
+type GetPossibleBreakpointsResult struct {
+	// List of the possible breakpoint locations.
+	Locations []BreakLocation `json:"locations"`
+}
+
+// GetPossibleBreakpoints calls Debugger.getPossibleBreakpoints.
+//
+// Returns possible locations for breakpoint. scriptId in start and end range locations should be
+// the same.
+func GetPossibleBreakpoints(ctx context.Context, c *internal.Client, sessionID string, params *GetPossibleBreakpointsParams) (*GetPossibleBreakpointsResult, error) {
+	if params == nil {
+		params = &GetPossibleBreakpointsParams{}
+	}
+	var result GetPossibleBreakpointsResult
+	err := c.Call(ctx, "Debugger.getPossibleBreakpoints", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetScriptSourceParams holds the parameters of Debugger.getScriptSource.
+type GetScriptSourceParams struct {
+	// Id of the script to get source for.
+	ScriptID runtime.ScriptID `json:"scriptId"`
+}
+
+// GetScriptSourceResult holds the result of Debugger.getScriptSource.
+type GetScriptSourceResult struct {
+	// Script source (empty in case of Wasm bytecode).
+	ScriptSource string `json:"scriptSource"`
+	// Wasm bytecode. (Encoded as a base64 string when passed over JSON)
+	Bytecode string `json:"bytecode,omitempty"`
+}
+
+// GetScriptSource calls Debugger.getScriptSource.
+//
+// Returns source for the script with given id.
+func GetScriptSource(ctx context.Context, c *internal.Client, sessionID string, params *GetScriptSourceParams) (*GetScriptSourceResult, error) {
+	if params == nil {
+		params = &GetScriptSourceParams{}
+	}
+	var result GetScriptSourceResult
+	err := c.Call(ctx, "Debugger.getScriptSource", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DisassembleWasmModuleParams holds the parameters of Debugger.disassembleWasmModule.
+type DisassembleWasmModuleParams struct {
+	// Id of the script to disassemble
+	ScriptID runtime.ScriptID `json:"scriptId"`
+}
+
+// DisassembleWasmModuleResult holds the result of Debugger.disassembleWasmModule.
+type DisassembleWasmModuleResult struct {
+	// For large modules, return a stream from which additional chunks of
+	// disassembly can be read successively.
+	StreamID string `json:"streamId,omitempty"`
+	// The total number of lines in the disassembly text.
+	TotalNumberOfLines int64 `json:"totalNumberOfLines"`
+	// The offsets of all function bodies, in the format [start1, end1,
+	// start2, end2, ...] where all ends are exclusive.
+	FunctionBodyOffsets []int64 `json:"functionBodyOffsets"`
+	// The first chunk of disassembly.
+	Chunk WasmDisassemblyChunk `json:"chunk"`
+}
+
+// DisassembleWasmModule calls Debugger.disassembleWasmModule.
+//
+// Experimental.
+func DisassembleWasmModule(ctx context.Context, c *internal.Client, sessionID string, params *DisassembleWasmModuleParams) (*DisassembleWasmModuleResult, error) {
+	if params == nil {
+		params = &DisassembleWasmModuleParams{}
+	}
+	var result DisassembleWasmModuleResult
+	err := c.Call(ctx, "Debugger.disassembleWasmModule", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// NextWasmDisassemblyChunkParams holds the parameters of Debugger.nextWasmDisassemblyChunk.
+type NextWasmDisassemblyChunkParams struct {
+	StreamID string `json:"streamId"`
+}
+
+// NextWasmDisassemblyChunkResult holds the result of Debugger.nextWasmDisassemblyChunk.
+type NextWasmDisassemblyChunkResult struct {
+	// The next chunk of disassembly.
+	Chunk WasmDisassemblyChunk `json:"chunk"`
+}
+
+// NextWasmDisassemblyChunk calls Debugger.nextWasmDisassemblyChunk.
+//
+// Disassemble the next chunk of lines for the module corresponding to the
+// stream. If disassembly is complete, this API will invalidate the streamId
+// and return an empty chunk. Any subsequent calls for the now invalid stream
+// will return errors.
+//
+// Experimental.
+func NextWasmDisassemblyChunk(ctx context.Context, c *internal.Client, sessionID string, params *NextWasmDisassemblyChunkParams) (*NextWasmDisassemblyChunkResult, error) {
+	if params == nil {
+		params = &NextWasmDisassemblyChunkParams{}
+	}
+	var result NextWasmDisassemblyChunkResult
+	err := c.Call(ctx, "Debugger.nextWasmDisassemblyChunk", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetWasmBytecodeParams holds the parameters of Debugger.getWasmBytecode.
+type GetWasmBytecodeParams struct {
+	// Id of the Wasm script to get source for.
+	ScriptID runtime.ScriptID `json:"scriptId"`
+}
+
+// GetWasmBytecodeResult holds the result of Debugger.getWasmBytecode.
+type GetWasmBytecodeResult struct {
+	// Script source. (Encoded as a base64 string when passed over JSON)
+	Bytecode string `json:"bytecode"`
+}
+
+// GetWasmBytecode calls Debugger.getWasmBytecode.
+//
+// This command is deprecated. Use getScriptSource instead.
+//
+// Deprecated: this item is deprecated in the protocol.
+func GetWasmBytecode(ctx context.Context, c *internal.Client, sessionID string, params *GetWasmBytecodeParams) (*GetWasmBytecodeResult, error) {
+	if params == nil {
+		params = &GetWasmBytecodeParams{}
+	}
+	var result GetWasmBytecodeResult
+	err := c.Call(ctx, "Debugger.getWasmBytecode", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetStackTraceParams holds the parameters of Debugger.getStackTrace.
+type GetStackTraceParams struct {
+	StackTraceID runtime.StackTraceID `json:"stackTraceId"`
+}
+
+// GetStackTraceResult holds the result of Debugger.getStackTrace.
+type GetStackTraceResult struct {
+	StackTrace runtime.StackTrace `json:"stackTrace"`
+}
+
+// GetStackTrace calls Debugger.getStackTrace.
+//
+// Returns stack trace with given `stackTraceId`.
+//
+// Experimental.
+func GetStackTrace(ctx context.Context, c *internal.Client, sessionID string, params *GetStackTraceParams) (*GetStackTraceResult, error) {
+	if params == nil {
+		params = &GetStackTraceParams{}
+	}
+	var result GetStackTraceResult
+	err := c.Call(ctx, "Debugger.getStackTrace", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Pause calls Debugger.pause.
+//
+// Stops on the next JavaScript statement.
+func Pause(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Debugger.pause", nil, sessionID, nil)
+}
+
+// PauseOnAsyncCallParams holds the parameters of Debugger.pauseOnAsyncCall.
+type PauseOnAsyncCallParams struct {
+	// Debugger will pause when async call with given stack trace is started.
+	ParentStackTraceID runtime.StackTraceID `json:"parentStackTraceId"`
+}
+
+// PauseOnAsyncCall calls Debugger.pauseOnAsyncCall.
+//
+// Experimental.
+//
+// Deprecated: this item is deprecated in the protocol.
+func PauseOnAsyncCall(ctx context.Context, c *internal.Client, sessionID string, params *PauseOnAsyncCallParams) error {
+	if params == nil {
+		params = &PauseOnAsyncCallParams{}
+	}
+	return c.Call(ctx, "Debugger.pauseOnAsyncCall", params, sessionID, nil)
+}
+
+// RemoveBreakpointParams holds the parameters of Debugger.removeBreakpoint.
+type RemoveBreakpointParams struct {
+	BreakpointID BreakpointID `json:"breakpointId"`
+}
+
+// RemoveBreakpoint calls Debugger.removeBreakpoint.
+//
+// Removes JavaScript breakpoint.
+func RemoveBreakpoint(ctx context.Context, c *internal.Client, sessionID string, params *RemoveBreakpointParams) error {
+	if params == nil {
+		params = &RemoveBreakpointParams{}
+	}
+	return c.Call(ctx, "Debugger.removeBreakpoint", params, sessionID, nil)
+}
+
+// RestartFrameParams holds the parameters of Debugger.restartFrame.
+type RestartFrameParams struct {
+	// Call frame identifier to evaluate on.
+	CallFrameID CallFrameID `json:"callFrameId"`
+	// The `mode` parameter must be present and set to 'StepInto', otherwise
+	// `restartFrame` will error out.
+	//
+	// Experimental.
+	Mode string `json:"mode,omitempty"`
+}
+
+// RestartFrameResult holds the result of Debugger.restartFrame.
+type RestartFrameResult struct {
+	// New stack trace.
+	//
+	// Deprecated: this item is deprecated in the protocol.
+	CallFrames []CallFrame `json:"callFrames"`
+	// Async stack trace, if any.
+	//
+	// Deprecated: this item is deprecated in the protocol.
+	AsyncStackTrace *runtime.StackTrace `json:"asyncStackTrace,omitempty"`
+	// Async stack trace, if any.
+	//
+	// Deprecated: this item is deprecated in the protocol.
+	AsyncStackTraceID *runtime.StackTraceID `json:"asyncStackTraceId,omitempty"`
+}
+
+// RestartFrame calls Debugger.restartFrame.
+//
+// Restarts particular call frame from the beginning. The old, deprecated
+// behavior of `restartFrame` is to stay paused and allow further CDP commands
+// after a restart was scheduled. This can cause problems with restarting, so
+// we now continue execution immediatly after it has been scheduled until we
+// reach the beginning of the restarted frame.
+//
+// To stay back-wards compatible, `restartFrame` now expects a `mode`
+// parameter to be present. If the `mode` parameter is missing, `restartFrame`
+// errors out.
+//
+// The various return values are deprecated and `callFrames` is always empty.
+// Use the call frames from the `Debugger#paused` events instead, that fires
+// once V8 pauses at the beginning of the restarted function.
+func RestartFrame(ctx context.Context, c *internal.Client, sessionID string, params *RestartFrameParams) (*RestartFrameResult, error) {
+	if params == nil {
+		params = &RestartFrameParams{}
+	}
+	var result RestartFrameResult
+	err := c.Call(ctx, "Debugger.restartFrame", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ResumeParams holds the parameters of Debugger.resume.
+type ResumeParams struct {
+	// Set to true to terminate execution upon resuming execution. In contrast
+	// to Runtime.terminateExecution, this will allows to execute further
+	// JavaScript (i.e. via evaluation) until execution of the paused code
+	// is actually resumed, at which point termination is triggered.
+	// If execution is currently not paused, this parameter has no effect.
+	TerminateOnResume *bool `json:"terminateOnResume,omitempty"`
+}
+
+// Resume calls Debugger.resume.
+//
+// Resumes JavaScript execution.
+func Resume(ctx context.Context, c *internal.Client, sessionID string, params *ResumeParams) error {
+	if params == nil {
+		params = &ResumeParams{}
+	}
+	return c.Call(ctx, "Debugger.resume", params, sessionID, nil)
+}
+
+// SearchInContentParams holds the parameters of Debugger.searchInContent.
+type SearchInContentParams struct {
+	// Id of the script to search in.
+	ScriptID runtime.ScriptID `json:"scriptId"`
+	// String to search for.
+	Query string `json:"query"`
+	// If true, search is case sensitive.
+	CaseSensitive *bool `json:"caseSensitive,omitempty"`
+	// If true, treats string parameter as regex.
+	IsRegex *bool `json:"isRegex,omitempty"`
+}
+
+// SearchInContentResult holds the result of Debugger.searchInContent.
+type SearchInContentResult struct {
+	// List of search matches.
+	Result []SearchMatch `json:"result"`
+}
+
+// SearchInContent calls Debugger.searchInContent.
+//
+// Searches for given string in script content.
+func SearchInContent(ctx context.Context, c *internal.Client, sessionID string, params *SearchInContentParams) (*SearchInContentResult, error) {
+	if params == nil {
+		params = &SearchInContentParams{}
+	}
+	var result SearchInContentResult
+	err := c.Call(ctx, "Debugger.searchInContent", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SetAsyncCallStackDepthParams holds the parameters of Debugger.setAsyncCallStackDepth.
+type SetAsyncCallStackDepthParams struct {
+	// Maximum depth of async call stacks. Setting to `0` will effectively disable collecting async
+	// call stacks (default).
+	MaxDepth int64 `json:"maxDepth"`
+}
+
+// SetAsyncCallStackDepth calls Debugger.setAsyncCallStackDepth.
+//
+// Enables or disables async call stacks tracking.
+func SetAsyncCallStackDepth(ctx context.Context, c *internal.Client, sessionID string, params *SetAsyncCallStackDepthParams) error {
+	if params == nil {
+		params = &SetAsyncCallStackDepthParams{}
+	}
+	return c.Call(ctx, "Debugger.setAsyncCallStackDepth", params, sessionID, nil)
+}
+
+// SetBlackboxPatternsParams holds the parameters of Debugger.setBlackboxPatterns.
+type SetBlackboxPatternsParams struct {
+	// Array of regexps that will be used to check script url for blackbox state.
+	Patterns []string `json:"patterns"`
+}
+
+// SetBlackboxPatterns calls Debugger.setBlackboxPatterns.
+//
+// Replace previous blackbox patterns with passed ones. Forces backend to skip stepping/pausing in
+// scripts with url matching one of the patterns. VM will try to leave blackboxed script by
+// performing 'step in' several times, finally resorting to 'step out' if unsuccessful.
+//
+// Experimental.
+func SetBlackboxPatterns(ctx context.Context, c *internal.Client, sessionID string, params *SetBlackboxPatternsParams) error {
+	if params == nil {
+		params = &SetBlackboxPatternsParams{}
+	}
+	return c.Call(ctx, "Debugger.setBlackboxPatterns", params, sessionID, nil)
+}
+
+// SetBlackboxedRangesParams holds the parameters of Debugger.setBlackboxedRanges.
+type SetBlackboxedRangesParams struct {
+	// Id of the script.
+	ScriptID  runtime.ScriptID `json:"scriptId"`
+	Positions []ScriptPosition `json:"positions"`
+}
+
+// SetBlackboxedRanges calls Debugger.setBlackboxedRanges.
+//
+// Makes backend skip steps in the script in blackboxed ranges. VM will try leave blacklisted
+// scripts by performing 'step in' several times, finally resorting to 'step out' if unsuccessful.
+// Positions array contains positions where blackbox state is changed. First interval isn't
+// blackboxed. Array should be sorted.
+//
+// Experimental.
+func SetBlackboxedRanges(ctx context.Context, c *internal.Client, sessionID string, params *SetBlackboxedRangesParams) error {
+	if params == nil {
+		params = &SetBlackboxedRangesParams{}
+	}
+	return c.Call(ctx, "Debugger.setBlackboxedRanges", params, sessionID, nil)
+}
+
+// SetBreakpointParams holds the parameters of Debugger.setBreakpoint.
+type SetBreakpointParams struct {
+	// Location to set breakpoint in.
+	Location Location `json:"location"`
+	// Expression to use as a breakpoint condition. When specified, debugger will only stop on the
+	// breakpoint if this expression evaluates to true.
+	Condition string `json:"condition,omitempty"`
+}
+
+// SetBreakpointResult holds the result of Debugger.setBreakpoint.
+type SetBreakpointResult struct {
+	// Id of the created breakpoint for further reference.
+	BreakpointID BreakpointID `json:"breakpointId"`
+	// Location this breakpoint resolved into.
+	ActualLocation Location `json:"actualLocation"`
+}
+
+// SetBreakpoint calls Debugger.setBreakpoint.
+//
+// Sets JavaScript breakpoint at a given location.
+func SetBreakpoint(ctx context.Context, c *internal.Client, sessionID string, params *SetBreakpointParams) (*SetBreakpointResult, error) {
+	if params == nil {
+		params = &SetBreakpointParams{}
+	}
+	var result SetBreakpointResult
+	err := c.Call(ctx, "Debugger.setBreakpoint", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SetInstrumentationBreakpointParams holds the parameters of Debugger.setInstrumentationBreakpoint.
+type SetInstrumentationBreakpointParams struct {
+	// Instrumentation name.
+	Instrumentation string `json:"instrumentation"`
+}
+
+// SetInstrumentationBreakpointResult holds the result of Debugger.setInstrumentationBreakpoint.
+type SetInstrumentationBreakpointResult struct {
+	// Id of the created breakpoint for further reference.
+	BreakpointID BreakpointID `json:"breakpointId"`
+}
+
+// SetInstrumentationBreakpoint calls Debugger.setInstrumentationBreakpoint.
+//
+// Sets instrumentation breakpoint.
+func SetInstrumentationBreakpoint(ctx context.Context, c *internal.Client, sessionID string, params *SetInstrumentationBreakpointParams) (*SetInstrumentationBreakpointResult, error) {
+	if params == nil {
+		params = &SetInstrumentationBreakpointParams{}
+	}
+	var result SetInstrumentationBreakpointResult
+	err := c.Call(ctx, "Debugger.setInstrumentationBreakpoint", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SetBreakpointByURLParams holds the parameters of Debugger.setBreakpointByUrl.
+type SetBreakpointByURLParams struct {
+	// Line number to set breakpoint at.
+	LineNumber int64 `json:"lineNumber"`
+	// URL of the resources to set breakpoint on.
+	URL string `json:"url,omitempty"`
+	// Regex pattern for the URLs of the resources to set breakpoints on. Either `url` or
+	// `urlRegex` must be specified.
+	URLRegex string `json:"urlRegex,omitempty"`
+	// Script hash of the resources to set breakpoint on.
+	ScriptHash string `json:"scriptHash,omitempty"`
+	// Offset in the line to set breakpoint at.
+	ColumnNumber *int64 `json:"columnNumber,omitempty"`
+	// Expression to use as a breakpoint condition. When specified, debugger will only stop on the
+	// breakpoint if this expression evaluates to true.
+	Condition string `json:"condition,omitempty"`
+}
+
+// SetBreakpointByURLResult holds the result of Debugger.setBreakpointByUrl.
+type SetBreakpointByURLResult struct {
+	// Id of the created breakpoint for further reference.
+	BreakpointID BreakpointID `json:"breakpointId"`
+	// List of the locations this breakpoint resolved into upon addition.
+	Locations []Location `json:"locations"`
+}
+
+// SetBreakpointByURL calls Debugger.setBreakpointByUrl.
+//
+// Sets JavaScript breakpoint at given location specified either by URL or URL regex. Once this
+// command is issued, all existing parsed scripts will have breakpoints resolved and returned in
+// `locations` property. Further matching script parsing will result in subsequent
+// `breakpointResolved` events issued. This logical breakpoint will survive page reloads.
+func SetBreakpointByURL(ctx context.Context, c *internal.Client, sessionID string, params *SetBreakpointByURLParams) (*SetBreakpointByURLResult, error) {
+	if params == nil {
+		params = &SetBreakpointByURLParams{}
+	}
+	var result SetBreakpointByURLResult
+	err := c.Call(ctx, "Debugger.setBreakpointByUrl", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SetBreakpointOnFunctionCallParams holds the parameters of Debugger.setBreakpointOnFunctionCall.
+type SetBreakpointOnFunctionCallParams struct {
+	// Function object id.
+	ObjectID runtime.RemoteObjectID `json:"objectId"`
+	// Expression to use as a breakpoint condition. When specified, debugger will
+	// stop on the breakpoint if this expression evaluates to true.
+	Condition string `json:"condition,omitempty"`
+}
+
+// SetBreakpointOnFunctionCallResult holds the result of Debugger.setBreakpointOnFunctionCall.
+type SetBreakpointOnFunctionCallResult struct {
+	// Id of the created breakpoint for further reference.
+	BreakpointID BreakpointID `json:"breakpointId"`
+}
+
+// SetBreakpointOnFunctionCall calls Debugger.setBreakpointOnFunctionCall.
+//
+// Sets JavaScript breakpoint before each call to the given function.
+// If another function was created from the same source as a given one,
+// calling it will also trigger the breakpoint.
+//
+// Experimental.
+func SetBreakpointOnFunctionCall(ctx context.Context, c *internal.Client, sessionID string, params *SetBreakpointOnFunctionCallParams) (*SetBreakpointOnFunctionCallResult, error) {
+	if params == nil {
+		params = &SetBreakpointOnFunctionCallParams{}
+	}
+	var result SetBreakpointOnFunctionCallResult
+	err := c.Call(ctx, "Debugger.setBreakpointOnFunctionCall", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SetBreakpointsActiveParams holds the parameters of Debugger.setBreakpointsActive.
+type SetBreakpointsActiveParams struct {
+	// New value for breakpoints active state.
+	Active bool `json:"active"`
+}
+
+// SetBreakpointsActive calls Debugger.setBreakpointsActive.
+//
+// Activates / deactivates all breakpoints on the page.
+func SetBreakpointsActive(ctx context.Context, c *internal.Client, sessionID string, params *SetBreakpointsActiveParams) error {
+	if params == nil {
+		params = &SetBreakpointsActiveParams{}
+	}
+	return c.Call(ctx, "Debugger.setBreakpointsActive", params, sessionID, nil)
+}
+
+// SetPauseOnExceptionsParams holds the parameters of Debugger.setPauseOnExceptions.
+type SetPauseOnExceptionsParams struct {
+	// Pause on exceptions mode.
+	State string `json:"state"`
+}
+
+// SetPauseOnExceptions calls Debugger.setPauseOnExceptions.
+//
+// Defines pause on exceptions state. Can be set to stop on all exceptions, uncaught exceptions,
+// or caught exceptions, no exceptions. Initial pause on exceptions state is `none`.
+func SetPauseOnExceptions(ctx context.Context, c *internal.Client, sessionID string, params *SetPauseOnExceptionsParams) error {
+	if params == nil {
+		params = &SetPauseOnExceptionsParams{}
+	}
+	return c.Call(ctx, "Debugger.setPauseOnExceptions", params, sessionID, nil)
+}
+
+// SetReturnValueParams holds the parameters of Debugger.setReturnValue.
+type SetReturnValueParams struct {
+	// New return value.
+	NewValue runtime.CallArgument `json:"newValue"`
+}
+
+// SetReturnValue calls Debugger.setReturnValue.
+//
+// Changes return value in top frame. Available only at return break position.
+//
+// Experimental.
+func SetReturnValue(ctx context.Context, c *internal.Client, sessionID string, params *SetReturnValueParams) error {
+	if params == nil {
+		params = &SetReturnValueParams{}
+	}
+	return c.Call(ctx, "Debugger.setReturnValue", params, sessionID, nil)
+}
+
+// SetScriptSourceParams holds the parameters of Debugger.setScriptSource.
+type SetScriptSourceParams struct {
+	// Id of the script to edit.
+	ScriptID runtime.ScriptID `json:"scriptId"`
+	// New content of the script.
+	ScriptSource string `json:"scriptSource"`
+	// If true the change will not actually be applied. Dry run may be used to get result
+	// description without actually modifying the code.
+	DryRun *bool `json:"dryRun,omitempty"`
+	// If true, then `scriptSource` is allowed to change the function on top of the stack
+	// as long as the top-most stack frame is the only activation of that function.
+	//
+	// Experimental.
+	AllowTopFrameEditing *bool `json:"allowTopFrameEditing,omitempty"`
+}
+
+// SetScriptSourceResult holds the result of Debugger.setScriptSource.
+type SetScriptSourceResult struct {
+	// New stack trace in case editing has happened while VM was stopped.
+	//
+	// Deprecated: this item is deprecated in the protocol.
+	CallFrames []CallFrame `json:"callFrames,omitempty"`
+	// Whether current call stack  was modified after applying the changes.
+	//
+	// Deprecated: this item is deprecated in the protocol.
+	StackChanged *bool `json:"stackChanged,omitempty"`
+	// Async stack trace, if any.
+	//
+	// Deprecated: this item is deprecated in the protocol.
+	AsyncStackTrace *runtime.StackTrace `json:"asyncStackTrace,omitempty"`
+	// Async stack trace, if any.
+	//
+	// Deprecated: this item is deprecated in the protocol.
+	AsyncStackTraceID *runtime.StackTraceID `json:"asyncStackTraceId,omitempty"`
+	// Whether the operation was successful or not. Only `Ok` denotes a
+	// successful live edit while the other enum variants denote why
+	// the live edit failed.
+	//
+	// Experimental.
+	Status string `json:"status"`
+	// Exception details if any. Only present when `status` is `CompileError`.
+	ExceptionDetails *runtime.ExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// SetScriptSource calls Debugger.setScriptSource.
+//
+// Edits JavaScript source live.
+//
+// In general, functions that are currently on the stack can not be edited with
+// a single exception: If the edited function is the top-most stack frame and
+// that is the only activation of that function on the stack. In this case
+// the live edit will be successful and a `Debugger.restartFrame` for the
+// top-most function is automatically triggered.
+func SetScriptSource(ctx context.Context, c *internal.Client, sessionID string, params *SetScriptSourceParams) (*SetScriptSourceResult, error) {
+	if params == nil {
+		params = &SetScriptSourceParams{}
+	}
+	var result SetScriptSourceResult
+	err := c.Call(ctx, "Debugger.setScriptSource", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SetSkipAllPausesParams holds the parameters of Debugger.setSkipAllPauses.
+type SetSkipAllPausesParams struct {
+	// New value for skip pauses state.
+	Skip bool `json:"skip"`
+}
+
+// SetSkipAllPauses calls Debugger.setSkipAllPauses.
+//
+// Makes page not interrupt on any pauses (breakpoint, exception, dom exception etc).
+func SetSkipAllPauses(ctx context.Context, c *internal.Client, sessionID string, params *SetSkipAllPausesParams) error {
+	if params == nil {
+		params = &SetSkipAllPausesParams{}
+	}
+	return c.Call(ctx, "Debugger.setSkipAllPauses", params, sessionID, nil)
+}
+
+// SetVariableValueParams holds the parameters of Debugger.setVariableValue.
+type SetVariableValueParams struct {
+	// 0-based number of scope as was listed in scope chain. Only 'local', 'closure' and 'catch'
+	// scope types are allowed. Other scopes could be manipulated manually.
+	ScopeNumber int64 `json:"scopeNumber"`
+	// Variable name.
+	VariableName string `json:"variableName"`
+	// New variable value.
+	NewValue runtime.CallArgument `json:"newValue"`
+	// Id of callframe that holds variable.
+	CallFrameID CallFrameID `json:"callFrameId"`
+}
+
+// SetVariableValue calls Debugger.setVariableValue.
+//
+// Changes value of variable in a callframe. Object-based scopes are not supported and must be
+// mutated manually.
+func SetVariableValue(ctx context.Context, c *internal.Client, sessionID string, params *SetVariableValueParams) error {
+	if params == nil {
+		params = &SetVariableValueParams{}
+	}
+	return c.Call(ctx, "Debugger.setVariableValue", params, sessionID, nil)
+}
+
+// StepIntoParams holds the parameters of Debugger.stepInto.
+type StepIntoParams struct {
+	// Debugger will pause on the execution of the first async task which was scheduled
+	// before next pause.
+	//
+	// Experimental.
+	BreakOnAsyncCall *bool `json:"breakOnAsyncCall,omitempty"`
+	// The skipList specifies location ranges that should be skipped on step into.
+	//
+	// Experimental.
+	SkipList []LocationRange `json:"skipList,omitempty"`
+}
+
+// StepInto calls Debugger.stepInto.
+//
+// Steps into the function call.
+func StepInto(ctx context.Context, c *internal.Client, sessionID string, params *StepIntoParams) error {
+	if params == nil {
+		params = &StepIntoParams{}
+	}
+	return c.Call(ctx, "Debugger.stepInto", params, sessionID, nil)
+}
+
+// StepOut calls Debugger.stepOut.
+//
+// Steps out of the function call.
+func StepOut(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Debugger.stepOut", nil, sessionID, nil)
+}
+
+// StepOverParams holds the parameters of Debugger.stepOver.
+type StepOverParams struct {
+	// The skipList specifies location ranges that should be skipped on step over.
+	//
+	// Experimental.
+	SkipList []LocationRange `json:"skipList,omitempty"`
+}
+
+// StepOver calls Debugger.stepOver.
+//
+// Steps over the statement.
+func StepOver(ctx context.Context, c *internal.Client, sessionID string, params *StepOverParams) error {
+	if params == nil {
+		params = &StepOverParams{}
+	}
+	return c.Call(ctx, "Debugger.stepOver", params, sessionID, nil)
+}
+
+// EventBreakpointResolved is the method name of the Debugger.breakpointResolved event.
+const EventBreakpointResolved = "Debugger.breakpointResolved"
+
+// BreakpointResolvedEvent is the payload of the Debugger.breakpointResolved event.
+//
+// Fired when breakpoint is resolved to an actual script and location.
+type BreakpointResolvedEvent struct {
+	// Breakpoint unique identifier.
+	BreakpointID BreakpointID `json:"breakpointId"`
+	// Actual breakpoint location.
+	Location Location `json:"location"`
+}
+
+// EventPaused is the method name of the Debugger.paused event.
+const EventPaused = "Debugger.paused"
+
+// PausedEvent is the payload of the Debugger.paused event.
+//
+// Fired when the virtual machine stopped on breakpoint or exception or any other stop criteria.
+type PausedEvent struct {
+	// Call stack the virtual machine stopped on.
+	CallFrames []CallFrame `json:"callFrames"`
+	// Pause reason.
+	Reason string `json:"reason"`
+	// Object containing break-specific auxiliary properties.
+	Data map[string]any `json:"data,omitempty"`
+	// Hit breakpoints IDs
+	HitBreakpoints []string `json:"hitBreakpoints,omitempty"`
+	// Async stack trace, if any.
+	AsyncStackTrace *runtime.StackTrace `json:"asyncStackTrace,omitempty"`
+	// Async stack trace, if any.
+	//
+	// Experimental.
+	AsyncStackTraceID *runtime.StackTraceID `json:"asyncStackTraceId,omitempty"`
+	// Never present, will be removed.
+	//
+	// Experimental.
+	//
+	// Deprecated: this item is deprecated in the protocol.
+	AsyncCallStackTraceID *runtime.StackTraceID `json:"asyncCallStackTraceId,omitempty"`
+}
+
+// EventResumed is the method name of the Debugger.resumed event.
+const EventResumed = "Debugger.resumed"
+
+// ResumedEvent is the payload of the Debugger.resumed event.
+//
+// Fired when the virtual machine resumed execution.
+type ResumedEvent struct{}
+
+// EventScriptFailedToParse is the method name of the Debugger.scriptFailedToParse event.
+const EventScriptFailedToParse = "Debugger.scriptFailedToParse"
+
+// ScriptFailedToParseEvent is the payload of the Debugger.scriptFailedToParse event.
+//
+// Fired when virtual machine fails to parse the script.
+type ScriptFailedToParseEvent struct {
+	// Identifier of the script parsed.
+	ScriptID runtime.ScriptID `json:"scriptId"`
+	// URL or name of the script parsed (if any).
+	URL string `json:"url"`
+	// Line offset of the script within the resource with given URL (for script tags).
+	StartLine int64 `json:"startLine"`
+	// Column offset of the script within the resource with given URL.
+	StartColumn int64 `json:"startColumn"`
+	// Last line of the script.
+	EndLine int64 `json:"endLine"`
+	// Length of the last line of the script.
+	EndColumn int64 `json:"endColumn"`
+	// Specifies script creation context.
+	ExecutionContextID runtime.ExecutionContextID `json:"executionContextId"`
+	// Content hash of the script, SHA-256.
+	Hash string `json:"hash"`
+	// Embedder-specific auxiliary data likely matching {isDefault: boolean, type: 'default'|'isolated'|'worker', frameId: string}
+	ExecutionContextAuxData map[string]any `json:"executionContextAuxData,omitempty"`
+	// URL of source map associated with script (if any).
+	SourceMapURL string `json:"sourceMapURL,omitempty"`
+	// True, if this script has sourceURL.
+	HasSourceURL *bool `json:"hasSourceURL,omitempty"`
+	// True, if this script is ES6 module.
+	IsModule *bool `json:"isModule,omitempty"`
+	// This script length.
+	Length *int64 `json:"length,omitempty"`
+	// JavaScript top stack frame of where the script parsed event was triggered if available.
+	//
+	// Experimental.
+	StackTrace *runtime.StackTrace `json:"stackTrace,omitempty"`
+	// If the scriptLanguage is WebAssembly, the code section offset in the module.
+	//
+	// Experimental.
+	CodeOffset *int64 `json:"codeOffset,omitempty"`
+	// The language of the script.
+	//
+	// Experimental.
+	ScriptLanguage ScriptLanguage `json:"scriptLanguage,omitempty"`
+	// The name the embedder supplied for this script.
+	//
+	// Experimental.
+	EmbedderName string `json:"embedderName,omitempty"`
+}
+
+// EventScriptParsed is the method name of the Debugger.scriptParsed event.
+const EventScriptParsed = "Debugger.scriptParsed"
+
+// ScriptParsedEvent is the payload of the Debugger.scriptParsed event.
+//
+// Fired when virtual machine parses script. This event is also fired for all known and uncollected
+// scripts upon enabling debugger.
+type ScriptParsedEvent struct {
+	// Identifier of the script parsed.
+	ScriptID runtime.ScriptID `json:"scriptId"`
+	// URL or name of the script parsed (if any).
+	URL string `json:"url"`
+	// Line offset of the script within the resource with given URL (for script tags).
+	StartLine int64 `json:"startLine"`
+	// Column offset of the script within the resource with given URL.
+	StartColumn int64 `json:"startColumn"`
+	// Last line of the script.
+	EndLine int64 `json:"endLine"`
+	// Length of the last line of the script.
+	EndColumn int64 `json:"endColumn"`
+	// Specifies script creation context.
+	ExecutionContextID runtime.ExecutionContextID `json:"executionContextId"`
+	// Content hash of the script, SHA-256.
+	Hash string `json:"hash"`
+	// Embedder-specific auxiliary data likely matching {isDefault: boolean, type: 'default'|'isolated'|'worker', frameId: string}
+	ExecutionContextAuxData map[string]any `json:"executionContextAuxData,omitempty"`
+	// True, if this script is generated as a result of the live edit operation.
+	//
+	// Experimental.
+	IsLiveEdit *bool `json:"isLiveEdit,omitempty"`
+	// URL of source map associated with script (if any).
+	SourceMapURL string `json:"sourceMapURL,omitempty"`
+	// True, if this script has sourceURL.
+	HasSourceURL *bool `json:"hasSourceURL,omitempty"`
+	// True, if this script is ES6 module.
+	IsModule *bool `json:"isModule,omitempty"`
+	// This script length.
+	Length *int64 `json:"length,omitempty"`
+	// JavaScript top stack frame of where the script parsed event was triggered if available.
+	//
+	// Experimental.
+	StackTrace *runtime.StackTrace `json:"stackTrace,omitempty"`
+	// If the scriptLanguage is WebAssembly, the code section offset in the module.
+	//
+	// Experimental.
+	CodeOffset *int64 `json:"codeOffset,omitempty"`
+	// The language of the script.
+	//
+	// Experimental.
+	ScriptLanguage ScriptLanguage `json:"scriptLanguage,omitempty"`
+	// If the scriptLanguage is WebASsembly, the source of debug symbols for the module.
+	//
+	// Experimental.
+	DebugSymbols *DebugSymbols `json:"debugSymbols,omitempty"`
+	// The name the embedder supplied for this script.
+	//
+	// Experimental.
+	EmbedderName string `json:"embedderName,omitempty"`
+}
+
+// ParseEvent decodes a Debugger event message into its typed event struct.
+func ParseEvent(msg *internal.CDPMessage) (any, error) {
+	var v any
+	switch msg.Method {
+	case EventBreakpointResolved:
+		v = &BreakpointResolvedEvent{}
+	case EventPaused:
+		v = &PausedEvent{}
+	case EventResumed:
+		v = &ResumedEvent{}
+	case EventScriptFailedToParse:
+		v = &ScriptFailedToParseEvent{}
+	case EventScriptParsed:
+		v = &ScriptParsedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Debugger event: %s", msg.Method)
+	}
+	if msg.Params == nil {
+		return v, nil
+	}
+	err := json.Unmarshal(msg.Params, v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
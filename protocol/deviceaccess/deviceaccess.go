@@ -0,0 +1,106 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package deviceaccess provides typed bindings for the CDP DeviceAccess domain.
+package deviceaccess
+
+import (
+	"cdp/internal"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// RequestID is the DeviceAccess.RequestId type.
+//
+// Device request id.
+type RequestID string
+
+// DeviceID is the DeviceAccess.DeviceId type.
+//
+// A device id.
+type DeviceID string
+
+// PromptDevice is the DeviceAccess.PromptDevice type.
+//
+// Device information displayed in a user prompt to select a device.
+type PromptDevice struct {
+	ID DeviceID `json:"id"`
+	// Display name as it appears in a device request user prompt.
+	Name string `json:"name"`
+}
+
+// Enable calls DeviceAccess.enable.
+//
+// Enable events in this domain.
+func Enable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "DeviceAccess.enable", nil, sessionID, nil)
+}
+
+// Disable calls DeviceAccess.disable.
+//
+// Disable events in this domain.
+func Disable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "DeviceAccess.disable", nil, sessionID, nil)
+}
+
+// SelectPromptParams holds the parameters of DeviceAccess.selectPrompt.
+type SelectPromptParams struct {
+	ID       RequestID `json:"id"`
+	DeviceID DeviceID  `json:"deviceId"`
+}
+
+// SelectPrompt calls DeviceAccess.selectPrompt.
+//
+// Select a device in response to a DeviceAccess.deviceRequestPrompted event.
+func SelectPrompt(ctx context.Context, c *internal.Client, sessionID string, params *SelectPromptParams) error {
+	if params == nil {
+		params = &SelectPromptParams{}
+	}
+	return c.Call(ctx, "DeviceAccess.selectPrompt", params, sessionID, nil)
+}
+
+// CancelPromptParams holds the parameters of DeviceAccess.cancelPrompt.
+type CancelPromptParams struct {
+	ID RequestID `json:"id"`
+}
+
+// CancelPrompt calls DeviceAccess.cancelPrompt.
+//
+// Cancel a prompt in response to a DeviceAccess.deviceRequestPrompted event.
+func CancelPrompt(ctx context.Context, c *internal.Client, sessionID string, params *CancelPromptParams) error {
+	if params == nil {
+		params = &CancelPromptParams{}
+	}
+	return c.Call(ctx, "DeviceAccess.cancelPrompt", params, sessionID, nil)
+}
+
+// EventDeviceRequestPrompted is the method name of the DeviceAccess.deviceRequestPrompted event.
+const EventDeviceRequestPrompted = "DeviceAccess.deviceRequestPrompted"
+
+// DeviceRequestPromptedEvent is the payload of the DeviceAccess.deviceRequestPrompted event.
+//
+// A device request opened a user prompt to select a device. Respond with the
+// selectPrompt or cancelPrompt command.
+type DeviceRequestPromptedEvent struct {
+	ID      RequestID      `json:"id"`
+	Devices []PromptDevice `json:"devices"`
+}
+
+// ParseEvent decodes a DeviceAccess event message into its typed event struct.
+func ParseEvent(msg *internal.CDPMessage) (any, error) {
+	var v any
+	switch msg.Method {
+	case EventDeviceRequestPrompted:
+		v = &DeviceRequestPromptedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown DeviceAccess event: %s", msg.Method)
+	}
+	if msg.Params == nil {
+		return v, nil
+	}
+	err := json.Unmarshal(msg.Params, v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,36 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package deviceorientation provides typed bindings for the CDP DeviceOrientation domain.
+package deviceorientation
+
+import (
+	"cdp/internal"
+	"context"
+)
+
+// ClearDeviceOrientationOverride calls DeviceOrientation.clearDeviceOrientationOverride.
+//
+// Clears the overridden Device Orientation.
+func ClearDeviceOrientationOverride(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "DeviceOrientation.clearDeviceOrientationOverride", nil, sessionID, nil)
+}
+
+// SetDeviceOrientationOverrideParams holds the parameters of DeviceOrientation.setDeviceOrientationOverride.
+type SetDeviceOrientationOverrideParams struct {
+	// Mock alpha
+	Alpha float64 `json:"alpha"`
+	// Mock beta
+	Beta float64 `json:"beta"`
+	// Mock gamma
+	Gamma float64 `json:"gamma"`
+}
+
+// SetDeviceOrientationOverride calls DeviceOrientation.setDeviceOrientationOverride.
+//
+// Overrides the Device Orientation.
+func SetDeviceOrientationOverride(ctx context.Context, c *internal.Client, sessionID string, params *SetDeviceOrientationOverrideParams) error {
+	if params == nil {
+		params = &SetDeviceOrientationOverrideParams{}
+	}
+	return c.Call(ctx, "DeviceOrientation.setDeviceOrientationOverride", params, sessionID, nil)
+}
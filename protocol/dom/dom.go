@@ -0,0 +1,1837 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package dom provides typed bindings for the CDP DOM domain.
+//
+// This domain exposes DOM read/write operations. Each DOM Node is represented with its mirror object
+// that has an `id`. This `id` can be used to get additional information on the Node, resolve it into
+// the JavaScript object wrapper, etc. It is important that client receives DOM events only for the
+// nodes that are known to the client. Backend keeps track of the nodes that were sent to the client
+// and never sends the same node twice. It is client's responsibility to collect information about
+// the nodes that were sent to the client. Note that `iframe` owner elements will return
+// corresponding document elements as their child nodes.
+package dom
+
+import (
+	"cdp/internal"
+	"cdp/protocol/runtime"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// NodeID is the DOM.NodeId type.
+//
+// Unique DOM node identifier.
+type NodeID int64
+
+// BackendNodeID is the DOM.BackendNodeId type.
+//
+// Unique DOM node identifier used to reference a node that may not have been pushed to the
+// front-end.
+type BackendNodeID int64
+
+// BackendNode is the DOM.BackendNode type.
+//
+// Backend node with a friendly name.
+type BackendNode struct {
+	// `Node`'s nodeType.
+	NodeType int64 `json:"nodeType"`
+	// `Node`'s nodeName.
+	NodeName      string        `json:"nodeName"`
+	BackendNodeID BackendNodeID `json:"backendNodeId"`
+}
+
+// PseudoType is the DOM.PseudoType type.
+//
+// Pseudo element type.
+type PseudoType string
+
+const (
+	PseudoTypeFirstLine               PseudoType = "first-line"
+	PseudoTypeFirstLetter             PseudoType = "first-letter"
+	PseudoTypeBefore                  PseudoType = "before"
+	PseudoTypeAfter                   PseudoType = "after"
+	PseudoTypeMarker                  PseudoType = "marker"
+	PseudoTypeBackdrop                PseudoType = "backdrop"
+	PseudoTypeSelection               PseudoType = "selection"
+	PseudoTypeSearchText              PseudoType = "search-text"
+	PseudoTypeTargetText              PseudoType = "target-text"
+	PseudoTypeSpellingError           PseudoType = "spelling-error"
+	PseudoTypeGrammarError            PseudoType = "grammar-error"
+	PseudoTypeHighlight               PseudoType = "highlight"
+	PseudoTypeFirstLineInherited      PseudoType = "first-line-inherited"
+	PseudoTypeScrollMarker            PseudoType = "scroll-marker"
+	PseudoTypeScrollMarkerGroup       PseudoType = "scroll-marker-group"
+	PseudoTypeScrollNextButton        PseudoType = "scroll-next-button"
+	PseudoTypeScrollPrevButton        PseudoType = "scroll-prev-button"
+	PseudoTypeScrollbar               PseudoType = "scrollbar"
+	PseudoTypeScrollbarThumb          PseudoType = "scrollbar-thumb"
+	PseudoTypeScrollbarButton         PseudoType = "scrollbar-button"
+	PseudoTypeScrollbarTrack          PseudoType = "scrollbar-track"
+	PseudoTypeScrollbarTrackPiece     PseudoType = "scrollbar-track-piece"
+	PseudoTypeScrollbarCorner         PseudoType = "scrollbar-corner"
+	PseudoTypeResizer                 PseudoType = "resizer"
+	PseudoTypeInputListButton         PseudoType = "input-list-button"
+	PseudoTypeViewTransition          PseudoType = "view-transition"
+	PseudoTypeViewTransitionGroup     PseudoType = "view-transition-group"
+	PseudoTypeViewTransitionImagePair PseudoType = "view-transition-image-pair"
+	PseudoTypeViewTransitionOld       PseudoType = "view-transition-old"
+	PseudoTypeViewTransitionNew       PseudoType = "view-transition-new"
+)
+
+// ShadowRootType is the DOM.ShadowRootType type.
+//
+// Shadow root type.
+type ShadowRootType string
+
+const (
+	ShadowRootTypeUserAgent ShadowRootType = "user-agent"
+	ShadowRootTypeOpen      ShadowRootType = "open"
+	ShadowRootTypeClosed    ShadowRootType = "closed"
+)
+
+// CompatibilityMode is the DOM.CompatibilityMode type.
+//
+// Document compatibility mode.
+type CompatibilityMode string
+
+const (
+	CompatibilityModeQuirksMode        CompatibilityMode = "QuirksMode"
+	CompatibilityModeLimitedQuirksMode CompatibilityMode = "LimitedQuirksMode"
+	CompatibilityModeNoQuirksMode      CompatibilityMode = "NoQuirksMode"
+)
+
+// PhysicalAxes is the DOM.PhysicalAxes type.
+//
+// ContainerSelector physical axes
+type PhysicalAxes string
+
+const (
+	PhysicalAxesHorizontal PhysicalAxes = "Horizontal"
+	PhysicalAxesVertical   PhysicalAxes = "Vertical"
+	PhysicalAxesBoth       PhysicalAxes = "Both"
+)
+
+// LogicalAxes is the DOM.LogicalAxes type.
+//
+// ContainerSelector logical axes
+type LogicalAxes string
+
+const (
+	LogicalAxesInline LogicalAxes = "Inline"
+	LogicalAxesBlock  LogicalAxes = "Block"
+	LogicalAxesBoth   LogicalAxes = "Both"
+)
+
+// ScrollOrientation is the DOM.ScrollOrientation type.
+//
+// Physical scroll orientation
+type ScrollOrientation string
+
+const (
+	ScrollOrientationHorizontal ScrollOrientation = "horizontal"
+	ScrollOrientationVertical   ScrollOrientation = "vertical"
+)
+
+// Node is the DOM.Node type.
+//
+// DOM interaction is implemented in terms of mirror objects that represent the actual DOM nodes.
+// DOMNode is a base node mirror type.
+type Node struct {
+	// Node identifier that is passed into the rest of the DOM messages as the `nodeId`. Backend
+	// will only push node with given `id` once. It is aware of all requested nodes and will only
+	// fire DOM events for nodes known to the client.
+	NodeID NodeID `json:"nodeId"`
+	// The id of the parent node if any.
+	ParentID *NodeID `json:"parentId,omitempty"`
+	// The BackendNodeId for this node.
+	BackendNodeID BackendNodeID `json:"backendNodeId"`
+	// `Node`'s nodeType.
+	NodeType int64 `json:"nodeType"`
+	// `Node`'s nodeName.
+	NodeName string `json:"nodeName"`
+	// `Node`'s localName.
+	LocalName string `json:"localName"`
+	// `Node`'s nodeValue.
+	NodeValue string `json:"nodeValue"`
+	// Child count for `Container` nodes.
+	ChildNodeCount *int64 `json:"childNodeCount,omitempty"`
+	// Child nodes of this node when requested with children.
+	Children []Node `json:"children,omitempty"`
+	// Attributes of the `Element` node in the form of flat array `[name1, value1, name2, value2]`.
+	Attributes []string `json:"attributes,omitempty"`
+	// Document URL that `Document` or `FrameOwner` node points to.
+	DocumentURL string `json:"documentURL,omitempty"`
+	// Base URL that `Document` or `FrameOwner` node uses for URL completion.
+	BaseURL string `json:"baseURL,omitempty"`
+	// `DocumentType`'s publicId.
+	PublicID string `json:"publicId,omitempty"`
+	// `DocumentType`'s systemId.
+	SystemID string `json:"systemId,omitempty"`
+	// `DocumentType`'s internalSubset.
+	InternalSubset string `json:"internalSubset,omitempty"`
+	// `Document`'s XML version in case of XML documents.
+	XMLVersion string `json:"xmlVersion,omitempty"`
+	// `Attr`'s name.
+	Name string `json:"name,omitempty"`
+	// `Attr`'s value.
+	Value string `json:"value,omitempty"`
+	// Pseudo element type for this node.
+	PseudoType PseudoType `json:"pseudoType,omitempty"`
+	// Pseudo element identifier for this node. Only present if there is a
+	// valid pseudoType.
+	PseudoIdentifier string `json:"pseudoIdentifier,omitempty"`
+	// Shadow root type.
+	ShadowRootType ShadowRootType `json:"shadowRootType,omitempty"`
+	// Frame ID for frame owner elements.
+	FrameID string `json:"frameId,omitempty"`
+	// Content document for frame owner elements.
+	ContentDocument *Node `json:"contentDocument,omitempty"`
+	// Shadow root list for given element host.
+	ShadowRoots []Node `json:"shadowRoots,omitempty"`
+	// Content document fragment for template elements.
+	TemplateContent *Node `json:"templateContent,omitempty"`
+	// Pseudo elements associated with this node.
+	PseudoElements []Node `json:"pseudoElements,omitempty"`
+	// Deprecated, as the HTML Imports API has been removed (crbug.com/937746).
+	// This property used to return the imported document for the HTMLImport links.
+	// The property is always undefined now.
+	//
+	// Deprecated: this item is deprecated in the protocol.
+	ImportedDocument *Node `json:"importedDocument,omitempty"`
+	// Distributed nodes for given insertion point.
+	DistributedNodes []BackendNode `json:"distributedNodes,omitempty"`
+	// Whether the node is SVG.
+	IsSVG             *bool             `json:"isSVG,omitempty"`
+	CompatibilityMode CompatibilityMode `json:"compatibilityMode,omitempty"`
+	AssignedSlot      *BackendNode      `json:"assignedSlot,omitempty"`
+}
+
+// DetachedElementInfo is the DOM.DetachedElementInfo type.
+//
+// A structure to hold the top-level node of a detached tree and an array of its retained descendants.
+type DetachedElementInfo struct {
+	TreeNode        Node     `json:"treeNode"`
+	RetainedNodeIDs []NodeID `json:"retainedNodeIds"`
+}
+
+// RGBA is the DOM.RGBA type.
+//
+// A structure holding an RGBA color.
+type RGBA struct {
+	// The red component, in the [0-255] range.
+	R int64 `json:"r"`
+	// The green component, in the [0-255] range.
+	G int64 `json:"g"`
+	// The blue component, in the [0-255] range.
+	B int64 `json:"b"`
+	// The alpha component, in the [0-1] range (default: 1).
+	A *float64 `json:"a,omitempty"`
+}
+
+// Quad is the DOM.Quad type.
+//
+// An array of quad vertices, x immediately followed by y for each point, points clock-wise.
+type Quad []float64
+
+// BoxModel is the DOM.BoxModel type.
+//
+// Box model.
+type BoxModel struct {
+	// Content box
+	Content Quad `json:"content"`
+	// Padding box
+	Padding Quad `json:"padding"`
+	// Border box
+	Border Quad `json:"border"`
+	// Margin box
+	Margin Quad `json:"margin"`
+	// Node width
+	Width int64 `json:"width"`
+	// Node height
+	Height int64 `json:"height"`
+	// Shape outside coordinates
+	ShapeOutside *ShapeOutsideInfo `json:"shapeOutside,omitempty"`
+}
+
+// ShapeOutsideInfo is the DOM.ShapeOutsideInfo type.
+//
+// CSS Shape Outside details.
+type ShapeOutsideInfo struct {
+	// Shape bounds
+	Bounds Quad `json:"bounds"`
+	// Shape coordinate details
+	Shape []json.RawMessage `json:"shape"`
+	// Margin shape bounds
+	MarginShape []json.RawMessage `json:"marginShape"`
+}
+
+// Rect is the DOM.Rect type.
+//
+// Rectangle.
+type Rect struct {
+	// X coordinate
+	X float64 `json:"x"`
+	// Y coordinate
+	Y float64 `json:"y"`
+	// Rectangle width
+	Width float64 `json:"width"`
+	// Rectangle height
+	Height float64 `json:"height"`
+}
+
+// CSSComputedStyleProperty is the DOM.CSSComputedStyleProperty type.
+type CSSComputedStyleProperty struct {
+	// Computed style property name.
+	Name string `json:"name"`
+	// Computed style property value.
+	Value string `json:"value"`
+}
+
+// CollectClassNamesFromSubtreeParams holds the parameters of DOM.collectClassNamesFromSubtree.
+type CollectClassNamesFromSubtreeParams struct {
+	// Id of the node to collect class names.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// CollectClassNamesFromSubtreeResult holds the result of DOM.collectClassNamesFromSubtree.
+type CollectClassNamesFromSubtreeResult struct {
+	// Class name list.
+	ClassNames []string `json:"classNames"`
+}
+
+// CollectClassNamesFromSubtree calls DOM.collectClassNamesFromSubtree.
+//
+// Collects class names for the node with given id and all of it's child nodes.
+//
+// Experimental.
+func CollectClassNamesFromSubtree(ctx context.Context, c *internal.Client, sessionID string, params *CollectClassNamesFromSubtreeParams) (*CollectClassNamesFromSubtreeResult, error) {
+	if params == nil {
+		params = &CollectClassNamesFromSubtreeParams{}
+	}
+	var result CollectClassNamesFromSubtreeResult
+	err := c.Call(ctx, "DOM.collectClassNamesFromSubtree", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CopyToParams holds the parameters of DOM.copyTo.
+type CopyToParams struct {
+	// Id of the node to copy.
+	NodeID NodeID `json:"nodeId"`
+	// Id of the element to drop the copy into.
+	TargetNodeID NodeID `json:"targetNodeId"`
+	// Drop the copy before this node (if absent, the copy becomes the last child of
+	// `targetNodeId`).
+	InsertBeforeNodeID *NodeID `json:"insertBeforeNodeId,omitempty"`
+}
+
+// CopyToResult holds the result of DOM.copyTo.
+type CopyToResult struct {
+	// Id of the node clone.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// CopyTo calls DOM.copyTo.
+//
+// Creates a deep copy of the specified node and places it into the target container before the
+// given anchor.
+//
+// Experimental.
+func CopyTo(ctx context.Context, c *internal.Client, sessionID string, params *CopyToParams) (*CopyToResult, error) {
+	if params == nil {
+		params = &CopyToParams{}
+	}
+	var result CopyToResult
+	err := c.Call(ctx, "DOM.copyTo", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DescribeNodeParams holds the parameters of DOM.describeNode.
+type DescribeNodeParams struct {
+	// Identifier of the node.
+	NodeID *NodeID `json:"nodeId,omitempty"`
+	// Identifier of the backend node.
+	BackendNodeID *BackendNodeID `json:"backendNodeId,omitempty"`
+	// JavaScript object id of the node wrapper.
+	ObjectID runtime.RemoteObjectID `json:"objectId,omitempty"`
+	// The maximum depth at which children should be retrieved, defaults to 1. Use -1 for the
+	// entire subtree or provide an integer larger than 0.
+	Depth *int64 `json:"depth,omitempty"`
+	// Whether or not iframes and shadow roots should be traversed when returning the subtree
+	// (default is false).
+	Pierce *bool `json:"pierce,omitempty"`
+}
+
+// DescribeNodeResult holds the result of DOM.describeNode.
+type DescribeNodeResult struct {
+	// Node description.
+	Node Node `json:"node"`
+}
+
+// DescribeNode calls DOM.describeNode.
+//
+// Describes node given its id, does not require domain to be enabled. Does not start tracking any
+// objects, can be used for automation.
+func DescribeNode(ctx context.Context, c *internal.Client, sessionID string, params *DescribeNodeParams) (*DescribeNodeResult, error) {
+	if params == nil {
+		params = &DescribeNodeParams{}
+	}
+	var result DescribeNodeResult
+	err := c.Call(ctx, "DOM.describeNode", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ScrollIntoViewIfNeededParams holds the parameters of DOM.scrollIntoViewIfNeeded.
+type ScrollIntoViewIfNeededParams struct {
+	// Identifier of the node.
+	NodeID *NodeID `json:"nodeId,omitempty"`
+	// Identifier of the backend node.
+	BackendNodeID *BackendNodeID `json:"backendNodeId,omitempty"`
+	// JavaScript object id of the node wrapper.
+	ObjectID runtime.RemoteObjectID `json:"objectId,omitempty"`
+	// The rect to be scrolled into view, relative to the node's border box, in CSS pixels.
+	// When omitted, center of the node will be used, similar to Element.scrollIntoView.
+	Rect *Rect `json:"rect,omitempty"`
+}
+
+// ScrollIntoViewIfNeeded calls DOM.scrollIntoViewIfNeeded.
+//
+// Scrolls the specified rect of the given node into view if not already visible.
+// Note: exactly one between nodeId, backendNodeId and objectId should be passed
+// to identify the node.
+func ScrollIntoViewIfNeeded(ctx context.Context, c *internal.Client, sessionID string, params *ScrollIntoViewIfNeededParams) error {
+	if params == nil {
+		params = &ScrollIntoViewIfNeededParams{}
+	}
+	return c.Call(ctx, "DOM.scrollIntoViewIfNeeded", params, sessionID, nil)
+}
+
+// Disable calls DOM.disable.
+//
+// Disables DOM agent for the given page.
+func Disable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "DOM.disable", nil, sessionID, nil)
+}
+
+// DiscardSearchResultsParams holds the parameters of DOM.discardSearchResults.
+type DiscardSearchResultsParams struct {
+	// Unique search session identifier.
+	SearchID string `json:"searchId"`
+}
+
+// DiscardSearchResults calls DOM.discardSearchResults.
+//
+// Discards search results from the session with the given id. `getSearchResults` should no longer
+// be called for that search.
+//
+// Experimental.
+func DiscardSearchResults(ctx context.Context, c *internal.Client, sessionID string, params *DiscardSearchResultsParams) error {
+	if params == nil {
+		params = &DiscardSearchResultsParams{}
+	}
+	return c.Call(ctx, "DOM.discardSearchResults", params, sessionID, nil)
+}
+
+// EnableParams holds the parameters of DOM.enable.
+type EnableParams struct {
+	// Whether to include whitespaces in the children array of returned Nodes.
+	//
+	// Experimental.
+	IncludeWhitespace string `json:"includeWhitespace,omitempty"`
+}
+
+// Enable calls DOM.enable.
+//
+// Enables DOM agent for the given page.
+func Enable(ctx context.Context, c *internal.Client, sessionID string, params *EnableParams) error {
+	if params == nil {
+		params = &EnableParams{}
+	}
+	return c.Call(ctx, "DOM.enable", params, sessionID, nil)
+}
+
+// FocusParams holds the parameters of DOM.focus.
+type FocusParams struct {
+	// Identifier of the node.
+	NodeID *NodeID `json:"nodeId,omitempty"`
+	// Identifier of the backend node.
+	BackendNodeID *BackendNodeID `json:"backendNodeId,omitempty"`
+	// JavaScript object id of the node wrapper.
+	ObjectID runtime.RemoteObjectID `json:"objectId,omitempty"`
+}
+
+// Focus calls DOM.focus.
+//
+// Focuses the given element.
+func Focus(ctx context.Context, c *internal.Client, sessionID string, params *FocusParams) error {
+	if params == nil {
+		params = &FocusParams{}
+	}
+	return c.Call(ctx, "DOM.focus", params, sessionID, nil)
+}
+
+// GetAttributesParams holds the parameters of DOM.getAttributes.
+type GetAttributesParams struct {
+	// Id of the node to retrieve attributes for.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// GetAttributesResult holds the result of DOM.getAttributes.
+type GetAttributesResult struct {
+	// An interleaved array of node attribute names and values.
+	Attributes []string `json:"attributes"`
+}
+
+// GetAttributes calls DOM.getAttributes.
+//
+// Returns attributes for the specified node.
+func GetAttributes(ctx context.Context, c *internal.Client, sessionID string, params *GetAttributesParams) (*GetAttributesResult, error) {
+	if params == nil {
+		params = &GetAttributesParams{}
+	}
+	var result GetAttributesResult
+	err := c.Call(ctx, "DOM.getAttributes", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetBoxModelParams holds the parameters of DOM.getBoxModel.
+type GetBoxModelParams struct {
+	// Identifier of the node.
+	NodeID *NodeID `json:"nodeId,omitempty"`
+	// Identifier of the backend node.
+	BackendNodeID *BackendNodeID `json:"backendNodeId,omitempty"`
+	// JavaScript object id of the node wrapper.
+	ObjectID runtime.RemoteObjectID `json:"objectId,omitempty"`
+}
+
+// GetBoxModelResult holds the result of DOM.getBoxModel.
+type GetBoxModelResult struct {
+	// Box model for the node.
+	Model BoxModel `json:"model"`
+}
+
+// GetBoxModel calls DOM.getBoxModel.
+//
+// Returns boxes for the given node.
+func GetBoxModel(ctx context.Context, c *internal.Client, sessionID string, params *GetBoxModelParams) (*GetBoxModelResult, error) {
+	if params == nil {
+		params = &GetBoxModelParams{}
+	}
+	var result GetBoxModelResult
+	err := c.Call(ctx, "DOM.getBoxModel", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetContentQuadsParams holds the parameters of DOM.getContentQuads.
+type GetContentQuadsParams struct {
+	// Identifier of the node.
+	NodeID *NodeID `json:"nodeId,omitempty"`
+	// Identifier of the backend node.
+	BackendNodeID *BackendNodeID `json:"backendNodeId,omitempty"`
+	// JavaScript object id of the node wrapper.
+	ObjectID runtime.RemoteObjectID `json:"objectId,omitempty"`
+}
+
+// GetContentQuadsResult holds the result of DOM.getContentQuads.
+type GetContentQuadsResult struct {
+	// Quads that describe node layout relative to viewport.
+	Quads []Quad `json:"quads"`
+}
+
+// GetContentQuads calls DOM.getContentQuads.
+//
+// Returns quads that describe node position on the page. This method
+// might return multiple quads for inline nodes.
+//
+// Experimental.
+func GetContentQuads(ctx context.Context, c *internal.Client, sessionID string, params *GetContentQuadsParams) (*GetContentQuadsResult, error) {
+	if params == nil {
+		params = &GetContentQuadsParams{}
+	}
+	var result GetContentQuadsResult
+	err := c.Call(ctx, "DOM.getContentQuads", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetDocumentParams holds the parameters of DOM.getDocument.
+type GetDocumentParams struct {
+	// The maximum depth at which children should be retrieved, defaults to 1. Use -1 for the
+	// entire subtree or provide an integer larger than 0.
+	Depth *int64 `json:"depth,omitempty"`
+	// Whether or not iframes and shadow roots should be traversed when returning the subtree
+	// (default is false).
+	Pierce *bool `json:"pierce,omitempty"`
+}
+
+// GetDocumentResult holds the result of DOM.getDocument.
+type GetDocumentResult struct {
+	// Resulting node.
+	Root Node `json:"root"`
+}
+
+// GetDocument calls DOM.getDocument.
+//
+// Returns the root DOM node (and optionally the subtree) to the caller.
+// Implicitly enables the DOM domain events for the current target.
+func GetDocument(ctx context.Context, c *internal.Client, sessionID string, params *GetDocumentParams) (*GetDocumentResult, error) {
+	if params == nil {
+		params = &GetDocumentParams{}
+	}
+	var result GetDocumentResult
+	err := c.Call(ctx, "DOM.getDocument", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetFlattenedDocumentParams holds the parameters of DOM.getFlattenedDocument.
+type GetFlattenedDocumentParams struct {
+	// The maximum depth at which children should be retrieved, defaults to 1. Use -1 for the
+	// entire subtree or provide an integer larger than 0.
+	Depth *int64 `json:"depth,omitempty"`
+	// Whether or not iframes and shadow roots should be traversed when returning the subtree
+	// (default is false).
+	Pierce *bool `json:"pierce,omitempty"`
+}
+
+// GetFlattenedDocumentResult holds the result of DOM.getFlattenedDocument.
+type GetFlattenedDocumentResult struct {
+	// Resulting node.
+	Nodes []Node `json:"nodes"`
+}
+
+// GetFlattenedDocument calls DOM.getFlattenedDocument.
+//
+// Returns the root DOM node (and optionally the subtree) to the caller.
+// Deprecated, as it is not designed to work well with the rest of the DOM agent.
+// Use DOMSnapshot.captureSnapshot instead.
+//
+// Deprecated: this item is deprecated in the protocol.
+func GetFlattenedDocument(ctx context.Context, c *internal.Client, sessionID string, params *GetFlattenedDocumentParams) (*GetFlattenedDocumentResult, error) {
+	if params == nil {
+		params = &GetFlattenedDocumentParams{}
+	}
+	var result GetFlattenedDocumentResult
+	err := c.Call(ctx, "DOM.getFlattenedDocument", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetNodesForSubtreeByStyleParams holds the parameters of DOM.getNodesForSubtreeByStyle.
+type GetNodesForSubtreeByStyleParams struct {
+	// Node ID pointing to the root of a subtree.
+	NodeID NodeID `json:"nodeId"`
+	// The style to filter nodes by (includes nodes if any of properties matches).
+	ComputedStyles []CSSComputedStyleProperty `json:"computedStyles"`
+	// Whether or not iframes and shadow roots in the same target should be traversed when returning the
+	// results (default is false).
+	Pierce *bool `json:"pierce,omitempty"`
+}
+
+// GetNodesForSubtreeByStyleResult holds the result of DOM.getNodesForSubtreeByStyle.
+type GetNodesForSubtreeByStyleResult struct {
+	// Resulting nodes.
+	NodeIDs []NodeID `json:"nodeIds"`
+}
+
+// GetNodesForSubtreeByStyle calls DOM.getNodesForSubtreeByStyle.
+//
+// Finds nodes with a given computed style in a subtree.
+//
+// Experimental.
+func GetNodesForSubtreeByStyle(ctx context.Context, c *internal.Client, sessionID string, params *GetNodesForSubtreeByStyleParams) (*GetNodesForSubtreeByStyleResult, error) {
+	if params == nil {
+		params = &GetNodesForSubtreeByStyleParams{}
+	}
+	var result GetNodesForSubtreeByStyleResult
+	err := c.Call(ctx, "DOM.getNodesForSubtreeByStyle", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetNodeForLocationParams holds the parameters of DOM.getNodeForLocation.
+type GetNodeForLocationParams struct {
+	// X coordinate.
+	X int64 `json:"x"`
+	// Y coordinate.
+	Y int64 `json:"y"`
+	// False to skip to the nearest non-UA shadow root ancestor (default: false).
+	IncludeUserAgentShadowDOM *bool `json:"includeUserAgentShadowDOM,omitempty"`
+	// Whether to ignore pointer-events: none on elements and hit test them.
+	IgnorePointerEventsNone *bool `json:"ignorePointerEventsNone,omitempty"`
+}
+
+// GetNodeForLocationResult holds the result of DOM.getNodeForLocation.
+type GetNodeForLocationResult struct {
+	// Resulting node.
+	BackendNodeID BackendNodeID `json:"backendNodeId"`
+	// Frame this node belongs to.
+	FrameID string `json:"frameId"`
+	// Id of the node at given coordinates, only when enabled and requested document.
+	NodeID *NodeID `json:"nodeId,omitempty"`
+}
+
+// GetNodeForLocation calls DOM.getNodeForLocation.
+//
+// Returns node id at given location. Depending on whether DOM domain is enabled, nodeId is
+// either returned or not.
+func GetNodeForLocation(ctx context.Context, c *internal.Client, sessionID string, params *GetNodeForLocationParams) (*GetNodeForLocationResult, error) {
+	if params == nil {
+		params = &GetNodeForLocationParams{}
+	}
+	var result GetNodeForLocationResult
+	err := c.Call(ctx, "DOM.getNodeForLocation", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetOuterHTMLParams holds the parameters of DOM.getOuterHTML.
+type GetOuterHTMLParams struct {
+	// Identifier of the node.
+	NodeID *NodeID `json:"nodeId,omitempty"`
+	// Identifier of the backend node.
+	BackendNodeID *BackendNodeID `json:"backendNodeId,omitempty"`
+	// JavaScript object id of the node wrapper.
+	ObjectID runtime.RemoteObjectID `json:"objectId,omitempty"`
+}
+
+// GetOuterHTMLResult holds the result of DOM.getOuterHTML.
+type GetOuterHTMLResult struct {
+	// Outer HTML markup.
+	OuterHTML string `json:"outerHTML"`
+}
+
+// GetOuterHTML calls DOM.getOuterHTML.
+//
+// Returns node's HTML markup.
+func GetOuterHTML(ctx context.Context, c *internal.Client, sessionID string, params *GetOuterHTMLParams) (*GetOuterHTMLResult, error) {
+	if params == nil {
+		params = &GetOuterHTMLParams{}
+	}
+	var result GetOuterHTMLResult
+	err := c.Call(ctx, "DOM.getOuterHTML", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetRelayoutBoundaryParams holds the parameters of DOM.getRelayoutBoundary.
+type GetRelayoutBoundaryParams struct {
+	// Id of the node.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// GetRelayoutBoundaryResult holds the result of DOM.getRelayoutBoundary.
+type GetRelayoutBoundaryResult struct {
+	// Relayout boundary node id for the given node.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// GetRelayoutBoundary calls DOM.getRelayoutBoundary.
+//
+// Returns the id of the nearest ancestor that is a relayout boundary.
+//
+// Experimental.
+func GetRelayoutBoundary(ctx context.Context, c *internal.Client, sessionID string, params *GetRelayoutBoundaryParams) (*GetRelayoutBoundaryResult, error) {
+	if params == nil {
+		params = &GetRelayoutBoundaryParams{}
+	}
+	var result GetRelayoutBoundaryResult
+	err := c.Call(ctx, "DOM.getRelayoutBoundary", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetSearchResultsParams holds the parameters of DOM.getSearchResults.
+type GetSearchResultsParams struct {
+	// Unique search session identifier.
+	SearchID string `json:"searchId"`
+	// Start index of the search result to be returned.
+	FromIndex int64 `json:"fromIndex"`
+	// End index of the search result to be returned.
+	ToIndex int64 `json:"toIndex"`
+}
+
+// GetSearchResultsResult holds the result of DOM.getSearchResults.
+type GetSearchResultsResult struct {
+	// Ids of the search result nodes.
+	NodeIDs []NodeID `json:"nodeIds"`
+}
+
+// GetSearchResults calls DOM.getSearchResults.
+//
+// Returns search results from given `fromIndex` to given `toIndex` from the search with the given
+// identifier.
+//
+// Experimental.
+func GetSearchResults(ctx context.Context, c *internal.Client, sessionID string, params *GetSearchResultsParams) (*GetSearchResultsResult, error) {
+	if params == nil {
+		params = &GetSearchResultsParams{}
+	}
+	var result GetSearchResultsResult
+	err := c.Call(ctx, "DOM.getSearchResults", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// HideHighlight calls DOM.hideHighlight.
+//
+// Hides any highlight.
+//
+// Redirects to Overlay.
+func HideHighlight(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "DOM.hideHighlight", nil, sessionID, nil)
+}
+
+// HighlightNode calls DOM.highlightNode.
+//
+// Highlights DOM node.
+//
+// Redirects to Overlay.
+func HighlightNode(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "DOM.highlightNode", nil, sessionID, nil)
+}
+
+// HighlightRect calls DOM.highlightRect.
+//
+// Highlights given rectangle.
+//
+// Redirects to Overlay.
+func HighlightRect(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "DOM.highlightRect", nil, sessionID, nil)
+}
+
+// MarkUndoableState calls DOM.markUndoableState.
+//
+// Marks last undoable state.
+//
+// Experimental.
+func MarkUndoableState(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "DOM.markUndoableState", nil, sessionID, nil)
+}
+
+// MoveToParams holds the parameters of DOM.moveTo.
+type MoveToParams struct {
+	// Id of the node to move.
+	NodeID NodeID `json:"nodeId"`
+	// Id of the element to drop the moved node into.
+	TargetNodeID NodeID `json:"targetNodeId"`
+	// Drop node before this one (if absent, the moved node becomes the last child of
+	// `targetNodeId`).
+	InsertBeforeNodeID *NodeID `json:"insertBeforeNodeId,omitempty"`
+}
+
+// MoveToResult holds the result of DOM.moveTo.
+type MoveToResult struct {
+	// New id of the moved node.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// MoveTo calls DOM.moveTo.
+//
+// Moves node into the new container, places it before the given anchor.
+func MoveTo(ctx context.Context, c *internal.Client, sessionID string, params *MoveToParams) (*MoveToResult, error) {
+	if params == nil {
+		params = &MoveToParams{}
+	}
+	var result MoveToResult
+	err := c.Call(ctx, "DOM.moveTo", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// PerformSearchParams holds the parameters of DOM.performSearch.
+type PerformSearchParams struct {
+	// Plain text or query selector or XPath search query.
+	Query string `json:"query"`
+	// True to search in user agent shadow DOM.
+	IncludeUserAgentShadowDOM *bool `json:"includeUserAgentShadowDOM,omitempty"`
+}
+
+// PerformSearchResult holds the result of DOM.performSearch.
+type PerformSearchResult struct {
+	// Unique search session identifier.
+	SearchID string `json:"searchId"`
+	// Number of search results.
+	ResultCount int64 `json:"resultCount"`
+}
+
+// PerformSearch calls DOM.performSearch.
+//
+// Searches for a given string in the DOM tree. Use `getSearchResults` to access search results or
+// `cancelSearch` to end this search session.
+//
+// Experimental.
+func PerformSearch(ctx context.Context, c *internal.Client, sessionID string, params *PerformSearchParams) (*PerformSearchResult, error) {
+	if params == nil {
+		params = &PerformSearchParams{}
+	}
+	var result PerformSearchResult
+	err := c.Call(ctx, "DOM.performSearch", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// PushNodeByPathToFrontendParams holds the parameters of DOM.pushNodeByPathToFrontend.
+type PushNodeByPathToFrontendParams struct {
+	// Path to node in the proprietary format.
+	Path string `json:"path"`
+}
+
+// PushNodeByPathToFrontendResult holds the result of DOM.pushNodeByPathToFrontend.
+type PushNodeByPathToFrontendResult struct {
+	// Id of the node for given path.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// PushNodeByPathToFrontend calls DOM.pushNodeByPathToFrontend.
+//
+// Requests that the node is sent to the caller given its path. // FIXME, use XPath
+//
+// Experimental.
+func PushNodeByPathToFrontend(ctx context.Context, c *internal.Client, sessionID string, params *PushNodeByPathToFrontendParams) (*PushNodeByPathToFrontendResult, error) {
+	if params == nil {
+		params = &PushNodeByPathToFrontendParams{}
+	}
+	var result PushNodeByPathToFrontendResult
+	err := c.Call(ctx, "DOM.pushNodeByPathToFrontend", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// PushNodesByBackendIDsToFrontendParams holds the parameters of DOM.pushNodesByBackendIdsToFrontend.
+type PushNodesByBackendIDsToFrontendParams struct {
+	// The array of backend node ids.
+	BackendNodeIDs []BackendNodeID `json:"backendNodeIds"`
+}
+
+// PushNodesByBackendIDsToFrontendResult holds the result of DOM.pushNodesByBackendIdsToFrontend.
+type PushNodesByBackendIDsToFrontendResult struct {
+	// The array of ids of pushed nodes that correspond to the backend ids specified in
+	// backendNodeIds.
+	NodeIDs []NodeID `json:"nodeIds"`
+}
+
+// PushNodesByBackendIDsToFrontend calls DOM.pushNodesByBackendIdsToFrontend.
+//
+// Requests that a batch of nodes is sent to the caller given their backend node ids.
+//
+// Experimental.
+func PushNodesByBackendIDsToFrontend(ctx context.Context, c *internal.Client, sessionID string, params *PushNodesByBackendIDsToFrontendParams) (*PushNodesByBackendIDsToFrontendResult, error) {
+	if params == nil {
+		params = &PushNodesByBackendIDsToFrontendParams{}
+	}
+	var result PushNodesByBackendIDsToFrontendResult
+	err := c.Call(ctx, "DOM.pushNodesByBackendIdsToFrontend", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// QuerySelectorParams holds the parameters of DOM.querySelector.
+type QuerySelectorParams struct {
+	// Id of the node to query upon.
+	NodeID NodeID `json:"nodeId"`
+	// Selector string.
+	Selector string `json:"selector"`
+}
+
+// QuerySelectorResult holds the result of DOM.querySelector.
+type QuerySelectorResult struct {
+	// Query selector result.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// QuerySelector calls DOM.querySelector.
+//
+// Executes `querySelector` on a given node.
+func QuerySelector(ctx context.Context, c *internal.Client, sessionID string, params *QuerySelectorParams) (*QuerySelectorResult, error) {
+	if params == nil {
+		params = &QuerySelectorParams{}
+	}
+	var result QuerySelectorResult
+	err := c.Call(ctx, "DOM.querySelector", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// QuerySelectorAllParams holds the parameters of DOM.querySelectorAll.
+type QuerySelectorAllParams struct {
+	// Id of the node to query upon.
+	NodeID NodeID `json:"nodeId"`
+	// Selector string.
+	Selector string `json:"selector"`
+}
+
+// QuerySelectorAllResult holds the result of DOM.querySelectorAll.
+type QuerySelectorAllResult struct {
+	// Query selector result.
+	NodeIDs []NodeID `json:"nodeIds"`
+}
+
+// QuerySelectorAll calls DOM.querySelectorAll.
+//
+// Executes `querySelectorAll` on a given node.
+func QuerySelectorAll(ctx context.Context, c *internal.Client, sessionID string, params *QuerySelectorAllParams) (*QuerySelectorAllResult, error) {
+	if params == nil {
+		params = &QuerySelectorAllParams{}
+	}
+	var result QuerySelectorAllResult
+	err := c.Call(ctx, "DOM.querySelectorAll", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetTopLayerElementsResult holds the result of DOM.getTopLayerElements.
+type GetTopLayerElementsResult struct {
+	// NodeIds of top layer elements
+	NodeIDs []NodeID `json:"nodeIds"`
+}
+
+// GetTopLayerElements calls DOM.getTopLayerElements.
+//
+// Returns NodeIds of current top layer elements.
+// Top layer is rendered closest to the user within a viewport, therefore its elements always
+// appear on top of all other content.
+//
+// Experimental.
+func GetTopLayerElements(ctx context.Context, c *internal.Client, sessionID string) (*GetTopLayerElementsResult, error) {
+	var result GetTopLayerElementsResult
+	err := c.Call(ctx, "DOM.getTopLayerElements", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetElementByRelationParams holds the parameters of DOM.getElementByRelation.
+type GetElementByRelationParams struct {
+	// Id of the node from which to query the relation.
+	NodeID NodeID `json:"nodeId"`
+	// Type of relation to get.
+	Relation string `json:"relation"`
+}
+
+// GetElementByRelationResult holds the result of DOM.getElementByRelation.
+type GetElementByRelationResult struct {
+	// NodeId of the element matching the queried relation.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// GetElementByRelation calls DOM.getElementByRelation.
+//
+// Returns the NodeId of the matched element according to certain relations.
+//
+// Experimental.
+func GetElementByRelation(ctx context.Context, c *internal.Client, sessionID string, params *GetElementByRelationParams) (*GetElementByRelationResult, error) {
+	if params == nil {
+		params = &GetElementByRelationParams{}
+	}
+	var result GetElementByRelationResult
+	err := c.Call(ctx, "DOM.getElementByRelation", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Redo calls DOM.redo.
+//
+// Re-does the last undone action.
+//
+// Experimental.
+func Redo(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "DOM.redo", nil, sessionID, nil)
+}
+
+// RemoveAttributeParams holds the parameters of DOM.removeAttribute.
+type RemoveAttributeParams struct {
+	// Id of the element to remove attribute from.
+	NodeID NodeID `json:"nodeId"`
+	// Name of the attribute to remove.
+	Name string `json:"name"`
+}
+
+// RemoveAttribute calls DOM.removeAttribute.
+//
+// Removes attribute with given name from an element with given id.
+func RemoveAttribute(ctx context.Context, c *internal.Client, sessionID string, params *RemoveAttributeParams) error {
+	if params == nil {
+		params = &RemoveAttributeParams{}
+	}
+	return c.Call(ctx, "DOM.removeAttribute", params, sessionID, nil)
+}
+
+// RemoveNodeParams holds the parameters of DOM.removeNode.
+type RemoveNodeParams struct {
+	// Id of the node to remove.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// RemoveNode calls DOM.removeNode.
+//
+// Removes node with given id.
+func RemoveNode(ctx context.Context, c *internal.Client, sessionID string, params *RemoveNodeParams) error {
+	if params == nil {
+		params = &RemoveNodeParams{}
+	}
+	return c.Call(ctx, "DOM.removeNode", params, sessionID, nil)
+}
+
+// RequestChildNodesParams holds the parameters of DOM.requestChildNodes.
+type RequestChildNodesParams struct {
+	// Id of the node to get children for.
+	NodeID NodeID `json:"nodeId"`
+	// The maximum depth at which children should be retrieved, defaults to 1. Use -1 for the
+	// entire subtree or provide an integer larger than 0.
+	Depth *int64 `json:"depth,omitempty"`
+	// Whether or not iframes and shadow roots should be traversed when returning the sub-tree
+	// (default is false).
+	Pierce *bool `json:"pierce,omitempty"`
+}
+
+// RequestChildNodes calls DOM.requestChildNodes.
+//
+// Requests that children of the node with given id are returned to the caller in form of
+// `setChildNodes` events where not only immediate children are retrieved, but all children down to
+// the specified depth.
+func RequestChildNodes(ctx context.Context, c *internal.Client, sessionID string, params *RequestChildNodesParams) error {
+	if params == nil {
+		params = &RequestChildNodesParams{}
+	}
+	return c.Call(ctx, "DOM.requestChildNodes", params, sessionID, nil)
+}
+
+// RequestNodeParams holds the parameters of DOM.requestNode.
+type RequestNodeParams struct {
+	// JavaScript object id to convert into node.
+	ObjectID runtime.RemoteObjectID `json:"objectId"`
+}
+
+// RequestNodeResult holds the result of DOM.requestNode.
+type RequestNodeResult struct {
+	// Node id for given object.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// RequestNode calls DOM.requestNode.
+//
+// Requests that the node is sent to the caller given the JavaScript node object reference. All
+// nodes that form the path from the node to the root are also sent to the client as a series of
+// `setChildNodes` notifications.
+func RequestNode(ctx context.Context, c *internal.Client, sessionID string, params *RequestNodeParams) (*RequestNodeResult, error) {
+	if params == nil {
+		params = &RequestNodeParams{}
+	}
+	var result RequestNodeResult
+	err := c.Call(ctx, "DOM.requestNode", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ResolveNodeParams holds the parameters of DOM.resolveNode.
+type ResolveNodeParams struct {
+	// Id of the node to resolve.
+	NodeID *NodeID `json:"nodeId,omitempty"`
+	// Backend identifier of the node to resolve.
+	BackendNodeID *BackendNodeID `json:"backendNodeId,omitempty"`
+	// Symbolic group name that can be used to release multiple objects.
+	ObjectGroup string `json:"objectGroup,omitempty"`
+	// Execution context in which to resolve the node.
+	ExecutionContextID *runtime.ExecutionContextID `json:"executionContextId,omitempty"`
+}
+
+// ResolveNodeResult holds the result of DOM.resolveNode.
+type ResolveNodeResult struct {
+	// JavaScript object wrapper for given node.
+	Object runtime.RemoteObject `json:"object"`
+}
+
+// ResolveNode calls DOM.resolveNode.
+//
+// Resolves the JavaScript node object for a given NodeId or BackendNodeId.
+func ResolveNode(ctx context.Context, c *internal.Client, sessionID string, params *ResolveNodeParams) (*ResolveNodeResult, error) {
+	if params == nil {
+		params = &ResolveNodeParams{}
+	}
+	var result ResolveNodeResult
+	err := c.Call(ctx, "DOM.resolveNode", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SetAttributeValueParams holds the parameters of DOM.setAttributeValue.
+type SetAttributeValueParams struct {
+	// Id of the element to set attribute for.
+	NodeID NodeID `json:"nodeId"`
+	// Attribute name.
+	Name string `json:"name"`
+	// Attribute value.
+	Value string `json:"value"`
+}
+
+// SetAttributeValue calls DOM.setAttributeValue.
+//
+// Sets attribute for an element with given id.
+func SetAttributeValue(ctx context.Context, c *internal.Client, sessionID string, params *SetAttributeValueParams) error {
+	if params == nil {
+		params = &SetAttributeValueParams{}
+	}
+	return c.Call(ctx, "DOM.setAttributeValue", params, sessionID, nil)
+}
+
+// SetAttributesAsTextParams holds the parameters of DOM.setAttributesAsText.
+type SetAttributesAsTextParams struct {
+	// Id of the element to set attributes for.
+	NodeID NodeID `json:"nodeId"`
+	// Text with a number of attributes. Will parse this text using HTML parser.
+	Text string `json:"text"`
+	// Attribute name to replace with new attributes derived from text in case text parsed
+	// successfully.
+	Name string `json:"name,omitempty"`
+}
+
+// SetAttributesAsText calls DOM.setAttributesAsText.
+//
+// Sets attributes on element with given id. This method is useful when user edits some existing
+// attribute value and types in several attribute name/value pairs.
+func SetAttributesAsText(ctx context.Context, c *internal.Client, sessionID string, params *SetAttributesAsTextParams) error {
+	if params == nil {
+		params = &SetAttributesAsTextParams{}
+	}
+	return c.Call(ctx, "DOM.setAttributesAsText", params, sessionID, nil)
+}
+
+// SetFileInputFilesParams holds the parameters of DOM.setFileInputFiles.
+type SetFileInputFilesParams struct {
+	// Array of file paths to set.
+	Files []string `json:"files"`
+	// Identifier of the node.
+	NodeID *NodeID `json:"nodeId,omitempty"`
+	// Identifier of the backend node.
+	BackendNodeID *BackendNodeID `json:"backendNodeId,omitempty"`
+	// JavaScript object id of the node wrapper.
+	ObjectID runtime.RemoteObjectID `json:"objectId,omitempty"`
+}
+
+// SetFileInputFiles calls DOM.setFileInputFiles.
+//
+// Sets files for the given file input element.
+func SetFileInputFiles(ctx context.Context, c *internal.Client, sessionID string, params *SetFileInputFilesParams) error {
+	if params == nil {
+		params = &SetFileInputFilesParams{}
+	}
+	return c.Call(ctx, "DOM.setFileInputFiles", params, sessionID, nil)
+}
+
+// SetNodeStackTracesEnabledParams holds the parameters of DOM.setNodeStackTracesEnabled.
+type SetNodeStackTracesEnabledParams struct {
+	// Enable or disable.
+	Enable bool `json:"enable"`
+}
+
+// SetNodeStackTracesEnabled calls DOM.setNodeStackTracesEnabled.
+//
+// Sets if stack traces should be captured for Nodes. See `Node.getNodeStackTraces`. Default is disabled.
+//
+// Experimental.
+func SetNodeStackTracesEnabled(ctx context.Context, c *internal.Client, sessionID string, params *SetNodeStackTracesEnabledParams) error {
+	if params == nil {
+		params = &SetNodeStackTracesEnabledParams{}
+	}
+	return c.Call(ctx, "DOM.setNodeStackTracesEnabled", params, sessionID, nil)
+}
+
+// GetNodeStackTracesParams holds the parameters of DOM.getNodeStackTraces.
+type GetNodeStackTracesParams struct {
+	// Id of the node to get stack traces for.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// GetNodeStackTracesResult holds the result of DOM.getNodeStackTraces.
+type GetNodeStackTracesResult struct {
+	// Creation stack trace, if available.
+	Creation *runtime.StackTrace `json:"creation,omitempty"`
+}
+
+// GetNodeStackTraces calls DOM.getNodeStackTraces.
+//
+// Gets stack traces associated with a Node. As of now, only provides stack trace for Node creation.
+//
+// Experimental.
+func GetNodeStackTraces(ctx context.Context, c *internal.Client, sessionID string, params *GetNodeStackTracesParams) (*GetNodeStackTracesResult, error) {
+	if params == nil {
+		params = &GetNodeStackTracesParams{}
+	}
+	var result GetNodeStackTracesResult
+	err := c.Call(ctx, "DOM.getNodeStackTraces", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetFileInfoParams holds the parameters of DOM.getFileInfo.
+type GetFileInfoParams struct {
+	// JavaScript object id of the node wrapper.
+	ObjectID runtime.RemoteObjectID `json:"objectId"`
+}
+
+// GetFileInfoResult holds the result of DOM.getFileInfo.
+type GetFileInfoResult struct {
+	Path string `json:"path"`
+}
+
+// GetFileInfo calls DOM.getFileInfo.
+//
+// Returns file information for the given
+// File wrapper.
+//
+// Experimental.
+func GetFileInfo(ctx context.Context, c *internal.Client, sessionID string, params *GetFileInfoParams) (*GetFileInfoResult, error) {
+	if params == nil {
+		params = &GetFileInfoParams{}
+	}
+	var result GetFileInfoResult
+	err := c.Call(ctx, "DOM.getFileInfo", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetDetachedDOMNodesResult holds the result of DOM.getDetachedDomNodes.
+type GetDetachedDOMNodesResult struct {
+	// The list of detached nodes
+	DetachedNodes []DetachedElementInfo `json:"detachedNodes"`
+}
+
+// GetDetachedDOMNodes calls DOM.getDetachedDomNodes.
+//
+// # Returns list of detached nodes
+//
+// Experimental.
+func GetDetachedDOMNodes(ctx context.Context, c *internal.Client, sessionID string) (*GetDetachedDOMNodesResult, error) {
+	var result GetDetachedDOMNodesResult
+	err := c.Call(ctx, "DOM.getDetachedDomNodes", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SetInspectedNodeParams holds the parameters of DOM.setInspectedNode.
+type SetInspectedNodeParams struct {
+	// DOM node id to be accessible by means of $x command line API.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// SetInspectedNode calls DOM.setInspectedNode.
+//
+// Enables console to refer to the node with given id via $x (see Command Line API for more details
+// $x functions).
+//
+// Experimental.
+func SetInspectedNode(ctx context.Context, c *internal.Client, sessionID string, params *SetInspectedNodeParams) error {
+	if params == nil {
+		params = &SetInspectedNodeParams{}
+	}
+	return c.Call(ctx, "DOM.setInspectedNode", params, sessionID, nil)
+}
+
+// SetNodeNameParams holds the parameters of DOM.setNodeName.
+type SetNodeNameParams struct {
+	// Id of the node to set name for.
+	NodeID NodeID `json:"nodeId"`
+	// New node's name.
+	Name string `json:"name"`
+}
+
+// SetNodeNameResult holds the result of DOM.setNodeName.
+type SetNodeNameResult struct {
+	// New node's id.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// SetNodeName calls DOM.setNodeName.
+//
+// Sets node name for a node with given id.
+func SetNodeName(ctx context.Context, c *internal.Client, sessionID string, params *SetNodeNameParams) (*SetNodeNameResult, error) {
+	if params == nil {
+		params = &SetNodeNameParams{}
+	}
+	var result SetNodeNameResult
+	err := c.Call(ctx, "DOM.setNodeName", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SetNodeValueParams holds the parameters of DOM.setNodeValue.
+type SetNodeValueParams struct {
+	// Id of the node to set value for.
+	NodeID NodeID `json:"nodeId"`
+	// New node's value.
+	Value string `json:"value"`
+}
+
+// SetNodeValue calls DOM.setNodeValue.
+//
+// Sets node value for a node with given id.
+func SetNodeValue(ctx context.Context, c *internal.Client, sessionID string, params *SetNodeValueParams) error {
+	if params == nil {
+		params = &SetNodeValueParams{}
+	}
+	return c.Call(ctx, "DOM.setNodeValue", params, sessionID, nil)
+}
+
+// SetOuterHTMLParams holds the parameters of DOM.setOuterHTML.
+type SetOuterHTMLParams struct {
+	// Id of the node to set markup for.
+	NodeID NodeID `json:"nodeId"`
+	// Outer HTML markup to set.
+	OuterHTML string `json:"outerHTML"`
+}
+
+// SetOuterHTML calls DOM.setOuterHTML.
+//
+// Sets node HTML markup, returns new node id.
+func SetOuterHTML(ctx context.Context, c *internal.Client, sessionID string, params *SetOuterHTMLParams) error {
+	if params == nil {
+		params = &SetOuterHTMLParams{}
+	}
+	return c.Call(ctx, "DOM.setOuterHTML", params, sessionID, nil)
+}
+
+// Undo calls DOM.undo.
+//
+// Undoes the last performed action.
+//
+// Experimental.
+func Undo(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "DOM.undo", nil, sessionID, nil)
+}
+
+// GetFrameOwnerParams holds the parameters of DOM.getFrameOwner.
+type GetFrameOwnerParams struct {
+	FrameID string `json:"frameId"`
+}
+
+// GetFrameOwnerResult holds the result of DOM.getFrameOwner.
+type GetFrameOwnerResult struct {
+	// Resulting node.
+	BackendNodeID BackendNodeID `json:"backendNodeId"`
+	// Id of the node at given coordinates, only when enabled and requested document.
+	NodeID *NodeID `json:"nodeId,omitempty"`
+}
+
+// GetFrameOwner calls DOM.getFrameOwner.
+//
+// Returns iframe node that owns iframe with the given domain.
+//
+// Experimental.
+func GetFrameOwner(ctx context.Context, c *internal.Client, sessionID string, params *GetFrameOwnerParams) (*GetFrameOwnerResult, error) {
+	if params == nil {
+		params = &GetFrameOwnerParams{}
+	}
+	var result GetFrameOwnerResult
+	err := c.Call(ctx, "DOM.getFrameOwner", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetContainerForNodeParams holds the parameters of DOM.getContainerForNode.
+type GetContainerForNodeParams struct {
+	NodeID        NodeID       `json:"nodeId"`
+	ContainerName string       `json:"containerName,omitempty"`
+	PhysicalAxes  PhysicalAxes `json:"physicalAxes,omitempty"`
+	LogicalAxes   LogicalAxes  `json:"logicalAxes,omitempty"`
+}
+
+// GetContainerForNodeResult holds the result of DOM.getContainerForNode.
+type GetContainerForNodeResult struct {
+	// The container node for the given node, or null if not found.
+	NodeID *NodeID `json:"nodeId,omitempty"`
+}
+
+// GetContainerForNode calls DOM.getContainerForNode.
+//
+// Returns the query container of the given node based on container query
+// conditions: containerName, physical, and logical axes. If no axes are
+// provided, the style container is returned, which is the direct parent or the
+// closest element with a matching container-name.
+//
+// Experimental.
+func GetContainerForNode(ctx context.Context, c *internal.Client, sessionID string, params *GetContainerForNodeParams) (*GetContainerForNodeResult, error) {
+	if params == nil {
+		params = &GetContainerForNodeParams{}
+	}
+	var result GetContainerForNodeResult
+	err := c.Call(ctx, "DOM.getContainerForNode", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetQueryingDescendantsForContainerParams holds the parameters of DOM.getQueryingDescendantsForContainer.
+type GetQueryingDescendantsForContainerParams struct {
+	// Id of the container node to find querying descendants from.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// GetQueryingDescendantsForContainerResult holds the result of DOM.getQueryingDescendantsForContainer.
+type GetQueryingDescendantsForContainerResult struct {
+	// Descendant nodes with container queries against the given container.
+	NodeIDs []NodeID `json:"nodeIds"`
+}
+
+// GetQueryingDescendantsForContainer calls DOM.getQueryingDescendantsForContainer.
+//
+// Returns the descendants of a container query container that have
+// container queries against this container.
+//
+// Experimental.
+func GetQueryingDescendantsForContainer(ctx context.Context, c *internal.Client, sessionID string, params *GetQueryingDescendantsForContainerParams) (*GetQueryingDescendantsForContainerResult, error) {
+	if params == nil {
+		params = &GetQueryingDescendantsForContainerParams{}
+	}
+	var result GetQueryingDescendantsForContainerResult
+	err := c.Call(ctx, "DOM.getQueryingDescendantsForContainer", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetAnchorElementParams holds the parameters of DOM.getAnchorElement.
+type GetAnchorElementParams struct {
+	// Id of the positioned element from which to find the anchor.
+	NodeID NodeID `json:"nodeId"`
+	// An optional anchor specifier, as defined in
+	// https://www.w3.org/TR/css-anchor-position-1/#anchor-specifier.
+	// If not provided, it will return the implicit anchor element for
+	// the given positioned element.
+	AnchorSpecifier string `json:"anchorSpecifier,omitempty"`
+}
+
+// GetAnchorElementResult holds the result of DOM.getAnchorElement.
+type GetAnchorElementResult struct {
+	// The anchor element of the given anchor query.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// GetAnchorElement calls DOM.getAnchorElement.
+//
+// Returns the target anchor element of the given anchor query according to
+// https://www.w3.org/TR/css-anchor-position-1/#target.
+//
+// Experimental.
+func GetAnchorElement(ctx context.Context, c *internal.Client, sessionID string, params *GetAnchorElementParams) (*GetAnchorElementResult, error) {
+	if params == nil {
+		params = &GetAnchorElementParams{}
+	}
+	var result GetAnchorElementResult
+	err := c.Call(ctx, "DOM.getAnchorElement", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// EventAttributeModified is the method name of the DOM.attributeModified event.
+const EventAttributeModified = "DOM.attributeModified"
+
+// AttributeModifiedEvent is the payload of the DOM.attributeModified event.
+//
+// Fired when `Element`'s attribute is modified.
+type AttributeModifiedEvent struct {
+	// Id of the node that has changed.
+	NodeID NodeID `json:"nodeId"`
+	// Attribute name.
+	Name string `json:"name"`
+	// Attribute value.
+	Value string `json:"value"`
+}
+
+// EventAttributeRemoved is the method name of the DOM.attributeRemoved event.
+const EventAttributeRemoved = "DOM.attributeRemoved"
+
+// AttributeRemovedEvent is the payload of the DOM.attributeRemoved event.
+//
+// Fired when `Element`'s attribute is removed.
+type AttributeRemovedEvent struct {
+	// Id of the node that has changed.
+	NodeID NodeID `json:"nodeId"`
+	// A ttribute name.
+	Name string `json:"name"`
+}
+
+// EventCharacterDataModified is the method name of the DOM.characterDataModified event.
+const EventCharacterDataModified = "DOM.characterDataModified"
+
+// CharacterDataModifiedEvent is the payload of the DOM.characterDataModified event.
+//
+// Mirrors `DOMCharacterDataModified` event.
+type CharacterDataModifiedEvent struct {
+	// Id of the node that has changed.
+	NodeID NodeID `json:"nodeId"`
+	// New text value.
+	CharacterData string `json:"characterData"`
+}
+
+// EventChildNodeCountUpdated is the method name of the DOM.childNodeCountUpdated event.
+const EventChildNodeCountUpdated = "DOM.childNodeCountUpdated"
+
+// ChildNodeCountUpdatedEvent is the payload of the DOM.childNodeCountUpdated event.
+//
+// Fired when `Container`'s child node count has changed.
+type ChildNodeCountUpdatedEvent struct {
+	// Id of the node that has changed.
+	NodeID NodeID `json:"nodeId"`
+	// New node count.
+	ChildNodeCount int64 `json:"childNodeCount"`
+}
+
+// EventChildNodeInserted is the method name of the DOM.childNodeInserted event.
+const EventChildNodeInserted = "DOM.childNodeInserted"
+
+// ChildNodeInsertedEvent is the payload of the DOM.childNodeInserted event.
+//
+// Mirrors `DOMNodeInserted` event.
+type ChildNodeInsertedEvent struct {
+	// Id of the node that has changed.
+	ParentNodeID NodeID `json:"parentNodeId"`
+	// Id of the previous sibling.
+	PreviousNodeID NodeID `json:"previousNodeId"`
+	// Inserted node data.
+	Node Node `json:"node"`
+}
+
+// EventChildNodeRemoved is the method name of the DOM.childNodeRemoved event.
+const EventChildNodeRemoved = "DOM.childNodeRemoved"
+
+// ChildNodeRemovedEvent is the payload of the DOM.childNodeRemoved event.
+//
+// Mirrors `DOMNodeRemoved` event.
+type ChildNodeRemovedEvent struct {
+	// Parent id.
+	ParentNodeID NodeID `json:"parentNodeId"`
+	// Id of the node that has been removed.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// EventDistributedNodesUpdated is the method name of the DOM.distributedNodesUpdated event.
+const EventDistributedNodesUpdated = "DOM.distributedNodesUpdated"
+
+// DistributedNodesUpdatedEvent is the payload of the DOM.distributedNodesUpdated event.
+//
+// Called when distribution is changed.
+//
+// Experimental.
+type DistributedNodesUpdatedEvent struct {
+	// Insertion point where distributed nodes were updated.
+	InsertionPointID NodeID `json:"insertionPointId"`
+	// Distributed nodes for given insertion point.
+	DistributedNodes []BackendNode `json:"distributedNodes"`
+}
+
+// EventDocumentUpdated is the method name of the DOM.documentUpdated event.
+const EventDocumentUpdated = "DOM.documentUpdated"
+
+// DocumentUpdatedEvent is the payload of the DOM.documentUpdated event.
+//
+// Fired when `Document` has been totally updated. Node ids are no longer valid.
+type DocumentUpdatedEvent struct{}
+
+// EventInlineStyleInvalidated is the method name of the DOM.inlineStyleInvalidated event.
+const EventInlineStyleInvalidated = "DOM.inlineStyleInvalidated"
+
+// InlineStyleInvalidatedEvent is the payload of the DOM.inlineStyleInvalidated event.
+//
+// Fired when `Element`'s inline style is modified via a CSS property modification.
+//
+// Experimental.
+type InlineStyleInvalidatedEvent struct {
+	// Ids of the nodes for which the inline styles have been invalidated.
+	NodeIDs []NodeID `json:"nodeIds"`
+}
+
+// EventPseudoElementAdded is the method name of the DOM.pseudoElementAdded event.
+const EventPseudoElementAdded = "DOM.pseudoElementAdded"
+
+// PseudoElementAddedEvent is the payload of the DOM.pseudoElementAdded event.
+//
+// Called when a pseudo element is added to an element.
+//
+// Experimental.
+type PseudoElementAddedEvent struct {
+	// Pseudo element's parent element id.
+	ParentID NodeID `json:"parentId"`
+	// The added pseudo element.
+	PseudoElement Node `json:"pseudoElement"`
+}
+
+// EventTopLayerElementsUpdated is the method name of the DOM.topLayerElementsUpdated event.
+const EventTopLayerElementsUpdated = "DOM.topLayerElementsUpdated"
+
+// TopLayerElementsUpdatedEvent is the payload of the DOM.topLayerElementsUpdated event.
+//
+// Called when top layer elements are changed.
+//
+// Experimental.
+type TopLayerElementsUpdatedEvent struct{}
+
+// EventPseudoElementRemoved is the method name of the DOM.pseudoElementRemoved event.
+const EventPseudoElementRemoved = "DOM.pseudoElementRemoved"
+
+// PseudoElementRemovedEvent is the payload of the DOM.pseudoElementRemoved event.
+//
+// Called when a pseudo element is removed from an element.
+//
+// Experimental.
+type PseudoElementRemovedEvent struct {
+	// Pseudo element's parent element id.
+	ParentID NodeID `json:"parentId"`
+	// The removed pseudo element id.
+	PseudoElementID NodeID `json:"pseudoElementId"`
+}
+
+// EventSetChildNodes is the method name of the DOM.setChildNodes event.
+const EventSetChildNodes = "DOM.setChildNodes"
+
+// SetChildNodesEvent is the payload of the DOM.setChildNodes event.
+//
+// Fired when backend wants to provide client with the missing DOM structure. This happens upon
+// most of the calls requesting node ids.
+type SetChildNodesEvent struct {
+	// Parent node id to populate with children.
+	ParentID NodeID `json:"parentId"`
+	// Child nodes array.
+	Nodes []Node `json:"nodes"`
+}
+
+// EventShadowRootPopped is the method name of the DOM.shadowRootPopped event.
+const EventShadowRootPopped = "DOM.shadowRootPopped"
+
+// ShadowRootPoppedEvent is the payload of the DOM.shadowRootPopped event.
+//
+// Called when shadow root is popped from the element.
+//
+// Experimental.
+type ShadowRootPoppedEvent struct {
+	// Host element id.
+	HostID NodeID `json:"hostId"`
+	// Shadow root id.
+	RootID NodeID `json:"rootId"`
+}
+
+// EventShadowRootPushed is the method name of the DOM.shadowRootPushed event.
+const EventShadowRootPushed = "DOM.shadowRootPushed"
+
+// ShadowRootPushedEvent is the payload of the DOM.shadowRootPushed event.
+//
+// Called when shadow root is pushed into the element.
+//
+// Experimental.
+type ShadowRootPushedEvent struct {
+	// Host element id.
+	HostID NodeID `json:"hostId"`
+	// Shadow root.
+	Root Node `json:"root"`
+}
+
+// ParseEvent decodes a DOM event message into its typed event struct.
+func ParseEvent(msg *internal.CDPMessage) (any, error) {
+	var v any
+	switch msg.Method {
+	case EventAttributeModified:
+		v = &AttributeModifiedEvent{}
+	case EventAttributeRemoved:
+		v = &AttributeRemovedEvent{}
+	case EventCharacterDataModified:
+		v = &CharacterDataModifiedEvent{}
+	case EventChildNodeCountUpdated:
+		v = &ChildNodeCountUpdatedEvent{}
+	case EventChildNodeInserted:
+		v = &ChildNodeInsertedEvent{}
+	case EventChildNodeRemoved:
+		v = &ChildNodeRemovedEvent{}
+	case EventDistributedNodesUpdated:
+		v = &DistributedNodesUpdatedEvent{}
+	case EventDocumentUpdated:
+		v = &DocumentUpdatedEvent{}
+	case EventInlineStyleInvalidated:
+		v = &InlineStyleInvalidatedEvent{}
+	case EventPseudoElementAdded:
+		v = &PseudoElementAddedEvent{}
+	case EventTopLayerElementsUpdated:
+		v = &TopLayerElementsUpdatedEvent{}
+	case EventPseudoElementRemoved:
+		v = &PseudoElementRemovedEvent{}
+	case EventSetChildNodes:
+		v = &SetChildNodesEvent{}
+	case EventShadowRootPopped:
+		v = &ShadowRootPoppedEvent{}
+	case EventShadowRootPushed:
+		v = &ShadowRootPushedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown DOM event: %s", msg.Method)
+	}
+	if msg.Params == nil {
+		return v, nil
+	}
+	err := json.Unmarshal(msg.Params, v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,267 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package domdebugger provides typed bindings for the CDP DOMDebugger domain.
+//
+// DOM debugging allows setting breakpoints on particular DOM operations and events. JavaScript
+// execution will stop on these operations as if there was a regular breakpoint set.
+package domdebugger
+
+import (
+	"cdp/internal"
+	"cdp/protocol/dom"
+	"cdp/protocol/runtime"
+	"context"
+)
+
+// DOMBreakpointType is the DOMDebugger.DOMBreakpointType type.
+//
+// DOM breakpoint type.
+type DOMBreakpointType string
+
+const (
+	DOMBreakpointTypeSubtreeModified   DOMBreakpointType = "subtree-modified"
+	DOMBreakpointTypeAttributeModified DOMBreakpointType = "attribute-modified"
+	DOMBreakpointTypeNodeRemoved       DOMBreakpointType = "node-removed"
+)
+
+// CSPViolationType is the DOMDebugger.CSPViolationType type.
+//
+// CSP Violation type.
+//
+// Experimental.
+type CSPViolationType string
+
+const (
+	CSPViolationTypeTrustedtypeSinkViolation   CSPViolationType = "trustedtype-sink-violation"
+	CSPViolationTypeTrustedtypePolicyViolation CSPViolationType = "trustedtype-policy-violation"
+)
+
+// EventListener is the DOMDebugger.EventListener type.
+//
+// Object event listener.
+type EventListener struct {
+	// `EventListener`'s type.
+	Type string `json:"type"`
+	// `EventListener`'s useCapture.
+	UseCapture bool `json:"useCapture"`
+	// `EventListener`'s passive flag.
+	Passive bool `json:"passive"`
+	// `EventListener`'s once flag.
+	Once bool `json:"once"`
+	// Script id of the handler code.
+	ScriptID runtime.ScriptID `json:"scriptId"`
+	// Line number in the script (0-based).
+	LineNumber int64 `json:"lineNumber"`
+	// Column number in the script (0-based).
+	ColumnNumber int64 `json:"columnNumber"`
+	// Event handler function value.
+	Handler *runtime.RemoteObject `json:"handler,omitempty"`
+	// Event original handler function value.
+	OriginalHandler *runtime.RemoteObject `json:"originalHandler,omitempty"`
+	// Node the listener is added to (if any).
+	BackendNodeID *dom.BackendNodeID `json:"backendNodeId,omitempty"`
+}
+
+// GetEventListenersParams holds the parameters of DOMDebugger.getEventListeners.
+type GetEventListenersParams struct {
+	// Identifier of the object to return listeners for.
+	ObjectID runtime.RemoteObjectID `json:"objectId"`
+	// The maximum depth at which Node children should be retrieved, defaults to 1. Use -1 for the
+	// entire subtree or provide an integer larger than 0.
+	Depth *int64 `json:"depth,omitempty"`
+	// Whether or not iframes and shadow roots should be traversed when returning the subtree
+	// (default is false). Reports listeners for all contexts if pierce is enabled.
+	Pierce *bool `json:"pierce,omitempty"`
+}
+
+// GetEventListenersResult holds the result of DOMDebugger.getEventListeners.
+type GetEventListenersResult struct {
+	// Array of relevant listeners.
+	Listeners []EventListener `json:"listeners"`
+}
+
+// GetEventListeners calls DOMDebugger.getEventListeners.
+//
+// Returns event listeners of the given object.
+func GetEventListeners(ctx context.Context, c *internal.Client, sessionID string, params *GetEventListenersParams) (*GetEventListenersResult, error) {
+	if params == nil {
+		params = &GetEventListenersParams{}
+	}
+	var result GetEventListenersResult
+	err := c.Call(ctx, "DOMDebugger.getEventListeners", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// RemoveDOMBreakpointParams holds the parameters of DOMDebugger.removeDOMBreakpoint.
+type RemoveDOMBreakpointParams struct {
+	// Identifier of the node to remove breakpoint from.
+	NodeID dom.NodeID `json:"nodeId"`
+	// Type of the breakpoint to remove.
+	Type DOMBreakpointType `json:"type"`
+}
+
+// RemoveDOMBreakpoint calls DOMDebugger.removeDOMBreakpoint.
+//
+// Removes DOM breakpoint that was set using `setDOMBreakpoint`.
+func RemoveDOMBreakpoint(ctx context.Context, c *internal.Client, sessionID string, params *RemoveDOMBreakpointParams) error {
+	if params == nil {
+		params = &RemoveDOMBreakpointParams{}
+	}
+	return c.Call(ctx, "DOMDebugger.removeDOMBreakpoint", params, sessionID, nil)
+}
+
+// RemoveEventListenerBreakpointParams holds the parameters of DOMDebugger.removeEventListenerBreakpoint.
+type RemoveEventListenerBreakpointParams struct {
+	// Event name.
+	EventName string `json:"eventName"`
+	// EventTarget interface name.
+	//
+	// Experimental.
+	TargetName string `json:"targetName,omitempty"`
+}
+
+// RemoveEventListenerBreakpoint calls DOMDebugger.removeEventListenerBreakpoint.
+//
+// Removes breakpoint on particular DOM event.
+func RemoveEventListenerBreakpoint(ctx context.Context, c *internal.Client, sessionID string, params *RemoveEventListenerBreakpointParams) error {
+	if params == nil {
+		params = &RemoveEventListenerBreakpointParams{}
+	}
+	return c.Call(ctx, "DOMDebugger.removeEventListenerBreakpoint", params, sessionID, nil)
+}
+
+// RemoveInstrumentationBreakpointParams holds the parameters of DOMDebugger.removeInstrumentationBreakpoint.
+type RemoveInstrumentationBreakpointParams struct {
+	// Instrumentation name to stop on.
+	EventName string `json:"eventName"`
+}
+
+// RemoveInstrumentationBreakpoint calls DOMDebugger.removeInstrumentationBreakpoint.
+//
+// Removes breakpoint on particular native event.
+//
+// Experimental.
+//
+// Deprecated: this item is deprecated in the protocol.
+//
+// Redirects to EventBreakpoints.
+func RemoveInstrumentationBreakpoint(ctx context.Context, c *internal.Client, sessionID string, params *RemoveInstrumentationBreakpointParams) error {
+	if params == nil {
+		params = &RemoveInstrumentationBreakpointParams{}
+	}
+	return c.Call(ctx, "DOMDebugger.removeInstrumentationBreakpoint", params, sessionID, nil)
+}
+
+// RemoveXHRBreakpointParams holds the parameters of DOMDebugger.removeXHRBreakpoint.
+type RemoveXHRBreakpointParams struct {
+	// Resource URL substring.
+	URL string `json:"url"`
+}
+
+// RemoveXHRBreakpoint calls DOMDebugger.removeXHRBreakpoint.
+//
+// Removes breakpoint from XMLHttpRequest.
+func RemoveXHRBreakpoint(ctx context.Context, c *internal.Client, sessionID string, params *RemoveXHRBreakpointParams) error {
+	if params == nil {
+		params = &RemoveXHRBreakpointParams{}
+	}
+	return c.Call(ctx, "DOMDebugger.removeXHRBreakpoint", params, sessionID, nil)
+}
+
+// SetBreakOnCSPViolationParams holds the parameters of DOMDebugger.setBreakOnCSPViolation.
+type SetBreakOnCSPViolationParams struct {
+	// CSP Violations to stop upon.
+	ViolationTypes []CSPViolationType `json:"violationTypes"`
+}
+
+// SetBreakOnCSPViolation calls DOMDebugger.setBreakOnCSPViolation.
+//
+// Sets breakpoint on particular CSP violations.
+//
+// Experimental.
+func SetBreakOnCSPViolation(ctx context.Context, c *internal.Client, sessionID string, params *SetBreakOnCSPViolationParams) error {
+	if params == nil {
+		params = &SetBreakOnCSPViolationParams{}
+	}
+	return c.Call(ctx, "DOMDebugger.setBreakOnCSPViolation", params, sessionID, nil)
+}
+
+// SetDOMBreakpointParams holds the parameters of DOMDebugger.setDOMBreakpoint.
+type SetDOMBreakpointParams struct {
+	// Identifier of the node to set breakpoint on.
+	NodeID dom.NodeID `json:"nodeId"`
+	// Type of the operation to stop upon.
+	Type DOMBreakpointType `json:"type"`
+}
+
+// SetDOMBreakpoint calls DOMDebugger.setDOMBreakpoint.
+//
+// Sets breakpoint on particular operation with DOM.
+func SetDOMBreakpoint(ctx context.Context, c *internal.Client, sessionID string, params *SetDOMBreakpointParams) error {
+	if params == nil {
+		params = &SetDOMBreakpointParams{}
+	}
+	return c.Call(ctx, "DOMDebugger.setDOMBreakpoint", params, sessionID, nil)
+}
+
+// SetEventListenerBreakpointParams holds the parameters of DOMDebugger.setEventListenerBreakpoint.
+type SetEventListenerBreakpointParams struct {
+	// DOM Event name to stop on (any DOM event will do).
+	EventName string `json:"eventName"`
+	// EventTarget interface name to stop on. If equal to `"*"` or not provided, will stop on any
+	// EventTarget.
+	//
+	// Experimental.
+	TargetName string `json:"targetName,omitempty"`
+}
+
+// SetEventListenerBreakpoint calls DOMDebugger.setEventListenerBreakpoint.
+//
+// Sets breakpoint on particular DOM event.
+func SetEventListenerBreakpoint(ctx context.Context, c *internal.Client, sessionID string, params *SetEventListenerBreakpointParams) error {
+	if params == nil {
+		params = &SetEventListenerBreakpointParams{}
+	}
+	return c.Call(ctx, "DOMDebugger.setEventListenerBreakpoint", params, sessionID, nil)
+}
+
+// SetInstrumentationBreakpointParams holds the parameters of DOMDebugger.setInstrumentationBreakpoint.
+type SetInstrumentationBreakpointParams struct {
+	// Instrumentation name to stop on.
+	EventName string `json:"eventName"`
+}
+
+// SetInstrumentationBreakpoint calls DOMDebugger.setInstrumentationBreakpoint.
+//
+// Sets breakpoint on particular native event.
+//
+// Experimental.
+//
+// Deprecated: this item is deprecated in the protocol.
+//
+// Redirects to EventBreakpoints.
+func SetInstrumentationBreakpoint(ctx context.Context, c *internal.Client, sessionID string, params *SetInstrumentationBreakpointParams) error {
+	if params == nil {
+		params = &SetInstrumentationBreakpointParams{}
+	}
+	return c.Call(ctx, "DOMDebugger.setInstrumentationBreakpoint", params, sessionID, nil)
+}
+
+// SetXHRBreakpointParams holds the parameters of DOMDebugger.setXHRBreakpoint.
+type SetXHRBreakpointParams struct {
+	// Resource URL substring. All XHRs having this substring in the URL will get stopped upon.
+	URL string `json:"url"`
+}
+
+// SetXHRBreakpoint calls DOMDebugger.setXHRBreakpoint.
+//
+// Sets breakpoint on XMLHttpRequest.
+func SetXHRBreakpoint(ctx context.Context, c *internal.Client, sessionID string, params *SetXHRBreakpointParams) error {
+	if params == nil {
+		params = &SetXHRBreakpointParams{}
+	}
+	return c.Call(ctx, "DOMDebugger.setXHRBreakpoint", params, sessionID, nil)
+}
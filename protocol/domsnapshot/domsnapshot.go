@@ -0,0 +1,402 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package domsnapshot provides typed bindings for the CDP DOMSnapshot domain.
+//
+// This domain facilitates obtaining document snapshots with DOM, layout, and style information.
+package domsnapshot
+
+import (
+	"cdp/internal"
+	"cdp/protocol/dom"
+	"cdp/protocol/domdebugger"
+	"cdp/protocol/page"
+	"context"
+)
+
+// DOMNode is the DOMSnapshot.DOMNode type.
+//
+// A Node in the DOM tree.
+type DOMNode struct {
+	// `Node`'s nodeType.
+	NodeType int64 `json:"nodeType"`
+	// `Node`'s nodeName.
+	NodeName string `json:"nodeName"`
+	// `Node`'s nodeValue.
+	NodeValue string `json:"nodeValue"`
+	// Only set for textarea elements, contains the text value.
+	TextValue string `json:"textValue,omitempty"`
+	// Only set for input elements, contains the input's associated text value.
+	InputValue string `json:"inputValue,omitempty"`
+	// Only set for radio and checkbox input elements, indicates if the element has been checked
+	InputChecked *bool `json:"inputChecked,omitempty"`
+	// Only set for option elements, indicates if the element has been selected
+	OptionSelected *bool `json:"optionSelected,omitempty"`
+	// `Node`'s id, corresponds to DOM.Node.backendNodeId.
+	BackendNodeID dom.BackendNodeID `json:"backendNodeId"`
+	// The indexes of the node's child nodes in the `domNodes` array returned by `getSnapshot`, if
+	// any.
+	ChildNodeIndexes []int64 `json:"childNodeIndexes,omitempty"`
+	// Attributes of an `Element` node.
+	Attributes []NameValue `json:"attributes,omitempty"`
+	// Indexes of pseudo elements associated with this node in the `domNodes` array returned by
+	// `getSnapshot`, if any.
+	PseudoElementIndexes []int64 `json:"pseudoElementIndexes,omitempty"`
+	// The index of the node's related layout tree node in the `layoutTreeNodes` array returned by
+	// `getSnapshot`, if any.
+	LayoutNodeIndex *int64 `json:"layoutNodeIndex,omitempty"`
+	// Document URL that `Document` or `FrameOwner` node points to.
+	DocumentURL string `json:"documentURL,omitempty"`
+	// Base URL that `Document` or `FrameOwner` node uses for URL completion.
+	BaseURL string `json:"baseURL,omitempty"`
+	// Only set for documents, contains the document's content language.
+	ContentLanguage string `json:"contentLanguage,omitempty"`
+	// Only set for documents, contains the document's character set encoding.
+	DocumentEncoding string `json:"documentEncoding,omitempty"`
+	// `DocumentType` node's publicId.
+	PublicID string `json:"publicId,omitempty"`
+	// `DocumentType` node's systemId.
+	SystemID string `json:"systemId,omitempty"`
+	// Frame ID for frame owner elements and also for the document node.
+	FrameID page.FrameID `json:"frameId,omitempty"`
+	// The index of a frame owner element's content document in the `domNodes` array returned by
+	// `getSnapshot`, if any.
+	ContentDocumentIndex *int64 `json:"contentDocumentIndex,omitempty"`
+	// Type of a pseudo element node.
+	PseudoType dom.PseudoType `json:"pseudoType,omitempty"`
+	// Shadow root type.
+	ShadowRootType dom.ShadowRootType `json:"shadowRootType,omitempty"`
+	// Whether this DOM node responds to mouse clicks. This includes nodes that have had click
+	// event listeners attached via JavaScript as well as anchor tags that naturally navigate when
+	// clicked.
+	IsClickable *bool `json:"isClickable,omitempty"`
+	// Details of the node's event listeners, if any.
+	EventListeners []domdebugger.EventListener `json:"eventListeners,omitempty"`
+	// The selected url for nodes with a srcset attribute.
+	CurrentSourceURL string `json:"currentSourceURL,omitempty"`
+	// The url of the script (if any) that generates this node.
+	OriginURL string `json:"originURL,omitempty"`
+	// Scroll offsets, set when this node is a Document.
+	ScrollOffsetX *float64 `json:"scrollOffsetX,omitempty"`
+	ScrollOffsetY *float64 `json:"scrollOffsetY,omitempty"`
+}
+
+// InlineTextBox is the DOMSnapshot.InlineTextBox type.
+//
+// Details of post layout rendered text positions. The exact layout should not be regarded as
+// stable and may change between versions.
+type InlineTextBox struct {
+	// The bounding box in document coordinates. Note that scroll offset of the document is ignored.
+	BoundingBox dom.Rect `json:"boundingBox"`
+	// The starting index in characters, for this post layout textbox substring. Characters that
+	// would be represented as a surrogate pair in UTF-16 have length 2.
+	StartCharacterIndex int64 `json:"startCharacterIndex"`
+	// The number of characters in this post layout textbox substring. Characters that would be
+	// represented as a surrogate pair in UTF-16 have length 2.
+	NumCharacters int64 `json:"numCharacters"`
+}
+
+// LayoutTreeNode is the DOMSnapshot.LayoutTreeNode type.
+//
+// Details of an element in the DOM tree with a LayoutObject.
+type LayoutTreeNode struct {
+	// The index of the related DOM node in the `domNodes` array returned by `getSnapshot`.
+	DOMNodeIndex int64 `json:"domNodeIndex"`
+	// The bounding box in document coordinates. Note that scroll offset of the document is ignored.
+	BoundingBox dom.Rect `json:"boundingBox"`
+	// Contents of the LayoutText, if any.
+	LayoutText string `json:"layoutText,omitempty"`
+	// The post-layout inline text nodes, if any.
+	InlineTextNodes []InlineTextBox `json:"inlineTextNodes,omitempty"`
+	// Index into the `computedStyles` array returned by `getSnapshot`.
+	StyleIndex *int64 `json:"styleIndex,omitempty"`
+	// Global paint order index, which is determined by the stacking order of the nodes. Nodes
+	// that are painted together will have the same index. Only provided if includePaintOrder in
+	// getSnapshot was true.
+	PaintOrder *int64 `json:"paintOrder,omitempty"`
+	// Set to true to indicate the element begins a new stacking context.
+	IsStackingContext *bool `json:"isStackingContext,omitempty"`
+}
+
+// ComputedStyle is the DOMSnapshot.ComputedStyle type.
+//
+// A subset of the full ComputedStyle as defined by the request whitelist.
+type ComputedStyle struct {
+	// Name/value pairs of computed style properties.
+	Properties []NameValue `json:"properties"`
+}
+
+// NameValue is the DOMSnapshot.NameValue type.
+//
+// A name/value pair.
+type NameValue struct {
+	// Attribute/property name.
+	Name string `json:"name"`
+	// Attribute/property value.
+	Value string `json:"value"`
+}
+
+// StringIndex is the DOMSnapshot.StringIndex type.
+//
+// Index of the string in the strings table.
+type StringIndex int64
+
+// ArrayOfStrings is the DOMSnapshot.ArrayOfStrings type.
+//
+// Index of the string in the strings table.
+type ArrayOfStrings []StringIndex
+
+// RareStringData is the DOMSnapshot.RareStringData type.
+//
+// Data that is only present on rare nodes.
+type RareStringData struct {
+	Index []int64       `json:"index"`
+	Value []StringIndex `json:"value"`
+}
+
+// RareBooleanData is the DOMSnapshot.RareBooleanData type.
+type RareBooleanData struct {
+	Index []int64 `json:"index"`
+}
+
+// RareIntegerData is the DOMSnapshot.RareIntegerData type.
+type RareIntegerData struct {
+	Index []int64 `json:"index"`
+	Value []int64 `json:"value"`
+}
+
+// Rectangle is the DOMSnapshot.Rectangle type.
+type Rectangle []float64
+
+// DocumentSnapshot is the DOMSnapshot.DocumentSnapshot type.
+//
+// Document snapshot.
+type DocumentSnapshot struct {
+	// Document URL that `Document` or `FrameOwner` node points to.
+	DocumentURL StringIndex `json:"documentURL"`
+	// Document title.
+	Title StringIndex `json:"title"`
+	// Base URL that `Document` or `FrameOwner` node uses for URL completion.
+	BaseURL StringIndex `json:"baseURL"`
+	// Contains the document's content language.
+	ContentLanguage StringIndex `json:"contentLanguage"`
+	// Contains the document's character set encoding.
+	EncodingName StringIndex `json:"encodingName"`
+	// `DocumentType` node's publicId.
+	PublicID StringIndex `json:"publicId"`
+	// `DocumentType` node's systemId.
+	SystemID StringIndex `json:"systemId"`
+	// Frame ID for frame owner elements and also for the document node.
+	FrameID StringIndex `json:"frameId"`
+	// A table with dom nodes.
+	Nodes NodeTreeSnapshot `json:"nodes"`
+	// The nodes in the layout tree.
+	Layout LayoutTreeSnapshot `json:"layout"`
+	// The post-layout inline text nodes.
+	TextBoxes TextBoxSnapshot `json:"textBoxes"`
+	// Horizontal scroll offset.
+	ScrollOffsetX *float64 `json:"scrollOffsetX,omitempty"`
+	// Vertical scroll offset.
+	ScrollOffsetY *float64 `json:"scrollOffsetY,omitempty"`
+	// Document content width.
+	ContentWidth *float64 `json:"contentWidth,omitempty"`
+	// Document content height.
+	ContentHeight *float64 `json:"contentHeight,omitempty"`
+}
+
+// NodeTreeSnapshot is the DOMSnapshot.NodeTreeSnapshot type.
+//
+// Table containing nodes.
+type NodeTreeSnapshot struct {
+	// Parent node index.
+	ParentIndex []int64 `json:"parentIndex,omitempty"`
+	// `Node`'s nodeType.
+	NodeType []int64 `json:"nodeType,omitempty"`
+	// Type of the shadow root the `Node` is in. String values are equal to the `ShadowRootType` enum.
+	ShadowRootType *RareStringData `json:"shadowRootType,omitempty"`
+	// `Node`'s nodeName.
+	NodeName []StringIndex `json:"nodeName,omitempty"`
+	// `Node`'s nodeValue.
+	NodeValue []StringIndex `json:"nodeValue,omitempty"`
+	// `Node`'s id, corresponds to DOM.Node.backendNodeId.
+	BackendNodeID []dom.BackendNodeID `json:"backendNodeId,omitempty"`
+	// Attributes of an `Element` node. Flatten name, value pairs.
+	Attributes []ArrayOfStrings `json:"attributes,omitempty"`
+	// Only set for textarea elements, contains the text value.
+	TextValue *RareStringData `json:"textValue,omitempty"`
+	// Only set for input elements, contains the input's associated text value.
+	InputValue *RareStringData `json:"inputValue,omitempty"`
+	// Only set for radio and checkbox input elements, indicates if the element has been checked
+	InputChecked *RareBooleanData `json:"inputChecked,omitempty"`
+	// Only set for option elements, indicates if the element has been selected
+	OptionSelected *RareBooleanData `json:"optionSelected,omitempty"`
+	// The index of the document in the list of the snapshot documents.
+	ContentDocumentIndex *RareIntegerData `json:"contentDocumentIndex,omitempty"`
+	// Type of a pseudo element node.
+	PseudoType *RareStringData `json:"pseudoType,omitempty"`
+	// Pseudo element identifier for this node. Only present if there is a
+	// valid pseudoType.
+	PseudoIdentifier *RareStringData `json:"pseudoIdentifier,omitempty"`
+	// Whether this DOM node responds to mouse clicks. This includes nodes that have had click
+	// event listeners attached via JavaScript as well as anchor tags that naturally navigate when
+	// clicked.
+	IsClickable *RareBooleanData `json:"isClickable,omitempty"`
+	// The selected url for nodes with a srcset attribute.
+	CurrentSourceURL *RareStringData `json:"currentSourceURL,omitempty"`
+	// The url of the script (if any) that generates this node.
+	OriginURL *RareStringData `json:"originURL,omitempty"`
+}
+
+// LayoutTreeSnapshot is the DOMSnapshot.LayoutTreeSnapshot type.
+//
+// Table of details of an element in the DOM tree with a LayoutObject.
+type LayoutTreeSnapshot struct {
+	// Index of the corresponding node in the `NodeTreeSnapshot` array returned by `captureSnapshot`.
+	NodeIndex []int64 `json:"nodeIndex"`
+	// Array of indexes specifying computed style strings, filtered according to the `computedStyles` parameter passed to `captureSnapshot`.
+	Styles []ArrayOfStrings `json:"styles"`
+	// The absolute position bounding box.
+	Bounds []Rectangle `json:"bounds"`
+	// Contents of the LayoutText, if any.
+	Text []StringIndex `json:"text"`
+	// Stacking context information.
+	StackingContexts RareBooleanData `json:"stackingContexts"`
+	// Global paint order index, which is determined by the stacking order of the nodes. Nodes
+	// that are painted together will have the same index. Only provided if includePaintOrder in
+	// captureSnapshot was true.
+	PaintOrders []int64 `json:"paintOrders,omitempty"`
+	// The offset rect of nodes. Only available when includeDOMRects is set to true
+	OffsetRects []Rectangle `json:"offsetRects,omitempty"`
+	// The scroll rect of nodes. Only available when includeDOMRects is set to true
+	ScrollRects []Rectangle `json:"scrollRects,omitempty"`
+	// The client rect of nodes. Only available when includeDOMRects is set to true
+	ClientRects []Rectangle `json:"clientRects,omitempty"`
+	// The list of background colors that are blended with colors of overlapping elements.
+	//
+	// Experimental.
+	BlendedBackgroundColors []StringIndex `json:"blendedBackgroundColors,omitempty"`
+	// The list of computed text opacities.
+	//
+	// Experimental.
+	TextColorOpacities []float64 `json:"textColorOpacities,omitempty"`
+}
+
+// TextBoxSnapshot is the DOMSnapshot.TextBoxSnapshot type.
+//
+// Table of details of the post layout rendered text positions. The exact layout should not be regarded as
+// stable and may change between versions.
+type TextBoxSnapshot struct {
+	// Index of the layout tree node that owns this box collection.
+	LayoutIndex []int64 `json:"layoutIndex"`
+	// The absolute position bounding box.
+	Bounds []Rectangle `json:"bounds"`
+	// The starting index in characters, for this post layout textbox substring. Characters that
+	// would be represented as a surrogate pair in UTF-16 have length 2.
+	Start []int64 `json:"start"`
+	// The number of characters in this post layout textbox substring. Characters that would be
+	// represented as a surrogate pair in UTF-16 have length 2.
+	Length []int64 `json:"length"`
+}
+
+// Disable calls DOMSnapshot.disable.
+//
+// Disables DOM snapshot agent for the given page.
+func Disable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "DOMSnapshot.disable", nil, sessionID, nil)
+}
+
+// Enable calls DOMSnapshot.enable.
+//
+// Enables DOM snapshot agent for the given page.
+func Enable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "DOMSnapshot.enable", nil, sessionID, nil)
+}
+
+// GetSnapshotParams holds the parameters of DOMSnapshot.getSnapshot.
+type GetSnapshotParams struct {
+	// Whitelist of computed styles to return.
+	ComputedStyleWhitelist []string `json:"computedStyleWhitelist"`
+	// Whether or not to retrieve details of DOM listeners (default false).
+	IncludeEventListeners *bool `json:"includeEventListeners,omitempty"`
+	// Whether to determine and include the paint order index of LayoutTreeNodes (default false).
+	IncludePaintOrder *bool `json:"includePaintOrder,omitempty"`
+	// Whether to include UA shadow tree in the snapshot (default false).
+	IncludeUserAgentShadowTree *bool `json:"includeUserAgentShadowTree,omitempty"`
+}
+
+// GetSnapshotResult holds the result of DOMSnapshot.getSnapshot.
+type GetSnapshotResult struct {
+	// The nodes in the DOM tree. The DOMNode at index 0 corresponds to the root document.
+	DOMNodes []DOMNode `json:"domNodes"`
+	// The nodes in the layout tree.
+	LayoutTreeNodes []LayoutTreeNode `json:"layoutTreeNodes"`
+	// Whitelisted ComputedStyle properties for each node in the layout tree.
+	ComputedStyles []ComputedStyle `json:"computedStyles"`
+}
+
+// GetSnapshot calls DOMSnapshot.getSnapshot.
+//
+// Returns a document snapshot, including the full DOM tree of the root node (including iframes,
+// template contents, and imported documents) in a flattened array, as well as layout and
+// white-listed computed style information for the nodes. Shadow DOM in the returned DOM tree is
+// flattened.
+//
+// Deprecated: this item is deprecated in the protocol.
+func GetSnapshot(ctx context.Context, c *internal.Client, sessionID string, params *GetSnapshotParams) (*GetSnapshotResult, error) {
+	if params == nil {
+		params = &GetSnapshotParams{}
+	}
+	var result GetSnapshotResult
+	err := c.Call(ctx, "DOMSnapshot.getSnapshot", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CaptureSnapshotParams holds the parameters of DOMSnapshot.captureSnapshot.
+type CaptureSnapshotParams struct {
+	// Whitelist of computed styles to return.
+	ComputedStyles []string `json:"computedStyles"`
+	// Whether to include layout object paint orders into the snapshot.
+	IncludePaintOrder *bool `json:"includePaintOrder,omitempty"`
+	// Whether to include DOM rectangles (offsetRects, clientRects, scrollRects) into the snapshot
+	IncludeDOMRects *bool `json:"includeDOMRects,omitempty"`
+	// Whether to include blended background colors in the snapshot (default: false).
+	// Blended background color is achieved by blending background colors of all elements
+	// that overlap with the current element.
+	//
+	// Experimental.
+	IncludeBlendedBackgroundColors *bool `json:"includeBlendedBackgroundColors,omitempty"`
+	// Whether to include text color opacity in the snapshot (default: false).
+	// An element might have the opacity property set that affects the text color of the element.
+	// The final text color opacity is computed based on the opacity of all overlapping elements.
+	//
+	// Experimental.
+	IncludeTextColorOpacities *bool `json:"includeTextColorOpacities,omitempty"`
+}
+
+// CaptureSnapshotResult holds the result of DOMSnapshot.captureSnapshot.
+type CaptureSnapshotResult struct {
+	// The nodes in the DOM tree. The DOMNode at index 0 corresponds to the root document.
+	Documents []DocumentSnapshot `json:"documents"`
+	// Shared string table that all string properties refer to with indexes.
+	Strings []string `json:"strings"`
+}
+
+// CaptureSnapshot calls DOMSnapshot.captureSnapshot.
+//
+// Returns a document snapshot, including the full DOM tree of the root node (including iframes,
+// template contents, and imported documents) in a flattened array, as well as layout and
+// white-listed computed style information for the nodes. Shadow DOM in the returned DOM tree is
+// flattened.
+func CaptureSnapshot(ctx context.Context, c *internal.Client, sessionID string, params *CaptureSnapshotParams) (*CaptureSnapshotResult, error) {
+	if params == nil {
+		params = &CaptureSnapshotParams{}
+	}
+	var result CaptureSnapshotResult
+	err := c.Call(ctx, "DOMSnapshot.captureSnapshot", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,175 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package domstorage provides typed bindings for the CDP DOMStorage domain.
+//
+// Query and modify DOM storage.
+package domstorage
+
+import (
+	"cdp/internal"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// SerializedStorageKey is the DOMStorage.SerializedStorageKey type.
+type SerializedStorageKey string
+
+// StorageID is the DOMStorage.StorageId type.
+//
+// DOM Storage identifier.
+type StorageID struct {
+	// Security origin for the storage.
+	SecurityOrigin string `json:"securityOrigin,omitempty"`
+	// Represents a key by which DOM Storage keys its CachedStorageAreas
+	StorageKey SerializedStorageKey `json:"storageKey,omitempty"`
+	// Whether the storage is local storage (not session storage).
+	IsLocalStorage bool `json:"isLocalStorage"`
+}
+
+// Item is the DOMStorage.Item type.
+//
+// DOM Storage item.
+type Item []string
+
+// ClearParams holds the parameters of DOMStorage.clear.
+type ClearParams struct {
+	StorageID StorageID `json:"storageId"`
+}
+
+// Clear calls DOMStorage.clear.
+func Clear(ctx context.Context, c *internal.Client, sessionID string, params *ClearParams) error {
+	if params == nil {
+		params = &ClearParams{}
+	}
+	return c.Call(ctx, "DOMStorage.clear", params, sessionID, nil)
+}
+
+// Disable calls DOMStorage.disable.
+//
+// Disables storage tracking, prevents storage events from being sent to the client.
+func Disable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "DOMStorage.disable", nil, sessionID, nil)
+}
+
+// Enable calls DOMStorage.enable.
+//
+// Enables storage tracking, storage events will now be delivered to the client.
+func Enable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "DOMStorage.enable", nil, sessionID, nil)
+}
+
+// GetDOMStorageItemsParams holds the parameters of DOMStorage.getDOMStorageItems.
+type GetDOMStorageItemsParams struct {
+	StorageID StorageID `json:"storageId"`
+}
+
+// GetDOMStorageItemsResult holds the result of DOMStorage.getDOMStorageItems.
+type GetDOMStorageItemsResult struct {
+	Entries []Item `json:"entries"`
+}
+
+// GetDOMStorageItems calls DOMStorage.getDOMStorageItems.
+func GetDOMStorageItems(ctx context.Context, c *internal.Client, sessionID string, params *GetDOMStorageItemsParams) (*GetDOMStorageItemsResult, error) {
+	if params == nil {
+		params = &GetDOMStorageItemsParams{}
+	}
+	var result GetDOMStorageItemsResult
+	err := c.Call(ctx, "DOMStorage.getDOMStorageItems", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// RemoveDOMStorageItemParams holds the parameters of DOMStorage.removeDOMStorageItem.
+type RemoveDOMStorageItemParams struct {
+	StorageID StorageID `json:"storageId"`
+	Key       string    `json:"key"`
+}
+
+// RemoveDOMStorageItem calls DOMStorage.removeDOMStorageItem.
+func RemoveDOMStorageItem(ctx context.Context, c *internal.Client, sessionID string, params *RemoveDOMStorageItemParams) error {
+	if params == nil {
+		params = &RemoveDOMStorageItemParams{}
+	}
+	return c.Call(ctx, "DOMStorage.removeDOMStorageItem", params, sessionID, nil)
+}
+
+// SetDOMStorageItemParams holds the parameters of DOMStorage.setDOMStorageItem.
+type SetDOMStorageItemParams struct {
+	StorageID StorageID `json:"storageId"`
+	Key       string    `json:"key"`
+	Value     string    `json:"value"`
+}
+
+// SetDOMStorageItem calls DOMStorage.setDOMStorageItem.
+func SetDOMStorageItem(ctx context.Context, c *internal.Client, sessionID string, params *SetDOMStorageItemParams) error {
+	if params == nil {
+		params = &SetDOMStorageItemParams{}
+	}
+	return c.Call(ctx, "DOMStorage.setDOMStorageItem", params, sessionID, nil)
+}
+
+// EventDOMStorageItemAdded is the method name of the DOMStorage.domStorageItemAdded event.
+const EventDOMStorageItemAdded = "DOMStorage.domStorageItemAdded"
+
+// DOMStorageItemAddedEvent is the payload of the DOMStorage.domStorageItemAdded event.
+type DOMStorageItemAddedEvent struct {
+	StorageID StorageID `json:"storageId"`
+	Key       string    `json:"key"`
+	NewValue  string    `json:"newValue"`
+}
+
+// EventDOMStorageItemRemoved is the method name of the DOMStorage.domStorageItemRemoved event.
+const EventDOMStorageItemRemoved = "DOMStorage.domStorageItemRemoved"
+
+// DOMStorageItemRemovedEvent is the payload of the DOMStorage.domStorageItemRemoved event.
+type DOMStorageItemRemovedEvent struct {
+	StorageID StorageID `json:"storageId"`
+	Key       string    `json:"key"`
+}
+
+// EventDOMStorageItemUpdated is the method name of the DOMStorage.domStorageItemUpdated event.
+const EventDOMStorageItemUpdated = "DOMStorage.domStorageItemUpdated"
+
+// DOMStorageItemUpdatedEvent is the payload of the DOMStorage.domStorageItemUpdated event.
+type DOMStorageItemUpdatedEvent struct {
+	StorageID StorageID `json:"storageId"`
+	Key       string    `json:"key"`
+	OldValue  string    `json:"oldValue"`
+	NewValue  string    `json:"newValue"`
+}
+
+// EventDOMStorageItemsCleared is the method name of the DOMStorage.domStorageItemsCleared event.
+const EventDOMStorageItemsCleared = "DOMStorage.domStorageItemsCleared"
+
+// DOMStorageItemsClearedEvent is the payload of the DOMStorage.domStorageItemsCleared event.
+type DOMStorageItemsClearedEvent struct {
+	StorageID StorageID `json:"storageId"`
+}
+
+// ParseEvent decodes a DOMStorage event message into its typed event struct.
+func ParseEvent(msg *internal.CDPMessage) (any, error) {
+	var v any
+	switch msg.Method {
+	case EventDOMStorageItemAdded:
+		v = &DOMStorageItemAddedEvent{}
+	case EventDOMStorageItemRemoved:
+		v = &DOMStorageItemRemovedEvent{}
+	case EventDOMStorageItemUpdated:
+		v = &DOMStorageItemUpdatedEvent{}
+	case EventDOMStorageItemsCleared:
+		v = &DOMStorageItemsClearedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown DOMStorage event: %s", msg.Method)
+	}
+	if msg.Params == nil {
+		return v, nil
+	}
+	err := json.Unmarshal(msg.Params, v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
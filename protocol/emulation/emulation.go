@@ -0,0 +1,929 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package emulation provides typed bindings for the CDP Emulation domain.
+//
+// This domain emulates different environments for the page.
+package emulation
+
+import (
+	"cdp/internal"
+	"cdp/protocol/dom"
+	"cdp/protocol/page"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// ScreenOrientation is the Emulation.ScreenOrientation type.
+//
+// Screen orientation.
+type ScreenOrientation struct {
+	// Orientation type.
+	Type string `json:"type"`
+	// Orientation angle.
+	Angle int64 `json:"angle"`
+}
+
+// DisplayFeature is the Emulation.DisplayFeature type.
+type DisplayFeature struct {
+	// Orientation of a display feature in relation to screen
+	Orientation string `json:"orientation"`
+	// The offset from the screen origin in either the x (for vertical
+	// orientation) or y (for horizontal orientation) direction.
+	Offset int64 `json:"offset"`
+	// A display feature may mask content such that it is not physically
+	// displayed - this length along with the offset describes this area.
+	// A display feature that only splits content will have a 0 mask_length.
+	MaskLength int64 `json:"maskLength"`
+}
+
+// DevicePosture is the Emulation.DevicePosture type.
+type DevicePosture struct {
+	// Current posture of the device
+	Type string `json:"type"`
+}
+
+// MediaFeature is the Emulation.MediaFeature type.
+type MediaFeature struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// VirtualTimePolicy is the Emulation.VirtualTimePolicy type.
+//
+// advance: If the scheduler runs out of immediate work, the virtual time base may fast forward to
+// allow the next delayed task (if any) to run; pause: The virtual time base may not advance;
+// pauseIfNetworkFetchesPending: The virtual time base may not advance if there are any pending
+// resource fetches.
+//
+// Experimental.
+type VirtualTimePolicy string
+
+const (
+	VirtualTimePolicyAdvance                      VirtualTimePolicy = "advance"
+	VirtualTimePolicyPause                        VirtualTimePolicy = "pause"
+	VirtualTimePolicyPauseIfNetworkFetchesPending VirtualTimePolicy = "pauseIfNetworkFetchesPending"
+)
+
+// UserAgentBrandVersion is the Emulation.UserAgentBrandVersion type.
+//
+// Used to specify User Agent Client Hints to emulate. See https://wicg.github.io/ua-client-hints
+//
+// Experimental.
+type UserAgentBrandVersion struct {
+	Brand   string `json:"brand"`
+	Version string `json:"version"`
+}
+
+// UserAgentMetadata is the Emulation.UserAgentMetadata type.
+//
+// Used to specify User Agent Client Hints to emulate. See https://wicg.github.io/ua-client-hints
+// Missing optional values will be filled in by the target with what it would normally use.
+//
+// Experimental.
+type UserAgentMetadata struct {
+	// Brands appearing in Sec-CH-UA.
+	Brands []UserAgentBrandVersion `json:"brands,omitempty"`
+	// Brands appearing in Sec-CH-UA-Full-Version-List.
+	FullVersionList []UserAgentBrandVersion `json:"fullVersionList,omitempty"`
+	// Deprecated: this item is deprecated in the protocol.
+	FullVersion     string `json:"fullVersion,omitempty"`
+	Platform        string `json:"platform"`
+	PlatformVersion string `json:"platformVersion"`
+	Architecture    string `json:"architecture"`
+	Model           string `json:"model"`
+	Mobile          bool   `json:"mobile"`
+	Bitness         string `json:"bitness,omitempty"`
+	Wow64           *bool  `json:"wow64,omitempty"`
+}
+
+// SensorType is the Emulation.SensorType type.
+//
+// Used to specify sensor types to emulate.
+// See https://w3c.github.io/sensors/#automation for more information.
+//
+// Experimental.
+type SensorType string
+
+const (
+	SensorTypeAbsoluteOrientation SensorType = "absolute-orientation"
+	SensorTypeAccelerometer       SensorType = "accelerometer"
+	SensorTypeAmbientLight        SensorType = "ambient-light"
+	SensorTypeGravity             SensorType = "gravity"
+	SensorTypeGyroscope           SensorType = "gyroscope"
+	SensorTypeLinearAcceleration  SensorType = "linear-acceleration"
+	SensorTypeMagnetometer        SensorType = "magnetometer"
+	SensorTypeProximity           SensorType = "proximity"
+	SensorTypeRelativeOrientation SensorType = "relative-orientation"
+)
+
+// SensorMetadata is the Emulation.SensorMetadata type.
+//
+// Experimental.
+type SensorMetadata struct {
+	Available        *bool    `json:"available,omitempty"`
+	MinimumFrequency *float64 `json:"minimumFrequency,omitempty"`
+	MaximumFrequency *float64 `json:"maximumFrequency,omitempty"`
+}
+
+// SensorReadingSingle is the Emulation.SensorReadingSingle type.
+//
+// Experimental.
+type SensorReadingSingle struct {
+	Value float64 `json:"value"`
+}
+
+// SensorReadingXYZ is the Emulation.SensorReadingXYZ type.
+//
+// Experimental.
+type SensorReadingXYZ struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+	Z float64 `json:"z"`
+}
+
+// SensorReadingQuaternion is the Emulation.SensorReadingQuaternion type.
+//
+// Experimental.
+type SensorReadingQuaternion struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+	Z float64 `json:"z"`
+	W float64 `json:"w"`
+}
+
+// SensorReading is the Emulation.SensorReading type.
+//
+// Experimental.
+type SensorReading struct {
+	Single     *SensorReadingSingle     `json:"single,omitempty"`
+	Xyz        *SensorReadingXYZ        `json:"xyz,omitempty"`
+	Quaternion *SensorReadingQuaternion `json:"quaternion,omitempty"`
+}
+
+// PressureSource is the Emulation.PressureSource type.
+//
+// Experimental.
+type PressureSource string
+
+const (
+	PressureSourceCPU PressureSource = "cpu"
+)
+
+// PressureState is the Emulation.PressureState type.
+//
+// Experimental.
+type PressureState string
+
+const (
+	PressureStateNominal  PressureState = "nominal"
+	PressureStateFair     PressureState = "fair"
+	PressureStateSerious  PressureState = "serious"
+	PressureStateCritical PressureState = "critical"
+)
+
+// PressureMetadata is the Emulation.PressureMetadata type.
+//
+// Experimental.
+type PressureMetadata struct {
+	Available *bool `json:"available,omitempty"`
+}
+
+// DisabledImageType is the Emulation.DisabledImageType type.
+//
+// Enum of image types that can be disabled.
+//
+// Experimental.
+type DisabledImageType string
+
+const (
+	DisabledImageTypeAvif DisabledImageType = "avif"
+	DisabledImageTypeWebp DisabledImageType = "webp"
+)
+
+// CanEmulateResult holds the result of Emulation.canEmulate.
+type CanEmulateResult struct {
+	// True if emulation is supported.
+	Result bool `json:"result"`
+}
+
+// CanEmulate calls Emulation.canEmulate.
+//
+// Tells whether emulation is supported.
+//
+// Deprecated: this item is deprecated in the protocol.
+func CanEmulate(ctx context.Context, c *internal.Client, sessionID string) (*CanEmulateResult, error) {
+	var result CanEmulateResult
+	err := c.Call(ctx, "Emulation.canEmulate", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ClearDeviceMetricsOverride calls Emulation.clearDeviceMetricsOverride.
+//
+// Clears the overridden device metrics.
+func ClearDeviceMetricsOverride(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Emulation.clearDeviceMetricsOverride", nil, sessionID, nil)
+}
+
+// ClearGeolocationOverride calls Emulation.clearGeolocationOverride.
+//
+// Clears the overridden Geolocation Position and Error.
+func ClearGeolocationOverride(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Emulation.clearGeolocationOverride", nil, sessionID, nil)
+}
+
+// ResetPageScaleFactor calls Emulation.resetPageScaleFactor.
+//
+// Requests that page scale factor is reset to initial values.
+//
+// Experimental.
+func ResetPageScaleFactor(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Emulation.resetPageScaleFactor", nil, sessionID, nil)
+}
+
+// SetFocusEmulationEnabledParams holds the parameters of Emulation.setFocusEmulationEnabled.
+type SetFocusEmulationEnabledParams struct {
+	// Whether to enable to disable focus emulation.
+	Enabled bool `json:"enabled"`
+}
+
+// SetFocusEmulationEnabled calls Emulation.setFocusEmulationEnabled.
+//
+// Enables or disables simulating a focused and active page.
+//
+// Experimental.
+func SetFocusEmulationEnabled(ctx context.Context, c *internal.Client, sessionID string, params *SetFocusEmulationEnabledParams) error {
+	if params == nil {
+		params = &SetFocusEmulationEnabledParams{}
+	}
+	return c.Call(ctx, "Emulation.setFocusEmulationEnabled", params, sessionID, nil)
+}
+
+// SetAutoDarkModeOverrideParams holds the parameters of Emulation.setAutoDarkModeOverride.
+type SetAutoDarkModeOverrideParams struct {
+	// Whether to enable or disable automatic dark mode.
+	// If not specified, any existing override will be cleared.
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// SetAutoDarkModeOverride calls Emulation.setAutoDarkModeOverride.
+//
+// Automatically render all web contents using a dark theme.
+//
+// Experimental.
+func SetAutoDarkModeOverride(ctx context.Context, c *internal.Client, sessionID string, params *SetAutoDarkModeOverrideParams) error {
+	if params == nil {
+		params = &SetAutoDarkModeOverrideParams{}
+	}
+	return c.Call(ctx, "Emulation.setAutoDarkModeOverride", params, sessionID, nil)
+}
+
+// SetCPUThrottlingRateParams holds the parameters of Emulation.setCPUThrottlingRate.
+type SetCPUThrottlingRateParams struct {
+	// Throttling rate as a slowdown factor (1 is no throttle, 2 is 2x slowdown, etc).
+	Rate float64 `json:"rate"`
+}
+
+// SetCPUThrottlingRate calls Emulation.setCPUThrottlingRate.
+//
+// Enables CPU throttling to emulate slow CPUs.
+func SetCPUThrottlingRate(ctx context.Context, c *internal.Client, sessionID string, params *SetCPUThrottlingRateParams) error {
+	if params == nil {
+		params = &SetCPUThrottlingRateParams{}
+	}
+	return c.Call(ctx, "Emulation.setCPUThrottlingRate", params, sessionID, nil)
+}
+
+// SetDefaultBackgroundColorOverrideParams holds the parameters of Emulation.setDefaultBackgroundColorOverride.
+type SetDefaultBackgroundColorOverrideParams struct {
+	// RGBA of the default background color. If not specified, any existing override will be
+	// cleared.
+	Color *dom.RGBA `json:"color,omitempty"`
+}
+
+// SetDefaultBackgroundColorOverride calls Emulation.setDefaultBackgroundColorOverride.
+//
+// Sets or clears an override of the default background color of the frame. This override is used
+// if the content does not specify one.
+func SetDefaultBackgroundColorOverride(ctx context.Context, c *internal.Client, sessionID string, params *SetDefaultBackgroundColorOverrideParams) error {
+	if params == nil {
+		params = &SetDefaultBackgroundColorOverrideParams{}
+	}
+	return c.Call(ctx, "Emulation.setDefaultBackgroundColorOverride", params, sessionID, nil)
+}
+
+// SetDeviceMetricsOverrideParams holds the parameters of Emulation.setDeviceMetricsOverride.
+type SetDeviceMetricsOverrideParams struct {
+	// Overriding width value in pixels (minimum 0, maximum 10000000). 0 disables the override.
+	Width int64 `json:"width"`
+	// Overriding height value in pixels (minimum 0, maximum 10000000). 0 disables the override.
+	Height int64 `json:"height"`
+	// Overriding device scale factor value. 0 disables the override.
+	DeviceScaleFactor float64 `json:"deviceScaleFactor"`
+	// Whether to emulate mobile device. This includes viewport meta tag, overlay scrollbars, text
+	// autosizing and more.
+	Mobile bool `json:"mobile"`
+	// Scale to apply to resulting view image.
+	//
+	// Experimental.
+	Scale *float64 `json:"scale,omitempty"`
+	// Overriding screen width value in pixels (minimum 0, maximum 10000000).
+	//
+	// Experimental.
+	ScreenWidth *int64 `json:"screenWidth,omitempty"`
+	// Overriding screen height value in pixels (minimum 0, maximum 10000000).
+	//
+	// Experimental.
+	ScreenHeight *int64 `json:"screenHeight,omitempty"`
+	// Overriding view X position on screen in pixels (minimum 0, maximum 10000000).
+	//
+	// Experimental.
+	PositionX *int64 `json:"positionX,omitempty"`
+	// Overriding view Y position on screen in pixels (minimum 0, maximum 10000000).
+	//
+	// Experimental.
+	PositionY *int64 `json:"positionY,omitempty"`
+	// Do not set visible view size, rely upon explicit setVisibleSize call.
+	//
+	// Experimental.
+	DontSetVisibleSize *bool `json:"dontSetVisibleSize,omitempty"`
+	// Screen orientation override.
+	ScreenOrientation *ScreenOrientation `json:"screenOrientation,omitempty"`
+	// If set, the visible area of the page will be overridden to this viewport. This viewport
+	// change is not observed by the page, e.g. viewport-relative elements do not change positions.
+	//
+	// Experimental.
+	Viewport *page.Viewport `json:"viewport,omitempty"`
+	// If set, the display feature of a multi-segment screen. If not set, multi-segment support
+	// is turned-off.
+	//
+	// Experimental.
+	DisplayFeature *DisplayFeature `json:"displayFeature,omitempty"`
+	// If set, the posture of a foldable device. If not set the posture is set
+	// to continuous.
+	// Deprecated, use Emulation.setDevicePostureOverride.
+	//
+	// Experimental.
+	//
+	// Deprecated: this item is deprecated in the protocol.
+	DevicePosture *DevicePosture `json:"devicePosture,omitempty"`
+}
+
+// SetDeviceMetricsOverride calls Emulation.setDeviceMetricsOverride.
+//
+// Overrides the values of device screen dimensions (window.screen.width, window.screen.height,
+// window.innerWidth, window.innerHeight, and "device-width"/"device-height"-related CSS media
+// query results).
+func SetDeviceMetricsOverride(ctx context.Context, c *internal.Client, sessionID string, params *SetDeviceMetricsOverrideParams) error {
+	if params == nil {
+		params = &SetDeviceMetricsOverrideParams{}
+	}
+	return c.Call(ctx, "Emulation.setDeviceMetricsOverride", params, sessionID, nil)
+}
+
+// SetDevicePostureOverrideParams holds the parameters of Emulation.setDevicePostureOverride.
+type SetDevicePostureOverrideParams struct {
+	Posture DevicePosture `json:"posture"`
+}
+
+// SetDevicePostureOverride calls Emulation.setDevicePostureOverride.
+//
+// Start reporting the given posture value to the Device Posture API.
+// This override can also be set in setDeviceMetricsOverride().
+//
+// Experimental.
+func SetDevicePostureOverride(ctx context.Context, c *internal.Client, sessionID string, params *SetDevicePostureOverrideParams) error {
+	if params == nil {
+		params = &SetDevicePostureOverrideParams{}
+	}
+	return c.Call(ctx, "Emulation.setDevicePostureOverride", params, sessionID, nil)
+}
+
+// ClearDevicePostureOverride calls Emulation.clearDevicePostureOverride.
+//
+// Clears a device posture override set with either setDeviceMetricsOverride()
+// or setDevicePostureOverride() and starts using posture information from the
+// platform again.
+// Does nothing if no override is set.
+//
+// Experimental.
+func ClearDevicePostureOverride(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Emulation.clearDevicePostureOverride", nil, sessionID, nil)
+}
+
+// SetScrollbarsHiddenParams holds the parameters of Emulation.setScrollbarsHidden.
+type SetScrollbarsHiddenParams struct {
+	// Whether scrollbars should be always hidden.
+	Hidden bool `json:"hidden"`
+}
+
+// SetScrollbarsHidden calls Emulation.setScrollbarsHidden.
+//
+// Experimental.
+func SetScrollbarsHidden(ctx context.Context, c *internal.Client, sessionID string, params *SetScrollbarsHiddenParams) error {
+	if params == nil {
+		params = &SetScrollbarsHiddenParams{}
+	}
+	return c.Call(ctx, "Emulation.setScrollbarsHidden", params, sessionID, nil)
+}
+
+// SetDocumentCookieDisabledParams holds the parameters of Emulation.setDocumentCookieDisabled.
+type SetDocumentCookieDisabledParams struct {
+	// Whether document.coookie API should be disabled.
+	Disabled bool `json:"disabled"`
+}
+
+// SetDocumentCookieDisabled calls Emulation.setDocumentCookieDisabled.
+//
+// Experimental.
+func SetDocumentCookieDisabled(ctx context.Context, c *internal.Client, sessionID string, params *SetDocumentCookieDisabledParams) error {
+	if params == nil {
+		params = &SetDocumentCookieDisabledParams{}
+	}
+	return c.Call(ctx, "Emulation.setDocumentCookieDisabled", params, sessionID, nil)
+}
+
+// SetEmitTouchEventsForMouseParams holds the parameters of Emulation.setEmitTouchEventsForMouse.
+type SetEmitTouchEventsForMouseParams struct {
+	// Whether touch emulation based on mouse input should be enabled.
+	Enabled bool `json:"enabled"`
+	// Touch/gesture events configuration. Default: current platform.
+	Configuration string `json:"configuration,omitempty"`
+}
+
+// SetEmitTouchEventsForMouse calls Emulation.setEmitTouchEventsForMouse.
+//
+// Experimental.
+func SetEmitTouchEventsForMouse(ctx context.Context, c *internal.Client, sessionID string, params *SetEmitTouchEventsForMouseParams) error {
+	if params == nil {
+		params = &SetEmitTouchEventsForMouseParams{}
+	}
+	return c.Call(ctx, "Emulation.setEmitTouchEventsForMouse", params, sessionID, nil)
+}
+
+// SetEmulatedMediaParams holds the parameters of Emulation.setEmulatedMedia.
+type SetEmulatedMediaParams struct {
+	// Media type to emulate. Empty string disables the override.
+	Media string `json:"media,omitempty"`
+	// Media features to emulate.
+	Features []MediaFeature `json:"features,omitempty"`
+}
+
+// SetEmulatedMedia calls Emulation.setEmulatedMedia.
+//
+// Emulates the given media type or media feature for CSS media queries.
+func SetEmulatedMedia(ctx context.Context, c *internal.Client, sessionID string, params *SetEmulatedMediaParams) error {
+	if params == nil {
+		params = &SetEmulatedMediaParams{}
+	}
+	return c.Call(ctx, "Emulation.setEmulatedMedia", params, sessionID, nil)
+}
+
+// SetEmulatedVisionDeficiencyParams holds the parameters of Emulation.setEmulatedVisionDeficiency.
+type SetEmulatedVisionDeficiencyParams struct {
+	// Vision deficiency to emulate. Order: best-effort emulations come first, followed by any
+	// physiologically accurate emulations for medically recognized color vision deficiencies.
+	Type string `json:"type"`
+}
+
+// SetEmulatedVisionDeficiency calls Emulation.setEmulatedVisionDeficiency.
+//
+// Emulates the given vision deficiency.
+func SetEmulatedVisionDeficiency(ctx context.Context, c *internal.Client, sessionID string, params *SetEmulatedVisionDeficiencyParams) error {
+	if params == nil {
+		params = &SetEmulatedVisionDeficiencyParams{}
+	}
+	return c.Call(ctx, "Emulation.setEmulatedVisionDeficiency", params, sessionID, nil)
+}
+
+// SetGeolocationOverrideParams holds the parameters of Emulation.setGeolocationOverride.
+type SetGeolocationOverrideParams struct {
+	// Mock latitude
+	Latitude *float64 `json:"latitude,omitempty"`
+	// Mock longitude
+	Longitude *float64 `json:"longitude,omitempty"`
+	// Mock accuracy
+	Accuracy *float64 `json:"accuracy,omitempty"`
+}
+
+// SetGeolocationOverride calls Emulation.setGeolocationOverride.
+//
+// Overrides the Geolocation Position or Error. Omitting any of the parameters emulates position
+// unavailable.
+func SetGeolocationOverride(ctx context.Context, c *internal.Client, sessionID string, params *SetGeolocationOverrideParams) error {
+	if params == nil {
+		params = &SetGeolocationOverrideParams{}
+	}
+	return c.Call(ctx, "Emulation.setGeolocationOverride", params, sessionID, nil)
+}
+
+// GetOverriddenSensorInformationParams holds the parameters of Emulation.getOverriddenSensorInformation.
+type GetOverriddenSensorInformationParams struct {
+	Type SensorType `json:"type"`
+}
+
+// GetOverriddenSensorInformationResult holds the result of Emulation.getOverriddenSensorInformation.
+type GetOverriddenSensorInformationResult struct {
+	RequestedSamplingFrequency float64 `json:"requestedSamplingFrequency"`
+}
+
+// GetOverriddenSensorInformation calls Emulation.getOverriddenSensorInformation.
+//
+// Experimental.
+func GetOverriddenSensorInformation(ctx context.Context, c *internal.Client, sessionID string, params *GetOverriddenSensorInformationParams) (*GetOverriddenSensorInformationResult, error) {
+	if params == nil {
+		params = &GetOverriddenSensorInformationParams{}
+	}
+	var result GetOverriddenSensorInformationResult
+	err := c.Call(ctx, "Emulation.getOverriddenSensorInformation", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SetSensorOverrideEnabledParams holds the parameters of Emulation.setSensorOverrideEnabled.
+type SetSensorOverrideEnabledParams struct {
+	Enabled  bool            `json:"enabled"`
+	Type     SensorType      `json:"type"`
+	Metadata *SensorMetadata `json:"metadata,omitempty"`
+}
+
+// SetSensorOverrideEnabled calls Emulation.setSensorOverrideEnabled.
+//
+// Overrides a platform sensor of a given type. If |enabled| is true, calls to
+// Sensor.start() will use a virtual sensor as backend rather than fetching
+// data from a real hardware sensor. Otherwise, existing virtual
+// sensor-backend Sensor objects will fire an error event and new calls to
+// Sensor.start() will attempt to use a real sensor instead.
+//
+// Experimental.
+func SetSensorOverrideEnabled(ctx context.Context, c *internal.Client, sessionID string, params *SetSensorOverrideEnabledParams) error {
+	if params == nil {
+		params = &SetSensorOverrideEnabledParams{}
+	}
+	return c.Call(ctx, "Emulation.setSensorOverrideEnabled", params, sessionID, nil)
+}
+
+// SetSensorOverrideReadingsParams holds the parameters of Emulation.setSensorOverrideReadings.
+type SetSensorOverrideReadingsParams struct {
+	Type    SensorType    `json:"type"`
+	Reading SensorReading `json:"reading"`
+}
+
+// SetSensorOverrideReadings calls Emulation.setSensorOverrideReadings.
+//
+// Updates the sensor readings reported by a sensor type previously overridden
+// by setSensorOverrideEnabled.
+//
+// Experimental.
+func SetSensorOverrideReadings(ctx context.Context, c *internal.Client, sessionID string, params *SetSensorOverrideReadingsParams) error {
+	if params == nil {
+		params = &SetSensorOverrideReadingsParams{}
+	}
+	return c.Call(ctx, "Emulation.setSensorOverrideReadings", params, sessionID, nil)
+}
+
+// SetPressureSourceOverrideEnabledParams holds the parameters of Emulation.setPressureSourceOverrideEnabled.
+type SetPressureSourceOverrideEnabledParams struct {
+	Enabled  bool              `json:"enabled"`
+	Source   PressureSource    `json:"source"`
+	Metadata *PressureMetadata `json:"metadata,omitempty"`
+}
+
+// SetPressureSourceOverrideEnabled calls Emulation.setPressureSourceOverrideEnabled.
+//
+// Overrides a pressure source of a given type, as used by the Compute
+// Pressure API, so that updates to PressureObserver.observe() are provided
+// via setPressureStateOverride instead of being retrieved from
+// platform-provided telemetry data.
+//
+// Experimental.
+func SetPressureSourceOverrideEnabled(ctx context.Context, c *internal.Client, sessionID string, params *SetPressureSourceOverrideEnabledParams) error {
+	if params == nil {
+		params = &SetPressureSourceOverrideEnabledParams{}
+	}
+	return c.Call(ctx, "Emulation.setPressureSourceOverrideEnabled", params, sessionID, nil)
+}
+
+// SetPressureStateOverrideParams holds the parameters of Emulation.setPressureStateOverride.
+type SetPressureStateOverrideParams struct {
+	Source PressureSource `json:"source"`
+	State  PressureState  `json:"state"`
+}
+
+// SetPressureStateOverride calls Emulation.setPressureStateOverride.
+//
+// Provides a given pressure state that will be processed and eventually be
+// delivered to PressureObserver users. |source| must have been previously
+// overridden by setPressureSourceOverrideEnabled.
+//
+// Experimental.
+func SetPressureStateOverride(ctx context.Context, c *internal.Client, sessionID string, params *SetPressureStateOverrideParams) error {
+	if params == nil {
+		params = &SetPressureStateOverrideParams{}
+	}
+	return c.Call(ctx, "Emulation.setPressureStateOverride", params, sessionID, nil)
+}
+
+// SetIdleOverrideParams holds the parameters of Emulation.setIdleOverride.
+type SetIdleOverrideParams struct {
+	// Mock isUserActive
+	IsUserActive bool `json:"isUserActive"`
+	// Mock isScreenUnlocked
+	IsScreenUnlocked bool `json:"isScreenUnlocked"`
+}
+
+// SetIdleOverride calls Emulation.setIdleOverride.
+//
+// Overrides the Idle state.
+func SetIdleOverride(ctx context.Context, c *internal.Client, sessionID string, params *SetIdleOverrideParams) error {
+	if params == nil {
+		params = &SetIdleOverrideParams{}
+	}
+	return c.Call(ctx, "Emulation.setIdleOverride", params, sessionID, nil)
+}
+
+// ClearIdleOverride calls Emulation.clearIdleOverride.
+//
+// Clears Idle state overrides.
+func ClearIdleOverride(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Emulation.clearIdleOverride", nil, sessionID, nil)
+}
+
+// SetNavigatorOverridesParams holds the parameters of Emulation.setNavigatorOverrides.
+type SetNavigatorOverridesParams struct {
+	// The platform navigator.platform should return.
+	Platform string `json:"platform"`
+}
+
+// SetNavigatorOverrides calls Emulation.setNavigatorOverrides.
+//
+// Overrides value returned by the javascript navigator object.
+//
+// Experimental.
+//
+// Deprecated: this item is deprecated in the protocol.
+func SetNavigatorOverrides(ctx context.Context, c *internal.Client, sessionID string, params *SetNavigatorOverridesParams) error {
+	if params == nil {
+		params = &SetNavigatorOverridesParams{}
+	}
+	return c.Call(ctx, "Emulation.setNavigatorOverrides", params, sessionID, nil)
+}
+
+// SetPageScaleFactorParams holds the parameters of Emulation.setPageScaleFactor.
+type SetPageScaleFactorParams struct {
+	// Page scale factor.
+	PageScaleFactor float64 `json:"pageScaleFactor"`
+}
+
+// SetPageScaleFactor calls Emulation.setPageScaleFactor.
+//
+// Sets a specified page scale factor.
+//
+// Experimental.
+func SetPageScaleFactor(ctx context.Context, c *internal.Client, sessionID string, params *SetPageScaleFactorParams) error {
+	if params == nil {
+		params = &SetPageScaleFactorParams{}
+	}
+	return c.Call(ctx, "Emulation.setPageScaleFactor", params, sessionID, nil)
+}
+
+// SetScriptExecutionDisabledParams holds the parameters of Emulation.setScriptExecutionDisabled.
+type SetScriptExecutionDisabledParams struct {
+	// Whether script execution should be disabled in the page.
+	Value bool `json:"value"`
+}
+
+// SetScriptExecutionDisabled calls Emulation.setScriptExecutionDisabled.
+//
+// Switches script execution in the page.
+func SetScriptExecutionDisabled(ctx context.Context, c *internal.Client, sessionID string, params *SetScriptExecutionDisabledParams) error {
+	if params == nil {
+		params = &SetScriptExecutionDisabledParams{}
+	}
+	return c.Call(ctx, "Emulation.setScriptExecutionDisabled", params, sessionID, nil)
+}
+
+// SetTouchEmulationEnabledParams holds the parameters of Emulation.setTouchEmulationEnabled.
+type SetTouchEmulationEnabledParams struct {
+	// Whether the touch event emulation should be enabled.
+	Enabled bool `json:"enabled"`
+	// Maximum touch points supported. Defaults to one.
+	MaxTouchPoints *int64 `json:"maxTouchPoints,omitempty"`
+}
+
+// SetTouchEmulationEnabled calls Emulation.setTouchEmulationEnabled.
+//
+// Enables touch on platforms which do not support them.
+func SetTouchEmulationEnabled(ctx context.Context, c *internal.Client, sessionID string, params *SetTouchEmulationEnabledParams) error {
+	if params == nil {
+		params = &SetTouchEmulationEnabledParams{}
+	}
+	return c.Call(ctx, "Emulation.setTouchEmulationEnabled", params, sessionID, nil)
+}
+
+// SetVirtualTimePolicyParams holds the parameters of Emulation.setVirtualTimePolicy.
+type SetVirtualTimePolicyParams struct {
+	Policy VirtualTimePolicy `json:"policy"`
+	// If set, after this many virtual milliseconds have elapsed virtual time will be paused and a
+	// virtualTimeBudgetExpired event is sent.
+	Budget *float64 `json:"budget,omitempty"`
+	// If set this specifies the maximum number of tasks that can be run before virtual is forced
+	// forwards to prevent deadlock.
+	MaxVirtualTimeTaskStarvationCount *int64 `json:"maxVirtualTimeTaskStarvationCount,omitempty"`
+	// If set, base::Time::Now will be overridden to initially return this value.
+	InitialVirtualTime *float64 `json:"initialVirtualTime,omitempty"`
+}
+
+// SetVirtualTimePolicyResult holds the result of Emulation.setVirtualTimePolicy.
+type SetVirtualTimePolicyResult struct {
+	// Absolute timestamp at which virtual time was first enabled (up time in milliseconds).
+	VirtualTimeTicksBase float64 `json:"virtualTimeTicksBase"`
+}
+
+// SetVirtualTimePolicy calls Emulation.setVirtualTimePolicy.
+//
+// Turns on virtual time for all frames (replacing real-time with a synthetic time source) and sets
+// the current virtual time policy.  Note this supersedes any previous time budget.
+//
+// Experimental.
+func SetVirtualTimePolicy(ctx context.Context, c *internal.Client, sessionID string, params *SetVirtualTimePolicyParams) (*SetVirtualTimePolicyResult, error) {
+	if params == nil {
+		params = &SetVirtualTimePolicyParams{}
+	}
+	var result SetVirtualTimePolicyResult
+	err := c.Call(ctx, "Emulation.setVirtualTimePolicy", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SetLocaleOverrideParams holds the parameters of Emulation.setLocaleOverride.
+type SetLocaleOverrideParams struct {
+	// ICU style C locale (e.g. "en_US"). If not specified or empty, disables the override and
+	// restores default host system locale.
+	Locale string `json:"locale,omitempty"`
+}
+
+// SetLocaleOverride calls Emulation.setLocaleOverride.
+//
+// Overrides default host system locale with the specified one.
+//
+// Experimental.
+func SetLocaleOverride(ctx context.Context, c *internal.Client, sessionID string, params *SetLocaleOverrideParams) error {
+	if params == nil {
+		params = &SetLocaleOverrideParams{}
+	}
+	return c.Call(ctx, "Emulation.setLocaleOverride", params, sessionID, nil)
+}
+
+// SetTimezoneOverrideParams holds the parameters of Emulation.setTimezoneOverride.
+type SetTimezoneOverrideParams struct {
+	// The timezone identifier. List of supported timezones:
+	// https://source.chromium.org/chromium/chromium/deps/icu.git/+/faee8bc70570192d82d2978a71e2a615788597d1:source/data/misc/metaZones.txt
+	// If empty, disables the override and restores default host system timezone.
+	TimezoneID string `json:"timezoneId"`
+}
+
+// SetTimezoneOverride calls Emulation.setTimezoneOverride.
+//
+// Overrides default host system timezone with the specified one.
+func SetTimezoneOverride(ctx context.Context, c *internal.Client, sessionID string, params *SetTimezoneOverrideParams) error {
+	if params == nil {
+		params = &SetTimezoneOverrideParams{}
+	}
+	return c.Call(ctx, "Emulation.setTimezoneOverride", params, sessionID, nil)
+}
+
+// SetVisibleSizeParams holds the parameters of Emulation.setVisibleSize.
+type SetVisibleSizeParams struct {
+	// Frame width (DIP).
+	Width int64 `json:"width"`
+	// Frame height (DIP).
+	Height int64 `json:"height"`
+}
+
+// SetVisibleSize calls Emulation.setVisibleSize.
+//
+// Resizes the frame/viewport of the page. Note that this does not affect the frame's container
+// (e.g. browser window). Can be used to produce screenshots of the specified size. Not supported
+// on Android.
+//
+// Experimental.
+//
+// Deprecated: this item is deprecated in the protocol.
+func SetVisibleSize(ctx context.Context, c *internal.Client, sessionID string, params *SetVisibleSizeParams) error {
+	if params == nil {
+		params = &SetVisibleSizeParams{}
+	}
+	return c.Call(ctx, "Emulation.setVisibleSize", params, sessionID, nil)
+}
+
+// SetDisabledImageTypesParams holds the parameters of Emulation.setDisabledImageTypes.
+type SetDisabledImageTypesParams struct {
+	// Image types to disable.
+	ImageTypes []DisabledImageType `json:"imageTypes"`
+}
+
+// SetDisabledImageTypes calls Emulation.setDisabledImageTypes.
+//
+// Experimental.
+func SetDisabledImageTypes(ctx context.Context, c *internal.Client, sessionID string, params *SetDisabledImageTypesParams) error {
+	if params == nil {
+		params = &SetDisabledImageTypesParams{}
+	}
+	return c.Call(ctx, "Emulation.setDisabledImageTypes", params, sessionID, nil)
+}
+
+// SetHardwareConcurrencyOverrideParams holds the parameters of Emulation.setHardwareConcurrencyOverride.
+type SetHardwareConcurrencyOverrideParams struct {
+	// Hardware concurrency to report
+	HardwareConcurrency int64 `json:"hardwareConcurrency"`
+}
+
+// SetHardwareConcurrencyOverride calls Emulation.setHardwareConcurrencyOverride.
+//
+// Experimental.
+func SetHardwareConcurrencyOverride(ctx context.Context, c *internal.Client, sessionID string, params *SetHardwareConcurrencyOverrideParams) error {
+	if params == nil {
+		params = &SetHardwareConcurrencyOverrideParams{}
+	}
+	return c.Call(ctx, "Emulation.setHardwareConcurrencyOverride", params, sessionID, nil)
+}
+
+// SetUserAgentOverrideParams holds the parameters of Emulation.setUserAgentOverride.
+type SetUserAgentOverrideParams struct {
+	// User agent to use.
+	UserAgent string `json:"userAgent"`
+	// Browser language to emulate.
+	AcceptLanguage string `json:"acceptLanguage,omitempty"`
+	// The platform navigator.platform should return.
+	Platform string `json:"platform,omitempty"`
+	// To be sent in Sec-CH-UA-* headers and returned in navigator.userAgentData
+	//
+	// Experimental.
+	UserAgentMetadata *UserAgentMetadata `json:"userAgentMetadata,omitempty"`
+}
+
+// SetUserAgentOverride calls Emulation.setUserAgentOverride.
+//
+// Allows overriding user agent with the given string.
+// `userAgentMetadata` must be set for Client Hint headers to be sent.
+func SetUserAgentOverride(ctx context.Context, c *internal.Client, sessionID string, params *SetUserAgentOverrideParams) error {
+	if params == nil {
+		params = &SetUserAgentOverrideParams{}
+	}
+	return c.Call(ctx, "Emulation.setUserAgentOverride", params, sessionID, nil)
+}
+
+// SetAutomationOverrideParams holds the parameters of Emulation.setAutomationOverride.
+type SetAutomationOverrideParams struct {
+	// Whether the override should be enabled.
+	Enabled bool `json:"enabled"`
+}
+
+// SetAutomationOverride calls Emulation.setAutomationOverride.
+//
+// Allows overriding the automation flag.
+//
+// Experimental.
+func SetAutomationOverride(ctx context.Context, c *internal.Client, sessionID string, params *SetAutomationOverrideParams) error {
+	if params == nil {
+		params = &SetAutomationOverrideParams{}
+	}
+	return c.Call(ctx, "Emulation.setAutomationOverride", params, sessionID, nil)
+}
+
+// EventVirtualTimeBudgetExpired is the method name of the Emulation.virtualTimeBudgetExpired event.
+const EventVirtualTimeBudgetExpired = "Emulation.virtualTimeBudgetExpired"
+
+// VirtualTimeBudgetExpiredEvent is the payload of the Emulation.virtualTimeBudgetExpired event.
+//
+// Notification sent after the virtual time budget for the current VirtualTimePolicy has run out.
+//
+// Experimental.
+type VirtualTimeBudgetExpiredEvent struct{}
+
+// ParseEvent decodes a Emulation event message into its typed event struct.
+func ParseEvent(msg *internal.CDPMessage) (any, error) {
+	var v any
+	switch msg.Method {
+	case EventVirtualTimeBudgetExpired:
+		v = &VirtualTimeBudgetExpiredEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Emulation event: %s", msg.Method)
+	}
+	if msg.Params == nil {
+		return v, nil
+	}
+	err := json.Unmarshal(msg.Params, v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,52 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package eventbreakpoints provides typed bindings for the CDP EventBreakpoints domain.
+//
+// EventBreakpoints permits setting JavaScript breakpoints on operations and events
+// occurring in native code invoked from JavaScript. Once breakpoint is hit, it is
+// reported through Debugger domain, similarly to regular breakpoints being hit.
+package eventbreakpoints
+
+import (
+	"cdp/internal"
+	"context"
+)
+
+// SetInstrumentationBreakpointParams holds the parameters of EventBreakpoints.setInstrumentationBreakpoint.
+type SetInstrumentationBreakpointParams struct {
+	// Instrumentation name to stop on.
+	EventName string `json:"eventName"`
+}
+
+// SetInstrumentationBreakpoint calls EventBreakpoints.setInstrumentationBreakpoint.
+//
+// Sets breakpoint on particular native event.
+func SetInstrumentationBreakpoint(ctx context.Context, c *internal.Client, sessionID string, params *SetInstrumentationBreakpointParams) error {
+	if params == nil {
+		params = &SetInstrumentationBreakpointParams{}
+	}
+	return c.Call(ctx, "EventBreakpoints.setInstrumentationBreakpoint", params, sessionID, nil)
+}
+
+// RemoveInstrumentationBreakpointParams holds the parameters of EventBreakpoints.removeInstrumentationBreakpoint.
+type RemoveInstrumentationBreakpointParams struct {
+	// Instrumentation name to stop on.
+	EventName string `json:"eventName"`
+}
+
+// RemoveInstrumentationBreakpoint calls EventBreakpoints.removeInstrumentationBreakpoint.
+//
+// Removes breakpoint on particular native event.
+func RemoveInstrumentationBreakpoint(ctx context.Context, c *internal.Client, sessionID string, params *RemoveInstrumentationBreakpointParams) error {
+	if params == nil {
+		params = &RemoveInstrumentationBreakpointParams{}
+	}
+	return c.Call(ctx, "EventBreakpoints.removeInstrumentationBreakpoint", params, sessionID, nil)
+}
+
+// Disable calls EventBreakpoints.disable.
+//
+// Removes all breakpoints
+func Disable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "EventBreakpoints.disable", nil, sessionID, nil)
+}
@@ -0,0 +1,144 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package extensions provides typed bindings for the CDP Extensions domain.
+//
+// Defines commands and events for browser extensions.
+package extensions
+
+import (
+	"cdp/internal"
+	"context"
+)
+
+// StorageArea is the Extensions.StorageArea type.
+//
+// Storage areas.
+type StorageArea string
+
+const (
+	StorageAreaSession StorageArea = "session"
+	StorageAreaLocal   StorageArea = "local"
+	StorageAreaSync    StorageArea = "sync"
+	StorageAreaManaged StorageArea = "managed"
+)
+
+// LoadUnpackedParams holds the parameters of Extensions.loadUnpacked.
+type LoadUnpackedParams struct {
+	// Absolute file path.
+	Path string `json:"path"`
+}
+
+// LoadUnpackedResult holds the result of Extensions.loadUnpacked.
+type LoadUnpackedResult struct {
+	// Extension id.
+	ID string `json:"id"`
+}
+
+// LoadUnpacked calls Extensions.loadUnpacked.
+//
+// Installs an unpacked extension from the filesystem similar to
+// --load-extension CLI flags. Returns extension ID once the extension
+// has been installed. Available if the client is connected using the
+// --remote-debugging-pipe flag and the --enable-unsafe-extension-debugging
+// flag is set.
+func LoadUnpacked(ctx context.Context, c *internal.Client, sessionID string, params *LoadUnpackedParams) (*LoadUnpackedResult, error) {
+	if params == nil {
+		params = &LoadUnpackedParams{}
+	}
+	var result LoadUnpackedResult
+	err := c.Call(ctx, "Extensions.loadUnpacked", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetStorageItemsParams holds the parameters of Extensions.getStorageItems.
+type GetStorageItemsParams struct {
+	// ID of extension.
+	ID string `json:"id"`
+	// StorageArea to retrieve data from.
+	StorageArea StorageArea `json:"storageArea"`
+	// Keys to retrieve.
+	Keys []string `json:"keys,omitempty"`
+}
+
+// GetStorageItemsResult holds the result of Extensions.getStorageItems.
+type GetStorageItemsResult struct {
+	Data map[string]any `json:"data"`
+}
+
+// GetStorageItems calls Extensions.getStorageItems.
+//
+// Gets data from extension storage in the given `storageArea`. If `keys` is
+// specified, these are used to filter the result.
+func GetStorageItems(ctx context.Context, c *internal.Client, sessionID string, params *GetStorageItemsParams) (*GetStorageItemsResult, error) {
+	if params == nil {
+		params = &GetStorageItemsParams{}
+	}
+	var result GetStorageItemsResult
+	err := c.Call(ctx, "Extensions.getStorageItems", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// RemoveStorageItemsParams holds the parameters of Extensions.removeStorageItems.
+type RemoveStorageItemsParams struct {
+	// ID of extension.
+	ID string `json:"id"`
+	// StorageArea to remove data from.
+	StorageArea StorageArea `json:"storageArea"`
+	// Keys to remove.
+	Keys []string `json:"keys"`
+}
+
+// RemoveStorageItems calls Extensions.removeStorageItems.
+//
+// Removes `keys` from extension storage in the given `storageArea`.
+func RemoveStorageItems(ctx context.Context, c *internal.Client, sessionID string, params *RemoveStorageItemsParams) error {
+	if params == nil {
+		params = &RemoveStorageItemsParams{}
+	}
+	return c.Call(ctx, "Extensions.removeStorageItems", params, sessionID, nil)
+}
+
+// ClearStorageItemsParams holds the parameters of Extensions.clearStorageItems.
+type ClearStorageItemsParams struct {
+	// ID of extension.
+	ID string `json:"id"`
+	// StorageArea to remove data from.
+	StorageArea StorageArea `json:"storageArea"`
+}
+
+// ClearStorageItems calls Extensions.clearStorageItems.
+//
+// Clears extension storage in the given `storageArea`.
+func ClearStorageItems(ctx context.Context, c *internal.Client, sessionID string, params *ClearStorageItemsParams) error {
+	if params == nil {
+		params = &ClearStorageItemsParams{}
+	}
+	return c.Call(ctx, "Extensions.clearStorageItems", params, sessionID, nil)
+}
+
+// SetStorageItemsParams holds the parameters of Extensions.setStorageItems.
+type SetStorageItemsParams struct {
+	// ID of extension.
+	ID string `json:"id"`
+	// StorageArea to set data in.
+	StorageArea StorageArea `json:"storageArea"`
+	// Values to set.
+	Values map[string]any `json:"values"`
+}
+
+// SetStorageItems calls Extensions.setStorageItems.
+//
+// Sets `values` in extension storage in the given `storageArea`. The provided `values`
+// will be merged with existing values in the storage area.
+func SetStorageItems(ctx context.Context, c *internal.Client, sessionID string, params *SetStorageItemsParams) error {
+	if params == nil {
+		params = &SetStorageItemsParams{}
+	}
+	return c.Call(ctx, "Extensions.setStorageItems", params, sessionID, nil)
+}
@@ -0,0 +1,206 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package fedcm provides typed bindings for the CDP FedCm domain.
+//
+// This domain allows interacting with the FedCM dialog.
+package fedcm
+
+import (
+	"cdp/internal"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// LoginState is the FedCm.LoginState type.
+//
+// Whether this is a sign-up or sign-in action for this account, i.e.
+// whether this account has ever been used to sign in to this RP before.
+type LoginState string
+
+const (
+	LoginStateSignIn LoginState = "SignIn"
+	LoginStateSignUp LoginState = "SignUp"
+)
+
+// DialogType is the FedCm.DialogType type.
+//
+// The types of FedCM dialogs.
+type DialogType string
+
+const (
+	DialogTypeAccountChooser  DialogType = "AccountChooser"
+	DialogTypeAutoReauthn     DialogType = "AutoReauthn"
+	DialogTypeConfirmIdpLogin DialogType = "ConfirmIdpLogin"
+	DialogTypeError           DialogType = "Error"
+)
+
+// DialogButton is the FedCm.DialogButton type.
+//
+// The buttons on the FedCM dialog.
+type DialogButton string
+
+const (
+	DialogButtonConfirmIdpLoginContinue DialogButton = "ConfirmIdpLoginContinue"
+	DialogButtonErrorGotIt              DialogButton = "ErrorGotIt"
+	DialogButtonErrorMoreDetails        DialogButton = "ErrorMoreDetails"
+)
+
+// AccountURLType is the FedCm.AccountUrlType type.
+//
+// The URLs that each account has
+type AccountURLType string
+
+const (
+	AccountURLTypeTermsOfService AccountURLType = "TermsOfService"
+	AccountURLTypePrivacyPolicy  AccountURLType = "PrivacyPolicy"
+)
+
+// Account is the FedCm.Account type.
+//
+// Corresponds to IdentityRequestAccount
+type Account struct {
+	AccountID    string     `json:"accountId"`
+	Email        string     `json:"email"`
+	Name         string     `json:"name"`
+	GivenName    string     `json:"givenName"`
+	PictureURL   string     `json:"pictureUrl"`
+	IdpConfigURL string     `json:"idpConfigUrl"`
+	IdpLoginURL  string     `json:"idpLoginUrl"`
+	LoginState   LoginState `json:"loginState"`
+	// These two are only set if the loginState is signUp
+	TermsOfServiceURL string `json:"termsOfServiceUrl,omitempty"`
+	PrivacyPolicyURL  string `json:"privacyPolicyUrl,omitempty"`
+}
+
+// EnableParams holds the parameters of FedCm.enable.
+type EnableParams struct {
+	// Allows callers to disable the promise rejection delay that would
+	// normally happen, if this is unimportant to what's being tested.
+	// (step 4 of https://fedidcg.github.io/FedCM/#browser-api-rp-sign-in)
+	DisableRejectionDelay *bool `json:"disableRejectionDelay,omitempty"`
+}
+
+// Enable calls FedCm.enable.
+func Enable(ctx context.Context, c *internal.Client, sessionID string, params *EnableParams) error {
+	if params == nil {
+		params = &EnableParams{}
+	}
+	return c.Call(ctx, "FedCm.enable", params, sessionID, nil)
+}
+
+// Disable calls FedCm.disable.
+func Disable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "FedCm.disable", nil, sessionID, nil)
+}
+
+// SelectAccountParams holds the parameters of FedCm.selectAccount.
+type SelectAccountParams struct {
+	DialogID     string `json:"dialogId"`
+	AccountIndex int64  `json:"accountIndex"`
+}
+
+// SelectAccount calls FedCm.selectAccount.
+func SelectAccount(ctx context.Context, c *internal.Client, sessionID string, params *SelectAccountParams) error {
+	if params == nil {
+		params = &SelectAccountParams{}
+	}
+	return c.Call(ctx, "FedCm.selectAccount", params, sessionID, nil)
+}
+
+// ClickDialogButtonParams holds the parameters of FedCm.clickDialogButton.
+type ClickDialogButtonParams struct {
+	DialogID     string       `json:"dialogId"`
+	DialogButton DialogButton `json:"dialogButton"`
+}
+
+// ClickDialogButton calls FedCm.clickDialogButton.
+func ClickDialogButton(ctx context.Context, c *internal.Client, sessionID string, params *ClickDialogButtonParams) error {
+	if params == nil {
+		params = &ClickDialogButtonParams{}
+	}
+	return c.Call(ctx, "FedCm.clickDialogButton", params, sessionID, nil)
+}
+
+// OpenURLParams holds the parameters of FedCm.openUrl.
+type OpenURLParams struct {
+	DialogID       string         `json:"dialogId"`
+	AccountIndex   int64          `json:"accountIndex"`
+	AccountURLType AccountURLType `json:"accountUrlType"`
+}
+
+// OpenURL calls FedCm.openUrl.
+func OpenURL(ctx context.Context, c *internal.Client, sessionID string, params *OpenURLParams) error {
+	if params == nil {
+		params = &OpenURLParams{}
+	}
+	return c.Call(ctx, "FedCm.openUrl", params, sessionID, nil)
+}
+
+// DismissDialogParams holds the parameters of FedCm.dismissDialog.
+type DismissDialogParams struct {
+	DialogID        string `json:"dialogId"`
+	TriggerCooldown *bool  `json:"triggerCooldown,omitempty"`
+}
+
+// DismissDialog calls FedCm.dismissDialog.
+func DismissDialog(ctx context.Context, c *internal.Client, sessionID string, params *DismissDialogParams) error {
+	if params == nil {
+		params = &DismissDialogParams{}
+	}
+	return c.Call(ctx, "FedCm.dismissDialog", params, sessionID, nil)
+}
+
+// ResetCooldown calls FedCm.resetCooldown.
+//
+// Resets the cooldown time, if any, to allow the next FedCM call to show
+// a dialog even if one was recently dismissed by the user.
+func ResetCooldown(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "FedCm.resetCooldown", nil, sessionID, nil)
+}
+
+// EventDialogShown is the method name of the FedCm.dialogShown event.
+const EventDialogShown = "FedCm.dialogShown"
+
+// DialogShownEvent is the payload of the FedCm.dialogShown event.
+type DialogShownEvent struct {
+	DialogID   string     `json:"dialogId"`
+	DialogType DialogType `json:"dialogType"`
+	Accounts   []Account  `json:"accounts"`
+	// These exist primarily so that the caller can verify the
+	// RP context was used appropriately.
+	Title    string `json:"title"`
+	Subtitle string `json:"subtitle,omitempty"`
+}
+
+// EventDialogClosed is the method name of the FedCm.dialogClosed event.
+const EventDialogClosed = "FedCm.dialogClosed"
+
+// DialogClosedEvent is the payload of the FedCm.dialogClosed event.
+//
+// Triggered when a dialog is closed, either by user action, JS abort,
+// or a command below.
+type DialogClosedEvent struct {
+	DialogID string `json:"dialogId"`
+}
+
+// ParseEvent decodes a FedCm event message into its typed event struct.
+func ParseEvent(msg *internal.CDPMessage) (any, error) {
+	var v any
+	switch msg.Method {
+	case EventDialogShown:
+		v = &DialogShownEvent{}
+	case EventDialogClosed:
+		v = &DialogClosedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown FedCm event: %s", msg.Method)
+	}
+	if msg.Params == nil {
+		return v, nil
+	}
+	err := json.Unmarshal(msg.Params, v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,63 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package filesystem provides typed bindings for the CDP FileSystem domain.
+package filesystem
+
+import (
+	"cdp/internal"
+	"cdp/protocol/network"
+	"cdp/protocol/storage"
+	"context"
+)
+
+// File is the FileSystem.File type.
+type File struct {
+	Name string `json:"name"`
+	// Timestamp
+	LastModified network.TimeSinceEpoch `json:"lastModified"`
+	// Size in bytes
+	Size float64 `json:"size"`
+	Type string  `json:"type"`
+}
+
+// Directory is the FileSystem.Directory type.
+type Directory struct {
+	Name              string   `json:"name"`
+	NestedDirectories []string `json:"nestedDirectories"`
+	// Files that are directly nested under this directory.
+	NestedFiles []File `json:"nestedFiles"`
+}
+
+// BucketFileSystemLocator is the FileSystem.BucketFileSystemLocator type.
+type BucketFileSystemLocator struct {
+	// Storage key
+	StorageKey storage.SerializedStorageKey `json:"storageKey"`
+	// Bucket name. Not passing a `bucketName` will retrieve the default Bucket. (https://developer.mozilla.org/en-US/docs/Web/API/Storage_API#storage_buckets)
+	BucketName string `json:"bucketName,omitempty"`
+	// Path to the directory using each path component as an array item.
+	PathComponents []string `json:"pathComponents"`
+}
+
+// GetDirectoryParams holds the parameters of FileSystem.getDirectory.
+type GetDirectoryParams struct {
+	BucketFileSystemLocator BucketFileSystemLocator `json:"bucketFileSystemLocator"`
+}
+
+// GetDirectoryResult holds the result of FileSystem.getDirectory.
+type GetDirectoryResult struct {
+	// Returns the directory object at the path.
+	Directory Directory `json:"directory"`
+}
+
+// GetDirectory calls FileSystem.getDirectory.
+func GetDirectory(ctx context.Context, c *internal.Client, sessionID string, params *GetDirectoryParams) (*GetDirectoryResult, error) {
+	if params == nil {
+		params = &GetDirectoryParams{}
+	}
+	var result GetDirectoryResult
+	err := c.Call(ctx, "FileSystem.getDirectory", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,86 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package headlessexperimental provides typed bindings for the CDP HeadlessExperimental domain.
+//
+// This domain provides experimental commands only supported in headless mode.
+package headlessexperimental
+
+import (
+	"cdp/internal"
+	"context"
+)
+
+// ScreenshotParams is the HeadlessExperimental.ScreenshotParams type.
+//
+// Encoding options for a screenshot.
+type ScreenshotParams struct {
+	// Image compression format (defaults to png).
+	Format string `json:"format,omitempty"`
+	// Compression quality from range [0..100] (jpeg and webp only).
+	Quality *int64 `json:"quality,omitempty"`
+	// Optimize image encoding for speed, not for resulting size (defaults to false)
+	OptimizeForSpeed *bool `json:"optimizeForSpeed,omitempty"`
+}
+
+// BeginFrameParams holds the parameters of HeadlessExperimental.beginFrame.
+type BeginFrameParams struct {
+	// Timestamp of this BeginFrame in Renderer TimeTicks (milliseconds of uptime). If not set,
+	// the current time will be used.
+	FrameTimeTicks *float64 `json:"frameTimeTicks,omitempty"`
+	// The interval between BeginFrames that is reported to the compositor, in milliseconds.
+	// Defaults to a 60 frames/second interval, i.e. about 16.666 milliseconds.
+	Interval *float64 `json:"interval,omitempty"`
+	// Whether updates should not be committed and drawn onto the display. False by default. If
+	// true, only side effects of the BeginFrame will be run, such as layout and animations, but
+	// any visual updates may not be visible on the display or in screenshots.
+	NoDisplayUpdates *bool `json:"noDisplayUpdates,omitempty"`
+	// If set, a screenshot of the frame will be captured and returned in the response. Otherwise,
+	// no screenshot will be captured. Note that capturing a screenshot can fail, for example,
+	// during renderer initialization. In such a case, no screenshot data will be returned.
+	Screenshot *ScreenshotParams `json:"screenshot,omitempty"`
+}
+
+// BeginFrameResult holds the result of HeadlessExperimental.beginFrame.
+type BeginFrameResult struct {
+	// Whether the BeginFrame resulted in damage and, thus, a new frame was committed to the
+	// display. Reported for diagnostic uses, may be removed in the future.
+	HasDamage bool `json:"hasDamage"`
+	// Base64-encoded image data of the screenshot, if one was requested and successfully taken. (Encoded as a base64 string when passed over JSON)
+	ScreenshotData string `json:"screenshotData,omitempty"`
+}
+
+// BeginFrame calls HeadlessExperimental.beginFrame.
+//
+// Sends a BeginFrame to the target and returns when the frame was completed. Optionally captures a
+// screenshot from the resulting frame. Requires that the target was created with enabled
+// BeginFrameControl. Designed for use with --run-all-compositor-stages-before-draw, see also
+// https://goo.gle/chrome-headless-rendering for more background.
+func BeginFrame(ctx context.Context, c *internal.Client, sessionID string, params *BeginFrameParams) (*BeginFrameResult, error) {
+	if params == nil {
+		params = &BeginFrameParams{}
+	}
+	var result BeginFrameResult
+	err := c.Call(ctx, "HeadlessExperimental.beginFrame", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Disable calls HeadlessExperimental.disable.
+//
+// Disables headless events for the target.
+//
+// Deprecated: this item is deprecated in the protocol.
+func Disable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "HeadlessExperimental.disable", nil, sessionID, nil)
+}
+
+// Enable calls HeadlessExperimental.enable.
+//
+// Enables headless events for the target.
+//
+// Deprecated: this item is deprecated in the protocol.
+func Enable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "HeadlessExperimental.enable", nil, sessionID, nil)
+}
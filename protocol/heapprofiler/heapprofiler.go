@@ -0,0 +1,338 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package heapprofiler provides typed bindings for the CDP HeapProfiler domain.
+package heapprofiler
+
+import (
+	"cdp/internal"
+	"cdp/protocol/runtime"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// HeapSnapshotObjectID is the HeapProfiler.HeapSnapshotObjectId type.
+//
+// Heap snapshot object id.
+type HeapSnapshotObjectID string
+
+// SamplingHeapProfileNode is the HeapProfiler.SamplingHeapProfileNode type.
+//
+// Sampling Heap Profile node. Holds callsite information, allocation statistics and child nodes.
+type SamplingHeapProfileNode struct {
+	// Function location.
+	CallFrame runtime.CallFrame `json:"callFrame"`
+	// Allocations size in bytes for the node excluding children.
+	SelfSize float64 `json:"selfSize"`
+	// Node id. Ids are unique across all profiles collected between startSampling and stopSampling.
+	ID int64 `json:"id"`
+	// Child nodes.
+	Children []SamplingHeapProfileNode `json:"children"`
+}
+
+// SamplingHeapProfileSample is the HeapProfiler.SamplingHeapProfileSample type.
+//
+// A single sample from a sampling profile.
+type SamplingHeapProfileSample struct {
+	// Allocation size in bytes attributed to the sample.
+	Size float64 `json:"size"`
+	// Id of the corresponding profile tree node.
+	NodeID int64 `json:"nodeId"`
+	// Time-ordered sample ordinal number. It is unique across all profiles retrieved
+	// between startSampling and stopSampling.
+	Ordinal float64 `json:"ordinal"`
+}
+
+// SamplingHeapProfile is the HeapProfiler.SamplingHeapProfile type.
+//
+// Sampling profile.
+type SamplingHeapProfile struct {
+	Head    SamplingHeapProfileNode     `json:"head"`
+	Samples []SamplingHeapProfileSample `json:"samples"`
+}
+
+// AddInspectedHeapObjectParams holds the parameters of HeapProfiler.addInspectedHeapObject.
+type AddInspectedHeapObjectParams struct {
+	// Heap snapshot object id to be accessible by means of $x command line API.
+	HeapObjectID HeapSnapshotObjectID `json:"heapObjectId"`
+}
+
+// AddInspectedHeapObject calls HeapProfiler.addInspectedHeapObject.
+//
+// Enables console to refer to the node with given id via $x (see Command Line API for more details
+// $x functions).
+func AddInspectedHeapObject(ctx context.Context, c *internal.Client, sessionID string, params *AddInspectedHeapObjectParams) error {
+	if params == nil {
+		params = &AddInspectedHeapObjectParams{}
+	}
+	return c.Call(ctx, "HeapProfiler.addInspectedHeapObject", params, sessionID, nil)
+}
+
+// CollectGarbage calls HeapProfiler.collectGarbage.
+func CollectGarbage(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "HeapProfiler.collectGarbage", nil, sessionID, nil)
+}
+
+// Disable calls HeapProfiler.disable.
+func Disable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "HeapProfiler.disable", nil, sessionID, nil)
+}
+
+// Enable calls HeapProfiler.enable.
+func Enable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "HeapProfiler.enable", nil, sessionID, nil)
+}
+
+// GetHeapObjectIDParams holds the parameters of HeapProfiler.getHeapObjectId.
+type GetHeapObjectIDParams struct {
+	// Identifier of the object to get heap object id for.
+	ObjectID runtime.RemoteObjectID `json:"objectId"`
+}
+
+// GetHeapObjectIDResult holds the result of HeapProfiler.getHeapObjectId.
+type GetHeapObjectIDResult struct {
+	// Id of the heap snapshot object corresponding to the passed remote object id.
+	HeapSnapshotObjectID HeapSnapshotObjectID `json:"heapSnapshotObjectId"`
+}
+
+// GetHeapObjectID calls HeapProfiler.getHeapObjectId.
+func GetHeapObjectID(ctx context.Context, c *internal.Client, sessionID string, params *GetHeapObjectIDParams) (*GetHeapObjectIDResult, error) {
+	if params == nil {
+		params = &GetHeapObjectIDParams{}
+	}
+	var result GetHeapObjectIDResult
+	err := c.Call(ctx, "HeapProfiler.getHeapObjectId", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetObjectByHeapObjectIDParams holds the parameters of HeapProfiler.getObjectByHeapObjectId.
+type GetObjectByHeapObjectIDParams struct {
+	ObjectID HeapSnapshotObjectID `json:"objectId"`
+	// Symbolic group name that can be used to release multiple objects.
+	ObjectGroup string `json:"objectGroup,omitempty"`
+}
+
+// GetObjectByHeapObjectIDResult holds the result of HeapProfiler.getObjectByHeapObjectId.
+type GetObjectByHeapObjectIDResult struct {
+	// Evaluation result.
+	Result runtime.RemoteObject `json:"result"`
+}
+
+// GetObjectByHeapObjectID calls HeapProfiler.getObjectByHeapObjectId.
+func GetObjectByHeapObjectID(ctx context.Context, c *internal.Client, sessionID string, params *GetObjectByHeapObjectIDParams) (*GetObjectByHeapObjectIDResult, error) {
+	if params == nil {
+		params = &GetObjectByHeapObjectIDParams{}
+	}
+	var result GetObjectByHeapObjectIDResult
+	err := c.Call(ctx, "HeapProfiler.getObjectByHeapObjectId", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetSamplingProfileResult holds the result of HeapProfiler.getSamplingProfile.
+type GetSamplingProfileResult struct {
+	// Return the sampling profile being collected.
+	Profile SamplingHeapProfile `json:"profile"`
+}
+
+// GetSamplingProfile calls HeapProfiler.getSamplingProfile.
+func GetSamplingProfile(ctx context.Context, c *internal.Client, sessionID string) (*GetSamplingProfileResult, error) {
+	var result GetSamplingProfileResult
+	err := c.Call(ctx, "HeapProfiler.getSamplingProfile", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// StartSamplingParams holds the parameters of HeapProfiler.startSampling.
+type StartSamplingParams struct {
+	// Average sample interval in bytes. Poisson distribution is used for the intervals. The
+	// default value is 32768 bytes.
+	SamplingInterval *float64 `json:"samplingInterval,omitempty"`
+	// By default, the sampling heap profiler reports only objects which are
+	// still alive when the profile is returned via getSamplingProfile or
+	// stopSampling, which is useful for determining what functions contribute
+	// the most to steady-state memory usage. This flag instructs the sampling
+	// heap profiler to also include information about objects discarded by
+	// major GC, which will show which functions cause large temporary memory
+	// usage or long GC pauses.
+	IncludeObjectsCollectedByMajorGC *bool `json:"includeObjectsCollectedByMajorGC,omitempty"`
+	// By default, the sampling heap profiler reports only objects which are
+	// still alive when the profile is returned via getSamplingProfile or
+	// stopSampling, which is useful for determining what functions contribute
+	// the most to steady-state memory usage. This flag instructs the sampling
+	// heap profiler to also include information about objects discarded by
+	// minor GC, which is useful when tuning a latency-sensitive application
+	// for minimal GC activity.
+	IncludeObjectsCollectedByMinorGC *bool `json:"includeObjectsCollectedByMinorGC,omitempty"`
+}
+
+// StartSampling calls HeapProfiler.startSampling.
+func StartSampling(ctx context.Context, c *internal.Client, sessionID string, params *StartSamplingParams) error {
+	if params == nil {
+		params = &StartSamplingParams{}
+	}
+	return c.Call(ctx, "HeapProfiler.startSampling", params, sessionID, nil)
+}
+
+// StartTrackingHeapObjectsParams holds the parameters of HeapProfiler.startTrackingHeapObjects.
+type StartTrackingHeapObjectsParams struct {
+	TrackAllocations *bool `json:"trackAllocations,omitempty"`
+}
+
+// StartTrackingHeapObjects calls HeapProfiler.startTrackingHeapObjects.
+func StartTrackingHeapObjects(ctx context.Context, c *internal.Client, sessionID string, params *StartTrackingHeapObjectsParams) error {
+	if params == nil {
+		params = &StartTrackingHeapObjectsParams{}
+	}
+	return c.Call(ctx, "HeapProfiler.startTrackingHeapObjects", params, sessionID, nil)
+}
+
+// StopSamplingResult holds the result of HeapProfiler.stopSampling.
+type StopSamplingResult struct {
+	// Recorded sampling heap profile.
+	Profile SamplingHeapProfile `json:"profile"`
+}
+
+// StopSampling calls HeapProfiler.stopSampling.
+func StopSampling(ctx context.Context, c *internal.Client, sessionID string) (*StopSamplingResult, error) {
+	var result StopSamplingResult
+	err := c.Call(ctx, "HeapProfiler.stopSampling", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// StopTrackingHeapObjectsParams holds the parameters of HeapProfiler.stopTrackingHeapObjects.
+type StopTrackingHeapObjectsParams struct {
+	// If true 'reportHeapSnapshotProgress' events will be generated while snapshot is being taken
+	// when the tracking is stopped.
+	ReportProgress *bool `json:"reportProgress,omitempty"`
+	// Deprecated in favor of `exposeInternals`.
+	//
+	// Deprecated: this item is deprecated in the protocol.
+	TreatGlobalObjectsAsRoots *bool `json:"treatGlobalObjectsAsRoots,omitempty"`
+	// If true, numerical values are included in the snapshot
+	CaptureNumericValue *bool `json:"captureNumericValue,omitempty"`
+	// If true, exposes internals of the snapshot.
+	//
+	// Experimental.
+	ExposeInternals *bool `json:"exposeInternals,omitempty"`
+}
+
+// StopTrackingHeapObjects calls HeapProfiler.stopTrackingHeapObjects.
+func StopTrackingHeapObjects(ctx context.Context, c *internal.Client, sessionID string, params *StopTrackingHeapObjectsParams) error {
+	if params == nil {
+		params = &StopTrackingHeapObjectsParams{}
+	}
+	return c.Call(ctx, "HeapProfiler.stopTrackingHeapObjects", params, sessionID, nil)
+}
+
+// TakeHeapSnapshotParams holds the parameters of HeapProfiler.takeHeapSnapshot.
+type TakeHeapSnapshotParams struct {
+	// If true 'reportHeapSnapshotProgress' events will be generated while snapshot is being taken.
+	ReportProgress *bool `json:"reportProgress,omitempty"`
+	// If true, a raw snapshot without artificial roots will be generated.
+	// Deprecated in favor of `exposeInternals`.
+	//
+	// Deprecated: this item is deprecated in the protocol.
+	TreatGlobalObjectsAsRoots *bool `json:"treatGlobalObjectsAsRoots,omitempty"`
+	// If true, numerical values are included in the snapshot
+	CaptureNumericValue *bool `json:"captureNumericValue,omitempty"`
+	// If true, exposes internals of the snapshot.
+	//
+	// Experimental.
+	ExposeInternals *bool `json:"exposeInternals,omitempty"`
+}
+
+// TakeHeapSnapshot calls HeapProfiler.takeHeapSnapshot.
+func TakeHeapSnapshot(ctx context.Context, c *internal.Client, sessionID string, params *TakeHeapSnapshotParams) error {
+	if params == nil {
+		params = &TakeHeapSnapshotParams{}
+	}
+	return c.Call(ctx, "HeapProfiler.takeHeapSnapshot", params, sessionID, nil)
+}
+
+// EventAddHeapSnapshotChunk is the method name of the HeapProfiler.addHeapSnapshotChunk event.
+const EventAddHeapSnapshotChunk = "HeapProfiler.addHeapSnapshotChunk"
+
+// AddHeapSnapshotChunkEvent is the payload of the HeapProfiler.addHeapSnapshotChunk event.
+type AddHeapSnapshotChunkEvent struct {
+	Chunk string `json:"chunk"`
+}
+
+// EventHeapStatsUpdate is the method name of the HeapProfiler.heapStatsUpdate event.
+const EventHeapStatsUpdate = "HeapProfiler.heapStatsUpdate"
+
+// HeapStatsUpdateEvent is the payload of the HeapProfiler.heapStatsUpdate event.
+//
+// If heap objects tracking has been started then backend may send update for one or more fragments
+type HeapStatsUpdateEvent struct {
+	// An array of triplets. Each triplet describes a fragment. The first integer is the fragment
+	// index, the second integer is a total count of objects for the fragment, the third integer is
+	// a total size of the objects for the fragment.
+	StatsUpdate []int64 `json:"statsUpdate"`
+}
+
+// EventLastSeenObjectID is the method name of the HeapProfiler.lastSeenObjectId event.
+const EventLastSeenObjectID = "HeapProfiler.lastSeenObjectId"
+
+// LastSeenObjectIDEvent is the payload of the HeapProfiler.lastSeenObjectId event.
+//
+// If heap objects tracking has been started then backend regularly sends a current value for last
+// seen object id and corresponding timestamp. If the were changes in the heap since last event
+// then one or more heapStatsUpdate events will be sent before a new lastSeenObjectId event.
+type LastSeenObjectIDEvent struct {
+	LastSeenObjectID int64   `json:"lastSeenObjectId"`
+	Timestamp        float64 `json:"timestamp"`
+}
+
+// EventReportHeapSnapshotProgress is the method name of the HeapProfiler.reportHeapSnapshotProgress event.
+const EventReportHeapSnapshotProgress = "HeapProfiler.reportHeapSnapshotProgress"
+
+// ReportHeapSnapshotProgressEvent is the payload of the HeapProfiler.reportHeapSnapshotProgress event.
+type ReportHeapSnapshotProgressEvent struct {
+	Done     int64 `json:"done"`
+	Total    int64 `json:"total"`
+	Finished *bool `json:"finished,omitempty"`
+}
+
+// EventResetProfiles is the method name of the HeapProfiler.resetProfiles event.
+const EventResetProfiles = "HeapProfiler.resetProfiles"
+
+// ResetProfilesEvent is the payload of the HeapProfiler.resetProfiles event.
+type ResetProfilesEvent struct{}
+
+// ParseEvent decodes a HeapProfiler event message into its typed event struct.
+func ParseEvent(msg *internal.CDPMessage) (any, error) {
+	var v any
+	switch msg.Method {
+	case EventAddHeapSnapshotChunk:
+		v = &AddHeapSnapshotChunkEvent{}
+	case EventHeapStatsUpdate:
+		v = &HeapStatsUpdateEvent{}
+	case EventLastSeenObjectID:
+		v = &LastSeenObjectIDEvent{}
+	case EventReportHeapSnapshotProgress:
+		v = &ReportHeapSnapshotProgressEvent{}
+	case EventResetProfiles:
+		v = &ResetProfilesEvent{}
+	default:
+		return nil, fmt.Errorf("unknown HeapProfiler event: %s", msg.Method)
+	}
+	if msg.Params == nil {
+		return v, nil
+	}
+	err := json.Unmarshal(msg.Params, v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,345 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package indexeddb provides typed bindings for the CDP IndexedDB domain.
+package indexeddb
+
+import (
+	"cdp/internal"
+	"cdp/protocol/runtime"
+	"cdp/protocol/storage"
+	"context"
+)
+
+// DatabaseWithObjectStores is the IndexedDB.DatabaseWithObjectStores type.
+//
+// Database with an array of object stores.
+type DatabaseWithObjectStores struct {
+	// Database name.
+	Name string `json:"name"`
+	// Database version (type is not 'integer', as the standard
+	// requires the version number to be 'unsigned long long')
+	Version float64 `json:"version"`
+	// Object stores in this database.
+	ObjectStores []ObjectStore `json:"objectStores"`
+}
+
+// ObjectStore is the IndexedDB.ObjectStore type.
+//
+// Object store.
+type ObjectStore struct {
+	// Object store name.
+	Name string `json:"name"`
+	// Object store key path.
+	KeyPath KeyPath `json:"keyPath"`
+	// If true, object store has auto increment flag set.
+	AutoIncrement bool `json:"autoIncrement"`
+	// Indexes in this object store.
+	Indexes []ObjectStoreIndex `json:"indexes"`
+}
+
+// ObjectStoreIndex is the IndexedDB.ObjectStoreIndex type.
+//
+// Object store index.
+type ObjectStoreIndex struct {
+	// Index name.
+	Name string `json:"name"`
+	// Index key path.
+	KeyPath KeyPath `json:"keyPath"`
+	// If true, index is unique.
+	Unique bool `json:"unique"`
+	// If true, index allows multiple entries for a key.
+	MultiEntry bool `json:"multiEntry"`
+}
+
+// Key is the IndexedDB.Key type.
+//
+// Key.
+type Key struct {
+	// Key type.
+	Type string `json:"type"`
+	// Number value.
+	Number *float64 `json:"number,omitempty"`
+	// String value.
+	String string `json:"string,omitempty"`
+	// Date value.
+	Date *float64 `json:"date,omitempty"`
+	// Array value.
+	Array []Key `json:"array,omitempty"`
+}
+
+// KeyRange is the IndexedDB.KeyRange type.
+//
+// Key range.
+type KeyRange struct {
+	// Lower bound.
+	Lower *Key `json:"lower,omitempty"`
+	// Upper bound.
+	Upper *Key `json:"upper,omitempty"`
+	// If true lower bound is open.
+	LowerOpen bool `json:"lowerOpen"`
+	// If true upper bound is open.
+	UpperOpen bool `json:"upperOpen"`
+}
+
+// DataEntry is the IndexedDB.DataEntry type.
+//
+// Data entry.
+type DataEntry struct {
+	// Key object.
+	Key runtime.RemoteObject `json:"key"`
+	// Primary key object.
+	PrimaryKey runtime.RemoteObject `json:"primaryKey"`
+	// Value object.
+	Value runtime.RemoteObject `json:"value"`
+}
+
+// KeyPath is the IndexedDB.KeyPath type.
+//
+// Key path.
+type KeyPath struct {
+	// Key path type.
+	Type string `json:"type"`
+	// String value.
+	String string `json:"string,omitempty"`
+	// Array value.
+	Array []string `json:"array,omitempty"`
+}
+
+// ClearObjectStoreParams holds the parameters of IndexedDB.clearObjectStore.
+type ClearObjectStoreParams struct {
+	// At least and at most one of securityOrigin, storageKey, or storageBucket must be specified.
+	// Security origin.
+	SecurityOrigin string `json:"securityOrigin,omitempty"`
+	// Storage key.
+	StorageKey string `json:"storageKey,omitempty"`
+	// Storage bucket. If not specified, it uses the default bucket.
+	StorageBucket *storage.StorageBucket `json:"storageBucket,omitempty"`
+	// Database name.
+	DatabaseName string `json:"databaseName"`
+	// Object store name.
+	ObjectStoreName string `json:"objectStoreName"`
+}
+
+// ClearObjectStore calls IndexedDB.clearObjectStore.
+//
+// Clears all entries from an object store.
+func ClearObjectStore(ctx context.Context, c *internal.Client, sessionID string, params *ClearObjectStoreParams) error {
+	if params == nil {
+		params = &ClearObjectStoreParams{}
+	}
+	return c.Call(ctx, "IndexedDB.clearObjectStore", params, sessionID, nil)
+}
+
+// DeleteDatabaseParams holds the parameters of IndexedDB.deleteDatabase.
+type DeleteDatabaseParams struct {
+	// At least and at most one of securityOrigin, storageKey, or storageBucket must be specified.
+	// Security origin.
+	SecurityOrigin string `json:"securityOrigin,omitempty"`
+	// Storage key.
+	StorageKey string `json:"storageKey,omitempty"`
+	// Storage bucket. If not specified, it uses the default bucket.
+	StorageBucket *storage.StorageBucket `json:"storageBucket,omitempty"`
+	// Database name.
+	DatabaseName string `json:"databaseName"`
+}
+
+// DeleteDatabase calls IndexedDB.deleteDatabase.
+//
+// Deletes a database.
+func DeleteDatabase(ctx context.Context, c *internal.Client, sessionID string, params *DeleteDatabaseParams) error {
+	if params == nil {
+		params = &DeleteDatabaseParams{}
+	}
+	return c.Call(ctx, "IndexedDB.deleteDatabase", params, sessionID, nil)
+}
+
+// DeleteObjectStoreEntriesParams holds the parameters of IndexedDB.deleteObjectStoreEntries.
+type DeleteObjectStoreEntriesParams struct {
+	// At least and at most one of securityOrigin, storageKey, or storageBucket must be specified.
+	// Security origin.
+	SecurityOrigin string `json:"securityOrigin,omitempty"`
+	// Storage key.
+	StorageKey string `json:"storageKey,omitempty"`
+	// Storage bucket. If not specified, it uses the default bucket.
+	StorageBucket   *storage.StorageBucket `json:"storageBucket,omitempty"`
+	DatabaseName    string                 `json:"databaseName"`
+	ObjectStoreName string                 `json:"objectStoreName"`
+	// Range of entry keys to delete
+	KeyRange KeyRange `json:"keyRange"`
+}
+
+// DeleteObjectStoreEntries calls IndexedDB.deleteObjectStoreEntries.
+//
+// Delete a range of entries from an object store
+func DeleteObjectStoreEntries(ctx context.Context, c *internal.Client, sessionID string, params *DeleteObjectStoreEntriesParams) error {
+	if params == nil {
+		params = &DeleteObjectStoreEntriesParams{}
+	}
+	return c.Call(ctx, "IndexedDB.deleteObjectStoreEntries", params, sessionID, nil)
+}
+
+// Disable calls IndexedDB.disable.
+//
+// Disables events from backend.
+func Disable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "IndexedDB.disable", nil, sessionID, nil)
+}
+
+// Enable calls IndexedDB.enable.
+//
+// Enables events from backend.
+func Enable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "IndexedDB.enable", nil, sessionID, nil)
+}
+
+// RequestDataParams holds the parameters of IndexedDB.requestData.
+type RequestDataParams struct {
+	// At least and at most one of securityOrigin, storageKey, or storageBucket must be specified.
+	// Security origin.
+	SecurityOrigin string `json:"securityOrigin,omitempty"`
+	// Storage key.
+	StorageKey string `json:"storageKey,omitempty"`
+	// Storage bucket. If not specified, it uses the default bucket.
+	StorageBucket *storage.StorageBucket `json:"storageBucket,omitempty"`
+	// Database name.
+	DatabaseName string `json:"databaseName"`
+	// Object store name.
+	ObjectStoreName string `json:"objectStoreName"`
+	// Index name, empty string for object store data requests.
+	IndexName string `json:"indexName"`
+	// Number of records to skip.
+	SkipCount int64 `json:"skipCount"`
+	// Number of records to fetch.
+	PageSize int64 `json:"pageSize"`
+	// Key range.
+	KeyRange *KeyRange `json:"keyRange,omitempty"`
+}
+
+// RequestDataResult holds the result of IndexedDB.requestData.
+type RequestDataResult struct {
+	// Array of object store data entries.
+	ObjectStoreDataEntries []DataEntry `json:"objectStoreDataEntries"`
+	// If true, there are more entries to fetch in the given range.
+	HasMore bool `json:"hasMore"`
+}
+
+// RequestData calls IndexedDB.requestData.
+//
+// Requests data from object store or index.
+func RequestData(ctx context.Context, c *internal.Client, sessionID string, params *RequestDataParams) (*RequestDataResult, error) {
+	if params == nil {
+		params = &RequestDataParams{}
+	}
+	var result RequestDataResult
+	err := c.Call(ctx, "IndexedDB.requestData", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetMetadataParams holds the parameters of IndexedDB.getMetadata.
+type GetMetadataParams struct {
+	// At least and at most one of securityOrigin, storageKey, or storageBucket must be specified.
+	// Security origin.
+	SecurityOrigin string `json:"securityOrigin,omitempty"`
+	// Storage key.
+	StorageKey string `json:"storageKey,omitempty"`
+	// Storage bucket. If not specified, it uses the default bucket.
+	StorageBucket *storage.StorageBucket `json:"storageBucket,omitempty"`
+	// Database name.
+	DatabaseName string `json:"databaseName"`
+	// Object store name.
+	ObjectStoreName string `json:"objectStoreName"`
+}
+
+// GetMetadataResult holds the result of IndexedDB.getMetadata.
+type GetMetadataResult struct {
+	// the entries count
+	EntriesCount float64 `json:"entriesCount"`
+	// the current value of key generator, to become the next inserted
+	// key into the object store. Valid if objectStore.autoIncrement
+	// is true.
+	KeyGeneratorValue float64 `json:"keyGeneratorValue"`
+}
+
+// GetMetadata calls IndexedDB.getMetadata.
+//
+// Gets metadata of an object store.
+func GetMetadata(ctx context.Context, c *internal.Client, sessionID string, params *GetMetadataParams) (*GetMetadataResult, error) {
+	if params == nil {
+		params = &GetMetadataParams{}
+	}
+	var result GetMetadataResult
+	err := c.Call(ctx, "IndexedDB.getMetadata", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// RequestDatabaseParams holds the parameters of IndexedDB.requestDatabase.
+type RequestDatabaseParams struct {
+	// At least and at most one of securityOrigin, storageKey, or storageBucket must be specified.
+	// Security origin.
+	SecurityOrigin string `json:"securityOrigin,omitempty"`
+	// Storage key.
+	StorageKey string `json:"storageKey,omitempty"`
+	// Storage bucket. If not specified, it uses the default bucket.
+	StorageBucket *storage.StorageBucket `json:"storageBucket,omitempty"`
+	// Database name.
+	DatabaseName string `json:"databaseName"`
+}
+
+// RequestDatabaseResult holds the result of IndexedDB.requestDatabase.
+type RequestDatabaseResult struct {
+	// Database with an array of object stores.
+	DatabaseWithObjectStores DatabaseWithObjectStores `json:"databaseWithObjectStores"`
+}
+
+// RequestDatabase calls IndexedDB.requestDatabase.
+//
+// Requests database with given name in given frame.
+func RequestDatabase(ctx context.Context, c *internal.Client, sessionID string, params *RequestDatabaseParams) (*RequestDatabaseResult, error) {
+	if params == nil {
+		params = &RequestDatabaseParams{}
+	}
+	var result RequestDatabaseResult
+	err := c.Call(ctx, "IndexedDB.requestDatabase", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// RequestDatabaseNamesParams holds the parameters of IndexedDB.requestDatabaseNames.
+type RequestDatabaseNamesParams struct {
+	// At least and at most one of securityOrigin, storageKey, or storageBucket must be specified.
+	// Security origin.
+	SecurityOrigin string `json:"securityOrigin,omitempty"`
+	// Storage key.
+	StorageKey string `json:"storageKey,omitempty"`
+	// Storage bucket. If not specified, it uses the default bucket.
+	StorageBucket *storage.StorageBucket `json:"storageBucket,omitempty"`
+}
+
+// RequestDatabaseNamesResult holds the result of IndexedDB.requestDatabaseNames.
+type RequestDatabaseNamesResult struct {
+	// Database names for origin.
+	DatabaseNames []string `json:"databaseNames"`
+}
+
+// RequestDatabaseNames calls IndexedDB.requestDatabaseNames.
+//
+// Requests database names for given security origin.
+func RequestDatabaseNames(ctx context.Context, c *internal.Client, sessionID string, params *RequestDatabaseNamesParams) (*RequestDatabaseNamesResult, error) {
+	if params == nil {
+		params = &RequestDatabaseNamesParams{}
+	}
+	var result RequestDatabaseNamesResult
+	err := c.Call(ctx, "IndexedDB.requestDatabaseNames", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,510 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package input provides typed bindings for the CDP Input domain.
+package input
+
+import (
+	"cdp/internal"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// TouchPoint is the Input.TouchPoint type.
+type TouchPoint struct {
+	// X coordinate of the event relative to the main frame's viewport in CSS pixels.
+	X float64 `json:"x"`
+	// Y coordinate of the event relative to the main frame's viewport in CSS pixels. 0 refers to
+	// the top of the viewport and Y increases as it proceeds towards the bottom of the viewport.
+	Y float64 `json:"y"`
+	// X radius of the touch area (default: 1.0).
+	RadiusX *float64 `json:"radiusX,omitempty"`
+	// Y radius of the touch area (default: 1.0).
+	RadiusY *float64 `json:"radiusY,omitempty"`
+	// Rotation angle (default: 0.0).
+	RotationAngle *float64 `json:"rotationAngle,omitempty"`
+	// Force (default: 1.0).
+	Force *float64 `json:"force,omitempty"`
+	// The normalized tangential pressure, which has a range of [-1,1] (default: 0).
+	//
+	// Experimental.
+	TangentialPressure *float64 `json:"tangentialPressure,omitempty"`
+	// The plane angle between the Y-Z plane and the plane containing both the stylus axis and the Y axis, in degrees of the range [-90,90], a positive tiltX is to the right (default: 0)
+	TiltX *float64 `json:"tiltX,omitempty"`
+	// The plane angle between the X-Z plane and the plane containing both the stylus axis and the X axis, in degrees of the range [-90,90], a positive tiltY is towards the user (default: 0).
+	TiltY *float64 `json:"tiltY,omitempty"`
+	// The clockwise rotation of a pen stylus around its own major axis, in degrees in the range [0,359] (default: 0).
+	//
+	// Experimental.
+	Twist *int64 `json:"twist,omitempty"`
+	// Identifier used to track touch sources between events, must be unique within an event.
+	ID *float64 `json:"id,omitempty"`
+}
+
+// GestureSourceType is the Input.GestureSourceType type.
+//
+// Experimental.
+type GestureSourceType string
+
+const (
+	GestureSourceTypeDefault GestureSourceType = "default"
+	GestureSourceTypeTouch   GestureSourceType = "touch"
+	GestureSourceTypeMouse   GestureSourceType = "mouse"
+)
+
+// MouseButton is the Input.MouseButton type.
+type MouseButton string
+
+const (
+	MouseButtonNone    MouseButton = "none"
+	MouseButtonLeft    MouseButton = "left"
+	MouseButtonMiddle  MouseButton = "middle"
+	MouseButtonRight   MouseButton = "right"
+	MouseButtonBack    MouseButton = "back"
+	MouseButtonForward MouseButton = "forward"
+)
+
+// TimeSinceEpoch is the Input.TimeSinceEpoch type.
+//
+// UTC time in seconds, counted from January 1, 1970.
+type TimeSinceEpoch float64
+
+// DragDataItem is the Input.DragDataItem type.
+//
+// Experimental.
+type DragDataItem struct {
+	// Mime type of the dragged data.
+	MimeType string `json:"mimeType"`
+	// Depending of the value of `mimeType`, it contains the dragged link,
+	// text, HTML markup or any other data.
+	Data string `json:"data"`
+	// Title associated with a link. Only valid when `mimeType` == "text/uri-list".
+	Title string `json:"title,omitempty"`
+	// Stores the base URL for the contained markup. Only valid when `mimeType`
+	// == "text/html".
+	BaseURL string `json:"baseURL,omitempty"`
+}
+
+// DragData is the Input.DragData type.
+//
+// Experimental.
+type DragData struct {
+	Items []DragDataItem `json:"items"`
+	// List of filenames that should be included when dropping
+	Files []string `json:"files,omitempty"`
+	// Bit field representing allowed drag operations. Copy = 1, Link = 2, Move = 16
+	DragOperationsMask int64 `json:"dragOperationsMask"`
+}
+
+// DispatchDragEventParams holds the parameters of Input.dispatchDragEvent.
+type DispatchDragEventParams struct {
+	// Type of the drag event.
+	Type string `json:"type"`
+	// X coordinate of the event relative to the main frame's viewport in CSS pixels.
+	X float64 `json:"x"`
+	// Y coordinate of the event relative to the main frame's viewport in CSS pixels. 0 refers to
+	// the top of the viewport and Y increases as it proceeds towards the bottom of the viewport.
+	Y    float64  `json:"y"`
+	Data DragData `json:"data"`
+	// Bit field representing pressed modifier keys. Alt=1, Ctrl=2, Meta/Command=4, Shift=8
+	// (default: 0).
+	Modifiers *int64 `json:"modifiers,omitempty"`
+}
+
+// DispatchDragEvent calls Input.dispatchDragEvent.
+//
+// Dispatches a drag event into the page.
+//
+// Experimental.
+func DispatchDragEvent(ctx context.Context, c *internal.Client, sessionID string, params *DispatchDragEventParams) error {
+	if params == nil {
+		params = &DispatchDragEventParams{}
+	}
+	return c.Call(ctx, "Input.dispatchDragEvent", params, sessionID, nil)
+}
+
+// DispatchKeyEventParams holds the parameters of Input.dispatchKeyEvent.
+type DispatchKeyEventParams struct {
+	// Type of the key event.
+	Type string `json:"type"`
+	// Bit field representing pressed modifier keys. Alt=1, Ctrl=2, Meta/Command=4, Shift=8
+	// (default: 0).
+	Modifiers *int64 `json:"modifiers,omitempty"`
+	// Time at which the event occurred.
+	Timestamp *TimeSinceEpoch `json:"timestamp,omitempty"`
+	// Text as generated by processing a virtual key code with a keyboard layout. Not needed for
+	// for `keyUp` and `rawKeyDown` events (default: "")
+	Text string `json:"text,omitempty"`
+	// Text that would have been generated by the keyboard if no modifiers were pressed (except for
+	// shift). Useful for shortcut (accelerator) key handling (default: "").
+	UnmodifiedText string `json:"unmodifiedText,omitempty"`
+	// Unique key identifier (e.g., 'U+0041') (default: "").
+	KeyIdentifier string `json:"keyIdentifier,omitempty"`
+	// Unique DOM defined string value for each physical key (e.g., 'KeyA') (default: "").
+	Code string `json:"code,omitempty"`
+	// Unique DOM defined string value describing the meaning of the key in the context of active
+	// modifiers, keyboard layout, etc (e.g., 'AltGr') (default: "").
+	Key string `json:"key,omitempty"`
+	// Windows virtual key code (default: 0).
+	WindowsVirtualKeyCode *int64 `json:"windowsVirtualKeyCode,omitempty"`
+	// Native virtual key code (default: 0).
+	NativeVirtualKeyCode *int64 `json:"nativeVirtualKeyCode,omitempty"`
+	// Whether the event was generated from auto repeat (default: false).
+	AutoRepeat *bool `json:"autoRepeat,omitempty"`
+	// Whether the event was generated from the keypad (default: false).
+	IsKeypad *bool `json:"isKeypad,omitempty"`
+	// Whether the event was a system key event (default: false).
+	IsSystemKey *bool `json:"isSystemKey,omitempty"`
+	// Whether the event was from the left or right side of the keyboard. 1=Left, 2=Right (default:
+	// 0).
+	Location *int64 `json:"location,omitempty"`
+	// Editing commands to send with the key event (e.g., 'selectAll') (default: []).
+	// These are related to but not equal the command names used in `document.execCommand` and NSStandardKeyBindingResponding.
+	// See https://source.chromium.org/chromium/chromium/src/+/main:third_party/blink/renderer/core/editing/commands/editor_command_names.h for valid command names.
+	//
+	// Experimental.
+	Commands []string `json:"commands,omitempty"`
+}
+
+// DispatchKeyEvent calls Input.dispatchKeyEvent.
+//
+// Dispatches a key event to the page.
+func DispatchKeyEvent(ctx context.Context, c *internal.Client, sessionID string, params *DispatchKeyEventParams) error {
+	if params == nil {
+		params = &DispatchKeyEventParams{}
+	}
+	return c.Call(ctx, "Input.dispatchKeyEvent", params, sessionID, nil)
+}
+
+// InsertTextParams holds the parameters of Input.insertText.
+type InsertTextParams struct {
+	// The text to insert.
+	Text string `json:"text"`
+}
+
+// InsertText calls Input.insertText.
+//
+// This method emulates inserting text that doesn't come from a key press,
+// for example an emoji keyboard or an IME.
+//
+// Experimental.
+func InsertText(ctx context.Context, c *internal.Client, sessionID string, params *InsertTextParams) error {
+	if params == nil {
+		params = &InsertTextParams{}
+	}
+	return c.Call(ctx, "Input.insertText", params, sessionID, nil)
+}
+
+// ImeSetCompositionParams holds the parameters of Input.imeSetComposition.
+type ImeSetCompositionParams struct {
+	// The text to insert
+	Text string `json:"text"`
+	// selection start
+	SelectionStart int64 `json:"selectionStart"`
+	// selection end
+	SelectionEnd int64 `json:"selectionEnd"`
+	// replacement start
+	ReplacementStart *int64 `json:"replacementStart,omitempty"`
+	// replacement end
+	ReplacementEnd *int64 `json:"replacementEnd,omitempty"`
+}
+
+// ImeSetComposition calls Input.imeSetComposition.
+//
+// This method sets the current candidate text for IME.
+// Use imeCommitComposition to commit the final text.
+// Use imeSetComposition with empty string as text to cancel composition.
+//
+// Experimental.
+func ImeSetComposition(ctx context.Context, c *internal.Client, sessionID string, params *ImeSetCompositionParams) error {
+	if params == nil {
+		params = &ImeSetCompositionParams{}
+	}
+	return c.Call(ctx, "Input.imeSetComposition", params, sessionID, nil)
+}
+
+// DispatchMouseEventParams holds the parameters of Input.dispatchMouseEvent.
+type DispatchMouseEventParams struct {
+	// Type of the mouse event.
+	Type string `json:"type"`
+	// X coordinate of the event relative to the main frame's viewport in CSS pixels.
+	X float64 `json:"x"`
+	// Y coordinate of the event relative to the main frame's viewport in CSS pixels. 0 refers to
+	// the top of the viewport and Y increases as it proceeds towards the bottom of the viewport.
+	Y float64 `json:"y"`
+	// Bit field representing pressed modifier keys. Alt=1, Ctrl=2, Meta/Command=4, Shift=8
+	// (default: 0).
+	Modifiers *int64 `json:"modifiers,omitempty"`
+	// Time at which the event occurred.
+	Timestamp *TimeSinceEpoch `json:"timestamp,omitempty"`
+	// Mouse button (default: "none").
+	Button MouseButton `json:"button,omitempty"`
+	// A number indicating which buttons are pressed on the mouse when a mouse event is triggered.
+	// Left=1, Right=2, Middle=4, Back=8, Forward=16, None=0.
+	Buttons *int64 `json:"buttons,omitempty"`
+	// Number of times the mouse button was clicked (default: 0).
+	ClickCount *int64 `json:"clickCount,omitempty"`
+	// The normalized pressure, which has a range of [0,1] (default: 0).
+	//
+	// Experimental.
+	Force *float64 `json:"force,omitempty"`
+	// The normalized tangential pressure, which has a range of [-1,1] (default: 0).
+	//
+	// Experimental.
+	TangentialPressure *float64 `json:"tangentialPressure,omitempty"`
+	// The plane angle between the Y-Z plane and the plane containing both the stylus axis and the Y axis, in degrees of the range [-90,90], a positive tiltX is to the right (default: 0).
+	TiltX *float64 `json:"tiltX,omitempty"`
+	// The plane angle between the X-Z plane and the plane containing both the stylus axis and the X axis, in degrees of the range [-90,90], a positive tiltY is towards the user (default: 0).
+	TiltY *float64 `json:"tiltY,omitempty"`
+	// The clockwise rotation of a pen stylus around its own major axis, in degrees in the range [0,359] (default: 0).
+	//
+	// Experimental.
+	Twist *int64 `json:"twist,omitempty"`
+	// X delta in CSS pixels for mouse wheel event (default: 0).
+	DeltaX *float64 `json:"deltaX,omitempty"`
+	// Y delta in CSS pixels for mouse wheel event (default: 0).
+	DeltaY *float64 `json:"deltaY,omitempty"`
+	// Pointer type (default: "mouse").
+	PointerType string `json:"pointerType,omitempty"`
+}
+
+// DispatchMouseEvent calls Input.dispatchMouseEvent.
+//
+// Dispatches a mouse event to the page.
+func DispatchMouseEvent(ctx context.Context, c *internal.Client, sessionID string, params *DispatchMouseEventParams) error {
+	if params == nil {
+		params = &DispatchMouseEventParams{}
+	}
+	return c.Call(ctx, "Input.dispatchMouseEvent", params, sessionID, nil)
+}
+
+// DispatchTouchEventParams holds the parameters of Input.dispatchTouchEvent.
+type DispatchTouchEventParams struct {
+	// Type of the touch event. TouchEnd and TouchCancel must not contain any touch points, while
+	// TouchStart and TouchMove must contains at least one.
+	Type string `json:"type"`
+	// Active touch points on the touch device. One event per any changed point (compared to
+	// previous touch event in a sequence) is generated, emulating pressing/moving/releasing points
+	// one by one.
+	TouchPoints []TouchPoint `json:"touchPoints"`
+	// Bit field representing pressed modifier keys. Alt=1, Ctrl=2, Meta/Command=4, Shift=8
+	// (default: 0).
+	Modifiers *int64 `json:"modifiers,omitempty"`
+	// Time at which the event occurred.
+	Timestamp *TimeSinceEpoch `json:"timestamp,omitempty"`
+}
+
+// DispatchTouchEvent calls Input.dispatchTouchEvent.
+//
+// Dispatches a touch event to the page.
+func DispatchTouchEvent(ctx context.Context, c *internal.Client, sessionID string, params *DispatchTouchEventParams) error {
+	if params == nil {
+		params = &DispatchTouchEventParams{}
+	}
+	return c.Call(ctx, "Input.dispatchTouchEvent", params, sessionID, nil)
+}
+
+// CancelDragging calls Input.cancelDragging.
+//
+// Cancels any active dragging in the page.
+func CancelDragging(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Input.cancelDragging", nil, sessionID, nil)
+}
+
+// EmulateTouchFromMouseEventParams holds the parameters of Input.emulateTouchFromMouseEvent.
+type EmulateTouchFromMouseEventParams struct {
+	// Type of the mouse event.
+	Type string `json:"type"`
+	// X coordinate of the mouse pointer in DIP.
+	X int64 `json:"x"`
+	// Y coordinate of the mouse pointer in DIP.
+	Y int64 `json:"y"`
+	// Mouse button. Only "none", "left", "right" are supported.
+	Button MouseButton `json:"button"`
+	// Time at which the event occurred (default: current time).
+	Timestamp *TimeSinceEpoch `json:"timestamp,omitempty"`
+	// X delta in DIP for mouse wheel event (default: 0).
+	DeltaX *float64 `json:"deltaX,omitempty"`
+	// Y delta in DIP for mouse wheel event (default: 0).
+	DeltaY *float64 `json:"deltaY,omitempty"`
+	// Bit field representing pressed modifier keys. Alt=1, Ctrl=2, Meta/Command=4, Shift=8
+	// (default: 0).
+	Modifiers *int64 `json:"modifiers,omitempty"`
+	// Number of times the mouse button was clicked (default: 0).
+	ClickCount *int64 `json:"clickCount,omitempty"`
+}
+
+// EmulateTouchFromMouseEvent calls Input.emulateTouchFromMouseEvent.
+//
+// Emulates touch event from the mouse event parameters.
+//
+// Experimental.
+func EmulateTouchFromMouseEvent(ctx context.Context, c *internal.Client, sessionID string, params *EmulateTouchFromMouseEventParams) error {
+	if params == nil {
+		params = &EmulateTouchFromMouseEventParams{}
+	}
+	return c.Call(ctx, "Input.emulateTouchFromMouseEvent", params, sessionID, nil)
+}
+
+// SetIgnoreInputEventsParams holds the parameters of Input.setIgnoreInputEvents.
+type SetIgnoreInputEventsParams struct {
+	// Ignores input events processing when set to true.
+	Ignore bool `json:"ignore"`
+}
+
+// SetIgnoreInputEvents calls Input.setIgnoreInputEvents.
+//
+// Ignores input events (useful while auditing page).
+func SetIgnoreInputEvents(ctx context.Context, c *internal.Client, sessionID string, params *SetIgnoreInputEventsParams) error {
+	if params == nil {
+		params = &SetIgnoreInputEventsParams{}
+	}
+	return c.Call(ctx, "Input.setIgnoreInputEvents", params, sessionID, nil)
+}
+
+// SetInterceptDragsParams holds the parameters of Input.setInterceptDrags.
+type SetInterceptDragsParams struct {
+	Enabled bool `json:"enabled"`
+}
+
+// SetInterceptDrags calls Input.setInterceptDrags.
+//
+// Prevents default drag and drop behavior and instead emits `Input.dragIntercepted` events.
+// Drag and drop behavior can be directly controlled via `Input.dispatchDragEvent`.
+//
+// Experimental.
+func SetInterceptDrags(ctx context.Context, c *internal.Client, sessionID string, params *SetInterceptDragsParams) error {
+	if params == nil {
+		params = &SetInterceptDragsParams{}
+	}
+	return c.Call(ctx, "Input.setInterceptDrags", params, sessionID, nil)
+}
+
+// SynthesizePinchGestureParams holds the parameters of Input.synthesizePinchGesture.
+type SynthesizePinchGestureParams struct {
+	// X coordinate of the start of the gesture in CSS pixels.
+	X float64 `json:"x"`
+	// Y coordinate of the start of the gesture in CSS pixels.
+	Y float64 `json:"y"`
+	// Relative scale factor after zooming (>1.0 zooms in, <1.0 zooms out).
+	ScaleFactor float64 `json:"scaleFactor"`
+	// Relative pointer speed in pixels per second (default: 800).
+	RelativeSpeed *int64 `json:"relativeSpeed,omitempty"`
+	// Which type of input events to be generated (default: 'default', which queries the platform
+	// for the preferred input type).
+	GestureSourceType GestureSourceType `json:"gestureSourceType,omitempty"`
+}
+
+// SynthesizePinchGesture calls Input.synthesizePinchGesture.
+//
+// Synthesizes a pinch gesture over a time period by issuing appropriate touch events.
+//
+// Experimental.
+func SynthesizePinchGesture(ctx context.Context, c *internal.Client, sessionID string, params *SynthesizePinchGestureParams) error {
+	if params == nil {
+		params = &SynthesizePinchGestureParams{}
+	}
+	return c.Call(ctx, "Input.synthesizePinchGesture", params, sessionID, nil)
+}
+
+// SynthesizeScrollGestureParams holds the parameters of Input.synthesizeScrollGesture.
+type SynthesizeScrollGestureParams struct {
+	// X coordinate of the start of the gesture in CSS pixels.
+	X float64 `json:"x"`
+	// Y coordinate of the start of the gesture in CSS pixels.
+	Y float64 `json:"y"`
+	// The distance to scroll along the X axis (positive to scroll left).
+	XDistance *float64 `json:"xDistance,omitempty"`
+	// The distance to scroll along the Y axis (positive to scroll up).
+	YDistance *float64 `json:"yDistance,omitempty"`
+	// The number of additional pixels to scroll back along the X axis, in addition to the given
+	// distance.
+	XOverscroll *float64 `json:"xOverscroll,omitempty"`
+	// The number of additional pixels to scroll back along the Y axis, in addition to the given
+	// distance.
+	YOverscroll *float64 `json:"yOverscroll,omitempty"`
+	// Prevent fling (default: true).
+	PreventFling *bool `json:"preventFling,omitempty"`
+	// Swipe speed in pixels per second (default: 800).
+	Speed *int64 `json:"speed,omitempty"`
+	// Which type of input events to be generated (default: 'default', which queries the platform
+	// for the preferred input type).
+	GestureSourceType GestureSourceType `json:"gestureSourceType,omitempty"`
+	// The number of times to repeat the gesture (default: 0).
+	RepeatCount *int64 `json:"repeatCount,omitempty"`
+	// The number of milliseconds delay between each repeat. (default: 250).
+	RepeatDelayMs *int64 `json:"repeatDelayMs,omitempty"`
+	// The name of the interaction markers to generate, if not empty (default: "").
+	InteractionMarkerName string `json:"interactionMarkerName,omitempty"`
+}
+
+// SynthesizeScrollGesture calls Input.synthesizeScrollGesture.
+//
+// Synthesizes a scroll gesture over a time period by issuing appropriate touch events.
+//
+// Experimental.
+func SynthesizeScrollGesture(ctx context.Context, c *internal.Client, sessionID string, params *SynthesizeScrollGestureParams) error {
+	if params == nil {
+		params = &SynthesizeScrollGestureParams{}
+	}
+	return c.Call(ctx, "Input.synthesizeScrollGesture", params, sessionID, nil)
+}
+
+// SynthesizeTapGestureParams holds the parameters of Input.synthesizeTapGesture.
+type SynthesizeTapGestureParams struct {
+	// X coordinate of the start of the gesture in CSS pixels.
+	X float64 `json:"x"`
+	// Y coordinate of the start of the gesture in CSS pixels.
+	Y float64 `json:"y"`
+	// Duration between touchdown and touchup events in ms (default: 50).
+	Duration *int64 `json:"duration,omitempty"`
+	// Number of times to perform the tap (e.g. 2 for double tap, default: 1).
+	TapCount *int64 `json:"tapCount,omitempty"`
+	// Which type of input events to be generated (default: 'default', which queries the platform
+	// for the preferred input type).
+	GestureSourceType GestureSourceType `json:"gestureSourceType,omitempty"`
+}
+
+// SynthesizeTapGesture calls Input.synthesizeTapGesture.
+//
+// Synthesizes a tap gesture over a time period by issuing appropriate touch events.
+//
+// Experimental.
+func SynthesizeTapGesture(ctx context.Context, c *internal.Client, sessionID string, params *SynthesizeTapGestureParams) error {
+	if params == nil {
+		params = &SynthesizeTapGestureParams{}
+	}
+	return c.Call(ctx, "Input.synthesizeTapGesture", params, sessionID, nil)
+}
+
+// EventDragIntercepted is the method name of the Input.dragIntercepted event.
+const EventDragIntercepted = "Input.dragIntercepted"
+
+// DragInterceptedEvent is the payload of the Input.dragIntercepted event.
+//
+// Emitted only when `Input.setInterceptDrags` is enabled. Use this data with `Input.dispatchDragEvent` to
+// restore normal drag and drop behavior.
+//
+// Experimental.
+type DragInterceptedEvent struct {
+	Data DragData `json:"data"`
+}
+
+// ParseEvent decodes a Input event message into its typed event struct.
+func ParseEvent(msg *internal.CDPMessage) (any, error) {
+	var v any
+	switch msg.Method {
+	case EventDragIntercepted:
+		v = &DragInterceptedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Input event: %s", msg.Method)
+	}
+	if msg.Params == nil {
+		return v, nil
+	}
+	err := json.Unmarshal(msg.Params, v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
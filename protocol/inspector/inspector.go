@@ -0,0 +1,75 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package inspector provides typed bindings for the CDP Inspector domain.
+package inspector
+
+import (
+	"cdp/internal"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Disable calls Inspector.disable.
+//
+// Disables inspector domain notifications.
+func Disable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Inspector.disable", nil, sessionID, nil)
+}
+
+// Enable calls Inspector.enable.
+//
+// Enables inspector domain notifications.
+func Enable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Inspector.enable", nil, sessionID, nil)
+}
+
+// EventDetached is the method name of the Inspector.detached event.
+const EventDetached = "Inspector.detached"
+
+// DetachedEvent is the payload of the Inspector.detached event.
+//
+// Fired when remote debugging connection is about to be terminated. Contains detach reason.
+type DetachedEvent struct {
+	// The reason why connection has been terminated.
+	Reason string `json:"reason"`
+}
+
+// EventTargetCrashed is the method name of the Inspector.targetCrashed event.
+const EventTargetCrashed = "Inspector.targetCrashed"
+
+// TargetCrashedEvent is the payload of the Inspector.targetCrashed event.
+//
+// Fired when debugging target has crashed
+type TargetCrashedEvent struct{}
+
+// EventTargetReloadedAfterCrash is the method name of the Inspector.targetReloadedAfterCrash event.
+const EventTargetReloadedAfterCrash = "Inspector.targetReloadedAfterCrash"
+
+// TargetReloadedAfterCrashEvent is the payload of the Inspector.targetReloadedAfterCrash event.
+//
+// Fired when debugging target has reloaded after crash
+type TargetReloadedAfterCrashEvent struct{}
+
+// ParseEvent decodes a Inspector event message into its typed event struct.
+func ParseEvent(msg *internal.CDPMessage) (any, error) {
+	var v any
+	switch msg.Method {
+	case EventDetached:
+		v = &DetachedEvent{}
+	case EventTargetCrashed:
+		v = &TargetCrashedEvent{}
+	case EventTargetReloadedAfterCrash:
+		v = &TargetReloadedAfterCrashEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Inspector event: %s", msg.Method)
+	}
+	if msg.Params == nil {
+		return v, nil
+	}
+	err := json.Unmarshal(msg.Params, v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,97 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package io provides typed bindings for the CDP IO domain.
+//
+// Input/Output operations for streams produced by DevTools.
+package io
+
+import (
+	"cdp/internal"
+	"cdp/protocol/runtime"
+	"context"
+)
+
+// StreamHandle is the IO.StreamHandle type.
+//
+// This is either obtained from another method or specified as `blob:<uuid>` where
+// `<uuid>` is an UUID of a Blob.
+type StreamHandle string
+
+// CloseParams holds the parameters of IO.close.
+type CloseParams struct {
+	// Handle of the stream to close.
+	Handle StreamHandle `json:"handle"`
+}
+
+// Close calls IO.close.
+//
+// Close the stream, discard any temporary backing storage.
+func Close(ctx context.Context, c *internal.Client, sessionID string, params *CloseParams) error {
+	if params == nil {
+		params = &CloseParams{}
+	}
+	return c.Call(ctx, "IO.close", params, sessionID, nil)
+}
+
+// ReadParams holds the parameters of IO.read.
+type ReadParams struct {
+	// Handle of the stream to read.
+	Handle StreamHandle `json:"handle"`
+	// Seek to the specified offset before reading (if not specified, proceed with offset
+	// following the last read). Some types of streams may only support sequential reads.
+	Offset *int64 `json:"offset,omitempty"`
+	// Maximum number of bytes to read (left upon the agent discretion if not specified).
+	Size *int64 `json:"size,omitempty"`
+}
+
+// ReadResult holds the result of IO.read.
+type ReadResult struct {
+	// Set if the data is base64-encoded
+	Base64Encoded *bool `json:"base64Encoded,omitempty"`
+	// Data that were read.
+	Data string `json:"data"`
+	// Set if the end-of-file condition occurred while reading.
+	Eof bool `json:"eof"`
+}
+
+// Read calls IO.read.
+//
+// Read a chunk of the stream
+func Read(ctx context.Context, c *internal.Client, sessionID string, params *ReadParams) (*ReadResult, error) {
+	if params == nil {
+		params = &ReadParams{}
+	}
+	var result ReadResult
+	err := c.Call(ctx, "IO.read", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ResolveBlobParams holds the parameters of IO.resolveBlob.
+type ResolveBlobParams struct {
+	// Object id of a Blob object wrapper.
+	ObjectID runtime.RemoteObjectID `json:"objectId"`
+}
+
+// ResolveBlobResult holds the result of IO.resolveBlob.
+type ResolveBlobResult struct {
+	// UUID of the specified Blob.
+	UUID string `json:"uuid"`
+}
+
+// ResolveBlob calls IO.resolveBlob.
+//
+// Return UUID of Blob object specified by a remote object id.
+func ResolveBlob(ctx context.Context, c *internal.Client, sessionID string, params *ResolveBlobParams) (*ResolveBlobResult, error) {
+	if params == nil {
+		params = &ResolveBlobParams{}
+	}
+	var result ResolveBlobResult
+	err := c.Call(ctx, "IO.resolveBlob", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
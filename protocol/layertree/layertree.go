@@ -0,0 +1,347 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package layertree provides typed bindings for the CDP LayerTree domain.
+package layertree
+
+import (
+	"cdp/internal"
+	"cdp/protocol/dom"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// LayerID is the LayerTree.LayerId type.
+//
+// Unique Layer identifier.
+type LayerID string
+
+// SnapshotID is the LayerTree.SnapshotId type.
+//
+// Unique snapshot identifier.
+type SnapshotID string
+
+// ScrollRect is the LayerTree.ScrollRect type.
+//
+// Rectangle where scrolling happens on the main thread.
+type ScrollRect struct {
+	// Rectangle itself.
+	Rect dom.Rect `json:"rect"`
+	// Reason for rectangle to force scrolling on the main thread
+	Type string `json:"type"`
+}
+
+// StickyPositionConstraint is the LayerTree.StickyPositionConstraint type.
+//
+// Sticky position constraints.
+type StickyPositionConstraint struct {
+	// Layout rectangle of the sticky element before being shifted
+	StickyBoxRect dom.Rect `json:"stickyBoxRect"`
+	// Layout rectangle of the containing block of the sticky element
+	ContainingBlockRect dom.Rect `json:"containingBlockRect"`
+	// The nearest sticky layer that shifts the sticky box
+	NearestLayerShiftingStickyBox LayerID `json:"nearestLayerShiftingStickyBox,omitempty"`
+	// The nearest sticky layer that shifts the containing block
+	NearestLayerShiftingContainingBlock LayerID `json:"nearestLayerShiftingContainingBlock,omitempty"`
+}
+
+// PictureTile is the LayerTree.PictureTile type.
+//
+// Serialized fragment of layer picture along with its offset within the layer.
+type PictureTile struct {
+	// Offset from owning layer left boundary
+	X float64 `json:"x"`
+	// Offset from owning layer top boundary
+	Y float64 `json:"y"`
+	// Base64-encoded snapshot data. (Encoded as a base64 string when passed over JSON)
+	Picture string `json:"picture"`
+}
+
+// Layer is the LayerTree.Layer type.
+//
+// Information about a compositing layer.
+type Layer struct {
+	// The unique id for this layer.
+	LayerID LayerID `json:"layerId"`
+	// The id of parent (not present for root).
+	ParentLayerID LayerID `json:"parentLayerId,omitempty"`
+	// The backend id for the node associated with this layer.
+	BackendNodeID *dom.BackendNodeID `json:"backendNodeId,omitempty"`
+	// Offset from parent layer, X coordinate.
+	OffsetX float64 `json:"offsetX"`
+	// Offset from parent layer, Y coordinate.
+	OffsetY float64 `json:"offsetY"`
+	// Layer width.
+	Width float64 `json:"width"`
+	// Layer height.
+	Height float64 `json:"height"`
+	// Transformation matrix for layer, default is identity matrix
+	Transform []float64 `json:"transform,omitempty"`
+	// Transform anchor point X, absent if no transform specified
+	AnchorX *float64 `json:"anchorX,omitempty"`
+	// Transform anchor point Y, absent if no transform specified
+	AnchorY *float64 `json:"anchorY,omitempty"`
+	// Transform anchor point Z, absent if no transform specified
+	AnchorZ *float64 `json:"anchorZ,omitempty"`
+	// Indicates how many time this layer has painted.
+	PaintCount int64 `json:"paintCount"`
+	// Indicates whether this layer hosts any content, rather than being used for
+	// transform/scrolling purposes only.
+	DrawsContent bool `json:"drawsContent"`
+	// Set if layer is not visible.
+	Invisible *bool `json:"invisible,omitempty"`
+	// Rectangles scrolling on main thread only.
+	ScrollRects []ScrollRect `json:"scrollRects,omitempty"`
+	// Sticky position constraint information
+	StickyPositionConstraint *StickyPositionConstraint `json:"stickyPositionConstraint,omitempty"`
+}
+
+// PaintProfile is the LayerTree.PaintProfile type.
+//
+// Array of timings, one per paint step.
+type PaintProfile []float64
+
+// CompositingReasonsParams holds the parameters of LayerTree.compositingReasons.
+type CompositingReasonsParams struct {
+	// The id of the layer for which we want to get the reasons it was composited.
+	LayerID LayerID `json:"layerId"`
+}
+
+// CompositingReasonsResult holds the result of LayerTree.compositingReasons.
+type CompositingReasonsResult struct {
+	// A list of strings specifying reasons for the given layer to become composited.
+	CompositingReasons []string `json:"compositingReasons"`
+	// A list of strings specifying reason IDs for the given layer to become composited.
+	CompositingReasonIDs []string `json:"compositingReasonIds"`
+}
+
+// CompositingReasons calls LayerTree.compositingReasons.
+//
+// Provides the reasons why the given layer was composited.
+func CompositingReasons(ctx context.Context, c *internal.Client, sessionID string, params *CompositingReasonsParams) (*CompositingReasonsResult, error) {
+	if params == nil {
+		params = &CompositingReasonsParams{}
+	}
+	var result CompositingReasonsResult
+	err := c.Call(ctx, "LayerTree.compositingReasons", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Disable calls LayerTree.disable.
+//
+// Disables compositing tree inspection.
+func Disable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "LayerTree.disable", nil, sessionID, nil)
+}
+
+// Enable calls LayerTree.enable.
+//
+// Enables compositing tree inspection.
+func Enable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "LayerTree.enable", nil, sessionID, nil)
+}
+
+// LoadSnapshotParams holds the parameters of LayerTree.loadSnapshot.
+type LoadSnapshotParams struct {
+	// An array of tiles composing the snapshot.
+	Tiles []PictureTile `json:"tiles"`
+}
+
+// LoadSnapshotResult holds the result of LayerTree.loadSnapshot.
+type LoadSnapshotResult struct {
+	// The id of the snapshot.
+	SnapshotID SnapshotID `json:"snapshotId"`
+}
+
+// LoadSnapshot calls LayerTree.loadSnapshot.
+//
+// Returns the snapshot identifier.
+func LoadSnapshot(ctx context.Context, c *internal.Client, sessionID string, params *LoadSnapshotParams) (*LoadSnapshotResult, error) {
+	if params == nil {
+		params = &LoadSnapshotParams{}
+	}
+	var result LoadSnapshotResult
+	err := c.Call(ctx, "LayerTree.loadSnapshot", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// MakeSnapshotParams holds the parameters of LayerTree.makeSnapshot.
+type MakeSnapshotParams struct {
+	// The id of the layer.
+	LayerID LayerID `json:"layerId"`
+}
+
+// MakeSnapshotResult holds the result of LayerTree.makeSnapshot.
+type MakeSnapshotResult struct {
+	// The id of the layer snapshot.
+	SnapshotID SnapshotID `json:"snapshotId"`
+}
+
+// MakeSnapshot calls LayerTree.makeSnapshot.
+//
+// Returns the layer snapshot identifier.
+func MakeSnapshot(ctx context.Context, c *internal.Client, sessionID string, params *MakeSnapshotParams) (*MakeSnapshotResult, error) {
+	if params == nil {
+		params = &MakeSnapshotParams{}
+	}
+	var result MakeSnapshotResult
+	err := c.Call(ctx, "LayerTree.makeSnapshot", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ProfileSnapshotParams holds the parameters of LayerTree.profileSnapshot.
+type ProfileSnapshotParams struct {
+	// The id of the layer snapshot.
+	SnapshotID SnapshotID `json:"snapshotId"`
+	// The maximum number of times to replay the snapshot (1, if not specified).
+	MinRepeatCount *int64 `json:"minRepeatCount,omitempty"`
+	// The minimum duration (in seconds) to replay the snapshot.
+	MinDuration *float64 `json:"minDuration,omitempty"`
+	// The clip rectangle to apply when replaying the snapshot.
+	ClipRect *dom.Rect `json:"clipRect,omitempty"`
+}
+
+// ProfileSnapshotResult holds the result of LayerTree.profileSnapshot.
+type ProfileSnapshotResult struct {
+	// The array of paint profiles, one per run.
+	Timings []PaintProfile `json:"timings"`
+}
+
+// ProfileSnapshot calls LayerTree.profileSnapshot.
+func ProfileSnapshot(ctx context.Context, c *internal.Client, sessionID string, params *ProfileSnapshotParams) (*ProfileSnapshotResult, error) {
+	if params == nil {
+		params = &ProfileSnapshotParams{}
+	}
+	var result ProfileSnapshotResult
+	err := c.Call(ctx, "LayerTree.profileSnapshot", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ReleaseSnapshotParams holds the parameters of LayerTree.releaseSnapshot.
+type ReleaseSnapshotParams struct {
+	// The id of the layer snapshot.
+	SnapshotID SnapshotID `json:"snapshotId"`
+}
+
+// ReleaseSnapshot calls LayerTree.releaseSnapshot.
+//
+// Releases layer snapshot captured by the back-end.
+func ReleaseSnapshot(ctx context.Context, c *internal.Client, sessionID string, params *ReleaseSnapshotParams) error {
+	if params == nil {
+		params = &ReleaseSnapshotParams{}
+	}
+	return c.Call(ctx, "LayerTree.releaseSnapshot", params, sessionID, nil)
+}
+
+// ReplaySnapshotParams holds the parameters of LayerTree.replaySnapshot.
+type ReplaySnapshotParams struct {
+	// The id of the layer snapshot.
+	SnapshotID SnapshotID `json:"snapshotId"`
+	// The first step to replay from (replay from the very start if not specified).
+	FromStep *int64 `json:"fromStep,omitempty"`
+	// The last step to replay to (replay till the end if not specified).
+	ToStep *int64 `json:"toStep,omitempty"`
+	// The scale to apply while replaying (defaults to 1).
+	Scale *float64 `json:"scale,omitempty"`
+}
+
+// ReplaySnapshotResult holds the result of LayerTree.replaySnapshot.
+type ReplaySnapshotResult struct {
+	// A data: URL for resulting image.
+	DataURL string `json:"dataURL"`
+}
+
+// ReplaySnapshot calls LayerTree.replaySnapshot.
+//
+// Replays the layer snapshot and returns the resulting bitmap.
+func ReplaySnapshot(ctx context.Context, c *internal.Client, sessionID string, params *ReplaySnapshotParams) (*ReplaySnapshotResult, error) {
+	if params == nil {
+		params = &ReplaySnapshotParams{}
+	}
+	var result ReplaySnapshotResult
+	err := c.Call(ctx, "LayerTree.replaySnapshot", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SnapshotCommandLogParams holds the parameters of LayerTree.snapshotCommandLog.
+type SnapshotCommandLogParams struct {
+	// The id of the layer snapshot.
+	SnapshotID SnapshotID `json:"snapshotId"`
+}
+
+// SnapshotCommandLogResult holds the result of LayerTree.snapshotCommandLog.
+type SnapshotCommandLogResult struct {
+	// The array of canvas function calls.
+	CommandLog []map[string]any `json:"commandLog"`
+}
+
+// SnapshotCommandLog calls LayerTree.snapshotCommandLog.
+//
+// Replays the layer snapshot and returns canvas log.
+func SnapshotCommandLog(ctx context.Context, c *internal.Client, sessionID string, params *SnapshotCommandLogParams) (*SnapshotCommandLogResult, error) {
+	if params == nil {
+		params = &SnapshotCommandLogParams{}
+	}
+	var result SnapshotCommandLogResult
+	err := c.Call(ctx, "LayerTree.snapshotCommandLog", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// EventLayerPainted is the method name of the LayerTree.layerPainted event.
+const EventLayerPainted = "LayerTree.layerPainted"
+
+// LayerPaintedEvent is the payload of the LayerTree.layerPainted event.
+type LayerPaintedEvent struct {
+	// The id of the painted layer.
+	LayerID LayerID `json:"layerId"`
+	// Clip rectangle.
+	Clip dom.Rect `json:"clip"`
+}
+
+// EventLayerTreeDidChange is the method name of the LayerTree.layerTreeDidChange event.
+const EventLayerTreeDidChange = "LayerTree.layerTreeDidChange"
+
+// LayerTreeDidChangeEvent is the payload of the LayerTree.layerTreeDidChange event.
+type LayerTreeDidChangeEvent struct {
+	// Layer tree, absent if not in the compositing mode.
+	Layers []Layer `json:"layers,omitempty"`
+}
+
+// ParseEvent decodes a LayerTree event message into its typed event struct.
+func ParseEvent(msg *internal.CDPMessage) (any, error) {
+	var v any
+	switch msg.Method {
+	case EventLayerPainted:
+		v = &LayerPaintedEvent{}
+	case EventLayerTreeDidChange:
+		v = &LayerTreeDidChangeEvent{}
+	default:
+		return nil, fmt.Errorf("unknown LayerTree event: %s", msg.Method)
+	}
+	if msg.Params == nil {
+		return v, nil
+	}
+	err := json.Unmarshal(msg.Params, v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,127 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package log provides typed bindings for the CDP Log domain.
+//
+// Provides access to log entries.
+package log
+
+import (
+	"cdp/internal"
+	"cdp/protocol/network"
+	"cdp/protocol/runtime"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// LogEntry is the Log.LogEntry type.
+//
+// Log entry.
+type LogEntry struct {
+	// Log entry source.
+	Source string `json:"source"`
+	// Log entry severity.
+	Level string `json:"level"`
+	// Logged text.
+	Text     string `json:"text"`
+	Category string `json:"category,omitempty"`
+	// Timestamp when this entry was added.
+	Timestamp runtime.Timestamp `json:"timestamp"`
+	// URL of the resource if known.
+	URL string `json:"url,omitempty"`
+	// Line number in the resource.
+	LineNumber *int64 `json:"lineNumber,omitempty"`
+	// JavaScript stack trace.
+	StackTrace *runtime.StackTrace `json:"stackTrace,omitempty"`
+	// Identifier of the network request associated with this entry.
+	NetworkRequestID network.RequestID `json:"networkRequestId,omitempty"`
+	// Identifier of the worker associated with this entry.
+	WorkerID string `json:"workerId,omitempty"`
+	// Call arguments.
+	Args []runtime.RemoteObject `json:"args,omitempty"`
+}
+
+// ViolationSetting is the Log.ViolationSetting type.
+//
+// Violation configuration setting.
+type ViolationSetting struct {
+	// Violation type.
+	Name string `json:"name"`
+	// Time threshold to trigger upon.
+	Threshold float64 `json:"threshold"`
+}
+
+// Clear calls Log.clear.
+//
+// Clears the log.
+func Clear(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Log.clear", nil, sessionID, nil)
+}
+
+// Disable calls Log.disable.
+//
+// Disables log domain, prevents further log entries from being reported to the client.
+func Disable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Log.disable", nil, sessionID, nil)
+}
+
+// Enable calls Log.enable.
+//
+// Enables log domain, sends the entries collected so far to the client by means of the
+// `entryAdded` notification.
+func Enable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Log.enable", nil, sessionID, nil)
+}
+
+// StartViolationsReportParams holds the parameters of Log.startViolationsReport.
+type StartViolationsReportParams struct {
+	// Configuration for violations.
+	Config []ViolationSetting `json:"config"`
+}
+
+// StartViolationsReport calls Log.startViolationsReport.
+//
+// start violation reporting.
+func StartViolationsReport(ctx context.Context, c *internal.Client, sessionID string, params *StartViolationsReportParams) error {
+	if params == nil {
+		params = &StartViolationsReportParams{}
+	}
+	return c.Call(ctx, "Log.startViolationsReport", params, sessionID, nil)
+}
+
+// StopViolationsReport calls Log.stopViolationsReport.
+//
+// Stop violation reporting.
+func StopViolationsReport(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Log.stopViolationsReport", nil, sessionID, nil)
+}
+
+// EventEntryAdded is the method name of the Log.entryAdded event.
+const EventEntryAdded = "Log.entryAdded"
+
+// EntryAddedEvent is the payload of the Log.entryAdded event.
+//
+// Issued when new message was logged.
+type EntryAddedEvent struct {
+	// The entry.
+	Entry LogEntry `json:"entry"`
+}
+
+// ParseEvent decodes a Log event message into its typed event struct.
+func ParseEvent(msg *internal.CDPMessage) (any, error) {
+	var v any
+	switch msg.Method {
+	case EventEntryAdded:
+		v = &EntryAddedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Log event: %s", msg.Method)
+	}
+	if msg.Params == nil {
+		return v, nil
+	}
+	err := json.Unmarshal(msg.Params, v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
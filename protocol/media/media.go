@@ -0,0 +1,180 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package media provides typed bindings for the CDP Media domain.
+//
+// This domain allows detailed inspection of media elements
+package media
+
+import (
+	"cdp/internal"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// PlayerID is the Media.PlayerId type.
+//
+// Players will get an ID that is unique within the agent context.
+type PlayerID string
+
+// Timestamp is the Media.Timestamp type.
+type Timestamp float64
+
+// PlayerMessage is the Media.PlayerMessage type.
+//
+// Have one type per entry in MediaLogRecord::Type
+// Corresponds to kMessage
+type PlayerMessage struct {
+	// Keep in sync with MediaLogMessageLevel
+	// We are currently keeping the message level 'error' separate from the
+	// PlayerError type because right now they represent different things,
+	// this one being a DVLOG(ERROR) style log message that gets printed
+	// based on what log level is selected in the UI, and the other is a
+	// representation of a media::PipelineStatus object. Soon however we're
+	// going to be moving away from using PipelineStatus for errors and
+	// introducing a new error type which should hopefully let us integrate
+	// the error log level into the PlayerError type.
+	Level   string `json:"level"`
+	Message string `json:"message"`
+}
+
+// PlayerProperty is the Media.PlayerProperty type.
+//
+// Corresponds to kMediaPropertyChange
+type PlayerProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// PlayerEvent is the Media.PlayerEvent type.
+//
+// Corresponds to kMediaEventTriggered
+type PlayerEvent struct {
+	Timestamp Timestamp `json:"timestamp"`
+	Value     string    `json:"value"`
+}
+
+// PlayerErrorSourceLocation is the Media.PlayerErrorSourceLocation type.
+//
+// Represents logged source line numbers reported in an error.
+// NOTE: file and line are from chromium c++ implementation code, not js.
+type PlayerErrorSourceLocation struct {
+	File string `json:"file"`
+	Line int64  `json:"line"`
+}
+
+// PlayerError is the Media.PlayerError type.
+//
+// Corresponds to kMediaError
+type PlayerError struct {
+	ErrorType string `json:"errorType"`
+	// Code is the numeric enum entry for a specific set of error codes, such
+	// as PipelineStatusCodes in media/base/pipeline_status.h
+	Code int64 `json:"code"`
+	// A trace of where this error was caused / where it passed through.
+	Stack []PlayerErrorSourceLocation `json:"stack"`
+	// Errors potentially have a root cause error, ie, a DecoderError might be
+	// caused by an WindowsError
+	Cause []PlayerError `json:"cause"`
+	// Extra data attached to an error, such as an HRESULT, Video Codec, etc.
+	Data map[string]any `json:"data"`
+}
+
+// Enable calls Media.enable.
+//
+// Enables the Media domain
+func Enable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Media.enable", nil, sessionID, nil)
+}
+
+// Disable calls Media.disable.
+//
+// Disables the Media domain.
+func Disable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Media.disable", nil, sessionID, nil)
+}
+
+// EventPlayerPropertiesChanged is the method name of the Media.playerPropertiesChanged event.
+const EventPlayerPropertiesChanged = "Media.playerPropertiesChanged"
+
+// PlayerPropertiesChangedEvent is the payload of the Media.playerPropertiesChanged event.
+//
+// This can be called multiple times, and can be used to set / override /
+// remove player properties. A null propValue indicates removal.
+type PlayerPropertiesChangedEvent struct {
+	PlayerID   PlayerID         `json:"playerId"`
+	Properties []PlayerProperty `json:"properties"`
+}
+
+// EventPlayerEventsAdded is the method name of the Media.playerEventsAdded event.
+const EventPlayerEventsAdded = "Media.playerEventsAdded"
+
+// PlayerEventsAddedEvent is the payload of the Media.playerEventsAdded event.
+//
+// Send events as a list, allowing them to be batched on the browser for less
+// congestion. If batched, events must ALWAYS be in chronological order.
+type PlayerEventsAddedEvent struct {
+	PlayerID PlayerID      `json:"playerId"`
+	Events   []PlayerEvent `json:"events"`
+}
+
+// EventPlayerMessagesLogged is the method name of the Media.playerMessagesLogged event.
+const EventPlayerMessagesLogged = "Media.playerMessagesLogged"
+
+// PlayerMessagesLoggedEvent is the payload of the Media.playerMessagesLogged event.
+//
+// Send a list of any messages that need to be delivered.
+type PlayerMessagesLoggedEvent struct {
+	PlayerID PlayerID        `json:"playerId"`
+	Messages []PlayerMessage `json:"messages"`
+}
+
+// EventPlayerErrorsRaised is the method name of the Media.playerErrorsRaised event.
+const EventPlayerErrorsRaised = "Media.playerErrorsRaised"
+
+// PlayerErrorsRaisedEvent is the payload of the Media.playerErrorsRaised event.
+//
+// Send a list of any errors that need to be delivered.
+type PlayerErrorsRaisedEvent struct {
+	PlayerID PlayerID      `json:"playerId"`
+	Errors   []PlayerError `json:"errors"`
+}
+
+// EventPlayersCreated is the method name of the Media.playersCreated event.
+const EventPlayersCreated = "Media.playersCreated"
+
+// PlayersCreatedEvent is the payload of the Media.playersCreated event.
+//
+// Called whenever a player is created, or when a new agent joins and receives
+// a list of active players. If an agent is restored, it will receive the full
+// list of player ids and all events again.
+type PlayersCreatedEvent struct {
+	Players []PlayerID `json:"players"`
+}
+
+// ParseEvent decodes a Media event message into its typed event struct.
+func ParseEvent(msg *internal.CDPMessage) (any, error) {
+	var v any
+	switch msg.Method {
+	case EventPlayerPropertiesChanged:
+		v = &PlayerPropertiesChangedEvent{}
+	case EventPlayerEventsAdded:
+		v = &PlayerEventsAddedEvent{}
+	case EventPlayerMessagesLogged:
+		v = &PlayerMessagesLoggedEvent{}
+	case EventPlayerErrorsRaised:
+		v = &PlayerErrorsRaisedEvent{}
+	case EventPlayersCreated:
+		v = &PlayersCreatedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Media event: %s", msg.Method)
+	}
+	if msg.Params == nil {
+		return v, nil
+	}
+	err := json.Unmarshal(msg.Params, v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,228 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package memory provides typed bindings for the CDP Memory domain.
+package memory
+
+import (
+	"cdp/internal"
+	"context"
+)
+
+// PressureLevel is the Memory.PressureLevel type.
+//
+// Memory pressure level.
+type PressureLevel string
+
+const (
+	PressureLevelModerate PressureLevel = "moderate"
+	PressureLevelCritical PressureLevel = "critical"
+)
+
+// SamplingProfileNode is the Memory.SamplingProfileNode type.
+//
+// Heap profile sample.
+type SamplingProfileNode struct {
+	// Size of the sampled allocation.
+	Size float64 `json:"size"`
+	// Total bytes attributed to this sample.
+	Total float64 `json:"total"`
+	// Execution stack at the point of allocation.
+	Stack []string `json:"stack"`
+}
+
+// SamplingProfile is the Memory.SamplingProfile type.
+//
+// Array of heap profile samples.
+type SamplingProfile struct {
+	Samples []SamplingProfileNode `json:"samples"`
+	Modules []Module              `json:"modules"`
+}
+
+// Module is the Memory.Module type.
+//
+// Executable module information
+type Module struct {
+	// Name of the module.
+	Name string `json:"name"`
+	// UUID of the module.
+	UUID string `json:"uuid"`
+	// Base address where the module is loaded into memory. Encoded as a decimal
+	// or hexadecimal (0x prefixed) string.
+	BaseAddress string `json:"baseAddress"`
+	// Size of the module in bytes.
+	Size float64 `json:"size"`
+}
+
+// DOMCounter is the Memory.DOMCounter type.
+//
+// DOM object counter data.
+type DOMCounter struct {
+	// Object name. Note: object names should be presumed volatile and clients should not expect
+	// the returned names to be consistent across runs.
+	Name string `json:"name"`
+	// Object count.
+	Count int64 `json:"count"`
+}
+
+// GetDOMCountersResult holds the result of Memory.getDOMCounters.
+type GetDOMCountersResult struct {
+	Documents        int64 `json:"documents"`
+	Nodes            int64 `json:"nodes"`
+	JSEventListeners int64 `json:"jsEventListeners"`
+}
+
+// GetDOMCounters calls Memory.getDOMCounters.
+//
+// Retruns current DOM object counters.
+func GetDOMCounters(ctx context.Context, c *internal.Client, sessionID string) (*GetDOMCountersResult, error) {
+	var result GetDOMCountersResult
+	err := c.Call(ctx, "Memory.getDOMCounters", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetDOMCountersForLeakDetectionResult holds the result of Memory.getDOMCountersForLeakDetection.
+type GetDOMCountersForLeakDetectionResult struct {
+	// DOM object counters.
+	Counters []DOMCounter `json:"counters"`
+}
+
+// GetDOMCountersForLeakDetection calls Memory.getDOMCountersForLeakDetection.
+//
+// Retruns DOM object counters after preparing renderer for leak detection.
+func GetDOMCountersForLeakDetection(ctx context.Context, c *internal.Client, sessionID string) (*GetDOMCountersForLeakDetectionResult, error) {
+	var result GetDOMCountersForLeakDetectionResult
+	err := c.Call(ctx, "Memory.getDOMCountersForLeakDetection", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// PrepareForLeakDetection calls Memory.prepareForLeakDetection.
+//
+// Prepares for leak detection by terminating workers, stopping spellcheckers,
+// dropping non-essential internal caches, running garbage collections, etc.
+func PrepareForLeakDetection(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Memory.prepareForLeakDetection", nil, sessionID, nil)
+}
+
+// ForciblyPurgeJavaScriptMemory calls Memory.forciblyPurgeJavaScriptMemory.
+//
+// Simulate OomIntervention by purging V8 memory.
+func ForciblyPurgeJavaScriptMemory(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Memory.forciblyPurgeJavaScriptMemory", nil, sessionID, nil)
+}
+
+// SetPressureNotificationsSuppressedParams holds the parameters of Memory.setPressureNotificationsSuppressed.
+type SetPressureNotificationsSuppressedParams struct {
+	// If true, memory pressure notifications will be suppressed.
+	Suppressed bool `json:"suppressed"`
+}
+
+// SetPressureNotificationsSuppressed calls Memory.setPressureNotificationsSuppressed.
+//
+// Enable/disable suppressing memory pressure notifications in all processes.
+func SetPressureNotificationsSuppressed(ctx context.Context, c *internal.Client, sessionID string, params *SetPressureNotificationsSuppressedParams) error {
+	if params == nil {
+		params = &SetPressureNotificationsSuppressedParams{}
+	}
+	return c.Call(ctx, "Memory.setPressureNotificationsSuppressed", params, sessionID, nil)
+}
+
+// SimulatePressureNotificationParams holds the parameters of Memory.simulatePressureNotification.
+type SimulatePressureNotificationParams struct {
+	// Memory pressure level of the notification.
+	Level PressureLevel `json:"level"`
+}
+
+// SimulatePressureNotification calls Memory.simulatePressureNotification.
+//
+// Simulate a memory pressure notification in all processes.
+func SimulatePressureNotification(ctx context.Context, c *internal.Client, sessionID string, params *SimulatePressureNotificationParams) error {
+	if params == nil {
+		params = &SimulatePressureNotificationParams{}
+	}
+	return c.Call(ctx, "Memory.simulatePressureNotification", params, sessionID, nil)
+}
+
+// StartSamplingParams holds the parameters of Memory.startSampling.
+type StartSamplingParams struct {
+	// Average number of bytes between samples.
+	SamplingInterval *int64 `json:"samplingInterval,omitempty"`
+	// Do not randomize intervals between samples.
+	SuppressRandomness *bool `json:"suppressRandomness,omitempty"`
+}
+
+// StartSampling calls Memory.startSampling.
+//
+// Start collecting native memory profile.
+func StartSampling(ctx context.Context, c *internal.Client, sessionID string, params *StartSamplingParams) error {
+	if params == nil {
+		params = &StartSamplingParams{}
+	}
+	return c.Call(ctx, "Memory.startSampling", params, sessionID, nil)
+}
+
+// StopSampling calls Memory.stopSampling.
+//
+// Stop collecting native memory profile.
+func StopSampling(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Memory.stopSampling", nil, sessionID, nil)
+}
+
+// GetAllTimeSamplingProfileResult holds the result of Memory.getAllTimeSamplingProfile.
+type GetAllTimeSamplingProfileResult struct {
+	Profile SamplingProfile `json:"profile"`
+}
+
+// GetAllTimeSamplingProfile calls Memory.getAllTimeSamplingProfile.
+//
+// Retrieve native memory allocations profile
+// collected since renderer process startup.
+func GetAllTimeSamplingProfile(ctx context.Context, c *internal.Client, sessionID string) (*GetAllTimeSamplingProfileResult, error) {
+	var result GetAllTimeSamplingProfileResult
+	err := c.Call(ctx, "Memory.getAllTimeSamplingProfile", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetBrowserSamplingProfileResult holds the result of Memory.getBrowserSamplingProfile.
+type GetBrowserSamplingProfileResult struct {
+	Profile SamplingProfile `json:"profile"`
+}
+
+// GetBrowserSamplingProfile calls Memory.getBrowserSamplingProfile.
+//
+// Retrieve native memory allocations profile
+// collected since browser process startup.
+func GetBrowserSamplingProfile(ctx context.Context, c *internal.Client, sessionID string) (*GetBrowserSamplingProfileResult, error) {
+	var result GetBrowserSamplingProfileResult
+	err := c.Call(ctx, "Memory.getBrowserSamplingProfile", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetSamplingProfileResult holds the result of Memory.getSamplingProfile.
+type GetSamplingProfileResult struct {
+	Profile SamplingProfile `json:"profile"`
+}
+
+// GetSamplingProfile calls Memory.getSamplingProfile.
+//
+// Retrieve native memory allocations profile collected since last
+// `startSampling` call.
+func GetSamplingProfile(ctx context.Context, c *internal.Client, sessionID string) (*GetSamplingProfileResult, error) {
+	var result GetSamplingProfileResult
+	err := c.Call(ctx, "Memory.getSamplingProfile", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
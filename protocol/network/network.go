@@ -0,0 +1,2772 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package network provides typed bindings for the CDP Network domain.
+//
+// Network domain allows tracking network activities of the page. It exposes information about http,
+// file, data and other requests and responses, their headers, bodies, timing, etc.
+package network
+
+import (
+	"cdp/internal"
+	"cdp/protocol/debugger"
+	"cdp/protocol/emulation"
+	"cdp/protocol/io"
+	"cdp/protocol/page"
+	"cdp/protocol/runtime"
+	"cdp/protocol/security"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// ResourceType is the Network.ResourceType type.
+//
+// Resource type as it was perceived by the rendering engine.
+type ResourceType string
+
+const (
+	ResourceTypeDocument           ResourceType = "Document"
+	ResourceTypeStylesheet         ResourceType = "Stylesheet"
+	ResourceTypeImage              ResourceType = "Image"
+	ResourceTypeMedia              ResourceType = "Media"
+	ResourceTypeFont               ResourceType = "Font"
+	ResourceTypeScript             ResourceType = "Script"
+	ResourceTypeTextTrack          ResourceType = "TextTrack"
+	ResourceTypeXHR                ResourceType = "XHR"
+	ResourceTypeFetch              ResourceType = "Fetch"
+	ResourceTypePrefetch           ResourceType = "Prefetch"
+	ResourceTypeEventSource        ResourceType = "EventSource"
+	ResourceTypeWebSocket          ResourceType = "WebSocket"
+	ResourceTypeManifest           ResourceType = "Manifest"
+	ResourceTypeSignedExchange     ResourceType = "SignedExchange"
+	ResourceTypePing               ResourceType = "Ping"
+	ResourceTypeCSPViolationReport ResourceType = "CSPViolationReport"
+	ResourceTypePreflight          ResourceType = "Preflight"
+	ResourceTypeOther              ResourceType = "Other"
+)
+
+// LoaderID is the Network.LoaderId type.
+//
+// Unique loader identifier.
+type LoaderID string
+
+// RequestID is the Network.RequestId type.
+//
+// Unique request identifier.
+type RequestID string
+
+// InterceptionID is the Network.InterceptionId type.
+//
+// Unique intercepted request identifier.
+type InterceptionID string
+
+// ErrorReason is the Network.ErrorReason type.
+//
+// Network level fetch failure reason.
+type ErrorReason string
+
+const (
+	ErrorReasonFailed               ErrorReason = "Failed"
+	ErrorReasonAborted              ErrorReason = "Aborted"
+	ErrorReasonTimedOut             ErrorReason = "TimedOut"
+	ErrorReasonAccessDenied         ErrorReason = "AccessDenied"
+	ErrorReasonConnectionClosed     ErrorReason = "ConnectionClosed"
+	ErrorReasonConnectionReset      ErrorReason = "ConnectionReset"
+	ErrorReasonConnectionRefused    ErrorReason = "ConnectionRefused"
+	ErrorReasonConnectionAborted    ErrorReason = "ConnectionAborted"
+	ErrorReasonConnectionFailed     ErrorReason = "ConnectionFailed"
+	ErrorReasonNameNotResolved      ErrorReason = "NameNotResolved"
+	ErrorReasonInternetDisconnected ErrorReason = "InternetDisconnected"
+	ErrorReasonAddressUnreachable   ErrorReason = "AddressUnreachable"
+	ErrorReasonBlockedByClient      ErrorReason = "BlockedByClient"
+	ErrorReasonBlockedByResponse    ErrorReason = "BlockedByResponse"
+)
+
+// TimeSinceEpoch is the Network.TimeSinceEpoch type.
+//
+// UTC time in seconds, counted from January 1, 1970.
+type TimeSinceEpoch float64
+
+// MonotonicTime is the Network.MonotonicTime type.
+//
+// Monotonically increasing time in seconds since an arbitrary point in the past.
+type MonotonicTime float64
+
+// Headers is the Network.Headers type.
+//
+// Request / response headers as keys / values of JSON object.
+type Headers map[string]any
+
+// ConnectionType is the Network.ConnectionType type.
+//
+// The underlying connection technology that the browser is supposedly using.
+type ConnectionType string
+
+const (
+	ConnectionTypeNone       ConnectionType = "none"
+	ConnectionTypeCellular2g ConnectionType = "cellular2g"
+	ConnectionTypeCellular3g ConnectionType = "cellular3g"
+	ConnectionTypeCellular4g ConnectionType = "cellular4g"
+	ConnectionTypeBluetooth  ConnectionType = "bluetooth"
+	ConnectionTypeEthernet   ConnectionType = "ethernet"
+	ConnectionTypeWifi       ConnectionType = "wifi"
+	ConnectionTypeWimax      ConnectionType = "wimax"
+	ConnectionTypeOther      ConnectionType = "other"
+)
+
+// CookieSameSite is the Network.CookieSameSite type.
+//
+// Represents the cookie's 'SameSite' status:
+// https://tools.ietf.org/html/draft-west-first-party-cookies
+type CookieSameSite string
+
+const (
+	CookieSameSiteStrict CookieSameSite = "Strict"
+	CookieSameSiteLax    CookieSameSite = "Lax"
+	CookieSameSiteNone   CookieSameSite = "None"
+)
+
+// CookiePriority is the Network.CookiePriority type.
+//
+// Represents the cookie's 'Priority' status:
+// https://tools.ietf.org/html/draft-west-cookie-priority-00
+//
+// Experimental.
+type CookiePriority string
+
+const (
+	CookiePriorityLow    CookiePriority = "Low"
+	CookiePriorityMedium CookiePriority = "Medium"
+	CookiePriorityHigh   CookiePriority = "High"
+)
+
+// CookieSourceScheme is the Network.CookieSourceScheme type.
+//
+// Represents the source scheme of the origin that originally set the cookie.
+// A value of "Unset" allows protocol clients to emulate legacy cookie scope for the scheme.
+// This is a temporary ability and it will be removed in the future.
+//
+// Experimental.
+type CookieSourceScheme string
+
+const (
+	CookieSourceSchemeUnset     CookieSourceScheme = "Unset"
+	CookieSourceSchemeNonSecure CookieSourceScheme = "NonSecure"
+	CookieSourceSchemeSecure    CookieSourceScheme = "Secure"
+)
+
+// ResourceTiming is the Network.ResourceTiming type.
+//
+// Timing information for the request.
+type ResourceTiming struct {
+	// Timing's requestTime is a baseline in seconds, while the other numbers are ticks in
+	// milliseconds relatively to this requestTime.
+	RequestTime float64 `json:"requestTime"`
+	// Started resolving proxy.
+	ProxyStart float64 `json:"proxyStart"`
+	// Finished resolving proxy.
+	ProxyEnd float64 `json:"proxyEnd"`
+	// Started DNS address resolve.
+	DnsStart float64 `json:"dnsStart"`
+	// Finished DNS address resolve.
+	DnsEnd float64 `json:"dnsEnd"`
+	// Started connecting to the remote host.
+	ConnectStart float64 `json:"connectStart"`
+	// Connected to the remote host.
+	ConnectEnd float64 `json:"connectEnd"`
+	// Started SSL handshake.
+	SSLStart float64 `json:"sslStart"`
+	// Finished SSL handshake.
+	SSLEnd float64 `json:"sslEnd"`
+	// Started running ServiceWorker.
+	//
+	// Experimental.
+	WorkerStart float64 `json:"workerStart"`
+	// Finished Starting ServiceWorker.
+	//
+	// Experimental.
+	WorkerReady float64 `json:"workerReady"`
+	// Started fetch event.
+	//
+	// Experimental.
+	WorkerFetchStart float64 `json:"workerFetchStart"`
+	// Settled fetch event respondWith promise.
+	//
+	// Experimental.
+	WorkerRespondWithSettled float64 `json:"workerRespondWithSettled"`
+	// Started ServiceWorker static routing source evaluation.
+	//
+	// Experimental.
+	WorkerRouterEvaluationStart *float64 `json:"workerRouterEvaluationStart,omitempty"`
+	// Started cache lookup when the source was evaluated to `cache`.
+	//
+	// Experimental.
+	WorkerCacheLookupStart *float64 `json:"workerCacheLookupStart,omitempty"`
+	// Started sending request.
+	SendStart float64 `json:"sendStart"`
+	// Finished sending request.
+	SendEnd float64 `json:"sendEnd"`
+	// Time the server started pushing request.
+	//
+	// Experimental.
+	PushStart float64 `json:"pushStart"`
+	// Time the server finished pushing request.
+	//
+	// Experimental.
+	PushEnd float64 `json:"pushEnd"`
+	// Started receiving response headers.
+	//
+	// Experimental.
+	ReceiveHeadersStart float64 `json:"receiveHeadersStart"`
+	// Finished receiving response headers.
+	ReceiveHeadersEnd float64 `json:"receiveHeadersEnd"`
+}
+
+// ResourcePriority is the Network.ResourcePriority type.
+//
+// Loading priority of a resource request.
+type ResourcePriority string
+
+const (
+	ResourcePriorityVeryLow  ResourcePriority = "VeryLow"
+	ResourcePriorityLow      ResourcePriority = "Low"
+	ResourcePriorityMedium   ResourcePriority = "Medium"
+	ResourcePriorityHigh     ResourcePriority = "High"
+	ResourcePriorityVeryHigh ResourcePriority = "VeryHigh"
+)
+
+// PostDataEntry is the Network.PostDataEntry type.
+//
+// Post data entry for HTTP request
+type PostDataEntry struct {
+	Bytes string `json:"bytes,omitempty"`
+}
+
+// Request is the Network.Request type.
+//
+// HTTP request data.
+type Request struct {
+	// Request URL (without fragment).
+	URL string `json:"url"`
+	// Fragment of the requested URL starting with hash, if present.
+	URLFragment string `json:"urlFragment,omitempty"`
+	// HTTP request method.
+	Method string `json:"method"`
+	// HTTP request headers.
+	Headers Headers `json:"headers"`
+	// HTTP POST request data.
+	// Use postDataEntries instead.
+	//
+	// Deprecated: this item is deprecated in the protocol.
+	PostData string `json:"postData,omitempty"`
+	// True when the request has POST data. Note that postData might still be omitted when this flag is true when the data is too long.
+	HasPostData *bool `json:"hasPostData,omitempty"`
+	// Request body elements (post data broken into individual entries).
+	//
+	// Experimental.
+	PostDataEntries []PostDataEntry `json:"postDataEntries,omitempty"`
+	// The mixed content type of the request.
+	MixedContentType security.MixedContentType `json:"mixedContentType,omitempty"`
+	// Priority of the resource request at the time request is sent.
+	InitialPriority ResourcePriority `json:"initialPriority"`
+	// The referrer policy of the request, as defined in https://www.w3.org/TR/referrer-policy/
+	ReferrerPolicy string `json:"referrerPolicy"`
+	// Whether is loaded via link preload.
+	IsLinkPreload *bool `json:"isLinkPreload,omitempty"`
+	// Set for requests when the TrustToken API is used. Contains the parameters
+	// passed by the developer (e.g. via "fetch") as understood by the backend.
+	//
+	// Experimental.
+	TrustTokenParams *TrustTokenParams `json:"trustTokenParams,omitempty"`
+	// True if this resource request is considered to be the 'same site' as the
+	// request corresponding to the main frame.
+	//
+	// Experimental.
+	IsSameSite *bool `json:"isSameSite,omitempty"`
+}
+
+// SignedCertificateTimestamp is the Network.SignedCertificateTimestamp type.
+//
+// Details of a signed certificate timestamp (SCT).
+type SignedCertificateTimestamp struct {
+	// Validation status.
+	Status string `json:"status"`
+	// Origin.
+	Origin string `json:"origin"`
+	// Log name / description.
+	LogDescription string `json:"logDescription"`
+	// Log ID.
+	LogID string `json:"logId"`
+	// Issuance date. Unlike TimeSinceEpoch, this contains the number of
+	// milliseconds since January 1, 1970, UTC, not the number of seconds.
+	Timestamp float64 `json:"timestamp"`
+	// Hash algorithm.
+	HashAlgorithm string `json:"hashAlgorithm"`
+	// Signature algorithm.
+	SignatureAlgorithm string `json:"signatureAlgorithm"`
+	// Signature data.
+	SignatureData string `json:"signatureData"`
+}
+
+// SecurityDetails is the Network.SecurityDetails type.
+//
+// Security details about a request.
+type SecurityDetails struct {
+	// Protocol name (e.g. "TLS 1.2" or "QUIC").
+	Protocol string `json:"protocol"`
+	// Key Exchange used by the connection, or the empty string if not applicable.
+	KeyExchange string `json:"keyExchange"`
+	// (EC)DH group used by the connection, if applicable.
+	KeyExchangeGroup string `json:"keyExchangeGroup,omitempty"`
+	// Cipher name.
+	Cipher string `json:"cipher"`
+	// TLS MAC. Note that AEAD ciphers do not have separate MACs.
+	Mac string `json:"mac,omitempty"`
+	// Certificate ID value.
+	CertificateID security.CertificateID `json:"certificateId"`
+	// Certificate subject name.
+	SubjectName string `json:"subjectName"`
+	// Subject Alternative Name (SAN) DNS names and IP addresses.
+	SanList []string `json:"sanList"`
+	// Name of the issuing CA.
+	Issuer string `json:"issuer"`
+	// Certificate valid from date.
+	ValidFrom TimeSinceEpoch `json:"validFrom"`
+	// Certificate valid to (expiration) date
+	ValidTo TimeSinceEpoch `json:"validTo"`
+	// List of signed certificate timestamps (SCTs).
+	SignedCertificateTimestampList []SignedCertificateTimestamp `json:"signedCertificateTimestampList"`
+	// Whether the request complied with Certificate Transparency policy
+	CertificateTransparencyCompliance CertificateTransparencyCompliance `json:"certificateTransparencyCompliance"`
+	// The signature algorithm used by the server in the TLS server signature,
+	// represented as a TLS SignatureScheme code point. Omitted if not
+	// applicable or not known.
+	ServerSignatureAlgorithm *int64 `json:"serverSignatureAlgorithm,omitempty"`
+	// Whether the connection used Encrypted ClientHello
+	EncryptedClientHello bool `json:"encryptedClientHello"`
+}
+
+// CertificateTransparencyCompliance is the Network.CertificateTransparencyCompliance type.
+//
+// Whether the request complied with Certificate Transparency policy.
+type CertificateTransparencyCompliance string
+
+const (
+	CertificateTransparencyComplianceUnknown      CertificateTransparencyCompliance = "unknown"
+	CertificateTransparencyComplianceNotCompliant CertificateTransparencyCompliance = "not-compliant"
+	CertificateTransparencyComplianceCompliant    CertificateTransparencyCompliance = "compliant"
+)
+
+// BlockedReason is the Network.BlockedReason type.
+//
+// The reason why request was blocked.
+type BlockedReason string
+
+const (
+	BlockedReasonOther                                                   BlockedReason = "other"
+	BlockedReasonCsp                                                     BlockedReason = "csp"
+	BlockedReasonMixedContent                                            BlockedReason = "mixed-content"
+	BlockedReasonOrigin                                                  BlockedReason = "origin"
+	BlockedReasonInspector                                               BlockedReason = "inspector"
+	BlockedReasonSubresourceFilter                                       BlockedReason = "subresource-filter"
+	BlockedReasonContentType                                             BlockedReason = "content-type"
+	BlockedReasonCoepFrameResourceNeedsCoepHeader                        BlockedReason = "coep-frame-resource-needs-coep-header"
+	BlockedReasonCoopSandboxedIframeCannotNavigateToCoopPage             BlockedReason = "coop-sandboxed-iframe-cannot-navigate-to-coop-page"
+	BlockedReasonCorpNotSameOrigin                                       BlockedReason = "corp-not-same-origin"
+	BlockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoep       BlockedReason = "corp-not-same-origin-after-defaulted-to-same-origin-by-coep"
+	BlockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByDip        BlockedReason = "corp-not-same-origin-after-defaulted-to-same-origin-by-dip"
+	BlockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip BlockedReason = "corp-not-same-origin-after-defaulted-to-same-origin-by-coep-and-dip"
+	BlockedReasonCorpNotSameSite                                         BlockedReason = "corp-not-same-site"
+)
+
+// CorsError is the Network.CorsError type.
+//
+// The reason why request was blocked.
+type CorsError string
+
+const (
+	CorsErrorDisallowedByMode                          CorsError = "DisallowedByMode"
+	CorsErrorInvalidResponse                           CorsError = "InvalidResponse"
+	CorsErrorWildcardOriginNotAllowed                  CorsError = "WildcardOriginNotAllowed"
+	CorsErrorMissingAllowOriginHeader                  CorsError = "MissingAllowOriginHeader"
+	CorsErrorMultipleAllowOriginValues                 CorsError = "MultipleAllowOriginValues"
+	CorsErrorInvalidAllowOriginValue                   CorsError = "InvalidAllowOriginValue"
+	CorsErrorAllowOriginMismatch                       CorsError = "AllowOriginMismatch"
+	CorsErrorInvalidAllowCredentials                   CorsError = "InvalidAllowCredentials"
+	CorsErrorCorsDisabledScheme                        CorsError = "CorsDisabledScheme"
+	CorsErrorPreflightInvalidStatus                    CorsError = "PreflightInvalidStatus"
+	CorsErrorPreflightDisallowedRedirect               CorsError = "PreflightDisallowedRedirect"
+	CorsErrorPreflightWildcardOriginNotAllowed         CorsError = "PreflightWildcardOriginNotAllowed"
+	CorsErrorPreflightMissingAllowOriginHeader         CorsError = "PreflightMissingAllowOriginHeader"
+	CorsErrorPreflightMultipleAllowOriginValues        CorsError = "PreflightMultipleAllowOriginValues"
+	CorsErrorPreflightInvalidAllowOriginValue          CorsError = "PreflightInvalidAllowOriginValue"
+	CorsErrorPreflightAllowOriginMismatch              CorsError = "PreflightAllowOriginMismatch"
+	CorsErrorPreflightInvalidAllowCredentials          CorsError = "PreflightInvalidAllowCredentials"
+	CorsErrorPreflightMissingAllowExternal             CorsError = "PreflightMissingAllowExternal"
+	CorsErrorPreflightInvalidAllowExternal             CorsError = "PreflightInvalidAllowExternal"
+	CorsErrorPreflightMissingAllowPrivateNetwork       CorsError = "PreflightMissingAllowPrivateNetwork"
+	CorsErrorPreflightInvalidAllowPrivateNetwork       CorsError = "PreflightInvalidAllowPrivateNetwork"
+	CorsErrorInvalidAllowMethodsPreflightResponse      CorsError = "InvalidAllowMethodsPreflightResponse"
+	CorsErrorInvalidAllowHeadersPreflightResponse      CorsError = "InvalidAllowHeadersPreflightResponse"
+	CorsErrorMethodDisallowedByPreflightResponse       CorsError = "MethodDisallowedByPreflightResponse"
+	CorsErrorHeaderDisallowedByPreflightResponse       CorsError = "HeaderDisallowedByPreflightResponse"
+	CorsErrorRedirectContainsCredentials               CorsError = "RedirectContainsCredentials"
+	CorsErrorInsecurePrivateNetwork                    CorsError = "InsecurePrivateNetwork"
+	CorsErrorInvalidPrivateNetworkAccess               CorsError = "InvalidPrivateNetworkAccess"
+	CorsErrorUnexpectedPrivateNetworkAccess            CorsError = "UnexpectedPrivateNetworkAccess"
+	CorsErrorNoCorsRedirectModeNotFollow               CorsError = "NoCorsRedirectModeNotFollow"
+	CorsErrorPreflightMissingPrivateNetworkAccessID    CorsError = "PreflightMissingPrivateNetworkAccessId"
+	CorsErrorPreflightMissingPrivateNetworkAccessName  CorsError = "PreflightMissingPrivateNetworkAccessName"
+	CorsErrorPrivateNetworkAccessPermissionUnavailable CorsError = "PrivateNetworkAccessPermissionUnavailable"
+	CorsErrorPrivateNetworkAccessPermissionDenied      CorsError = "PrivateNetworkAccessPermissionDenied"
+)
+
+// CorsErrorStatus is the Network.CorsErrorStatus type.
+type CorsErrorStatus struct {
+	CorsError       CorsError `json:"corsError"`
+	FailedParameter string    `json:"failedParameter"`
+}
+
+// ServiceWorkerResponseSource is the Network.ServiceWorkerResponseSource type.
+//
+// Source of serviceworker response.
+type ServiceWorkerResponseSource string
+
+const (
+	ServiceWorkerResponseSourceCacheStorage ServiceWorkerResponseSource = "cache-storage"
+	ServiceWorkerResponseSourceHTTPCache    ServiceWorkerResponseSource = "http-cache"
+	ServiceWorkerResponseSourceFallbackCode ServiceWorkerResponseSource = "fallback-code"
+	ServiceWorkerResponseSourceNetwork      ServiceWorkerResponseSource = "network"
+)
+
+// TrustTokenParams is the Network.TrustTokenParams type.
+//
+// Determines what type of Trust Token operation is executed and
+// depending on the type, some additional parameters. The values
+// are specified in third_party/blink/renderer/core/fetch/trust_token.idl.
+//
+// Experimental.
+type TrustTokenParams struct {
+	Operation TrustTokenOperationType `json:"operation"`
+	// Only set for "token-redemption" operation and determine whether
+	// to request a fresh SRR or use a still valid cached SRR.
+	RefreshPolicy string `json:"refreshPolicy"`
+	// Origins of issuers from whom to request tokens or redemption
+	// records.
+	Issuers []string `json:"issuers,omitempty"`
+}
+
+// TrustTokenOperationType is the Network.TrustTokenOperationType type.
+//
+// Experimental.
+type TrustTokenOperationType string
+
+const (
+	TrustTokenOperationTypeIssuance   TrustTokenOperationType = "Issuance"
+	TrustTokenOperationTypeRedemption TrustTokenOperationType = "Redemption"
+	TrustTokenOperationTypeSigning    TrustTokenOperationType = "Signing"
+)
+
+// AlternateProtocolUsage is the Network.AlternateProtocolUsage type.
+//
+// The reason why Chrome uses a specific transport protocol for HTTP semantics.
+//
+// Experimental.
+type AlternateProtocolUsage string
+
+const (
+	AlternateProtocolUsageAlternativeJobWonWithoutRace AlternateProtocolUsage = "alternativeJobWonWithoutRace"
+	AlternateProtocolUsageAlternativeJobWonRace        AlternateProtocolUsage = "alternativeJobWonRace"
+	AlternateProtocolUsageMainJobWonRace               AlternateProtocolUsage = "mainJobWonRace"
+	AlternateProtocolUsageMappingMissing               AlternateProtocolUsage = "mappingMissing"
+	AlternateProtocolUsageBroken                       AlternateProtocolUsage = "broken"
+	AlternateProtocolUsageDnsAlpnH3JobWonWithoutRace   AlternateProtocolUsage = "dnsAlpnH3JobWonWithoutRace"
+	AlternateProtocolUsageDnsAlpnH3JobWonRace          AlternateProtocolUsage = "dnsAlpnH3JobWonRace"
+	AlternateProtocolUsageUnspecifiedReason            AlternateProtocolUsage = "unspecifiedReason"
+)
+
+// ServiceWorkerRouterSource is the Network.ServiceWorkerRouterSource type.
+//
+// Source of service worker router.
+type ServiceWorkerRouterSource string
+
+const (
+	ServiceWorkerRouterSourceNetwork                    ServiceWorkerRouterSource = "network"
+	ServiceWorkerRouterSourceCache                      ServiceWorkerRouterSource = "cache"
+	ServiceWorkerRouterSourceFetchEvent                 ServiceWorkerRouterSource = "fetch-event"
+	ServiceWorkerRouterSourceRaceNetworkAndFetchHandler ServiceWorkerRouterSource = "race-network-and-fetch-handler"
+)
+
+// ServiceWorkerRouterInfo is the Network.ServiceWorkerRouterInfo type.
+//
+// Experimental.
+type ServiceWorkerRouterInfo struct {
+	// ID of the rule matched. If there is a matched rule, this field will
+	// be set, otherwiser no value will be set.
+	RuleIDMatched *int64 `json:"ruleIdMatched,omitempty"`
+	// The router source of the matched rule. If there is a matched rule, this
+	// field will be set, otherwise no value will be set.
+	MatchedSourceType ServiceWorkerRouterSource `json:"matchedSourceType,omitempty"`
+	// The actual router source used.
+	ActualSourceType ServiceWorkerRouterSource `json:"actualSourceType,omitempty"`
+}
+
+// Response is the Network.Response type.
+//
+// HTTP response data.
+type Response struct {
+	// Response URL. This URL can be different from CachedResource.url in case of redirect.
+	URL string `json:"url"`
+	// HTTP response status code.
+	Status int64 `json:"status"`
+	// HTTP response status text.
+	StatusText string `json:"statusText"`
+	// HTTP response headers.
+	Headers Headers `json:"headers"`
+	// HTTP response headers text. This has been replaced by the headers in Network.responseReceivedExtraInfo.
+	//
+	// Deprecated: this item is deprecated in the protocol.
+	HeadersText string `json:"headersText,omitempty"`
+	// Resource mimeType as determined by the browser.
+	MimeType string `json:"mimeType"`
+	// Resource charset as determined by the browser (if applicable).
+	Charset string `json:"charset"`
+	// Refined HTTP request headers that were actually transmitted over the network.
+	RequestHeaders Headers `json:"requestHeaders,omitempty"`
+	// HTTP request headers text. This has been replaced by the headers in Network.requestWillBeSentExtraInfo.
+	//
+	// Deprecated: this item is deprecated in the protocol.
+	RequestHeadersText string `json:"requestHeadersText,omitempty"`
+	// Specifies whether physical connection was actually reused for this request.
+	ConnectionReused bool `json:"connectionReused"`
+	// Physical connection id that was actually used for this request.
+	ConnectionID float64 `json:"connectionId"`
+	// Remote IP address.
+	RemoteIPAddress string `json:"remoteIPAddress,omitempty"`
+	// Remote port.
+	RemotePort *int64 `json:"remotePort,omitempty"`
+	// Specifies that the request was served from the disk cache.
+	FromDiskCache *bool `json:"fromDiskCache,omitempty"`
+	// Specifies that the request was served from the ServiceWorker.
+	FromServiceWorker *bool `json:"fromServiceWorker,omitempty"`
+	// Specifies that the request was served from the prefetch cache.
+	FromPrefetchCache *bool `json:"fromPrefetchCache,omitempty"`
+	// Specifies that the request was served from the prefetch cache.
+	FromEarlyHints *bool `json:"fromEarlyHints,omitempty"`
+	// Information about how ServiceWorker Static Router API was used. If this
+	// field is set with `matchedSourceType` field, a matching rule is found.
+	// If this field is set without `matchedSource`, no matching rule is found.
+	// Otherwise, the API is not used.
+	//
+	// Experimental.
+	ServiceWorkerRouterInfo *ServiceWorkerRouterInfo `json:"serviceWorkerRouterInfo,omitempty"`
+	// Total number of bytes received for this request so far.
+	EncodedDataLength float64 `json:"encodedDataLength"`
+	// Timing information for the given request.
+	Timing *ResourceTiming `json:"timing,omitempty"`
+	// Response source of response from ServiceWorker.
+	ServiceWorkerResponseSource ServiceWorkerResponseSource `json:"serviceWorkerResponseSource,omitempty"`
+	// The time at which the returned response was generated.
+	ResponseTime *TimeSinceEpoch `json:"responseTime,omitempty"`
+	// Cache Storage Cache Name.
+	CacheStorageCacheName string `json:"cacheStorageCacheName,omitempty"`
+	// Protocol used to fetch this request.
+	Protocol string `json:"protocol,omitempty"`
+	// The reason why Chrome uses a specific transport protocol for HTTP semantics.
+	//
+	// Experimental.
+	AlternateProtocolUsage AlternateProtocolUsage `json:"alternateProtocolUsage,omitempty"`
+	// Security state of the request resource.
+	SecurityState security.SecurityState `json:"securityState"`
+	// Security details for the request.
+	SecurityDetails *SecurityDetails `json:"securityDetails,omitempty"`
+}
+
+// WebSocketRequest is the Network.WebSocketRequest type.
+//
+// WebSocket request data.
+type WebSocketRequest struct {
+	// HTTP request headers.
+	Headers Headers `json:"headers"`
+}
+
+// WebSocketResponse is the Network.WebSocketResponse type.
+//
+// WebSocket response data.
+type WebSocketResponse struct {
+	// HTTP response status code.
+	Status int64 `json:"status"`
+	// HTTP response status text.
+	StatusText string `json:"statusText"`
+	// HTTP response headers.
+	Headers Headers `json:"headers"`
+	// HTTP response headers text.
+	HeadersText string `json:"headersText,omitempty"`
+	// HTTP request headers.
+	RequestHeaders Headers `json:"requestHeaders,omitempty"`
+	// HTTP request headers text.
+	RequestHeadersText string `json:"requestHeadersText,omitempty"`
+}
+
+// WebSocketFrame is the Network.WebSocketFrame type.
+//
+// WebSocket message data. This represents an entire WebSocket message, not just a fragmented frame as the name suggests.
+type WebSocketFrame struct {
+	// WebSocket message opcode.
+	Opcode float64 `json:"opcode"`
+	// WebSocket message mask.
+	Mask bool `json:"mask"`
+	// WebSocket message payload data.
+	// If the opcode is 1, this is a text message and payloadData is a UTF-8 string.
+	// If the opcode isn't 1, then payloadData is a base64 encoded string representing binary data.
+	PayloadData string `json:"payloadData"`
+}
+
+// CachedResource is the Network.CachedResource type.
+//
+// Information about the cached resource.
+type CachedResource struct {
+	// Resource URL. This is the url of the original network request.
+	URL string `json:"url"`
+	// Type of this resource.
+	Type ResourceType `json:"type"`
+	// Cached response data.
+	Response *Response `json:"response,omitempty"`
+	// Cached response body size.
+	BodySize float64 `json:"bodySize"`
+}
+
+// Initiator is the Network.Initiator type.
+//
+// Information about the request initiator.
+type Initiator struct {
+	// Type of this initiator.
+	Type string `json:"type"`
+	// Initiator JavaScript stack trace, set for Script only.
+	Stack *runtime.StackTrace `json:"stack,omitempty"`
+	// Initiator URL, set for Parser type or for Script type (when script is importing module) or for SignedExchange type.
+	URL string `json:"url,omitempty"`
+	// Initiator line number, set for Parser type or for Script type (when script is importing
+	// module) (0-based).
+	LineNumber *float64 `json:"lineNumber,omitempty"`
+	// Initiator column number, set for Parser type or for Script type (when script is importing
+	// module) (0-based).
+	ColumnNumber *float64 `json:"columnNumber,omitempty"`
+	// Set if another request triggered this request (e.g. preflight).
+	RequestID RequestID `json:"requestId,omitempty"`
+}
+
+// CookiePartitionKey is the Network.CookiePartitionKey type.
+//
+// cookiePartitionKey object
+// The representation of the components of the key that are created by the cookiePartitionKey class contained in net/cookies/cookie_partition_key.h.
+//
+// Experimental.
+type CookiePartitionKey struct {
+	// The site of the top-level URL the browser was visiting at the start
+	// of the request to the endpoint that set the cookie.
+	TopLevelSite string `json:"topLevelSite"`
+	// Indicates if the cookie has any ancestors that are cross-site to the topLevelSite.
+	HasCrossSiteAncestor bool `json:"hasCrossSiteAncestor"`
+}
+
+// Cookie is the Network.Cookie type.
+//
+// Cookie object
+type Cookie struct {
+	// Cookie name.
+	Name string `json:"name"`
+	// Cookie value.
+	Value string `json:"value"`
+	// Cookie domain.
+	Domain string `json:"domain"`
+	// Cookie path.
+	Path string `json:"path"`
+	// Cookie expiration date as the number of seconds since the UNIX epoch.
+	Expires float64 `json:"expires"`
+	// Cookie size.
+	Size int64 `json:"size"`
+	// True if cookie is http-only.
+	HTTPOnly bool `json:"httpOnly"`
+	// True if cookie is secure.
+	Secure bool `json:"secure"`
+	// True in case of session cookie.
+	Session bool `json:"session"`
+	// Cookie SameSite type.
+	SameSite CookieSameSite `json:"sameSite,omitempty"`
+	// Cookie Priority
+	//
+	// Experimental.
+	Priority CookiePriority `json:"priority"`
+	// True if cookie is SameParty.
+	//
+	// Experimental.
+	//
+	// Deprecated: this item is deprecated in the protocol.
+	SameParty bool `json:"sameParty"`
+	// Cookie source scheme type.
+	//
+	// Experimental.
+	SourceScheme CookieSourceScheme `json:"sourceScheme"`
+	// Cookie source port. Valid values are {-1, [1, 65535]}, -1 indicates an unspecified port.
+	// An unspecified port value allows protocol clients to emulate legacy cookie scope for the port.
+	// This is a temporary ability and it will be removed in the future.
+	//
+	// Experimental.
+	SourcePort int64 `json:"sourcePort"`
+	// Cookie partition key.
+	//
+	// Experimental.
+	PartitionKey *CookiePartitionKey `json:"partitionKey,omitempty"`
+	// True if cookie partition key is opaque.
+	//
+	// Experimental.
+	PartitionKeyOpaque *bool `json:"partitionKeyOpaque,omitempty"`
+}
+
+// SetCookieBlockedReason is the Network.SetCookieBlockedReason type.
+//
+// Types of reasons why a cookie may not be stored from a response.
+//
+// Experimental.
+type SetCookieBlockedReason string
+
+const (
+	SetCookieBlockedReasonSecureOnly                               SetCookieBlockedReason = "SecureOnly"
+	SetCookieBlockedReasonSameSiteStrict                           SetCookieBlockedReason = "SameSiteStrict"
+	SetCookieBlockedReasonSameSiteLax                              SetCookieBlockedReason = "SameSiteLax"
+	SetCookieBlockedReasonSameSiteUnspecifiedTreatedAsLax          SetCookieBlockedReason = "SameSiteUnspecifiedTreatedAsLax"
+	SetCookieBlockedReasonSameSiteNoneInsecure                     SetCookieBlockedReason = "SameSiteNoneInsecure"
+	SetCookieBlockedReasonUserPreferences                          SetCookieBlockedReason = "UserPreferences"
+	SetCookieBlockedReasonThirdPartyPhaseout                       SetCookieBlockedReason = "ThirdPartyPhaseout"
+	SetCookieBlockedReasonThirdPartyBlockedInFirstPartySet         SetCookieBlockedReason = "ThirdPartyBlockedInFirstPartySet"
+	SetCookieBlockedReasonSyntaxError                              SetCookieBlockedReason = "SyntaxError"
+	SetCookieBlockedReasonSchemeNotSupported                       SetCookieBlockedReason = "SchemeNotSupported"
+	SetCookieBlockedReasonOverwriteSecure                          SetCookieBlockedReason = "OverwriteSecure"
+	SetCookieBlockedReasonInvalidDomain                            SetCookieBlockedReason = "InvalidDomain"
+	SetCookieBlockedReasonInvalidPrefix                            SetCookieBlockedReason = "InvalidPrefix"
+	SetCookieBlockedReasonUnknownError                             SetCookieBlockedReason = "UnknownError"
+	SetCookieBlockedReasonSchemefulSameSiteStrict                  SetCookieBlockedReason = "SchemefulSameSiteStrict"
+	SetCookieBlockedReasonSchemefulSameSiteLax                     SetCookieBlockedReason = "SchemefulSameSiteLax"
+	SetCookieBlockedReasonSchemefulSameSiteUnspecifiedTreatedAsLax SetCookieBlockedReason = "SchemefulSameSiteUnspecifiedTreatedAsLax"
+	SetCookieBlockedReasonSamePartyFromCrossPartyContext           SetCookieBlockedReason = "SamePartyFromCrossPartyContext"
+	SetCookieBlockedReasonSamePartyConflictsWithOtherAttributes    SetCookieBlockedReason = "SamePartyConflictsWithOtherAttributes"
+	SetCookieBlockedReasonNameValuePairExceedsMaxSize              SetCookieBlockedReason = "NameValuePairExceedsMaxSize"
+	SetCookieBlockedReasonDisallowedCharacter                      SetCookieBlockedReason = "DisallowedCharacter"
+	SetCookieBlockedReasonNoCookieContent                          SetCookieBlockedReason = "NoCookieContent"
+)
+
+// CookieBlockedReason is the Network.CookieBlockedReason type.
+//
+// Types of reasons why a cookie may not be sent with a request.
+//
+// Experimental.
+type CookieBlockedReason string
+
+const (
+	CookieBlockedReasonSecureOnly                               CookieBlockedReason = "SecureOnly"
+	CookieBlockedReasonNotOnPath                                CookieBlockedReason = "NotOnPath"
+	CookieBlockedReasonDomainMismatch                           CookieBlockedReason = "DomainMismatch"
+	CookieBlockedReasonSameSiteStrict                           CookieBlockedReason = "SameSiteStrict"
+	CookieBlockedReasonSameSiteLax                              CookieBlockedReason = "SameSiteLax"
+	CookieBlockedReasonSameSiteUnspecifiedTreatedAsLax          CookieBlockedReason = "SameSiteUnspecifiedTreatedAsLax"
+	CookieBlockedReasonSameSiteNoneInsecure                     CookieBlockedReason = "SameSiteNoneInsecure"
+	CookieBlockedReasonUserPreferences                          CookieBlockedReason = "UserPreferences"
+	CookieBlockedReasonThirdPartyPhaseout                       CookieBlockedReason = "ThirdPartyPhaseout"
+	CookieBlockedReasonThirdPartyBlockedInFirstPartySet         CookieBlockedReason = "ThirdPartyBlockedInFirstPartySet"
+	CookieBlockedReasonUnknownError                             CookieBlockedReason = "UnknownError"
+	CookieBlockedReasonSchemefulSameSiteStrict                  CookieBlockedReason = "SchemefulSameSiteStrict"
+	CookieBlockedReasonSchemefulSameSiteLax                     CookieBlockedReason = "SchemefulSameSiteLax"
+	CookieBlockedReasonSchemefulSameSiteUnspecifiedTreatedAsLax CookieBlockedReason = "SchemefulSameSiteUnspecifiedTreatedAsLax"
+	CookieBlockedReasonSamePartyFromCrossPartyContext           CookieBlockedReason = "SamePartyFromCrossPartyContext"
+	CookieBlockedReasonNameValuePairExceedsMaxSize              CookieBlockedReason = "NameValuePairExceedsMaxSize"
+)
+
+// CookieExemptionReason is the Network.CookieExemptionReason type.
+//
+// Types of reasons why a cookie should have been blocked by 3PCD but is exempted for the request.
+//
+// Experimental.
+type CookieExemptionReason string
+
+const (
+	CookieExemptionReasonNone                         CookieExemptionReason = "None"
+	CookieExemptionReasonUserSetting                  CookieExemptionReason = "UserSetting"
+	CookieExemptionReasonTPCDMetadata                 CookieExemptionReason = "TPCDMetadata"
+	CookieExemptionReasonTPCDDeprecationTrial         CookieExemptionReason = "TPCDDeprecationTrial"
+	CookieExemptionReasonTopLevelTPCDDeprecationTrial CookieExemptionReason = "TopLevelTPCDDeprecationTrial"
+	CookieExemptionReasonTPCDHeuristics               CookieExemptionReason = "TPCDHeuristics"
+	CookieExemptionReasonEnterprisePolicy             CookieExemptionReason = "EnterprisePolicy"
+	CookieExemptionReasonStorageAccess                CookieExemptionReason = "StorageAccess"
+	CookieExemptionReasonTopLevelStorageAccess        CookieExemptionReason = "TopLevelStorageAccess"
+	CookieExemptionReasonCorsOptIn                    CookieExemptionReason = "CorsOptIn"
+	CookieExemptionReasonScheme                       CookieExemptionReason = "Scheme"
+)
+
+// BlockedSetCookieWithReason is the Network.BlockedSetCookieWithReason type.
+//
+// A cookie which was not stored from a response with the corresponding reason.
+//
+// Experimental.
+type BlockedSetCookieWithReason struct {
+	// The reason(s) this cookie was blocked.
+	BlockedReasons []SetCookieBlockedReason `json:"blockedReasons"`
+	// The string representing this individual cookie as it would appear in the header.
+	// This is not the entire "cookie" or "set-cookie" header which could have multiple cookies.
+	CookieLine string `json:"cookieLine"`
+	// The cookie object which represents the cookie which was not stored. It is optional because
+	// sometimes complete cookie information is not available, such as in the case of parsing
+	// errors.
+	Cookie *Cookie `json:"cookie,omitempty"`
+}
+
+// ExemptedSetCookieWithReason is the Network.ExemptedSetCookieWithReason type.
+//
+// A cookie should have been blocked by 3PCD but is exempted and stored from a response with the
+// corresponding reason. A cookie could only have at most one exemption reason.
+//
+// Experimental.
+type ExemptedSetCookieWithReason struct {
+	// The reason the cookie was exempted.
+	ExemptionReason CookieExemptionReason `json:"exemptionReason"`
+	// The string representing this individual cookie as it would appear in the header.
+	CookieLine string `json:"cookieLine"`
+	// The cookie object representing the cookie.
+	Cookie Cookie `json:"cookie"`
+}
+
+// AssociatedCookie is the Network.AssociatedCookie type.
+//
+// A cookie associated with the request which may or may not be sent with it.
+// Includes the cookies itself and reasons for blocking or exemption.
+//
+// Experimental.
+type AssociatedCookie struct {
+	// The cookie object representing the cookie which was not sent.
+	Cookie Cookie `json:"cookie"`
+	// The reason(s) the cookie was blocked. If empty means the cookie is included.
+	BlockedReasons []CookieBlockedReason `json:"blockedReasons"`
+	// The reason the cookie should have been blocked by 3PCD but is exempted. A cookie could
+	// only have at most one exemption reason.
+	ExemptionReason CookieExemptionReason `json:"exemptionReason,omitempty"`
+}
+
+// CookieParam is the Network.CookieParam type.
+//
+// Cookie parameter object
+type CookieParam struct {
+	// Cookie name.
+	Name string `json:"name"`
+	// Cookie value.
+	Value string `json:"value"`
+	// The request-URI to associate with the setting of the cookie. This value can affect the
+	// default domain, path, source port, and source scheme values of the created cookie.
+	URL string `json:"url,omitempty"`
+	// Cookie domain.
+	Domain string `json:"domain,omitempty"`
+	// Cookie path.
+	Path string `json:"path,omitempty"`
+	// True if cookie is secure.
+	Secure *bool `json:"secure,omitempty"`
+	// True if cookie is http-only.
+	HTTPOnly *bool `json:"httpOnly,omitempty"`
+	// Cookie SameSite type.
+	SameSite CookieSameSite `json:"sameSite,omitempty"`
+	// Cookie expiration date, session cookie if not set
+	Expires *TimeSinceEpoch `json:"expires,omitempty"`
+	// Cookie Priority.
+	//
+	// Experimental.
+	Priority CookiePriority `json:"priority,omitempty"`
+	// True if cookie is SameParty.
+	//
+	// Experimental.
+	SameParty *bool `json:"sameParty,omitempty"`
+	// Cookie source scheme type.
+	//
+	// Experimental.
+	SourceScheme CookieSourceScheme `json:"sourceScheme,omitempty"`
+	// Cookie source port. Valid values are {-1, [1, 65535]}, -1 indicates an unspecified port.
+	// An unspecified port value allows protocol clients to emulate legacy cookie scope for the port.
+	// This is a temporary ability and it will be removed in the future.
+	//
+	// Experimental.
+	SourcePort *int64 `json:"sourcePort,omitempty"`
+	// Cookie partition key. If not set, the cookie will be set as not partitioned.
+	//
+	// Experimental.
+	PartitionKey *CookiePartitionKey `json:"partitionKey,omitempty"`
+}
+
+// AuthChallenge is the Network.AuthChallenge type.
+//
+// Authorization challenge for HTTP status code 401 or 407.
+//
+// Experimental.
+type AuthChallenge struct {
+	// Source of the authentication challenge.
+	Source string `json:"source,omitempty"`
+	// Origin of the challenger.
+	Origin string `json:"origin"`
+	// The authentication scheme used, such as basic or digest
+	Scheme string `json:"scheme"`
+	// The realm of the challenge. May be empty.
+	Realm string `json:"realm"`
+}
+
+// AuthChallengeResponse is the Network.AuthChallengeResponse type.
+//
+// Response to an AuthChallenge.
+//
+// Experimental.
+type AuthChallengeResponse struct {
+	// The decision on what to do in response to the authorization challenge.  Default means
+	// deferring to the default behavior of the net stack, which will likely either the Cancel
+	// authentication or display a popup dialog box.
+	Response string `json:"response"`
+	// The username to provide, possibly empty. Should only be set if response is
+	// ProvideCredentials.
+	Username string `json:"username,omitempty"`
+	// The password to provide, possibly empty. Should only be set if response is
+	// ProvideCredentials.
+	Password string `json:"password,omitempty"`
+}
+
+// InterceptionStage is the Network.InterceptionStage type.
+//
+// Stages of the interception to begin intercepting. Request will intercept before the request is
+// sent. Response will intercept after the response is received.
+//
+// Experimental.
+type InterceptionStage string
+
+const (
+	InterceptionStageRequest         InterceptionStage = "Request"
+	InterceptionStageHeadersReceived InterceptionStage = "HeadersReceived"
+)
+
+// RequestPattern is the Network.RequestPattern type.
+//
+// Request pattern for interception.
+//
+// Experimental.
+type RequestPattern struct {
+	// Wildcards (`'*'` -> zero or more, `'?'` -> exactly one) are allowed. Escape character is
+	// backslash. Omitting is equivalent to `"*"`.
+	URLPattern string `json:"urlPattern,omitempty"`
+	// If set, only requests for matching resource types will be intercepted.
+	ResourceType ResourceType `json:"resourceType,omitempty"`
+	// Stage at which to begin intercepting requests. Default is Request.
+	InterceptionStage InterceptionStage `json:"interceptionStage,omitempty"`
+}
+
+// SignedExchangeSignature is the Network.SignedExchangeSignature type.
+//
+// Information about a signed exchange signature.
+// https://wicg.github.io/webpackage/draft-yasskin-httpbis-origin-signed-exchanges-impl.html#rfc.section.3.1
+//
+// Experimental.
+type SignedExchangeSignature struct {
+	// Signed exchange signature label.
+	Label string `json:"label"`
+	// The hex string of signed exchange signature.
+	Signature string `json:"signature"`
+	// Signed exchange signature integrity.
+	Integrity string `json:"integrity"`
+	// Signed exchange signature cert Url.
+	CertURL string `json:"certUrl,omitempty"`
+	// The hex string of signed exchange signature cert sha256.
+	CertSha256 string `json:"certSha256,omitempty"`
+	// Signed exchange signature validity Url.
+	ValidityURL string `json:"validityUrl"`
+	// Signed exchange signature date.
+	Date int64 `json:"date"`
+	// Signed exchange signature expires.
+	Expires int64 `json:"expires"`
+	// The encoded certificates.
+	Certificates []string `json:"certificates,omitempty"`
+}
+
+// SignedExchangeHeader is the Network.SignedExchangeHeader type.
+//
+// Information about a signed exchange header.
+// https://wicg.github.io/webpackage/draft-yasskin-httpbis-origin-signed-exchanges-impl.html#cbor-representation
+//
+// Experimental.
+type SignedExchangeHeader struct {
+	// Signed exchange request URL.
+	RequestURL string `json:"requestUrl"`
+	// Signed exchange response code.
+	ResponseCode int64 `json:"responseCode"`
+	// Signed exchange response headers.
+	ResponseHeaders Headers `json:"responseHeaders"`
+	// Signed exchange response signature.
+	Signatures []SignedExchangeSignature `json:"signatures"`
+	// Signed exchange header integrity hash in the form of `sha256-<base64-hash-value>`.
+	HeaderIntegrity string `json:"headerIntegrity"`
+}
+
+// SignedExchangeErrorField is the Network.SignedExchangeErrorField type.
+//
+// Field type for a signed exchange related error.
+//
+// Experimental.
+type SignedExchangeErrorField string
+
+const (
+	SignedExchangeErrorFieldSignatureSig         SignedExchangeErrorField = "signatureSig"
+	SignedExchangeErrorFieldSignatureIntegrity   SignedExchangeErrorField = "signatureIntegrity"
+	SignedExchangeErrorFieldSignatureCertURL     SignedExchangeErrorField = "signatureCertUrl"
+	SignedExchangeErrorFieldSignatureCertSha256  SignedExchangeErrorField = "signatureCertSha256"
+	SignedExchangeErrorFieldSignatureValidityURL SignedExchangeErrorField = "signatureValidityUrl"
+	SignedExchangeErrorFieldSignatureTimestamps  SignedExchangeErrorField = "signatureTimestamps"
+)
+
+// SignedExchangeError is the Network.SignedExchangeError type.
+//
+// Information about a signed exchange response.
+//
+// Experimental.
+type SignedExchangeError struct {
+	// Error message.
+	Message string `json:"message"`
+	// The index of the signature which caused the error.
+	SignatureIndex *int64 `json:"signatureIndex,omitempty"`
+	// The field which caused the error.
+	ErrorField SignedExchangeErrorField `json:"errorField,omitempty"`
+}
+
+// SignedExchangeInfo is the Network.SignedExchangeInfo type.
+//
+// Information about a signed exchange response.
+//
+// Experimental.
+type SignedExchangeInfo struct {
+	// The outer response of signed HTTP exchange which was received from network.
+	OuterResponse Response `json:"outerResponse"`
+	// Information about the signed exchange header.
+	Header *SignedExchangeHeader `json:"header,omitempty"`
+	// Security details for the signed exchange header.
+	SecurityDetails *SecurityDetails `json:"securityDetails,omitempty"`
+	// Errors occurred while handling the signed exchange.
+	Errors []SignedExchangeError `json:"errors,omitempty"`
+}
+
+// ContentEncoding is the Network.ContentEncoding type.
+//
+// List of content encodings supported by the backend.
+//
+// Experimental.
+type ContentEncoding string
+
+const (
+	ContentEncodingDeflate ContentEncoding = "deflate"
+	ContentEncodingGzip    ContentEncoding = "gzip"
+	ContentEncodingBr      ContentEncoding = "br"
+	ContentEncodingZstd    ContentEncoding = "zstd"
+)
+
+// PrivateNetworkRequestPolicy is the Network.PrivateNetworkRequestPolicy type.
+//
+// Experimental.
+type PrivateNetworkRequestPolicy string
+
+const (
+	PrivateNetworkRequestPolicyAllow                          PrivateNetworkRequestPolicy = "Allow"
+	PrivateNetworkRequestPolicyBlockFromInsecureToMorePrivate PrivateNetworkRequestPolicy = "BlockFromInsecureToMorePrivate"
+	PrivateNetworkRequestPolicyWarnFromInsecureToMorePrivate  PrivateNetworkRequestPolicy = "WarnFromInsecureToMorePrivate"
+	PrivateNetworkRequestPolicyPreflightBlock                 PrivateNetworkRequestPolicy = "PreflightBlock"
+	PrivateNetworkRequestPolicyPreflightWarn                  PrivateNetworkRequestPolicy = "PreflightWarn"
+)
+
+// IPAddressSpace is the Network.IPAddressSpace type.
+//
+// Experimental.
+type IPAddressSpace string
+
+const (
+	IPAddressSpaceLocal   IPAddressSpace = "Local"
+	IPAddressSpacePrivate IPAddressSpace = "Private"
+	IPAddressSpacePublic  IPAddressSpace = "Public"
+	IPAddressSpaceUnknown IPAddressSpace = "Unknown"
+)
+
+// ConnectTiming is the Network.ConnectTiming type.
+//
+// Experimental.
+type ConnectTiming struct {
+	// Timing's requestTime is a baseline in seconds, while the other numbers are ticks in
+	// milliseconds relatively to this requestTime. Matches ResourceTiming's requestTime for
+	// the same request (but not for redirected requests).
+	RequestTime float64 `json:"requestTime"`
+}
+
+// ClientSecurityState is the Network.ClientSecurityState type.
+//
+// Experimental.
+type ClientSecurityState struct {
+	InitiatorIsSecureContext    bool                        `json:"initiatorIsSecureContext"`
+	InitiatorIPAddressSpace     IPAddressSpace              `json:"initiatorIPAddressSpace"`
+	PrivateNetworkRequestPolicy PrivateNetworkRequestPolicy `json:"privateNetworkRequestPolicy"`
+}
+
+// CrossOriginOpenerPolicyValue is the Network.CrossOriginOpenerPolicyValue type.
+//
+// Experimental.
+type CrossOriginOpenerPolicyValue string
+
+const (
+	CrossOriginOpenerPolicyValueSameOrigin                 CrossOriginOpenerPolicyValue = "SameOrigin"
+	CrossOriginOpenerPolicyValueSameOriginAllowPopups      CrossOriginOpenerPolicyValue = "SameOriginAllowPopups"
+	CrossOriginOpenerPolicyValueRestrictProperties         CrossOriginOpenerPolicyValue = "RestrictProperties"
+	CrossOriginOpenerPolicyValueUnsafeNone                 CrossOriginOpenerPolicyValue = "UnsafeNone"
+	CrossOriginOpenerPolicyValueSameOriginPlusCoep         CrossOriginOpenerPolicyValue = "SameOriginPlusCoep"
+	CrossOriginOpenerPolicyValueRestrictPropertiesPlusCoep CrossOriginOpenerPolicyValue = "RestrictPropertiesPlusCoep"
+	CrossOriginOpenerPolicyValueNoopenerAllowPopups        CrossOriginOpenerPolicyValue = "NoopenerAllowPopups"
+)
+
+// CrossOriginOpenerPolicyStatus is the Network.CrossOriginOpenerPolicyStatus type.
+//
+// Experimental.
+type CrossOriginOpenerPolicyStatus struct {
+	Value                       CrossOriginOpenerPolicyValue `json:"value"`
+	ReportOnlyValue             CrossOriginOpenerPolicyValue `json:"reportOnlyValue"`
+	ReportingEndpoint           string                       `json:"reportingEndpoint,omitempty"`
+	ReportOnlyReportingEndpoint string                       `json:"reportOnlyReportingEndpoint,omitempty"`
+}
+
+// CrossOriginEmbedderPolicyValue is the Network.CrossOriginEmbedderPolicyValue type.
+//
+// Experimental.
+type CrossOriginEmbedderPolicyValue string
+
+const (
+	CrossOriginEmbedderPolicyValueNone           CrossOriginEmbedderPolicyValue = "None"
+	CrossOriginEmbedderPolicyValueCredentialless CrossOriginEmbedderPolicyValue = "Credentialless"
+	CrossOriginEmbedderPolicyValueRequireCorp    CrossOriginEmbedderPolicyValue = "RequireCorp"
+)
+
+// CrossOriginEmbedderPolicyStatus is the Network.CrossOriginEmbedderPolicyStatus type.
+//
+// Experimental.
+type CrossOriginEmbedderPolicyStatus struct {
+	Value                       CrossOriginEmbedderPolicyValue `json:"value"`
+	ReportOnlyValue             CrossOriginEmbedderPolicyValue `json:"reportOnlyValue"`
+	ReportingEndpoint           string                         `json:"reportingEndpoint,omitempty"`
+	ReportOnlyReportingEndpoint string                         `json:"reportOnlyReportingEndpoint,omitempty"`
+}
+
+// ContentSecurityPolicySource is the Network.ContentSecurityPolicySource type.
+//
+// Experimental.
+type ContentSecurityPolicySource string
+
+const (
+	ContentSecurityPolicySourceHTTP ContentSecurityPolicySource = "HTTP"
+	ContentSecurityPolicySourceMeta ContentSecurityPolicySource = "Meta"
+)
+
+// ContentSecurityPolicyStatus is the Network.ContentSecurityPolicyStatus type.
+//
+// Experimental.
+type ContentSecurityPolicyStatus struct {
+	EffectiveDirectives string                      `json:"effectiveDirectives"`
+	IsEnforced          bool                        `json:"isEnforced"`
+	Source              ContentSecurityPolicySource `json:"source"`
+}
+
+// SecurityIsolationStatus is the Network.SecurityIsolationStatus type.
+//
+// Experimental.
+type SecurityIsolationStatus struct {
+	Coop *CrossOriginOpenerPolicyStatus   `json:"coop,omitempty"`
+	Coep *CrossOriginEmbedderPolicyStatus `json:"coep,omitempty"`
+	Csp  []ContentSecurityPolicyStatus    `json:"csp,omitempty"`
+}
+
+// ReportStatus is the Network.ReportStatus type.
+//
+// The status of a Reporting API report.
+//
+// Experimental.
+type ReportStatus string
+
+const (
+	ReportStatusQueued           ReportStatus = "Queued"
+	ReportStatusPending          ReportStatus = "Pending"
+	ReportStatusMarkedForRemoval ReportStatus = "MarkedForRemoval"
+	ReportStatusSuccess          ReportStatus = "Success"
+)
+
+// ReportID is the Network.ReportId type.
+//
+// Experimental.
+type ReportID string
+
+// ReportingAPIReport is the Network.ReportingApiReport type.
+//
+// An object representing a report generated by the Reporting API.
+//
+// Experimental.
+type ReportingAPIReport struct {
+	ID ReportID `json:"id"`
+	// The URL of the document that triggered the report.
+	InitiatorURL string `json:"initiatorUrl"`
+	// The name of the endpoint group that should be used to deliver the report.
+	Destination string `json:"destination"`
+	// The type of the report (specifies the set of data that is contained in the report body).
+	Type string `json:"type"`
+	// When the report was generated.
+	Timestamp TimeSinceEpoch `json:"timestamp"`
+	// How many uploads deep the related request was.
+	Depth int64 `json:"depth"`
+	// The number of delivery attempts made so far, not including an active attempt.
+	CompletedAttempts int64          `json:"completedAttempts"`
+	Body              map[string]any `json:"body"`
+	Status            ReportStatus   `json:"status"`
+}
+
+// ReportingAPIEndpoint is the Network.ReportingApiEndpoint type.
+//
+// Experimental.
+type ReportingAPIEndpoint struct {
+	// The URL of the endpoint to which reports may be delivered.
+	URL string `json:"url"`
+	// Name of the endpoint group.
+	GroupName string `json:"groupName"`
+}
+
+// LoadNetworkResourcePageResult is the Network.LoadNetworkResourcePageResult type.
+//
+// An object providing the result of a network resource load.
+//
+// Experimental.
+type LoadNetworkResourcePageResult struct {
+	Success bool `json:"success"`
+	// Optional values used for error reporting.
+	NetError       *float64 `json:"netError,omitempty"`
+	NetErrorName   string   `json:"netErrorName,omitempty"`
+	HTTPStatusCode *float64 `json:"httpStatusCode,omitempty"`
+	// If successful, one of the following two fields holds the result.
+	Stream io.StreamHandle `json:"stream,omitempty"`
+	// Response headers.
+	Headers Headers `json:"headers,omitempty"`
+}
+
+// LoadNetworkResourceOptions is the Network.LoadNetworkResourceOptions type.
+//
+// An options object that may be extended later to better support CORS,
+// CORB and streaming.
+//
+// Experimental.
+type LoadNetworkResourceOptions struct {
+	DisableCache       bool `json:"disableCache"`
+	IncludeCredentials bool `json:"includeCredentials"`
+}
+
+// SetAcceptedEncodingsParams holds the parameters of Network.setAcceptedEncodings.
+type SetAcceptedEncodingsParams struct {
+	// List of accepted content encodings.
+	Encodings []ContentEncoding `json:"encodings"`
+}
+
+// SetAcceptedEncodings calls Network.setAcceptedEncodings.
+//
+// Sets a list of content encodings that will be accepted. Empty list means no encoding is accepted.
+//
+// Experimental.
+func SetAcceptedEncodings(ctx context.Context, c *internal.Client, sessionID string, params *SetAcceptedEncodingsParams) error {
+	if params == nil {
+		params = &SetAcceptedEncodingsParams{}
+	}
+	return c.Call(ctx, "Network.setAcceptedEncodings", params, sessionID, nil)
+}
+
+// ClearAcceptedEncodingsOverride calls Network.clearAcceptedEncodingsOverride.
+//
+// # Clears accepted encodings set by setAcceptedEncodings
+//
+// Experimental.
+func ClearAcceptedEncodingsOverride(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Network.clearAcceptedEncodingsOverride", nil, sessionID, nil)
+}
+
+// CanClearBrowserCacheResult holds the result of Network.canClearBrowserCache.
+type CanClearBrowserCacheResult struct {
+	// True if browser cache can be cleared.
+	Result bool `json:"result"`
+}
+
+// CanClearBrowserCache calls Network.canClearBrowserCache.
+//
+// Tells whether clearing browser cache is supported.
+//
+// Deprecated: this item is deprecated in the protocol.
+func CanClearBrowserCache(ctx context.Context, c *internal.Client, sessionID string) (*CanClearBrowserCacheResult, error) {
+	var result CanClearBrowserCacheResult
+	err := c.Call(ctx, "Network.canClearBrowserCache", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CanClearBrowserCookiesResult holds the result of Network.canClearBrowserCookies.
+type CanClearBrowserCookiesResult struct {
+	// True if browser cookies can be cleared.
+	Result bool `json:"result"`
+}
+
+// CanClearBrowserCookies calls Network.canClearBrowserCookies.
+//
+// Tells whether clearing browser cookies is supported.
+//
+// Deprecated: this item is deprecated in the protocol.
+func CanClearBrowserCookies(ctx context.Context, c *internal.Client, sessionID string) (*CanClearBrowserCookiesResult, error) {
+	var result CanClearBrowserCookiesResult
+	err := c.Call(ctx, "Network.canClearBrowserCookies", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CanEmulateNetworkConditionsResult holds the result of Network.canEmulateNetworkConditions.
+type CanEmulateNetworkConditionsResult struct {
+	// True if emulation of network conditions is supported.
+	Result bool `json:"result"`
+}
+
+// CanEmulateNetworkConditions calls Network.canEmulateNetworkConditions.
+//
+// Tells whether emulation of network conditions is supported.
+//
+// Deprecated: this item is deprecated in the protocol.
+func CanEmulateNetworkConditions(ctx context.Context, c *internal.Client, sessionID string) (*CanEmulateNetworkConditionsResult, error) {
+	var result CanEmulateNetworkConditionsResult
+	err := c.Call(ctx, "Network.canEmulateNetworkConditions", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ClearBrowserCache calls Network.clearBrowserCache.
+//
+// Clears browser cache.
+func ClearBrowserCache(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Network.clearBrowserCache", nil, sessionID, nil)
+}
+
+// ClearBrowserCookies calls Network.clearBrowserCookies.
+//
+// Clears browser cookies.
+func ClearBrowserCookies(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Network.clearBrowserCookies", nil, sessionID, nil)
+}
+
+// ContinueInterceptedRequestParams holds the parameters of Network.continueInterceptedRequest.
+type ContinueInterceptedRequestParams struct {
+	InterceptionID InterceptionID `json:"interceptionId"`
+	// If set this causes the request to fail with the given reason. Passing `Aborted` for requests
+	// marked with `isNavigationRequest` also cancels the navigation. Must not be set in response
+	// to an authChallenge.
+	ErrorReason ErrorReason `json:"errorReason,omitempty"`
+	// If set the requests completes using with the provided base64 encoded raw response, including
+	// HTTP status line and headers etc... Must not be set in response to an authChallenge. (Encoded as a base64 string when passed over JSON)
+	RawResponse string `json:"rawResponse,omitempty"`
+	// If set the request url will be modified in a way that's not observable by page. Must not be
+	// set in response to an authChallenge.
+	URL string `json:"url,omitempty"`
+	// If set this allows the request method to be overridden. Must not be set in response to an
+	// authChallenge.
+	Method string `json:"method,omitempty"`
+	// If set this allows postData to be set. Must not be set in response to an authChallenge.
+	PostData string `json:"postData,omitempty"`
+	// If set this allows the request headers to be changed. Must not be set in response to an
+	// authChallenge.
+	Headers Headers `json:"headers,omitempty"`
+	// Response to a requestIntercepted with an authChallenge. Must not be set otherwise.
+	AuthChallengeResponse *AuthChallengeResponse `json:"authChallengeResponse,omitempty"`
+}
+
+// ContinueInterceptedRequest calls Network.continueInterceptedRequest.
+//
+// Response to Network.requestIntercepted which either modifies the request to continue with any
+// modifications, or blocks it, or completes it with the provided response bytes. If a network
+// fetch occurs as a result which encounters a redirect an additional Network.requestIntercepted
+// event will be sent with the same InterceptionId.
+// Deprecated, use Fetch.continueRequest, Fetch.fulfillRequest and Fetch.failRequest instead.
+//
+// Experimental.
+//
+// Deprecated: this item is deprecated in the protocol.
+func ContinueInterceptedRequest(ctx context.Context, c *internal.Client, sessionID string, params *ContinueInterceptedRequestParams) error {
+	if params == nil {
+		params = &ContinueInterceptedRequestParams{}
+	}
+	return c.Call(ctx, "Network.continueInterceptedRequest", params, sessionID, nil)
+}
+
+// DeleteCookiesParams holds the parameters of Network.deleteCookies.
+type DeleteCookiesParams struct {
+	// Name of the cookies to remove.
+	Name string `json:"name"`
+	// If specified, deletes all the cookies with the given name where domain and path match
+	// provided URL.
+	URL string `json:"url,omitempty"`
+	// If specified, deletes only cookies with the exact domain.
+	Domain string `json:"domain,omitempty"`
+	// If specified, deletes only cookies with the exact path.
+	Path string `json:"path,omitempty"`
+	// If specified, deletes only cookies with the the given name and partitionKey where
+	// all partition key attributes match the cookie partition key attribute.
+	//
+	// Experimental.
+	PartitionKey *CookiePartitionKey `json:"partitionKey,omitempty"`
+}
+
+// DeleteCookies calls Network.deleteCookies.
+//
+// Deletes browser cookies with matching name and url or domain/path/partitionKey pair.
+func DeleteCookies(ctx context.Context, c *internal.Client, sessionID string, params *DeleteCookiesParams) error {
+	if params == nil {
+		params = &DeleteCookiesParams{}
+	}
+	return c.Call(ctx, "Network.deleteCookies", params, sessionID, nil)
+}
+
+// Disable calls Network.disable.
+//
+// Disables network tracking, prevents network events from being sent to the client.
+func Disable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Network.disable", nil, sessionID, nil)
+}
+
+// EmulateNetworkConditionsParams holds the parameters of Network.emulateNetworkConditions.
+type EmulateNetworkConditionsParams struct {
+	// True to emulate internet disconnection.
+	Offline bool `json:"offline"`
+	// Minimum latency from request sent to response headers received (ms).
+	Latency float64 `json:"latency"`
+	// Maximal aggregated download throughput (bytes/sec). -1 disables download throttling.
+	DownloadThroughput float64 `json:"downloadThroughput"`
+	// Maximal aggregated upload throughput (bytes/sec).  -1 disables upload throttling.
+	UploadThroughput float64 `json:"uploadThroughput"`
+	// Connection type if known.
+	ConnectionType ConnectionType `json:"connectionType,omitempty"`
+	// WebRTC packet loss (percent, 0-100). 0 disables packet loss emulation, 100 drops all the packets.
+	//
+	// Experimental.
+	PacketLoss *float64 `json:"packetLoss,omitempty"`
+	// WebRTC packet queue length (packet). 0 removes any queue length limitations.
+	//
+	// Experimental.
+	PacketQueueLength *int64 `json:"packetQueueLength,omitempty"`
+	// WebRTC packetReordering feature.
+	//
+	// Experimental.
+	PacketReordering *bool `json:"packetReordering,omitempty"`
+}
+
+// EmulateNetworkConditions calls Network.emulateNetworkConditions.
+//
+// Activates emulation of network conditions.
+func EmulateNetworkConditions(ctx context.Context, c *internal.Client, sessionID string, params *EmulateNetworkConditionsParams) error {
+	if params == nil {
+		params = &EmulateNetworkConditionsParams{}
+	}
+	return c.Call(ctx, "Network.emulateNetworkConditions", params, sessionID, nil)
+}
+
+// EnableParams holds the parameters of Network.enable.
+type EnableParams struct {
+	// Buffer size in bytes to use when preserving network payloads (XHRs, etc).
+	//
+	// Experimental.
+	MaxTotalBufferSize *int64 `json:"maxTotalBufferSize,omitempty"`
+	// Per-resource buffer size in bytes to use when preserving network payloads (XHRs, etc).
+	//
+	// Experimental.
+	MaxResourceBufferSize *int64 `json:"maxResourceBufferSize,omitempty"`
+	// Longest post body size (in bytes) that would be included in requestWillBeSent notification
+	MaxPostDataSize *int64 `json:"maxPostDataSize,omitempty"`
+}
+
+// Enable calls Network.enable.
+//
+// Enables network tracking, network events will now be delivered to the client.
+func Enable(ctx context.Context, c *internal.Client, sessionID string, params *EnableParams) error {
+	if params == nil {
+		params = &EnableParams{}
+	}
+	return c.Call(ctx, "Network.enable", params, sessionID, nil)
+}
+
+// GetAllCookiesResult holds the result of Network.getAllCookies.
+type GetAllCookiesResult struct {
+	// Array of cookie objects.
+	Cookies []Cookie `json:"cookies"`
+}
+
+// GetAllCookies calls Network.getAllCookies.
+//
+// Returns all browser cookies. Depending on the backend support, will return detailed cookie
+// information in the `cookies` field.
+// Deprecated. Use Storage.getCookies instead.
+//
+// Deprecated: this item is deprecated in the protocol.
+func GetAllCookies(ctx context.Context, c *internal.Client, sessionID string) (*GetAllCookiesResult, error) {
+	var result GetAllCookiesResult
+	err := c.Call(ctx, "Network.getAllCookies", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetCertificateParams holds the parameters of Network.getCertificate.
+type GetCertificateParams struct {
+	// Origin to get certificate for.
+	Origin string `json:"origin"`
+}
+
+// GetCertificateResult holds the result of Network.getCertificate.
+type GetCertificateResult struct {
+	TableNames []string `json:"tableNames"`
+}
+
+// GetCertificate calls Network.getCertificate.
+//
+// Returns the DER-encoded certificate.
+//
+// Experimental.
+func GetCertificate(ctx context.Context, c *internal.Client, sessionID string, params *GetCertificateParams) (*GetCertificateResult, error) {
+	if params == nil {
+		params = &GetCertificateParams{}
+	}
+	var result GetCertificateResult
+	err := c.Call(ctx, "Network.getCertificate", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetCookiesParams holds the parameters of Network.getCookies.
+type GetCookiesParams struct {
+	// The list of URLs for which applicable cookies will be fetched.
+	// If not specified, it's assumed to be set to the list containing
+	// the URLs of the page and all of its subframes.
+	URLs []string `json:"urls,omitempty"`
+}
+
+// GetCookiesResult holds the result of Network.getCookies.
+type GetCookiesResult struct {
+	// Array of cookie objects.
+	Cookies []Cookie `json:"cookies"`
+}
+
+// GetCookies calls Network.getCookies.
+//
+// Returns all browser cookies for the current URL. Depending on the backend support, will return
+// detailed cookie information in the `cookies` field.
+func GetCookies(ctx context.Context, c *internal.Client, sessionID string, params *GetCookiesParams) (*GetCookiesResult, error) {
+	if params == nil {
+		params = &GetCookiesParams{}
+	}
+	var result GetCookiesResult
+	err := c.Call(ctx, "Network.getCookies", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetResponseBodyParams holds the parameters of Network.getResponseBody.
+type GetResponseBodyParams struct {
+	// Identifier of the network request to get content for.
+	RequestID RequestID `json:"requestId"`
+}
+
+// GetResponseBodyResult holds the result of Network.getResponseBody.
+type GetResponseBodyResult struct {
+	// Response body.
+	Body string `json:"body"`
+	// True, if content was sent as base64.
+	Base64Encoded bool `json:"base64Encoded"`
+}
+
+// GetResponseBody calls Network.getResponseBody.
+//
+// Returns content served for the given request.
+func GetResponseBody(ctx context.Context, c *internal.Client, sessionID string, params *GetResponseBodyParams) (*GetResponseBodyResult, error) {
+	if params == nil {
+		params = &GetResponseBodyParams{}
+	}
+	var result GetResponseBodyResult
+	err := c.Call(ctx, "Network.getResponseBody", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetRequestPostDataParams holds the parameters of Network.getRequestPostData.
+type GetRequestPostDataParams struct {
+	// Identifier of the network request to get content for.
+	RequestID RequestID `json:"requestId"`
+}
+
+// GetRequestPostDataResult holds the result of Network.getRequestPostData.
+type GetRequestPostDataResult struct {
+	// Request body string, omitting files from multipart requests
+	PostData string `json:"postData"`
+}
+
+// GetRequestPostData calls Network.getRequestPostData.
+//
+// Returns post data sent with the request. Returns an error when no data was sent with the request.
+func GetRequestPostData(ctx context.Context, c *internal.Client, sessionID string, params *GetRequestPostDataParams) (*GetRequestPostDataResult, error) {
+	if params == nil {
+		params = &GetRequestPostDataParams{}
+	}
+	var result GetRequestPostDataResult
+	err := c.Call(ctx, "Network.getRequestPostData", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetResponseBodyForInterceptionParams holds the parameters of Network.getResponseBodyForInterception.
+type GetResponseBodyForInterceptionParams struct {
+	// Identifier for the intercepted request to get body for.
+	InterceptionID InterceptionID `json:"interceptionId"`
+}
+
+// GetResponseBodyForInterceptionResult holds the result of Network.getResponseBodyForInterception.
+type GetResponseBodyForInterceptionResult struct {
+	// Response body.
+	Body string `json:"body"`
+	// True, if content was sent as base64.
+	Base64Encoded bool `json:"base64Encoded"`
+}
+
+// GetResponseBodyForInterception calls Network.getResponseBodyForInterception.
+//
+// Returns content served for the given currently intercepted request.
+//
+// Experimental.
+func GetResponseBodyForInterception(ctx context.Context, c *internal.Client, sessionID string, params *GetResponseBodyForInterceptionParams) (*GetResponseBodyForInterceptionResult, error) {
+	if params == nil {
+		params = &GetResponseBodyForInterceptionParams{}
+	}
+	var result GetResponseBodyForInterceptionResult
+	err := c.Call(ctx, "Network.getResponseBodyForInterception", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// TakeResponseBodyForInterceptionAsStreamParams holds the parameters of Network.takeResponseBodyForInterceptionAsStream.
+type TakeResponseBodyForInterceptionAsStreamParams struct {
+	InterceptionID InterceptionID `json:"interceptionId"`
+}
+
+// TakeResponseBodyForInterceptionAsStreamResult holds the result of Network.takeResponseBodyForInterceptionAsStream.
+type TakeResponseBodyForInterceptionAsStreamResult struct {
+	Stream io.StreamHandle `json:"stream"`
+}
+
+// TakeResponseBodyForInterceptionAsStream calls Network.takeResponseBodyForInterceptionAsStream.
+//
+// Returns a handle to the stream representing the response body. Note that after this command,
+// the intercepted request can't be continued as is -- you either need to cancel it or to provide
+// the response body. The stream only supports sequential read, IO.read will fail if the position
+// is specified.
+//
+// Experimental.
+func TakeResponseBodyForInterceptionAsStream(ctx context.Context, c *internal.Client, sessionID string, params *TakeResponseBodyForInterceptionAsStreamParams) (*TakeResponseBodyForInterceptionAsStreamResult, error) {
+	if params == nil {
+		params = &TakeResponseBodyForInterceptionAsStreamParams{}
+	}
+	var result TakeResponseBodyForInterceptionAsStreamResult
+	err := c.Call(ctx, "Network.takeResponseBodyForInterceptionAsStream", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ReplayXHRParams holds the parameters of Network.replayXHR.
+type ReplayXHRParams struct {
+	// Identifier of XHR to replay.
+	RequestID RequestID `json:"requestId"`
+}
+
+// ReplayXHR calls Network.replayXHR.
+//
+// This method sends a new XMLHttpRequest which is identical to the original one. The following
+// parameters should be identical: method, url, async, request body, extra headers, withCredentials
+// attribute, user, password.
+//
+// Experimental.
+func ReplayXHR(ctx context.Context, c *internal.Client, sessionID string, params *ReplayXHRParams) error {
+	if params == nil {
+		params = &ReplayXHRParams{}
+	}
+	return c.Call(ctx, "Network.replayXHR", params, sessionID, nil)
+}
+
+// SearchInResponseBodyParams holds the parameters of Network.searchInResponseBody.
+type SearchInResponseBodyParams struct {
+	// Identifier of the network response to search.
+	RequestID RequestID `json:"requestId"`
+	// String to search for.
+	Query string `json:"query"`
+	// If true, search is case sensitive.
+	CaseSensitive *bool `json:"caseSensitive,omitempty"`
+	// If true, treats string parameter as regex.
+	IsRegex *bool `json:"isRegex,omitempty"`
+}
+
+// SearchInResponseBodyResult holds the result of Network.searchInResponseBody.
+type SearchInResponseBodyResult struct {
+	// List of search matches.
+	Result []debugger.SearchMatch `json:"result"`
+}
+
+// SearchInResponseBody calls Network.searchInResponseBody.
+//
+// Searches for given string in response content.
+//
+// Experimental.
+func SearchInResponseBody(ctx context.Context, c *internal.Client, sessionID string, params *SearchInResponseBodyParams) (*SearchInResponseBodyResult, error) {
+	if params == nil {
+		params = &SearchInResponseBodyParams{}
+	}
+	var result SearchInResponseBodyResult
+	err := c.Call(ctx, "Network.searchInResponseBody", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SetBlockedURLsParams holds the parameters of Network.setBlockedURLs.
+type SetBlockedURLsParams struct {
+	// URL patterns to block. Wildcards ('*') are allowed.
+	URLs []string `json:"urls"`
+}
+
+// SetBlockedURLs calls Network.setBlockedURLs.
+//
+// Blocks URLs from loading.
+//
+// Experimental.
+func SetBlockedURLs(ctx context.Context, c *internal.Client, sessionID string, params *SetBlockedURLsParams) error {
+	if params == nil {
+		params = &SetBlockedURLsParams{}
+	}
+	return c.Call(ctx, "Network.setBlockedURLs", params, sessionID, nil)
+}
+
+// SetBypassServiceWorkerParams holds the parameters of Network.setBypassServiceWorker.
+type SetBypassServiceWorkerParams struct {
+	// Bypass service worker and load from network.
+	Bypass bool `json:"bypass"`
+}
+
+// SetBypassServiceWorker calls Network.setBypassServiceWorker.
+//
+// Toggles ignoring of service worker for each request.
+func SetBypassServiceWorker(ctx context.Context, c *internal.Client, sessionID string, params *SetBypassServiceWorkerParams) error {
+	if params == nil {
+		params = &SetBypassServiceWorkerParams{}
+	}
+	return c.Call(ctx, "Network.setBypassServiceWorker", params, sessionID, nil)
+}
+
+// SetCacheDisabledParams holds the parameters of Network.setCacheDisabled.
+type SetCacheDisabledParams struct {
+	// Cache disabled state.
+	CacheDisabled bool `json:"cacheDisabled"`
+}
+
+// SetCacheDisabled calls Network.setCacheDisabled.
+//
+// Toggles ignoring cache for each request. If `true`, cache will not be used.
+func SetCacheDisabled(ctx context.Context, c *internal.Client, sessionID string, params *SetCacheDisabledParams) error {
+	if params == nil {
+		params = &SetCacheDisabledParams{}
+	}
+	return c.Call(ctx, "Network.setCacheDisabled", params, sessionID, nil)
+}
+
+// SetCookieParams holds the parameters of Network.setCookie.
+type SetCookieParams struct {
+	// Cookie name.
+	Name string `json:"name"`
+	// Cookie value.
+	Value string `json:"value"`
+	// The request-URI to associate with the setting of the cookie. This value can affect the
+	// default domain, path, source port, and source scheme values of the created cookie.
+	URL string `json:"url,omitempty"`
+	// Cookie domain.
+	Domain string `json:"domain,omitempty"`
+	// Cookie path.
+	Path string `json:"path,omitempty"`
+	// True if cookie is secure.
+	Secure *bool `json:"secure,omitempty"`
+	// True if cookie is http-only.
+	HTTPOnly *bool `json:"httpOnly,omitempty"`
+	// Cookie SameSite type.
+	SameSite CookieSameSite `json:"sameSite,omitempty"`
+	// Cookie expiration date, session cookie if not set
+	Expires *TimeSinceEpoch `json:"expires,omitempty"`
+	// Cookie Priority type.
+	//
+	// Experimental.
+	Priority CookiePriority `json:"priority,omitempty"`
+	// True if cookie is SameParty.
+	//
+	// Experimental.
+	SameParty *bool `json:"sameParty,omitempty"`
+	// Cookie source scheme type.
+	//
+	// Experimental.
+	SourceScheme CookieSourceScheme `json:"sourceScheme,omitempty"`
+	// Cookie source port. Valid values are {-1, [1, 65535]}, -1 indicates an unspecified port.
+	// An unspecified port value allows protocol clients to emulate legacy cookie scope for the port.
+	// This is a temporary ability and it will be removed in the future.
+	//
+	// Experimental.
+	SourcePort *int64 `json:"sourcePort,omitempty"`
+	// Cookie partition key. If not set, the cookie will be set as not partitioned.
+	//
+	// Experimental.
+	PartitionKey *CookiePartitionKey `json:"partitionKey,omitempty"`
+}
+
+// SetCookieResult holds the result of Network.setCookie.
+type SetCookieResult struct {
+	// Always set to true. If an error occurs, the response indicates protocol error.
+	//
+	// Deprecated: this item is deprecated in the protocol.
+	Success bool `json:"success"`
+}
+
+// SetCookie calls Network.setCookie.
+//
+// Sets a cookie with the given cookie data; may overwrite equivalent cookies if they exist.
+func SetCookie(ctx context.Context, c *internal.Client, sessionID string, params *SetCookieParams) (*SetCookieResult, error) {
+	if params == nil {
+		params = &SetCookieParams{}
+	}
+	var result SetCookieResult
+	err := c.Call(ctx, "Network.setCookie", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SetCookiesParams holds the parameters of Network.setCookies.
+type SetCookiesParams struct {
+	// Cookies to be set.
+	Cookies []CookieParam `json:"cookies"`
+}
+
+// SetCookies calls Network.setCookies.
+//
+// Sets given cookies.
+func SetCookies(ctx context.Context, c *internal.Client, sessionID string, params *SetCookiesParams) error {
+	if params == nil {
+		params = &SetCookiesParams{}
+	}
+	return c.Call(ctx, "Network.setCookies", params, sessionID, nil)
+}
+
+// SetExtraHTTPHeadersParams holds the parameters of Network.setExtraHTTPHeaders.
+type SetExtraHTTPHeadersParams struct {
+	// Map with extra HTTP headers.
+	Headers Headers `json:"headers"`
+}
+
+// SetExtraHTTPHeaders calls Network.setExtraHTTPHeaders.
+//
+// Specifies whether to always send extra HTTP headers with the requests from this page.
+func SetExtraHTTPHeaders(ctx context.Context, c *internal.Client, sessionID string, params *SetExtraHTTPHeadersParams) error {
+	if params == nil {
+		params = &SetExtraHTTPHeadersParams{}
+	}
+	return c.Call(ctx, "Network.setExtraHTTPHeaders", params, sessionID, nil)
+}
+
+// SetAttachDebugStackParams holds the parameters of Network.setAttachDebugStack.
+type SetAttachDebugStackParams struct {
+	// Whether to attach a page script stack for debugging purpose.
+	Enabled bool `json:"enabled"`
+}
+
+// SetAttachDebugStack calls Network.setAttachDebugStack.
+//
+// # Specifies whether to attach a page script stack id in requests
+//
+// Experimental.
+func SetAttachDebugStack(ctx context.Context, c *internal.Client, sessionID string, params *SetAttachDebugStackParams) error {
+	if params == nil {
+		params = &SetAttachDebugStackParams{}
+	}
+	return c.Call(ctx, "Network.setAttachDebugStack", params, sessionID, nil)
+}
+
+// SetRequestInterceptionParams holds the parameters of Network.setRequestInterception.
+type SetRequestInterceptionParams struct {
+	// Requests matching any of these patterns will be forwarded and wait for the corresponding
+	// continueInterceptedRequest call.
+	Patterns []RequestPattern `json:"patterns"`
+}
+
+// SetRequestInterception calls Network.setRequestInterception.
+//
+// Sets the requests to intercept that match the provided patterns and optionally resource types.
+// Deprecated, please use Fetch.enable instead.
+//
+// Experimental.
+//
+// Deprecated: this item is deprecated in the protocol.
+func SetRequestInterception(ctx context.Context, c *internal.Client, sessionID string, params *SetRequestInterceptionParams) error {
+	if params == nil {
+		params = &SetRequestInterceptionParams{}
+	}
+	return c.Call(ctx, "Network.setRequestInterception", params, sessionID, nil)
+}
+
+// SetUserAgentOverrideParams holds the parameters of Network.setUserAgentOverride.
+type SetUserAgentOverrideParams struct {
+	// User agent to use.
+	UserAgent string `json:"userAgent"`
+	// Browser language to emulate.
+	AcceptLanguage string `json:"acceptLanguage,omitempty"`
+	// The platform navigator.platform should return.
+	Platform string `json:"platform,omitempty"`
+	// To be sent in Sec-CH-UA-* headers and returned in navigator.userAgentData
+	//
+	// Experimental.
+	UserAgentMetadata *emulation.UserAgentMetadata `json:"userAgentMetadata,omitempty"`
+}
+
+// SetUserAgentOverride calls Network.setUserAgentOverride.
+//
+// Allows overriding user agent with the given string.
+//
+// Redirects to Emulation.
+func SetUserAgentOverride(ctx context.Context, c *internal.Client, sessionID string, params *SetUserAgentOverrideParams) error {
+	if params == nil {
+		params = &SetUserAgentOverrideParams{}
+	}
+	return c.Call(ctx, "Network.setUserAgentOverride", params, sessionID, nil)
+}
+
+// StreamResourceContentParams holds the parameters of Network.streamResourceContent.
+type StreamResourceContentParams struct {
+	// Identifier of the request to stream.
+	RequestID RequestID `json:"requestId"`
+}
+
+// StreamResourceContentResult holds the result of Network.streamResourceContent.
+type StreamResourceContentResult struct {
+	// Data that has been buffered until streaming is enabled. (Encoded as a base64 string when passed over JSON)
+	BufferedData string `json:"bufferedData"`
+}
+
+// StreamResourceContent calls Network.streamResourceContent.
+//
+// Enables streaming of the response for the given requestId.
+// If enabled, the dataReceived event contains the data that was received during streaming.
+//
+// Experimental.
+func StreamResourceContent(ctx context.Context, c *internal.Client, sessionID string, params *StreamResourceContentParams) (*StreamResourceContentResult, error) {
+	if params == nil {
+		params = &StreamResourceContentParams{}
+	}
+	var result StreamResourceContentResult
+	err := c.Call(ctx, "Network.streamResourceContent", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetSecurityIsolationStatusParams holds the parameters of Network.getSecurityIsolationStatus.
+type GetSecurityIsolationStatusParams struct {
+	// If no frameId is provided, the status of the target is provided.
+	FrameID page.FrameID `json:"frameId,omitempty"`
+}
+
+// GetSecurityIsolationStatusResult holds the result of Network.getSecurityIsolationStatus.
+type GetSecurityIsolationStatusResult struct {
+	Status SecurityIsolationStatus `json:"status"`
+}
+
+// GetSecurityIsolationStatus calls Network.getSecurityIsolationStatus.
+//
+// Returns information about the COEP/COOP isolation status.
+//
+// Experimental.
+func GetSecurityIsolationStatus(ctx context.Context, c *internal.Client, sessionID string, params *GetSecurityIsolationStatusParams) (*GetSecurityIsolationStatusResult, error) {
+	if params == nil {
+		params = &GetSecurityIsolationStatusParams{}
+	}
+	var result GetSecurityIsolationStatusResult
+	err := c.Call(ctx, "Network.getSecurityIsolationStatus", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// EnableReportingAPIParams holds the parameters of Network.enableReportingApi.
+type EnableReportingAPIParams struct {
+	// Whether to enable or disable events for the Reporting API
+	Enable bool `json:"enable"`
+}
+
+// EnableReportingAPI calls Network.enableReportingApi.
+//
+// Enables tracking for the Reporting API, events generated by the Reporting API will now be delivered to the client.
+// Enabling triggers 'reportingApiReportAdded' for all existing reports.
+//
+// Experimental.
+func EnableReportingAPI(ctx context.Context, c *internal.Client, sessionID string, params *EnableReportingAPIParams) error {
+	if params == nil {
+		params = &EnableReportingAPIParams{}
+	}
+	return c.Call(ctx, "Network.enableReportingApi", params, sessionID, nil)
+}
+
+// LoadNetworkResourceParams holds the parameters of Network.loadNetworkResource.
+type LoadNetworkResourceParams struct {
+	// Frame id to get the resource for. Mandatory for frame targets, and
+	// should be omitted for worker targets.
+	FrameID page.FrameID `json:"frameId,omitempty"`
+	// URL of the resource to get content for.
+	URL string `json:"url"`
+	// Options for the request.
+	Options LoadNetworkResourceOptions `json:"options"`
+}
+
+// LoadNetworkResourceResult holds the result of Network.loadNetworkResource.
+type LoadNetworkResourceResult struct {
+	Resource LoadNetworkResourcePageResult `json:"resource"`
+}
+
+// LoadNetworkResource calls Network.loadNetworkResource.
+//
+// Fetches the resource and returns the content.
+//
+// Experimental.
+func LoadNetworkResource(ctx context.Context, c *internal.Client, sessionID string, params *LoadNetworkResourceParams) (*LoadNetworkResourceResult, error) {
+	if params == nil {
+		params = &LoadNetworkResourceParams{}
+	}
+	var result LoadNetworkResourceResult
+	err := c.Call(ctx, "Network.loadNetworkResource", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// EventDataReceived is the method name of the Network.dataReceived event.
+const EventDataReceived = "Network.dataReceived"
+
+// DataReceivedEvent is the payload of the Network.dataReceived event.
+//
+// Fired when data chunk was received over the network.
+type DataReceivedEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+	// Data chunk length.
+	DataLength int64 `json:"dataLength"`
+	// Actual bytes received (might be less than dataLength for compressed encodings).
+	EncodedDataLength int64 `json:"encodedDataLength"`
+	// Data that was received. (Encoded as a base64 string when passed over JSON)
+	//
+	// Experimental.
+	Data string `json:"data,omitempty"`
+}
+
+// EventEventSourceMessageReceived is the method name of the Network.eventSourceMessageReceived event.
+const EventEventSourceMessageReceived = "Network.eventSourceMessageReceived"
+
+// EventSourceMessageReceivedEvent is the payload of the Network.eventSourceMessageReceived event.
+//
+// Fired when EventSource message is received.
+type EventSourceMessageReceivedEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+	// Message type.
+	EventName string `json:"eventName"`
+	// Message identifier.
+	EventID string `json:"eventId"`
+	// Message content.
+	Data string `json:"data"`
+}
+
+// EventLoadingFailed is the method name of the Network.loadingFailed event.
+const EventLoadingFailed = "Network.loadingFailed"
+
+// LoadingFailedEvent is the payload of the Network.loadingFailed event.
+//
+// Fired when HTTP request has failed to load.
+type LoadingFailedEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+	// Resource type.
+	Type ResourceType `json:"type"`
+	// Error message. List of network errors: https://cs.chromium.org/chromium/src/net/base/net_error_list.h
+	ErrorText string `json:"errorText"`
+	// True if loading was canceled.
+	Canceled *bool `json:"canceled,omitempty"`
+	// The reason why loading was blocked, if any.
+	BlockedReason BlockedReason `json:"blockedReason,omitempty"`
+	// The reason why loading was blocked by CORS, if any.
+	CorsErrorStatus *CorsErrorStatus `json:"corsErrorStatus,omitempty"`
+}
+
+// EventLoadingFinished is the method name of the Network.loadingFinished event.
+const EventLoadingFinished = "Network.loadingFinished"
+
+// LoadingFinishedEvent is the payload of the Network.loadingFinished event.
+//
+// Fired when HTTP request has finished loading.
+type LoadingFinishedEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+	// Total number of bytes received for this request.
+	EncodedDataLength float64 `json:"encodedDataLength"`
+}
+
+// EventRequestIntercepted is the method name of the Network.requestIntercepted event.
+const EventRequestIntercepted = "Network.requestIntercepted"
+
+// RequestInterceptedEvent is the payload of the Network.requestIntercepted event.
+//
+// Details of an intercepted HTTP request, which must be either allowed, blocked, modified or
+// mocked.
+// Deprecated, use Fetch.requestPaused instead.
+//
+// Experimental.
+//
+// Deprecated: this item is deprecated in the protocol.
+type RequestInterceptedEvent struct {
+	// Each request the page makes will have a unique id, however if any redirects are encountered
+	// while processing that fetch, they will be reported with the same id as the original fetch.
+	// Likewise if HTTP authentication is needed then the same fetch id will be used.
+	InterceptionID InterceptionID `json:"interceptionId"`
+	Request        Request        `json:"request"`
+	// The id of the frame that initiated the request.
+	FrameID page.FrameID `json:"frameId"`
+	// How the requested resource will be used.
+	ResourceType ResourceType `json:"resourceType"`
+	// Whether this is a navigation request, which can abort the navigation completely.
+	IsNavigationRequest bool `json:"isNavigationRequest"`
+	// Set if the request is a navigation that will result in a download.
+	// Only present after response is received from the server (i.e. HeadersReceived stage).
+	IsDownload *bool `json:"isDownload,omitempty"`
+	// Redirect location, only sent if a redirect was intercepted.
+	RedirectURL string `json:"redirectUrl,omitempty"`
+	// Details of the Authorization Challenge encountered. If this is set then
+	// continueInterceptedRequest must contain an authChallengeResponse.
+	AuthChallenge *AuthChallenge `json:"authChallenge,omitempty"`
+	// Response error if intercepted at response stage or if redirect occurred while intercepting
+	// request.
+	ResponseErrorReason ErrorReason `json:"responseErrorReason,omitempty"`
+	// Response code if intercepted at response stage or if redirect occurred while intercepting
+	// request or auth retry occurred.
+	ResponseStatusCode *int64 `json:"responseStatusCode,omitempty"`
+	// Response headers if intercepted at the response stage or if redirect occurred while
+	// intercepting request or auth retry occurred.
+	ResponseHeaders Headers `json:"responseHeaders,omitempty"`
+	// If the intercepted request had a corresponding requestWillBeSent event fired for it, then
+	// this requestId will be the same as the requestId present in the requestWillBeSent event.
+	RequestID RequestID `json:"requestId,omitempty"`
+}
+
+// EventRequestServedFromCache is the method name of the Network.requestServedFromCache event.
+const EventRequestServedFromCache = "Network.requestServedFromCache"
+
+// RequestServedFromCacheEvent is the payload of the Network.requestServedFromCache event.
+//
+// Fired if request ended up loading from cache.
+type RequestServedFromCacheEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+}
+
+// EventRequestWillBeSent is the method name of the Network.requestWillBeSent event.
+const EventRequestWillBeSent = "Network.requestWillBeSent"
+
+// RequestWillBeSentEvent is the payload of the Network.requestWillBeSent event.
+//
+// Fired when page is about to send HTTP request.
+type RequestWillBeSentEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// Loader identifier. Empty string if the request is fetched from worker.
+	LoaderID LoaderID `json:"loaderId"`
+	// URL of the document this request is loaded for.
+	DocumentURL string `json:"documentURL"`
+	// Request data.
+	Request Request `json:"request"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+	// Timestamp.
+	WallTime TimeSinceEpoch `json:"wallTime"`
+	// Request initiator.
+	Initiator Initiator `json:"initiator"`
+	// In the case that redirectResponse is populated, this flag indicates whether
+	// requestWillBeSentExtraInfo and responseReceivedExtraInfo events will be or were emitted
+	// for the request which was just redirected.
+	//
+	// Experimental.
+	RedirectHasExtraInfo bool `json:"redirectHasExtraInfo"`
+	// Redirect response data.
+	RedirectResponse *Response `json:"redirectResponse,omitempty"`
+	// Type of this resource.
+	Type ResourceType `json:"type,omitempty"`
+	// Frame identifier.
+	FrameID page.FrameID `json:"frameId,omitempty"`
+	// Whether the request is initiated by a user gesture. Defaults to false.
+	HasUserGesture *bool `json:"hasUserGesture,omitempty"`
+}
+
+// EventResourceChangedPriority is the method name of the Network.resourceChangedPriority event.
+const EventResourceChangedPriority = "Network.resourceChangedPriority"
+
+// ResourceChangedPriorityEvent is the payload of the Network.resourceChangedPriority event.
+//
+// # Fired when resource loading priority is changed
+//
+// Experimental.
+type ResourceChangedPriorityEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// New priority
+	NewPriority ResourcePriority `json:"newPriority"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+}
+
+// EventSignedExchangeReceived is the method name of the Network.signedExchangeReceived event.
+const EventSignedExchangeReceived = "Network.signedExchangeReceived"
+
+// SignedExchangeReceivedEvent is the payload of the Network.signedExchangeReceived event.
+//
+// # Fired when a signed exchange was received over the network
+//
+// Experimental.
+type SignedExchangeReceivedEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// Information about the signed exchange response.
+	Info SignedExchangeInfo `json:"info"`
+}
+
+// EventResponseReceived is the method name of the Network.responseReceived event.
+const EventResponseReceived = "Network.responseReceived"
+
+// ResponseReceivedEvent is the payload of the Network.responseReceived event.
+//
+// Fired when HTTP response is available.
+type ResponseReceivedEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// Loader identifier. Empty string if the request is fetched from worker.
+	LoaderID LoaderID `json:"loaderId"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+	// Resource type.
+	Type ResourceType `json:"type"`
+	// Response data.
+	Response Response `json:"response"`
+	// Indicates whether requestWillBeSentExtraInfo and responseReceivedExtraInfo events will be
+	// or were emitted for this request.
+	//
+	// Experimental.
+	HasExtraInfo bool `json:"hasExtraInfo"`
+	// Frame identifier.
+	FrameID page.FrameID `json:"frameId,omitempty"`
+}
+
+// EventWebSocketClosed is the method name of the Network.webSocketClosed event.
+const EventWebSocketClosed = "Network.webSocketClosed"
+
+// WebSocketClosedEvent is the payload of the Network.webSocketClosed event.
+//
+// Fired when WebSocket is closed.
+type WebSocketClosedEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+}
+
+// EventWebSocketCreated is the method name of the Network.webSocketCreated event.
+const EventWebSocketCreated = "Network.webSocketCreated"
+
+// WebSocketCreatedEvent is the payload of the Network.webSocketCreated event.
+//
+// Fired upon WebSocket creation.
+type WebSocketCreatedEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// WebSocket request URL.
+	URL string `json:"url"`
+	// Request initiator.
+	Initiator *Initiator `json:"initiator,omitempty"`
+}
+
+// EventWebSocketFrameError is the method name of the Network.webSocketFrameError event.
+const EventWebSocketFrameError = "Network.webSocketFrameError"
+
+// WebSocketFrameErrorEvent is the payload of the Network.webSocketFrameError event.
+//
+// Fired when WebSocket message error occurs.
+type WebSocketFrameErrorEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+	// WebSocket error message.
+	ErrorMessage string `json:"errorMessage"`
+}
+
+// EventWebSocketFrameReceived is the method name of the Network.webSocketFrameReceived event.
+const EventWebSocketFrameReceived = "Network.webSocketFrameReceived"
+
+// WebSocketFrameReceivedEvent is the payload of the Network.webSocketFrameReceived event.
+//
+// Fired when WebSocket message is received.
+type WebSocketFrameReceivedEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+	// WebSocket response data.
+	Response WebSocketFrame `json:"response"`
+}
+
+// EventWebSocketFrameSent is the method name of the Network.webSocketFrameSent event.
+const EventWebSocketFrameSent = "Network.webSocketFrameSent"
+
+// WebSocketFrameSentEvent is the payload of the Network.webSocketFrameSent event.
+//
+// Fired when WebSocket message is sent.
+type WebSocketFrameSentEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+	// WebSocket response data.
+	Response WebSocketFrame `json:"response"`
+}
+
+// EventWebSocketHandshakeResponseReceived is the method name of the Network.webSocketHandshakeResponseReceived event.
+const EventWebSocketHandshakeResponseReceived = "Network.webSocketHandshakeResponseReceived"
+
+// WebSocketHandshakeResponseReceivedEvent is the payload of the Network.webSocketHandshakeResponseReceived event.
+//
+// Fired when WebSocket handshake response becomes available.
+type WebSocketHandshakeResponseReceivedEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+	// WebSocket response data.
+	Response WebSocketResponse `json:"response"`
+}
+
+// EventWebSocketWillSendHandshakeRequest is the method name of the Network.webSocketWillSendHandshakeRequest event.
+const EventWebSocketWillSendHandshakeRequest = "Network.webSocketWillSendHandshakeRequest"
+
+// WebSocketWillSendHandshakeRequestEvent is the payload of the Network.webSocketWillSendHandshakeRequest event.
+//
+// Fired when WebSocket is about to initiate handshake.
+type WebSocketWillSendHandshakeRequestEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+	// UTC Timestamp.
+	WallTime TimeSinceEpoch `json:"wallTime"`
+	// WebSocket request data.
+	Request WebSocketRequest `json:"request"`
+}
+
+// EventWebTransportCreated is the method name of the Network.webTransportCreated event.
+const EventWebTransportCreated = "Network.webTransportCreated"
+
+// WebTransportCreatedEvent is the payload of the Network.webTransportCreated event.
+//
+// Fired upon WebTransport creation.
+type WebTransportCreatedEvent struct {
+	// WebTransport identifier.
+	TransportID RequestID `json:"transportId"`
+	// WebTransport request URL.
+	URL string `json:"url"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+	// Request initiator.
+	Initiator *Initiator `json:"initiator,omitempty"`
+}
+
+// EventWebTransportConnectionEstablished is the method name of the Network.webTransportConnectionEstablished event.
+const EventWebTransportConnectionEstablished = "Network.webTransportConnectionEstablished"
+
+// WebTransportConnectionEstablishedEvent is the payload of the Network.webTransportConnectionEstablished event.
+//
+// Fired when WebTransport handshake is finished.
+type WebTransportConnectionEstablishedEvent struct {
+	// WebTransport identifier.
+	TransportID RequestID `json:"transportId"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+}
+
+// EventWebTransportClosed is the method name of the Network.webTransportClosed event.
+const EventWebTransportClosed = "Network.webTransportClosed"
+
+// WebTransportClosedEvent is the payload of the Network.webTransportClosed event.
+//
+// Fired when WebTransport is disposed.
+type WebTransportClosedEvent struct {
+	// WebTransport identifier.
+	TransportID RequestID `json:"transportId"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+}
+
+// EventRequestWillBeSentExtraInfo is the method name of the Network.requestWillBeSentExtraInfo event.
+const EventRequestWillBeSentExtraInfo = "Network.requestWillBeSentExtraInfo"
+
+// RequestWillBeSentExtraInfoEvent is the payload of the Network.requestWillBeSentExtraInfo event.
+//
+// Fired when additional information about a requestWillBeSent event is available from the
+// network stack. Not every requestWillBeSent event will have an additional
+// requestWillBeSentExtraInfo fired for it, and there is no guarantee whether requestWillBeSent
+// or requestWillBeSentExtraInfo will be fired first for the same request.
+//
+// Experimental.
+type RequestWillBeSentExtraInfoEvent struct {
+	// Request identifier. Used to match this information to an existing requestWillBeSent event.
+	RequestID RequestID `json:"requestId"`
+	// A list of cookies potentially associated to the requested URL. This includes both cookies sent with
+	// the request and the ones not sent; the latter are distinguished by having blockedReasons field set.
+	AssociatedCookies []AssociatedCookie `json:"associatedCookies"`
+	// Raw request headers as they will be sent over the wire.
+	Headers Headers `json:"headers"`
+	// Connection timing information for the request.
+	//
+	// Experimental.
+	ConnectTiming ConnectTiming `json:"connectTiming"`
+	// The client security state set for the request.
+	ClientSecurityState *ClientSecurityState `json:"clientSecurityState,omitempty"`
+	// Whether the site has partitioned cookies stored in a partition different than the current one.
+	SiteHasCookieInOtherPartition *bool `json:"siteHasCookieInOtherPartition,omitempty"`
+}
+
+// EventResponseReceivedExtraInfo is the method name of the Network.responseReceivedExtraInfo event.
+const EventResponseReceivedExtraInfo = "Network.responseReceivedExtraInfo"
+
+// ResponseReceivedExtraInfoEvent is the payload of the Network.responseReceivedExtraInfo event.
+//
+// Fired when additional information about a responseReceived event is available from the network
+// stack. Not every responseReceived event will have an additional responseReceivedExtraInfo for
+// it, and responseReceivedExtraInfo may be fired before or after responseReceived.
+//
+// Experimental.
+type ResponseReceivedExtraInfoEvent struct {
+	// Request identifier. Used to match this information to another responseReceived event.
+	RequestID RequestID `json:"requestId"`
+	// A list of cookies which were not stored from the response along with the corresponding
+	// reasons for blocking. The cookies here may not be valid due to syntax errors, which
+	// are represented by the invalid cookie line string instead of a proper cookie.
+	BlockedCookies []BlockedSetCookieWithReason `json:"blockedCookies"`
+	// Raw response headers as they were received over the wire.
+	Headers Headers `json:"headers"`
+	// The IP address space of the resource. The address space can only be determined once the transport
+	// established the connection, so we can't send it in `requestWillBeSentExtraInfo`.
+	ResourceIPAddressSpace IPAddressSpace `json:"resourceIPAddressSpace"`
+	// The status code of the response. This is useful in cases the request failed and no responseReceived
+	// event is triggered, which is the case for, e.g., CORS errors. This is also the correct status code
+	// for cached requests, where the status in responseReceived is a 200 and this will be 304.
+	StatusCode int64 `json:"statusCode"`
+	// Raw response header text as it was received over the wire. The raw text may not always be
+	// available, such as in the case of HTTP/2 or QUIC.
+	HeadersText string `json:"headersText,omitempty"`
+	// The cookie partition key that will be used to store partitioned cookies set in this response.
+	// Only sent when partitioned cookies are enabled.
+	//
+	// Experimental.
+	CookiePartitionKey *CookiePartitionKey `json:"cookiePartitionKey,omitempty"`
+	// True if partitioned cookies are enabled, but the partition key is not serializable to string.
+	CookiePartitionKeyOpaque *bool `json:"cookiePartitionKeyOpaque,omitempty"`
+	// A list of cookies which should have been blocked by 3PCD but are exempted and stored from
+	// the response with the corresponding reason.
+	ExemptedCookies []ExemptedSetCookieWithReason `json:"exemptedCookies,omitempty"`
+}
+
+// EventResponseReceivedEarlyHints is the method name of the Network.responseReceivedEarlyHints event.
+const EventResponseReceivedEarlyHints = "Network.responseReceivedEarlyHints"
+
+// ResponseReceivedEarlyHintsEvent is the payload of the Network.responseReceivedEarlyHints event.
+//
+// Fired when 103 Early Hints headers is received in addition to the common response.
+// Not every responseReceived event will have an responseReceivedEarlyHints fired.
+// Only one responseReceivedEarlyHints may be fired for eached responseReceived event.
+//
+// Experimental.
+type ResponseReceivedEarlyHintsEvent struct {
+	// Request identifier. Used to match this information to another responseReceived event.
+	RequestID RequestID `json:"requestId"`
+	// Raw response headers as they were received over the wire.
+	Headers Headers `json:"headers"`
+}
+
+// EventTrustTokenOperationDone is the method name of the Network.trustTokenOperationDone event.
+const EventTrustTokenOperationDone = "Network.trustTokenOperationDone"
+
+// TrustTokenOperationDoneEvent is the payload of the Network.trustTokenOperationDone event.
+//
+// Fired exactly once for each Trust Token operation. Depending on
+// the type of the operation and whether the operation succeeded or
+// failed, the event is fired before the corresponding request was sent
+// or after the response was received.
+//
+// Experimental.
+type TrustTokenOperationDoneEvent struct {
+	// Detailed success or error status of the operation.
+	// 'AlreadyExists' also signifies a successful operation, as the result
+	// of the operation already exists und thus, the operation was abort
+	// preemptively (e.g. a cache hit).
+	Status    string                  `json:"status"`
+	Type      TrustTokenOperationType `json:"type"`
+	RequestID RequestID               `json:"requestId"`
+	// Top level origin. The context in which the operation was attempted.
+	TopLevelOrigin string `json:"topLevelOrigin,omitempty"`
+	// Origin of the issuer in case of a "Issuance" or "Redemption" operation.
+	IssuerOrigin string `json:"issuerOrigin,omitempty"`
+	// The number of obtained Trust Tokens on a successful "Issuance" operation.
+	IssuedTokenCount *int64 `json:"issuedTokenCount,omitempty"`
+}
+
+// EventPolicyUpdated is the method name of the Network.policyUpdated event.
+const EventPolicyUpdated = "Network.policyUpdated"
+
+// PolicyUpdatedEvent is the payload of the Network.policyUpdated event.
+//
+// Fired once security policy has been updated.
+//
+// Experimental.
+type PolicyUpdatedEvent struct{}
+
+// EventSubresourceWebBundleMetadataReceived is the method name of the Network.subresourceWebBundleMetadataReceived event.
+const EventSubresourceWebBundleMetadataReceived = "Network.subresourceWebBundleMetadataReceived"
+
+// SubresourceWebBundleMetadataReceivedEvent is the payload of the Network.subresourceWebBundleMetadataReceived event.
+//
+// Fired once when parsing the .wbn file has succeeded.
+// The event contains the information about the web bundle contents.
+//
+// Experimental.
+type SubresourceWebBundleMetadataReceivedEvent struct {
+	// Request identifier. Used to match this information to another event.
+	RequestID RequestID `json:"requestId"`
+	// A list of URLs of resources in the subresource Web Bundle.
+	URLs []string `json:"urls"`
+}
+
+// EventSubresourceWebBundleMetadataError is the method name of the Network.subresourceWebBundleMetadataError event.
+const EventSubresourceWebBundleMetadataError = "Network.subresourceWebBundleMetadataError"
+
+// SubresourceWebBundleMetadataErrorEvent is the payload of the Network.subresourceWebBundleMetadataError event.
+//
+// Fired once when parsing the .wbn file has failed.
+//
+// Experimental.
+type SubresourceWebBundleMetadataErrorEvent struct {
+	// Request identifier. Used to match this information to another event.
+	RequestID RequestID `json:"requestId"`
+	// Error message
+	ErrorMessage string `json:"errorMessage"`
+}
+
+// EventSubresourceWebBundleInnerResponseParsed is the method name of the Network.subresourceWebBundleInnerResponseParsed event.
+const EventSubresourceWebBundleInnerResponseParsed = "Network.subresourceWebBundleInnerResponseParsed"
+
+// SubresourceWebBundleInnerResponseParsedEvent is the payload of the Network.subresourceWebBundleInnerResponseParsed event.
+//
+// Fired when handling requests for resources within a .wbn file.
+// Note: this will only be fired for resources that are requested by the webpage.
+//
+// Experimental.
+type SubresourceWebBundleInnerResponseParsedEvent struct {
+	// Request identifier of the subresource request
+	InnerRequestID RequestID `json:"innerRequestId"`
+	// URL of the subresource resource.
+	InnerRequestURL string `json:"innerRequestURL"`
+	// Bundle request identifier. Used to match this information to another event.
+	// This made be absent in case when the instrumentation was enabled only
+	// after webbundle was parsed.
+	BundleRequestID RequestID `json:"bundleRequestId,omitempty"`
+}
+
+// EventSubresourceWebBundleInnerResponseError is the method name of the Network.subresourceWebBundleInnerResponseError event.
+const EventSubresourceWebBundleInnerResponseError = "Network.subresourceWebBundleInnerResponseError"
+
+// SubresourceWebBundleInnerResponseErrorEvent is the payload of the Network.subresourceWebBundleInnerResponseError event.
+//
+// Fired when request for resources within a .wbn file failed.
+//
+// Experimental.
+type SubresourceWebBundleInnerResponseErrorEvent struct {
+	// Request identifier of the subresource request
+	InnerRequestID RequestID `json:"innerRequestId"`
+	// URL of the subresource resource.
+	InnerRequestURL string `json:"innerRequestURL"`
+	// Error message
+	ErrorMessage string `json:"errorMessage"`
+	// Bundle request identifier. Used to match this information to another event.
+	// This made be absent in case when the instrumentation was enabled only
+	// after webbundle was parsed.
+	BundleRequestID RequestID `json:"bundleRequestId,omitempty"`
+}
+
+// EventReportingAPIReportAdded is the method name of the Network.reportingApiReportAdded event.
+const EventReportingAPIReportAdded = "Network.reportingApiReportAdded"
+
+// ReportingAPIReportAddedEvent is the payload of the Network.reportingApiReportAdded event.
+//
+// Is sent whenever a new report is added.
+// And after 'enableReportingApi' for all existing reports.
+//
+// Experimental.
+type ReportingAPIReportAddedEvent struct {
+	Report ReportingAPIReport `json:"report"`
+}
+
+// EventReportingAPIReportUpdated is the method name of the Network.reportingApiReportUpdated event.
+const EventReportingAPIReportUpdated = "Network.reportingApiReportUpdated"
+
+// ReportingAPIReportUpdatedEvent is the payload of the Network.reportingApiReportUpdated event.
+//
+// Experimental.
+type ReportingAPIReportUpdatedEvent struct {
+	Report ReportingAPIReport `json:"report"`
+}
+
+// EventReportingAPIEndpointsChangedForOrigin is the method name of the Network.reportingApiEndpointsChangedForOrigin event.
+const EventReportingAPIEndpointsChangedForOrigin = "Network.reportingApiEndpointsChangedForOrigin"
+
+// ReportingAPIEndpointsChangedForOriginEvent is the payload of the Network.reportingApiEndpointsChangedForOrigin event.
+//
+// Experimental.
+type ReportingAPIEndpointsChangedForOriginEvent struct {
+	// Origin of the document(s) which configured the endpoints.
+	Origin    string                 `json:"origin"`
+	Endpoints []ReportingAPIEndpoint `json:"endpoints"`
+}
+
+// ParseEvent decodes a Network event message into its typed event struct.
+func ParseEvent(msg *internal.CDPMessage) (any, error) {
+	var v any
+	switch msg.Method {
+	case EventDataReceived:
+		v = &DataReceivedEvent{}
+	case EventEventSourceMessageReceived:
+		v = &EventSourceMessageReceivedEvent{}
+	case EventLoadingFailed:
+		v = &LoadingFailedEvent{}
+	case EventLoadingFinished:
+		v = &LoadingFinishedEvent{}
+	case EventRequestIntercepted:
+		v = &RequestInterceptedEvent{}
+	case EventRequestServedFromCache:
+		v = &RequestServedFromCacheEvent{}
+	case EventRequestWillBeSent:
+		v = &RequestWillBeSentEvent{}
+	case EventResourceChangedPriority:
+		v = &ResourceChangedPriorityEvent{}
+	case EventSignedExchangeReceived:
+		v = &SignedExchangeReceivedEvent{}
+	case EventResponseReceived:
+		v = &ResponseReceivedEvent{}
+	case EventWebSocketClosed:
+		v = &WebSocketClosedEvent{}
+	case EventWebSocketCreated:
+		v = &WebSocketCreatedEvent{}
+	case EventWebSocketFrameError:
+		v = &WebSocketFrameErrorEvent{}
+	case EventWebSocketFrameReceived:
+		v = &WebSocketFrameReceivedEvent{}
+	case EventWebSocketFrameSent:
+		v = &WebSocketFrameSentEvent{}
+	case EventWebSocketHandshakeResponseReceived:
+		v = &WebSocketHandshakeResponseReceivedEvent{}
+	case EventWebSocketWillSendHandshakeRequest:
+		v = &WebSocketWillSendHandshakeRequestEvent{}
+	case EventWebTransportCreated:
+		v = &WebTransportCreatedEvent{}
+	case EventWebTransportConnectionEstablished:
+		v = &WebTransportConnectionEstablishedEvent{}
+	case EventWebTransportClosed:
+		v = &WebTransportClosedEvent{}
+	case EventRequestWillBeSentExtraInfo:
+		v = &RequestWillBeSentExtraInfoEvent{}
+	case EventResponseReceivedExtraInfo:
+		v = &ResponseReceivedExtraInfoEvent{}
+	case EventResponseReceivedEarlyHints:
+		v = &ResponseReceivedEarlyHintsEvent{}
+	case EventTrustTokenOperationDone:
+		v = &TrustTokenOperationDoneEvent{}
+	case EventPolicyUpdated:
+		v = &PolicyUpdatedEvent{}
+	case EventSubresourceWebBundleMetadataReceived:
+		v = &SubresourceWebBundleMetadataReceivedEvent{}
+	case EventSubresourceWebBundleMetadataError:
+		v = &SubresourceWebBundleMetadataErrorEvent{}
+	case EventSubresourceWebBundleInnerResponseParsed:
+		v = &SubresourceWebBundleInnerResponseParsedEvent{}
+	case EventSubresourceWebBundleInnerResponseError:
+		v = &SubresourceWebBundleInnerResponseErrorEvent{}
+	case EventReportingAPIReportAdded:
+		v = &ReportingAPIReportAddedEvent{}
+	case EventReportingAPIReportUpdated:
+		v = &ReportingAPIReportUpdatedEvent{}
+	case EventReportingAPIEndpointsChangedForOrigin:
+		v = &ReportingAPIEndpointsChangedForOriginEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Network event: %s", msg.Method)
+	}
+	if msg.Params == nil {
+		return v, nil
+	}
+	err := json.Unmarshal(msg.Params, v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
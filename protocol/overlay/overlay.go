@@ -0,0 +1,880 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package overlay provides typed bindings for the CDP Overlay domain.
+//
+// This domain provides various functionality related to drawing atop the inspected page.
+package overlay
+
+import (
+	"cdp/internal"
+	"cdp/protocol/dom"
+	"cdp/protocol/page"
+	"cdp/protocol/runtime"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// SourceOrderConfig is the Overlay.SourceOrderConfig type.
+//
+// Configuration data for drawing the source order of an elements children.
+type SourceOrderConfig struct {
+	// the color to outline the given element in.
+	ParentOutlineColor dom.RGBA `json:"parentOutlineColor"`
+	// the color to outline the child elements in.
+	ChildOutlineColor dom.RGBA `json:"childOutlineColor"`
+}
+
+// GridHighlightConfig is the Overlay.GridHighlightConfig type.
+//
+// Configuration data for the highlighting of Grid elements.
+type GridHighlightConfig struct {
+	// Whether the extension lines from grid cells to the rulers should be shown (default: false).
+	ShowGridExtensionLines *bool `json:"showGridExtensionLines,omitempty"`
+	// Show Positive line number labels (default: false).
+	ShowPositiveLineNumbers *bool `json:"showPositiveLineNumbers,omitempty"`
+	// Show Negative line number labels (default: false).
+	ShowNegativeLineNumbers *bool `json:"showNegativeLineNumbers,omitempty"`
+	// Show area name labels (default: false).
+	ShowAreaNames *bool `json:"showAreaNames,omitempty"`
+	// Show line name labels (default: false).
+	ShowLineNames *bool `json:"showLineNames,omitempty"`
+	// Show track size labels (default: false).
+	ShowTrackSizes *bool `json:"showTrackSizes,omitempty"`
+	// The grid container border highlight color (default: transparent).
+	GridBorderColor *dom.RGBA `json:"gridBorderColor,omitempty"`
+	// The cell border color (default: transparent). Deprecated, please use rowLineColor and columnLineColor instead.
+	//
+	// Deprecated: this item is deprecated in the protocol.
+	CellBorderColor *dom.RGBA `json:"cellBorderColor,omitempty"`
+	// The row line color (default: transparent).
+	RowLineColor *dom.RGBA `json:"rowLineColor,omitempty"`
+	// The column line color (default: transparent).
+	ColumnLineColor *dom.RGBA `json:"columnLineColor,omitempty"`
+	// Whether the grid border is dashed (default: false).
+	GridBorderDash *bool `json:"gridBorderDash,omitempty"`
+	// Whether the cell border is dashed (default: false). Deprecated, please us rowLineDash and columnLineDash instead.
+	//
+	// Deprecated: this item is deprecated in the protocol.
+	CellBorderDash *bool `json:"cellBorderDash,omitempty"`
+	// Whether row lines are dashed (default: false).
+	RowLineDash *bool `json:"rowLineDash,omitempty"`
+	// Whether column lines are dashed (default: false).
+	ColumnLineDash *bool `json:"columnLineDash,omitempty"`
+	// The row gap highlight fill color (default: transparent).
+	RowGapColor *dom.RGBA `json:"rowGapColor,omitempty"`
+	// The row gap hatching fill color (default: transparent).
+	RowHatchColor *dom.RGBA `json:"rowHatchColor,omitempty"`
+	// The column gap highlight fill color (default: transparent).
+	ColumnGapColor *dom.RGBA `json:"columnGapColor,omitempty"`
+	// The column gap hatching fill color (default: transparent).
+	ColumnHatchColor *dom.RGBA `json:"columnHatchColor,omitempty"`
+	// The named grid areas border color (Default: transparent).
+	AreaBorderColor *dom.RGBA `json:"areaBorderColor,omitempty"`
+	// The grid container background color (Default: transparent).
+	GridBackgroundColor *dom.RGBA `json:"gridBackgroundColor,omitempty"`
+}
+
+// FlexContainerHighlightConfig is the Overlay.FlexContainerHighlightConfig type.
+//
+// Configuration data for the highlighting of Flex container elements.
+type FlexContainerHighlightConfig struct {
+	// The style of the container border
+	ContainerBorder *LineStyle `json:"containerBorder,omitempty"`
+	// The style of the separator between lines
+	LineSeparator *LineStyle `json:"lineSeparator,omitempty"`
+	// The style of the separator between items
+	ItemSeparator *LineStyle `json:"itemSeparator,omitempty"`
+	// Style of content-distribution space on the main axis (justify-content).
+	MainDistributedSpace *BoxStyle `json:"mainDistributedSpace,omitempty"`
+	// Style of content-distribution space on the cross axis (align-content).
+	CrossDistributedSpace *BoxStyle `json:"crossDistributedSpace,omitempty"`
+	// Style of empty space caused by row gaps (gap/row-gap).
+	RowGapSpace *BoxStyle `json:"rowGapSpace,omitempty"`
+	// Style of empty space caused by columns gaps (gap/column-gap).
+	ColumnGapSpace *BoxStyle `json:"columnGapSpace,omitempty"`
+	// Style of the self-alignment line (align-items).
+	CrossAlignment *LineStyle `json:"crossAlignment,omitempty"`
+}
+
+// FlexItemHighlightConfig is the Overlay.FlexItemHighlightConfig type.
+//
+// Configuration data for the highlighting of Flex item elements.
+type FlexItemHighlightConfig struct {
+	// Style of the box representing the item's base size
+	BaseSizeBox *BoxStyle `json:"baseSizeBox,omitempty"`
+	// Style of the border around the box representing the item's base size
+	BaseSizeBorder *LineStyle `json:"baseSizeBorder,omitempty"`
+	// Style of the arrow representing if the item grew or shrank
+	FlexibilityArrow *LineStyle `json:"flexibilityArrow,omitempty"`
+}
+
+// LineStyle is the Overlay.LineStyle type.
+//
+// Style information for drawing a line.
+type LineStyle struct {
+	// The color of the line (default: transparent)
+	Color *dom.RGBA `json:"color,omitempty"`
+	// The line pattern (default: solid)
+	Pattern string `json:"pattern,omitempty"`
+}
+
+// BoxStyle is the Overlay.BoxStyle type.
+//
+// Style information for drawing a box.
+type BoxStyle struct {
+	// The background color for the box (default: transparent)
+	FillColor *dom.RGBA `json:"fillColor,omitempty"`
+	// The hatching color for the box (default: transparent)
+	HatchColor *dom.RGBA `json:"hatchColor,omitempty"`
+}
+
+// ContrastAlgorithm is the Overlay.ContrastAlgorithm type.
+type ContrastAlgorithm string
+
+const (
+	ContrastAlgorithmAa   ContrastAlgorithm = "aa"
+	ContrastAlgorithmAaa  ContrastAlgorithm = "aaa"
+	ContrastAlgorithmApca ContrastAlgorithm = "apca"
+)
+
+// HighlightConfig is the Overlay.HighlightConfig type.
+//
+// Configuration data for the highlighting of page elements.
+type HighlightConfig struct {
+	// Whether the node info tooltip should be shown (default: false).
+	ShowInfo *bool `json:"showInfo,omitempty"`
+	// Whether the node styles in the tooltip (default: false).
+	ShowStyles *bool `json:"showStyles,omitempty"`
+	// Whether the rulers should be shown (default: false).
+	ShowRulers *bool `json:"showRulers,omitempty"`
+	// Whether the a11y info should be shown (default: true).
+	ShowAccessibilityInfo *bool `json:"showAccessibilityInfo,omitempty"`
+	// Whether the extension lines from node to the rulers should be shown (default: false).
+	ShowExtensionLines *bool `json:"showExtensionLines,omitempty"`
+	// The content box highlight fill color (default: transparent).
+	ContentColor *dom.RGBA `json:"contentColor,omitempty"`
+	// The padding highlight fill color (default: transparent).
+	PaddingColor *dom.RGBA `json:"paddingColor,omitempty"`
+	// The border highlight fill color (default: transparent).
+	BorderColor *dom.RGBA `json:"borderColor,omitempty"`
+	// The margin highlight fill color (default: transparent).
+	MarginColor *dom.RGBA `json:"marginColor,omitempty"`
+	// The event target element highlight fill color (default: transparent).
+	EventTargetColor *dom.RGBA `json:"eventTargetColor,omitempty"`
+	// The shape outside fill color (default: transparent).
+	ShapeColor *dom.RGBA `json:"shapeColor,omitempty"`
+	// The shape margin fill color (default: transparent).
+	ShapeMarginColor *dom.RGBA `json:"shapeMarginColor,omitempty"`
+	// The grid layout color (default: transparent).
+	CSSGridColor *dom.RGBA `json:"cssGridColor,omitempty"`
+	// The color format used to format color styles (default: hex).
+	ColorFormat ColorFormat `json:"colorFormat,omitempty"`
+	// The grid layout highlight configuration (default: all transparent).
+	GridHighlightConfig *GridHighlightConfig `json:"gridHighlightConfig,omitempty"`
+	// The flex container highlight configuration (default: all transparent).
+	FlexContainerHighlightConfig *FlexContainerHighlightConfig `json:"flexContainerHighlightConfig,omitempty"`
+	// The flex item highlight configuration (default: all transparent).
+	FlexItemHighlightConfig *FlexItemHighlightConfig `json:"flexItemHighlightConfig,omitempty"`
+	// The contrast algorithm to use for the contrast ratio (default: aa).
+	ContrastAlgorithm ContrastAlgorithm `json:"contrastAlgorithm,omitempty"`
+	// The container query container highlight configuration (default: all transparent).
+	ContainerQueryContainerHighlightConfig *ContainerQueryContainerHighlightConfig `json:"containerQueryContainerHighlightConfig,omitempty"`
+}
+
+// ColorFormat is the Overlay.ColorFormat type.
+type ColorFormat string
+
+const (
+	ColorFormatRgb ColorFormat = "rgb"
+	ColorFormatHsl ColorFormat = "hsl"
+	ColorFormatHwb ColorFormat = "hwb"
+	ColorFormatHex ColorFormat = "hex"
+)
+
+// GridNodeHighlightConfig is the Overlay.GridNodeHighlightConfig type.
+//
+// Configurations for Persistent Grid Highlight
+type GridNodeHighlightConfig struct {
+	// A descriptor for the highlight appearance.
+	GridHighlightConfig GridHighlightConfig `json:"gridHighlightConfig"`
+	// Identifier of the node to highlight.
+	NodeID dom.NodeID `json:"nodeId"`
+}
+
+// FlexNodeHighlightConfig is the Overlay.FlexNodeHighlightConfig type.
+type FlexNodeHighlightConfig struct {
+	// A descriptor for the highlight appearance of flex containers.
+	FlexContainerHighlightConfig FlexContainerHighlightConfig `json:"flexContainerHighlightConfig"`
+	// Identifier of the node to highlight.
+	NodeID dom.NodeID `json:"nodeId"`
+}
+
+// ScrollSnapContainerHighlightConfig is the Overlay.ScrollSnapContainerHighlightConfig type.
+type ScrollSnapContainerHighlightConfig struct {
+	// The style of the snapport border (default: transparent)
+	SnapportBorder *LineStyle `json:"snapportBorder,omitempty"`
+	// The style of the snap area border (default: transparent)
+	SnapAreaBorder *LineStyle `json:"snapAreaBorder,omitempty"`
+	// The margin highlight fill color (default: transparent).
+	ScrollMarginColor *dom.RGBA `json:"scrollMarginColor,omitempty"`
+	// The padding highlight fill color (default: transparent).
+	ScrollPaddingColor *dom.RGBA `json:"scrollPaddingColor,omitempty"`
+}
+
+// ScrollSnapHighlightConfig is the Overlay.ScrollSnapHighlightConfig type.
+type ScrollSnapHighlightConfig struct {
+	// A descriptor for the highlight appearance of scroll snap containers.
+	ScrollSnapContainerHighlightConfig ScrollSnapContainerHighlightConfig `json:"scrollSnapContainerHighlightConfig"`
+	// Identifier of the node to highlight.
+	NodeID dom.NodeID `json:"nodeId"`
+}
+
+// HingeConfig is the Overlay.HingeConfig type.
+//
+// Configuration for dual screen hinge
+type HingeConfig struct {
+	// A rectangle represent hinge
+	Rect dom.Rect `json:"rect"`
+	// The content box highlight fill color (default: a dark color).
+	ContentColor *dom.RGBA `json:"contentColor,omitempty"`
+	// The content box highlight outline color (default: transparent).
+	OutlineColor *dom.RGBA `json:"outlineColor,omitempty"`
+}
+
+// WindowControlsOverlayConfig is the Overlay.WindowControlsOverlayConfig type.
+//
+// Configuration for Window Controls Overlay
+type WindowControlsOverlayConfig struct {
+	// Whether the title bar CSS should be shown when emulating the Window Controls Overlay.
+	ShowCSS bool `json:"showCSS"`
+	// Selected platforms to show the overlay.
+	SelectedPlatform string `json:"selectedPlatform"`
+	// The theme color defined in app manifest.
+	ThemeColor string `json:"themeColor"`
+}
+
+// ContainerQueryHighlightConfig is the Overlay.ContainerQueryHighlightConfig type.
+type ContainerQueryHighlightConfig struct {
+	// A descriptor for the highlight appearance of container query containers.
+	ContainerQueryContainerHighlightConfig ContainerQueryContainerHighlightConfig `json:"containerQueryContainerHighlightConfig"`
+	// Identifier of the container node to highlight.
+	NodeID dom.NodeID `json:"nodeId"`
+}
+
+// ContainerQueryContainerHighlightConfig is the Overlay.ContainerQueryContainerHighlightConfig type.
+type ContainerQueryContainerHighlightConfig struct {
+	// The style of the container border.
+	ContainerBorder *LineStyle `json:"containerBorder,omitempty"`
+	// The style of the descendants' borders.
+	DescendantBorder *LineStyle `json:"descendantBorder,omitempty"`
+}
+
+// IsolatedElementHighlightConfig is the Overlay.IsolatedElementHighlightConfig type.
+type IsolatedElementHighlightConfig struct {
+	// A descriptor for the highlight appearance of an element in isolation mode.
+	IsolationModeHighlightConfig IsolationModeHighlightConfig `json:"isolationModeHighlightConfig"`
+	// Identifier of the isolated element to highlight.
+	NodeID dom.NodeID `json:"nodeId"`
+}
+
+// IsolationModeHighlightConfig is the Overlay.IsolationModeHighlightConfig type.
+type IsolationModeHighlightConfig struct {
+	// The fill color of the resizers (default: transparent).
+	ResizerColor *dom.RGBA `json:"resizerColor,omitempty"`
+	// The fill color for resizer handles (default: transparent).
+	ResizerHandleColor *dom.RGBA `json:"resizerHandleColor,omitempty"`
+	// The fill color for the mask covering non-isolated elements (default: transparent).
+	MaskColor *dom.RGBA `json:"maskColor,omitempty"`
+}
+
+// InspectMode is the Overlay.InspectMode type.
+type InspectMode string
+
+const (
+	InspectModeSearchForNode         InspectMode = "searchForNode"
+	InspectModeSearchForUAShadowDOM  InspectMode = "searchForUAShadowDOM"
+	InspectModeCaptureAreaScreenshot InspectMode = "captureAreaScreenshot"
+	InspectModeShowDistances         InspectMode = "showDistances"
+	InspectModeNone                  InspectMode = "none"
+)
+
+// Disable calls Overlay.disable.
+//
+// Disables domain notifications.
+func Disable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Overlay.disable", nil, sessionID, nil)
+}
+
+// Enable calls Overlay.enable.
+//
+// Enables domain notifications.
+func Enable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Overlay.enable", nil, sessionID, nil)
+}
+
+// GetHighlightObjectForTestParams holds the parameters of Overlay.getHighlightObjectForTest.
+type GetHighlightObjectForTestParams struct {
+	// Id of the node to get highlight object for.
+	NodeID dom.NodeID `json:"nodeId"`
+	// Whether to include distance info.
+	IncludeDistance *bool `json:"includeDistance,omitempty"`
+	// Whether to include style info.
+	IncludeStyle *bool `json:"includeStyle,omitempty"`
+	// The color format to get config with (default: hex).
+	ColorFormat ColorFormat `json:"colorFormat,omitempty"`
+	// Whether to show accessibility info (default: true).
+	ShowAccessibilityInfo *bool `json:"showAccessibilityInfo,omitempty"`
+}
+
+// GetHighlightObjectForTestResult holds the result of Overlay.getHighlightObjectForTest.
+type GetHighlightObjectForTestResult struct {
+	// Highlight data for the node.
+	Highlight map[string]any `json:"highlight"`
+}
+
+// GetHighlightObjectForTest calls Overlay.getHighlightObjectForTest.
+//
+// For testing.
+func GetHighlightObjectForTest(ctx context.Context, c *internal.Client, sessionID string, params *GetHighlightObjectForTestParams) (*GetHighlightObjectForTestResult, error) {
+	if params == nil {
+		params = &GetHighlightObjectForTestParams{}
+	}
+	var result GetHighlightObjectForTestResult
+	err := c.Call(ctx, "Overlay.getHighlightObjectForTest", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetGridHighlightObjectsForTestParams holds the parameters of Overlay.getGridHighlightObjectsForTest.
+type GetGridHighlightObjectsForTestParams struct {
+	// Ids of the node to get highlight object for.
+	NodeIDs []dom.NodeID `json:"nodeIds"`
+}
+
+// GetGridHighlightObjectsForTestResult holds the result of Overlay.getGridHighlightObjectsForTest.
+type GetGridHighlightObjectsForTestResult struct {
+	// Grid Highlight data for the node ids provided.
+	Highlights map[string]any `json:"highlights"`
+}
+
+// GetGridHighlightObjectsForTest calls Overlay.getGridHighlightObjectsForTest.
+//
+// For Persistent Grid testing.
+func GetGridHighlightObjectsForTest(ctx context.Context, c *internal.Client, sessionID string, params *GetGridHighlightObjectsForTestParams) (*GetGridHighlightObjectsForTestResult, error) {
+	if params == nil {
+		params = &GetGridHighlightObjectsForTestParams{}
+	}
+	var result GetGridHighlightObjectsForTestResult
+	err := c.Call(ctx, "Overlay.getGridHighlightObjectsForTest", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetSourceOrderHighlightObjectForTestParams holds the parameters of Overlay.getSourceOrderHighlightObjectForTest.
+type GetSourceOrderHighlightObjectForTestParams struct {
+	// Id of the node to highlight.
+	NodeID dom.NodeID `json:"nodeId"`
+}
+
+// GetSourceOrderHighlightObjectForTestResult holds the result of Overlay.getSourceOrderHighlightObjectForTest.
+type GetSourceOrderHighlightObjectForTestResult struct {
+	// Source order highlight data for the node id provided.
+	Highlight map[string]any `json:"highlight"`
+}
+
+// GetSourceOrderHighlightObjectForTest calls Overlay.getSourceOrderHighlightObjectForTest.
+//
+// For Source Order Viewer testing.
+func GetSourceOrderHighlightObjectForTest(ctx context.Context, c *internal.Client, sessionID string, params *GetSourceOrderHighlightObjectForTestParams) (*GetSourceOrderHighlightObjectForTestResult, error) {
+	if params == nil {
+		params = &GetSourceOrderHighlightObjectForTestParams{}
+	}
+	var result GetSourceOrderHighlightObjectForTestResult
+	err := c.Call(ctx, "Overlay.getSourceOrderHighlightObjectForTest", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// HideHighlight calls Overlay.hideHighlight.
+//
+// Hides any highlight.
+func HideHighlight(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Overlay.hideHighlight", nil, sessionID, nil)
+}
+
+// HighlightFrameParams holds the parameters of Overlay.highlightFrame.
+type HighlightFrameParams struct {
+	// Identifier of the frame to highlight.
+	FrameID page.FrameID `json:"frameId"`
+	// The content box highlight fill color (default: transparent).
+	ContentColor *dom.RGBA `json:"contentColor,omitempty"`
+	// The content box highlight outline color (default: transparent).
+	ContentOutlineColor *dom.RGBA `json:"contentOutlineColor,omitempty"`
+}
+
+// HighlightFrame calls Overlay.highlightFrame.
+//
+// Highlights owner element of the frame with given id.
+// Deprecated: Doesn't work reliably and cannot be fixed due to process
+// separation (the owner node might be in a different process). Determine
+// the owner node in the client and use highlightNode.
+//
+// Deprecated: this item is deprecated in the protocol.
+func HighlightFrame(ctx context.Context, c *internal.Client, sessionID string, params *HighlightFrameParams) error {
+	if params == nil {
+		params = &HighlightFrameParams{}
+	}
+	return c.Call(ctx, "Overlay.highlightFrame", params, sessionID, nil)
+}
+
+// HighlightNodeParams holds the parameters of Overlay.highlightNode.
+type HighlightNodeParams struct {
+	// A descriptor for the highlight appearance.
+	HighlightConfig HighlightConfig `json:"highlightConfig"`
+	// Identifier of the node to highlight.
+	NodeID *dom.NodeID `json:"nodeId,omitempty"`
+	// Identifier of the backend node to highlight.
+	BackendNodeID *dom.BackendNodeID `json:"backendNodeId,omitempty"`
+	// JavaScript object id of the node to be highlighted.
+	ObjectID runtime.RemoteObjectID `json:"objectId,omitempty"`
+	// Selectors to highlight relevant nodes.
+	Selector string `json:"selector,omitempty"`
+}
+
+// HighlightNode calls Overlay.highlightNode.
+//
+// Highlights DOM node with given id or with the given JavaScript object wrapper. Either nodeId or
+// objectId must be specified.
+func HighlightNode(ctx context.Context, c *internal.Client, sessionID string, params *HighlightNodeParams) error {
+	if params == nil {
+		params = &HighlightNodeParams{}
+	}
+	return c.Call(ctx, "Overlay.highlightNode", params, sessionID, nil)
+}
+
+// HighlightQuadParams holds the parameters of Overlay.highlightQuad.
+type HighlightQuadParams struct {
+	// Quad to highlight
+	Quad dom.Quad `json:"quad"`
+	// The highlight fill color (default: transparent).
+	Color *dom.RGBA `json:"color,omitempty"`
+	// The highlight outline color (default: transparent).
+	OutlineColor *dom.RGBA `json:"outlineColor,omitempty"`
+}
+
+// HighlightQuad calls Overlay.highlightQuad.
+//
+// Highlights given quad. Coordinates are absolute with respect to the main frame viewport.
+func HighlightQuad(ctx context.Context, c *internal.Client, sessionID string, params *HighlightQuadParams) error {
+	if params == nil {
+		params = &HighlightQuadParams{}
+	}
+	return c.Call(ctx, "Overlay.highlightQuad", params, sessionID, nil)
+}
+
+// HighlightRectParams holds the parameters of Overlay.highlightRect.
+type HighlightRectParams struct {
+	// X coordinate
+	X int64 `json:"x"`
+	// Y coordinate
+	Y int64 `json:"y"`
+	// Rectangle width
+	Width int64 `json:"width"`
+	// Rectangle height
+	Height int64 `json:"height"`
+	// The highlight fill color (default: transparent).
+	Color *dom.RGBA `json:"color,omitempty"`
+	// The highlight outline color (default: transparent).
+	OutlineColor *dom.RGBA `json:"outlineColor,omitempty"`
+}
+
+// HighlightRect calls Overlay.highlightRect.
+//
+// Highlights given rectangle. Coordinates are absolute with respect to the main frame viewport.
+func HighlightRect(ctx context.Context, c *internal.Client, sessionID string, params *HighlightRectParams) error {
+	if params == nil {
+		params = &HighlightRectParams{}
+	}
+	return c.Call(ctx, "Overlay.highlightRect", params, sessionID, nil)
+}
+
+// HighlightSourceOrderParams holds the parameters of Overlay.highlightSourceOrder.
+type HighlightSourceOrderParams struct {
+	// A descriptor for the appearance of the overlay drawing.
+	SourceOrderConfig SourceOrderConfig `json:"sourceOrderConfig"`
+	// Identifier of the node to highlight.
+	NodeID *dom.NodeID `json:"nodeId,omitempty"`
+	// Identifier of the backend node to highlight.
+	BackendNodeID *dom.BackendNodeID `json:"backendNodeId,omitempty"`
+	// JavaScript object id of the node to be highlighted.
+	ObjectID runtime.RemoteObjectID `json:"objectId,omitempty"`
+}
+
+// HighlightSourceOrder calls Overlay.highlightSourceOrder.
+//
+// Highlights the source order of the children of the DOM node with given id or with the given
+// JavaScript object wrapper. Either nodeId or objectId must be specified.
+func HighlightSourceOrder(ctx context.Context, c *internal.Client, sessionID string, params *HighlightSourceOrderParams) error {
+	if params == nil {
+		params = &HighlightSourceOrderParams{}
+	}
+	return c.Call(ctx, "Overlay.highlightSourceOrder", params, sessionID, nil)
+}
+
+// SetInspectModeParams holds the parameters of Overlay.setInspectMode.
+type SetInspectModeParams struct {
+	// Set an inspection mode.
+	Mode InspectMode `json:"mode"`
+	// A descriptor for the highlight appearance of hovered-over nodes. May be omitted if `enabled
+	// == false`.
+	HighlightConfig *HighlightConfig `json:"highlightConfig,omitempty"`
+}
+
+// SetInspectMode calls Overlay.setInspectMode.
+//
+// Enters the 'inspect' mode. In this mode, elements that user is hovering over are highlighted.
+// Backend then generates 'inspectNodeRequested' event upon element selection.
+func SetInspectMode(ctx context.Context, c *internal.Client, sessionID string, params *SetInspectModeParams) error {
+	if params == nil {
+		params = &SetInspectModeParams{}
+	}
+	return c.Call(ctx, "Overlay.setInspectMode", params, sessionID, nil)
+}
+
+// SetShowAdHighlightsParams holds the parameters of Overlay.setShowAdHighlights.
+type SetShowAdHighlightsParams struct {
+	// True for showing ad highlights
+	Show bool `json:"show"`
+}
+
+// SetShowAdHighlights calls Overlay.setShowAdHighlights.
+//
+// Highlights owner element of all frames detected to be ads.
+func SetShowAdHighlights(ctx context.Context, c *internal.Client, sessionID string, params *SetShowAdHighlightsParams) error {
+	if params == nil {
+		params = &SetShowAdHighlightsParams{}
+	}
+	return c.Call(ctx, "Overlay.setShowAdHighlights", params, sessionID, nil)
+}
+
+// SetPausedInDebuggerMessageParams holds the parameters of Overlay.setPausedInDebuggerMessage.
+type SetPausedInDebuggerMessageParams struct {
+	// The message to display, also triggers resume and step over controls.
+	Message string `json:"message,omitempty"`
+}
+
+// SetPausedInDebuggerMessage calls Overlay.setPausedInDebuggerMessage.
+func SetPausedInDebuggerMessage(ctx context.Context, c *internal.Client, sessionID string, params *SetPausedInDebuggerMessageParams) error {
+	if params == nil {
+		params = &SetPausedInDebuggerMessageParams{}
+	}
+	return c.Call(ctx, "Overlay.setPausedInDebuggerMessage", params, sessionID, nil)
+}
+
+// SetShowDebugBordersParams holds the parameters of Overlay.setShowDebugBorders.
+type SetShowDebugBordersParams struct {
+	// True for showing debug borders
+	Show bool `json:"show"`
+}
+
+// SetShowDebugBorders calls Overlay.setShowDebugBorders.
+//
+// Requests that backend shows debug borders on layers
+func SetShowDebugBorders(ctx context.Context, c *internal.Client, sessionID string, params *SetShowDebugBordersParams) error {
+	if params == nil {
+		params = &SetShowDebugBordersParams{}
+	}
+	return c.Call(ctx, "Overlay.setShowDebugBorders", params, sessionID, nil)
+}
+
+// SetShowFPSCounterParams holds the parameters of Overlay.setShowFPSCounter.
+type SetShowFPSCounterParams struct {
+	// True for showing the FPS counter
+	Show bool `json:"show"`
+}
+
+// SetShowFPSCounter calls Overlay.setShowFPSCounter.
+//
+// Requests that backend shows the FPS counter
+func SetShowFPSCounter(ctx context.Context, c *internal.Client, sessionID string, params *SetShowFPSCounterParams) error {
+	if params == nil {
+		params = &SetShowFPSCounterParams{}
+	}
+	return c.Call(ctx, "Overlay.setShowFPSCounter", params, sessionID, nil)
+}
+
+// SetShowGridOverlaysParams holds the parameters of Overlay.setShowGridOverlays.
+type SetShowGridOverlaysParams struct {
+	// An array of node identifiers and descriptors for the highlight appearance.
+	GridNodeHighlightConfigs []GridNodeHighlightConfig `json:"gridNodeHighlightConfigs"`
+}
+
+// SetShowGridOverlays calls Overlay.setShowGridOverlays.
+//
+// Highlight multiple elements with the CSS Grid overlay.
+func SetShowGridOverlays(ctx context.Context, c *internal.Client, sessionID string, params *SetShowGridOverlaysParams) error {
+	if params == nil {
+		params = &SetShowGridOverlaysParams{}
+	}
+	return c.Call(ctx, "Overlay.setShowGridOverlays", params, sessionID, nil)
+}
+
+// SetShowFlexOverlaysParams holds the parameters of Overlay.setShowFlexOverlays.
+type SetShowFlexOverlaysParams struct {
+	// An array of node identifiers and descriptors for the highlight appearance.
+	FlexNodeHighlightConfigs []FlexNodeHighlightConfig `json:"flexNodeHighlightConfigs"`
+}
+
+// SetShowFlexOverlays calls Overlay.setShowFlexOverlays.
+func SetShowFlexOverlays(ctx context.Context, c *internal.Client, sessionID string, params *SetShowFlexOverlaysParams) error {
+	if params == nil {
+		params = &SetShowFlexOverlaysParams{}
+	}
+	return c.Call(ctx, "Overlay.setShowFlexOverlays", params, sessionID, nil)
+}
+
+// SetShowScrollSnapOverlaysParams holds the parameters of Overlay.setShowScrollSnapOverlays.
+type SetShowScrollSnapOverlaysParams struct {
+	// An array of node identifiers and descriptors for the highlight appearance.
+	ScrollSnapHighlightConfigs []ScrollSnapHighlightConfig `json:"scrollSnapHighlightConfigs"`
+}
+
+// SetShowScrollSnapOverlays calls Overlay.setShowScrollSnapOverlays.
+func SetShowScrollSnapOverlays(ctx context.Context, c *internal.Client, sessionID string, params *SetShowScrollSnapOverlaysParams) error {
+	if params == nil {
+		params = &SetShowScrollSnapOverlaysParams{}
+	}
+	return c.Call(ctx, "Overlay.setShowScrollSnapOverlays", params, sessionID, nil)
+}
+
+// SetShowContainerQueryOverlaysParams holds the parameters of Overlay.setShowContainerQueryOverlays.
+type SetShowContainerQueryOverlaysParams struct {
+	// An array of node identifiers and descriptors for the highlight appearance.
+	ContainerQueryHighlightConfigs []ContainerQueryHighlightConfig `json:"containerQueryHighlightConfigs"`
+}
+
+// SetShowContainerQueryOverlays calls Overlay.setShowContainerQueryOverlays.
+func SetShowContainerQueryOverlays(ctx context.Context, c *internal.Client, sessionID string, params *SetShowContainerQueryOverlaysParams) error {
+	if params == nil {
+		params = &SetShowContainerQueryOverlaysParams{}
+	}
+	return c.Call(ctx, "Overlay.setShowContainerQueryOverlays", params, sessionID, nil)
+}
+
+// SetShowPaintRectsParams holds the parameters of Overlay.setShowPaintRects.
+type SetShowPaintRectsParams struct {
+	// True for showing paint rectangles
+	Result bool `json:"result"`
+}
+
+// SetShowPaintRects calls Overlay.setShowPaintRects.
+//
+// Requests that backend shows paint rectangles
+func SetShowPaintRects(ctx context.Context, c *internal.Client, sessionID string, params *SetShowPaintRectsParams) error {
+	if params == nil {
+		params = &SetShowPaintRectsParams{}
+	}
+	return c.Call(ctx, "Overlay.setShowPaintRects", params, sessionID, nil)
+}
+
+// SetShowLayoutShiftRegionsParams holds the parameters of Overlay.setShowLayoutShiftRegions.
+type SetShowLayoutShiftRegionsParams struct {
+	// True for showing layout shift regions
+	Result bool `json:"result"`
+}
+
+// SetShowLayoutShiftRegions calls Overlay.setShowLayoutShiftRegions.
+//
+// Requests that backend shows layout shift regions
+func SetShowLayoutShiftRegions(ctx context.Context, c *internal.Client, sessionID string, params *SetShowLayoutShiftRegionsParams) error {
+	if params == nil {
+		params = &SetShowLayoutShiftRegionsParams{}
+	}
+	return c.Call(ctx, "Overlay.setShowLayoutShiftRegions", params, sessionID, nil)
+}
+
+// SetShowScrollBottleneckRectsParams holds the parameters of Overlay.setShowScrollBottleneckRects.
+type SetShowScrollBottleneckRectsParams struct {
+	// True for showing scroll bottleneck rects
+	Show bool `json:"show"`
+}
+
+// SetShowScrollBottleneckRects calls Overlay.setShowScrollBottleneckRects.
+//
+// Requests that backend shows scroll bottleneck rects
+func SetShowScrollBottleneckRects(ctx context.Context, c *internal.Client, sessionID string, params *SetShowScrollBottleneckRectsParams) error {
+	if params == nil {
+		params = &SetShowScrollBottleneckRectsParams{}
+	}
+	return c.Call(ctx, "Overlay.setShowScrollBottleneckRects", params, sessionID, nil)
+}
+
+// SetShowHitTestBordersParams holds the parameters of Overlay.setShowHitTestBorders.
+type SetShowHitTestBordersParams struct {
+	// True for showing hit-test borders
+	Show bool `json:"show"`
+}
+
+// SetShowHitTestBorders calls Overlay.setShowHitTestBorders.
+//
+// Deprecated, no longer has any effect.
+//
+// Deprecated: this item is deprecated in the protocol.
+func SetShowHitTestBorders(ctx context.Context, c *internal.Client, sessionID string, params *SetShowHitTestBordersParams) error {
+	if params == nil {
+		params = &SetShowHitTestBordersParams{}
+	}
+	return c.Call(ctx, "Overlay.setShowHitTestBorders", params, sessionID, nil)
+}
+
+// SetShowWebVitalsParams holds the parameters of Overlay.setShowWebVitals.
+type SetShowWebVitalsParams struct {
+	Show bool `json:"show"`
+}
+
+// SetShowWebVitals calls Overlay.setShowWebVitals.
+//
+// Request that backend shows an overlay with web vital metrics.
+func SetShowWebVitals(ctx context.Context, c *internal.Client, sessionID string, params *SetShowWebVitalsParams) error {
+	if params == nil {
+		params = &SetShowWebVitalsParams{}
+	}
+	return c.Call(ctx, "Overlay.setShowWebVitals", params, sessionID, nil)
+}
+
+// SetShowViewportSizeOnResizeParams holds the parameters of Overlay.setShowViewportSizeOnResize.
+type SetShowViewportSizeOnResizeParams struct {
+	// Whether to paint size or not.
+	Show bool `json:"show"`
+}
+
+// SetShowViewportSizeOnResize calls Overlay.setShowViewportSizeOnResize.
+//
+// Paints viewport size upon main frame resize.
+func SetShowViewportSizeOnResize(ctx context.Context, c *internal.Client, sessionID string, params *SetShowViewportSizeOnResizeParams) error {
+	if params == nil {
+		params = &SetShowViewportSizeOnResizeParams{}
+	}
+	return c.Call(ctx, "Overlay.setShowViewportSizeOnResize", params, sessionID, nil)
+}
+
+// SetShowHingeParams holds the parameters of Overlay.setShowHinge.
+type SetShowHingeParams struct {
+	// hinge data, null means hideHinge
+	HingeConfig *HingeConfig `json:"hingeConfig,omitempty"`
+}
+
+// SetShowHinge calls Overlay.setShowHinge.
+//
+// Add a dual screen device hinge
+func SetShowHinge(ctx context.Context, c *internal.Client, sessionID string, params *SetShowHingeParams) error {
+	if params == nil {
+		params = &SetShowHingeParams{}
+	}
+	return c.Call(ctx, "Overlay.setShowHinge", params, sessionID, nil)
+}
+
+// SetShowIsolatedElementsParams holds the parameters of Overlay.setShowIsolatedElements.
+type SetShowIsolatedElementsParams struct {
+	// An array of node identifiers and descriptors for the highlight appearance.
+	IsolatedElementHighlightConfigs []IsolatedElementHighlightConfig `json:"isolatedElementHighlightConfigs"`
+}
+
+// SetShowIsolatedElements calls Overlay.setShowIsolatedElements.
+//
+// Show elements in isolation mode with overlays.
+func SetShowIsolatedElements(ctx context.Context, c *internal.Client, sessionID string, params *SetShowIsolatedElementsParams) error {
+	if params == nil {
+		params = &SetShowIsolatedElementsParams{}
+	}
+	return c.Call(ctx, "Overlay.setShowIsolatedElements", params, sessionID, nil)
+}
+
+// SetShowWindowControlsOverlayParams holds the parameters of Overlay.setShowWindowControlsOverlay.
+type SetShowWindowControlsOverlayParams struct {
+	// Window Controls Overlay data, null means hide Window Controls Overlay
+	WindowControlsOverlayConfig *WindowControlsOverlayConfig `json:"windowControlsOverlayConfig,omitempty"`
+}
+
+// SetShowWindowControlsOverlay calls Overlay.setShowWindowControlsOverlay.
+//
+// Show Window Controls Overlay for PWA
+func SetShowWindowControlsOverlay(ctx context.Context, c *internal.Client, sessionID string, params *SetShowWindowControlsOverlayParams) error {
+	if params == nil {
+		params = &SetShowWindowControlsOverlayParams{}
+	}
+	return c.Call(ctx, "Overlay.setShowWindowControlsOverlay", params, sessionID, nil)
+}
+
+// EventInspectNodeRequested is the method name of the Overlay.inspectNodeRequested event.
+const EventInspectNodeRequested = "Overlay.inspectNodeRequested"
+
+// InspectNodeRequestedEvent is the payload of the Overlay.inspectNodeRequested event.
+//
+// Fired when the node should be inspected. This happens after call to `setInspectMode` or when
+// user manually inspects an element.
+type InspectNodeRequestedEvent struct {
+	// Id of the node to inspect.
+	BackendNodeID dom.BackendNodeID `json:"backendNodeId"`
+}
+
+// EventNodeHighlightRequested is the method name of the Overlay.nodeHighlightRequested event.
+const EventNodeHighlightRequested = "Overlay.nodeHighlightRequested"
+
+// NodeHighlightRequestedEvent is the payload of the Overlay.nodeHighlightRequested event.
+//
+// Fired when the node should be highlighted. This happens after call to `setInspectMode`.
+type NodeHighlightRequestedEvent struct {
+	NodeID dom.NodeID `json:"nodeId"`
+}
+
+// EventScreenshotRequested is the method name of the Overlay.screenshotRequested event.
+const EventScreenshotRequested = "Overlay.screenshotRequested"
+
+// ScreenshotRequestedEvent is the payload of the Overlay.screenshotRequested event.
+//
+// Fired when user asks to capture screenshot of some area on the page.
+type ScreenshotRequestedEvent struct {
+	// Viewport to capture, in device independent pixels (dip).
+	Viewport page.Viewport `json:"viewport"`
+}
+
+// EventInspectModeCanceled is the method name of the Overlay.inspectModeCanceled event.
+const EventInspectModeCanceled = "Overlay.inspectModeCanceled"
+
+// InspectModeCanceledEvent is the payload of the Overlay.inspectModeCanceled event.
+//
+// Fired when user cancels the inspect mode.
+type InspectModeCanceledEvent struct{}
+
+// ParseEvent decodes a Overlay event message into its typed event struct.
+func ParseEvent(msg *internal.CDPMessage) (any, error) {
+	var v any
+	switch msg.Method {
+	case EventInspectNodeRequested:
+		v = &InspectNodeRequestedEvent{}
+	case EventNodeHighlightRequested:
+		v = &NodeHighlightRequestedEvent{}
+	case EventScreenshotRequested:
+		v = &ScreenshotRequestedEvent{}
+	case EventInspectModeCanceled:
+		v = &InspectModeCanceledEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Overlay event: %s", msg.Method)
+	}
+	if msg.Params == nil {
+		return v, nil
+	}
+	err := json.Unmarshal(msg.Params, v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,2874 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package page provides typed bindings for the CDP Page domain.
+//
+// Actions and events related to the inspected page belong to the page domain.
+package page
+
+import (
+	"cdp/internal"
+	"cdp/protocol/debugger"
+	"cdp/protocol/dom"
+	"cdp/protocol/io"
+	"cdp/protocol/runtime"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// FrameID is the Page.FrameId type.
+//
+// Unique frame identifier.
+type FrameID string
+
+// AdFrameType is the Page.AdFrameType type.
+//
+// Indicates whether a frame has been identified as an ad.
+//
+// Experimental.
+type AdFrameType string
+
+const (
+	AdFrameTypeNone  AdFrameType = "none"
+	AdFrameTypeChild AdFrameType = "child"
+	AdFrameTypeRoot  AdFrameType = "root"
+)
+
+// AdFrameExplanation is the Page.AdFrameExplanation type.
+//
+// Experimental.
+type AdFrameExplanation string
+
+const (
+	AdFrameExplanationParentIsAd          AdFrameExplanation = "ParentIsAd"
+	AdFrameExplanationCreatedByAdScript   AdFrameExplanation = "CreatedByAdScript"
+	AdFrameExplanationMatchedBlockingRule AdFrameExplanation = "MatchedBlockingRule"
+)
+
+// AdFrameStatus is the Page.AdFrameStatus type.
+//
+// Indicates whether a frame has been identified as an ad and why.
+//
+// Experimental.
+type AdFrameStatus struct {
+	AdFrameType  AdFrameType          `json:"adFrameType"`
+	Explanations []AdFrameExplanation `json:"explanations,omitempty"`
+}
+
+// AdScriptID is the Page.AdScriptId type.
+//
+// Identifies the bottom-most script which caused the frame to be labelled
+// as an ad.
+//
+// Experimental.
+type AdScriptID struct {
+	// Script Id of the bottom-most script which caused the frame to be labelled
+	// as an ad.
+	ScriptID runtime.ScriptID `json:"scriptId"`
+	// Id of adScriptId's debugger.
+	DebuggerID runtime.UniqueDebuggerID `json:"debuggerId"`
+}
+
+// SecureContextType is the Page.SecureContextType type.
+//
+// Indicates whether the frame is a secure context and why it is the case.
+//
+// Experimental.
+type SecureContextType string
+
+const (
+	SecureContextTypeSecure           SecureContextType = "Secure"
+	SecureContextTypeSecureLocalhost  SecureContextType = "SecureLocalhost"
+	SecureContextTypeInsecureScheme   SecureContextType = "InsecureScheme"
+	SecureContextTypeInsecureAncestor SecureContextType = "InsecureAncestor"
+)
+
+// CrossOriginIsolatedContextType is the Page.CrossOriginIsolatedContextType type.
+//
+// Indicates whether the frame is cross-origin isolated and why it is the case.
+//
+// Experimental.
+type CrossOriginIsolatedContextType string
+
+const (
+	CrossOriginIsolatedContextTypeIsolated                   CrossOriginIsolatedContextType = "Isolated"
+	CrossOriginIsolatedContextTypeNotIsolated                CrossOriginIsolatedContextType = "NotIsolated"
+	CrossOriginIsolatedContextTypeNotIsolatedFeatureDisabled CrossOriginIsolatedContextType = "NotIsolatedFeatureDisabled"
+)
+
+// GatedAPIFeatures is the Page.GatedAPIFeatures type.
+//
+// Experimental.
+type GatedAPIFeatures string
+
+const (
+	GatedAPIFeaturesSharedArrayBuffers                GatedAPIFeatures = "SharedArrayBuffers"
+	GatedAPIFeaturesSharedArrayBuffersTransferAllowed GatedAPIFeatures = "SharedArrayBuffersTransferAllowed"
+	GatedAPIFeaturesPerformanceMeasureMemory          GatedAPIFeatures = "PerformanceMeasureMemory"
+	GatedAPIFeaturesPerformanceProfile                GatedAPIFeatures = "PerformanceProfile"
+)
+
+// PermissionsPolicyFeature is the Page.PermissionsPolicyFeature type.
+//
+// All Permissions Policy features. This enum should match the one defined
+// in third_party/blink/renderer/core/permissions_policy/permissions_policy_features.json5.
+//
+// Experimental.
+type PermissionsPolicyFeature string
+
+const (
+	PermissionsPolicyFeatureAccelerometer                PermissionsPolicyFeature = "accelerometer"
+	PermissionsPolicyFeatureAllScreensCapture            PermissionsPolicyFeature = "all-screens-capture"
+	PermissionsPolicyFeatureAmbientLightSensor           PermissionsPolicyFeature = "ambient-light-sensor"
+	PermissionsPolicyFeatureAttributionReporting         PermissionsPolicyFeature = "attribution-reporting"
+	PermissionsPolicyFeatureAutoplay                     PermissionsPolicyFeature = "autoplay"
+	PermissionsPolicyFeatureBluetooth                    PermissionsPolicyFeature = "bluetooth"
+	PermissionsPolicyFeatureBrowsingTopics               PermissionsPolicyFeature = "browsing-topics"
+	PermissionsPolicyFeatureCamera                       PermissionsPolicyFeature = "camera"
+	PermissionsPolicyFeatureCapturedSurfaceControl       PermissionsPolicyFeature = "captured-surface-control"
+	PermissionsPolicyFeatureChDpr                        PermissionsPolicyFeature = "ch-dpr"
+	PermissionsPolicyFeatureChDeviceMemory               PermissionsPolicyFeature = "ch-device-memory"
+	PermissionsPolicyFeatureChDownlink                   PermissionsPolicyFeature = "ch-downlink"
+	PermissionsPolicyFeatureChEct                        PermissionsPolicyFeature = "ch-ect"
+	PermissionsPolicyFeatureChPrefersColorScheme         PermissionsPolicyFeature = "ch-prefers-color-scheme"
+	PermissionsPolicyFeatureChPrefersReducedMotion       PermissionsPolicyFeature = "ch-prefers-reduced-motion"
+	PermissionsPolicyFeatureChPrefersReducedTransparency PermissionsPolicyFeature = "ch-prefers-reduced-transparency"
+	PermissionsPolicyFeatureChRtt                        PermissionsPolicyFeature = "ch-rtt"
+	PermissionsPolicyFeatureChSaveData                   PermissionsPolicyFeature = "ch-save-data"
+	PermissionsPolicyFeatureChUa                         PermissionsPolicyFeature = "ch-ua"
+	PermissionsPolicyFeatureChUaArch                     PermissionsPolicyFeature = "ch-ua-arch"
+	PermissionsPolicyFeatureChUaBitness                  PermissionsPolicyFeature = "ch-ua-bitness"
+	PermissionsPolicyFeatureChUaPlatform                 PermissionsPolicyFeature = "ch-ua-platform"
+	PermissionsPolicyFeatureChUaModel                    PermissionsPolicyFeature = "ch-ua-model"
+	PermissionsPolicyFeatureChUaMobile                   PermissionsPolicyFeature = "ch-ua-mobile"
+	PermissionsPolicyFeatureChUaFormFactors              PermissionsPolicyFeature = "ch-ua-form-factors"
+	PermissionsPolicyFeatureChUaFullVersion              PermissionsPolicyFeature = "ch-ua-full-version"
+	PermissionsPolicyFeatureChUaFullVersionList          PermissionsPolicyFeature = "ch-ua-full-version-list"
+	PermissionsPolicyFeatureChUaPlatformVersion          PermissionsPolicyFeature = "ch-ua-platform-version"
+	PermissionsPolicyFeatureChUaWow64                    PermissionsPolicyFeature = "ch-ua-wow64"
+	PermissionsPolicyFeatureChViewportHeight             PermissionsPolicyFeature = "ch-viewport-height"
+	PermissionsPolicyFeatureChViewportWidth              PermissionsPolicyFeature = "ch-viewport-width"
+	PermissionsPolicyFeatureChWidth                      PermissionsPolicyFeature = "ch-width"
+	PermissionsPolicyFeatureClipboardRead                PermissionsPolicyFeature = "clipboard-read"
+	PermissionsPolicyFeatureClipboardWrite               PermissionsPolicyFeature = "clipboard-write"
+	PermissionsPolicyFeatureComputePressure              PermissionsPolicyFeature = "compute-pressure"
+	PermissionsPolicyFeatureCrossOriginIsolated          PermissionsPolicyFeature = "cross-origin-isolated"
+	PermissionsPolicyFeatureDeferredFetch                PermissionsPolicyFeature = "deferred-fetch"
+	PermissionsPolicyFeatureDigitalCredentialsGet        PermissionsPolicyFeature = "digital-credentials-get"
+	PermissionsPolicyFeatureDirectSockets                PermissionsPolicyFeature = "direct-sockets"
+	PermissionsPolicyFeatureDisplayCapture               PermissionsPolicyFeature = "display-capture"
+	PermissionsPolicyFeatureDocumentDomain               PermissionsPolicyFeature = "document-domain"
+	PermissionsPolicyFeatureEncryptedMedia               PermissionsPolicyFeature = "encrypted-media"
+	PermissionsPolicyFeatureExecutionWhileOutOfViewport  PermissionsPolicyFeature = "execution-while-out-of-viewport"
+	PermissionsPolicyFeatureExecutionWhileNotRendered    PermissionsPolicyFeature = "execution-while-not-rendered"
+	PermissionsPolicyFeatureFocusWithoutUserActivation   PermissionsPolicyFeature = "focus-without-user-activation"
+	PermissionsPolicyFeatureFullscreen                   PermissionsPolicyFeature = "fullscreen"
+	PermissionsPolicyFeatureFrobulate                    PermissionsPolicyFeature = "frobulate"
+	PermissionsPolicyFeatureGamepad                      PermissionsPolicyFeature = "gamepad"
+	PermissionsPolicyFeatureGeolocation                  PermissionsPolicyFeature = "geolocation"
+	PermissionsPolicyFeatureGyroscope                    PermissionsPolicyFeature = "gyroscope"
+	PermissionsPolicyFeatureHid                          PermissionsPolicyFeature = "hid"
+	PermissionsPolicyFeatureIdentityCredentialsGet       PermissionsPolicyFeature = "identity-credentials-get"
+	PermissionsPolicyFeatureIdleDetection                PermissionsPolicyFeature = "idle-detection"
+	PermissionsPolicyFeatureInterestCohort               PermissionsPolicyFeature = "interest-cohort"
+	PermissionsPolicyFeatureJoinAdInterestGroup          PermissionsPolicyFeature = "join-ad-interest-group"
+	PermissionsPolicyFeatureKeyboardMap                  PermissionsPolicyFeature = "keyboard-map"
+	PermissionsPolicyFeatureLocalFonts                   PermissionsPolicyFeature = "local-fonts"
+	PermissionsPolicyFeatureMagnetometer                 PermissionsPolicyFeature = "magnetometer"
+	PermissionsPolicyFeatureMediaPlaybackWhileNotVisible PermissionsPolicyFeature = "media-playback-while-not-visible"
+	PermissionsPolicyFeatureMicrophone                   PermissionsPolicyFeature = "microphone"
+	PermissionsPolicyFeatureMidi                         PermissionsPolicyFeature = "midi"
+	PermissionsPolicyFeatureOtpCredentials               PermissionsPolicyFeature = "otp-credentials"
+	PermissionsPolicyFeaturePayment                      PermissionsPolicyFeature = "payment"
+	PermissionsPolicyFeaturePictureInPicture             PermissionsPolicyFeature = "picture-in-picture"
+	PermissionsPolicyFeaturePopins                       PermissionsPolicyFeature = "popins"
+	PermissionsPolicyFeaturePrivateAggregation           PermissionsPolicyFeature = "private-aggregation"
+	PermissionsPolicyFeaturePrivateStateTokenIssuance    PermissionsPolicyFeature = "private-state-token-issuance"
+	PermissionsPolicyFeaturePrivateStateTokenRedemption  PermissionsPolicyFeature = "private-state-token-redemption"
+	PermissionsPolicyFeaturePublickeyCredentialsCreate   PermissionsPolicyFeature = "publickey-credentials-create"
+	PermissionsPolicyFeaturePublickeyCredentialsGet      PermissionsPolicyFeature = "publickey-credentials-get"
+	PermissionsPolicyFeatureRunAdAuction                 PermissionsPolicyFeature = "run-ad-auction"
+	PermissionsPolicyFeatureScreenWakeLock               PermissionsPolicyFeature = "screen-wake-lock"
+	PermissionsPolicyFeatureSerial                       PermissionsPolicyFeature = "serial"
+	PermissionsPolicyFeatureSharedAutofill               PermissionsPolicyFeature = "shared-autofill"
+	PermissionsPolicyFeatureSharedStorage                PermissionsPolicyFeature = "shared-storage"
+	PermissionsPolicyFeatureSharedStorageSelectURL       PermissionsPolicyFeature = "shared-storage-select-url"
+	PermissionsPolicyFeatureSmartCard                    PermissionsPolicyFeature = "smart-card"
+	PermissionsPolicyFeatureSpeakerSelection             PermissionsPolicyFeature = "speaker-selection"
+	PermissionsPolicyFeatureStorageAccess                PermissionsPolicyFeature = "storage-access"
+	PermissionsPolicyFeatureSubApps                      PermissionsPolicyFeature = "sub-apps"
+	PermissionsPolicyFeatureSyncXhr                      PermissionsPolicyFeature = "sync-xhr"
+	PermissionsPolicyFeatureUnload                       PermissionsPolicyFeature = "unload"
+	PermissionsPolicyFeatureUsb                          PermissionsPolicyFeature = "usb"
+	PermissionsPolicyFeatureUsbUnrestricted              PermissionsPolicyFeature = "usb-unrestricted"
+	PermissionsPolicyFeatureVerticalScroll               PermissionsPolicyFeature = "vertical-scroll"
+	PermissionsPolicyFeatureWebAppInstallation           PermissionsPolicyFeature = "web-app-installation"
+	PermissionsPolicyFeatureWebPrinting                  PermissionsPolicyFeature = "web-printing"
+	PermissionsPolicyFeatureWebShare                     PermissionsPolicyFeature = "web-share"
+	PermissionsPolicyFeatureWindowManagement             PermissionsPolicyFeature = "window-management"
+	PermissionsPolicyFeatureXrSpatialTracking            PermissionsPolicyFeature = "xr-spatial-tracking"
+)
+
+// PermissionsPolicyBlockReason is the Page.PermissionsPolicyBlockReason type.
+//
+// Reason for a permissions policy feature to be disabled.
+//
+// Experimental.
+type PermissionsPolicyBlockReason string
+
+const (
+	PermissionsPolicyBlockReasonHeader            PermissionsPolicyBlockReason = "Header"
+	PermissionsPolicyBlockReasonIframeAttribute   PermissionsPolicyBlockReason = "IframeAttribute"
+	PermissionsPolicyBlockReasonInFencedFrameTree PermissionsPolicyBlockReason = "InFencedFrameTree"
+	PermissionsPolicyBlockReasonInIsolatedApp     PermissionsPolicyBlockReason = "InIsolatedApp"
+)
+
+// PermissionsPolicyBlockLocator is the Page.PermissionsPolicyBlockLocator type.
+//
+// Experimental.
+type PermissionsPolicyBlockLocator struct {
+	FrameID     FrameID                      `json:"frameId"`
+	BlockReason PermissionsPolicyBlockReason `json:"blockReason"`
+}
+
+// PermissionsPolicyFeatureState is the Page.PermissionsPolicyFeatureState type.
+//
+// Experimental.
+type PermissionsPolicyFeatureState struct {
+	Feature PermissionsPolicyFeature       `json:"feature"`
+	Allowed bool                           `json:"allowed"`
+	Locator *PermissionsPolicyBlockLocator `json:"locator,omitempty"`
+}
+
+// OriginTrialTokenStatus is the Page.OriginTrialTokenStatus type.
+//
+// Origin Trial(https://www.chromium.org/blink/origin-trials) support.
+// Status for an Origin Trial token.
+//
+// Experimental.
+type OriginTrialTokenStatus string
+
+const (
+	OriginTrialTokenStatusSuccess                OriginTrialTokenStatus = "Success"
+	OriginTrialTokenStatusNotSupported           OriginTrialTokenStatus = "NotSupported"
+	OriginTrialTokenStatusInsecure               OriginTrialTokenStatus = "Insecure"
+	OriginTrialTokenStatusExpired                OriginTrialTokenStatus = "Expired"
+	OriginTrialTokenStatusWrongOrigin            OriginTrialTokenStatus = "WrongOrigin"
+	OriginTrialTokenStatusInvalidSignature       OriginTrialTokenStatus = "InvalidSignature"
+	OriginTrialTokenStatusMalformed              OriginTrialTokenStatus = "Malformed"
+	OriginTrialTokenStatusWrongVersion           OriginTrialTokenStatus = "WrongVersion"
+	OriginTrialTokenStatusFeatureDisabled        OriginTrialTokenStatus = "FeatureDisabled"
+	OriginTrialTokenStatusTokenDisabled          OriginTrialTokenStatus = "TokenDisabled"
+	OriginTrialTokenStatusFeatureDisabledForUser OriginTrialTokenStatus = "FeatureDisabledForUser"
+	OriginTrialTokenStatusUnknownTrial           OriginTrialTokenStatus = "UnknownTrial"
+)
+
+// OriginTrialStatus is the Page.OriginTrialStatus type.
+//
+// Status for an Origin Trial.
+//
+// Experimental.
+type OriginTrialStatus string
+
+const (
+	OriginTrialStatusEnabled               OriginTrialStatus = "Enabled"
+	OriginTrialStatusValidTokenNotProvided OriginTrialStatus = "ValidTokenNotProvided"
+	OriginTrialStatusOSNotSupported        OriginTrialStatus = "OSNotSupported"
+	OriginTrialStatusTrialNotAllowed       OriginTrialStatus = "TrialNotAllowed"
+)
+
+// OriginTrialUsageRestriction is the Page.OriginTrialUsageRestriction type.
+//
+// Experimental.
+type OriginTrialUsageRestriction string
+
+const (
+	OriginTrialUsageRestrictionNone   OriginTrialUsageRestriction = "None"
+	OriginTrialUsageRestrictionSubset OriginTrialUsageRestriction = "Subset"
+)
+
+// OriginTrialToken is the Page.OriginTrialToken type.
+//
+// Experimental.
+type OriginTrialToken struct {
+	Origin           string                      `json:"origin"`
+	MatchSubDomains  bool                        `json:"matchSubDomains"`
+	TrialName        string                      `json:"trialName"`
+	ExpiryTime       float64                     `json:"expiryTime"`
+	IsThirdParty     bool                        `json:"isThirdParty"`
+	UsageRestriction OriginTrialUsageRestriction `json:"usageRestriction"`
+}
+
+// OriginTrialTokenWithStatus is the Page.OriginTrialTokenWithStatus type.
+//
+// Experimental.
+type OriginTrialTokenWithStatus struct {
+	RawTokenText string `json:"rawTokenText"`
+	// `parsedToken` is present only when the token is extractable and
+	// parsable.
+	ParsedToken *OriginTrialToken      `json:"parsedToken,omitempty"`
+	Status      OriginTrialTokenStatus `json:"status"`
+}
+
+// OriginTrial is the Page.OriginTrial type.
+//
+// Experimental.
+type OriginTrial struct {
+	TrialName        string                       `json:"trialName"`
+	Status           OriginTrialStatus            `json:"status"`
+	TokensWithStatus []OriginTrialTokenWithStatus `json:"tokensWithStatus"`
+}
+
+// Frame is the Page.Frame type.
+//
+// Information about the Frame on the page.
+type Frame struct {
+	// Frame unique identifier.
+	ID FrameID `json:"id"`
+	// Parent frame identifier.
+	ParentID FrameID `json:"parentId,omitempty"`
+	// Identifier of the loader associated with this frame.
+	LoaderID string `json:"loaderId"`
+	// Frame's name as specified in the tag.
+	Name string `json:"name,omitempty"`
+	// Frame document's URL without fragment.
+	URL string `json:"url"`
+	// Frame document's URL fragment including the '#'.
+	//
+	// Experimental.
+	URLFragment string `json:"urlFragment,omitempty"`
+	// Frame document's registered domain, taking the public suffixes list into account.
+	// Extracted from the Frame's url.
+	// Example URLs: http://www.google.com/file.html -> "google.com"
+	//               http://a.b.co.uk/file.html      -> "b.co.uk"
+	//
+	// Experimental.
+	DomainAndRegistry string `json:"domainAndRegistry"`
+	// Frame document's security origin.
+	SecurityOrigin string `json:"securityOrigin"`
+	// Frame document's mimeType as determined by the browser.
+	MimeType string `json:"mimeType"`
+	// If the frame failed to load, this contains the URL that could not be loaded. Note that unlike url above, this URL may contain a fragment.
+	//
+	// Experimental.
+	UnreachableURL string `json:"unreachableUrl,omitempty"`
+	// Indicates whether this frame was tagged as an ad and why.
+	//
+	// Experimental.
+	AdFrameStatus *AdFrameStatus `json:"adFrameStatus,omitempty"`
+	// Indicates whether the main document is a secure context and explains why that is the case.
+	//
+	// Experimental.
+	SecureContextType SecureContextType `json:"secureContextType"`
+	// Indicates whether this is a cross origin isolated context.
+	//
+	// Experimental.
+	CrossOriginIsolatedContextType CrossOriginIsolatedContextType `json:"crossOriginIsolatedContextType"`
+	// Indicated which gated APIs / features are available.
+	//
+	// Experimental.
+	GatedAPIFeatures []GatedAPIFeatures `json:"gatedAPIFeatures"`
+}
+
+// FrameResource is the Page.FrameResource type.
+//
+// Information about the Resource on the page.
+//
+// Experimental.
+type FrameResource struct {
+	// Resource URL.
+	URL string `json:"url"`
+	// Type of this resource.
+	Type string `json:"type"`
+	// Resource mimeType as determined by the browser.
+	MimeType string `json:"mimeType"`
+	// last-modified timestamp as reported by server.
+	LastModified *float64 `json:"lastModified,omitempty"`
+	// Resource content size.
+	ContentSize *float64 `json:"contentSize,omitempty"`
+	// True if the resource failed to load.
+	Failed *bool `json:"failed,omitempty"`
+	// True if the resource was canceled during loading.
+	Canceled *bool `json:"canceled,omitempty"`
+}
+
+// FrameResourceTree is the Page.FrameResourceTree type.
+//
+// Information about the Frame hierarchy along with their cached resources.
+//
+// Experimental.
+type FrameResourceTree struct {
+	// Frame information for this tree item.
+	Frame Frame `json:"frame"`
+	// Child frames.
+	ChildFrames []FrameResourceTree `json:"childFrames,omitempty"`
+	// Information about frame resources.
+	Resources []FrameResource `json:"resources"`
+}
+
+// FrameTree is the Page.FrameTree type.
+//
+// Information about the Frame hierarchy.
+type FrameTree struct {
+	// Frame information for this tree item.
+	Frame Frame `json:"frame"`
+	// Child frames.
+	ChildFrames []FrameTree `json:"childFrames,omitempty"`
+}
+
+// ScriptIdentifier is the Page.ScriptIdentifier type.
+//
+// Unique script identifier.
+type ScriptIdentifier string
+
+// TransitionType is the Page.TransitionType type.
+//
+// Transition type.
+type TransitionType string
+
+const (
+	TransitionTypeLink             TransitionType = "link"
+	TransitionTypeTyped            TransitionType = "typed"
+	TransitionTypeAddressBar       TransitionType = "address_bar"
+	TransitionTypeAutoBookmark     TransitionType = "auto_bookmark"
+	TransitionTypeAutoSubframe     TransitionType = "auto_subframe"
+	TransitionTypeManualSubframe   TransitionType = "manual_subframe"
+	TransitionTypeGenerated        TransitionType = "generated"
+	TransitionTypeAutoToplevel     TransitionType = "auto_toplevel"
+	TransitionTypeFormSubmit       TransitionType = "form_submit"
+	TransitionTypeReload           TransitionType = "reload"
+	TransitionTypeKeyword          TransitionType = "keyword"
+	TransitionTypeKeywordGenerated TransitionType = "keyword_generated"
+	TransitionTypeOther            TransitionType = "other"
+)
+
+// NavigationEntry is the Page.NavigationEntry type.
+//
+// Navigation history entry.
+type NavigationEntry struct {
+	// Unique id of the navigation history entry.
+	ID int64 `json:"id"`
+	// URL of the navigation history entry.
+	URL string `json:"url"`
+	// URL that the user typed in the url bar.
+	UserTypedURL string `json:"userTypedURL"`
+	// Title of the navigation history entry.
+	Title string `json:"title"`
+	// Transition type.
+	TransitionType TransitionType `json:"transitionType"`
+}
+
+// ScreencastFrameMetadata is the Page.ScreencastFrameMetadata type.
+//
+// Screencast frame metadata.
+//
+// Experimental.
+type ScreencastFrameMetadata struct {
+	// Top offset in DIP.
+	OffsetTop float64 `json:"offsetTop"`
+	// Page scale factor.
+	PageScaleFactor float64 `json:"pageScaleFactor"`
+	// Device screen width in DIP.
+	DeviceWidth float64 `json:"deviceWidth"`
+	// Device screen height in DIP.
+	DeviceHeight float64 `json:"deviceHeight"`
+	// Position of horizontal scroll in CSS pixels.
+	ScrollOffsetX float64 `json:"scrollOffsetX"`
+	// Position of vertical scroll in CSS pixels.
+	ScrollOffsetY float64 `json:"scrollOffsetY"`
+	// Frame swap timestamp.
+	Timestamp *float64 `json:"timestamp,omitempty"`
+}
+
+// DialogType is the Page.DialogType type.
+//
+// Javascript dialog type.
+type DialogType string
+
+const (
+	DialogTypeAlert        DialogType = "alert"
+	DialogTypeConfirm      DialogType = "confirm"
+	DialogTypePrompt       DialogType = "prompt"
+	DialogTypeBeforeunload DialogType = "beforeunload"
+)
+
+// AppManifestError is the Page.AppManifestError type.
+//
+// Error while paring app manifest.
+type AppManifestError struct {
+	// Error message.
+	Message string `json:"message"`
+	// If critical, this is a non-recoverable parse error.
+	Critical int64 `json:"critical"`
+	// Error line.
+	Line int64 `json:"line"`
+	// Error column.
+	Column int64 `json:"column"`
+}
+
+// AppManifestParsedProperties is the Page.AppManifestParsedProperties type.
+//
+// Parsed app manifest properties.
+//
+// Experimental.
+type AppManifestParsedProperties struct {
+	// Computed scope value
+	Scope string `json:"scope"`
+}
+
+// LayoutViewport is the Page.LayoutViewport type.
+//
+// Layout viewport position and dimensions.
+type LayoutViewport struct {
+	// Horizontal offset relative to the document (CSS pixels).
+	PageX int64 `json:"pageX"`
+	// Vertical offset relative to the document (CSS pixels).
+	PageY int64 `json:"pageY"`
+	// Width (CSS pixels), excludes scrollbar if present.
+	ClientWidth int64 `json:"clientWidth"`
+	// Height (CSS pixels), excludes scrollbar if present.
+	ClientHeight int64 `json:"clientHeight"`
+}
+
+// VisualViewport is the Page.VisualViewport type.
+//
+// Visual viewport position, dimensions, and scale.
+type VisualViewport struct {
+	// Horizontal offset relative to the layout viewport (CSS pixels).
+	OffsetX float64 `json:"offsetX"`
+	// Vertical offset relative to the layout viewport (CSS pixels).
+	OffsetY float64 `json:"offsetY"`
+	// Horizontal offset relative to the document (CSS pixels).
+	PageX float64 `json:"pageX"`
+	// Vertical offset relative to the document (CSS pixels).
+	PageY float64 `json:"pageY"`
+	// Width (CSS pixels), excludes scrollbar if present.
+	ClientWidth float64 `json:"clientWidth"`
+	// Height (CSS pixels), excludes scrollbar if present.
+	ClientHeight float64 `json:"clientHeight"`
+	// Scale relative to the ideal viewport (size at width=device-width).
+	Scale float64 `json:"scale"`
+	// Page zoom factor (CSS to device independent pixels ratio).
+	Zoom *float64 `json:"zoom,omitempty"`
+}
+
+// Viewport is the Page.Viewport type.
+//
+// Viewport for capturing screenshot.
+type Viewport struct {
+	// X offset in device independent pixels (dip).
+	X float64 `json:"x"`
+	// Y offset in device independent pixels (dip).
+	Y float64 `json:"y"`
+	// Rectangle width in device independent pixels (dip).
+	Width float64 `json:"width"`
+	// Rectangle height in device independent pixels (dip).
+	Height float64 `json:"height"`
+	// Page scale factor.
+	Scale float64 `json:"scale"`
+}
+
+// FontFamilies is the Page.FontFamilies type.
+//
+// Generic font families collection.
+//
+// Experimental.
+type FontFamilies struct {
+	// The standard font-family.
+	Standard string `json:"standard,omitempty"`
+	// The fixed font-family.
+	Fixed string `json:"fixed,omitempty"`
+	// The serif font-family.
+	Serif string `json:"serif,omitempty"`
+	// The sansSerif font-family.
+	SansSerif string `json:"sansSerif,omitempty"`
+	// The cursive font-family.
+	Cursive string `json:"cursive,omitempty"`
+	// The fantasy font-family.
+	Fantasy string `json:"fantasy,omitempty"`
+	// The math font-family.
+	Math string `json:"math,omitempty"`
+}
+
+// ScriptFontFamilies is the Page.ScriptFontFamilies type.
+//
+// Font families collection for a script.
+//
+// Experimental.
+type ScriptFontFamilies struct {
+	// Name of the script which these font families are defined for.
+	Script string `json:"script"`
+	// Generic font families collection for the script.
+	FontFamilies FontFamilies `json:"fontFamilies"`
+}
+
+// FontSizes is the Page.FontSizes type.
+//
+// Default font sizes.
+//
+// Experimental.
+type FontSizes struct {
+	// Default standard font size.
+	Standard *int64 `json:"standard,omitempty"`
+	// Default fixed font size.
+	Fixed *int64 `json:"fixed,omitempty"`
+}
+
+// ClientNavigationReason is the Page.ClientNavigationReason type.
+//
+// Experimental.
+type ClientNavigationReason string
+
+const (
+	ClientNavigationReasonAnchorClick            ClientNavigationReason = "anchorClick"
+	ClientNavigationReasonFormSubmissionGet      ClientNavigationReason = "formSubmissionGet"
+	ClientNavigationReasonFormSubmissionPost     ClientNavigationReason = "formSubmissionPost"
+	ClientNavigationReasonHTTPHeaderRefresh      ClientNavigationReason = "httpHeaderRefresh"
+	ClientNavigationReasonInitialFrameNavigation ClientNavigationReason = "initialFrameNavigation"
+	ClientNavigationReasonMetaTagRefresh         ClientNavigationReason = "metaTagRefresh"
+	ClientNavigationReasonOther                  ClientNavigationReason = "other"
+	ClientNavigationReasonPageBlockInterstitial  ClientNavigationReason = "pageBlockInterstitial"
+	ClientNavigationReasonReload                 ClientNavigationReason = "reload"
+	ClientNavigationReasonScriptInitiated        ClientNavigationReason = "scriptInitiated"
+)
+
+// ClientNavigationDisposition is the Page.ClientNavigationDisposition type.
+//
+// Experimental.
+type ClientNavigationDisposition string
+
+const (
+	ClientNavigationDispositionCurrentTab ClientNavigationDisposition = "currentTab"
+	ClientNavigationDispositionNewTab     ClientNavigationDisposition = "newTab"
+	ClientNavigationDispositionNewWindow  ClientNavigationDisposition = "newWindow"
+	ClientNavigationDispositionDownload   ClientNavigationDisposition = "download"
+)
+
+// InstallabilityErrorArgument is the Page.InstallabilityErrorArgument type.
+//
+// Experimental.
+type InstallabilityErrorArgument struct {
+	// Argument name (e.g. name:'minimum-icon-size-in-pixels').
+	Name string `json:"name"`
+	// Argument value (e.g. value:'64').
+	Value string `json:"value"`
+}
+
+// InstallabilityError is the Page.InstallabilityError type.
+//
+// # The installability error
+//
+// Experimental.
+type InstallabilityError struct {
+	// The error id (e.g. 'manifest-missing-suitable-icon').
+	ErrorID string `json:"errorId"`
+	// The list of error arguments (e.g. {name:'minimum-icon-size-in-pixels', value:'64'}).
+	ErrorArguments []InstallabilityErrorArgument `json:"errorArguments"`
+}
+
+// ReferrerPolicy is the Page.ReferrerPolicy type.
+//
+// The referring-policy used for the navigation.
+//
+// Experimental.
+type ReferrerPolicy string
+
+const (
+	ReferrerPolicyNoReferrer                  ReferrerPolicy = "noReferrer"
+	ReferrerPolicyNoReferrerWhenDowngrade     ReferrerPolicy = "noReferrerWhenDowngrade"
+	ReferrerPolicyOrigin                      ReferrerPolicy = "origin"
+	ReferrerPolicyOriginWhenCrossOrigin       ReferrerPolicy = "originWhenCrossOrigin"
+	ReferrerPolicySameOrigin                  ReferrerPolicy = "sameOrigin"
+	ReferrerPolicyStrictOrigin                ReferrerPolicy = "strictOrigin"
+	ReferrerPolicyStrictOriginWhenCrossOrigin ReferrerPolicy = "strictOriginWhenCrossOrigin"
+	ReferrerPolicyUnsafeURL                   ReferrerPolicy = "unsafeUrl"
+)
+
+// CompilationCacheParams is the Page.CompilationCacheParams type.
+//
+// Per-script compilation cache parameters for `Page.produceCompilationCache`
+//
+// Experimental.
+type CompilationCacheParams struct {
+	// The URL of the script to produce a compilation cache entry for.
+	URL string `json:"url"`
+	// A hint to the backend whether eager compilation is recommended.
+	// (the actual compilation mode used is upon backend discretion).
+	Eager *bool `json:"eager,omitempty"`
+}
+
+// FileFilter is the Page.FileFilter type.
+//
+// Experimental.
+type FileFilter struct {
+	Name    string   `json:"name,omitempty"`
+	Accepts []string `json:"accepts,omitempty"`
+}
+
+// FileHandler is the Page.FileHandler type.
+//
+// Experimental.
+type FileHandler struct {
+	Action string          `json:"action"`
+	Name   string          `json:"name"`
+	Icons  []ImageResource `json:"icons,omitempty"`
+	// Mimic a map, name is the key, accepts is the value.
+	Accepts []FileFilter `json:"accepts,omitempty"`
+	// Won't repeat the enums, using string for easy comparison. Same as the
+	// other enums below.
+	LaunchType string `json:"launchType"`
+}
+
+// ImageResource is the Page.ImageResource type.
+//
+// The image definition used in both icon and screenshot.
+//
+// Experimental.
+type ImageResource struct {
+	// The src field in the definition, but changing to url in favor of
+	// consistency.
+	URL   string `json:"url"`
+	Sizes string `json:"sizes,omitempty"`
+	Type  string `json:"type,omitempty"`
+}
+
+// LaunchHandler is the Page.LaunchHandler type.
+//
+// Experimental.
+type LaunchHandler struct {
+	ClientMode string `json:"clientMode"`
+}
+
+// ProtocolHandler is the Page.ProtocolHandler type.
+//
+// Experimental.
+type ProtocolHandler struct {
+	Protocol string `json:"protocol"`
+	URL      string `json:"url"`
+}
+
+// RelatedApplication is the Page.RelatedApplication type.
+//
+// Experimental.
+type RelatedApplication struct {
+	ID  string `json:"id,omitempty"`
+	URL string `json:"url"`
+}
+
+// ScopeExtension is the Page.ScopeExtension type.
+//
+// Experimental.
+type ScopeExtension struct {
+	// Instead of using tuple, this field always returns the serialized string
+	// for easy understanding and comparison.
+	Origin            string `json:"origin"`
+	HasOriginWildcard bool   `json:"hasOriginWildcard"`
+}
+
+// Screenshot is the Page.Screenshot type.
+//
+// Experimental.
+type Screenshot struct {
+	Image      ImageResource `json:"image"`
+	FormFactor string        `json:"formFactor"`
+	Label      string        `json:"label,omitempty"`
+}
+
+// ShareTarget is the Page.ShareTarget type.
+//
+// Experimental.
+type ShareTarget struct {
+	Action  string `json:"action"`
+	Method  string `json:"method"`
+	Enctype string `json:"enctype"`
+	// Embed the ShareTargetParams
+	Title string       `json:"title,omitempty"`
+	Text  string       `json:"text,omitempty"`
+	URL   string       `json:"url,omitempty"`
+	Files []FileFilter `json:"files,omitempty"`
+}
+
+// Shortcut is the Page.Shortcut type.
+//
+// Experimental.
+type Shortcut struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// WebAppManifest is the Page.WebAppManifest type.
+//
+// Experimental.
+type WebAppManifest struct {
+	BackgroundColor string `json:"backgroundColor,omitempty"`
+	// The extra description provided by the manifest.
+	Description string `json:"description,omitempty"`
+	Dir         string `json:"dir,omitempty"`
+	Display     string `json:"display,omitempty"`
+	// The overrided display mode controlled by the user.
+	DisplayOverrides []string `json:"displayOverrides,omitempty"`
+	// The handlers to open files.
+	FileHandlers []FileHandler   `json:"fileHandlers,omitempty"`
+	Icons        []ImageResource `json:"icons,omitempty"`
+	ID           string          `json:"id,omitempty"`
+	Lang         string          `json:"lang,omitempty"`
+	// TODO(crbug.com/1231886): This field is non-standard and part of a Chrome
+	// experiment. See:
+	// https://github.com/WICG/web-app-launch/blob/main/launch_handler.md
+	LaunchHandler             *LaunchHandler `json:"launchHandler,omitempty"`
+	Name                      string         `json:"name,omitempty"`
+	Orientation               string         `json:"orientation,omitempty"`
+	PreferRelatedApplications *bool          `json:"preferRelatedApplications,omitempty"`
+	// The handlers to open protocols.
+	ProtocolHandlers    []ProtocolHandler    `json:"protocolHandlers,omitempty"`
+	RelatedApplications []RelatedApplication `json:"relatedApplications,omitempty"`
+	Scope               string               `json:"scope,omitempty"`
+	// Non-standard, see
+	// https://github.com/WICG/manifest-incubations/blob/gh-pages/scope_extensions-explainer.md
+	ScopeExtensions []ScopeExtension `json:"scopeExtensions,omitempty"`
+	// The screenshots used by chromium.
+	Screenshots []Screenshot `json:"screenshots,omitempty"`
+	ShareTarget *ShareTarget `json:"shareTarget,omitempty"`
+	ShortName   string       `json:"shortName,omitempty"`
+	Shortcuts   []Shortcut   `json:"shortcuts,omitempty"`
+	StartURL    string       `json:"startUrl,omitempty"`
+	ThemeColor  string       `json:"themeColor,omitempty"`
+}
+
+// AutoResponseMode is the Page.AutoResponseMode type.
+//
+// Enum of possible auto-response for permission / prompt dialogs.
+//
+// Experimental.
+type AutoResponseMode string
+
+const (
+	AutoResponseModeNone       AutoResponseMode = "none"
+	AutoResponseModeAutoAccept AutoResponseMode = "autoAccept"
+	AutoResponseModeAutoReject AutoResponseMode = "autoReject"
+	AutoResponseModeAutoOptOut AutoResponseMode = "autoOptOut"
+)
+
+// NavigationType is the Page.NavigationType type.
+//
+// The type of a frameNavigated event.
+//
+// Experimental.
+type NavigationType string
+
+const (
+	NavigationTypeNavigation              NavigationType = "Navigation"
+	NavigationTypeBackForwardCacheRestore NavigationType = "BackForwardCacheRestore"
+)
+
+// BackForwardCacheNotRestoredReason is the Page.BackForwardCacheNotRestoredReason type.
+//
+// List of not restored reasons for back-forward cache.
+//
+// Experimental.
+type BackForwardCacheNotRestoredReason string
+
+const (
+	BackForwardCacheNotRestoredReasonNotPrimaryMainFrame                                      BackForwardCacheNotRestoredReason = "NotPrimaryMainFrame"
+	BackForwardCacheNotRestoredReasonBackForwardCacheDisabled                                 BackForwardCacheNotRestoredReason = "BackForwardCacheDisabled"
+	BackForwardCacheNotRestoredReasonRelatedActiveContentsExist                               BackForwardCacheNotRestoredReason = "RelatedActiveContentsExist"
+	BackForwardCacheNotRestoredReasonHTTPStatusNotOK                                          BackForwardCacheNotRestoredReason = "HTTPStatusNotOK"
+	BackForwardCacheNotRestoredReasonSchemeNotHTTPOrHTTPS                                     BackForwardCacheNotRestoredReason = "SchemeNotHTTPOrHTTPS"
+	BackForwardCacheNotRestoredReasonLoading                                                  BackForwardCacheNotRestoredReason = "Loading"
+	BackForwardCacheNotRestoredReasonWasGrantedMediaAccess                                    BackForwardCacheNotRestoredReason = "WasGrantedMediaAccess"
+	BackForwardCacheNotRestoredReasonDisableForRenderFrameHostCalled                          BackForwardCacheNotRestoredReason = "DisableForRenderFrameHostCalled"
+	BackForwardCacheNotRestoredReasonDomainNotAllowed                                         BackForwardCacheNotRestoredReason = "DomainNotAllowed"
+	BackForwardCacheNotRestoredReasonHTTPMethodNotGET                                         BackForwardCacheNotRestoredReason = "HTTPMethodNotGET"
+	BackForwardCacheNotRestoredReasonSubframeIsNavigating                                     BackForwardCacheNotRestoredReason = "SubframeIsNavigating"
+	BackForwardCacheNotRestoredReasonTimeout                                                  BackForwardCacheNotRestoredReason = "Timeout"
+	BackForwardCacheNotRestoredReasonCacheLimit                                               BackForwardCacheNotRestoredReason = "CacheLimit"
+	BackForwardCacheNotRestoredReasonJavaScriptExecution                                      BackForwardCacheNotRestoredReason = "JavaScriptExecution"
+	BackForwardCacheNotRestoredReasonRendererProcessKilled                                    BackForwardCacheNotRestoredReason = "RendererProcessKilled"
+	BackForwardCacheNotRestoredReasonRendererProcessCrashed                                   BackForwardCacheNotRestoredReason = "RendererProcessCrashed"
+	BackForwardCacheNotRestoredReasonSchedulerTrackedFeatureUsed                              BackForwardCacheNotRestoredReason = "SchedulerTrackedFeatureUsed"
+	BackForwardCacheNotRestoredReasonConflictingBrowsingInstance                              BackForwardCacheNotRestoredReason = "ConflictingBrowsingInstance"
+	BackForwardCacheNotRestoredReasonCacheFlushed                                             BackForwardCacheNotRestoredReason = "CacheFlushed"
+	BackForwardCacheNotRestoredReasonServiceWorkerVersionActivation                           BackForwardCacheNotRestoredReason = "ServiceWorkerVersionActivation"
+	BackForwardCacheNotRestoredReasonSessionRestored                                          BackForwardCacheNotRestoredReason = "SessionRestored"
+	BackForwardCacheNotRestoredReasonServiceWorkerPostMessage                                 BackForwardCacheNotRestoredReason = "ServiceWorkerPostMessage"
+	BackForwardCacheNotRestoredReasonEnteredBackForwardCacheBeforeServiceWorkerHostAdded      BackForwardCacheNotRestoredReason = "EnteredBackForwardCacheBeforeServiceWorkerHostAdded"
+	BackForwardCacheNotRestoredReasonRenderFrameHostReusedSameSite                            BackForwardCacheNotRestoredReason = "RenderFrameHostReused_SameSite"
+	BackForwardCacheNotRestoredReasonRenderFrameHostReusedCrossSite                           BackForwardCacheNotRestoredReason = "RenderFrameHostReused_CrossSite"
+	BackForwardCacheNotRestoredReasonServiceWorkerClaim                                       BackForwardCacheNotRestoredReason = "ServiceWorkerClaim"
+	BackForwardCacheNotRestoredReasonIgnoreEventAndEvict                                      BackForwardCacheNotRestoredReason = "IgnoreEventAndEvict"
+	BackForwardCacheNotRestoredReasonHaveInnerContents                                        BackForwardCacheNotRestoredReason = "HaveInnerContents"
+	BackForwardCacheNotRestoredReasonTimeoutPuttingInCache                                    BackForwardCacheNotRestoredReason = "TimeoutPuttingInCache"
+	BackForwardCacheNotRestoredReasonBackForwardCacheDisabledByLowMemory                      BackForwardCacheNotRestoredReason = "BackForwardCacheDisabledByLowMemory"
+	BackForwardCacheNotRestoredReasonBackForwardCacheDisabledByCommandLine                    BackForwardCacheNotRestoredReason = "BackForwardCacheDisabledByCommandLine"
+	BackForwardCacheNotRestoredReasonNetworkRequestDatapipeDrainedAsBytesConsumer             BackForwardCacheNotRestoredReason = "NetworkRequestDatapipeDrainedAsBytesConsumer"
+	BackForwardCacheNotRestoredReasonNetworkRequestRedirected                                 BackForwardCacheNotRestoredReason = "NetworkRequestRedirected"
+	BackForwardCacheNotRestoredReasonNetworkRequestTimeout                                    BackForwardCacheNotRestoredReason = "NetworkRequestTimeout"
+	BackForwardCacheNotRestoredReasonNetworkExceedsBufferLimit                                BackForwardCacheNotRestoredReason = "NetworkExceedsBufferLimit"
+	BackForwardCacheNotRestoredReasonNavigationCancelledWhileRestoring                        BackForwardCacheNotRestoredReason = "NavigationCancelledWhileRestoring"
+	BackForwardCacheNotRestoredReasonNotMostRecentNavigationEntry                             BackForwardCacheNotRestoredReason = "NotMostRecentNavigationEntry"
+	BackForwardCacheNotRestoredReasonBackForwardCacheDisabledForPrerender                     BackForwardCacheNotRestoredReason = "BackForwardCacheDisabledForPrerender"
+	BackForwardCacheNotRestoredReasonUserAgentOverrideDiffers                                 BackForwardCacheNotRestoredReason = "UserAgentOverrideDiffers"
+	BackForwardCacheNotRestoredReasonForegroundCacheLimit                                     BackForwardCacheNotRestoredReason = "ForegroundCacheLimit"
+	BackForwardCacheNotRestoredReasonBrowsingInstanceNotSwapped                               BackForwardCacheNotRestoredReason = "BrowsingInstanceNotSwapped"
+	BackForwardCacheNotRestoredReasonBackForwardCacheDisabledForDelegate                      BackForwardCacheNotRestoredReason = "BackForwardCacheDisabledForDelegate"
+	BackForwardCacheNotRestoredReasonUnloadHandlerExistsInMainFrame                           BackForwardCacheNotRestoredReason = "UnloadHandlerExistsInMainFrame"
+	BackForwardCacheNotRestoredReasonUnloadHandlerExistsInSubFrame                            BackForwardCacheNotRestoredReason = "UnloadHandlerExistsInSubFrame"
+	BackForwardCacheNotRestoredReasonServiceWorkerUnregistration                              BackForwardCacheNotRestoredReason = "ServiceWorkerUnregistration"
+	BackForwardCacheNotRestoredReasonCacheControlNoStore                                      BackForwardCacheNotRestoredReason = "CacheControlNoStore"
+	BackForwardCacheNotRestoredReasonCacheControlNoStoreCookieModified                        BackForwardCacheNotRestoredReason = "CacheControlNoStoreCookieModified"
+	BackForwardCacheNotRestoredReasonCacheControlNoStoreHTTPOnlyCookieModified                BackForwardCacheNotRestoredReason = "CacheControlNoStoreHTTPOnlyCookieModified"
+	BackForwardCacheNotRestoredReasonNoResponseHead                                           BackForwardCacheNotRestoredReason = "NoResponseHead"
+	BackForwardCacheNotRestoredReasonUnknown                                                  BackForwardCacheNotRestoredReason = "Unknown"
+	BackForwardCacheNotRestoredReasonActivationNavigationsDisallowedForBug1234857             BackForwardCacheNotRestoredReason = "ActivationNavigationsDisallowedForBug1234857"
+	BackForwardCacheNotRestoredReasonErrorDocument                                            BackForwardCacheNotRestoredReason = "ErrorDocument"
+	BackForwardCacheNotRestoredReasonFencedFramesEmbedder                                     BackForwardCacheNotRestoredReason = "FencedFramesEmbedder"
+	BackForwardCacheNotRestoredReasonCookieDisabled                                           BackForwardCacheNotRestoredReason = "CookieDisabled"
+	BackForwardCacheNotRestoredReasonHTTPAuthRequired                                         BackForwardCacheNotRestoredReason = "HTTPAuthRequired"
+	BackForwardCacheNotRestoredReasonCookieFlushed                                            BackForwardCacheNotRestoredReason = "CookieFlushed"
+	BackForwardCacheNotRestoredReasonBroadcastChannelOnMessage                                BackForwardCacheNotRestoredReason = "BroadcastChannelOnMessage"
+	BackForwardCacheNotRestoredReasonWebViewSettingsChanged                                   BackForwardCacheNotRestoredReason = "WebViewSettingsChanged"
+	BackForwardCacheNotRestoredReasonWebViewJavaScriptObjectChanged                           BackForwardCacheNotRestoredReason = "WebViewJavaScriptObjectChanged"
+	BackForwardCacheNotRestoredReasonWebViewMessageListenerInjected                           BackForwardCacheNotRestoredReason = "WebViewMessageListenerInjected"
+	BackForwardCacheNotRestoredReasonWebViewSafeBrowsingAllowlistChanged                      BackForwardCacheNotRestoredReason = "WebViewSafeBrowsingAllowlistChanged"
+	BackForwardCacheNotRestoredReasonWebViewDocumentStartJavascriptChanged                    BackForwardCacheNotRestoredReason = "WebViewDocumentStartJavascriptChanged"
+	BackForwardCacheNotRestoredReasonWebSocket                                                BackForwardCacheNotRestoredReason = "WebSocket"
+	BackForwardCacheNotRestoredReasonWebTransport                                             BackForwardCacheNotRestoredReason = "WebTransport"
+	BackForwardCacheNotRestoredReasonWebRTC                                                   BackForwardCacheNotRestoredReason = "WebRTC"
+	BackForwardCacheNotRestoredReasonMainResourceHasCacheControlNoStore                       BackForwardCacheNotRestoredReason = "MainResourceHasCacheControlNoStore"
+	BackForwardCacheNotRestoredReasonMainResourceHasCacheControlNoCache                       BackForwardCacheNotRestoredReason = "MainResourceHasCacheControlNoCache"
+	BackForwardCacheNotRestoredReasonSubresourceHasCacheControlNoStore                        BackForwardCacheNotRestoredReason = "SubresourceHasCacheControlNoStore"
+	BackForwardCacheNotRestoredReasonSubresourceHasCacheControlNoCache                        BackForwardCacheNotRestoredReason = "SubresourceHasCacheControlNoCache"
+	BackForwardCacheNotRestoredReasonContainsPlugins                                          BackForwardCacheNotRestoredReason = "ContainsPlugins"
+	BackForwardCacheNotRestoredReasonDocumentLoaded                                           BackForwardCacheNotRestoredReason = "DocumentLoaded"
+	BackForwardCacheNotRestoredReasonOutstandingNetworkRequestOthers                          BackForwardCacheNotRestoredReason = "OutstandingNetworkRequestOthers"
+	BackForwardCacheNotRestoredReasonRequestedMIDIPermission                                  BackForwardCacheNotRestoredReason = "RequestedMIDIPermission"
+	BackForwardCacheNotRestoredReasonRequestedAudioCapturePermission                          BackForwardCacheNotRestoredReason = "RequestedAudioCapturePermission"
+	BackForwardCacheNotRestoredReasonRequestedVideoCapturePermission                          BackForwardCacheNotRestoredReason = "RequestedVideoCapturePermission"
+	BackForwardCacheNotRestoredReasonRequestedBackForwardCacheBlockedSensors                  BackForwardCacheNotRestoredReason = "RequestedBackForwardCacheBlockedSensors"
+	BackForwardCacheNotRestoredReasonRequestedBackgroundWorkPermission                        BackForwardCacheNotRestoredReason = "RequestedBackgroundWorkPermission"
+	BackForwardCacheNotRestoredReasonBroadcastChannel                                         BackForwardCacheNotRestoredReason = "BroadcastChannel"
+	BackForwardCacheNotRestoredReasonWebXR                                                    BackForwardCacheNotRestoredReason = "WebXR"
+	BackForwardCacheNotRestoredReasonSharedWorker                                             BackForwardCacheNotRestoredReason = "SharedWorker"
+	BackForwardCacheNotRestoredReasonWebLocks                                                 BackForwardCacheNotRestoredReason = "WebLocks"
+	BackForwardCacheNotRestoredReasonWebHID                                                   BackForwardCacheNotRestoredReason = "WebHID"
+	BackForwardCacheNotRestoredReasonWebShare                                                 BackForwardCacheNotRestoredReason = "WebShare"
+	BackForwardCacheNotRestoredReasonRequestedStorageAccessGrant                              BackForwardCacheNotRestoredReason = "RequestedStorageAccessGrant"
+	BackForwardCacheNotRestoredReasonWebNfc                                                   BackForwardCacheNotRestoredReason = "WebNfc"
+	BackForwardCacheNotRestoredReasonOutstandingNetworkRequestFetch                           BackForwardCacheNotRestoredReason = "OutstandingNetworkRequestFetch"
+	BackForwardCacheNotRestoredReasonOutstandingNetworkRequestXHR                             BackForwardCacheNotRestoredReason = "OutstandingNetworkRequestXHR"
+	BackForwardCacheNotRestoredReasonAppBanner                                                BackForwardCacheNotRestoredReason = "AppBanner"
+	BackForwardCacheNotRestoredReasonPrinting                                                 BackForwardCacheNotRestoredReason = "Printing"
+	BackForwardCacheNotRestoredReasonWebDatabase                                              BackForwardCacheNotRestoredReason = "WebDatabase"
+	BackForwardCacheNotRestoredReasonPictureInPicture                                         BackForwardCacheNotRestoredReason = "PictureInPicture"
+	BackForwardCacheNotRestoredReasonSpeechRecognizer                                         BackForwardCacheNotRestoredReason = "SpeechRecognizer"
+	BackForwardCacheNotRestoredReasonIdleManager                                              BackForwardCacheNotRestoredReason = "IdleManager"
+	BackForwardCacheNotRestoredReasonPaymentManager                                           BackForwardCacheNotRestoredReason = "PaymentManager"
+	BackForwardCacheNotRestoredReasonSpeechSynthesis                                          BackForwardCacheNotRestoredReason = "SpeechSynthesis"
+	BackForwardCacheNotRestoredReasonKeyboardLock                                             BackForwardCacheNotRestoredReason = "KeyboardLock"
+	BackForwardCacheNotRestoredReasonWebOTPService                                            BackForwardCacheNotRestoredReason = "WebOTPService"
+	BackForwardCacheNotRestoredReasonOutstandingNetworkRequestDirectSocket                    BackForwardCacheNotRestoredReason = "OutstandingNetworkRequestDirectSocket"
+	BackForwardCacheNotRestoredReasonInjectedJavascript                                       BackForwardCacheNotRestoredReason = "InjectedJavascript"
+	BackForwardCacheNotRestoredReasonInjectedStyleSheet                                       BackForwardCacheNotRestoredReason = "InjectedStyleSheet"
+	BackForwardCacheNotRestoredReasonKeepaliveRequest                                         BackForwardCacheNotRestoredReason = "KeepaliveRequest"
+	BackForwardCacheNotRestoredReasonIndexedDBEvent                                           BackForwardCacheNotRestoredReason = "IndexedDBEvent"
+	BackForwardCacheNotRestoredReasonDummy                                                    BackForwardCacheNotRestoredReason = "Dummy"
+	BackForwardCacheNotRestoredReasonJSNetworkRequestReceivedCacheControlNoStoreResource      BackForwardCacheNotRestoredReason = "JsNetworkRequestReceivedCacheControlNoStoreResource"
+	BackForwardCacheNotRestoredReasonWebRTCSticky                                             BackForwardCacheNotRestoredReason = "WebRTCSticky"
+	BackForwardCacheNotRestoredReasonWebTransportSticky                                       BackForwardCacheNotRestoredReason = "WebTransportSticky"
+	BackForwardCacheNotRestoredReasonWebSocketSticky                                          BackForwardCacheNotRestoredReason = "WebSocketSticky"
+	BackForwardCacheNotRestoredReasonSmartCard                                                BackForwardCacheNotRestoredReason = "SmartCard"
+	BackForwardCacheNotRestoredReasonLiveMediaStreamTrack                                     BackForwardCacheNotRestoredReason = "LiveMediaStreamTrack"
+	BackForwardCacheNotRestoredReasonUnloadHandler                                            BackForwardCacheNotRestoredReason = "UnloadHandler"
+	BackForwardCacheNotRestoredReasonParserAborted                                            BackForwardCacheNotRestoredReason = "ParserAborted"
+	BackForwardCacheNotRestoredReasonContentSecurityHandler                                   BackForwardCacheNotRestoredReason = "ContentSecurityHandler"
+	BackForwardCacheNotRestoredReasonContentWebAuthenticationAPI                              BackForwardCacheNotRestoredReason = "ContentWebAuthenticationAPI"
+	BackForwardCacheNotRestoredReasonContentFileChooser                                       BackForwardCacheNotRestoredReason = "ContentFileChooser"
+	BackForwardCacheNotRestoredReasonContentSerial                                            BackForwardCacheNotRestoredReason = "ContentSerial"
+	BackForwardCacheNotRestoredReasonContentFileSystemAccess                                  BackForwardCacheNotRestoredReason = "ContentFileSystemAccess"
+	BackForwardCacheNotRestoredReasonContentMediaDevicesDispatcherHost                        BackForwardCacheNotRestoredReason = "ContentMediaDevicesDispatcherHost"
+	BackForwardCacheNotRestoredReasonContentWebBluetooth                                      BackForwardCacheNotRestoredReason = "ContentWebBluetooth"
+	BackForwardCacheNotRestoredReasonContentWebUSB                                            BackForwardCacheNotRestoredReason = "ContentWebUSB"
+	BackForwardCacheNotRestoredReasonContentMediaSessionService                               BackForwardCacheNotRestoredReason = "ContentMediaSessionService"
+	BackForwardCacheNotRestoredReasonContentScreenReader                                      BackForwardCacheNotRestoredReason = "ContentScreenReader"
+	BackForwardCacheNotRestoredReasonContentDiscarded                                         BackForwardCacheNotRestoredReason = "ContentDiscarded"
+	BackForwardCacheNotRestoredReasonEmbedderPopupBlockerTabHelper                            BackForwardCacheNotRestoredReason = "EmbedderPopupBlockerTabHelper"
+	BackForwardCacheNotRestoredReasonEmbedderSafeBrowsingTriggeredPopupBlocker                BackForwardCacheNotRestoredReason = "EmbedderSafeBrowsingTriggeredPopupBlocker"
+	BackForwardCacheNotRestoredReasonEmbedderSafeBrowsingThreatDetails                        BackForwardCacheNotRestoredReason = "EmbedderSafeBrowsingThreatDetails"
+	BackForwardCacheNotRestoredReasonEmbedderAppBannerManager                                 BackForwardCacheNotRestoredReason = "EmbedderAppBannerManager"
+	BackForwardCacheNotRestoredReasonEmbedderDOMDistillerViewerSource                         BackForwardCacheNotRestoredReason = "EmbedderDomDistillerViewerSource"
+	BackForwardCacheNotRestoredReasonEmbedderDOMDistillerSelfDeletingRequestDelegate          BackForwardCacheNotRestoredReason = "EmbedderDomDistillerSelfDeletingRequestDelegate"
+	BackForwardCacheNotRestoredReasonEmbedderOomInterventionTabHelper                         BackForwardCacheNotRestoredReason = "EmbedderOomInterventionTabHelper"
+	BackForwardCacheNotRestoredReasonEmbedderOfflinePage                                      BackForwardCacheNotRestoredReason = "EmbedderOfflinePage"
+	BackForwardCacheNotRestoredReasonEmbedderChromePasswordManagerClientBindCredentialManager BackForwardCacheNotRestoredReason = "EmbedderChromePasswordManagerClientBindCredentialManager"
+	BackForwardCacheNotRestoredReasonEmbedderPermissionRequestManager                         BackForwardCacheNotRestoredReason = "EmbedderPermissionRequestManager"
+	BackForwardCacheNotRestoredReasonEmbedderModalDialog                                      BackForwardCacheNotRestoredReason = "EmbedderModalDialog"
+	BackForwardCacheNotRestoredReasonEmbedderExtensions                                       BackForwardCacheNotRestoredReason = "EmbedderExtensions"
+	BackForwardCacheNotRestoredReasonEmbedderExtensionMessaging                               BackForwardCacheNotRestoredReason = "EmbedderExtensionMessaging"
+	BackForwardCacheNotRestoredReasonEmbedderExtensionMessagingForOpenPort                    BackForwardCacheNotRestoredReason = "EmbedderExtensionMessagingForOpenPort"
+	BackForwardCacheNotRestoredReasonEmbedderExtensionSentMessageToCachedFrame                BackForwardCacheNotRestoredReason = "EmbedderExtensionSentMessageToCachedFrame"
+	BackForwardCacheNotRestoredReasonRequestedByWebViewClient                                 BackForwardCacheNotRestoredReason = "RequestedByWebViewClient"
+)
+
+// BackForwardCacheNotRestoredReasonType is the Page.BackForwardCacheNotRestoredReasonType type.
+//
+// Types of not restored reasons for back-forward cache.
+//
+// Experimental.
+type BackForwardCacheNotRestoredReasonType string
+
+const (
+	BackForwardCacheNotRestoredReasonTypeSupportPending    BackForwardCacheNotRestoredReasonType = "SupportPending"
+	BackForwardCacheNotRestoredReasonTypePageSupportNeeded BackForwardCacheNotRestoredReasonType = "PageSupportNeeded"
+	BackForwardCacheNotRestoredReasonTypeCircumstantial    BackForwardCacheNotRestoredReasonType = "Circumstantial"
+)
+
+// BackForwardCacheBlockingDetails is the Page.BackForwardCacheBlockingDetails type.
+//
+// Experimental.
+type BackForwardCacheBlockingDetails struct {
+	// Url of the file where blockage happened. Optional because of tests.
+	URL string `json:"url,omitempty"`
+	// Function name where blockage happened. Optional because of anonymous functions and tests.
+	Function string `json:"function,omitempty"`
+	// Line number in the script (0-based).
+	LineNumber int64 `json:"lineNumber"`
+	// Column number in the script (0-based).
+	ColumnNumber int64 `json:"columnNumber"`
+}
+
+// BackForwardCacheNotRestoredExplanation is the Page.BackForwardCacheNotRestoredExplanation type.
+//
+// Experimental.
+type BackForwardCacheNotRestoredExplanation struct {
+	// Type of the reason
+	Type BackForwardCacheNotRestoredReasonType `json:"type"`
+	// Not restored reason
+	Reason BackForwardCacheNotRestoredReason `json:"reason"`
+	// Context associated with the reason. The meaning of this context is
+	// dependent on the reason:
+	// - EmbedderExtensionSentMessageToCachedFrame: the extension ID.
+	Context string                            `json:"context,omitempty"`
+	Details []BackForwardCacheBlockingDetails `json:"details,omitempty"`
+}
+
+// BackForwardCacheNotRestoredExplanationTree is the Page.BackForwardCacheNotRestoredExplanationTree type.
+//
+// Experimental.
+type BackForwardCacheNotRestoredExplanationTree struct {
+	// URL of each frame
+	URL string `json:"url"`
+	// Not restored reasons of each frame
+	Explanations []BackForwardCacheNotRestoredExplanation `json:"explanations"`
+	// Array of children frame
+	Children []BackForwardCacheNotRestoredExplanationTree `json:"children"`
+}
+
+// AddScriptToEvaluateOnLoadParams holds the parameters of Page.addScriptToEvaluateOnLoad.
+type AddScriptToEvaluateOnLoadParams struct {
+	ScriptSource string `json:"scriptSource"`
+}
+
+// AddScriptToEvaluateOnLoadResult holds the result of Page.addScriptToEvaluateOnLoad.
+type AddScriptToEvaluateOnLoadResult struct {
+	// Identifier of the added script.
+	Identifier ScriptIdentifier `json:"identifier"`
+}
+
+// AddScriptToEvaluateOnLoad calls Page.addScriptToEvaluateOnLoad.
+//
+// Deprecated, please use addScriptToEvaluateOnNewDocument instead.
+//
+// Experimental.
+//
+// Deprecated: this item is deprecated in the protocol.
+func AddScriptToEvaluateOnLoad(ctx context.Context, c *internal.Client, sessionID string, params *AddScriptToEvaluateOnLoadParams) (*AddScriptToEvaluateOnLoadResult, error) {
+	if params == nil {
+		params = &AddScriptToEvaluateOnLoadParams{}
+	}
+	var result AddScriptToEvaluateOnLoadResult
+	err := c.Call(ctx, "Page.addScriptToEvaluateOnLoad", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// AddScriptToEvaluateOnNewDocumentParams holds the parameters of Page.addScriptToEvaluateOnNewDocument.
+type AddScriptToEvaluateOnNewDocumentParams struct {
+	Source string `json:"source"`
+	// If specified, creates an isolated world with the given name and evaluates given script in it.
+	// This world name will be used as the ExecutionContextDescription::name when the corresponding
+	// event is emitted.
+	//
+	// Experimental.
+	WorldName string `json:"worldName,omitempty"`
+	// Specifies whether command line API should be available to the script, defaults
+	// to false.
+	//
+	// Experimental.
+	IncludeCommandLineAPI *bool `json:"includeCommandLineAPI,omitempty"`
+	// If true, runs the script immediately on existing execution contexts or worlds.
+	// Default: false.
+	//
+	// Experimental.
+	RunImmediately *bool `json:"runImmediately,omitempty"`
+}
+
+// AddScriptToEvaluateOnNewDocumentResult holds the result of Page.addScriptToEvaluateOnNewDocument.
+type AddScriptToEvaluateOnNewDocumentResult struct {
+	// Identifier of the added script.
+	Identifier ScriptIdentifier `json:"identifier"`
+}
+
+// AddScriptToEvaluateOnNewDocument calls Page.addScriptToEvaluateOnNewDocument.
+//
+// Evaluates given script in every frame upon creation (before loading frame's scripts).
+func AddScriptToEvaluateOnNewDocument(ctx context.Context, c *internal.Client, sessionID string, params *AddScriptToEvaluateOnNewDocumentParams) (*AddScriptToEvaluateOnNewDocumentResult, error) {
+	if params == nil {
+		params = &AddScriptToEvaluateOnNewDocumentParams{}
+	}
+	var result AddScriptToEvaluateOnNewDocumentResult
+	err := c.Call(ctx, "Page.addScriptToEvaluateOnNewDocument", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// BringToFront calls Page.bringToFront.
+//
+// Brings page to front (activates tab).
+func BringToFront(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Page.bringToFront", nil, sessionID, nil)
+}
+
+// CaptureScreenshotParams holds the parameters of Page.captureScreenshot.
+type CaptureScreenshotParams struct {
+	// Image compression format (defaults to png).
+	Format string `json:"format,omitempty"`
+	// Compression quality from range [0..100] (jpeg only).
+	Quality *int64 `json:"quality,omitempty"`
+	// Capture the screenshot of a given region only.
+	Clip *Viewport `json:"clip,omitempty"`
+	// Capture the screenshot from the surface, rather than the view. Defaults to true.
+	//
+	// Experimental.
+	FromSurface *bool `json:"fromSurface,omitempty"`
+	// Capture the screenshot beyond the viewport. Defaults to false.
+	//
+	// Experimental.
+	CaptureBeyondViewport *bool `json:"captureBeyondViewport,omitempty"`
+	// Optimize image encoding for speed, not for resulting size (defaults to false)
+	//
+	// Experimental.
+	OptimizeForSpeed *bool `json:"optimizeForSpeed,omitempty"`
+}
+
+// CaptureScreenshotResult holds the result of Page.captureScreenshot.
+type CaptureScreenshotResult struct {
+	// Base64-encoded image data. (Encoded as a base64 string when passed over JSON)
+	Data string `json:"data"`
+}
+
+// CaptureScreenshot calls Page.captureScreenshot.
+//
+// Capture page screenshot.
+func CaptureScreenshot(ctx context.Context, c *internal.Client, sessionID string, params *CaptureScreenshotParams) (*CaptureScreenshotResult, error) {
+	if params == nil {
+		params = &CaptureScreenshotParams{}
+	}
+	var result CaptureScreenshotResult
+	err := c.Call(ctx, "Page.captureScreenshot", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CaptureSnapshotParams holds the parameters of Page.captureSnapshot.
+type CaptureSnapshotParams struct {
+	// Format (defaults to mhtml).
+	Format string `json:"format,omitempty"`
+}
+
+// CaptureSnapshotResult holds the result of Page.captureSnapshot.
+type CaptureSnapshotResult struct {
+	// Serialized page data.
+	Data string `json:"data"`
+}
+
+// CaptureSnapshot calls Page.captureSnapshot.
+//
+// Returns a snapshot of the page as a string. For MHTML format, the serialization includes
+// iframes, shadow DOM, external resources, and element-inline styles.
+//
+// Experimental.
+func CaptureSnapshot(ctx context.Context, c *internal.Client, sessionID string, params *CaptureSnapshotParams) (*CaptureSnapshotResult, error) {
+	if params == nil {
+		params = &CaptureSnapshotParams{}
+	}
+	var result CaptureSnapshotResult
+	err := c.Call(ctx, "Page.captureSnapshot", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ClearDeviceMetricsOverride calls Page.clearDeviceMetricsOverride.
+//
+// Clears the overridden device metrics.
+//
+// Experimental.
+//
+// Deprecated: this item is deprecated in the protocol.
+//
+// Redirects to Emulation.
+func ClearDeviceMetricsOverride(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Page.clearDeviceMetricsOverride", nil, sessionID, nil)
+}
+
+// ClearDeviceOrientationOverride calls Page.clearDeviceOrientationOverride.
+//
+// Clears the overridden Device Orientation.
+//
+// Experimental.
+//
+// Deprecated: this item is deprecated in the protocol.
+//
+// Redirects to DeviceOrientation.
+func ClearDeviceOrientationOverride(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Page.clearDeviceOrientationOverride", nil, sessionID, nil)
+}
+
+// ClearGeolocationOverride calls Page.clearGeolocationOverride.
+//
+// Clears the overridden Geolocation Position and Error.
+//
+// Deprecated: this item is deprecated in the protocol.
+//
+// Redirects to Emulation.
+func ClearGeolocationOverride(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Page.clearGeolocationOverride", nil, sessionID, nil)
+}
+
+// CreateIsolatedWorldParams holds the parameters of Page.createIsolatedWorld.
+type CreateIsolatedWorldParams struct {
+	// Id of the frame in which the isolated world should be created.
+	FrameID FrameID `json:"frameId"`
+	// An optional name which is reported in the Execution Context.
+	WorldName string `json:"worldName,omitempty"`
+	// Whether or not universal access should be granted to the isolated world. This is a powerful
+	// option, use with caution.
+	GrantUniveralAccess *bool `json:"grantUniveralAccess,omitempty"`
+}
+
+// CreateIsolatedWorldResult holds the result of Page.createIsolatedWorld.
+type CreateIsolatedWorldResult struct {
+	// Execution context of the isolated world.
+	ExecutionContextID runtime.ExecutionContextID `json:"executionContextId"`
+}
+
+// CreateIsolatedWorld calls Page.createIsolatedWorld.
+//
+// Creates an isolated world for the given frame.
+func CreateIsolatedWorld(ctx context.Context, c *internal.Client, sessionID string, params *CreateIsolatedWorldParams) (*CreateIsolatedWorldResult, error) {
+	if params == nil {
+		params = &CreateIsolatedWorldParams{}
+	}
+	var result CreateIsolatedWorldResult
+	err := c.Call(ctx, "Page.createIsolatedWorld", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteCookieParams holds the parameters of Page.deleteCookie.
+type DeleteCookieParams struct {
+	// Name of the cookie to remove.
+	CookieName string `json:"cookieName"`
+	// URL to match cooke domain and path.
+	URL string `json:"url"`
+}
+
+// DeleteCookie calls Page.deleteCookie.
+//
+// Deletes browser cookie with given name, domain and path.
+//
+// Experimental.
+//
+// Deprecated: this item is deprecated in the protocol.
+//
+// Redirects to Network.
+func DeleteCookie(ctx context.Context, c *internal.Client, sessionID string, params *DeleteCookieParams) error {
+	if params == nil {
+		params = &DeleteCookieParams{}
+	}
+	return c.Call(ctx, "Page.deleteCookie", params, sessionID, nil)
+}
+
+// Disable calls Page.disable.
+//
+// Disables page domain notifications.
+func Disable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Page.disable", nil, sessionID, nil)
+}
+
+// Enable calls Page.enable.
+//
+// Enables page domain notifications.
+func Enable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Page.enable", nil, sessionID, nil)
+}
+
+// GetAppManifestParams holds the parameters of Page.getAppManifest.
+type GetAppManifestParams struct {
+	ManifestID string `json:"manifestId,omitempty"`
+}
+
+// GetAppManifestResult holds the result of Page.getAppManifest.
+type GetAppManifestResult struct {
+	// Manifest location.
+	URL    string             `json:"url"`
+	Errors []AppManifestError `json:"errors"`
+	// Manifest content.
+	Data string `json:"data,omitempty"`
+	// Parsed manifest properties. Deprecated, use manifest instead.
+	//
+	// Experimental.
+	//
+	// Deprecated: this item is deprecated in the protocol.
+	Parsed *AppManifestParsedProperties `json:"parsed,omitempty"`
+	// Experimental.
+	Manifest WebAppManifest `json:"manifest"`
+}
+
+// GetAppManifest calls Page.getAppManifest.
+//
+// Gets the processed manifest for this current document.
+//
+//	This API always waits for the manifest to be loaded.
+//	If manifestId is provided, and it does not match the manifest of the
+//	  current document, this API errors out.
+//	If there is not a loaded page, this API errors out immediately.
+func GetAppManifest(ctx context.Context, c *internal.Client, sessionID string, params *GetAppManifestParams) (*GetAppManifestResult, error) {
+	if params == nil {
+		params = &GetAppManifestParams{}
+	}
+	var result GetAppManifestResult
+	err := c.Call(ctx, "Page.getAppManifest", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetInstallabilityErrorsResult holds the result of Page.getInstallabilityErrors.
+type GetInstallabilityErrorsResult struct {
+	InstallabilityErrors []InstallabilityError `json:"installabilityErrors"`
+}
+
+// GetInstallabilityErrors calls Page.getInstallabilityErrors.
+//
+// Experimental.
+func GetInstallabilityErrors(ctx context.Context, c *internal.Client, sessionID string) (*GetInstallabilityErrorsResult, error) {
+	var result GetInstallabilityErrorsResult
+	err := c.Call(ctx, "Page.getInstallabilityErrors", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetManifestIconsResult holds the result of Page.getManifestIcons.
+type GetManifestIconsResult struct {
+	PrimaryIcon string `json:"primaryIcon,omitempty"`
+}
+
+// GetManifestIcons calls Page.getManifestIcons.
+//
+// Deprecated because it's not guaranteed that the returned icon is in fact the one used for PWA installation.
+//
+// Experimental.
+//
+// Deprecated: this item is deprecated in the protocol.
+func GetManifestIcons(ctx context.Context, c *internal.Client, sessionID string) (*GetManifestIconsResult, error) {
+	var result GetManifestIconsResult
+	err := c.Call(ctx, "Page.getManifestIcons", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetAppIDResult holds the result of Page.getAppId.
+type GetAppIDResult struct {
+	// App id, either from manifest's id attribute or computed from start_url
+	AppID string `json:"appId,omitempty"`
+	// Recommendation for manifest's id attribute to match current id computed from start_url
+	RecommendedID string `json:"recommendedId,omitempty"`
+}
+
+// GetAppID calls Page.getAppId.
+//
+// Returns the unique (PWA) app id.
+// Only returns values if the feature flag 'WebAppEnableManifestId' is enabled
+//
+// Experimental.
+func GetAppID(ctx context.Context, c *internal.Client, sessionID string) (*GetAppIDResult, error) {
+	var result GetAppIDResult
+	err := c.Call(ctx, "Page.getAppId", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetAdScriptIDParams holds the parameters of Page.getAdScriptId.
+type GetAdScriptIDParams struct {
+	FrameID FrameID `json:"frameId"`
+}
+
+// GetAdScriptIDResult holds the result of Page.getAdScriptId.
+type GetAdScriptIDResult struct {
+	// Identifies the bottom-most script which caused the frame to be labelled
+	// as an ad. Only sent if frame is labelled as an ad and id is available.
+	AdScriptID *AdScriptID `json:"adScriptId,omitempty"`
+}
+
+// GetAdScriptID calls Page.getAdScriptId.
+//
+// Experimental.
+func GetAdScriptID(ctx context.Context, c *internal.Client, sessionID string, params *GetAdScriptIDParams) (*GetAdScriptIDResult, error) {
+	if params == nil {
+		params = &GetAdScriptIDParams{}
+	}
+	var result GetAdScriptIDResult
+	err := c.Call(ctx, "Page.getAdScriptId", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetFrameTreeResult holds the result of Page.getFrameTree.
+type GetFrameTreeResult struct {
+	// Present frame tree structure.
+	FrameTree FrameTree `json:"frameTree"`
+}
+
+// GetFrameTree calls Page.getFrameTree.
+//
+// Returns present frame tree structure.
+func GetFrameTree(ctx context.Context, c *internal.Client, sessionID string) (*GetFrameTreeResult, error) {
+	var result GetFrameTreeResult
+	err := c.Call(ctx, "Page.getFrameTree", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetLayoutMetricsResult holds the result of Page.getLayoutMetrics.
+type GetLayoutMetricsResult struct {
+	// Deprecated metrics relating to the layout viewport. Is in device pixels. Use `cssLayoutViewport` instead.
+	//
+	// Deprecated: this item is deprecated in the protocol.
+	LayoutViewport LayoutViewport `json:"layoutViewport"`
+	// Deprecated metrics relating to the visual viewport. Is in device pixels. Use `cssVisualViewport` instead.
+	//
+	// Deprecated: this item is deprecated in the protocol.
+	VisualViewport VisualViewport `json:"visualViewport"`
+	// Deprecated size of scrollable area. Is in DP. Use `cssContentSize` instead.
+	//
+	// Deprecated: this item is deprecated in the protocol.
+	ContentSize dom.Rect `json:"contentSize"`
+	// Metrics relating to the layout viewport in CSS pixels.
+	CSSLayoutViewport LayoutViewport `json:"cssLayoutViewport"`
+	// Metrics relating to the visual viewport in CSS pixels.
+	CSSVisualViewport VisualViewport `json:"cssVisualViewport"`
+	// Size of scrollable area in CSS pixels.
+	CSSContentSize dom.Rect `json:"cssContentSize"`
+}
+
+// GetLayoutMetrics calls Page.getLayoutMetrics.
+//
+// Returns metrics relating to the layouting of the page, such as viewport bounds/scale.
+func GetLayoutMetrics(ctx context.Context, c *internal.Client, sessionID string) (*GetLayoutMetricsResult, error) {
+	var result GetLayoutMetricsResult
+	err := c.Call(ctx, "Page.getLayoutMetrics", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetNavigationHistoryResult holds the result of Page.getNavigationHistory.
+type GetNavigationHistoryResult struct {
+	// Index of the current navigation history entry.
+	CurrentIndex int64 `json:"currentIndex"`
+	// Array of navigation history entries.
+	Entries []NavigationEntry `json:"entries"`
+}
+
+// GetNavigationHistory calls Page.getNavigationHistory.
+//
+// Returns navigation history for the current page.
+func GetNavigationHistory(ctx context.Context, c *internal.Client, sessionID string) (*GetNavigationHistoryResult, error) {
+	var result GetNavigationHistoryResult
+	err := c.Call(ctx, "Page.getNavigationHistory", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ResetNavigationHistory calls Page.resetNavigationHistory.
+//
+// Resets navigation history for the current page.
+func ResetNavigationHistory(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Page.resetNavigationHistory", nil, sessionID, nil)
+}
+
+// GetResourceContentParams holds the parameters of Page.getResourceContent.
+type GetResourceContentParams struct {
+	// Frame id to get resource for.
+	FrameID FrameID `json:"frameId"`
+	// URL of the resource to get content for.
+	URL string `json:"url"`
+}
+
+// GetResourceContentResult holds the result of Page.getResourceContent.
+type GetResourceContentResult struct {
+	// Resource content.
+	Content string `json:"content"`
+	// True, if content was served as base64.
+	Base64Encoded bool `json:"base64Encoded"`
+}
+
+// GetResourceContent calls Page.getResourceContent.
+//
+// Returns content of the given resource.
+//
+// Experimental.
+func GetResourceContent(ctx context.Context, c *internal.Client, sessionID string, params *GetResourceContentParams) (*GetResourceContentResult, error) {
+	if params == nil {
+		params = &GetResourceContentParams{}
+	}
+	var result GetResourceContentResult
+	err := c.Call(ctx, "Page.getResourceContent", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetResourceTreeResult holds the result of Page.getResourceTree.
+type GetResourceTreeResult struct {
+	// Present frame / resource tree structure.
+	FrameTree FrameResourceTree `json:"frameTree"`
+}
+
+// GetResourceTree calls Page.getResourceTree.
+//
+// Returns present frame / resource tree structure.
+//
+// Experimental.
+func GetResourceTree(ctx context.Context, c *internal.Client, sessionID string) (*GetResourceTreeResult, error) {
+	var result GetResourceTreeResult
+	err := c.Call(ctx, "Page.getResourceTree", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// HandleJavaScriptDialogParams holds the parameters of Page.handleJavaScriptDialog.
+type HandleJavaScriptDialogParams struct {
+	// Whether to accept or dismiss the dialog.
+	Accept bool `json:"accept"`
+	// The text to enter into the dialog prompt before accepting. Used only if this is a prompt
+	// dialog.
+	PromptText string `json:"promptText,omitempty"`
+}
+
+// HandleJavaScriptDialog calls Page.handleJavaScriptDialog.
+//
+// Accepts or dismisses a JavaScript initiated dialog (alert, confirm, prompt, or onbeforeunload).
+func HandleJavaScriptDialog(ctx context.Context, c *internal.Client, sessionID string, params *HandleJavaScriptDialogParams) error {
+	if params == nil {
+		params = &HandleJavaScriptDialogParams{}
+	}
+	return c.Call(ctx, "Page.handleJavaScriptDialog", params, sessionID, nil)
+}
+
+// NavigateParams holds the parameters of Page.navigate.
+type NavigateParams struct {
+	// URL to navigate the page to.
+	URL string `json:"url"`
+	// Referrer URL.
+	Referrer string `json:"referrer,omitempty"`
+	// Intended transition type.
+	TransitionType TransitionType `json:"transitionType,omitempty"`
+	// Frame id to navigate, if not specified navigates the top frame.
+	FrameID FrameID `json:"frameId,omitempty"`
+	// Referrer-policy used for the navigation.
+	//
+	// Experimental.
+	ReferrerPolicy ReferrerPolicy `json:"referrerPolicy,omitempty"`
+}
+
+// NavigateResult holds the result of Page.navigate.
+type NavigateResult struct {
+	// Frame id that has navigated (or failed to navigate)
+	FrameID FrameID `json:"frameId"`
+	// Loader identifier. This is omitted in case of same-document navigation,
+	// as the previously committed loaderId would not change.
+	LoaderID string `json:"loaderId,omitempty"`
+	// User friendly error message, present if and only if navigation has failed.
+	ErrorText string `json:"errorText,omitempty"`
+}
+
+// Navigate calls Page.navigate.
+//
+// Navigates current page to the given URL.
+func Navigate(ctx context.Context, c *internal.Client, sessionID string, params *NavigateParams) (*NavigateResult, error) {
+	if params == nil {
+		params = &NavigateParams{}
+	}
+	var result NavigateResult
+	err := c.Call(ctx, "Page.navigate", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// NavigateToHistoryEntryParams holds the parameters of Page.navigateToHistoryEntry.
+type NavigateToHistoryEntryParams struct {
+	// Unique id of the entry to navigate to.
+	EntryID int64 `json:"entryId"`
+}
+
+// NavigateToHistoryEntry calls Page.navigateToHistoryEntry.
+//
+// Navigates current page to the given history entry.
+func NavigateToHistoryEntry(ctx context.Context, c *internal.Client, sessionID string, params *NavigateToHistoryEntryParams) error {
+	if params == nil {
+		params = &NavigateToHistoryEntryParams{}
+	}
+	return c.Call(ctx, "Page.navigateToHistoryEntry", params, sessionID, nil)
+}
+
+// PrintToPDFParams holds the parameters of Page.printToPDF.
+type PrintToPDFParams struct {
+	// Paper orientation. Defaults to false.
+	Landscape *bool `json:"landscape,omitempty"`
+	// Display header and footer. Defaults to false.
+	DisplayHeaderFooter *bool `json:"displayHeaderFooter,omitempty"`
+	// Print background graphics. Defaults to false.
+	PrintBackground *bool `json:"printBackground,omitempty"`
+	// Scale of the webpage rendering. Defaults to 1.
+	Scale *float64 `json:"scale,omitempty"`
+	// Paper width in inches. Defaults to 8.5 inches.
+	PaperWidth *float64 `json:"paperWidth,omitempty"`
+	// Paper height in inches. Defaults to 11 inches.
+	PaperHeight *float64 `json:"paperHeight,omitempty"`
+	// Top margin in inches. Defaults to 1cm (~0.4 inches).
+	MarginTop *float64 `json:"marginTop,omitempty"`
+	// Bottom margin in inches. Defaults to 1cm (~0.4 inches).
+	MarginBottom *float64 `json:"marginBottom,omitempty"`
+	// Left margin in inches. Defaults to 1cm (~0.4 inches).
+	MarginLeft *float64 `json:"marginLeft,omitempty"`
+	// Right margin in inches. Defaults to 1cm (~0.4 inches).
+	MarginRight *float64 `json:"marginRight,omitempty"`
+	// Paper ranges to print, one based, e.g., '1-5, 8, 11-13'. Pages are
+	// printed in the document order, not in the order specified, and no
+	// more than once.
+	// Defaults to empty string, which implies the entire document is printed.
+	// The page numbers are quietly capped to actual page count of the
+	// document, and ranges beyond the end of the document are ignored.
+	// If this results in no pages to print, an error is reported.
+	// It is an error to specify a range with start greater than end.
+	PageRanges string `json:"pageRanges,omitempty"`
+	// HTML template for the print header. Should be valid HTML markup with following
+	// classes used to inject printing values into them:
+	// - `date`: formatted print date
+	// - `title`: document title
+	// - `url`: document location
+	// - `pageNumber`: current page number
+	// - `totalPages`: total pages in the document
+	//
+	// For example, `<span class=title></span>` would generate span containing the title.
+	HeaderTemplate string `json:"headerTemplate,omitempty"`
+	// HTML template for the print footer. Should use the same format as the `headerTemplate`.
+	FooterTemplate string `json:"footerTemplate,omitempty"`
+	// Whether or not to prefer page size as defined by css. Defaults to false,
+	// in which case the content will be scaled to fit the paper size.
+	PreferCSSPageSize *bool `json:"preferCSSPageSize,omitempty"`
+	// return as stream
+	//
+	// Experimental.
+	TransferMode string `json:"transferMode,omitempty"`
+	// Whether or not to generate tagged (accessible) PDF. Defaults to embedder choice.
+	//
+	// Experimental.
+	GenerateTaggedPDF *bool `json:"generateTaggedPDF,omitempty"`
+	// Whether or not to embed the document outline into the PDF.
+	//
+	// Experimental.
+	GenerateDocumentOutline *bool `json:"generateDocumentOutline,omitempty"`
+}
+
+// PrintToPDFResult holds the result of Page.printToPDF.
+type PrintToPDFResult struct {
+	// Base64-encoded pdf data. Empty if |returnAsStream| is specified. (Encoded as a base64 string when passed over JSON)
+	Data string `json:"data"`
+	// A handle of the stream that holds resulting PDF data.
+	//
+	// Experimental.
+	Stream io.StreamHandle `json:"stream,omitempty"`
+}
+
+// PrintToPDF calls Page.printToPDF.
+//
+// Print page as PDF.
+func PrintToPDF(ctx context.Context, c *internal.Client, sessionID string, params *PrintToPDFParams) (*PrintToPDFResult, error) {
+	if params == nil {
+		params = &PrintToPDFParams{}
+	}
+	var result PrintToPDFResult
+	err := c.Call(ctx, "Page.printToPDF", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ReloadParams holds the parameters of Page.reload.
+type ReloadParams struct {
+	// If true, browser cache is ignored (as if the user pressed Shift+refresh).
+	IgnoreCache *bool `json:"ignoreCache,omitempty"`
+	// If set, the script will be injected into all frames of the inspected page after reload.
+	// Argument will be ignored if reloading dataURL origin.
+	ScriptToEvaluateOnLoad string `json:"scriptToEvaluateOnLoad,omitempty"`
+	// If set, an error will be thrown if the target page's main frame's
+	// loader id does not match the provided id. This prevents accidentally
+	// reloading an unintended target in case there's a racing navigation.
+	//
+	// Experimental.
+	LoaderID string `json:"loaderId,omitempty"`
+}
+
+// Reload calls Page.reload.
+//
+// Reloads given page optionally ignoring the cache.
+func Reload(ctx context.Context, c *internal.Client, sessionID string, params *ReloadParams) error {
+	if params == nil {
+		params = &ReloadParams{}
+	}
+	return c.Call(ctx, "Page.reload", params, sessionID, nil)
+}
+
+// RemoveScriptToEvaluateOnLoadParams holds the parameters of Page.removeScriptToEvaluateOnLoad.
+type RemoveScriptToEvaluateOnLoadParams struct {
+	Identifier ScriptIdentifier `json:"identifier"`
+}
+
+// RemoveScriptToEvaluateOnLoad calls Page.removeScriptToEvaluateOnLoad.
+//
+// Deprecated, please use removeScriptToEvaluateOnNewDocument instead.
+//
+// Experimental.
+//
+// Deprecated: this item is deprecated in the protocol.
+func RemoveScriptToEvaluateOnLoad(ctx context.Context, c *internal.Client, sessionID string, params *RemoveScriptToEvaluateOnLoadParams) error {
+	if params == nil {
+		params = &RemoveScriptToEvaluateOnLoadParams{}
+	}
+	return c.Call(ctx, "Page.removeScriptToEvaluateOnLoad", params, sessionID, nil)
+}
+
+// RemoveScriptToEvaluateOnNewDocumentParams holds the parameters of Page.removeScriptToEvaluateOnNewDocument.
+type RemoveScriptToEvaluateOnNewDocumentParams struct {
+	Identifier ScriptIdentifier `json:"identifier"`
+}
+
+// RemoveScriptToEvaluateOnNewDocument calls Page.removeScriptToEvaluateOnNewDocument.
+//
+// Removes given script from the list.
+func RemoveScriptToEvaluateOnNewDocument(ctx context.Context, c *internal.Client, sessionID string, params *RemoveScriptToEvaluateOnNewDocumentParams) error {
+	if params == nil {
+		params = &RemoveScriptToEvaluateOnNewDocumentParams{}
+	}
+	return c.Call(ctx, "Page.removeScriptToEvaluateOnNewDocument", params, sessionID, nil)
+}
+
+// ScreencastFrameAckParams holds the parameters of Page.screencastFrameAck.
+type ScreencastFrameAckParams struct {
+	// Frame number.
+	SessionID int64 `json:"sessionId"`
+}
+
+// ScreencastFrameAck calls Page.screencastFrameAck.
+//
+// Acknowledges that a screencast frame has been received by the frontend.
+//
+// Experimental.
+func ScreencastFrameAck(ctx context.Context, c *internal.Client, sessionID string, params *ScreencastFrameAckParams) error {
+	if params == nil {
+		params = &ScreencastFrameAckParams{}
+	}
+	return c.Call(ctx, "Page.screencastFrameAck", params, sessionID, nil)
+}
+
+// SearchInResourceParams holds the parameters of Page.searchInResource.
+type SearchInResourceParams struct {
+	// Frame id for resource to search in.
+	FrameID FrameID `json:"frameId"`
+	// URL of the resource to search in.
+	URL string `json:"url"`
+	// String to search for.
+	Query string `json:"query"`
+	// If true, search is case sensitive.
+	CaseSensitive *bool `json:"caseSensitive,omitempty"`
+	// If true, treats string parameter as regex.
+	IsRegex *bool `json:"isRegex,omitempty"`
+}
+
+// SearchInResourceResult holds the result of Page.searchInResource.
+type SearchInResourceResult struct {
+	// List of search matches.
+	Result []debugger.SearchMatch `json:"result"`
+}
+
+// SearchInResource calls Page.searchInResource.
+//
+// Searches for given string in resource content.
+//
+// Experimental.
+func SearchInResource(ctx context.Context, c *internal.Client, sessionID string, params *SearchInResourceParams) (*SearchInResourceResult, error) {
+	if params == nil {
+		params = &SearchInResourceParams{}
+	}
+	var result SearchInResourceResult
+	err := c.Call(ctx, "Page.searchInResource", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SetAdBlockingEnabledParams holds the parameters of Page.setAdBlockingEnabled.
+type SetAdBlockingEnabledParams struct {
+	// Whether to block ads.
+	Enabled bool `json:"enabled"`
+}
+
+// SetAdBlockingEnabled calls Page.setAdBlockingEnabled.
+//
+// Enable Chrome's experimental ad filter on all sites.
+//
+// Experimental.
+func SetAdBlockingEnabled(ctx context.Context, c *internal.Client, sessionID string, params *SetAdBlockingEnabledParams) error {
+	if params == nil {
+		params = &SetAdBlockingEnabledParams{}
+	}
+	return c.Call(ctx, "Page.setAdBlockingEnabled", params, sessionID, nil)
+}
+
+// SetBypassCSPParams holds the parameters of Page.setBypassCSP.
+type SetBypassCSPParams struct {
+	// Whether to bypass page CSP.
+	Enabled bool `json:"enabled"`
+}
+
+// SetBypassCSP calls Page.setBypassCSP.
+//
+// Enable page Content Security Policy by-passing.
+func SetBypassCSP(ctx context.Context, c *internal.Client, sessionID string, params *SetBypassCSPParams) error {
+	if params == nil {
+		params = &SetBypassCSPParams{}
+	}
+	return c.Call(ctx, "Page.setBypassCSP", params, sessionID, nil)
+}
+
+// GetPermissionsPolicyStateParams holds the parameters of Page.getPermissionsPolicyState.
+type GetPermissionsPolicyStateParams struct {
+	FrameID FrameID `json:"frameId"`
+}
+
+// GetPermissionsPolicyStateResult holds the result of Page.getPermissionsPolicyState.
+type GetPermissionsPolicyStateResult struct {
+	States []PermissionsPolicyFeatureState `json:"states"`
+}
+
+// GetPermissionsPolicyState calls Page.getPermissionsPolicyState.
+//
+// Get Permissions Policy state on given frame.
+//
+// Experimental.
+func GetPermissionsPolicyState(ctx context.Context, c *internal.Client, sessionID string, params *GetPermissionsPolicyStateParams) (*GetPermissionsPolicyStateResult, error) {
+	if params == nil {
+		params = &GetPermissionsPolicyStateParams{}
+	}
+	var result GetPermissionsPolicyStateResult
+	err := c.Call(ctx, "Page.getPermissionsPolicyState", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetOriginTrialsParams holds the parameters of Page.getOriginTrials.
+type GetOriginTrialsParams struct {
+	FrameID FrameID `json:"frameId"`
+}
+
+// GetOriginTrialsResult holds the result of Page.getOriginTrials.
+type GetOriginTrialsResult struct {
+	OriginTrials []OriginTrial `json:"originTrials"`
+}
+
+// GetOriginTrials calls Page.getOriginTrials.
+//
+// Get Origin Trials on given frame.
+//
+// Experimental.
+func GetOriginTrials(ctx context.Context, c *internal.Client, sessionID string, params *GetOriginTrialsParams) (*GetOriginTrialsResult, error) {
+	if params == nil {
+		params = &GetOriginTrialsParams{}
+	}
+	var result GetOriginTrialsResult
+	err := c.Call(ctx, "Page.getOriginTrials", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SetDeviceMetricsOverrideParams holds the parameters of Page.setDeviceMetricsOverride.
+type SetDeviceMetricsOverrideParams struct {
+	// Overriding width value in pixels (minimum 0, maximum 10000000). 0 disables the override.
+	Width int64 `json:"width"`
+	// Overriding height value in pixels (minimum 0, maximum 10000000). 0 disables the override.
+	Height int64 `json:"height"`
+	// Overriding device scale factor value. 0 disables the override.
+	DeviceScaleFactor float64 `json:"deviceScaleFactor"`
+	// Whether to emulate mobile device. This includes viewport meta tag, overlay scrollbars, text
+	// autosizing and more.
+	Mobile bool `json:"mobile"`
+	// Scale to apply to resulting view image.
+	Scale *float64 `json:"scale,omitempty"`
+	// Overriding screen width value in pixels (minimum 0, maximum 10000000).
+	ScreenWidth *int64 `json:"screenWidth,omitempty"`
+	// Overriding screen height value in pixels (minimum 0, maximum 10000000).
+	ScreenHeight *int64 `json:"screenHeight,omitempty"`
+	// Overriding view X position on screen in pixels (minimum 0, maximum 10000000).
+	PositionX *int64 `json:"positionX,omitempty"`
+	// Overriding view Y position on screen in pixels (minimum 0, maximum 10000000).
+	PositionY *int64 `json:"positionY,omitempty"`
+	// Do not set visible view size, rely upon explicit setVisibleSize call.
+	DontSetVisibleSize *bool `json:"dontSetVisibleSize,omitempty"`
+	// Screen orientation override.
+	ScreenOrientation json.RawMessage `json:"screenOrientation,omitempty"`
+	// The viewport dimensions and scale. If not set, the override is cleared.
+	Viewport *Viewport `json:"viewport,omitempty"`
+}
+
+// SetDeviceMetricsOverride calls Page.setDeviceMetricsOverride.
+//
+// Overrides the values of device screen dimensions (window.screen.width, window.screen.height,
+// window.innerWidth, window.innerHeight, and "device-width"/"device-height"-related CSS media
+// query results).
+//
+// Experimental.
+//
+// Deprecated: this item is deprecated in the protocol.
+//
+// Redirects to Emulation.
+func SetDeviceMetricsOverride(ctx context.Context, c *internal.Client, sessionID string, params *SetDeviceMetricsOverrideParams) error {
+	if params == nil {
+		params = &SetDeviceMetricsOverrideParams{}
+	}
+	return c.Call(ctx, "Page.setDeviceMetricsOverride", params, sessionID, nil)
+}
+
+// SetDeviceOrientationOverrideParams holds the parameters of Page.setDeviceOrientationOverride.
+type SetDeviceOrientationOverrideParams struct {
+	// Mock alpha
+	Alpha float64 `json:"alpha"`
+	// Mock beta
+	Beta float64 `json:"beta"`
+	// Mock gamma
+	Gamma float64 `json:"gamma"`
+}
+
+// SetDeviceOrientationOverride calls Page.setDeviceOrientationOverride.
+//
+// Overrides the Device Orientation.
+//
+// Experimental.
+//
+// Deprecated: this item is deprecated in the protocol.
+//
+// Redirects to DeviceOrientation.
+func SetDeviceOrientationOverride(ctx context.Context, c *internal.Client, sessionID string, params *SetDeviceOrientationOverrideParams) error {
+	if params == nil {
+		params = &SetDeviceOrientationOverrideParams{}
+	}
+	return c.Call(ctx, "Page.setDeviceOrientationOverride", params, sessionID, nil)
+}
+
+// SetFontFamiliesParams holds the parameters of Page.setFontFamilies.
+type SetFontFamiliesParams struct {
+	// Specifies font families to set. If a font family is not specified, it won't be changed.
+	FontFamilies FontFamilies `json:"fontFamilies"`
+	// Specifies font families to set for individual scripts.
+	ForScripts []ScriptFontFamilies `json:"forScripts,omitempty"`
+}
+
+// SetFontFamilies calls Page.setFontFamilies.
+//
+// Set generic font families.
+//
+// Experimental.
+func SetFontFamilies(ctx context.Context, c *internal.Client, sessionID string, params *SetFontFamiliesParams) error {
+	if params == nil {
+		params = &SetFontFamiliesParams{}
+	}
+	return c.Call(ctx, "Page.setFontFamilies", params, sessionID, nil)
+}
+
+// SetFontSizesParams holds the parameters of Page.setFontSizes.
+type SetFontSizesParams struct {
+	// Specifies font sizes to set. If a font size is not specified, it won't be changed.
+	FontSizes FontSizes `json:"fontSizes"`
+}
+
+// SetFontSizes calls Page.setFontSizes.
+//
+// Set default font sizes.
+//
+// Experimental.
+func SetFontSizes(ctx context.Context, c *internal.Client, sessionID string, params *SetFontSizesParams) error {
+	if params == nil {
+		params = &SetFontSizesParams{}
+	}
+	return c.Call(ctx, "Page.setFontSizes", params, sessionID, nil)
+}
+
+// SetDocumentContentParams holds the parameters of Page.setDocumentContent.
+type SetDocumentContentParams struct {
+	// Frame id to set HTML for.
+	FrameID FrameID `json:"frameId"`
+	// HTML content to set.
+	HTML string `json:"html"`
+}
+
+// SetDocumentContent calls Page.setDocumentContent.
+//
+// Sets given markup as the document's HTML.
+func SetDocumentContent(ctx context.Context, c *internal.Client, sessionID string, params *SetDocumentContentParams) error {
+	if params == nil {
+		params = &SetDocumentContentParams{}
+	}
+	return c.Call(ctx, "Page.setDocumentContent", params, sessionID, nil)
+}
+
+// SetDownloadBehaviorParams holds the parameters of Page.setDownloadBehavior.
+type SetDownloadBehaviorParams struct {
+	// Whether to allow all or deny all download requests, or use default Chrome behavior if
+	// available (otherwise deny).
+	Behavior string `json:"behavior"`
+	// The default path to save downloaded files to. This is required if behavior is set to 'allow'
+	DownloadPath string `json:"downloadPath,omitempty"`
+}
+
+// SetDownloadBehavior calls Page.setDownloadBehavior.
+//
+// Set the behavior when downloading a file.
+//
+// Experimental.
+//
+// Deprecated: this item is deprecated in the protocol.
+func SetDownloadBehavior(ctx context.Context, c *internal.Client, sessionID string, params *SetDownloadBehaviorParams) error {
+	if params == nil {
+		params = &SetDownloadBehaviorParams{}
+	}
+	return c.Call(ctx, "Page.setDownloadBehavior", params, sessionID, nil)
+}
+
+// SetGeolocationOverrideParams holds the parameters of Page.setGeolocationOverride.
+type SetGeolocationOverrideParams struct {
+	// Mock latitude
+	Latitude *float64 `json:"latitude,omitempty"`
+	// Mock longitude
+	Longitude *float64 `json:"longitude,omitempty"`
+	// Mock accuracy
+	Accuracy *float64 `json:"accuracy,omitempty"`
+}
+
+// SetGeolocationOverride calls Page.setGeolocationOverride.
+//
+// Overrides the Geolocation Position or Error. Omitting any of the parameters emulates position
+// unavailable.
+//
+// Deprecated: this item is deprecated in the protocol.
+//
+// Redirects to Emulation.
+func SetGeolocationOverride(ctx context.Context, c *internal.Client, sessionID string, params *SetGeolocationOverrideParams) error {
+	if params == nil {
+		params = &SetGeolocationOverrideParams{}
+	}
+	return c.Call(ctx, "Page.setGeolocationOverride", params, sessionID, nil)
+}
+
+// SetLifecycleEventsEnabledParams holds the parameters of Page.setLifecycleEventsEnabled.
+type SetLifecycleEventsEnabledParams struct {
+	// If true, starts emitting lifecycle events.
+	Enabled bool `json:"enabled"`
+}
+
+// SetLifecycleEventsEnabled calls Page.setLifecycleEventsEnabled.
+//
+// Controls whether page will emit lifecycle events.
+func SetLifecycleEventsEnabled(ctx context.Context, c *internal.Client, sessionID string, params *SetLifecycleEventsEnabledParams) error {
+	if params == nil {
+		params = &SetLifecycleEventsEnabledParams{}
+	}
+	return c.Call(ctx, "Page.setLifecycleEventsEnabled", params, sessionID, nil)
+}
+
+// SetTouchEmulationEnabledParams holds the parameters of Page.setTouchEmulationEnabled.
+type SetTouchEmulationEnabledParams struct {
+	// Whether the touch event emulation should be enabled.
+	Enabled bool `json:"enabled"`
+	// Touch/gesture events configuration. Default: current platform.
+	Configuration string `json:"configuration,omitempty"`
+}
+
+// SetTouchEmulationEnabled calls Page.setTouchEmulationEnabled.
+//
+// Toggles mouse event-based touch event emulation.
+//
+// Experimental.
+//
+// Deprecated: this item is deprecated in the protocol.
+//
+// Redirects to Emulation.
+func SetTouchEmulationEnabled(ctx context.Context, c *internal.Client, sessionID string, params *SetTouchEmulationEnabledParams) error {
+	if params == nil {
+		params = &SetTouchEmulationEnabledParams{}
+	}
+	return c.Call(ctx, "Page.setTouchEmulationEnabled", params, sessionID, nil)
+}
+
+// StartScreencastParams holds the parameters of Page.startScreencast.
+type StartScreencastParams struct {
+	// Image compression format.
+	Format string `json:"format,omitempty"`
+	// Compression quality from range [0..100].
+	Quality *int64 `json:"quality,omitempty"`
+	// Maximum screenshot width.
+	MaxWidth *int64 `json:"maxWidth,omitempty"`
+	// Maximum screenshot height.
+	MaxHeight *int64 `json:"maxHeight,omitempty"`
+	// Send every n-th frame.
+	EveryNthFrame *int64 `json:"everyNthFrame,omitempty"`
+}
+
+// StartScreencast calls Page.startScreencast.
+//
+// Starts sending each frame using the `screencastFrame` event.
+//
+// Experimental.
+func StartScreencast(ctx context.Context, c *internal.Client, sessionID string, params *StartScreencastParams) error {
+	if params == nil {
+		params = &StartScreencastParams{}
+	}
+	return c.Call(ctx, "Page.startScreencast", params, sessionID, nil)
+}
+
+// StopLoading calls Page.stopLoading.
+//
+// Force the page stop all navigations and pending resource fetches.
+func StopLoading(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Page.stopLoading", nil, sessionID, nil)
+}
+
+// Crash calls Page.crash.
+//
+// Crashes renderer on the IO thread, generates minidumps.
+//
+// Experimental.
+func Crash(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Page.crash", nil, sessionID, nil)
+}
+
+// Close calls Page.close.
+//
+// Tries to close page, running its beforeunload hooks, if any.
+func Close(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Page.close", nil, sessionID, nil)
+}
+
+// SetWebLifecycleStateParams holds the parameters of Page.setWebLifecycleState.
+type SetWebLifecycleStateParams struct {
+	// Target lifecycle state
+	State string `json:"state"`
+}
+
+// SetWebLifecycleState calls Page.setWebLifecycleState.
+//
+// Tries to update the web lifecycle state of the page.
+// It will transition the page to the given state according to:
+// https://github.com/WICG/web-lifecycle/
+//
+// Experimental.
+func SetWebLifecycleState(ctx context.Context, c *internal.Client, sessionID string, params *SetWebLifecycleStateParams) error {
+	if params == nil {
+		params = &SetWebLifecycleStateParams{}
+	}
+	return c.Call(ctx, "Page.setWebLifecycleState", params, sessionID, nil)
+}
+
+// StopScreencast calls Page.stopScreencast.
+//
+// Stops sending each frame in the `screencastFrame`.
+//
+// Experimental.
+func StopScreencast(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Page.stopScreencast", nil, sessionID, nil)
+}
+
+// ProduceCompilationCacheParams holds the parameters of Page.produceCompilationCache.
+type ProduceCompilationCacheParams struct {
+	Scripts []CompilationCacheParams `json:"scripts"`
+}
+
+// ProduceCompilationCache calls Page.produceCompilationCache.
+//
+// Requests backend to produce compilation cache for the specified scripts.
+// `scripts` are appended to the list of scripts for which the cache
+// would be produced. The list may be reset during page navigation.
+// When script with a matching URL is encountered, the cache is optionally
+// produced upon backend discretion, based on internal heuristics.
+// See also: `Page.compilationCacheProduced`.
+//
+// Experimental.
+func ProduceCompilationCache(ctx context.Context, c *internal.Client, sessionID string, params *ProduceCompilationCacheParams) error {
+	if params == nil {
+		params = &ProduceCompilationCacheParams{}
+	}
+	return c.Call(ctx, "Page.produceCompilationCache", params, sessionID, nil)
+}
+
+// AddCompilationCacheParams holds the parameters of Page.addCompilationCache.
+type AddCompilationCacheParams struct {
+	URL string `json:"url"`
+	// Base64-encoded data (Encoded as a base64 string when passed over JSON)
+	Data string `json:"data"`
+}
+
+// AddCompilationCache calls Page.addCompilationCache.
+//
+// Seeds compilation cache for given url. Compilation cache does not survive
+// cross-process navigation.
+//
+// Experimental.
+func AddCompilationCache(ctx context.Context, c *internal.Client, sessionID string, params *AddCompilationCacheParams) error {
+	if params == nil {
+		params = &AddCompilationCacheParams{}
+	}
+	return c.Call(ctx, "Page.addCompilationCache", params, sessionID, nil)
+}
+
+// ClearCompilationCache calls Page.clearCompilationCache.
+//
+// Clears seeded compilation cache.
+//
+// Experimental.
+func ClearCompilationCache(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Page.clearCompilationCache", nil, sessionID, nil)
+}
+
+// SetSPCTransactionModeParams holds the parameters of Page.setSPCTransactionMode.
+type SetSPCTransactionModeParams struct {
+	Mode AutoResponseMode `json:"mode"`
+}
+
+// SetSPCTransactionMode calls Page.setSPCTransactionMode.
+//
+// Sets the Secure Payment Confirmation transaction mode.
+// https://w3c.github.io/secure-payment-confirmation/#sctn-automation-set-spc-transaction-mode
+//
+// Experimental.
+func SetSPCTransactionMode(ctx context.Context, c *internal.Client, sessionID string, params *SetSPCTransactionModeParams) error {
+	if params == nil {
+		params = &SetSPCTransactionModeParams{}
+	}
+	return c.Call(ctx, "Page.setSPCTransactionMode", params, sessionID, nil)
+}
+
+// SetRPHRegistrationModeParams holds the parameters of Page.setRPHRegistrationMode.
+type SetRPHRegistrationModeParams struct {
+	Mode AutoResponseMode `json:"mode"`
+}
+
+// SetRPHRegistrationMode calls Page.setRPHRegistrationMode.
+//
+// Extensions for Custom Handlers API:
+// https://html.spec.whatwg.org/multipage/system-state.html#rph-automation
+//
+// Experimental.
+func SetRPHRegistrationMode(ctx context.Context, c *internal.Client, sessionID string, params *SetRPHRegistrationModeParams) error {
+	if params == nil {
+		params = &SetRPHRegistrationModeParams{}
+	}
+	return c.Call(ctx, "Page.setRPHRegistrationMode", params, sessionID, nil)
+}
+
+// GenerateTestReportParams holds the parameters of Page.generateTestReport.
+type GenerateTestReportParams struct {
+	// Message to be displayed in the report.
+	Message string `json:"message"`
+	// Specifies the endpoint group to deliver the report to.
+	Group string `json:"group,omitempty"`
+}
+
+// GenerateTestReport calls Page.generateTestReport.
+//
+// Generates a report for testing.
+//
+// Experimental.
+func GenerateTestReport(ctx context.Context, c *internal.Client, sessionID string, params *GenerateTestReportParams) error {
+	if params == nil {
+		params = &GenerateTestReportParams{}
+	}
+	return c.Call(ctx, "Page.generateTestReport", params, sessionID, nil)
+}
+
+// WaitForDebugger calls Page.waitForDebugger.
+//
+// Pauses page execution. Can be resumed using generic Runtime.runIfWaitingForDebugger.
+//
+// Experimental.
+func WaitForDebugger(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Page.waitForDebugger", nil, sessionID, nil)
+}
+
+// SetInterceptFileChooserDialogParams holds the parameters of Page.setInterceptFileChooserDialog.
+type SetInterceptFileChooserDialogParams struct {
+	Enabled bool `json:"enabled"`
+}
+
+// SetInterceptFileChooserDialog calls Page.setInterceptFileChooserDialog.
+//
+// Intercept file chooser requests and transfer control to protocol clients.
+// When file chooser interception is enabled, native file chooser dialog is not shown.
+// Instead, a protocol event `Page.fileChooserOpened` is emitted.
+func SetInterceptFileChooserDialog(ctx context.Context, c *internal.Client, sessionID string, params *SetInterceptFileChooserDialogParams) error {
+	if params == nil {
+		params = &SetInterceptFileChooserDialogParams{}
+	}
+	return c.Call(ctx, "Page.setInterceptFileChooserDialog", params, sessionID, nil)
+}
+
+// SetPrerenderingAllowedParams holds the parameters of Page.setPrerenderingAllowed.
+type SetPrerenderingAllowedParams struct {
+	IsAllowed bool `json:"isAllowed"`
+}
+
+// SetPrerenderingAllowed calls Page.setPrerenderingAllowed.
+//
+// Enable/disable prerendering manually.
+//
+// This command is a short-term solution for https://crbug.com/1440085.
+// See https://docs.google.com/document/d/12HVmFxYj5Jc-eJr5OmWsa2bqTJsbgGLKI6ZIyx0_wpA
+// for more details.
+//
+// TODO(https://crbug.com/1440085): Remove this once Puppeteer supports tab targets.
+//
+// Experimental.
+func SetPrerenderingAllowed(ctx context.Context, c *internal.Client, sessionID string, params *SetPrerenderingAllowedParams) error {
+	if params == nil {
+		params = &SetPrerenderingAllowedParams{}
+	}
+	return c.Call(ctx, "Page.setPrerenderingAllowed", params, sessionID, nil)
+}
+
+// EventDOMContentEventFired is the method name of the Page.domContentEventFired event.
+const EventDOMContentEventFired = "Page.domContentEventFired"
+
+// DOMContentEventFiredEvent is the payload of the Page.domContentEventFired event.
+type DOMContentEventFiredEvent struct {
+	Timestamp float64 `json:"timestamp"`
+}
+
+// EventFileChooserOpened is the method name of the Page.fileChooserOpened event.
+const EventFileChooserOpened = "Page.fileChooserOpened"
+
+// FileChooserOpenedEvent is the payload of the Page.fileChooserOpened event.
+//
+// Emitted only when `page.interceptFileChooser` is enabled.
+type FileChooserOpenedEvent struct {
+	// Id of the frame containing input node.
+	//
+	// Experimental.
+	FrameID FrameID `json:"frameId"`
+	// Input mode.
+	Mode string `json:"mode"`
+	// Input node id. Only present for file choosers opened via an `<input type="file">` element.
+	//
+	// Experimental.
+	BackendNodeID *dom.BackendNodeID `json:"backendNodeId,omitempty"`
+}
+
+// EventFrameAttached is the method name of the Page.frameAttached event.
+const EventFrameAttached = "Page.frameAttached"
+
+// FrameAttachedEvent is the payload of the Page.frameAttached event.
+//
+// Fired when frame has been attached to its parent.
+type FrameAttachedEvent struct {
+	// Id of the frame that has been attached.
+	FrameID FrameID `json:"frameId"`
+	// Parent frame identifier.
+	ParentFrameID FrameID `json:"parentFrameId"`
+	// JavaScript stack trace of when frame was attached, only set if frame initiated from script.
+	Stack *runtime.StackTrace `json:"stack,omitempty"`
+}
+
+// EventFrameClearedScheduledNavigation is the method name of the Page.frameClearedScheduledNavigation event.
+const EventFrameClearedScheduledNavigation = "Page.frameClearedScheduledNavigation"
+
+// FrameClearedScheduledNavigationEvent is the payload of the Page.frameClearedScheduledNavigation event.
+//
+// Fired when frame no longer has a scheduled navigation.
+//
+// Deprecated: this item is deprecated in the protocol.
+type FrameClearedScheduledNavigationEvent struct {
+	// Id of the frame that has cleared its scheduled navigation.
+	FrameID FrameID `json:"frameId"`
+}
+
+// EventFrameDetached is the method name of the Page.frameDetached event.
+const EventFrameDetached = "Page.frameDetached"
+
+// FrameDetachedEvent is the payload of the Page.frameDetached event.
+//
+// Fired when frame has been detached from its parent.
+type FrameDetachedEvent struct {
+	// Id of the frame that has been detached.
+	FrameID FrameID `json:"frameId"`
+	// Experimental.
+	Reason string `json:"reason"`
+}
+
+// EventFrameSubtreeWillBeDetached is the method name of the Page.frameSubtreeWillBeDetached event.
+const EventFrameSubtreeWillBeDetached = "Page.frameSubtreeWillBeDetached"
+
+// FrameSubtreeWillBeDetachedEvent is the payload of the Page.frameSubtreeWillBeDetached event.
+//
+// Fired before frame subtree is detached. Emitted before any frame of the
+// subtree is actually detached.
+//
+// Experimental.
+type FrameSubtreeWillBeDetachedEvent struct {
+	// Id of the frame that is the root of the subtree that will be detached.
+	FrameID FrameID `json:"frameId"`
+}
+
+// EventFrameNavigated is the method name of the Page.frameNavigated event.
+const EventFrameNavigated = "Page.frameNavigated"
+
+// FrameNavigatedEvent is the payload of the Page.frameNavigated event.
+//
+// Fired once navigation of the frame has completed. Frame is now associated with the new loader.
+type FrameNavigatedEvent struct {
+	// Frame object.
+	Frame Frame `json:"frame"`
+	// Experimental.
+	Type NavigationType `json:"type"`
+}
+
+// EventDocumentOpened is the method name of the Page.documentOpened event.
+const EventDocumentOpened = "Page.documentOpened"
+
+// DocumentOpenedEvent is the payload of the Page.documentOpened event.
+//
+// Fired when opening document to write to.
+//
+// Experimental.
+type DocumentOpenedEvent struct {
+	// Frame object.
+	Frame Frame `json:"frame"`
+}
+
+// EventFrameResized is the method name of the Page.frameResized event.
+const EventFrameResized = "Page.frameResized"
+
+// FrameResizedEvent is the payload of the Page.frameResized event.
+//
+// Experimental.
+type FrameResizedEvent struct{}
+
+// EventFrameRequestedNavigation is the method name of the Page.frameRequestedNavigation event.
+const EventFrameRequestedNavigation = "Page.frameRequestedNavigation"
+
+// FrameRequestedNavigationEvent is the payload of the Page.frameRequestedNavigation event.
+//
+// Fired when a renderer-initiated navigation is requested.
+// Navigation may still be cancelled after the event is issued.
+//
+// Experimental.
+type FrameRequestedNavigationEvent struct {
+	// Id of the frame that is being navigated.
+	FrameID FrameID `json:"frameId"`
+	// The reason for the navigation.
+	Reason ClientNavigationReason `json:"reason"`
+	// The destination URL for the requested navigation.
+	URL string `json:"url"`
+	// The disposition for the navigation.
+	Disposition ClientNavigationDisposition `json:"disposition"`
+}
+
+// EventFrameScheduledNavigation is the method name of the Page.frameScheduledNavigation event.
+const EventFrameScheduledNavigation = "Page.frameScheduledNavigation"
+
+// FrameScheduledNavigationEvent is the payload of the Page.frameScheduledNavigation event.
+//
+// Fired when frame schedules a potential navigation.
+//
+// Deprecated: this item is deprecated in the protocol.
+type FrameScheduledNavigationEvent struct {
+	// Id of the frame that has scheduled a navigation.
+	FrameID FrameID `json:"frameId"`
+	// Delay (in seconds) until the navigation is scheduled to begin. The navigation is not
+	// guaranteed to start.
+	Delay float64 `json:"delay"`
+	// The reason for the navigation.
+	Reason ClientNavigationReason `json:"reason"`
+	// The destination URL for the scheduled navigation.
+	URL string `json:"url"`
+}
+
+// EventFrameStartedLoading is the method name of the Page.frameStartedLoading event.
+const EventFrameStartedLoading = "Page.frameStartedLoading"
+
+// FrameStartedLoadingEvent is the payload of the Page.frameStartedLoading event.
+//
+// Fired when frame has started loading.
+//
+// Experimental.
+type FrameStartedLoadingEvent struct {
+	// Id of the frame that has started loading.
+	FrameID FrameID `json:"frameId"`
+}
+
+// EventFrameStoppedLoading is the method name of the Page.frameStoppedLoading event.
+const EventFrameStoppedLoading = "Page.frameStoppedLoading"
+
+// FrameStoppedLoadingEvent is the payload of the Page.frameStoppedLoading event.
+//
+// Fired when frame has stopped loading.
+//
+// Experimental.
+type FrameStoppedLoadingEvent struct {
+	// Id of the frame that has stopped loading.
+	FrameID FrameID `json:"frameId"`
+}
+
+// EventDownloadWillBegin is the method name of the Page.downloadWillBegin event.
+const EventDownloadWillBegin = "Page.downloadWillBegin"
+
+// DownloadWillBeginEvent is the payload of the Page.downloadWillBegin event.
+//
+// Fired when page is about to start a download.
+// Deprecated. Use Browser.downloadWillBegin instead.
+//
+// Experimental.
+//
+// Deprecated: this item is deprecated in the protocol.
+type DownloadWillBeginEvent struct {
+	// Id of the frame that caused download to begin.
+	FrameID FrameID `json:"frameId"`
+	// Global unique identifier of the download.
+	Guid string `json:"guid"`
+	// URL of the resource being downloaded.
+	URL string `json:"url"`
+	// Suggested file name of the resource (the actual name of the file saved on disk may differ).
+	SuggestedFilename string `json:"suggestedFilename"`
+}
+
+// EventDownloadProgress is the method name of the Page.downloadProgress event.
+const EventDownloadProgress = "Page.downloadProgress"
+
+// DownloadProgressEvent is the payload of the Page.downloadProgress event.
+//
+// Fired when download makes progress. Last call has |done| == true.
+// Deprecated. Use Browser.downloadProgress instead.
+//
+// Experimental.
+//
+// Deprecated: this item is deprecated in the protocol.
+type DownloadProgressEvent struct {
+	// Global unique identifier of the download.
+	Guid string `json:"guid"`
+	// Total expected bytes to download.
+	TotalBytes float64 `json:"totalBytes"`
+	// Total bytes received.
+	ReceivedBytes float64 `json:"receivedBytes"`
+	// Download status.
+	State string `json:"state"`
+}
+
+// EventInterstitialHidden is the method name of the Page.interstitialHidden event.
+const EventInterstitialHidden = "Page.interstitialHidden"
+
+// InterstitialHiddenEvent is the payload of the Page.interstitialHidden event.
+//
+// Fired when interstitial page was hidden
+type InterstitialHiddenEvent struct{}
+
+// EventInterstitialShown is the method name of the Page.interstitialShown event.
+const EventInterstitialShown = "Page.interstitialShown"
+
+// InterstitialShownEvent is the payload of the Page.interstitialShown event.
+//
+// Fired when interstitial page was shown
+type InterstitialShownEvent struct{}
+
+// EventJavascriptDialogClosed is the method name of the Page.javascriptDialogClosed event.
+const EventJavascriptDialogClosed = "Page.javascriptDialogClosed"
+
+// JavascriptDialogClosedEvent is the payload of the Page.javascriptDialogClosed event.
+//
+// Fired when a JavaScript initiated dialog (alert, confirm, prompt, or onbeforeunload) has been
+// closed.
+type JavascriptDialogClosedEvent struct {
+	// Whether dialog was confirmed.
+	Result bool `json:"result"`
+	// User input in case of prompt.
+	UserInput string `json:"userInput"`
+}
+
+// EventJavascriptDialogOpening is the method name of the Page.javascriptDialogOpening event.
+const EventJavascriptDialogOpening = "Page.javascriptDialogOpening"
+
+// JavascriptDialogOpeningEvent is the payload of the Page.javascriptDialogOpening event.
+//
+// Fired when a JavaScript initiated dialog (alert, confirm, prompt, or onbeforeunload) is about to
+// open.
+type JavascriptDialogOpeningEvent struct {
+	// Frame url.
+	URL string `json:"url"`
+	// Message that will be displayed by the dialog.
+	Message string `json:"message"`
+	// Dialog type.
+	Type DialogType `json:"type"`
+	// True iff browser is capable showing or acting on the given dialog. When browser has no
+	// dialog handler for given target, calling alert while Page domain is engaged will stall
+	// the page execution. Execution can be resumed via calling Page.handleJavaScriptDialog.
+	HasBrowserHandler bool `json:"hasBrowserHandler"`
+	// Default dialog prompt.
+	DefaultPrompt string `json:"defaultPrompt,omitempty"`
+}
+
+// EventLifecycleEvent is the method name of the Page.lifecycleEvent event.
+const EventLifecycleEvent = "Page.lifecycleEvent"
+
+// LifecycleEventEvent is the payload of the Page.lifecycleEvent event.
+//
+// Fired for top level page lifecycle events such as navigation, load, paint, etc.
+type LifecycleEventEvent struct {
+	// Id of the frame.
+	FrameID FrameID `json:"frameId"`
+	// Loader identifier. Empty string if the request is fetched from worker.
+	LoaderID  string  `json:"loaderId"`
+	Name      string  `json:"name"`
+	Timestamp float64 `json:"timestamp"`
+}
+
+// EventBackForwardCacheNotUsed is the method name of the Page.backForwardCacheNotUsed event.
+const EventBackForwardCacheNotUsed = "Page.backForwardCacheNotUsed"
+
+// BackForwardCacheNotUsedEvent is the payload of the Page.backForwardCacheNotUsed event.
+//
+// Fired for failed bfcache history navigations if BackForwardCache feature is enabled. Do
+// not assume any ordering with the Page.frameNavigated event. This event is fired only for
+// main-frame history navigation where the document changes (non-same-document navigations),
+// when bfcache navigation fails.
+//
+// Experimental.
+type BackForwardCacheNotUsedEvent struct {
+	// The loader id for the associated navigation.
+	LoaderID string `json:"loaderId"`
+	// The frame id of the associated frame.
+	FrameID FrameID `json:"frameId"`
+	// Array of reasons why the page could not be cached. This must not be empty.
+	NotRestoredExplanations []BackForwardCacheNotRestoredExplanation `json:"notRestoredExplanations"`
+	// Tree structure of reasons why the page could not be cached for each frame.
+	NotRestoredExplanationsTree *BackForwardCacheNotRestoredExplanationTree `json:"notRestoredExplanationsTree,omitempty"`
+}
+
+// EventLoadEventFired is the method name of the Page.loadEventFired event.
+const EventLoadEventFired = "Page.loadEventFired"
+
+// LoadEventFiredEvent is the payload of the Page.loadEventFired event.
+type LoadEventFiredEvent struct {
+	Timestamp float64 `json:"timestamp"`
+}
+
+// EventNavigatedWithinDocument is the method name of the Page.navigatedWithinDocument event.
+const EventNavigatedWithinDocument = "Page.navigatedWithinDocument"
+
+// NavigatedWithinDocumentEvent is the payload of the Page.navigatedWithinDocument event.
+//
+// Fired when same-document navigation happens, e.g. due to history API usage or anchor navigation.
+//
+// Experimental.
+type NavigatedWithinDocumentEvent struct {
+	// Id of the frame.
+	FrameID FrameID `json:"frameId"`
+	// Frame's new url.
+	URL string `json:"url"`
+	// Navigation type
+	NavigationType string `json:"navigationType"`
+}
+
+// EventScreencastFrame is the method name of the Page.screencastFrame event.
+const EventScreencastFrame = "Page.screencastFrame"
+
+// ScreencastFrameEvent is the payload of the Page.screencastFrame event.
+//
+// Compressed image data requested by the `startScreencast`.
+//
+// Experimental.
+type ScreencastFrameEvent struct {
+	// Base64-encoded compressed image. (Encoded as a base64 string when passed over JSON)
+	Data string `json:"data"`
+	// Screencast frame metadata.
+	Metadata ScreencastFrameMetadata `json:"metadata"`
+	// Frame number.
+	SessionID int64 `json:"sessionId"`
+}
+
+// EventScreencastVisibilityChanged is the method name of the Page.screencastVisibilityChanged event.
+const EventScreencastVisibilityChanged = "Page.screencastVisibilityChanged"
+
+// ScreencastVisibilityChangedEvent is the payload of the Page.screencastVisibilityChanged event.
+//
+// Fired when the page with currently enabled screencast was shown or hidden `.
+//
+// Experimental.
+type ScreencastVisibilityChangedEvent struct {
+	// True if the page is visible.
+	Visible bool `json:"visible"`
+}
+
+// EventWindowOpen is the method name of the Page.windowOpen event.
+const EventWindowOpen = "Page.windowOpen"
+
+// WindowOpenEvent is the payload of the Page.windowOpen event.
+//
+// Fired when a new window is going to be opened, via window.open(), link click, form submission,
+// etc.
+type WindowOpenEvent struct {
+	// The URL for the new window.
+	URL string `json:"url"`
+	// Window name.
+	WindowName string `json:"windowName"`
+	// An array of enabled window features.
+	WindowFeatures []string `json:"windowFeatures"`
+	// Whether or not it was triggered by user gesture.
+	UserGesture bool `json:"userGesture"`
+}
+
+// EventCompilationCacheProduced is the method name of the Page.compilationCacheProduced event.
+const EventCompilationCacheProduced = "Page.compilationCacheProduced"
+
+// CompilationCacheProducedEvent is the payload of the Page.compilationCacheProduced event.
+//
+// Issued for every compilation cache generated. Is only available
+// if Page.setGenerateCompilationCache is enabled.
+//
+// Experimental.
+type CompilationCacheProducedEvent struct {
+	URL string `json:"url"`
+	// Base64-encoded data (Encoded as a base64 string when passed over JSON)
+	Data string `json:"data"`
+}
+
+// ParseEvent decodes a Page event message into its typed event struct.
+func ParseEvent(msg *internal.CDPMessage) (any, error) {
+	var v any
+	switch msg.Method {
+	case EventDOMContentEventFired:
+		v = &DOMContentEventFiredEvent{}
+	case EventFileChooserOpened:
+		v = &FileChooserOpenedEvent{}
+	case EventFrameAttached:
+		v = &FrameAttachedEvent{}
+	case EventFrameClearedScheduledNavigation:
+		v = &FrameClearedScheduledNavigationEvent{}
+	case EventFrameDetached:
+		v = &FrameDetachedEvent{}
+	case EventFrameSubtreeWillBeDetached:
+		v = &FrameSubtreeWillBeDetachedEvent{}
+	case EventFrameNavigated:
+		v = &FrameNavigatedEvent{}
+	case EventDocumentOpened:
+		v = &DocumentOpenedEvent{}
+	case EventFrameResized:
+		v = &FrameResizedEvent{}
+	case EventFrameRequestedNavigation:
+		v = &FrameRequestedNavigationEvent{}
+	case EventFrameScheduledNavigation:
+		v = &FrameScheduledNavigationEvent{}
+	case EventFrameStartedLoading:
+		v = &FrameStartedLoadingEvent{}
+	case EventFrameStoppedLoading:
+		v = &FrameStoppedLoadingEvent{}
+	case EventDownloadWillBegin:
+		v = &DownloadWillBeginEvent{}
+	case EventDownloadProgress:
+		v = &DownloadProgressEvent{}
+	case EventInterstitialHidden:
+		v = &InterstitialHiddenEvent{}
+	case EventInterstitialShown:
+		v = &InterstitialShownEvent{}
+	case EventJavascriptDialogClosed:
+		v = &JavascriptDialogClosedEvent{}
+	case EventJavascriptDialogOpening:
+		v = &JavascriptDialogOpeningEvent{}
+	case EventLifecycleEvent:
+		v = &LifecycleEventEvent{}
+	case EventBackForwardCacheNotUsed:
+		v = &BackForwardCacheNotUsedEvent{}
+	case EventLoadEventFired:
+		v = &LoadEventFiredEvent{}
+	case EventNavigatedWithinDocument:
+		v = &NavigatedWithinDocumentEvent{}
+	case EventScreencastFrame:
+		v = &ScreencastFrameEvent{}
+	case EventScreencastVisibilityChanged:
+		v = &ScreencastVisibilityChangedEvent{}
+	case EventWindowOpen:
+		v = &WindowOpenEvent{}
+	case EventCompilationCacheProduced:
+		v = &CompilationCacheProducedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Page event: %s", msg.Method)
+	}
+	if msg.Params == nil {
+		return v, nil
+	}
+	err := json.Unmarshal(msg.Params, v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
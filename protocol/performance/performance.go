@@ -0,0 +1,116 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package performance provides typed bindings for the CDP Performance domain.
+package performance
+
+import (
+	"cdp/internal"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Metric is the Performance.Metric type.
+//
+// Run-time execution metric.
+type Metric struct {
+	// Metric name.
+	Name string `json:"name"`
+	// Metric value.
+	Value float64 `json:"value"`
+}
+
+// Disable calls Performance.disable.
+//
+// Disable collecting and reporting metrics.
+func Disable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Performance.disable", nil, sessionID, nil)
+}
+
+// EnableParams holds the parameters of Performance.enable.
+type EnableParams struct {
+	// Time domain to use for collecting and reporting duration metrics.
+	TimeDomain string `json:"timeDomain,omitempty"`
+}
+
+// Enable calls Performance.enable.
+//
+// Enable collecting and reporting metrics.
+func Enable(ctx context.Context, c *internal.Client, sessionID string, params *EnableParams) error {
+	if params == nil {
+		params = &EnableParams{}
+	}
+	return c.Call(ctx, "Performance.enable", params, sessionID, nil)
+}
+
+// SetTimeDomainParams holds the parameters of Performance.setTimeDomain.
+type SetTimeDomainParams struct {
+	// Time domain
+	TimeDomain string `json:"timeDomain"`
+}
+
+// SetTimeDomain calls Performance.setTimeDomain.
+//
+// Sets time domain to use for collecting and reporting duration metrics.
+// Note that this must be called before enabling metrics collection. Calling
+// this method while metrics collection is enabled returns an error.
+//
+// Experimental.
+//
+// Deprecated: this item is deprecated in the protocol.
+func SetTimeDomain(ctx context.Context, c *internal.Client, sessionID string, params *SetTimeDomainParams) error {
+	if params == nil {
+		params = &SetTimeDomainParams{}
+	}
+	return c.Call(ctx, "Performance.setTimeDomain", params, sessionID, nil)
+}
+
+// GetMetricsResult holds the result of Performance.getMetrics.
+type GetMetricsResult struct {
+	// Current values for run-time metrics.
+	Metrics []Metric `json:"metrics"`
+}
+
+// GetMetrics calls Performance.getMetrics.
+//
+// Retrieve current values of run-time metrics.
+func GetMetrics(ctx context.Context, c *internal.Client, sessionID string) (*GetMetricsResult, error) {
+	var result GetMetricsResult
+	err := c.Call(ctx, "Performance.getMetrics", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// EventMetrics is the method name of the Performance.metrics event.
+const EventMetrics = "Performance.metrics"
+
+// MetricsEvent is the payload of the Performance.metrics event.
+//
+// Current values of the metrics.
+type MetricsEvent struct {
+	// Current values of the metrics.
+	Metrics []Metric `json:"metrics"`
+	// Timestamp title.
+	Title string `json:"title"`
+}
+
+// ParseEvent decodes a Performance event message into its typed event struct.
+func ParseEvent(msg *internal.CDPMessage) (any, error) {
+	var v any
+	switch msg.Method {
+	case EventMetrics:
+		v = &MetricsEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Performance event: %s", msg.Method)
+	}
+	if msg.Params == nil {
+		return v, nil
+	}
+	err := json.Unmarshal(msg.Params, v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,117 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package performancetimeline provides typed bindings for the CDP PerformanceTimeline domain.
+//
+// Reporting of performance timeline events, as specified in
+// https://w3c.github.io/performance-timeline/#dom-performanceobserver.
+package performancetimeline
+
+import (
+	"cdp/internal"
+	"cdp/protocol/dom"
+	"cdp/protocol/network"
+	"cdp/protocol/page"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// LargestContentfulPaint is the PerformanceTimeline.LargestContentfulPaint type.
+//
+// See https://github.com/WICG/LargestContentfulPaint and largest_contentful_paint.idl
+type LargestContentfulPaint struct {
+	RenderTime network.TimeSinceEpoch `json:"renderTime"`
+	LoadTime   network.TimeSinceEpoch `json:"loadTime"`
+	// The number of pixels being painted.
+	Size float64 `json:"size"`
+	// The id attribute of the element, if available.
+	ElementID string `json:"elementId,omitempty"`
+	// The URL of the image (may be trimmed).
+	URL    string             `json:"url,omitempty"`
+	NodeID *dom.BackendNodeID `json:"nodeId,omitempty"`
+}
+
+// LayoutShiftAttribution is the PerformanceTimeline.LayoutShiftAttribution type.
+type LayoutShiftAttribution struct {
+	PreviousRect dom.Rect           `json:"previousRect"`
+	CurrentRect  dom.Rect           `json:"currentRect"`
+	NodeID       *dom.BackendNodeID `json:"nodeId,omitempty"`
+}
+
+// LayoutShift is the PerformanceTimeline.LayoutShift type.
+//
+// See https://wicg.github.io/layout-instability/#sec-layout-shift and layout_shift.idl
+type LayoutShift struct {
+	// Score increment produced by this event.
+	Value          float64                  `json:"value"`
+	HadRecentInput bool                     `json:"hadRecentInput"`
+	LastInputTime  network.TimeSinceEpoch   `json:"lastInputTime"`
+	Sources        []LayoutShiftAttribution `json:"sources"`
+}
+
+// TimelineEvent is the PerformanceTimeline.TimelineEvent type.
+type TimelineEvent struct {
+	// Identifies the frame that this event is related to. Empty for non-frame targets.
+	FrameID page.FrameID `json:"frameId"`
+	// The event type, as specified in https://w3c.github.io/performance-timeline/#dom-performanceentry-entrytype
+	// This determines which of the optional "details" fields is present.
+	Type string `json:"type"`
+	// Name may be empty depending on the type.
+	Name string `json:"name"`
+	// Time in seconds since Epoch, monotonically increasing within document lifetime.
+	Time network.TimeSinceEpoch `json:"time"`
+	// Event duration, if applicable.
+	Duration           *float64                `json:"duration,omitempty"`
+	LcpDetails         *LargestContentfulPaint `json:"lcpDetails,omitempty"`
+	LayoutShiftDetails *LayoutShift            `json:"layoutShiftDetails,omitempty"`
+}
+
+// EnableParams holds the parameters of PerformanceTimeline.enable.
+type EnableParams struct {
+	// The types of event to report, as specified in
+	// https://w3c.github.io/performance-timeline/#dom-performanceentry-entrytype
+	// The specified filter overrides any previous filters, passing empty
+	// filter disables recording.
+	// Note that not all types exposed to the web platform are currently supported.
+	EventTypes []string `json:"eventTypes"`
+}
+
+// Enable calls PerformanceTimeline.enable.
+//
+// Previously buffered events would be reported before method returns.
+// See also: timelineEventAdded
+func Enable(ctx context.Context, c *internal.Client, sessionID string, params *EnableParams) error {
+	if params == nil {
+		params = &EnableParams{}
+	}
+	return c.Call(ctx, "PerformanceTimeline.enable", params, sessionID, nil)
+}
+
+// EventTimelineEventAdded is the method name of the PerformanceTimeline.timelineEventAdded event.
+const EventTimelineEventAdded = "PerformanceTimeline.timelineEventAdded"
+
+// TimelineEventAddedEvent is the payload of the PerformanceTimeline.timelineEventAdded event.
+//
+// Sent when a performance timeline event is added. See reportPerformanceTimeline method.
+type TimelineEventAddedEvent struct {
+	Event TimelineEvent `json:"event"`
+}
+
+// ParseEvent decodes a PerformanceTimeline event message into its typed event struct.
+func ParseEvent(msg *internal.CDPMessage) (any, error) {
+	var v any
+	switch msg.Method {
+	case EventTimelineEventAdded:
+		v = &TimelineEventAddedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown PerformanceTimeline event: %s", msg.Method)
+	}
+	if msg.Params == nil {
+		return v, nil
+	}
+	err := json.Unmarshal(msg.Params, v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
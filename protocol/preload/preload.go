@@ -0,0 +1,371 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package preload provides typed bindings for the CDP Preload domain.
+package preload
+
+import (
+	"cdp/internal"
+	"cdp/protocol/dom"
+	"cdp/protocol/network"
+	"cdp/protocol/page"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// RuleSetID is the Preload.RuleSetId type.
+//
+// Unique id
+type RuleSetID string
+
+// RuleSet is the Preload.RuleSet type.
+//
+// Corresponds to SpeculationRuleSet
+type RuleSet struct {
+	ID RuleSetID `json:"id"`
+	// Identifies a document which the rule set is associated with.
+	LoaderID network.LoaderID `json:"loaderId"`
+	// Source text of JSON representing the rule set. If it comes from
+	// `<script>` tag, it is the textContent of the node. Note that it is
+	// a JSON for valid case.
+	//
+	// See also:
+	// - https://wicg.github.io/nav-speculation/speculation-rules.html
+	// - https://github.com/WICG/nav-speculation/blob/main/triggers.md
+	SourceText string `json:"sourceText"`
+	// A speculation rule set is either added through an inline
+	// `<script>` tag or through an external resource via the
+	// 'Speculation-Rules' HTTP header. For the first case, we include
+	// the BackendNodeId of the relevant `<script>` tag. For the second
+	// case, we include the external URL where the rule set was loaded
+	// from, and also RequestId if Network domain is enabled.
+	//
+	// See also:
+	// - https://wicg.github.io/nav-speculation/speculation-rules.html#speculation-rules-script
+	// - https://wicg.github.io/nav-speculation/speculation-rules.html#speculation-rules-header
+	BackendNodeID *dom.BackendNodeID `json:"backendNodeId,omitempty"`
+	URL           string             `json:"url,omitempty"`
+	RequestID     network.RequestID  `json:"requestId,omitempty"`
+	// Error information
+	// `errorMessage` is null iff `errorType` is null.
+	ErrorType RuleSetErrorType `json:"errorType,omitempty"`
+	// TODO(https://crbug.com/1425354): Replace this property with structured error.
+	//
+	// Deprecated: this item is deprecated in the protocol.
+	ErrorMessage string `json:"errorMessage,omitempty"`
+}
+
+// RuleSetErrorType is the Preload.RuleSetErrorType type.
+type RuleSetErrorType string
+
+const (
+	RuleSetErrorTypeSourceIsNotJSONObject RuleSetErrorType = "SourceIsNotJsonObject"
+	RuleSetErrorTypeInvalidRulesSkipped   RuleSetErrorType = "InvalidRulesSkipped"
+)
+
+// SpeculationAction is the Preload.SpeculationAction type.
+//
+// The type of preloading attempted. It corresponds to
+// mojom::SpeculationAction (although PrefetchWithSubresources is omitted as it
+// isn't being used by clients).
+type SpeculationAction string
+
+const (
+	SpeculationActionPrefetch  SpeculationAction = "Prefetch"
+	SpeculationActionPrerender SpeculationAction = "Prerender"
+)
+
+// SpeculationTargetHint is the Preload.SpeculationTargetHint type.
+//
+// Corresponds to mojom::SpeculationTargetHint.
+// See https://github.com/WICG/nav-speculation/blob/main/triggers.md#window-name-targeting-hints
+type SpeculationTargetHint string
+
+const (
+	SpeculationTargetHintBlank SpeculationTargetHint = "Blank"
+	SpeculationTargetHintSelf  SpeculationTargetHint = "Self"
+)
+
+// PreloadingAttemptKey is the Preload.PreloadingAttemptKey type.
+//
+// A key that identifies a preloading attempt.
+//
+// The url used is the url specified by the trigger (i.e. the initial URL), and
+// not the final url that is navigated to. For example, prerendering allows
+// same-origin main frame navigations during the attempt, but the attempt is
+// still keyed with the initial URL.
+type PreloadingAttemptKey struct {
+	LoaderID   network.LoaderID      `json:"loaderId"`
+	Action     SpeculationAction     `json:"action"`
+	URL        string                `json:"url"`
+	TargetHint SpeculationTargetHint `json:"targetHint,omitempty"`
+}
+
+// PreloadingAttemptSource is the Preload.PreloadingAttemptSource type.
+//
+// Lists sources for a preloading attempt, specifically the ids of rule sets
+// that had a speculation rule that triggered the attempt, and the
+// BackendNodeIds of <a href> or <area href> elements that triggered the
+// attempt (in the case of attempts triggered by a document rule). It is
+// possible for multiple rule sets and links to trigger a single attempt.
+type PreloadingAttemptSource struct {
+	Key        PreloadingAttemptKey `json:"key"`
+	RuleSetIDs []RuleSetID          `json:"ruleSetIds"`
+	NodeIDs    []dom.BackendNodeID  `json:"nodeIds"`
+}
+
+// PrerenderFinalStatus is the Preload.PrerenderFinalStatus type.
+//
+// List of FinalStatus reasons for Prerender2.
+type PrerenderFinalStatus string
+
+const (
+	PrerenderFinalStatusActivated                                                  PrerenderFinalStatus = "Activated"
+	PrerenderFinalStatusDestroyed                                                  PrerenderFinalStatus = "Destroyed"
+	PrerenderFinalStatusLowEndDevice                                               PrerenderFinalStatus = "LowEndDevice"
+	PrerenderFinalStatusInvalidSchemeRedirect                                      PrerenderFinalStatus = "InvalidSchemeRedirect"
+	PrerenderFinalStatusInvalidSchemeNavigation                                    PrerenderFinalStatus = "InvalidSchemeNavigation"
+	PrerenderFinalStatusNavigationRequestBlockedByCsp                              PrerenderFinalStatus = "NavigationRequestBlockedByCsp"
+	PrerenderFinalStatusMainFrameNavigation                                        PrerenderFinalStatus = "MainFrameNavigation"
+	PrerenderFinalStatusMojoBinderPolicy                                           PrerenderFinalStatus = "MojoBinderPolicy"
+	PrerenderFinalStatusRendererProcessCrashed                                     PrerenderFinalStatus = "RendererProcessCrashed"
+	PrerenderFinalStatusRendererProcessKilled                                      PrerenderFinalStatus = "RendererProcessKilled"
+	PrerenderFinalStatusDownload                                                   PrerenderFinalStatus = "Download"
+	PrerenderFinalStatusTriggerDestroyed                                           PrerenderFinalStatus = "TriggerDestroyed"
+	PrerenderFinalStatusNavigationNotCommitted                                     PrerenderFinalStatus = "NavigationNotCommitted"
+	PrerenderFinalStatusNavigationBadHTTPStatus                                    PrerenderFinalStatus = "NavigationBadHttpStatus"
+	PrerenderFinalStatusClientCertRequested                                        PrerenderFinalStatus = "ClientCertRequested"
+	PrerenderFinalStatusNavigationRequestNetworkError                              PrerenderFinalStatus = "NavigationRequestNetworkError"
+	PrerenderFinalStatusCancelAllHostsForTesting                                   PrerenderFinalStatus = "CancelAllHostsForTesting"
+	PrerenderFinalStatusDidFailLoad                                                PrerenderFinalStatus = "DidFailLoad"
+	PrerenderFinalStatusStop                                                       PrerenderFinalStatus = "Stop"
+	PrerenderFinalStatusSSLCertificateError                                        PrerenderFinalStatus = "SslCertificateError"
+	PrerenderFinalStatusLoginAuthRequested                                         PrerenderFinalStatus = "LoginAuthRequested"
+	PrerenderFinalStatusUaChangeRequiresReload                                     PrerenderFinalStatus = "UaChangeRequiresReload"
+	PrerenderFinalStatusBlockedByClient                                            PrerenderFinalStatus = "BlockedByClient"
+	PrerenderFinalStatusAudioOutputDeviceRequested                                 PrerenderFinalStatus = "AudioOutputDeviceRequested"
+	PrerenderFinalStatusMixedContent                                               PrerenderFinalStatus = "MixedContent"
+	PrerenderFinalStatusTriggerBackgrounded                                        PrerenderFinalStatus = "TriggerBackgrounded"
+	PrerenderFinalStatusMemoryLimitExceeded                                        PrerenderFinalStatus = "MemoryLimitExceeded"
+	PrerenderFinalStatusDataSaverEnabled                                           PrerenderFinalStatus = "DataSaverEnabled"
+	PrerenderFinalStatusTriggerURLHasEffectiveURL                                  PrerenderFinalStatus = "TriggerUrlHasEffectiveUrl"
+	PrerenderFinalStatusActivatedBeforeStarted                                     PrerenderFinalStatus = "ActivatedBeforeStarted"
+	PrerenderFinalStatusInactivePageRestriction                                    PrerenderFinalStatus = "InactivePageRestriction"
+	PrerenderFinalStatusStartFailed                                                PrerenderFinalStatus = "StartFailed"
+	PrerenderFinalStatusTimeoutBackgrounded                                        PrerenderFinalStatus = "TimeoutBackgrounded"
+	PrerenderFinalStatusCrossSiteRedirectInInitialNavigation                       PrerenderFinalStatus = "CrossSiteRedirectInInitialNavigation"
+	PrerenderFinalStatusCrossSiteNavigationInInitialNavigation                     PrerenderFinalStatus = "CrossSiteNavigationInInitialNavigation"
+	PrerenderFinalStatusSameSiteCrossOriginRedirectNotOptInInInitialNavigation     PrerenderFinalStatus = "SameSiteCrossOriginRedirectNotOptInInInitialNavigation"
+	PrerenderFinalStatusSameSiteCrossOriginNavigationNotOptInInInitialNavigation   PrerenderFinalStatus = "SameSiteCrossOriginNavigationNotOptInInInitialNavigation"
+	PrerenderFinalStatusActivationNavigationParameterMismatch                      PrerenderFinalStatus = "ActivationNavigationParameterMismatch"
+	PrerenderFinalStatusActivatedInBackground                                      PrerenderFinalStatus = "ActivatedInBackground"
+	PrerenderFinalStatusEmbedderHostDisallowed                                     PrerenderFinalStatus = "EmbedderHostDisallowed"
+	PrerenderFinalStatusActivationNavigationDestroyedBeforeSuccess                 PrerenderFinalStatus = "ActivationNavigationDestroyedBeforeSuccess"
+	PrerenderFinalStatusTabClosedByUserGesture                                     PrerenderFinalStatus = "TabClosedByUserGesture"
+	PrerenderFinalStatusTabClosedWithoutUserGesture                                PrerenderFinalStatus = "TabClosedWithoutUserGesture"
+	PrerenderFinalStatusPrimaryMainFrameRendererProcessCrashed                     PrerenderFinalStatus = "PrimaryMainFrameRendererProcessCrashed"
+	PrerenderFinalStatusPrimaryMainFrameRendererProcessKilled                      PrerenderFinalStatus = "PrimaryMainFrameRendererProcessKilled"
+	PrerenderFinalStatusActivationFramePolicyNotCompatible                         PrerenderFinalStatus = "ActivationFramePolicyNotCompatible"
+	PrerenderFinalStatusPreloadingDisabled                                         PrerenderFinalStatus = "PreloadingDisabled"
+	PrerenderFinalStatusBatterySaverEnabled                                        PrerenderFinalStatus = "BatterySaverEnabled"
+	PrerenderFinalStatusActivatedDuringMainFrameNavigation                         PrerenderFinalStatus = "ActivatedDuringMainFrameNavigation"
+	PrerenderFinalStatusPreloadingUnsupportedByWebContents                         PrerenderFinalStatus = "PreloadingUnsupportedByWebContents"
+	PrerenderFinalStatusCrossSiteRedirectInMainFrameNavigation                     PrerenderFinalStatus = "CrossSiteRedirectInMainFrameNavigation"
+	PrerenderFinalStatusCrossSiteNavigationInMainFrameNavigation                   PrerenderFinalStatus = "CrossSiteNavigationInMainFrameNavigation"
+	PrerenderFinalStatusSameSiteCrossOriginRedirectNotOptInInMainFrameNavigation   PrerenderFinalStatus = "SameSiteCrossOriginRedirectNotOptInInMainFrameNavigation"
+	PrerenderFinalStatusSameSiteCrossOriginNavigationNotOptInInMainFrameNavigation PrerenderFinalStatus = "SameSiteCrossOriginNavigationNotOptInInMainFrameNavigation"
+	PrerenderFinalStatusMemoryPressureOnTrigger                                    PrerenderFinalStatus = "MemoryPressureOnTrigger"
+	PrerenderFinalStatusMemoryPressureAfterTriggered                               PrerenderFinalStatus = "MemoryPressureAfterTriggered"
+	PrerenderFinalStatusPrerenderingDisabledByDevTools                             PrerenderFinalStatus = "PrerenderingDisabledByDevTools"
+	PrerenderFinalStatusSpeculationRuleRemoved                                     PrerenderFinalStatus = "SpeculationRuleRemoved"
+	PrerenderFinalStatusActivatedWithAuxiliaryBrowsingContexts                     PrerenderFinalStatus = "ActivatedWithAuxiliaryBrowsingContexts"
+	PrerenderFinalStatusMaxNumOfRunningEagerPrerendersExceeded                     PrerenderFinalStatus = "MaxNumOfRunningEagerPrerendersExceeded"
+	PrerenderFinalStatusMaxNumOfRunningNonEagerPrerendersExceeded                  PrerenderFinalStatus = "MaxNumOfRunningNonEagerPrerendersExceeded"
+	PrerenderFinalStatusMaxNumOfRunningEmbedderPrerendersExceeded                  PrerenderFinalStatus = "MaxNumOfRunningEmbedderPrerendersExceeded"
+	PrerenderFinalStatusPrerenderingURLHasEffectiveURL                             PrerenderFinalStatus = "PrerenderingUrlHasEffectiveUrl"
+	PrerenderFinalStatusRedirectedPrerenderingURLHasEffectiveURL                   PrerenderFinalStatus = "RedirectedPrerenderingUrlHasEffectiveUrl"
+	PrerenderFinalStatusActivationURLHasEffectiveURL                               PrerenderFinalStatus = "ActivationUrlHasEffectiveUrl"
+	PrerenderFinalStatusJavaScriptInterfaceAdded                                   PrerenderFinalStatus = "JavaScriptInterfaceAdded"
+	PrerenderFinalStatusJavaScriptInterfaceRemoved                                 PrerenderFinalStatus = "JavaScriptInterfaceRemoved"
+	PrerenderFinalStatusAllPrerenderingCanceled                                    PrerenderFinalStatus = "AllPrerenderingCanceled"
+	PrerenderFinalStatusWindowClosed                                               PrerenderFinalStatus = "WindowClosed"
+	PrerenderFinalStatusSlowNetwork                                                PrerenderFinalStatus = "SlowNetwork"
+	PrerenderFinalStatusOtherPrerenderedPageActivated                              PrerenderFinalStatus = "OtherPrerenderedPageActivated"
+)
+
+// PreloadingStatus is the Preload.PreloadingStatus type.
+//
+// Preloading status values, see also PreloadingTriggeringOutcome. This
+// status is shared by prefetchStatusUpdated and prerenderStatusUpdated.
+type PreloadingStatus string
+
+const (
+	PreloadingStatusPending      PreloadingStatus = "Pending"
+	PreloadingStatusRunning      PreloadingStatus = "Running"
+	PreloadingStatusReady        PreloadingStatus = "Ready"
+	PreloadingStatusSuccess      PreloadingStatus = "Success"
+	PreloadingStatusFailure      PreloadingStatus = "Failure"
+	PreloadingStatusNotSupported PreloadingStatus = "NotSupported"
+)
+
+// PrefetchStatus is the Preload.PrefetchStatus type.
+//
+// TODO(https://crbug.com/1384419): revisit the list of PrefetchStatus and
+// filter out the ones that aren't necessary to the developers.
+type PrefetchStatus string
+
+const (
+	PrefetchStatusPrefetchAllowed                                             PrefetchStatus = "PrefetchAllowed"
+	PrefetchStatusPrefetchFailedIneligibleRedirect                            PrefetchStatus = "PrefetchFailedIneligibleRedirect"
+	PrefetchStatusPrefetchFailedInvalidRedirect                               PrefetchStatus = "PrefetchFailedInvalidRedirect"
+	PrefetchStatusPrefetchFailedMIMENotSupported                              PrefetchStatus = "PrefetchFailedMIMENotSupported"
+	PrefetchStatusPrefetchFailedNetError                                      PrefetchStatus = "PrefetchFailedNetError"
+	PrefetchStatusPrefetchFailedNon2XX                                        PrefetchStatus = "PrefetchFailedNon2XX"
+	PrefetchStatusPrefetchEvictedAfterCandidateRemoved                        PrefetchStatus = "PrefetchEvictedAfterCandidateRemoved"
+	PrefetchStatusPrefetchEvictedForNewerPrefetch                             PrefetchStatus = "PrefetchEvictedForNewerPrefetch"
+	PrefetchStatusPrefetchHeldback                                            PrefetchStatus = "PrefetchHeldback"
+	PrefetchStatusPrefetchIneligibleRetryAfter                                PrefetchStatus = "PrefetchIneligibleRetryAfter"
+	PrefetchStatusPrefetchIsPrivacyDecoy                                      PrefetchStatus = "PrefetchIsPrivacyDecoy"
+	PrefetchStatusPrefetchIsStale                                             PrefetchStatus = "PrefetchIsStale"
+	PrefetchStatusPrefetchNotEligibleBrowserContextOffTheRecord               PrefetchStatus = "PrefetchNotEligibleBrowserContextOffTheRecord"
+	PrefetchStatusPrefetchNotEligibleDataSaverEnabled                         PrefetchStatus = "PrefetchNotEligibleDataSaverEnabled"
+	PrefetchStatusPrefetchNotEligibleExistingProxy                            PrefetchStatus = "PrefetchNotEligibleExistingProxy"
+	PrefetchStatusPrefetchNotEligibleHostIsNonUnique                          PrefetchStatus = "PrefetchNotEligibleHostIsNonUnique"
+	PrefetchStatusPrefetchNotEligibleNonDefaultStoragePartition               PrefetchStatus = "PrefetchNotEligibleNonDefaultStoragePartition"
+	PrefetchStatusPrefetchNotEligibleSameSiteCrossOriginPrefetchRequiredProxy PrefetchStatus = "PrefetchNotEligibleSameSiteCrossOriginPrefetchRequiredProxy"
+	PrefetchStatusPrefetchNotEligibleSchemeIsNotHTTPS                         PrefetchStatus = "PrefetchNotEligibleSchemeIsNotHttps"
+	PrefetchStatusPrefetchNotEligibleUserHasCookies                           PrefetchStatus = "PrefetchNotEligibleUserHasCookies"
+	PrefetchStatusPrefetchNotEligibleUserHasServiceWorker                     PrefetchStatus = "PrefetchNotEligibleUserHasServiceWorker"
+	PrefetchStatusPrefetchNotEligibleBatterySaverEnabled                      PrefetchStatus = "PrefetchNotEligibleBatterySaverEnabled"
+	PrefetchStatusPrefetchNotEligiblePreloadingDisabled                       PrefetchStatus = "PrefetchNotEligiblePreloadingDisabled"
+	PrefetchStatusPrefetchNotFinishedInTime                                   PrefetchStatus = "PrefetchNotFinishedInTime"
+	PrefetchStatusPrefetchNotStarted                                          PrefetchStatus = "PrefetchNotStarted"
+	PrefetchStatusPrefetchNotUsedCookiesChanged                               PrefetchStatus = "PrefetchNotUsedCookiesChanged"
+	PrefetchStatusPrefetchProxyNotAvailable                                   PrefetchStatus = "PrefetchProxyNotAvailable"
+	PrefetchStatusPrefetchResponseUsed                                        PrefetchStatus = "PrefetchResponseUsed"
+	PrefetchStatusPrefetchSuccessfulButNotUsed                                PrefetchStatus = "PrefetchSuccessfulButNotUsed"
+	PrefetchStatusPrefetchNotUsedProbeFailed                                  PrefetchStatus = "PrefetchNotUsedProbeFailed"
+)
+
+// PrerenderMismatchedHeaders is the Preload.PrerenderMismatchedHeaders type.
+//
+// Information of headers to be displayed when the header mismatch occurred.
+type PrerenderMismatchedHeaders struct {
+	HeaderName      string `json:"headerName"`
+	InitialValue    string `json:"initialValue,omitempty"`
+	ActivationValue string `json:"activationValue,omitempty"`
+}
+
+// Enable calls Preload.enable.
+func Enable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Preload.enable", nil, sessionID, nil)
+}
+
+// Disable calls Preload.disable.
+func Disable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Preload.disable", nil, sessionID, nil)
+}
+
+// EventRuleSetUpdated is the method name of the Preload.ruleSetUpdated event.
+const EventRuleSetUpdated = "Preload.ruleSetUpdated"
+
+// RuleSetUpdatedEvent is the payload of the Preload.ruleSetUpdated event.
+//
+// Upsert. Currently, it is only emitted when a rule set added.
+type RuleSetUpdatedEvent struct {
+	RuleSet RuleSet `json:"ruleSet"`
+}
+
+// EventRuleSetRemoved is the method name of the Preload.ruleSetRemoved event.
+const EventRuleSetRemoved = "Preload.ruleSetRemoved"
+
+// RuleSetRemovedEvent is the payload of the Preload.ruleSetRemoved event.
+type RuleSetRemovedEvent struct {
+	ID RuleSetID `json:"id"`
+}
+
+// EventPreloadEnabledStateUpdated is the method name of the Preload.preloadEnabledStateUpdated event.
+const EventPreloadEnabledStateUpdated = "Preload.preloadEnabledStateUpdated"
+
+// PreloadEnabledStateUpdatedEvent is the payload of the Preload.preloadEnabledStateUpdated event.
+//
+// Fired when a preload enabled state is updated.
+type PreloadEnabledStateUpdatedEvent struct {
+	DisabledByPreference                        bool `json:"disabledByPreference"`
+	DisabledByDataSaver                         bool `json:"disabledByDataSaver"`
+	DisabledByBatterySaver                      bool `json:"disabledByBatterySaver"`
+	DisabledByHoldbackPrefetchSpeculationRules  bool `json:"disabledByHoldbackPrefetchSpeculationRules"`
+	DisabledByHoldbackPrerenderSpeculationRules bool `json:"disabledByHoldbackPrerenderSpeculationRules"`
+}
+
+// EventPrefetchStatusUpdated is the method name of the Preload.prefetchStatusUpdated event.
+const EventPrefetchStatusUpdated = "Preload.prefetchStatusUpdated"
+
+// PrefetchStatusUpdatedEvent is the payload of the Preload.prefetchStatusUpdated event.
+//
+// Fired when a prefetch attempt is updated.
+type PrefetchStatusUpdatedEvent struct {
+	Key PreloadingAttemptKey `json:"key"`
+	// The frame id of the frame initiating prefetch.
+	InitiatingFrameID page.FrameID      `json:"initiatingFrameId"`
+	PrefetchURL       string            `json:"prefetchUrl"`
+	Status            PreloadingStatus  `json:"status"`
+	PrefetchStatus    PrefetchStatus    `json:"prefetchStatus"`
+	RequestID         network.RequestID `json:"requestId"`
+}
+
+// EventPrerenderStatusUpdated is the method name of the Preload.prerenderStatusUpdated event.
+const EventPrerenderStatusUpdated = "Preload.prerenderStatusUpdated"
+
+// PrerenderStatusUpdatedEvent is the payload of the Preload.prerenderStatusUpdated event.
+//
+// Fired when a prerender attempt is updated.
+type PrerenderStatusUpdatedEvent struct {
+	Key             PreloadingAttemptKey `json:"key"`
+	Status          PreloadingStatus     `json:"status"`
+	PrerenderStatus PrerenderFinalStatus `json:"prerenderStatus,omitempty"`
+	// This is used to give users more information about the name of Mojo interface
+	// that is incompatible with prerender and has caused the cancellation of the attempt.
+	DisallowedMojoInterface string                       `json:"disallowedMojoInterface,omitempty"`
+	MismatchedHeaders       []PrerenderMismatchedHeaders `json:"mismatchedHeaders,omitempty"`
+}
+
+// EventPreloadingAttemptSourcesUpdated is the method name of the Preload.preloadingAttemptSourcesUpdated event.
+const EventPreloadingAttemptSourcesUpdated = "Preload.preloadingAttemptSourcesUpdated"
+
+// PreloadingAttemptSourcesUpdatedEvent is the payload of the Preload.preloadingAttemptSourcesUpdated event.
+//
+// Send a list of sources for all preloading attempts in a document.
+type PreloadingAttemptSourcesUpdatedEvent struct {
+	LoaderID                 network.LoaderID          `json:"loaderId"`
+	PreloadingAttemptSources []PreloadingAttemptSource `json:"preloadingAttemptSources"`
+}
+
+// ParseEvent decodes a Preload event message into its typed event struct.
+func ParseEvent(msg *internal.CDPMessage) (any, error) {
+	var v any
+	switch msg.Method {
+	case EventRuleSetUpdated:
+		v = &RuleSetUpdatedEvent{}
+	case EventRuleSetRemoved:
+		v = &RuleSetRemovedEvent{}
+	case EventPreloadEnabledStateUpdated:
+		v = &PreloadEnabledStateUpdatedEvent{}
+	case EventPrefetchStatusUpdated:
+		v = &PrefetchStatusUpdatedEvent{}
+	case EventPrerenderStatusUpdated:
+		v = &PrerenderStatusUpdatedEvent{}
+	case EventPreloadingAttemptSourcesUpdated:
+		v = &PreloadingAttemptSourcesUpdatedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Preload event: %s", msg.Method)
+	}
+	if msg.Params == nil {
+		return v, nil
+	}
+	err := json.Unmarshal(msg.Params, v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
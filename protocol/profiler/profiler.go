@@ -0,0 +1,293 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package profiler provides typed bindings for the CDP Profiler domain.
+package profiler
+
+import (
+	"cdp/internal"
+	"cdp/protocol/debugger"
+	"cdp/protocol/runtime"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// ProfileNode is the Profiler.ProfileNode type.
+//
+// Profile node. Holds callsite information, execution statistics and child nodes.
+type ProfileNode struct {
+	// Unique id of the node.
+	ID int64 `json:"id"`
+	// Function location.
+	CallFrame runtime.CallFrame `json:"callFrame"`
+	// Number of samples where this node was on top of the call stack.
+	HitCount *int64 `json:"hitCount,omitempty"`
+	// Child node ids.
+	Children []int64 `json:"children,omitempty"`
+	// The reason of being not optimized. The function may be deoptimized or marked as don't
+	// optimize.
+	DeoptReason string `json:"deoptReason,omitempty"`
+	// An array of source position ticks.
+	PositionTicks []PositionTickInfo `json:"positionTicks,omitempty"`
+}
+
+// Profile is the Profiler.Profile type.
+//
+// Profile.
+type Profile struct {
+	// The list of profile nodes. First item is the root node.
+	Nodes []ProfileNode `json:"nodes"`
+	// Profiling start timestamp in microseconds.
+	StartTime float64 `json:"startTime"`
+	// Profiling end timestamp in microseconds.
+	EndTime float64 `json:"endTime"`
+	// Ids of samples top nodes.
+	Samples []int64 `json:"samples,omitempty"`
+	// Time intervals between adjacent samples in microseconds. The first delta is relative to the
+	// profile startTime.
+	TimeDeltas []int64 `json:"timeDeltas,omitempty"`
+}
+
+// PositionTickInfo is the Profiler.PositionTickInfo type.
+//
+// Specifies a number of samples attributed to a certain source position.
+type PositionTickInfo struct {
+	// Source line number (1-based).
+	Line int64 `json:"line"`
+	// Number of samples attributed to the source line.
+	Ticks int64 `json:"ticks"`
+}
+
+// CoverageRange is the Profiler.CoverageRange type.
+//
+// Coverage data for a source range.
+type CoverageRange struct {
+	// JavaScript script source offset for the range start.
+	StartOffset int64 `json:"startOffset"`
+	// JavaScript script source offset for the range end.
+	EndOffset int64 `json:"endOffset"`
+	// Collected execution count of the source range.
+	Count int64 `json:"count"`
+}
+
+// FunctionCoverage is the Profiler.FunctionCoverage type.
+//
+// Coverage data for a JavaScript function.
+type FunctionCoverage struct {
+	// JavaScript function name.
+	FunctionName string `json:"functionName"`
+	// Source ranges inside the function with coverage data.
+	Ranges []CoverageRange `json:"ranges"`
+	// Whether coverage data for this function has block granularity.
+	IsBlockCoverage bool `json:"isBlockCoverage"`
+}
+
+// ScriptCoverage is the Profiler.ScriptCoverage type.
+//
+// Coverage data for a JavaScript script.
+type ScriptCoverage struct {
+	// JavaScript script id.
+	ScriptID runtime.ScriptID `json:"scriptId"`
+	// JavaScript script name or url.
+	URL string `json:"url"`
+	// Functions contained in the script that has coverage data.
+	Functions []FunctionCoverage `json:"functions"`
+}
+
+// Disable calls Profiler.disable.
+func Disable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Profiler.disable", nil, sessionID, nil)
+}
+
+// Enable calls Profiler.enable.
+func Enable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Profiler.enable", nil, sessionID, nil)
+}
+
+// GetBestEffortCoverageResult holds the result of Profiler.getBestEffortCoverage.
+type GetBestEffortCoverageResult struct {
+	// Coverage data for the current isolate.
+	Result []ScriptCoverage `json:"result"`
+}
+
+// GetBestEffortCoverage calls Profiler.getBestEffortCoverage.
+//
+// Collect coverage data for the current isolate. The coverage data may be incomplete due to
+// garbage collection.
+func GetBestEffortCoverage(ctx context.Context, c *internal.Client, sessionID string) (*GetBestEffortCoverageResult, error) {
+	var result GetBestEffortCoverageResult
+	err := c.Call(ctx, "Profiler.getBestEffortCoverage", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SetSamplingIntervalParams holds the parameters of Profiler.setSamplingInterval.
+type SetSamplingIntervalParams struct {
+	// New sampling interval in microseconds.
+	Interval int64 `json:"interval"`
+}
+
+// SetSamplingInterval calls Profiler.setSamplingInterval.
+//
+// Changes CPU profiler sampling interval. Must be called before CPU profiles recording started.
+func SetSamplingInterval(ctx context.Context, c *internal.Client, sessionID string, params *SetSamplingIntervalParams) error {
+	if params == nil {
+		params = &SetSamplingIntervalParams{}
+	}
+	return c.Call(ctx, "Profiler.setSamplingInterval", params, sessionID, nil)
+}
+
+// Start calls Profiler.start.
+func Start(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Profiler.start", nil, sessionID, nil)
+}
+
+// StartPreciseCoverageParams holds the parameters of Profiler.startPreciseCoverage.
+type StartPreciseCoverageParams struct {
+	// Collect accurate call counts beyond simple 'covered' or 'not covered'.
+	CallCount *bool `json:"callCount,omitempty"`
+	// Collect block-based coverage.
+	Detailed *bool `json:"detailed,omitempty"`
+	// Allow the backend to send updates on its own initiative
+	AllowTriggeredUpdates *bool `json:"allowTriggeredUpdates,omitempty"`
+}
+
+// StartPreciseCoverageResult holds the result of Profiler.startPreciseCoverage.
+type StartPreciseCoverageResult struct {
+	// Monotonically increasing time (in seconds) when the coverage update was taken in the backend.
+	Timestamp float64 `json:"timestamp"`
+}
+
+// StartPreciseCoverage calls Profiler.startPreciseCoverage.
+//
+// Enable precise code coverage. Coverage data for JavaScript executed before enabling precise code
+// coverage may be incomplete. Enabling prevents running optimized code and resets execution
+// counters.
+func StartPreciseCoverage(ctx context.Context, c *internal.Client, sessionID string, params *StartPreciseCoverageParams) (*StartPreciseCoverageResult, error) {
+	if params == nil {
+		params = &StartPreciseCoverageParams{}
+	}
+	var result StartPreciseCoverageResult
+	err := c.Call(ctx, "Profiler.startPreciseCoverage", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// StopResult holds the result of Profiler.stop.
+type StopResult struct {
+	// Recorded profile.
+	Profile Profile `json:"profile"`
+}
+
+// Stop calls Profiler.stop.
+func Stop(ctx context.Context, c *internal.Client, sessionID string) (*StopResult, error) {
+	var result StopResult
+	err := c.Call(ctx, "Profiler.stop", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// StopPreciseCoverage calls Profiler.stopPreciseCoverage.
+//
+// Disable precise code coverage. Disabling releases unnecessary execution count records and allows
+// executing optimized code.
+func StopPreciseCoverage(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Profiler.stopPreciseCoverage", nil, sessionID, nil)
+}
+
+// TakePreciseCoverageResult holds the result of Profiler.takePreciseCoverage.
+type TakePreciseCoverageResult struct {
+	// Coverage data for the current isolate.
+	Result []ScriptCoverage `json:"result"`
+	// Monotonically increasing time (in seconds) when the coverage update was taken in the backend.
+	Timestamp float64 `json:"timestamp"`
+}
+
+// TakePreciseCoverage calls Profiler.takePreciseCoverage.
+//
+// Collect coverage data for the current isolate, and resets execution counters. Precise code
+// coverage needs to have started.
+func TakePreciseCoverage(ctx context.Context, c *internal.Client, sessionID string) (*TakePreciseCoverageResult, error) {
+	var result TakePreciseCoverageResult
+	err := c.Call(ctx, "Profiler.takePreciseCoverage", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// EventConsoleProfileFinished is the method name of the Profiler.consoleProfileFinished event.
+const EventConsoleProfileFinished = "Profiler.consoleProfileFinished"
+
+// ConsoleProfileFinishedEvent is the payload of the Profiler.consoleProfileFinished event.
+type ConsoleProfileFinishedEvent struct {
+	ID string `json:"id"`
+	// Location of console.profileEnd().
+	Location debugger.Location `json:"location"`
+	Profile  Profile           `json:"profile"`
+	// Profile title passed as an argument to console.profile().
+	Title string `json:"title,omitempty"`
+}
+
+// EventConsoleProfileStarted is the method name of the Profiler.consoleProfileStarted event.
+const EventConsoleProfileStarted = "Profiler.consoleProfileStarted"
+
+// ConsoleProfileStartedEvent is the payload of the Profiler.consoleProfileStarted event.
+//
+// Sent when new profile recording is started using console.profile() call.
+type ConsoleProfileStartedEvent struct {
+	ID string `json:"id"`
+	// Location of console.profile().
+	Location debugger.Location `json:"location"`
+	// Profile title passed as an argument to console.profile().
+	Title string `json:"title,omitempty"`
+}
+
+// EventPreciseCoverageDeltaUpdate is the method name of the Profiler.preciseCoverageDeltaUpdate event.
+const EventPreciseCoverageDeltaUpdate = "Profiler.preciseCoverageDeltaUpdate"
+
+// PreciseCoverageDeltaUpdateEvent is the payload of the Profiler.preciseCoverageDeltaUpdate event.
+//
+// Reports coverage delta since the last poll (either from an event like this, or from
+// `takePreciseCoverage` for the current isolate. May only be sent if precise code
+// coverage has been started. This event can be trigged by the embedder to, for example,
+// trigger collection of coverage data immediately at a certain point in time.
+//
+// Experimental.
+type PreciseCoverageDeltaUpdateEvent struct {
+	// Monotonically increasing time (in seconds) when the coverage update was taken in the backend.
+	Timestamp float64 `json:"timestamp"`
+	// Identifier for distinguishing coverage events.
+	Occasion string `json:"occasion"`
+	// Coverage data for the current isolate.
+	Result []ScriptCoverage `json:"result"`
+}
+
+// ParseEvent decodes a Profiler event message into its typed event struct.
+func ParseEvent(msg *internal.CDPMessage) (any, error) {
+	var v any
+	switch msg.Method {
+	case EventConsoleProfileFinished:
+		v = &ConsoleProfileFinishedEvent{}
+	case EventConsoleProfileStarted:
+		v = &ConsoleProfileStartedEvent{}
+	case EventPreciseCoverageDeltaUpdate:
+		v = &PreciseCoverageDeltaUpdateEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Profiler event: %s", msg.Method)
+	}
+	if msg.Params == nil {
+		return v, nil
+	}
+	err := json.Unmarshal(msg.Params, v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
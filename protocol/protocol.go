@@ -0,0 +1,11 @@
+// Package protocol holds typed Go bindings for the Chrome DevTools Protocol,
+// one package per domain, built on top of internal.Client.
+//
+// The domain packages are generated from the protocol JSON vendored under
+// reference/devtools-protocol. Run go generate ./protocol to refresh them, or
+// point the generator at a running browser to match its exact protocol:
+//
+//	go run ../internal/protocol/gen -in http://127.0.0.1:9222
+package protocol
+
+//go:generate go run ../internal/protocol/gen
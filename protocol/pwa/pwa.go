@@ -0,0 +1,232 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package pwa provides typed bindings for the CDP PWA domain.
+//
+// This domain allows interacting with the browser to control PWAs.
+package pwa
+
+import (
+	"cdp/internal"
+	"cdp/protocol/target"
+	"context"
+)
+
+// FileHandlerAccept is the PWA.FileHandlerAccept type.
+//
+// The following types are the replica of
+// https://crsrc.org/c/chrome/browser/web_applications/proto/web_app_os_integration_state.proto;drc=9910d3be894c8f142c977ba1023f30a656bc13fc;l=67
+type FileHandlerAccept struct {
+	// New name of the mimetype according to
+	// https://www.iana.org/assignments/media-types/media-types.xhtml
+	MediaType      string   `json:"mediaType"`
+	FileExtensions []string `json:"fileExtensions"`
+}
+
+// FileHandler is the PWA.FileHandler type.
+type FileHandler struct {
+	Action      string              `json:"action"`
+	Accepts     []FileHandlerAccept `json:"accepts"`
+	DisplayName string              `json:"displayName"`
+}
+
+// DisplayMode is the PWA.DisplayMode type.
+//
+// If user prefers opening the app in browser or an app window.
+type DisplayMode string
+
+const (
+	DisplayModeStandalone DisplayMode = "standalone"
+	DisplayModeBrowser    DisplayMode = "browser"
+)
+
+// GetOsAppStateParams holds the parameters of PWA.getOsAppState.
+type GetOsAppStateParams struct {
+	// The id from the webapp's manifest file, commonly it's the url of the
+	// site installing the webapp. See
+	// https://web.dev/learn/pwa/web-app-manifest.
+	ManifestID string `json:"manifestId"`
+}
+
+// GetOsAppStateResult holds the result of PWA.getOsAppState.
+type GetOsAppStateResult struct {
+	BadgeCount   int64         `json:"badgeCount"`
+	FileHandlers []FileHandler `json:"fileHandlers"`
+}
+
+// GetOsAppState calls PWA.getOsAppState.
+//
+// Returns the following OS state for the given manifest id.
+func GetOsAppState(ctx context.Context, c *internal.Client, sessionID string, params *GetOsAppStateParams) (*GetOsAppStateResult, error) {
+	if params == nil {
+		params = &GetOsAppStateParams{}
+	}
+	var result GetOsAppStateResult
+	err := c.Call(ctx, "PWA.getOsAppState", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// InstallParams holds the parameters of PWA.install.
+type InstallParams struct {
+	ManifestID string `json:"manifestId"`
+	// The location of the app or bundle overriding the one derived from the
+	// manifestId.
+	InstallURLOrBundleURL string `json:"installUrlOrBundleUrl,omitempty"`
+}
+
+// Install calls PWA.install.
+//
+// Installs the given manifest identity, optionally using the given install_url
+// or IWA bundle location.
+//
+// TODO(crbug.com/337872319) Support IWA to meet the following specific
+// requirement.
+// IWA-specific install description: If the manifest_id is isolated-app://,
+// install_url_or_bundle_url is required, and can be either an http(s) URL or
+// file:// URL pointing to a signed web bundle (.swbn). The .swbn file's
+// signing key must correspond to manifest_id. If Chrome is not in IWA dev
+// mode, the installation will fail, regardless of the state of the allowlist.
+func Install(ctx context.Context, c *internal.Client, sessionID string, params *InstallParams) error {
+	if params == nil {
+		params = &InstallParams{}
+	}
+	return c.Call(ctx, "PWA.install", params, sessionID, nil)
+}
+
+// UninstallParams holds the parameters of PWA.uninstall.
+type UninstallParams struct {
+	ManifestID string `json:"manifestId"`
+}
+
+// Uninstall calls PWA.uninstall.
+//
+// Uninstalls the given manifest_id and closes any opened app windows.
+func Uninstall(ctx context.Context, c *internal.Client, sessionID string, params *UninstallParams) error {
+	if params == nil {
+		params = &UninstallParams{}
+	}
+	return c.Call(ctx, "PWA.uninstall", params, sessionID, nil)
+}
+
+// LaunchParams holds the parameters of PWA.launch.
+type LaunchParams struct {
+	ManifestID string `json:"manifestId"`
+	URL        string `json:"url,omitempty"`
+}
+
+// LaunchResult holds the result of PWA.launch.
+type LaunchResult struct {
+	// ID of the tab target created as a result.
+	TargetID target.TargetID `json:"targetId"`
+}
+
+// Launch calls PWA.launch.
+//
+// Launches the installed web app, or an url in the same web app instead of the
+// default start url if it is provided. Returns a page Target.TargetID which
+// can be used to attach to via Target.attachToTarget or similar APIs.
+func Launch(ctx context.Context, c *internal.Client, sessionID string, params *LaunchParams) (*LaunchResult, error) {
+	if params == nil {
+		params = &LaunchParams{}
+	}
+	var result LaunchResult
+	err := c.Call(ctx, "PWA.launch", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// LaunchFilesInAppParams holds the parameters of PWA.launchFilesInApp.
+type LaunchFilesInAppParams struct {
+	ManifestID string   `json:"manifestId"`
+	Files      []string `json:"files"`
+}
+
+// LaunchFilesInAppResult holds the result of PWA.launchFilesInApp.
+type LaunchFilesInAppResult struct {
+	// IDs of the tab targets created as the result.
+	TargetIDs []target.TargetID `json:"targetIds"`
+}
+
+// LaunchFilesInApp calls PWA.launchFilesInApp.
+//
+// Opens one or more local files from an installed web app identified by its
+// manifestId. The web app needs to have file handlers registered to process
+// the files. The API returns one or more page Target.TargetIDs which can be
+// used to attach to via Target.attachToTarget or similar APIs.
+// If some files in the parameters cannot be handled by the web app, they will
+// be ignored. If none of the files can be handled, this API returns an error.
+// If no files are provided as the parameter, this API also returns an error.
+//
+// According to the definition of the file handlers in the manifest file, one
+// Target.TargetID may represent a page handling one or more files. The order
+// of the returned Target.TargetIDs is not guaranteed.
+//
+// TODO(crbug.com/339454034): Check the existences of the input files.
+func LaunchFilesInApp(ctx context.Context, c *internal.Client, sessionID string, params *LaunchFilesInAppParams) (*LaunchFilesInAppResult, error) {
+	if params == nil {
+		params = &LaunchFilesInAppParams{}
+	}
+	var result LaunchFilesInAppResult
+	err := c.Call(ctx, "PWA.launchFilesInApp", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// OpenCurrentPageInAppParams holds the parameters of PWA.openCurrentPageInApp.
+type OpenCurrentPageInAppParams struct {
+	ManifestID string `json:"manifestId"`
+}
+
+// OpenCurrentPageInApp calls PWA.openCurrentPageInApp.
+//
+// Opens the current page in its web app identified by the manifest id, needs
+// to be called on a page target. This function returns immediately without
+// waiting for the app to finish loading.
+func OpenCurrentPageInApp(ctx context.Context, c *internal.Client, sessionID string, params *OpenCurrentPageInAppParams) error {
+	if params == nil {
+		params = &OpenCurrentPageInAppParams{}
+	}
+	return c.Call(ctx, "PWA.openCurrentPageInApp", params, sessionID, nil)
+}
+
+// ChangeAppUserSettingsParams holds the parameters of PWA.changeAppUserSettings.
+type ChangeAppUserSettingsParams struct {
+	ManifestID string `json:"manifestId"`
+	// If user allows the links clicked on by the user in the app's scope, or
+	// extended scope if the manifest has scope extensions and the flags
+	// `DesktopPWAsLinkCapturingWithScopeExtensions` and
+	// `WebAppEnableScopeExtensions` are enabled.
+	//
+	// Note, the API does not support resetting the linkCapturing to the
+	// initial value, uninstalling and installing the web app again will reset
+	// it.
+	//
+	// TODO(crbug.com/339453269): Setting this value on ChromeOS is not
+	// supported yet.
+	LinkCapturing *bool       `json:"linkCapturing,omitempty"`
+	DisplayMode   DisplayMode `json:"displayMode,omitempty"`
+}
+
+// ChangeAppUserSettings calls PWA.changeAppUserSettings.
+//
+// Changes user settings of the web app identified by its manifestId. If the
+// app was not installed, this command returns an error. Unset parameters will
+// be ignored; unrecognized values will cause an error.
+//
+// Unlike the ones defined in the manifest files of the web apps, these
+// settings are provided by the browser and controlled by the users, they
+// impact the way the browser handling the web apps.
+//
+// See the comment of each parameter.
+func ChangeAppUserSettings(ctx context.Context, c *internal.Client, sessionID string, params *ChangeAppUserSettingsParams) error {
+	if params == nil {
+		params = &ChangeAppUserSettingsParams{}
+	}
+	return c.Call(ctx, "PWA.changeAppUserSettings", params, sessionID, nil)
+}
@@ -0,0 +1,1130 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package runtime provides typed bindings for the CDP Runtime domain.
+//
+// Runtime domain exposes JavaScript runtime by means of remote evaluation and mirror objects.
+// Evaluation results are returned as mirror object that expose object type, string representation
+// and unique identifier that can be used for further object reference. Original objects are
+// maintained in memory unless they are either explicitly released or are released along with the
+// other objects in their object group.
+package runtime
+
+import (
+	"cdp/internal"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// ScriptID is the Runtime.ScriptId type.
+//
+// Unique script identifier.
+type ScriptID string
+
+// SerializationOptions is the Runtime.SerializationOptions type.
+//
+// Represents options for serialization. Overrides `generatePreview` and `returnByValue`.
+type SerializationOptions struct {
+	Serialization string `json:"serialization"`
+	// Deep serialization depth. Default is full depth. Respected only in `deep` serialization mode.
+	MaxDepth *int64 `json:"maxDepth,omitempty"`
+	// Embedder-specific parameters. For example if connected to V8 in Chrome these control DOM
+	// serialization via `maxNodeDepth: integer` and `includeShadowTree: "none" | "open" | "all"`.
+	// Values can be only of type string or integer.
+	AdditionalParameters map[string]any `json:"additionalParameters,omitempty"`
+}
+
+// DeepSerializedValue is the Runtime.DeepSerializedValue type.
+//
+// Represents deep serialized value.
+type DeepSerializedValue struct {
+	Type     string          `json:"type"`
+	Value    json.RawMessage `json:"value,omitempty"`
+	ObjectID string          `json:"objectId,omitempty"`
+	// Set if value reference met more then once during serialization. In such
+	// case, value is provided only to one of the serialized values. Unique
+	// per value in the scope of one CDP call.
+	WeakLocalObjectReference *int64 `json:"weakLocalObjectReference,omitempty"`
+}
+
+// RemoteObjectID is the Runtime.RemoteObjectId type.
+//
+// Unique object identifier.
+type RemoteObjectID string
+
+// UnserializableValue is the Runtime.UnserializableValue type.
+//
+// Primitive value which cannot be JSON-stringified. Includes values `-0`, `NaN`, `Infinity`,
+// `-Infinity`, and bigint literals.
+type UnserializableValue string
+
+// RemoteObject is the Runtime.RemoteObject type.
+//
+// Mirror object referencing original JavaScript object.
+type RemoteObject struct {
+	// Object type.
+	Type string `json:"type"`
+	// Object subtype hint. Specified for `object` type values only.
+	// NOTE: If you change anything here, make sure to also update
+	// `subtype` in `ObjectPreview` and `PropertyPreview` below.
+	Subtype string `json:"subtype,omitempty"`
+	// Object class (constructor) name. Specified for `object` type values only.
+	ClassName string `json:"className,omitempty"`
+	// Remote object value in case of primitive values or JSON values (if it was requested).
+	Value json.RawMessage `json:"value,omitempty"`
+	// Primitive value which can not be JSON-stringified does not have `value`, but gets this
+	// property.
+	UnserializableValue UnserializableValue `json:"unserializableValue,omitempty"`
+	// String representation of the object.
+	Description string `json:"description,omitempty"`
+	// Deep serialized value.
+	//
+	// Experimental.
+	DeepSerializedValue *DeepSerializedValue `json:"deepSerializedValue,omitempty"`
+	// Unique object identifier (for non-primitive values).
+	ObjectID RemoteObjectID `json:"objectId,omitempty"`
+	// Preview containing abbreviated property values. Specified for `object` type values only.
+	//
+	// Experimental.
+	Preview *ObjectPreview `json:"preview,omitempty"`
+	// Experimental.
+	CustomPreview *CustomPreview `json:"customPreview,omitempty"`
+}
+
+// CustomPreview is the Runtime.CustomPreview type.
+//
+// Experimental.
+type CustomPreview struct {
+	// The JSON-stringified result of formatter.header(object, config) call.
+	// It contains json ML array that represents RemoteObject.
+	Header string `json:"header"`
+	// If formatter returns true as a result of formatter.hasBody call then bodyGetterId will
+	// contain RemoteObjectId for the function that returns result of formatter.body(object, config) call.
+	// The result value is json ML array.
+	BodyGetterID RemoteObjectID `json:"bodyGetterId,omitempty"`
+}
+
+// ObjectPreview is the Runtime.ObjectPreview type.
+//
+// Object containing abbreviated remote object value.
+//
+// Experimental.
+type ObjectPreview struct {
+	// Object type.
+	Type string `json:"type"`
+	// Object subtype hint. Specified for `object` type values only.
+	Subtype string `json:"subtype,omitempty"`
+	// String representation of the object.
+	Description string `json:"description,omitempty"`
+	// True iff some of the properties or entries of the original object did not fit.
+	Overflow bool `json:"overflow"`
+	// List of the properties.
+	Properties []PropertyPreview `json:"properties"`
+	// List of the entries. Specified for `map` and `set` subtype values only.
+	Entries []EntryPreview `json:"entries,omitempty"`
+}
+
+// PropertyPreview is the Runtime.PropertyPreview type.
+//
+// Experimental.
+type PropertyPreview struct {
+	// Property name.
+	Name string `json:"name"`
+	// Object type. Accessor means that the property itself is an accessor property.
+	Type string `json:"type"`
+	// User-friendly property value string.
+	Value string `json:"value,omitempty"`
+	// Nested value preview.
+	ValuePreview *ObjectPreview `json:"valuePreview,omitempty"`
+	// Object subtype hint. Specified for `object` type values only.
+	Subtype string `json:"subtype,omitempty"`
+}
+
+// EntryPreview is the Runtime.EntryPreview type.
+//
+// Experimental.
+type EntryPreview struct {
+	// Preview of the key. Specified for map-like collection entries.
+	Key *ObjectPreview `json:"key,omitempty"`
+	// Preview of the value.
+	Value ObjectPreview `json:"value"`
+}
+
+// PropertyDescriptor is the Runtime.PropertyDescriptor type.
+//
+// Object property descriptor.
+type PropertyDescriptor struct {
+	// Property name or symbol description.
+	Name string `json:"name"`
+	// The value associated with the property.
+	Value *RemoteObject `json:"value,omitempty"`
+	// True if the value associated with the property may be changed (data descriptors only).
+	Writable *bool `json:"writable,omitempty"`
+	// A function which serves as a getter for the property, or `undefined` if there is no getter
+	// (accessor descriptors only).
+	Get *RemoteObject `json:"get,omitempty"`
+	// A function which serves as a setter for the property, or `undefined` if there is no setter
+	// (accessor descriptors only).
+	Set *RemoteObject `json:"set,omitempty"`
+	// True if the type of this property descriptor may be changed and if the property may be
+	// deleted from the corresponding object.
+	Configurable bool `json:"configurable"`
+	// True if this property shows up during enumeration of the properties on the corresponding
+	// object.
+	Enumerable bool `json:"enumerable"`
+	// True if the result was thrown during the evaluation.
+	WasThrown *bool `json:"wasThrown,omitempty"`
+	// True if the property is owned for the object.
+	IsOwn *bool `json:"isOwn,omitempty"`
+	// Property symbol object, if the property is of the `symbol` type.
+	Symbol *RemoteObject `json:"symbol,omitempty"`
+}
+
+// InternalPropertyDescriptor is the Runtime.InternalPropertyDescriptor type.
+//
+// Object internal property descriptor. This property isn't normally visible in JavaScript code.
+type InternalPropertyDescriptor struct {
+	// Conventional property name.
+	Name string `json:"name"`
+	// The value associated with the property.
+	Value *RemoteObject `json:"value,omitempty"`
+}
+
+// PrivatePropertyDescriptor is the Runtime.PrivatePropertyDescriptor type.
+//
+// Object private field descriptor.
+//
+// Experimental.
+type PrivatePropertyDescriptor struct {
+	// Private property name.
+	Name string `json:"name"`
+	// The value associated with the private property.
+	Value *RemoteObject `json:"value,omitempty"`
+	// A function which serves as a getter for the private property,
+	// or `undefined` if there is no getter (accessor descriptors only).
+	Get *RemoteObject `json:"get,omitempty"`
+	// A function which serves as a setter for the private property,
+	// or `undefined` if there is no setter (accessor descriptors only).
+	Set *RemoteObject `json:"set,omitempty"`
+}
+
+// CallArgument is the Runtime.CallArgument type.
+//
+// Represents function call argument. Either remote object id `objectId`, primitive `value`,
+// unserializable primitive value or neither of (for undefined) them should be specified.
+type CallArgument struct {
+	// Primitive value or serializable javascript object.
+	Value json.RawMessage `json:"value,omitempty"`
+	// Primitive value which can not be JSON-stringified.
+	UnserializableValue UnserializableValue `json:"unserializableValue,omitempty"`
+	// Remote object handle.
+	ObjectID RemoteObjectID `json:"objectId,omitempty"`
+}
+
+// ExecutionContextID is the Runtime.ExecutionContextId type.
+//
+// Id of an execution context.
+type ExecutionContextID int64
+
+// ExecutionContextDescription is the Runtime.ExecutionContextDescription type.
+//
+// Description of an isolated world.
+type ExecutionContextDescription struct {
+	// Unique id of the execution context. It can be used to specify in which execution context
+	// script evaluation should be performed.
+	ID ExecutionContextID `json:"id"`
+	// Execution context origin.
+	Origin string `json:"origin"`
+	// Human readable name describing given context.
+	Name string `json:"name"`
+	// A system-unique execution context identifier. Unlike the id, this is unique across
+	// multiple processes, so can be reliably used to identify specific context while backend
+	// performs a cross-process navigation.
+	//
+	// Experimental.
+	UniqueID string `json:"uniqueId"`
+	// Embedder-specific auxiliary data likely matching {isDefault: boolean, type: 'default'|'isolated'|'worker', frameId: string}
+	AuxData map[string]any `json:"auxData,omitempty"`
+}
+
+// ExceptionDetails is the Runtime.ExceptionDetails type.
+//
+// Detailed information about exception (or error) that was thrown during script compilation or
+// execution.
+type ExceptionDetails struct {
+	// Exception id.
+	ExceptionID int64 `json:"exceptionId"`
+	// Exception text, which should be used together with exception object when available.
+	Text string `json:"text"`
+	// Line number of the exception location (0-based).
+	LineNumber int64 `json:"lineNumber"`
+	// Column number of the exception location (0-based).
+	ColumnNumber int64 `json:"columnNumber"`
+	// Script ID of the exception location.
+	ScriptID ScriptID `json:"scriptId,omitempty"`
+	// URL of the exception location, to be used when the script was not reported.
+	URL string `json:"url,omitempty"`
+	// JavaScript stack trace if available.
+	StackTrace *StackTrace `json:"stackTrace,omitempty"`
+	// Exception object if available.
+	Exception *RemoteObject `json:"exception,omitempty"`
+	// Identifier of the context where exception happened.
+	ExecutionContextID *ExecutionContextID `json:"executionContextId,omitempty"`
+	// Dictionary with entries of meta data that the client associated
+	// with this exception, such as information about associated network
+	// requests, etc.
+	//
+	// Experimental.
+	ExceptionMetaData map[string]any `json:"exceptionMetaData,omitempty"`
+}
+
+// Timestamp is the Runtime.Timestamp type.
+//
+// Number of milliseconds since epoch.
+type Timestamp float64
+
+// TimeDelta is the Runtime.TimeDelta type.
+//
+// Number of milliseconds.
+type TimeDelta float64
+
+// CallFrame is the Runtime.CallFrame type.
+//
+// Stack entry for runtime errors and assertions.
+type CallFrame struct {
+	// JavaScript function name.
+	FunctionName string `json:"functionName"`
+	// JavaScript script id.
+	ScriptID ScriptID `json:"scriptId"`
+	// JavaScript script name or url.
+	URL string `json:"url"`
+	// JavaScript script line number (0-based).
+	LineNumber int64 `json:"lineNumber"`
+	// JavaScript script column number (0-based).
+	ColumnNumber int64 `json:"columnNumber"`
+}
+
+// StackTrace is the Runtime.StackTrace type.
+//
+// Call frames for assertions or error messages.
+type StackTrace struct {
+	// String label of this stack trace. For async traces this may be a name of the function that
+	// initiated the async call.
+	Description string `json:"description,omitempty"`
+	// JavaScript function name.
+	CallFrames []CallFrame `json:"callFrames"`
+	// Asynchronous JavaScript stack trace that preceded this stack, if available.
+	Parent *StackTrace `json:"parent,omitempty"`
+	// Asynchronous JavaScript stack trace that preceded this stack, if available.
+	//
+	// Experimental.
+	ParentID *StackTraceID `json:"parentId,omitempty"`
+}
+
+// UniqueDebuggerID is the Runtime.UniqueDebuggerId type.
+//
+// Unique identifier of current debugger.
+//
+// Experimental.
+type UniqueDebuggerID string
+
+// StackTraceID is the Runtime.StackTraceId type.
+//
+// If `debuggerId` is set stack trace comes from another debugger and can be resolved there. This
+// allows to track cross-debugger calls. See `Runtime.StackTrace` and `Debugger.paused` for usages.
+//
+// Experimental.
+type StackTraceID struct {
+	ID         string           `json:"id"`
+	DebuggerID UniqueDebuggerID `json:"debuggerId,omitempty"`
+}
+
+// AwaitPromiseParams holds the parameters of Runtime.awaitPromise.
+type AwaitPromiseParams struct {
+	// Identifier of the promise.
+	PromiseObjectID RemoteObjectID `json:"promiseObjectId"`
+	// Whether the result is expected to be a JSON object that should be sent by value.
+	ReturnByValue *bool `json:"returnByValue,omitempty"`
+	// Whether preview should be generated for the result.
+	GeneratePreview *bool `json:"generatePreview,omitempty"`
+}
+
+// AwaitPromiseResult holds the result of Runtime.awaitPromise.
+type AwaitPromiseResult struct {
+	// Promise result. Will contain rejected value if promise was rejected.
+	Result RemoteObject `json:"result"`
+	// Exception details if stack strace is available.
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// AwaitPromise calls Runtime.awaitPromise.
+//
+// Add handler to promise with given promise object id.
+func AwaitPromise(ctx context.Context, c *internal.Client, sessionID string, params *AwaitPromiseParams) (*AwaitPromiseResult, error) {
+	if params == nil {
+		params = &AwaitPromiseParams{}
+	}
+	var result AwaitPromiseResult
+	err := c.Call(ctx, "Runtime.awaitPromise", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CallFunctionOnParams holds the parameters of Runtime.callFunctionOn.
+type CallFunctionOnParams struct {
+	// Declaration of the function to call.
+	FunctionDeclaration string `json:"functionDeclaration"`
+	// Identifier of the object to call function on. Either objectId or executionContextId should
+	// be specified.
+	ObjectID RemoteObjectID `json:"objectId,omitempty"`
+	// Call arguments. All call arguments must belong to the same JavaScript world as the target
+	// object.
+	Arguments []CallArgument `json:"arguments,omitempty"`
+	// In silent mode exceptions thrown during evaluation are not reported and do not pause
+	// execution. Overrides `setPauseOnException` state.
+	Silent *bool `json:"silent,omitempty"`
+	// Whether the result is expected to be a JSON object which should be sent by value.
+	// Can be overriden by `serializationOptions`.
+	ReturnByValue *bool `json:"returnByValue,omitempty"`
+	// Whether preview should be generated for the result.
+	//
+	// Experimental.
+	GeneratePreview *bool `json:"generatePreview,omitempty"`
+	// Whether execution should be treated as initiated by user in the UI.
+	UserGesture *bool `json:"userGesture,omitempty"`
+	// Whether execution should `await` for resulting value and return once awaited promise is
+	// resolved.
+	AwaitPromise *bool `json:"awaitPromise,omitempty"`
+	// Specifies execution context which global object will be used to call function on. Either
+	// executionContextId or objectId should be specified.
+	ExecutionContextID *ExecutionContextID `json:"executionContextId,omitempty"`
+	// Symbolic group name that can be used to release multiple objects. If objectGroup is not
+	// specified and objectId is, objectGroup will be inherited from object.
+	ObjectGroup string `json:"objectGroup,omitempty"`
+	// Whether to throw an exception if side effect cannot be ruled out during evaluation.
+	//
+	// Experimental.
+	ThrowOnSideEffect *bool `json:"throwOnSideEffect,omitempty"`
+	// An alternative way to specify the execution context to call function on.
+	// Compared to contextId that may be reused across processes, this is guaranteed to be
+	// system-unique, so it can be used to prevent accidental function call
+	// in context different than intended (e.g. as a result of navigation across process
+	// boundaries).
+	// This is mutually exclusive with `executionContextId`.
+	//
+	// Experimental.
+	UniqueContextID string `json:"uniqueContextId,omitempty"`
+	// Specifies the result serialization. If provided, overrides
+	// `generatePreview` and `returnByValue`.
+	//
+	// Experimental.
+	SerializationOptions *SerializationOptions `json:"serializationOptions,omitempty"`
+}
+
+// CallFunctionOnResult holds the result of Runtime.callFunctionOn.
+type CallFunctionOnResult struct {
+	// Call result.
+	Result RemoteObject `json:"result"`
+	// Exception details.
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// CallFunctionOn calls Runtime.callFunctionOn.
+//
+// Calls function with given declaration on the given object. Object group of the result is
+// inherited from the target object.
+func CallFunctionOn(ctx context.Context, c *internal.Client, sessionID string, params *CallFunctionOnParams) (*CallFunctionOnResult, error) {
+	if params == nil {
+		params = &CallFunctionOnParams{}
+	}
+	var result CallFunctionOnResult
+	err := c.Call(ctx, "Runtime.callFunctionOn", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CompileScriptParams holds the parameters of Runtime.compileScript.
+type CompileScriptParams struct {
+	// Expression to compile.
+	Expression string `json:"expression"`
+	// Source url to be set for the script.
+	SourceURL string `json:"sourceURL"`
+	// Specifies whether the compiled script should be persisted.
+	PersistScript bool `json:"persistScript"`
+	// Specifies in which execution context to perform script run. If the parameter is omitted the
+	// evaluation will be performed in the context of the inspected page.
+	ExecutionContextID *ExecutionContextID `json:"executionContextId,omitempty"`
+}
+
+// CompileScriptResult holds the result of Runtime.compileScript.
+type CompileScriptResult struct {
+	// Id of the script.
+	ScriptID ScriptID `json:"scriptId,omitempty"`
+	// Exception details.
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// CompileScript calls Runtime.compileScript.
+//
+// Compiles expression.
+func CompileScript(ctx context.Context, c *internal.Client, sessionID string, params *CompileScriptParams) (*CompileScriptResult, error) {
+	if params == nil {
+		params = &CompileScriptParams{}
+	}
+	var result CompileScriptResult
+	err := c.Call(ctx, "Runtime.compileScript", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Disable calls Runtime.disable.
+//
+// Disables reporting of execution contexts creation.
+func Disable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Runtime.disable", nil, sessionID, nil)
+}
+
+// DiscardConsoleEntries calls Runtime.discardConsoleEntries.
+//
+// Discards collected exceptions and console API calls.
+func DiscardConsoleEntries(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Runtime.discardConsoleEntries", nil, sessionID, nil)
+}
+
+// Enable calls Runtime.enable.
+//
+// Enables reporting of execution contexts creation by means of `executionContextCreated` event.
+// When the reporting gets enabled the event will be sent immediately for each existing execution
+// context.
+func Enable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Runtime.enable", nil, sessionID, nil)
+}
+
+// EvaluateParams holds the parameters of Runtime.evaluate.
+type EvaluateParams struct {
+	// Expression to evaluate.
+	Expression string `json:"expression"`
+	// Symbolic group name that can be used to release multiple objects.
+	ObjectGroup string `json:"objectGroup,omitempty"`
+	// Determines whether Command Line API should be available during the evaluation.
+	IncludeCommandLineAPI *bool `json:"includeCommandLineAPI,omitempty"`
+	// In silent mode exceptions thrown during evaluation are not reported and do not pause
+	// execution. Overrides `setPauseOnException` state.
+	Silent *bool `json:"silent,omitempty"`
+	// Specifies in which execution context to perform evaluation. If the parameter is omitted the
+	// evaluation will be performed in the context of the inspected page.
+	// This is mutually exclusive with `uniqueContextId`, which offers an
+	// alternative way to identify the execution context that is more reliable
+	// in a multi-process environment.
+	ContextID *ExecutionContextID `json:"contextId,omitempty"`
+	// Whether the result is expected to be a JSON object that should be sent by value.
+	ReturnByValue *bool `json:"returnByValue,omitempty"`
+	// Whether preview should be generated for the result.
+	//
+	// Experimental.
+	GeneratePreview *bool `json:"generatePreview,omitempty"`
+	// Whether execution should be treated as initiated by user in the UI.
+	UserGesture *bool `json:"userGesture,omitempty"`
+	// Whether execution should `await` for resulting value and return once awaited promise is
+	// resolved.
+	AwaitPromise *bool `json:"awaitPromise,omitempty"`
+	// Whether to throw an exception if side effect cannot be ruled out during evaluation.
+	// This implies `disableBreaks` below.
+	//
+	// Experimental.
+	ThrowOnSideEffect *bool `json:"throwOnSideEffect,omitempty"`
+	// Terminate execution after timing out (number of milliseconds).
+	//
+	// Experimental.
+	Timeout *TimeDelta `json:"timeout,omitempty"`
+	// Disable breakpoints during execution.
+	//
+	// Experimental.
+	DisableBreaks *bool `json:"disableBreaks,omitempty"`
+	// Setting this flag to true enables `let` re-declaration and top-level `await`.
+	// Note that `let` variables can only be re-declared if they originate from
+	// `replMode` themselves.
+	//
+	// Experimental.
+	ReplMode *bool `json:"replMode,omitempty"`
+	// The Content Security Policy (CSP) for the target might block 'unsafe-eval'
+	// which includes eval(), Function(), setTimeout() and setInterval()
+	// when called with non-callable arguments. This flag bypasses CSP for this
+	// evaluation and allows unsafe-eval. Defaults to true.
+	//
+	// Experimental.
+	AllowUnsafeEvalBlockedByCSP *bool `json:"allowUnsafeEvalBlockedByCSP,omitempty"`
+	// An alternative way to specify the execution context to evaluate in.
+	// Compared to contextId that may be reused across processes, this is guaranteed to be
+	// system-unique, so it can be used to prevent accidental evaluation of the expression
+	// in context different than intended (e.g. as a result of navigation across process
+	// boundaries).
+	// This is mutually exclusive with `contextId`.
+	//
+	// Experimental.
+	UniqueContextID string `json:"uniqueContextId,omitempty"`
+	// Specifies the result serialization. If provided, overrides
+	// `generatePreview` and `returnByValue`.
+	//
+	// Experimental.
+	SerializationOptions *SerializationOptions `json:"serializationOptions,omitempty"`
+}
+
+// EvaluateResult holds the result of Runtime.evaluate.
+type EvaluateResult struct {
+	// Evaluation result.
+	Result RemoteObject `json:"result"`
+	// Exception details.
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// Evaluate calls Runtime.evaluate.
+//
+// Evaluates expression on global object.
+func Evaluate(ctx context.Context, c *internal.Client, sessionID string, params *EvaluateParams) (*EvaluateResult, error) {
+	if params == nil {
+		params = &EvaluateParams{}
+	}
+	var result EvaluateResult
+	err := c.Call(ctx, "Runtime.evaluate", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetIsolateIDResult holds the result of Runtime.getIsolateId.
+type GetIsolateIDResult struct {
+	// The isolate id.
+	ID string `json:"id"`
+}
+
+// GetIsolateID calls Runtime.getIsolateId.
+//
+// Returns the isolate id.
+//
+// Experimental.
+func GetIsolateID(ctx context.Context, c *internal.Client, sessionID string) (*GetIsolateIDResult, error) {
+	var result GetIsolateIDResult
+	err := c.Call(ctx, "Runtime.getIsolateId", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetHeapUsageResult holds the result of Runtime.getHeapUsage.
+type GetHeapUsageResult struct {
+	// Used heap size in bytes.
+	UsedSize float64 `json:"usedSize"`
+	// Allocated heap size in bytes.
+	TotalSize float64 `json:"totalSize"`
+}
+
+// GetHeapUsage calls Runtime.getHeapUsage.
+//
+// Returns the JavaScript heap usage.
+// It is the total usage of the corresponding isolate not scoped to a particular Runtime.
+//
+// Experimental.
+func GetHeapUsage(ctx context.Context, c *internal.Client, sessionID string) (*GetHeapUsageResult, error) {
+	var result GetHeapUsageResult
+	err := c.Call(ctx, "Runtime.getHeapUsage", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetPropertiesParams holds the parameters of Runtime.getProperties.
+type GetPropertiesParams struct {
+	// Identifier of the object to return properties for.
+	ObjectID RemoteObjectID `json:"objectId"`
+	// If true, returns properties belonging only to the element itself, not to its prototype
+	// chain.
+	OwnProperties *bool `json:"ownProperties,omitempty"`
+	// If true, returns accessor properties (with getter/setter) only; internal properties are not
+	// returned either.
+	//
+	// Experimental.
+	AccessorPropertiesOnly *bool `json:"accessorPropertiesOnly,omitempty"`
+	// Whether preview should be generated for the results.
+	//
+	// Experimental.
+	GeneratePreview *bool `json:"generatePreview,omitempty"`
+	// If true, returns non-indexed properties only.
+	//
+	// Experimental.
+	NonIndexedPropertiesOnly *bool `json:"nonIndexedPropertiesOnly,omitempty"`
+}
+
+// GetPropertiesResult holds the result of Runtime.getProperties.
+type GetPropertiesResult struct {
+	// Object properties.
+	Result []PropertyDescriptor `json:"result"`
+	// Internal object properties (only of the element itself).
+	InternalProperties []InternalPropertyDescriptor `json:"internalProperties,omitempty"`
+	// Object private properties.
+	//
+	// Experimental.
+	PrivateProperties []PrivatePropertyDescriptor `json:"privateProperties,omitempty"`
+	// Exception details.
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// GetProperties calls Runtime.getProperties.
+//
+// Returns properties of a given object. Object group of the result is inherited from the target
+// object.
+func GetProperties(ctx context.Context, c *internal.Client, sessionID string, params *GetPropertiesParams) (*GetPropertiesResult, error) {
+	if params == nil {
+		params = &GetPropertiesParams{}
+	}
+	var result GetPropertiesResult
+	err := c.Call(ctx, "Runtime.getProperties", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GlobalLexicalScopeNamesParams holds the parameters of Runtime.globalLexicalScopeNames.
+type GlobalLexicalScopeNamesParams struct {
+	// Specifies in which execution context to lookup global scope variables.
+	ExecutionContextID *ExecutionContextID `json:"executionContextId,omitempty"`
+}
+
+// GlobalLexicalScopeNamesResult holds the result of Runtime.globalLexicalScopeNames.
+type GlobalLexicalScopeNamesResult struct {
+	Names []string `json:"names"`
+}
+
+// GlobalLexicalScopeNames calls Runtime.globalLexicalScopeNames.
+//
+// Returns all let, const and class variables from global scope.
+func GlobalLexicalScopeNames(ctx context.Context, c *internal.Client, sessionID string, params *GlobalLexicalScopeNamesParams) (*GlobalLexicalScopeNamesResult, error) {
+	if params == nil {
+		params = &GlobalLexicalScopeNamesParams{}
+	}
+	var result GlobalLexicalScopeNamesResult
+	err := c.Call(ctx, "Runtime.globalLexicalScopeNames", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// QueryObjectsParams holds the parameters of Runtime.queryObjects.
+type QueryObjectsParams struct {
+	// Identifier of the prototype to return objects for.
+	PrototypeObjectID RemoteObjectID `json:"prototypeObjectId"`
+	// Symbolic group name that can be used to release the results.
+	ObjectGroup string `json:"objectGroup,omitempty"`
+}
+
+// QueryObjectsResult holds the result of Runtime.queryObjects.
+type QueryObjectsResult struct {
+	// Array with objects.
+	Objects RemoteObject `json:"objects"`
+}
+
+// QueryObjects calls Runtime.queryObjects.
+func QueryObjects(ctx context.Context, c *internal.Client, sessionID string, params *QueryObjectsParams) (*QueryObjectsResult, error) {
+	if params == nil {
+		params = &QueryObjectsParams{}
+	}
+	var result QueryObjectsResult
+	err := c.Call(ctx, "Runtime.queryObjects", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ReleaseObjectParams holds the parameters of Runtime.releaseObject.
+type ReleaseObjectParams struct {
+	// Identifier of the object to release.
+	ObjectID RemoteObjectID `json:"objectId"`
+}
+
+// ReleaseObject calls Runtime.releaseObject.
+//
+// Releases remote object with given id.
+func ReleaseObject(ctx context.Context, c *internal.Client, sessionID string, params *ReleaseObjectParams) error {
+	if params == nil {
+		params = &ReleaseObjectParams{}
+	}
+	return c.Call(ctx, "Runtime.releaseObject", params, sessionID, nil)
+}
+
+// ReleaseObjectGroupParams holds the parameters of Runtime.releaseObjectGroup.
+type ReleaseObjectGroupParams struct {
+	// Symbolic object group name.
+	ObjectGroup string `json:"objectGroup"`
+}
+
+// ReleaseObjectGroup calls Runtime.releaseObjectGroup.
+//
+// Releases all remote objects that belong to a given group.
+func ReleaseObjectGroup(ctx context.Context, c *internal.Client, sessionID string, params *ReleaseObjectGroupParams) error {
+	if params == nil {
+		params = &ReleaseObjectGroupParams{}
+	}
+	return c.Call(ctx, "Runtime.releaseObjectGroup", params, sessionID, nil)
+}
+
+// RunIfWaitingForDebugger calls Runtime.runIfWaitingForDebugger.
+//
+// Tells inspected instance to run if it was waiting for debugger to attach.
+func RunIfWaitingForDebugger(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Runtime.runIfWaitingForDebugger", nil, sessionID, nil)
+}
+
+// RunScriptParams holds the parameters of Runtime.runScript.
+type RunScriptParams struct {
+	// Id of the script to run.
+	ScriptID ScriptID `json:"scriptId"`
+	// Specifies in which execution context to perform script run. If the parameter is omitted the
+	// evaluation will be performed in the context of the inspected page.
+	ExecutionContextID *ExecutionContextID `json:"executionContextId,omitempty"`
+	// Symbolic group name that can be used to release multiple objects.
+	ObjectGroup string `json:"objectGroup,omitempty"`
+	// In silent mode exceptions thrown during evaluation are not reported and do not pause
+	// execution. Overrides `setPauseOnException` state.
+	Silent *bool `json:"silent,omitempty"`
+	// Determines whether Command Line API should be available during the evaluation.
+	IncludeCommandLineAPI *bool `json:"includeCommandLineAPI,omitempty"`
+	// Whether the result is expected to be a JSON object which should be sent by value.
+	ReturnByValue *bool `json:"returnByValue,omitempty"`
+	// Whether preview should be generated for the result.
+	GeneratePreview *bool `json:"generatePreview,omitempty"`
+	// Whether execution should `await` for resulting value and return once awaited promise is
+	// resolved.
+	AwaitPromise *bool `json:"awaitPromise,omitempty"`
+}
+
+// RunScriptResult holds the result of Runtime.runScript.
+type RunScriptResult struct {
+	// Run result.
+	Result RemoteObject `json:"result"`
+	// Exception details.
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// RunScript calls Runtime.runScript.
+//
+// Runs script with given id in a given context.
+func RunScript(ctx context.Context, c *internal.Client, sessionID string, params *RunScriptParams) (*RunScriptResult, error) {
+	if params == nil {
+		params = &RunScriptParams{}
+	}
+	var result RunScriptResult
+	err := c.Call(ctx, "Runtime.runScript", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SetAsyncCallStackDepthParams holds the parameters of Runtime.setAsyncCallStackDepth.
+type SetAsyncCallStackDepthParams struct {
+	// Maximum depth of async call stacks. Setting to `0` will effectively disable collecting async
+	// call stacks (default).
+	MaxDepth int64 `json:"maxDepth"`
+}
+
+// SetAsyncCallStackDepth calls Runtime.setAsyncCallStackDepth.
+//
+// Enables or disables async call stacks tracking.
+//
+// Redirects to Debugger.
+func SetAsyncCallStackDepth(ctx context.Context, c *internal.Client, sessionID string, params *SetAsyncCallStackDepthParams) error {
+	if params == nil {
+		params = &SetAsyncCallStackDepthParams{}
+	}
+	return c.Call(ctx, "Runtime.setAsyncCallStackDepth", params, sessionID, nil)
+}
+
+// SetCustomObjectFormatterEnabledParams holds the parameters of Runtime.setCustomObjectFormatterEnabled.
+type SetCustomObjectFormatterEnabledParams struct {
+	Enabled bool `json:"enabled"`
+}
+
+// SetCustomObjectFormatterEnabled calls Runtime.setCustomObjectFormatterEnabled.
+//
+// Experimental.
+func SetCustomObjectFormatterEnabled(ctx context.Context, c *internal.Client, sessionID string, params *SetCustomObjectFormatterEnabledParams) error {
+	if params == nil {
+		params = &SetCustomObjectFormatterEnabledParams{}
+	}
+	return c.Call(ctx, "Runtime.setCustomObjectFormatterEnabled", params, sessionID, nil)
+}
+
+// SetMaxCallStackSizeToCaptureParams holds the parameters of Runtime.setMaxCallStackSizeToCapture.
+type SetMaxCallStackSizeToCaptureParams struct {
+	Size int64 `json:"size"`
+}
+
+// SetMaxCallStackSizeToCapture calls Runtime.setMaxCallStackSizeToCapture.
+//
+// Experimental.
+func SetMaxCallStackSizeToCapture(ctx context.Context, c *internal.Client, sessionID string, params *SetMaxCallStackSizeToCaptureParams) error {
+	if params == nil {
+		params = &SetMaxCallStackSizeToCaptureParams{}
+	}
+	return c.Call(ctx, "Runtime.setMaxCallStackSizeToCapture", params, sessionID, nil)
+}
+
+// TerminateExecution calls Runtime.terminateExecution.
+//
+// Terminate current or next JavaScript execution.
+// Will cancel the termination when the outer-most script execution ends.
+//
+// Experimental.
+func TerminateExecution(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Runtime.terminateExecution", nil, sessionID, nil)
+}
+
+// AddBindingParams holds the parameters of Runtime.addBinding.
+type AddBindingParams struct {
+	Name string `json:"name"`
+	// If specified, the binding would only be exposed to the specified
+	// execution context. If omitted and `executionContextName` is not set,
+	// the binding is exposed to all execution contexts of the target.
+	// This parameter is mutually exclusive with `executionContextName`.
+	// Deprecated in favor of `executionContextName` due to an unclear use case
+	// and bugs in implementation (crbug.com/1169639). `executionContextId` will be
+	// removed in the future.
+	//
+	// Experimental.
+	//
+	// Deprecated: this item is deprecated in the protocol.
+	ExecutionContextID *ExecutionContextID `json:"executionContextId,omitempty"`
+	// If specified, the binding is exposed to the executionContext with
+	// matching name, even for contexts created after the binding is added.
+	// See also `ExecutionContext.name` and `worldName` parameter to
+	// `Page.addScriptToEvaluateOnNewDocument`.
+	// This parameter is mutually exclusive with `executionContextId`.
+	ExecutionContextName string `json:"executionContextName,omitempty"`
+}
+
+// AddBinding calls Runtime.addBinding.
+//
+// If executionContextId is empty, adds binding with the given name on the
+// global objects of all inspected contexts, including those created later,
+// bindings survive reloads.
+// Binding function takes exactly one argument, this argument should be string,
+// in case of any other input, function throws an exception.
+// Each binding function call produces Runtime.bindingCalled notification.
+func AddBinding(ctx context.Context, c *internal.Client, sessionID string, params *AddBindingParams) error {
+	if params == nil {
+		params = &AddBindingParams{}
+	}
+	return c.Call(ctx, "Runtime.addBinding", params, sessionID, nil)
+}
+
+// RemoveBindingParams holds the parameters of Runtime.removeBinding.
+type RemoveBindingParams struct {
+	Name string `json:"name"`
+}
+
+// RemoveBinding calls Runtime.removeBinding.
+//
+// This method does not remove binding function from global object but
+// unsubscribes current runtime agent from Runtime.bindingCalled notifications.
+func RemoveBinding(ctx context.Context, c *internal.Client, sessionID string, params *RemoveBindingParams) error {
+	if params == nil {
+		params = &RemoveBindingParams{}
+	}
+	return c.Call(ctx, "Runtime.removeBinding", params, sessionID, nil)
+}
+
+// GetExceptionDetailsParams holds the parameters of Runtime.getExceptionDetails.
+type GetExceptionDetailsParams struct {
+	// The error object for which to resolve the exception details.
+	ErrorObjectID RemoteObjectID `json:"errorObjectId"`
+}
+
+// GetExceptionDetailsResult holds the result of Runtime.getExceptionDetails.
+type GetExceptionDetailsResult struct {
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// GetExceptionDetails calls Runtime.getExceptionDetails.
+//
+// This method tries to lookup and populate exception details for a
+// JavaScript Error object.
+// Note that the stackTrace portion of the resulting exceptionDetails will
+// only be populated if the Runtime domain was enabled at the time when the
+// Error was thrown.
+//
+// Experimental.
+func GetExceptionDetails(ctx context.Context, c *internal.Client, sessionID string, params *GetExceptionDetailsParams) (*GetExceptionDetailsResult, error) {
+	if params == nil {
+		params = &GetExceptionDetailsParams{}
+	}
+	var result GetExceptionDetailsResult
+	err := c.Call(ctx, "Runtime.getExceptionDetails", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// EventBindingCalled is the method name of the Runtime.bindingCalled event.
+const EventBindingCalled = "Runtime.bindingCalled"
+
+// BindingCalledEvent is the payload of the Runtime.bindingCalled event.
+//
+// Notification is issued every time when binding is called.
+//
+// Experimental.
+type BindingCalledEvent struct {
+	Name    string `json:"name"`
+	Payload string `json:"payload"`
+	// Identifier of the context where the call was made.
+	ExecutionContextID ExecutionContextID `json:"executionContextId"`
+}
+
+// EventConsoleAPICalled is the method name of the Runtime.consoleAPICalled event.
+const EventConsoleAPICalled = "Runtime.consoleAPICalled"
+
+// ConsoleAPICalledEvent is the payload of the Runtime.consoleAPICalled event.
+//
+// Issued when console API was called.
+type ConsoleAPICalledEvent struct {
+	// Type of the call.
+	Type string `json:"type"`
+	// Call arguments.
+	Args []RemoteObject `json:"args"`
+	// Identifier of the context where the call was made.
+	ExecutionContextID ExecutionContextID `json:"executionContextId"`
+	// Call timestamp.
+	Timestamp Timestamp `json:"timestamp"`
+	// Stack trace captured when the call was made. The async stack chain is automatically reported for
+	// the following call types: `assert`, `error`, `trace`, `warning`. For other types the async call
+	// chain can be retrieved using `Debugger.getStackTrace` and `stackTrace.parentId` field.
+	StackTrace *StackTrace `json:"stackTrace,omitempty"`
+	// Console context descriptor for calls on non-default console context (not console.*):
+	// 'anonymous#unique-logger-id' for call on unnamed context, 'name#unique-logger-id' for call
+	// on named context.
+	//
+	// Experimental.
+	Context string `json:"context,omitempty"`
+}
+
+// EventExceptionRevoked is the method name of the Runtime.exceptionRevoked event.
+const EventExceptionRevoked = "Runtime.exceptionRevoked"
+
+// ExceptionRevokedEvent is the payload of the Runtime.exceptionRevoked event.
+//
+// Issued when unhandled exception was revoked.
+type ExceptionRevokedEvent struct {
+	// Reason describing why exception was revoked.
+	Reason string `json:"reason"`
+	// The id of revoked exception, as reported in `exceptionThrown`.
+	ExceptionID int64 `json:"exceptionId"`
+}
+
+// EventExceptionThrown is the method name of the Runtime.exceptionThrown event.
+const EventExceptionThrown = "Runtime.exceptionThrown"
+
+// ExceptionThrownEvent is the payload of the Runtime.exceptionThrown event.
+//
+// Issued when exception was thrown and unhandled.
+type ExceptionThrownEvent struct {
+	// Timestamp of the exception.
+	Timestamp        Timestamp        `json:"timestamp"`
+	ExceptionDetails ExceptionDetails `json:"exceptionDetails"`
+}
+
+// EventExecutionContextCreated is the method name of the Runtime.executionContextCreated event.
+const EventExecutionContextCreated = "Runtime.executionContextCreated"
+
+// ExecutionContextCreatedEvent is the payload of the Runtime.executionContextCreated event.
+//
+// Issued when new execution context is created.
+type ExecutionContextCreatedEvent struct {
+	// A newly created execution context.
+	Context ExecutionContextDescription `json:"context"`
+}
+
+// EventExecutionContextDestroyed is the method name of the Runtime.executionContextDestroyed event.
+const EventExecutionContextDestroyed = "Runtime.executionContextDestroyed"
+
+// ExecutionContextDestroyedEvent is the payload of the Runtime.executionContextDestroyed event.
+//
+// Issued when execution context is destroyed.
+type ExecutionContextDestroyedEvent struct {
+	// Id of the destroyed context
+	//
+	// Deprecated: this item is deprecated in the protocol.
+	ExecutionContextID ExecutionContextID `json:"executionContextId"`
+	// Unique Id of the destroyed context
+	//
+	// Experimental.
+	ExecutionContextUniqueID string `json:"executionContextUniqueId"`
+}
+
+// EventExecutionContextsCleared is the method name of the Runtime.executionContextsCleared event.
+const EventExecutionContextsCleared = "Runtime.executionContextsCleared"
+
+// ExecutionContextsClearedEvent is the payload of the Runtime.executionContextsCleared event.
+//
+// Issued when all executionContexts were cleared in browser
+type ExecutionContextsClearedEvent struct{}
+
+// EventInspectRequested is the method name of the Runtime.inspectRequested event.
+const EventInspectRequested = "Runtime.inspectRequested"
+
+// InspectRequestedEvent is the payload of the Runtime.inspectRequested event.
+//
+// Issued when object should be inspected (for example, as a result of inspect() command line API
+// call).
+type InspectRequestedEvent struct {
+	Object RemoteObject   `json:"object"`
+	Hints  map[string]any `json:"hints"`
+	// Identifier of the context where the call was made.
+	//
+	// Experimental.
+	ExecutionContextID *ExecutionContextID `json:"executionContextId,omitempty"`
+}
+
+// ParseEvent decodes a Runtime event message into its typed event struct.
+func ParseEvent(msg *internal.CDPMessage) (any, error) {
+	var v any
+	switch msg.Method {
+	case EventBindingCalled:
+		v = &BindingCalledEvent{}
+	case EventConsoleAPICalled:
+		v = &ConsoleAPICalledEvent{}
+	case EventExceptionRevoked:
+		v = &ExceptionRevokedEvent{}
+	case EventExceptionThrown:
+		v = &ExceptionThrownEvent{}
+	case EventExecutionContextCreated:
+		v = &ExecutionContextCreatedEvent{}
+	case EventExecutionContextDestroyed:
+		v = &ExecutionContextDestroyedEvent{}
+	case EventExecutionContextsCleared:
+		v = &ExecutionContextsClearedEvent{}
+	case EventInspectRequested:
+		v = &InspectRequestedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Runtime event: %s", msg.Method)
+	}
+	if msg.Params == nil {
+		return v, nil
+	}
+	err := json.Unmarshal(msg.Params, v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,39 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package schema provides typed bindings for the CDP Schema domain.
+//
+// This domain is deprecated.
+package schema
+
+import (
+	"cdp/internal"
+	"context"
+)
+
+// Domain is the Schema.Domain type.
+//
+// Description of the protocol domain.
+type Domain struct {
+	// Domain name.
+	Name string `json:"name"`
+	// Domain version.
+	Version string `json:"version"`
+}
+
+// GetDomainsResult holds the result of Schema.getDomains.
+type GetDomainsResult struct {
+	// List of supported domains.
+	Domains []Domain `json:"domains"`
+}
+
+// GetDomains calls Schema.getDomains.
+//
+// Returns supported domains.
+func GetDomains(ctx context.Context, c *internal.Client, sessionID string) (*GetDomainsResult, error) {
+	var result GetDomainsResult
+	err := c.Call(ctx, "Schema.getDomains", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,332 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package security provides typed bindings for the CDP Security domain.
+//
+// Security
+package security
+
+import (
+	"cdp/internal"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// CertificateID is the Security.CertificateId type.
+//
+// An internal certificate ID value.
+type CertificateID int64
+
+// MixedContentType is the Security.MixedContentType type.
+//
+// A description of mixed content (HTTP resources on HTTPS pages), as defined by
+// https://www.w3.org/TR/mixed-content/#categories
+type MixedContentType string
+
+const (
+	MixedContentTypeBlockable           MixedContentType = "blockable"
+	MixedContentTypeOptionallyBlockable MixedContentType = "optionally-blockable"
+	MixedContentTypeNone                MixedContentType = "none"
+)
+
+// SecurityState is the Security.SecurityState type.
+//
+// The security level of a page or resource.
+type SecurityState string
+
+const (
+	SecurityStateUnknown        SecurityState = "unknown"
+	SecurityStateNeutral        SecurityState = "neutral"
+	SecurityStateInsecure       SecurityState = "insecure"
+	SecurityStateSecure         SecurityState = "secure"
+	SecurityStateInfo           SecurityState = "info"
+	SecurityStateInsecureBroken SecurityState = "insecure-broken"
+)
+
+// CertificateSecurityState is the Security.CertificateSecurityState type.
+//
+// Details about the security state of the page certificate.
+//
+// Experimental.
+type CertificateSecurityState struct {
+	// Protocol name (e.g. "TLS 1.2" or "QUIC").
+	Protocol string `json:"protocol"`
+	// Key Exchange used by the connection, or the empty string if not applicable.
+	KeyExchange string `json:"keyExchange"`
+	// (EC)DH group used by the connection, if applicable.
+	KeyExchangeGroup string `json:"keyExchangeGroup,omitempty"`
+	// Cipher name.
+	Cipher string `json:"cipher"`
+	// TLS MAC. Note that AEAD ciphers do not have separate MACs.
+	Mac string `json:"mac,omitempty"`
+	// Page certificate.
+	Certificate []string `json:"certificate"`
+	// Certificate subject name.
+	SubjectName string `json:"subjectName"`
+	// Name of the issuing CA.
+	Issuer string `json:"issuer"`
+	// Certificate valid from date.
+	ValidFrom float64 `json:"validFrom"`
+	// Certificate valid to (expiration) date
+	ValidTo float64 `json:"validTo"`
+	// The highest priority network error code, if the certificate has an error.
+	CertificateNetworkError string `json:"certificateNetworkError,omitempty"`
+	// True if the certificate uses a weak signature algorithm.
+	CertificateHasWeakSignature bool `json:"certificateHasWeakSignature"`
+	// True if the certificate has a SHA1 signature in the chain.
+	CertificateHasSha1Signature bool `json:"certificateHasSha1Signature"`
+	// True if modern SSL
+	ModernSSL bool `json:"modernSSL"`
+	// True if the connection is using an obsolete SSL protocol.
+	ObsoleteSSLProtocol bool `json:"obsoleteSslProtocol"`
+	// True if the connection is using an obsolete SSL key exchange.
+	ObsoleteSSLKeyExchange bool `json:"obsoleteSslKeyExchange"`
+	// True if the connection is using an obsolete SSL cipher.
+	ObsoleteSSLCipher bool `json:"obsoleteSslCipher"`
+	// True if the connection is using an obsolete SSL signature.
+	ObsoleteSSLSignature bool `json:"obsoleteSslSignature"`
+}
+
+// SafetyTipStatus is the Security.SafetyTipStatus type.
+//
+// Experimental.
+type SafetyTipStatus string
+
+const (
+	SafetyTipStatusBadReputation SafetyTipStatus = "badReputation"
+	SafetyTipStatusLookalike     SafetyTipStatus = "lookalike"
+)
+
+// SafetyTipInfo is the Security.SafetyTipInfo type.
+//
+// Experimental.
+type SafetyTipInfo struct {
+	// Describes whether the page triggers any safety tips or reputation warnings. Default is unknown.
+	SafetyTipStatus SafetyTipStatus `json:"safetyTipStatus"`
+	// The URL the safety tip suggested ("Did you mean?"). Only filled in for lookalike matches.
+	SafeURL string `json:"safeUrl,omitempty"`
+}
+
+// VisibleSecurityState is the Security.VisibleSecurityState type.
+//
+// Security state information about the page.
+//
+// Experimental.
+type VisibleSecurityState struct {
+	// The security level of the page.
+	SecurityState SecurityState `json:"securityState"`
+	// Security state details about the page certificate.
+	CertificateSecurityState *CertificateSecurityState `json:"certificateSecurityState,omitempty"`
+	// The type of Safety Tip triggered on the page. Note that this field will be set even if the Safety Tip UI was not actually shown.
+	SafetyTipInfo *SafetyTipInfo `json:"safetyTipInfo,omitempty"`
+	// Array of security state issues ids.
+	SecurityStateIssueIDs []string `json:"securityStateIssueIds"`
+}
+
+// SecurityStateExplanation is the Security.SecurityStateExplanation type.
+//
+// An explanation of an factor contributing to the security state.
+type SecurityStateExplanation struct {
+	// Security state representing the severity of the factor being explained.
+	SecurityState SecurityState `json:"securityState"`
+	// Title describing the type of factor.
+	Title string `json:"title"`
+	// Short phrase describing the type of factor.
+	Summary string `json:"summary"`
+	// Full text explanation of the factor.
+	Description string `json:"description"`
+	// The type of mixed content described by the explanation.
+	MixedContentType MixedContentType `json:"mixedContentType"`
+	// Page certificate.
+	Certificate []string `json:"certificate"`
+	// Recommendations to fix any issues.
+	Recommendations []string `json:"recommendations,omitempty"`
+}
+
+// InsecureContentStatus is the Security.InsecureContentStatus type.
+//
+// Information about insecure content on the page.
+//
+// Deprecated: this item is deprecated in the protocol.
+type InsecureContentStatus struct {
+	// Always false.
+	RanMixedContent bool `json:"ranMixedContent"`
+	// Always false.
+	DisplayedMixedContent bool `json:"displayedMixedContent"`
+	// Always false.
+	ContainedMixedForm bool `json:"containedMixedForm"`
+	// Always false.
+	RanContentWithCertErrors bool `json:"ranContentWithCertErrors"`
+	// Always false.
+	DisplayedContentWithCertErrors bool `json:"displayedContentWithCertErrors"`
+	// Always set to unknown.
+	RanInsecureContentStyle SecurityState `json:"ranInsecureContentStyle"`
+	// Always set to unknown.
+	DisplayedInsecureContentStyle SecurityState `json:"displayedInsecureContentStyle"`
+}
+
+// CertificateErrorAction is the Security.CertificateErrorAction type.
+//
+// The action to take when a certificate error occurs. continue will continue processing the
+// request and cancel will cancel the request.
+type CertificateErrorAction string
+
+const (
+	CertificateErrorActionContinue CertificateErrorAction = "continue"
+	CertificateErrorActionCancel   CertificateErrorAction = "cancel"
+)
+
+// Disable calls Security.disable.
+//
+// Disables tracking security state changes.
+func Disable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Security.disable", nil, sessionID, nil)
+}
+
+// Enable calls Security.enable.
+//
+// Enables tracking security state changes.
+func Enable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Security.enable", nil, sessionID, nil)
+}
+
+// SetIgnoreCertificateErrorsParams holds the parameters of Security.setIgnoreCertificateErrors.
+type SetIgnoreCertificateErrorsParams struct {
+	// If true, all certificate errors will be ignored.
+	Ignore bool `json:"ignore"`
+}
+
+// SetIgnoreCertificateErrors calls Security.setIgnoreCertificateErrors.
+//
+// Enable/disable whether all certificate errors should be ignored.
+func SetIgnoreCertificateErrors(ctx context.Context, c *internal.Client, sessionID string, params *SetIgnoreCertificateErrorsParams) error {
+	if params == nil {
+		params = &SetIgnoreCertificateErrorsParams{}
+	}
+	return c.Call(ctx, "Security.setIgnoreCertificateErrors", params, sessionID, nil)
+}
+
+// HandleCertificateErrorParams holds the parameters of Security.handleCertificateError.
+type HandleCertificateErrorParams struct {
+	// The ID of the event.
+	EventID int64 `json:"eventId"`
+	// The action to take on the certificate error.
+	Action CertificateErrorAction `json:"action"`
+}
+
+// HandleCertificateError calls Security.handleCertificateError.
+//
+// Handles a certificate error that fired a certificateError event.
+//
+// Deprecated: this item is deprecated in the protocol.
+func HandleCertificateError(ctx context.Context, c *internal.Client, sessionID string, params *HandleCertificateErrorParams) error {
+	if params == nil {
+		params = &HandleCertificateErrorParams{}
+	}
+	return c.Call(ctx, "Security.handleCertificateError", params, sessionID, nil)
+}
+
+// SetOverrideCertificateErrorsParams holds the parameters of Security.setOverrideCertificateErrors.
+type SetOverrideCertificateErrorsParams struct {
+	// If true, certificate errors will be overridden.
+	Override bool `json:"override"`
+}
+
+// SetOverrideCertificateErrors calls Security.setOverrideCertificateErrors.
+//
+// Enable/disable overriding certificate errors. If enabled, all certificate error events need to
+// be handled by the DevTools client and should be answered with `handleCertificateError` commands.
+//
+// Deprecated: this item is deprecated in the protocol.
+func SetOverrideCertificateErrors(ctx context.Context, c *internal.Client, sessionID string, params *SetOverrideCertificateErrorsParams) error {
+	if params == nil {
+		params = &SetOverrideCertificateErrorsParams{}
+	}
+	return c.Call(ctx, "Security.setOverrideCertificateErrors", params, sessionID, nil)
+}
+
+// EventCertificateError is the method name of the Security.certificateError event.
+const EventCertificateError = "Security.certificateError"
+
+// CertificateErrorEvent is the payload of the Security.certificateError event.
+//
+// There is a certificate error. If overriding certificate errors is enabled, then it should be
+// handled with the `handleCertificateError` command. Note: this event does not fire if the
+// certificate error has been allowed internally. Only one client per target should override
+// certificate errors at the same time.
+//
+// Deprecated: this item is deprecated in the protocol.
+type CertificateErrorEvent struct {
+	// The ID of the event.
+	EventID int64 `json:"eventId"`
+	// The type of the error.
+	ErrorType string `json:"errorType"`
+	// The url that was requested.
+	RequestURL string `json:"requestURL"`
+}
+
+// EventVisibleSecurityStateChanged is the method name of the Security.visibleSecurityStateChanged event.
+const EventVisibleSecurityStateChanged = "Security.visibleSecurityStateChanged"
+
+// VisibleSecurityStateChangedEvent is the payload of the Security.visibleSecurityStateChanged event.
+//
+// The security state of the page changed.
+//
+// Experimental.
+type VisibleSecurityStateChangedEvent struct {
+	// Security state information about the page.
+	VisibleSecurityState VisibleSecurityState `json:"visibleSecurityState"`
+}
+
+// EventSecurityStateChanged is the method name of the Security.securityStateChanged event.
+const EventSecurityStateChanged = "Security.securityStateChanged"
+
+// SecurityStateChangedEvent is the payload of the Security.securityStateChanged event.
+//
+// The security state of the page changed. No longer being sent.
+//
+// Deprecated: this item is deprecated in the protocol.
+type SecurityStateChangedEvent struct {
+	// Security state.
+	SecurityState SecurityState `json:"securityState"`
+	// True if the page was loaded over cryptographic transport such as HTTPS.
+	//
+	// Deprecated: this item is deprecated in the protocol.
+	SchemeIsCryptographic bool `json:"schemeIsCryptographic"`
+	// Previously a list of explanations for the security state. Now always
+	// empty.
+	//
+	// Deprecated: this item is deprecated in the protocol.
+	Explanations []SecurityStateExplanation `json:"explanations"`
+	// Information about insecure content on the page.
+	//
+	// Deprecated: this item is deprecated in the protocol.
+	InsecureContentStatus InsecureContentStatus `json:"insecureContentStatus"`
+	// Overrides user-visible description of the state. Always omitted.
+	//
+	// Deprecated: this item is deprecated in the protocol.
+	Summary string `json:"summary,omitempty"`
+}
+
+// ParseEvent decodes a Security event message into its typed event struct.
+func ParseEvent(msg *internal.CDPMessage) (any, error) {
+	var v any
+	switch msg.Method {
+	case EventCertificateError:
+		v = &CertificateErrorEvent{}
+	case EventVisibleSecurityStateChanged:
+		v = &VisibleSecurityStateChangedEvent{}
+	case EventSecurityStateChanged:
+		v = &SecurityStateChangedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Security event: %s", msg.Method)
+	}
+	if msg.Params == nil {
+		return v, nil
+	}
+	err := json.Unmarshal(msg.Params, v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
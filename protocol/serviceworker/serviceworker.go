@@ -0,0 +1,276 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package serviceworker provides typed bindings for the CDP ServiceWorker domain.
+package serviceworker
+
+import (
+	"cdp/internal"
+	"cdp/protocol/target"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// RegistrationID is the ServiceWorker.RegistrationID type.
+type RegistrationID string
+
+// ServiceWorkerRegistration is the ServiceWorker.ServiceWorkerRegistration type.
+//
+// ServiceWorker registration.
+type ServiceWorkerRegistration struct {
+	RegistrationID RegistrationID `json:"registrationId"`
+	ScopeURL       string         `json:"scopeURL"`
+	IsDeleted      bool           `json:"isDeleted"`
+}
+
+// ServiceWorkerVersionRunningStatus is the ServiceWorker.ServiceWorkerVersionRunningStatus type.
+type ServiceWorkerVersionRunningStatus string
+
+const (
+	ServiceWorkerVersionRunningStatusStopped  ServiceWorkerVersionRunningStatus = "stopped"
+	ServiceWorkerVersionRunningStatusStarting ServiceWorkerVersionRunningStatus = "starting"
+	ServiceWorkerVersionRunningStatusRunning  ServiceWorkerVersionRunningStatus = "running"
+	ServiceWorkerVersionRunningStatusStopping ServiceWorkerVersionRunningStatus = "stopping"
+)
+
+// ServiceWorkerVersionStatus is the ServiceWorker.ServiceWorkerVersionStatus type.
+type ServiceWorkerVersionStatus string
+
+const (
+	ServiceWorkerVersionStatusNew        ServiceWorkerVersionStatus = "new"
+	ServiceWorkerVersionStatusInstalling ServiceWorkerVersionStatus = "installing"
+	ServiceWorkerVersionStatusInstalled  ServiceWorkerVersionStatus = "installed"
+	ServiceWorkerVersionStatusActivating ServiceWorkerVersionStatus = "activating"
+	ServiceWorkerVersionStatusActivated  ServiceWorkerVersionStatus = "activated"
+	ServiceWorkerVersionStatusRedundant  ServiceWorkerVersionStatus = "redundant"
+)
+
+// ServiceWorkerVersion is the ServiceWorker.ServiceWorkerVersion type.
+//
+// ServiceWorker version.
+type ServiceWorkerVersion struct {
+	VersionID      string                            `json:"versionId"`
+	RegistrationID RegistrationID                    `json:"registrationId"`
+	ScriptURL      string                            `json:"scriptURL"`
+	RunningStatus  ServiceWorkerVersionRunningStatus `json:"runningStatus"`
+	Status         ServiceWorkerVersionStatus        `json:"status"`
+	// The Last-Modified header value of the main script.
+	ScriptLastModified *float64 `json:"scriptLastModified,omitempty"`
+	// The time at which the response headers of the main script were received from the server.
+	// For cached script it is the last time the cache entry was validated.
+	ScriptResponseTime *float64          `json:"scriptResponseTime,omitempty"`
+	ControlledClients  []target.TargetID `json:"controlledClients,omitempty"`
+	TargetID           target.TargetID   `json:"targetId,omitempty"`
+	RouterRules        string            `json:"routerRules,omitempty"`
+}
+
+// ServiceWorkerErrorMessage is the ServiceWorker.ServiceWorkerErrorMessage type.
+//
+// ServiceWorker error message.
+type ServiceWorkerErrorMessage struct {
+	ErrorMessage   string         `json:"errorMessage"`
+	RegistrationID RegistrationID `json:"registrationId"`
+	VersionID      string         `json:"versionId"`
+	SourceURL      string         `json:"sourceURL"`
+	LineNumber     int64          `json:"lineNumber"`
+	ColumnNumber   int64          `json:"columnNumber"`
+}
+
+// DeliverPushMessageParams holds the parameters of ServiceWorker.deliverPushMessage.
+type DeliverPushMessageParams struct {
+	Origin         string         `json:"origin"`
+	RegistrationID RegistrationID `json:"registrationId"`
+	Data           string         `json:"data"`
+}
+
+// DeliverPushMessage calls ServiceWorker.deliverPushMessage.
+func DeliverPushMessage(ctx context.Context, c *internal.Client, sessionID string, params *DeliverPushMessageParams) error {
+	if params == nil {
+		params = &DeliverPushMessageParams{}
+	}
+	return c.Call(ctx, "ServiceWorker.deliverPushMessage", params, sessionID, nil)
+}
+
+// Disable calls ServiceWorker.disable.
+func Disable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "ServiceWorker.disable", nil, sessionID, nil)
+}
+
+// DispatchSyncEventParams holds the parameters of ServiceWorker.dispatchSyncEvent.
+type DispatchSyncEventParams struct {
+	Origin         string         `json:"origin"`
+	RegistrationID RegistrationID `json:"registrationId"`
+	Tag            string         `json:"tag"`
+	LastChance     bool           `json:"lastChance"`
+}
+
+// DispatchSyncEvent calls ServiceWorker.dispatchSyncEvent.
+func DispatchSyncEvent(ctx context.Context, c *internal.Client, sessionID string, params *DispatchSyncEventParams) error {
+	if params == nil {
+		params = &DispatchSyncEventParams{}
+	}
+	return c.Call(ctx, "ServiceWorker.dispatchSyncEvent", params, sessionID, nil)
+}
+
+// DispatchPeriodicSyncEventParams holds the parameters of ServiceWorker.dispatchPeriodicSyncEvent.
+type DispatchPeriodicSyncEventParams struct {
+	Origin         string         `json:"origin"`
+	RegistrationID RegistrationID `json:"registrationId"`
+	Tag            string         `json:"tag"`
+}
+
+// DispatchPeriodicSyncEvent calls ServiceWorker.dispatchPeriodicSyncEvent.
+func DispatchPeriodicSyncEvent(ctx context.Context, c *internal.Client, sessionID string, params *DispatchPeriodicSyncEventParams) error {
+	if params == nil {
+		params = &DispatchPeriodicSyncEventParams{}
+	}
+	return c.Call(ctx, "ServiceWorker.dispatchPeriodicSyncEvent", params, sessionID, nil)
+}
+
+// Enable calls ServiceWorker.enable.
+func Enable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "ServiceWorker.enable", nil, sessionID, nil)
+}
+
+// InspectWorkerParams holds the parameters of ServiceWorker.inspectWorker.
+type InspectWorkerParams struct {
+	VersionID string `json:"versionId"`
+}
+
+// InspectWorker calls ServiceWorker.inspectWorker.
+func InspectWorker(ctx context.Context, c *internal.Client, sessionID string, params *InspectWorkerParams) error {
+	if params == nil {
+		params = &InspectWorkerParams{}
+	}
+	return c.Call(ctx, "ServiceWorker.inspectWorker", params, sessionID, nil)
+}
+
+// SetForceUpdateOnPageLoadParams holds the parameters of ServiceWorker.setForceUpdateOnPageLoad.
+type SetForceUpdateOnPageLoadParams struct {
+	ForceUpdateOnPageLoad bool `json:"forceUpdateOnPageLoad"`
+}
+
+// SetForceUpdateOnPageLoad calls ServiceWorker.setForceUpdateOnPageLoad.
+func SetForceUpdateOnPageLoad(ctx context.Context, c *internal.Client, sessionID string, params *SetForceUpdateOnPageLoadParams) error {
+	if params == nil {
+		params = &SetForceUpdateOnPageLoadParams{}
+	}
+	return c.Call(ctx, "ServiceWorker.setForceUpdateOnPageLoad", params, sessionID, nil)
+}
+
+// SkipWaitingParams holds the parameters of ServiceWorker.skipWaiting.
+type SkipWaitingParams struct {
+	ScopeURL string `json:"scopeURL"`
+}
+
+// SkipWaiting calls ServiceWorker.skipWaiting.
+func SkipWaiting(ctx context.Context, c *internal.Client, sessionID string, params *SkipWaitingParams) error {
+	if params == nil {
+		params = &SkipWaitingParams{}
+	}
+	return c.Call(ctx, "ServiceWorker.skipWaiting", params, sessionID, nil)
+}
+
+// StartWorkerParams holds the parameters of ServiceWorker.startWorker.
+type StartWorkerParams struct {
+	ScopeURL string `json:"scopeURL"`
+}
+
+// StartWorker calls ServiceWorker.startWorker.
+func StartWorker(ctx context.Context, c *internal.Client, sessionID string, params *StartWorkerParams) error {
+	if params == nil {
+		params = &StartWorkerParams{}
+	}
+	return c.Call(ctx, "ServiceWorker.startWorker", params, sessionID, nil)
+}
+
+// StopAllWorkers calls ServiceWorker.stopAllWorkers.
+func StopAllWorkers(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "ServiceWorker.stopAllWorkers", nil, sessionID, nil)
+}
+
+// StopWorkerParams holds the parameters of ServiceWorker.stopWorker.
+type StopWorkerParams struct {
+	VersionID string `json:"versionId"`
+}
+
+// StopWorker calls ServiceWorker.stopWorker.
+func StopWorker(ctx context.Context, c *internal.Client, sessionID string, params *StopWorkerParams) error {
+	if params == nil {
+		params = &StopWorkerParams{}
+	}
+	return c.Call(ctx, "ServiceWorker.stopWorker", params, sessionID, nil)
+}
+
+// UnregisterParams holds the parameters of ServiceWorker.unregister.
+type UnregisterParams struct {
+	ScopeURL string `json:"scopeURL"`
+}
+
+// Unregister calls ServiceWorker.unregister.
+func Unregister(ctx context.Context, c *internal.Client, sessionID string, params *UnregisterParams) error {
+	if params == nil {
+		params = &UnregisterParams{}
+	}
+	return c.Call(ctx, "ServiceWorker.unregister", params, sessionID, nil)
+}
+
+// UpdateRegistrationParams holds the parameters of ServiceWorker.updateRegistration.
+type UpdateRegistrationParams struct {
+	ScopeURL string `json:"scopeURL"`
+}
+
+// UpdateRegistration calls ServiceWorker.updateRegistration.
+func UpdateRegistration(ctx context.Context, c *internal.Client, sessionID string, params *UpdateRegistrationParams) error {
+	if params == nil {
+		params = &UpdateRegistrationParams{}
+	}
+	return c.Call(ctx, "ServiceWorker.updateRegistration", params, sessionID, nil)
+}
+
+// EventWorkerErrorReported is the method name of the ServiceWorker.workerErrorReported event.
+const EventWorkerErrorReported = "ServiceWorker.workerErrorReported"
+
+// WorkerErrorReportedEvent is the payload of the ServiceWorker.workerErrorReported event.
+type WorkerErrorReportedEvent struct {
+	ErrorMessage ServiceWorkerErrorMessage `json:"errorMessage"`
+}
+
+// EventWorkerRegistrationUpdated is the method name of the ServiceWorker.workerRegistrationUpdated event.
+const EventWorkerRegistrationUpdated = "ServiceWorker.workerRegistrationUpdated"
+
+// WorkerRegistrationUpdatedEvent is the payload of the ServiceWorker.workerRegistrationUpdated event.
+type WorkerRegistrationUpdatedEvent struct {
+	Registrations []ServiceWorkerRegistration `json:"registrations"`
+}
+
+// EventWorkerVersionUpdated is the method name of the ServiceWorker.workerVersionUpdated event.
+const EventWorkerVersionUpdated = "ServiceWorker.workerVersionUpdated"
+
+// WorkerVersionUpdatedEvent is the payload of the ServiceWorker.workerVersionUpdated event.
+type WorkerVersionUpdatedEvent struct {
+	Versions []ServiceWorkerVersion `json:"versions"`
+}
+
+// ParseEvent decodes a ServiceWorker event message into its typed event struct.
+func ParseEvent(msg *internal.CDPMessage) (any, error) {
+	var v any
+	switch msg.Method {
+	case EventWorkerErrorReported:
+		v = &WorkerErrorReportedEvent{}
+	case EventWorkerRegistrationUpdated:
+		v = &WorkerRegistrationUpdatedEvent{}
+	case EventWorkerVersionUpdated:
+		v = &WorkerVersionUpdatedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown ServiceWorker event: %s", msg.Method)
+	}
+	if msg.Params == nil {
+		return v, nil
+	}
+	err := json.Unmarshal(msg.Params, v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
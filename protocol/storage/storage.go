@@ -0,0 +1,1482 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package storage provides typed bindings for the CDP Storage domain.
+package storage
+
+import (
+	"cdp/internal"
+	"cdp/protocol/browser"
+	"cdp/protocol/network"
+	"cdp/protocol/page"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// SerializedStorageKey is the Storage.SerializedStorageKey type.
+type SerializedStorageKey string
+
+// StorageType is the Storage.StorageType type.
+//
+// Enum of possible storage types.
+type StorageType string
+
+const (
+	StorageTypeAppcache       StorageType = "appcache"
+	StorageTypeCookies        StorageType = "cookies"
+	StorageTypeFileSystems    StorageType = "file_systems"
+	StorageTypeIndexeddb      StorageType = "indexeddb"
+	StorageTypeLocalStorage   StorageType = "local_storage"
+	StorageTypeShaderCache    StorageType = "shader_cache"
+	StorageTypeWebsql         StorageType = "websql"
+	StorageTypeServiceWorkers StorageType = "service_workers"
+	StorageTypeCacheStorage   StorageType = "cache_storage"
+	StorageTypeInterestGroups StorageType = "interest_groups"
+	StorageTypeSharedStorage  StorageType = "shared_storage"
+	StorageTypeStorageBuckets StorageType = "storage_buckets"
+	StorageTypeAll            StorageType = "all"
+	StorageTypeOther          StorageType = "other"
+)
+
+// UsageForType is the Storage.UsageForType type.
+//
+// Usage for a storage type.
+type UsageForType struct {
+	// Name of storage type.
+	StorageType StorageType `json:"storageType"`
+	// Storage usage (bytes).
+	Usage float64 `json:"usage"`
+}
+
+// TrustTokens is the Storage.TrustTokens type.
+//
+// Pair of issuer origin and number of available (signed, but not used) Trust
+// Tokens from that issuer.
+//
+// Experimental.
+type TrustTokens struct {
+	IssuerOrigin string  `json:"issuerOrigin"`
+	Count        float64 `json:"count"`
+}
+
+// InterestGroupAuctionID is the Storage.InterestGroupAuctionId type.
+//
+// Protected audience interest group auction identifier.
+type InterestGroupAuctionID string
+
+// InterestGroupAccessType is the Storage.InterestGroupAccessType type.
+//
+// Enum of interest group access types.
+type InterestGroupAccessType string
+
+const (
+	InterestGroupAccessTypeJoin                  InterestGroupAccessType = "join"
+	InterestGroupAccessTypeLeave                 InterestGroupAccessType = "leave"
+	InterestGroupAccessTypeUpdate                InterestGroupAccessType = "update"
+	InterestGroupAccessTypeLoaded                InterestGroupAccessType = "loaded"
+	InterestGroupAccessTypeBid                   InterestGroupAccessType = "bid"
+	InterestGroupAccessTypeWin                   InterestGroupAccessType = "win"
+	InterestGroupAccessTypeAdditionalBid         InterestGroupAccessType = "additionalBid"
+	InterestGroupAccessTypeAdditionalBidWin      InterestGroupAccessType = "additionalBidWin"
+	InterestGroupAccessTypeTopLevelBid           InterestGroupAccessType = "topLevelBid"
+	InterestGroupAccessTypeTopLevelAdditionalBid InterestGroupAccessType = "topLevelAdditionalBid"
+	InterestGroupAccessTypeClear                 InterestGroupAccessType = "clear"
+)
+
+// InterestGroupAuctionEventType is the Storage.InterestGroupAuctionEventType type.
+//
+// Enum of auction events.
+type InterestGroupAuctionEventType string
+
+const (
+	InterestGroupAuctionEventTypeStarted        InterestGroupAuctionEventType = "started"
+	InterestGroupAuctionEventTypeConfigResolved InterestGroupAuctionEventType = "configResolved"
+)
+
+// InterestGroupAuctionFetchType is the Storage.InterestGroupAuctionFetchType type.
+//
+// Enum of network fetches auctions can do.
+type InterestGroupAuctionFetchType string
+
+const (
+	InterestGroupAuctionFetchTypeBidderJS             InterestGroupAuctionFetchType = "bidderJs"
+	InterestGroupAuctionFetchTypeBidderWasm           InterestGroupAuctionFetchType = "bidderWasm"
+	InterestGroupAuctionFetchTypeSellerJS             InterestGroupAuctionFetchType = "sellerJs"
+	InterestGroupAuctionFetchTypeBidderTrustedSignals InterestGroupAuctionFetchType = "bidderTrustedSignals"
+	InterestGroupAuctionFetchTypeSellerTrustedSignals InterestGroupAuctionFetchType = "sellerTrustedSignals"
+)
+
+// SharedStorageAccessType is the Storage.SharedStorageAccessType type.
+//
+// Enum of shared storage access types.
+type SharedStorageAccessType string
+
+const (
+	SharedStorageAccessTypeDocumentAddModule      SharedStorageAccessType = "documentAddModule"
+	SharedStorageAccessTypeDocumentSelectURL      SharedStorageAccessType = "documentSelectURL"
+	SharedStorageAccessTypeDocumentRun            SharedStorageAccessType = "documentRun"
+	SharedStorageAccessTypeDocumentSet            SharedStorageAccessType = "documentSet"
+	SharedStorageAccessTypeDocumentAppend         SharedStorageAccessType = "documentAppend"
+	SharedStorageAccessTypeDocumentDelete         SharedStorageAccessType = "documentDelete"
+	SharedStorageAccessTypeDocumentClear          SharedStorageAccessType = "documentClear"
+	SharedStorageAccessTypeDocumentGet            SharedStorageAccessType = "documentGet"
+	SharedStorageAccessTypeWorkletSet             SharedStorageAccessType = "workletSet"
+	SharedStorageAccessTypeWorkletAppend          SharedStorageAccessType = "workletAppend"
+	SharedStorageAccessTypeWorkletDelete          SharedStorageAccessType = "workletDelete"
+	SharedStorageAccessTypeWorkletClear           SharedStorageAccessType = "workletClear"
+	SharedStorageAccessTypeWorkletGet             SharedStorageAccessType = "workletGet"
+	SharedStorageAccessTypeWorkletKeys            SharedStorageAccessType = "workletKeys"
+	SharedStorageAccessTypeWorkletEntries         SharedStorageAccessType = "workletEntries"
+	SharedStorageAccessTypeWorkletLength          SharedStorageAccessType = "workletLength"
+	SharedStorageAccessTypeWorkletRemainingBudget SharedStorageAccessType = "workletRemainingBudget"
+	SharedStorageAccessTypeHeaderSet              SharedStorageAccessType = "headerSet"
+	SharedStorageAccessTypeHeaderAppend           SharedStorageAccessType = "headerAppend"
+	SharedStorageAccessTypeHeaderDelete           SharedStorageAccessType = "headerDelete"
+	SharedStorageAccessTypeHeaderClear            SharedStorageAccessType = "headerClear"
+)
+
+// SharedStorageEntry is the Storage.SharedStorageEntry type.
+//
+// Struct for a single key-value pair in an origin's shared storage.
+type SharedStorageEntry struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// SharedStorageMetadata is the Storage.SharedStorageMetadata type.
+//
+// Details for an origin's shared storage.
+type SharedStorageMetadata struct {
+	// Time when the origin's shared storage was last created.
+	CreationTime network.TimeSinceEpoch `json:"creationTime"`
+	// Number of key-value pairs stored in origin's shared storage.
+	Length int64 `json:"length"`
+	// Current amount of bits of entropy remaining in the navigation budget.
+	RemainingBudget float64 `json:"remainingBudget"`
+	// Total number of bytes stored as key-value pairs in origin's shared
+	// storage.
+	BytesUsed int64 `json:"bytesUsed"`
+}
+
+// SharedStorageReportingMetadata is the Storage.SharedStorageReportingMetadata type.
+//
+// Pair of reporting metadata details for a candidate URL for `selectURL()`.
+type SharedStorageReportingMetadata struct {
+	EventType    string `json:"eventType"`
+	ReportingURL string `json:"reportingUrl"`
+}
+
+// SharedStorageURLWithMetadata is the Storage.SharedStorageUrlWithMetadata type.
+//
+// Bundles a candidate URL with its reporting metadata.
+type SharedStorageURLWithMetadata struct {
+	// Spec of candidate URL.
+	URL string `json:"url"`
+	// Any associated reporting metadata.
+	ReportingMetadata []SharedStorageReportingMetadata `json:"reportingMetadata"`
+}
+
+// SharedStorageAccessParams is the Storage.SharedStorageAccessParams type.
+//
+// Bundles the parameters for shared storage access events whose
+// presence/absence can vary according to SharedStorageAccessType.
+type SharedStorageAccessParams struct {
+	// Spec of the module script URL.
+	// Present only for SharedStorageAccessType.documentAddModule.
+	ScriptSourceURL string `json:"scriptSourceUrl,omitempty"`
+	// Name of the registered operation to be run.
+	// Present only for SharedStorageAccessType.documentRun and
+	// SharedStorageAccessType.documentSelectURL.
+	OperationName string `json:"operationName,omitempty"`
+	// The operation's serialized data in bytes (converted to a string).
+	// Present only for SharedStorageAccessType.documentRun and
+	// SharedStorageAccessType.documentSelectURL.
+	SerializedData string `json:"serializedData,omitempty"`
+	// Array of candidate URLs' specs, along with any associated metadata.
+	// Present only for SharedStorageAccessType.documentSelectURL.
+	URLsWithMetadata []SharedStorageURLWithMetadata `json:"urlsWithMetadata,omitempty"`
+	// Key for a specific entry in an origin's shared storage.
+	// Present only for SharedStorageAccessType.documentSet,
+	// SharedStorageAccessType.documentAppend,
+	// SharedStorageAccessType.documentDelete,
+	// SharedStorageAccessType.workletSet,
+	// SharedStorageAccessType.workletAppend,
+	// SharedStorageAccessType.workletDelete,
+	// SharedStorageAccessType.workletGet,
+	// SharedStorageAccessType.headerSet,
+	// SharedStorageAccessType.headerAppend, and
+	// SharedStorageAccessType.headerDelete.
+	Key string `json:"key,omitempty"`
+	// Value for a specific entry in an origin's shared storage.
+	// Present only for SharedStorageAccessType.documentSet,
+	// SharedStorageAccessType.documentAppend,
+	// SharedStorageAccessType.workletSet,
+	// SharedStorageAccessType.workletAppend,
+	// SharedStorageAccessType.headerSet, and
+	// SharedStorageAccessType.headerAppend.
+	Value string `json:"value,omitempty"`
+	// Whether or not to set an entry for a key if that key is already present.
+	// Present only for SharedStorageAccessType.documentSet,
+	// SharedStorageAccessType.workletSet, and
+	// SharedStorageAccessType.headerSet.
+	IgnoreIfPresent *bool `json:"ignoreIfPresent,omitempty"`
+}
+
+// StorageBucketsDurability is the Storage.StorageBucketsDurability type.
+type StorageBucketsDurability string
+
+const (
+	StorageBucketsDurabilityRelaxed StorageBucketsDurability = "relaxed"
+	StorageBucketsDurabilityStrict  StorageBucketsDurability = "strict"
+)
+
+// StorageBucket is the Storage.StorageBucket type.
+type StorageBucket struct {
+	StorageKey SerializedStorageKey `json:"storageKey"`
+	// If not specified, it is the default bucket of the storageKey.
+	Name string `json:"name,omitempty"`
+}
+
+// StorageBucketInfo is the Storage.StorageBucketInfo type.
+type StorageBucketInfo struct {
+	Bucket     StorageBucket          `json:"bucket"`
+	ID         string                 `json:"id"`
+	Expiration network.TimeSinceEpoch `json:"expiration"`
+	// Storage quota (bytes).
+	Quota      float64                  `json:"quota"`
+	Persistent bool                     `json:"persistent"`
+	Durability StorageBucketsDurability `json:"durability"`
+}
+
+// AttributionReportingSourceType is the Storage.AttributionReportingSourceType type.
+//
+// Experimental.
+type AttributionReportingSourceType string
+
+const (
+	AttributionReportingSourceTypeNavigation AttributionReportingSourceType = "navigation"
+	AttributionReportingSourceTypeEvent      AttributionReportingSourceType = "event"
+)
+
+// UnsignedInt64AsBase10 is the Storage.UnsignedInt64AsBase10 type.
+//
+// Experimental.
+type UnsignedInt64AsBase10 string
+
+// UnsignedInt128AsBase16 is the Storage.UnsignedInt128AsBase16 type.
+//
+// Experimental.
+type UnsignedInt128AsBase16 string
+
+// SignedInt64AsBase10 is the Storage.SignedInt64AsBase10 type.
+//
+// Experimental.
+type SignedInt64AsBase10 string
+
+// AttributionReportingFilterDataEntry is the Storage.AttributionReportingFilterDataEntry type.
+//
+// Experimental.
+type AttributionReportingFilterDataEntry struct {
+	Key    string   `json:"key"`
+	Values []string `json:"values"`
+}
+
+// AttributionReportingFilterConfig is the Storage.AttributionReportingFilterConfig type.
+//
+// Experimental.
+type AttributionReportingFilterConfig struct {
+	FilterValues []AttributionReportingFilterDataEntry `json:"filterValues"`
+	// duration in seconds
+	LookbackWindow *int64 `json:"lookbackWindow,omitempty"`
+}
+
+// AttributionReportingFilterPair is the Storage.AttributionReportingFilterPair type.
+//
+// Experimental.
+type AttributionReportingFilterPair struct {
+	Filters    []AttributionReportingFilterConfig `json:"filters"`
+	NotFilters []AttributionReportingFilterConfig `json:"notFilters"`
+}
+
+// AttributionReportingAggregationKeysEntry is the Storage.AttributionReportingAggregationKeysEntry type.
+//
+// Experimental.
+type AttributionReportingAggregationKeysEntry struct {
+	Key   string                 `json:"key"`
+	Value UnsignedInt128AsBase16 `json:"value"`
+}
+
+// AttributionReportingEventReportWindows is the Storage.AttributionReportingEventReportWindows type.
+//
+// Experimental.
+type AttributionReportingEventReportWindows struct {
+	// duration in seconds
+	Start int64 `json:"start"`
+	// duration in seconds
+	Ends []int64 `json:"ends"`
+}
+
+// AttributionReportingTriggerSpec is the Storage.AttributionReportingTriggerSpec type.
+//
+// Experimental.
+type AttributionReportingTriggerSpec struct {
+	// number instead of integer because not all uint32 can be represented by
+	// int
+	TriggerData        []float64                              `json:"triggerData"`
+	EventReportWindows AttributionReportingEventReportWindows `json:"eventReportWindows"`
+}
+
+// AttributionReportingTriggerDataMatching is the Storage.AttributionReportingTriggerDataMatching type.
+//
+// Experimental.
+type AttributionReportingTriggerDataMatching string
+
+const (
+	AttributionReportingTriggerDataMatchingExact   AttributionReportingTriggerDataMatching = "exact"
+	AttributionReportingTriggerDataMatchingModulus AttributionReportingTriggerDataMatching = "modulus"
+)
+
+// AttributionReportingAggregatableDebugReportingData is the Storage.AttributionReportingAggregatableDebugReportingData type.
+//
+// Experimental.
+type AttributionReportingAggregatableDebugReportingData struct {
+	KeyPiece UnsignedInt128AsBase16 `json:"keyPiece"`
+	// number instead of integer because not all uint32 can be represented by
+	// int
+	Value float64  `json:"value"`
+	Types []string `json:"types"`
+}
+
+// AttributionReportingAggregatableDebugReportingConfig is the Storage.AttributionReportingAggregatableDebugReportingConfig type.
+//
+// Experimental.
+type AttributionReportingAggregatableDebugReportingConfig struct {
+	// number instead of integer because not all uint32 can be represented by
+	// int, only present for source registrations
+	Budget                       *float64                                             `json:"budget,omitempty"`
+	KeyPiece                     UnsignedInt128AsBase16                               `json:"keyPiece"`
+	DebugData                    []AttributionReportingAggregatableDebugReportingData `json:"debugData"`
+	AggregationCoordinatorOrigin string                                               `json:"aggregationCoordinatorOrigin,omitempty"`
+}
+
+// AttributionScopesData is the Storage.AttributionScopesData type.
+//
+// Experimental.
+type AttributionScopesData struct {
+	Values []string `json:"values"`
+	// number instead of integer because not all uint32 can be represented by
+	// int
+	Limit          float64 `json:"limit"`
+	MaxEventStates float64 `json:"maxEventStates"`
+}
+
+// AttributionReportingSourceRegistration is the Storage.AttributionReportingSourceRegistration type.
+//
+// Experimental.
+type AttributionReportingSourceRegistration struct {
+	Time network.TimeSinceEpoch `json:"time"`
+	// duration in seconds
+	Expiry       int64                             `json:"expiry"`
+	TriggerSpecs []AttributionReportingTriggerSpec `json:"triggerSpecs"`
+	// duration in seconds
+	AggregatableReportWindow         int64                                                `json:"aggregatableReportWindow"`
+	Type                             AttributionReportingSourceType                       `json:"type"`
+	SourceOrigin                     string                                               `json:"sourceOrigin"`
+	ReportingOrigin                  string                                               `json:"reportingOrigin"`
+	DestinationSites                 []string                                             `json:"destinationSites"`
+	EventID                          UnsignedInt64AsBase10                                `json:"eventId"`
+	Priority                         SignedInt64AsBase10                                  `json:"priority"`
+	FilterData                       []AttributionReportingFilterDataEntry                `json:"filterData"`
+	AggregationKeys                  []AttributionReportingAggregationKeysEntry           `json:"aggregationKeys"`
+	DebugKey                         UnsignedInt64AsBase10                                `json:"debugKey,omitempty"`
+	TriggerDataMatching              AttributionReportingTriggerDataMatching              `json:"triggerDataMatching"`
+	DestinationLimitPriority         SignedInt64AsBase10                                  `json:"destinationLimitPriority"`
+	AggregatableDebugReportingConfig AttributionReportingAggregatableDebugReportingConfig `json:"aggregatableDebugReportingConfig"`
+	ScopesData                       *AttributionScopesData                               `json:"scopesData,omitempty"`
+}
+
+// AttributionReportingSourceRegistrationResult is the Storage.AttributionReportingSourceRegistrationResult type.
+//
+// Experimental.
+type AttributionReportingSourceRegistrationResult string
+
+const (
+	AttributionReportingSourceRegistrationResultSuccess                                AttributionReportingSourceRegistrationResult = "success"
+	AttributionReportingSourceRegistrationResultInternalError                          AttributionReportingSourceRegistrationResult = "internalError"
+	AttributionReportingSourceRegistrationResultInsufficientSourceCapacity             AttributionReportingSourceRegistrationResult = "insufficientSourceCapacity"
+	AttributionReportingSourceRegistrationResultInsufficientUniqueDestinationCapacity  AttributionReportingSourceRegistrationResult = "insufficientUniqueDestinationCapacity"
+	AttributionReportingSourceRegistrationResultExcessiveReportingOrigins              AttributionReportingSourceRegistrationResult = "excessiveReportingOrigins"
+	AttributionReportingSourceRegistrationResultProhibitedByBrowserPolicy              AttributionReportingSourceRegistrationResult = "prohibitedByBrowserPolicy"
+	AttributionReportingSourceRegistrationResultSuccessNoised                          AttributionReportingSourceRegistrationResult = "successNoised"
+	AttributionReportingSourceRegistrationResultDestinationReportingLimitReached       AttributionReportingSourceRegistrationResult = "destinationReportingLimitReached"
+	AttributionReportingSourceRegistrationResultDestinationGlobalLimitReached          AttributionReportingSourceRegistrationResult = "destinationGlobalLimitReached"
+	AttributionReportingSourceRegistrationResultDestinationBothLimitsReached           AttributionReportingSourceRegistrationResult = "destinationBothLimitsReached"
+	AttributionReportingSourceRegistrationResultReportingOriginsPerSiteLimitReached    AttributionReportingSourceRegistrationResult = "reportingOriginsPerSiteLimitReached"
+	AttributionReportingSourceRegistrationResultExceedsMaxChannelCapacity              AttributionReportingSourceRegistrationResult = "exceedsMaxChannelCapacity"
+	AttributionReportingSourceRegistrationResultExceedsMaxScopesChannelCapacity        AttributionReportingSourceRegistrationResult = "exceedsMaxScopesChannelCapacity"
+	AttributionReportingSourceRegistrationResultExceedsMaxTriggerStateCardinality      AttributionReportingSourceRegistrationResult = "exceedsMaxTriggerStateCardinality"
+	AttributionReportingSourceRegistrationResultExceedsMaxEventStatesLimit             AttributionReportingSourceRegistrationResult = "exceedsMaxEventStatesLimit"
+	AttributionReportingSourceRegistrationResultDestinationPerDayReportingLimitReached AttributionReportingSourceRegistrationResult = "destinationPerDayReportingLimitReached"
+)
+
+// AttributionReportingSourceRegistrationTimeConfig is the Storage.AttributionReportingSourceRegistrationTimeConfig type.
+//
+// Experimental.
+type AttributionReportingSourceRegistrationTimeConfig string
+
+const (
+	AttributionReportingSourceRegistrationTimeConfigInclude AttributionReportingSourceRegistrationTimeConfig = "include"
+	AttributionReportingSourceRegistrationTimeConfigExclude AttributionReportingSourceRegistrationTimeConfig = "exclude"
+)
+
+// AttributionReportingAggregatableValueDictEntry is the Storage.AttributionReportingAggregatableValueDictEntry type.
+//
+// Experimental.
+type AttributionReportingAggregatableValueDictEntry struct {
+	Key string `json:"key"`
+	// number instead of integer because not all uint32 can be represented by
+	// int
+	Value       float64               `json:"value"`
+	FilteringID UnsignedInt64AsBase10 `json:"filteringId"`
+}
+
+// AttributionReportingAggregatableValueEntry is the Storage.AttributionReportingAggregatableValueEntry type.
+//
+// Experimental.
+type AttributionReportingAggregatableValueEntry struct {
+	Values  []AttributionReportingAggregatableValueDictEntry `json:"values"`
+	Filters AttributionReportingFilterPair                   `json:"filters"`
+}
+
+// AttributionReportingEventTriggerData is the Storage.AttributionReportingEventTriggerData type.
+//
+// Experimental.
+type AttributionReportingEventTriggerData struct {
+	Data     UnsignedInt64AsBase10          `json:"data"`
+	Priority SignedInt64AsBase10            `json:"priority"`
+	DedupKey UnsignedInt64AsBase10          `json:"dedupKey,omitempty"`
+	Filters  AttributionReportingFilterPair `json:"filters"`
+}
+
+// AttributionReportingAggregatableTriggerData is the Storage.AttributionReportingAggregatableTriggerData type.
+//
+// Experimental.
+type AttributionReportingAggregatableTriggerData struct {
+	KeyPiece   UnsignedInt128AsBase16         `json:"keyPiece"`
+	SourceKeys []string                       `json:"sourceKeys"`
+	Filters    AttributionReportingFilterPair `json:"filters"`
+}
+
+// AttributionReportingAggregatableDedupKey is the Storage.AttributionReportingAggregatableDedupKey type.
+//
+// Experimental.
+type AttributionReportingAggregatableDedupKey struct {
+	DedupKey UnsignedInt64AsBase10          `json:"dedupKey,omitempty"`
+	Filters  AttributionReportingFilterPair `json:"filters"`
+}
+
+// AttributionReportingTriggerRegistration is the Storage.AttributionReportingTriggerRegistration type.
+//
+// Experimental.
+type AttributionReportingTriggerRegistration struct {
+	Filters                          AttributionReportingFilterPair                       `json:"filters"`
+	DebugKey                         UnsignedInt64AsBase10                                `json:"debugKey,omitempty"`
+	AggregatableDedupKeys            []AttributionReportingAggregatableDedupKey           `json:"aggregatableDedupKeys"`
+	EventTriggerData                 []AttributionReportingEventTriggerData               `json:"eventTriggerData"`
+	AggregatableTriggerData          []AttributionReportingAggregatableTriggerData        `json:"aggregatableTriggerData"`
+	AggregatableValues               []AttributionReportingAggregatableValueEntry         `json:"aggregatableValues"`
+	AggregatableFilteringIDMaxBytes  int64                                                `json:"aggregatableFilteringIdMaxBytes"`
+	DebugReporting                   bool                                                 `json:"debugReporting"`
+	AggregationCoordinatorOrigin     string                                               `json:"aggregationCoordinatorOrigin,omitempty"`
+	SourceRegistrationTimeConfig     AttributionReportingSourceRegistrationTimeConfig     `json:"sourceRegistrationTimeConfig"`
+	TriggerContextID                 string                                               `json:"triggerContextId,omitempty"`
+	AggregatableDebugReportingConfig AttributionReportingAggregatableDebugReportingConfig `json:"aggregatableDebugReportingConfig"`
+	Scopes                           []string                                             `json:"scopes"`
+}
+
+// AttributionReportingEventLevelResult is the Storage.AttributionReportingEventLevelResult type.
+//
+// Experimental.
+type AttributionReportingEventLevelResult string
+
+const (
+	AttributionReportingEventLevelResultSuccess                             AttributionReportingEventLevelResult = "success"
+	AttributionReportingEventLevelResultSuccessDroppedLowerPriority         AttributionReportingEventLevelResult = "successDroppedLowerPriority"
+	AttributionReportingEventLevelResultInternalError                       AttributionReportingEventLevelResult = "internalError"
+	AttributionReportingEventLevelResultNoCapacityForAttributionDestination AttributionReportingEventLevelResult = "noCapacityForAttributionDestination"
+	AttributionReportingEventLevelResultNoMatchingSources                   AttributionReportingEventLevelResult = "noMatchingSources"
+	AttributionReportingEventLevelResultDeduplicated                        AttributionReportingEventLevelResult = "deduplicated"
+	AttributionReportingEventLevelResultExcessiveAttributions               AttributionReportingEventLevelResult = "excessiveAttributions"
+	AttributionReportingEventLevelResultPriorityTooLow                      AttributionReportingEventLevelResult = "priorityTooLow"
+	AttributionReportingEventLevelResultNeverAttributedSource               AttributionReportingEventLevelResult = "neverAttributedSource"
+	AttributionReportingEventLevelResultExcessiveReportingOrigins           AttributionReportingEventLevelResult = "excessiveReportingOrigins"
+	AttributionReportingEventLevelResultNoMatchingSourceFilterData          AttributionReportingEventLevelResult = "noMatchingSourceFilterData"
+	AttributionReportingEventLevelResultProhibitedByBrowserPolicy           AttributionReportingEventLevelResult = "prohibitedByBrowserPolicy"
+	AttributionReportingEventLevelResultNoMatchingConfigurations            AttributionReportingEventLevelResult = "noMatchingConfigurations"
+	AttributionReportingEventLevelResultExcessiveReports                    AttributionReportingEventLevelResult = "excessiveReports"
+	AttributionReportingEventLevelResultFalselyAttributedSource             AttributionReportingEventLevelResult = "falselyAttributedSource"
+	AttributionReportingEventLevelResultReportWindowPassed                  AttributionReportingEventLevelResult = "reportWindowPassed"
+	AttributionReportingEventLevelResultNotRegistered                       AttributionReportingEventLevelResult = "notRegistered"
+	AttributionReportingEventLevelResultReportWindowNotStarted              AttributionReportingEventLevelResult = "reportWindowNotStarted"
+	AttributionReportingEventLevelResultNoMatchingTriggerData               AttributionReportingEventLevelResult = "noMatchingTriggerData"
+)
+
+// AttributionReportingAggregatableResult is the Storage.AttributionReportingAggregatableResult type.
+//
+// Experimental.
+type AttributionReportingAggregatableResult string
+
+const (
+	AttributionReportingAggregatableResultSuccess                             AttributionReportingAggregatableResult = "success"
+	AttributionReportingAggregatableResultInternalError                       AttributionReportingAggregatableResult = "internalError"
+	AttributionReportingAggregatableResultNoCapacityForAttributionDestination AttributionReportingAggregatableResult = "noCapacityForAttributionDestination"
+	AttributionReportingAggregatableResultNoMatchingSources                   AttributionReportingAggregatableResult = "noMatchingSources"
+	AttributionReportingAggregatableResultExcessiveAttributions               AttributionReportingAggregatableResult = "excessiveAttributions"
+	AttributionReportingAggregatableResultExcessiveReportingOrigins           AttributionReportingAggregatableResult = "excessiveReportingOrigins"
+	AttributionReportingAggregatableResultNoHistograms                        AttributionReportingAggregatableResult = "noHistograms"
+	AttributionReportingAggregatableResultInsufficientBudget                  AttributionReportingAggregatableResult = "insufficientBudget"
+	AttributionReportingAggregatableResultNoMatchingSourceFilterData          AttributionReportingAggregatableResult = "noMatchingSourceFilterData"
+	AttributionReportingAggregatableResultNotRegistered                       AttributionReportingAggregatableResult = "notRegistered"
+	AttributionReportingAggregatableResultProhibitedByBrowserPolicy           AttributionReportingAggregatableResult = "prohibitedByBrowserPolicy"
+	AttributionReportingAggregatableResultDeduplicated                        AttributionReportingAggregatableResult = "deduplicated"
+	AttributionReportingAggregatableResultReportWindowPassed                  AttributionReportingAggregatableResult = "reportWindowPassed"
+	AttributionReportingAggregatableResultExcessiveReports                    AttributionReportingAggregatableResult = "excessiveReports"
+)
+
+// RelatedWebsiteSet is the Storage.RelatedWebsiteSet type.
+//
+// A single Related Website Set object.
+//
+// Experimental.
+type RelatedWebsiteSet struct {
+	// The primary site of this set, along with the ccTLDs if there is any.
+	PrimarySites []string `json:"primarySites"`
+	// The associated sites of this set, along with the ccTLDs if there is any.
+	AssociatedSites []string `json:"associatedSites"`
+	// The service sites of this set, along with the ccTLDs if there is any.
+	ServiceSites []string `json:"serviceSites"`
+}
+
+// GetStorageKeyForFrameParams holds the parameters of Storage.getStorageKeyForFrame.
+type GetStorageKeyForFrameParams struct {
+	FrameID page.FrameID `json:"frameId"`
+}
+
+// GetStorageKeyForFrameResult holds the result of Storage.getStorageKeyForFrame.
+type GetStorageKeyForFrameResult struct {
+	StorageKey SerializedStorageKey `json:"storageKey"`
+}
+
+// GetStorageKeyForFrame calls Storage.getStorageKeyForFrame.
+//
+// Returns a storage key given a frame id.
+func GetStorageKeyForFrame(ctx context.Context, c *internal.Client, sessionID string, params *GetStorageKeyForFrameParams) (*GetStorageKeyForFrameResult, error) {
+	if params == nil {
+		params = &GetStorageKeyForFrameParams{}
+	}
+	var result GetStorageKeyForFrameResult
+	err := c.Call(ctx, "Storage.getStorageKeyForFrame", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ClearDataForOriginParams holds the parameters of Storage.clearDataForOrigin.
+type ClearDataForOriginParams struct {
+	// Security origin.
+	Origin string `json:"origin"`
+	// Comma separated list of StorageType to clear.
+	StorageTypes string `json:"storageTypes"`
+}
+
+// ClearDataForOrigin calls Storage.clearDataForOrigin.
+//
+// Clears storage for origin.
+func ClearDataForOrigin(ctx context.Context, c *internal.Client, sessionID string, params *ClearDataForOriginParams) error {
+	if params == nil {
+		params = &ClearDataForOriginParams{}
+	}
+	return c.Call(ctx, "Storage.clearDataForOrigin", params, sessionID, nil)
+}
+
+// ClearDataForStorageKeyParams holds the parameters of Storage.clearDataForStorageKey.
+type ClearDataForStorageKeyParams struct {
+	// Storage key.
+	StorageKey string `json:"storageKey"`
+	// Comma separated list of StorageType to clear.
+	StorageTypes string `json:"storageTypes"`
+}
+
+// ClearDataForStorageKey calls Storage.clearDataForStorageKey.
+//
+// Clears storage for storage key.
+func ClearDataForStorageKey(ctx context.Context, c *internal.Client, sessionID string, params *ClearDataForStorageKeyParams) error {
+	if params == nil {
+		params = &ClearDataForStorageKeyParams{}
+	}
+	return c.Call(ctx, "Storage.clearDataForStorageKey", params, sessionID, nil)
+}
+
+// GetCookiesParams holds the parameters of Storage.getCookies.
+type GetCookiesParams struct {
+	// Browser context to use when called on the browser endpoint.
+	BrowserContextID browser.BrowserContextID `json:"browserContextId,omitempty"`
+}
+
+// GetCookiesResult holds the result of Storage.getCookies.
+type GetCookiesResult struct {
+	// Array of cookie objects.
+	Cookies []network.Cookie `json:"cookies"`
+}
+
+// GetCookies calls Storage.getCookies.
+//
+// Returns all browser cookies.
+func GetCookies(ctx context.Context, c *internal.Client, sessionID string, params *GetCookiesParams) (*GetCookiesResult, error) {
+	if params == nil {
+		params = &GetCookiesParams{}
+	}
+	var result GetCookiesResult
+	err := c.Call(ctx, "Storage.getCookies", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SetCookiesParams holds the parameters of Storage.setCookies.
+type SetCookiesParams struct {
+	// Cookies to be set.
+	Cookies []network.CookieParam `json:"cookies"`
+	// Browser context to use when called on the browser endpoint.
+	BrowserContextID browser.BrowserContextID `json:"browserContextId,omitempty"`
+}
+
+// SetCookies calls Storage.setCookies.
+//
+// Sets given cookies.
+func SetCookies(ctx context.Context, c *internal.Client, sessionID string, params *SetCookiesParams) error {
+	if params == nil {
+		params = &SetCookiesParams{}
+	}
+	return c.Call(ctx, "Storage.setCookies", params, sessionID, nil)
+}
+
+// ClearCookiesParams holds the parameters of Storage.clearCookies.
+type ClearCookiesParams struct {
+	// Browser context to use when called on the browser endpoint.
+	BrowserContextID browser.BrowserContextID `json:"browserContextId,omitempty"`
+}
+
+// ClearCookies calls Storage.clearCookies.
+//
+// Clears cookies.
+func ClearCookies(ctx context.Context, c *internal.Client, sessionID string, params *ClearCookiesParams) error {
+	if params == nil {
+		params = &ClearCookiesParams{}
+	}
+	return c.Call(ctx, "Storage.clearCookies", params, sessionID, nil)
+}
+
+// GetUsageAndQuotaParams holds the parameters of Storage.getUsageAndQuota.
+type GetUsageAndQuotaParams struct {
+	// Security origin.
+	Origin string `json:"origin"`
+}
+
+// GetUsageAndQuotaResult holds the result of Storage.getUsageAndQuota.
+type GetUsageAndQuotaResult struct {
+	// Storage usage (bytes).
+	Usage float64 `json:"usage"`
+	// Storage quota (bytes).
+	Quota float64 `json:"quota"`
+	// Whether or not the origin has an active storage quota override
+	OverrideActive bool `json:"overrideActive"`
+	// Storage usage per type (bytes).
+	UsageBreakdown []UsageForType `json:"usageBreakdown"`
+}
+
+// GetUsageAndQuota calls Storage.getUsageAndQuota.
+//
+// Returns usage and quota in bytes.
+func GetUsageAndQuota(ctx context.Context, c *internal.Client, sessionID string, params *GetUsageAndQuotaParams) (*GetUsageAndQuotaResult, error) {
+	if params == nil {
+		params = &GetUsageAndQuotaParams{}
+	}
+	var result GetUsageAndQuotaResult
+	err := c.Call(ctx, "Storage.getUsageAndQuota", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// OverrideQuotaForOriginParams holds the parameters of Storage.overrideQuotaForOrigin.
+type OverrideQuotaForOriginParams struct {
+	// Security origin.
+	Origin string `json:"origin"`
+	// The quota size (in bytes) to override the original quota with.
+	// If this is called multiple times, the overridden quota will be equal to
+	// the quotaSize provided in the final call. If this is called without
+	// specifying a quotaSize, the quota will be reset to the default value for
+	// the specified origin. If this is called multiple times with different
+	// origins, the override will be maintained for each origin until it is
+	// disabled (called without a quotaSize).
+	QuotaSize *float64 `json:"quotaSize,omitempty"`
+}
+
+// OverrideQuotaForOrigin calls Storage.overrideQuotaForOrigin.
+//
+// # Override quota for the specified origin
+//
+// Experimental.
+func OverrideQuotaForOrigin(ctx context.Context, c *internal.Client, sessionID string, params *OverrideQuotaForOriginParams) error {
+	if params == nil {
+		params = &OverrideQuotaForOriginParams{}
+	}
+	return c.Call(ctx, "Storage.overrideQuotaForOrigin", params, sessionID, nil)
+}
+
+// TrackCacheStorageForOriginParams holds the parameters of Storage.trackCacheStorageForOrigin.
+type TrackCacheStorageForOriginParams struct {
+	// Security origin.
+	Origin string `json:"origin"`
+}
+
+// TrackCacheStorageForOrigin calls Storage.trackCacheStorageForOrigin.
+//
+// Registers origin to be notified when an update occurs to its cache storage list.
+func TrackCacheStorageForOrigin(ctx context.Context, c *internal.Client, sessionID string, params *TrackCacheStorageForOriginParams) error {
+	if params == nil {
+		params = &TrackCacheStorageForOriginParams{}
+	}
+	return c.Call(ctx, "Storage.trackCacheStorageForOrigin", params, sessionID, nil)
+}
+
+// TrackCacheStorageForStorageKeyParams holds the parameters of Storage.trackCacheStorageForStorageKey.
+type TrackCacheStorageForStorageKeyParams struct {
+	// Storage key.
+	StorageKey string `json:"storageKey"`
+}
+
+// TrackCacheStorageForStorageKey calls Storage.trackCacheStorageForStorageKey.
+//
+// Registers storage key to be notified when an update occurs to its cache storage list.
+func TrackCacheStorageForStorageKey(ctx context.Context, c *internal.Client, sessionID string, params *TrackCacheStorageForStorageKeyParams) error {
+	if params == nil {
+		params = &TrackCacheStorageForStorageKeyParams{}
+	}
+	return c.Call(ctx, "Storage.trackCacheStorageForStorageKey", params, sessionID, nil)
+}
+
+// TrackIndexedDBForOriginParams holds the parameters of Storage.trackIndexedDBForOrigin.
+type TrackIndexedDBForOriginParams struct {
+	// Security origin.
+	Origin string `json:"origin"`
+}
+
+// TrackIndexedDBForOrigin calls Storage.trackIndexedDBForOrigin.
+//
+// Registers origin to be notified when an update occurs to its IndexedDB.
+func TrackIndexedDBForOrigin(ctx context.Context, c *internal.Client, sessionID string, params *TrackIndexedDBForOriginParams) error {
+	if params == nil {
+		params = &TrackIndexedDBForOriginParams{}
+	}
+	return c.Call(ctx, "Storage.trackIndexedDBForOrigin", params, sessionID, nil)
+}
+
+// TrackIndexedDBForStorageKeyParams holds the parameters of Storage.trackIndexedDBForStorageKey.
+type TrackIndexedDBForStorageKeyParams struct {
+	// Storage key.
+	StorageKey string `json:"storageKey"`
+}
+
+// TrackIndexedDBForStorageKey calls Storage.trackIndexedDBForStorageKey.
+//
+// Registers storage key to be notified when an update occurs to its IndexedDB.
+func TrackIndexedDBForStorageKey(ctx context.Context, c *internal.Client, sessionID string, params *TrackIndexedDBForStorageKeyParams) error {
+	if params == nil {
+		params = &TrackIndexedDBForStorageKeyParams{}
+	}
+	return c.Call(ctx, "Storage.trackIndexedDBForStorageKey", params, sessionID, nil)
+}
+
+// UntrackCacheStorageForOriginParams holds the parameters of Storage.untrackCacheStorageForOrigin.
+type UntrackCacheStorageForOriginParams struct {
+	// Security origin.
+	Origin string `json:"origin"`
+}
+
+// UntrackCacheStorageForOrigin calls Storage.untrackCacheStorageForOrigin.
+//
+// Unregisters origin from receiving notifications for cache storage.
+func UntrackCacheStorageForOrigin(ctx context.Context, c *internal.Client, sessionID string, params *UntrackCacheStorageForOriginParams) error {
+	if params == nil {
+		params = &UntrackCacheStorageForOriginParams{}
+	}
+	return c.Call(ctx, "Storage.untrackCacheStorageForOrigin", params, sessionID, nil)
+}
+
+// UntrackCacheStorageForStorageKeyParams holds the parameters of Storage.untrackCacheStorageForStorageKey.
+type UntrackCacheStorageForStorageKeyParams struct {
+	// Storage key.
+	StorageKey string `json:"storageKey"`
+}
+
+// UntrackCacheStorageForStorageKey calls Storage.untrackCacheStorageForStorageKey.
+//
+// Unregisters storage key from receiving notifications for cache storage.
+func UntrackCacheStorageForStorageKey(ctx context.Context, c *internal.Client, sessionID string, params *UntrackCacheStorageForStorageKeyParams) error {
+	if params == nil {
+		params = &UntrackCacheStorageForStorageKeyParams{}
+	}
+	return c.Call(ctx, "Storage.untrackCacheStorageForStorageKey", params, sessionID, nil)
+}
+
+// UntrackIndexedDBForOriginParams holds the parameters of Storage.untrackIndexedDBForOrigin.
+type UntrackIndexedDBForOriginParams struct {
+	// Security origin.
+	Origin string `json:"origin"`
+}
+
+// UntrackIndexedDBForOrigin calls Storage.untrackIndexedDBForOrigin.
+//
+// Unregisters origin from receiving notifications for IndexedDB.
+func UntrackIndexedDBForOrigin(ctx context.Context, c *internal.Client, sessionID string, params *UntrackIndexedDBForOriginParams) error {
+	if params == nil {
+		params = &UntrackIndexedDBForOriginParams{}
+	}
+	return c.Call(ctx, "Storage.untrackIndexedDBForOrigin", params, sessionID, nil)
+}
+
+// UntrackIndexedDBForStorageKeyParams holds the parameters of Storage.untrackIndexedDBForStorageKey.
+type UntrackIndexedDBForStorageKeyParams struct {
+	// Storage key.
+	StorageKey string `json:"storageKey"`
+}
+
+// UntrackIndexedDBForStorageKey calls Storage.untrackIndexedDBForStorageKey.
+//
+// Unregisters storage key from receiving notifications for IndexedDB.
+func UntrackIndexedDBForStorageKey(ctx context.Context, c *internal.Client, sessionID string, params *UntrackIndexedDBForStorageKeyParams) error {
+	if params == nil {
+		params = &UntrackIndexedDBForStorageKeyParams{}
+	}
+	return c.Call(ctx, "Storage.untrackIndexedDBForStorageKey", params, sessionID, nil)
+}
+
+// GetTrustTokensResult holds the result of Storage.getTrustTokens.
+type GetTrustTokensResult struct {
+	Tokens []TrustTokens `json:"tokens"`
+}
+
+// GetTrustTokens calls Storage.getTrustTokens.
+//
+// Returns the number of stored Trust Tokens per issuer for the
+// current browsing context.
+//
+// Experimental.
+func GetTrustTokens(ctx context.Context, c *internal.Client, sessionID string) (*GetTrustTokensResult, error) {
+	var result GetTrustTokensResult
+	err := c.Call(ctx, "Storage.getTrustTokens", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ClearTrustTokensParams holds the parameters of Storage.clearTrustTokens.
+type ClearTrustTokensParams struct {
+	IssuerOrigin string `json:"issuerOrigin"`
+}
+
+// ClearTrustTokensResult holds the result of Storage.clearTrustTokens.
+type ClearTrustTokensResult struct {
+	// True if any tokens were deleted, false otherwise.
+	DidDeleteTokens bool `json:"didDeleteTokens"`
+}
+
+// ClearTrustTokens calls Storage.clearTrustTokens.
+//
+// Removes all Trust Tokens issued by the provided issuerOrigin.
+// Leaves other stored data, including the issuer's Redemption Records, intact.
+//
+// Experimental.
+func ClearTrustTokens(ctx context.Context, c *internal.Client, sessionID string, params *ClearTrustTokensParams) (*ClearTrustTokensResult, error) {
+	if params == nil {
+		params = &ClearTrustTokensParams{}
+	}
+	var result ClearTrustTokensResult
+	err := c.Call(ctx, "Storage.clearTrustTokens", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetInterestGroupDetailsParams holds the parameters of Storage.getInterestGroupDetails.
+type GetInterestGroupDetailsParams struct {
+	OwnerOrigin string `json:"ownerOrigin"`
+	Name        string `json:"name"`
+}
+
+// GetInterestGroupDetailsResult holds the result of Storage.getInterestGroupDetails.
+type GetInterestGroupDetailsResult struct {
+	// This largely corresponds to:
+	// https://wicg.github.io/turtledove/#dictdef-generatebidinterestgroup
+	// but has absolute expirationTime instead of relative lifetimeMs and
+	// also adds joiningOrigin.
+	Details map[string]any `json:"details"`
+}
+
+// GetInterestGroupDetails calls Storage.getInterestGroupDetails.
+//
+// Gets details for a named interest group.
+//
+// Experimental.
+func GetInterestGroupDetails(ctx context.Context, c *internal.Client, sessionID string, params *GetInterestGroupDetailsParams) (*GetInterestGroupDetailsResult, error) {
+	if params == nil {
+		params = &GetInterestGroupDetailsParams{}
+	}
+	var result GetInterestGroupDetailsResult
+	err := c.Call(ctx, "Storage.getInterestGroupDetails", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SetInterestGroupTrackingParams holds the parameters of Storage.setInterestGroupTracking.
+type SetInterestGroupTrackingParams struct {
+	Enable bool `json:"enable"`
+}
+
+// SetInterestGroupTracking calls Storage.setInterestGroupTracking.
+//
+// Enables/Disables issuing of interestGroupAccessed events.
+//
+// Experimental.
+func SetInterestGroupTracking(ctx context.Context, c *internal.Client, sessionID string, params *SetInterestGroupTrackingParams) error {
+	if params == nil {
+		params = &SetInterestGroupTrackingParams{}
+	}
+	return c.Call(ctx, "Storage.setInterestGroupTracking", params, sessionID, nil)
+}
+
+// SetInterestGroupAuctionTrackingParams holds the parameters of Storage.setInterestGroupAuctionTracking.
+type SetInterestGroupAuctionTrackingParams struct {
+	Enable bool `json:"enable"`
+}
+
+// SetInterestGroupAuctionTracking calls Storage.setInterestGroupAuctionTracking.
+//
+// Enables/Disables issuing of interestGroupAuctionEventOccurred and
+// interestGroupAuctionNetworkRequestCreated.
+//
+// Experimental.
+func SetInterestGroupAuctionTracking(ctx context.Context, c *internal.Client, sessionID string, params *SetInterestGroupAuctionTrackingParams) error {
+	if params == nil {
+		params = &SetInterestGroupAuctionTrackingParams{}
+	}
+	return c.Call(ctx, "Storage.setInterestGroupAuctionTracking", params, sessionID, nil)
+}
+
+// GetSharedStorageMetadataParams holds the parameters of Storage.getSharedStorageMetadata.
+type GetSharedStorageMetadataParams struct {
+	OwnerOrigin string `json:"ownerOrigin"`
+}
+
+// GetSharedStorageMetadataResult holds the result of Storage.getSharedStorageMetadata.
+type GetSharedStorageMetadataResult struct {
+	Metadata SharedStorageMetadata `json:"metadata"`
+}
+
+// GetSharedStorageMetadata calls Storage.getSharedStorageMetadata.
+//
+// Gets metadata for an origin's shared storage.
+//
+// Experimental.
+func GetSharedStorageMetadata(ctx context.Context, c *internal.Client, sessionID string, params *GetSharedStorageMetadataParams) (*GetSharedStorageMetadataResult, error) {
+	if params == nil {
+		params = &GetSharedStorageMetadataParams{}
+	}
+	var result GetSharedStorageMetadataResult
+	err := c.Call(ctx, "Storage.getSharedStorageMetadata", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetSharedStorageEntriesParams holds the parameters of Storage.getSharedStorageEntries.
+type GetSharedStorageEntriesParams struct {
+	OwnerOrigin string `json:"ownerOrigin"`
+}
+
+// GetSharedStorageEntriesResult holds the result of Storage.getSharedStorageEntries.
+type GetSharedStorageEntriesResult struct {
+	Entries []SharedStorageEntry `json:"entries"`
+}
+
+// GetSharedStorageEntries calls Storage.getSharedStorageEntries.
+//
+// Gets the entries in an given origin's shared storage.
+//
+// Experimental.
+func GetSharedStorageEntries(ctx context.Context, c *internal.Client, sessionID string, params *GetSharedStorageEntriesParams) (*GetSharedStorageEntriesResult, error) {
+	if params == nil {
+		params = &GetSharedStorageEntriesParams{}
+	}
+	var result GetSharedStorageEntriesResult
+	err := c.Call(ctx, "Storage.getSharedStorageEntries", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SetSharedStorageEntryParams holds the parameters of Storage.setSharedStorageEntry.
+type SetSharedStorageEntryParams struct {
+	OwnerOrigin string `json:"ownerOrigin"`
+	Key         string `json:"key"`
+	Value       string `json:"value"`
+	// If `ignoreIfPresent` is included and true, then only sets the entry if
+	// `key` doesn't already exist.
+	IgnoreIfPresent *bool `json:"ignoreIfPresent,omitempty"`
+}
+
+// SetSharedStorageEntry calls Storage.setSharedStorageEntry.
+//
+// Sets entry with `key` and `value` for a given origin's shared storage.
+//
+// Experimental.
+func SetSharedStorageEntry(ctx context.Context, c *internal.Client, sessionID string, params *SetSharedStorageEntryParams) error {
+	if params == nil {
+		params = &SetSharedStorageEntryParams{}
+	}
+	return c.Call(ctx, "Storage.setSharedStorageEntry", params, sessionID, nil)
+}
+
+// DeleteSharedStorageEntryParams holds the parameters of Storage.deleteSharedStorageEntry.
+type DeleteSharedStorageEntryParams struct {
+	OwnerOrigin string `json:"ownerOrigin"`
+	Key         string `json:"key"`
+}
+
+// DeleteSharedStorageEntry calls Storage.deleteSharedStorageEntry.
+//
+// Deletes entry for `key` (if it exists) for a given origin's shared storage.
+//
+// Experimental.
+func DeleteSharedStorageEntry(ctx context.Context, c *internal.Client, sessionID string, params *DeleteSharedStorageEntryParams) error {
+	if params == nil {
+		params = &DeleteSharedStorageEntryParams{}
+	}
+	return c.Call(ctx, "Storage.deleteSharedStorageEntry", params, sessionID, nil)
+}
+
+// ClearSharedStorageEntriesParams holds the parameters of Storage.clearSharedStorageEntries.
+type ClearSharedStorageEntriesParams struct {
+	OwnerOrigin string `json:"ownerOrigin"`
+}
+
+// ClearSharedStorageEntries calls Storage.clearSharedStorageEntries.
+//
+// Clears all entries for a given origin's shared storage.
+//
+// Experimental.
+func ClearSharedStorageEntries(ctx context.Context, c *internal.Client, sessionID string, params *ClearSharedStorageEntriesParams) error {
+	if params == nil {
+		params = &ClearSharedStorageEntriesParams{}
+	}
+	return c.Call(ctx, "Storage.clearSharedStorageEntries", params, sessionID, nil)
+}
+
+// ResetSharedStorageBudgetParams holds the parameters of Storage.resetSharedStorageBudget.
+type ResetSharedStorageBudgetParams struct {
+	OwnerOrigin string `json:"ownerOrigin"`
+}
+
+// ResetSharedStorageBudget calls Storage.resetSharedStorageBudget.
+//
+// Resets the budget for `ownerOrigin` by clearing all budget withdrawals.
+//
+// Experimental.
+func ResetSharedStorageBudget(ctx context.Context, c *internal.Client, sessionID string, params *ResetSharedStorageBudgetParams) error {
+	if params == nil {
+		params = &ResetSharedStorageBudgetParams{}
+	}
+	return c.Call(ctx, "Storage.resetSharedStorageBudget", params, sessionID, nil)
+}
+
+// SetSharedStorageTrackingParams holds the parameters of Storage.setSharedStorageTracking.
+type SetSharedStorageTrackingParams struct {
+	Enable bool `json:"enable"`
+}
+
+// SetSharedStorageTracking calls Storage.setSharedStorageTracking.
+//
+// Enables/disables issuing of sharedStorageAccessed events.
+//
+// Experimental.
+func SetSharedStorageTracking(ctx context.Context, c *internal.Client, sessionID string, params *SetSharedStorageTrackingParams) error {
+	if params == nil {
+		params = &SetSharedStorageTrackingParams{}
+	}
+	return c.Call(ctx, "Storage.setSharedStorageTracking", params, sessionID, nil)
+}
+
+// SetStorageBucketTrackingParams holds the parameters of Storage.setStorageBucketTracking.
+type SetStorageBucketTrackingParams struct {
+	StorageKey string `json:"storageKey"`
+	Enable     bool   `json:"enable"`
+}
+
+// SetStorageBucketTracking calls Storage.setStorageBucketTracking.
+//
+// Set tracking for a storage key's buckets.
+//
+// Experimental.
+func SetStorageBucketTracking(ctx context.Context, c *internal.Client, sessionID string, params *SetStorageBucketTrackingParams) error {
+	if params == nil {
+		params = &SetStorageBucketTrackingParams{}
+	}
+	return c.Call(ctx, "Storage.setStorageBucketTracking", params, sessionID, nil)
+}
+
+// DeleteStorageBucketParams holds the parameters of Storage.deleteStorageBucket.
+type DeleteStorageBucketParams struct {
+	Bucket StorageBucket `json:"bucket"`
+}
+
+// DeleteStorageBucket calls Storage.deleteStorageBucket.
+//
+// Deletes the Storage Bucket with the given storage key and bucket name.
+//
+// Experimental.
+func DeleteStorageBucket(ctx context.Context, c *internal.Client, sessionID string, params *DeleteStorageBucketParams) error {
+	if params == nil {
+		params = &DeleteStorageBucketParams{}
+	}
+	return c.Call(ctx, "Storage.deleteStorageBucket", params, sessionID, nil)
+}
+
+// RunBounceTrackingMitigationsResult holds the result of Storage.runBounceTrackingMitigations.
+type RunBounceTrackingMitigationsResult struct {
+	DeletedSites []string `json:"deletedSites"`
+}
+
+// RunBounceTrackingMitigations calls Storage.runBounceTrackingMitigations.
+//
+// Deletes state for sites identified as potential bounce trackers, immediately.
+//
+// Experimental.
+func RunBounceTrackingMitigations(ctx context.Context, c *internal.Client, sessionID string) (*RunBounceTrackingMitigationsResult, error) {
+	var result RunBounceTrackingMitigationsResult
+	err := c.Call(ctx, "Storage.runBounceTrackingMitigations", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SetAttributionReportingLocalTestingModeParams holds the parameters of Storage.setAttributionReportingLocalTestingMode.
+type SetAttributionReportingLocalTestingModeParams struct {
+	// If enabled, noise is suppressed and reports are sent immediately.
+	Enabled bool `json:"enabled"`
+}
+
+// SetAttributionReportingLocalTestingMode calls Storage.setAttributionReportingLocalTestingMode.
+//
+// https://wicg.github.io/attribution-reporting-api/
+//
+// Experimental.
+func SetAttributionReportingLocalTestingMode(ctx context.Context, c *internal.Client, sessionID string, params *SetAttributionReportingLocalTestingModeParams) error {
+	if params == nil {
+		params = &SetAttributionReportingLocalTestingModeParams{}
+	}
+	return c.Call(ctx, "Storage.setAttributionReportingLocalTestingMode", params, sessionID, nil)
+}
+
+// SetAttributionReportingTrackingParams holds the parameters of Storage.setAttributionReportingTracking.
+type SetAttributionReportingTrackingParams struct {
+	Enable bool `json:"enable"`
+}
+
+// SetAttributionReportingTracking calls Storage.setAttributionReportingTracking.
+//
+// Enables/disables issuing of Attribution Reporting events.
+//
+// Experimental.
+func SetAttributionReportingTracking(ctx context.Context, c *internal.Client, sessionID string, params *SetAttributionReportingTrackingParams) error {
+	if params == nil {
+		params = &SetAttributionReportingTrackingParams{}
+	}
+	return c.Call(ctx, "Storage.setAttributionReportingTracking", params, sessionID, nil)
+}
+
+// SendPendingAttributionReportsResult holds the result of Storage.sendPendingAttributionReports.
+type SendPendingAttributionReportsResult struct {
+	// The number of reports that were sent.
+	NumSent int64 `json:"numSent"`
+}
+
+// SendPendingAttributionReports calls Storage.sendPendingAttributionReports.
+//
+// Sends all pending Attribution Reports immediately, regardless of their
+// scheduled report time.
+//
+// Experimental.
+func SendPendingAttributionReports(ctx context.Context, c *internal.Client, sessionID string) (*SendPendingAttributionReportsResult, error) {
+	var result SendPendingAttributionReportsResult
+	err := c.Call(ctx, "Storage.sendPendingAttributionReports", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetRelatedWebsiteSetsResult holds the result of Storage.getRelatedWebsiteSets.
+type GetRelatedWebsiteSetsResult struct {
+	Sets []RelatedWebsiteSet `json:"sets"`
+}
+
+// GetRelatedWebsiteSets calls Storage.getRelatedWebsiteSets.
+//
+// Returns the effective Related Website Sets in use by this profile for the browser
+// session. The effective Related Website Sets will not change during a browser session.
+//
+// Experimental.
+func GetRelatedWebsiteSets(ctx context.Context, c *internal.Client, sessionID string) (*GetRelatedWebsiteSetsResult, error) {
+	var result GetRelatedWebsiteSetsResult
+	err := c.Call(ctx, "Storage.getRelatedWebsiteSets", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// EventCacheStorageContentUpdated is the method name of the Storage.cacheStorageContentUpdated event.
+const EventCacheStorageContentUpdated = "Storage.cacheStorageContentUpdated"
+
+// CacheStorageContentUpdatedEvent is the payload of the Storage.cacheStorageContentUpdated event.
+//
+// A cache's contents have been modified.
+type CacheStorageContentUpdatedEvent struct {
+	// Origin to update.
+	Origin string `json:"origin"`
+	// Storage key to update.
+	StorageKey string `json:"storageKey"`
+	// Storage bucket to update.
+	BucketID string `json:"bucketId"`
+	// Name of cache in origin.
+	CacheName string `json:"cacheName"`
+}
+
+// EventCacheStorageListUpdated is the method name of the Storage.cacheStorageListUpdated event.
+const EventCacheStorageListUpdated = "Storage.cacheStorageListUpdated"
+
+// CacheStorageListUpdatedEvent is the payload of the Storage.cacheStorageListUpdated event.
+//
+// A cache has been added/deleted.
+type CacheStorageListUpdatedEvent struct {
+	// Origin to update.
+	Origin string `json:"origin"`
+	// Storage key to update.
+	StorageKey string `json:"storageKey"`
+	// Storage bucket to update.
+	BucketID string `json:"bucketId"`
+}
+
+// EventIndexedDBContentUpdated is the method name of the Storage.indexedDBContentUpdated event.
+const EventIndexedDBContentUpdated = "Storage.indexedDBContentUpdated"
+
+// IndexedDBContentUpdatedEvent is the payload of the Storage.indexedDBContentUpdated event.
+//
+// The origin's IndexedDB object store has been modified.
+type IndexedDBContentUpdatedEvent struct {
+	// Origin to update.
+	Origin string `json:"origin"`
+	// Storage key to update.
+	StorageKey string `json:"storageKey"`
+	// Storage bucket to update.
+	BucketID string `json:"bucketId"`
+	// Database to update.
+	DatabaseName string `json:"databaseName"`
+	// ObjectStore to update.
+	ObjectStoreName string `json:"objectStoreName"`
+}
+
+// EventIndexedDBListUpdated is the method name of the Storage.indexedDBListUpdated event.
+const EventIndexedDBListUpdated = "Storage.indexedDBListUpdated"
+
+// IndexedDBListUpdatedEvent is the payload of the Storage.indexedDBListUpdated event.
+//
+// The origin's IndexedDB database list has been modified.
+type IndexedDBListUpdatedEvent struct {
+	// Origin to update.
+	Origin string `json:"origin"`
+	// Storage key to update.
+	StorageKey string `json:"storageKey"`
+	// Storage bucket to update.
+	BucketID string `json:"bucketId"`
+}
+
+// EventInterestGroupAccessed is the method name of the Storage.interestGroupAccessed event.
+const EventInterestGroupAccessed = "Storage.interestGroupAccessed"
+
+// InterestGroupAccessedEvent is the payload of the Storage.interestGroupAccessed event.
+//
+// One of the interest groups was accessed. Note that these events are global
+// to all targets sharing an interest group store.
+type InterestGroupAccessedEvent struct {
+	AccessTime  network.TimeSinceEpoch  `json:"accessTime"`
+	Type        InterestGroupAccessType `json:"type"`
+	OwnerOrigin string                  `json:"ownerOrigin"`
+	Name        string                  `json:"name"`
+	// For topLevelBid/topLevelAdditionalBid, and when appropriate,
+	// win and additionalBidWin
+	ComponentSellerOrigin string `json:"componentSellerOrigin,omitempty"`
+	// For bid or somethingBid event, if done locally and not on a server.
+	Bid         *float64 `json:"bid,omitempty"`
+	BidCurrency string   `json:"bidCurrency,omitempty"`
+	// For non-global events --- links to interestGroupAuctionEvent
+	UniqueAuctionID InterestGroupAuctionID `json:"uniqueAuctionId,omitempty"`
+}
+
+// EventInterestGroupAuctionEventOccurred is the method name of the Storage.interestGroupAuctionEventOccurred event.
+const EventInterestGroupAuctionEventOccurred = "Storage.interestGroupAuctionEventOccurred"
+
+// InterestGroupAuctionEventOccurredEvent is the payload of the Storage.interestGroupAuctionEventOccurred event.
+//
+// An auction involving interest groups is taking place. These events are
+// target-specific.
+type InterestGroupAuctionEventOccurredEvent struct {
+	EventTime       network.TimeSinceEpoch        `json:"eventTime"`
+	Type            InterestGroupAuctionEventType `json:"type"`
+	UniqueAuctionID InterestGroupAuctionID        `json:"uniqueAuctionId"`
+	// Set for child auctions.
+	ParentAuctionID InterestGroupAuctionID `json:"parentAuctionId,omitempty"`
+	// Set for started and configResolved
+	AuctionConfig map[string]any `json:"auctionConfig,omitempty"`
+}
+
+// EventInterestGroupAuctionNetworkRequestCreated is the method name of the Storage.interestGroupAuctionNetworkRequestCreated event.
+const EventInterestGroupAuctionNetworkRequestCreated = "Storage.interestGroupAuctionNetworkRequestCreated"
+
+// InterestGroupAuctionNetworkRequestCreatedEvent is the payload of the Storage.interestGroupAuctionNetworkRequestCreated event.
+//
+// Specifies which auctions a particular network fetch may be related to, and
+// in what role. Note that it is not ordered with respect to
+// Network.requestWillBeSent (but will happen before loadingFinished
+// loadingFailed).
+type InterestGroupAuctionNetworkRequestCreatedEvent struct {
+	Type      InterestGroupAuctionFetchType `json:"type"`
+	RequestID network.RequestID             `json:"requestId"`
+	// This is the set of the auctions using the worklet that issued this
+	// request.  In the case of trusted signals, it's possible that only some of
+	// them actually care about the keys being queried.
+	Auctions []InterestGroupAuctionID `json:"auctions"`
+}
+
+// EventSharedStorageAccessed is the method name of the Storage.sharedStorageAccessed event.
+const EventSharedStorageAccessed = "Storage.sharedStorageAccessed"
+
+// SharedStorageAccessedEvent is the payload of the Storage.sharedStorageAccessed event.
+//
+// Shared storage was accessed by the associated page.
+// The following parameters are included in all events.
+type SharedStorageAccessedEvent struct {
+	// Time of the access.
+	AccessTime network.TimeSinceEpoch `json:"accessTime"`
+	// Enum value indicating the Shared Storage API method invoked.
+	Type SharedStorageAccessType `json:"type"`
+	// DevTools Frame Token for the primary frame tree's root.
+	MainFrameID page.FrameID `json:"mainFrameId"`
+	// Serialized origin for the context that invoked the Shared Storage API.
+	OwnerOrigin string `json:"ownerOrigin"`
+	// The sub-parameters wrapped by `params` are all optional and their
+	// presence/absence depends on `type`.
+	Params SharedStorageAccessParams `json:"params"`
+}
+
+// EventStorageBucketCreatedOrUpdated is the method name of the Storage.storageBucketCreatedOrUpdated event.
+const EventStorageBucketCreatedOrUpdated = "Storage.storageBucketCreatedOrUpdated"
+
+// StorageBucketCreatedOrUpdatedEvent is the payload of the Storage.storageBucketCreatedOrUpdated event.
+type StorageBucketCreatedOrUpdatedEvent struct {
+	BucketInfo StorageBucketInfo `json:"bucketInfo"`
+}
+
+// EventStorageBucketDeleted is the method name of the Storage.storageBucketDeleted event.
+const EventStorageBucketDeleted = "Storage.storageBucketDeleted"
+
+// StorageBucketDeletedEvent is the payload of the Storage.storageBucketDeleted event.
+type StorageBucketDeletedEvent struct {
+	BucketID string `json:"bucketId"`
+}
+
+// EventAttributionReportingSourceRegistered is the method name of the Storage.attributionReportingSourceRegistered event.
+const EventAttributionReportingSourceRegistered = "Storage.attributionReportingSourceRegistered"
+
+// AttributionReportingSourceRegisteredEvent is the payload of the Storage.attributionReportingSourceRegistered event.
+//
+// Experimental.
+type AttributionReportingSourceRegisteredEvent struct {
+	Registration AttributionReportingSourceRegistration       `json:"registration"`
+	Result       AttributionReportingSourceRegistrationResult `json:"result"`
+}
+
+// EventAttributionReportingTriggerRegistered is the method name of the Storage.attributionReportingTriggerRegistered event.
+const EventAttributionReportingTriggerRegistered = "Storage.attributionReportingTriggerRegistered"
+
+// AttributionReportingTriggerRegisteredEvent is the payload of the Storage.attributionReportingTriggerRegistered event.
+//
+// Experimental.
+type AttributionReportingTriggerRegisteredEvent struct {
+	Registration AttributionReportingTriggerRegistration `json:"registration"`
+	EventLevel   AttributionReportingEventLevelResult    `json:"eventLevel"`
+	Aggregatable AttributionReportingAggregatableResult  `json:"aggregatable"`
+}
+
+// ParseEvent decodes a Storage event message into its typed event struct.
+func ParseEvent(msg *internal.CDPMessage) (any, error) {
+	var v any
+	switch msg.Method {
+	case EventCacheStorageContentUpdated:
+		v = &CacheStorageContentUpdatedEvent{}
+	case EventCacheStorageListUpdated:
+		v = &CacheStorageListUpdatedEvent{}
+	case EventIndexedDBContentUpdated:
+		v = &IndexedDBContentUpdatedEvent{}
+	case EventIndexedDBListUpdated:
+		v = &IndexedDBListUpdatedEvent{}
+	case EventInterestGroupAccessed:
+		v = &InterestGroupAccessedEvent{}
+	case EventInterestGroupAuctionEventOccurred:
+		v = &InterestGroupAuctionEventOccurredEvent{}
+	case EventInterestGroupAuctionNetworkRequestCreated:
+		v = &InterestGroupAuctionNetworkRequestCreatedEvent{}
+	case EventSharedStorageAccessed:
+		v = &SharedStorageAccessedEvent{}
+	case EventStorageBucketCreatedOrUpdated:
+		v = &StorageBucketCreatedOrUpdatedEvent{}
+	case EventStorageBucketDeleted:
+		v = &StorageBucketDeletedEvent{}
+	case EventAttributionReportingSourceRegistered:
+		v = &AttributionReportingSourceRegisteredEvent{}
+	case EventAttributionReportingTriggerRegistered:
+		v = &AttributionReportingTriggerRegisteredEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Storage event: %s", msg.Method)
+	}
+	if msg.Params == nil {
+		return v, nil
+	}
+	err := json.Unmarshal(msg.Params, v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,212 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package systeminfo provides typed bindings for the CDP SystemInfo domain.
+//
+// The SystemInfo domain defines methods and events for querying low-level system information.
+package systeminfo
+
+import (
+	"cdp/internal"
+	"context"
+)
+
+// GPUDevice is the SystemInfo.GPUDevice type.
+//
+// Describes a single graphics processor (GPU).
+type GPUDevice struct {
+	// PCI ID of the GPU vendor, if available; 0 otherwise.
+	VendorID float64 `json:"vendorId"`
+	// PCI ID of the GPU device, if available; 0 otherwise.
+	DeviceID float64 `json:"deviceId"`
+	// Sub sys ID of the GPU, only available on Windows.
+	SubSysID *float64 `json:"subSysId,omitempty"`
+	// Revision of the GPU, only available on Windows.
+	Revision *float64 `json:"revision,omitempty"`
+	// String description of the GPU vendor, if the PCI ID is not available.
+	VendorString string `json:"vendorString"`
+	// String description of the GPU device, if the PCI ID is not available.
+	DeviceString string `json:"deviceString"`
+	// String description of the GPU driver vendor.
+	DriverVendor string `json:"driverVendor"`
+	// String description of the GPU driver version.
+	DriverVersion string `json:"driverVersion"`
+}
+
+// Size is the SystemInfo.Size type.
+//
+// Describes the width and height dimensions of an entity.
+type Size struct {
+	// Width in pixels.
+	Width int64 `json:"width"`
+	// Height in pixels.
+	Height int64 `json:"height"`
+}
+
+// VideoDecodeAcceleratorCapability is the SystemInfo.VideoDecodeAcceleratorCapability type.
+//
+// Describes a supported video decoding profile with its associated minimum and
+// maximum resolutions.
+type VideoDecodeAcceleratorCapability struct {
+	// Video codec profile that is supported, e.g. VP9 Profile 2.
+	Profile string `json:"profile"`
+	// Maximum video dimensions in pixels supported for this |profile|.
+	MaxResolution Size `json:"maxResolution"`
+	// Minimum video dimensions in pixels supported for this |profile|.
+	MinResolution Size `json:"minResolution"`
+}
+
+// VideoEncodeAcceleratorCapability is the SystemInfo.VideoEncodeAcceleratorCapability type.
+//
+// Describes a supported video encoding profile with its associated maximum
+// resolution and maximum framerate.
+type VideoEncodeAcceleratorCapability struct {
+	// Video codec profile that is supported, e.g H264 Main.
+	Profile string `json:"profile"`
+	// Maximum video dimensions in pixels supported for this |profile|.
+	MaxResolution Size `json:"maxResolution"`
+	// Maximum encoding framerate in frames per second supported for this
+	// |profile|, as fraction's numerator and denominator, e.g. 24/1 fps,
+	// 24000/1001 fps, etc.
+	MaxFramerateNumerator   int64 `json:"maxFramerateNumerator"`
+	MaxFramerateDenominator int64 `json:"maxFramerateDenominator"`
+}
+
+// SubsamplingFormat is the SystemInfo.SubsamplingFormat type.
+//
+// YUV subsampling type of the pixels of a given image.
+type SubsamplingFormat string
+
+const (
+	SubsamplingFormatYuv420 SubsamplingFormat = "yuv420"
+	SubsamplingFormatYuv422 SubsamplingFormat = "yuv422"
+	SubsamplingFormatYuv444 SubsamplingFormat = "yuv444"
+)
+
+// ImageType is the SystemInfo.ImageType type.
+//
+// Image format of a given image.
+type ImageType string
+
+const (
+	ImageTypeJpeg    ImageType = "jpeg"
+	ImageTypeWebp    ImageType = "webp"
+	ImageTypeUnknown ImageType = "unknown"
+)
+
+// ImageDecodeAcceleratorCapability is the SystemInfo.ImageDecodeAcceleratorCapability type.
+//
+// Describes a supported image decoding profile with its associated minimum and
+// maximum resolutions and subsampling.
+type ImageDecodeAcceleratorCapability struct {
+	// Image coded, e.g. Jpeg.
+	ImageType ImageType `json:"imageType"`
+	// Maximum supported dimensions of the image in pixels.
+	MaxDimensions Size `json:"maxDimensions"`
+	// Minimum supported dimensions of the image in pixels.
+	MinDimensions Size `json:"minDimensions"`
+	// Optional array of supported subsampling formats, e.g. 4:2:0, if known.
+	Subsamplings []SubsamplingFormat `json:"subsamplings"`
+}
+
+// GPUInfo is the SystemInfo.GPUInfo type.
+//
+// Provides information about the GPU(s) on the system.
+type GPUInfo struct {
+	// The graphics devices on the system. Element 0 is the primary GPU.
+	Devices []GPUDevice `json:"devices"`
+	// An optional dictionary of additional GPU related attributes.
+	AuxAttributes map[string]any `json:"auxAttributes,omitempty"`
+	// An optional dictionary of graphics features and their status.
+	FeatureStatus map[string]any `json:"featureStatus,omitempty"`
+	// An optional array of GPU driver bug workarounds.
+	DriverBugWorkarounds []string `json:"driverBugWorkarounds"`
+	// Supported accelerated video decoding capabilities.
+	VideoDecoding []VideoDecodeAcceleratorCapability `json:"videoDecoding"`
+	// Supported accelerated video encoding capabilities.
+	VideoEncoding []VideoEncodeAcceleratorCapability `json:"videoEncoding"`
+	// Supported accelerated image decoding capabilities.
+	ImageDecoding []ImageDecodeAcceleratorCapability `json:"imageDecoding"`
+}
+
+// ProcessInfo is the SystemInfo.ProcessInfo type.
+//
+// Represents process info.
+type ProcessInfo struct {
+	// Specifies process type.
+	Type string `json:"type"`
+	// Specifies process id.
+	ID int64 `json:"id"`
+	// Specifies cumulative CPU usage in seconds across all threads of the
+	// process since the process start.
+	CPUTime float64 `json:"cpuTime"`
+}
+
+// GetInfoResult holds the result of SystemInfo.getInfo.
+type GetInfoResult struct {
+	// Information about the GPUs on the system.
+	GPU GPUInfo `json:"gpu"`
+	// A platform-dependent description of the model of the machine. On Mac OS, this is, for
+	// example, 'MacBookPro'. Will be the empty string if not supported.
+	ModelName string `json:"modelName"`
+	// A platform-dependent description of the version of the machine. On Mac OS, this is, for
+	// example, '10.1'. Will be the empty string if not supported.
+	ModelVersion string `json:"modelVersion"`
+	// The command line string used to launch the browser. Will be the empty string if not
+	// supported.
+	CommandLine string `json:"commandLine"`
+}
+
+// GetInfo calls SystemInfo.getInfo.
+//
+// Returns information about the system.
+func GetInfo(ctx context.Context, c *internal.Client, sessionID string) (*GetInfoResult, error) {
+	var result GetInfoResult
+	err := c.Call(ctx, "SystemInfo.getInfo", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetFeatureStateParams holds the parameters of SystemInfo.getFeatureState.
+type GetFeatureStateParams struct {
+	FeatureState string `json:"featureState"`
+}
+
+// GetFeatureStateResult holds the result of SystemInfo.getFeatureState.
+type GetFeatureStateResult struct {
+	FeatureEnabled bool `json:"featureEnabled"`
+}
+
+// GetFeatureState calls SystemInfo.getFeatureState.
+//
+// Returns information about the feature state.
+func GetFeatureState(ctx context.Context, c *internal.Client, sessionID string, params *GetFeatureStateParams) (*GetFeatureStateResult, error) {
+	if params == nil {
+		params = &GetFeatureStateParams{}
+	}
+	var result GetFeatureStateResult
+	err := c.Call(ctx, "SystemInfo.getFeatureState", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetProcessInfoResult holds the result of SystemInfo.getProcessInfo.
+type GetProcessInfoResult struct {
+	// An array of process info blocks.
+	ProcessInfo []ProcessInfo `json:"processInfo"`
+}
+
+// GetProcessInfo calls SystemInfo.getProcessInfo.
+//
+// Returns information about all running processes.
+func GetProcessInfo(ctx context.Context, c *internal.Client, sessionID string) (*GetProcessInfoResult, error) {
+	var result GetProcessInfoResult
+	err := c.Call(ctx, "SystemInfo.getProcessInfo", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
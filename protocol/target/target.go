@@ -0,0 +1,658 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package target provides typed bindings for the CDP Target domain.
+//
+// Supports additional targets discovery and allows to attach to them.
+package target
+
+import (
+	"cdp/internal"
+	"cdp/protocol/page"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// TargetID is the Target.TargetID type.
+type TargetID string
+
+// SessionID is the Target.SessionID type.
+//
+// Unique identifier of attached debugging session.
+type SessionID string
+
+// TargetInfo is the Target.TargetInfo type.
+type TargetInfo struct {
+	TargetID TargetID `json:"targetId"`
+	// List of types: https://source.chromium.org/chromium/chromium/src/+/main:content/browser/devtools/devtools_agent_host_impl.cc?ss=chromium&q=f:devtools%20-f:out%20%22::kTypeTab%5B%5D%22
+	Type  string `json:"type"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+	// Whether the target has an attached client.
+	Attached bool `json:"attached"`
+	// Opener target Id
+	OpenerID TargetID `json:"openerId,omitempty"`
+	// Whether the target has access to the originating window.
+	//
+	// Experimental.
+	CanAccessOpener bool `json:"canAccessOpener"`
+	// Frame id of originating window (is only set if target has an opener).
+	//
+	// Experimental.
+	OpenerFrameID page.FrameID `json:"openerFrameId,omitempty"`
+	// Experimental.
+	BrowserContextID string `json:"browserContextId,omitempty"`
+	// Provides additional details for specific target types. For example, for
+	// the type of "page", this may be set to "prerender".
+	//
+	// Experimental.
+	Subtype string `json:"subtype,omitempty"`
+}
+
+// FilterEntry is the Target.FilterEntry type.
+//
+// A filter used by target query/discovery/auto-attach operations.
+//
+// Experimental.
+type FilterEntry struct {
+	// If set, causes exclusion of matching targets from the list.
+	Exclude *bool `json:"exclude,omitempty"`
+	// If not present, matches any type.
+	Type string `json:"type,omitempty"`
+}
+
+// TargetFilter is the Target.TargetFilter type.
+//
+// The entries in TargetFilter are matched sequentially against targets and
+// the first entry that matches determines if the target is included or not,
+// depending on the value of `exclude` field in the entry.
+// If filter is not specified, the one assumed is
+// [{type: "browser", exclude: true}, {type: "tab", exclude: true}, {}]
+// (i.e. include everything but `browser` and `tab`).
+//
+// Experimental.
+type TargetFilter []FilterEntry
+
+// RemoteLocation is the Target.RemoteLocation type.
+//
+// Experimental.
+type RemoteLocation struct {
+	Host string `json:"host"`
+	Port int64  `json:"port"`
+}
+
+// ActivateTargetParams holds the parameters of Target.activateTarget.
+type ActivateTargetParams struct {
+	TargetID TargetID `json:"targetId"`
+}
+
+// ActivateTarget calls Target.activateTarget.
+//
+// Activates (focuses) the target.
+func ActivateTarget(ctx context.Context, c *internal.Client, sessionID string, params *ActivateTargetParams) error {
+	if params == nil {
+		params = &ActivateTargetParams{}
+	}
+	return c.Call(ctx, "Target.activateTarget", params, sessionID, nil)
+}
+
+// AttachToTargetParams holds the parameters of Target.attachToTarget.
+type AttachToTargetParams struct {
+	TargetID TargetID `json:"targetId"`
+	// Enables "flat" access to the session via specifying sessionId attribute in the commands.
+	// We plan to make this the default, deprecate non-flattened mode,
+	// and eventually retire it. See crbug.com/991325.
+	Flatten *bool `json:"flatten,omitempty"`
+}
+
+// AttachToTargetResult holds the result of Target.attachToTarget.
+type AttachToTargetResult struct {
+	// Id assigned to the session.
+	SessionID SessionID `json:"sessionId"`
+}
+
+// AttachToTarget calls Target.attachToTarget.
+//
+// Attaches to the target with given id.
+func AttachToTarget(ctx context.Context, c *internal.Client, sessionID string, params *AttachToTargetParams) (*AttachToTargetResult, error) {
+	if params == nil {
+		params = &AttachToTargetParams{}
+	}
+	var result AttachToTargetResult
+	err := c.Call(ctx, "Target.attachToTarget", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// AttachToBrowserTargetResult holds the result of Target.attachToBrowserTarget.
+type AttachToBrowserTargetResult struct {
+	// Id assigned to the session.
+	SessionID SessionID `json:"sessionId"`
+}
+
+// AttachToBrowserTarget calls Target.attachToBrowserTarget.
+//
+// Attaches to the browser target, only uses flat sessionId mode.
+//
+// Experimental.
+func AttachToBrowserTarget(ctx context.Context, c *internal.Client, sessionID string) (*AttachToBrowserTargetResult, error) {
+	var result AttachToBrowserTargetResult
+	err := c.Call(ctx, "Target.attachToBrowserTarget", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CloseTargetParams holds the parameters of Target.closeTarget.
+type CloseTargetParams struct {
+	TargetID TargetID `json:"targetId"`
+}
+
+// CloseTargetResult holds the result of Target.closeTarget.
+type CloseTargetResult struct {
+	// Always set to true. If an error occurs, the response indicates protocol error.
+	//
+	// Deprecated: this item is deprecated in the protocol.
+	Success bool `json:"success"`
+}
+
+// CloseTarget calls Target.closeTarget.
+//
+// Closes the target. If the target is a page that gets closed too.
+func CloseTarget(ctx context.Context, c *internal.Client, sessionID string, params *CloseTargetParams) (*CloseTargetResult, error) {
+	if params == nil {
+		params = &CloseTargetParams{}
+	}
+	var result CloseTargetResult
+	err := c.Call(ctx, "Target.closeTarget", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ExposeDevToolsProtocolParams holds the parameters of Target.exposeDevToolsProtocol.
+type ExposeDevToolsProtocolParams struct {
+	TargetID TargetID `json:"targetId"`
+	// Binding name, 'cdp' if not specified.
+	BindingName string `json:"bindingName,omitempty"`
+}
+
+// ExposeDevToolsProtocol calls Target.exposeDevToolsProtocol.
+//
+// Inject object to the target's main frame that provides a communication
+// channel with browser target.
+//
+// Injected object will be available as `window[bindingName]`.
+//
+// The object has the following API:
+// - `binding.send(json)` - a method to send messages over the remote debugging protocol
+// - `binding.onmessage = json => handleMessage(json)` - a callback that will be called for the protocol notifications and command responses.
+//
+// Experimental.
+func ExposeDevToolsProtocol(ctx context.Context, c *internal.Client, sessionID string, params *ExposeDevToolsProtocolParams) error {
+	if params == nil {
+		params = &ExposeDevToolsProtocolParams{}
+	}
+	return c.Call(ctx, "Target.exposeDevToolsProtocol", params, sessionID, nil)
+}
+
+// CreateBrowserContextParams holds the parameters of Target.createBrowserContext.
+type CreateBrowserContextParams struct {
+	// If specified, disposes this context when debugging session disconnects.
+	//
+	// Experimental.
+	DisposeOnDetach *bool `json:"disposeOnDetach,omitempty"`
+	// Proxy server, similar to the one passed to --proxy-server
+	//
+	// Experimental.
+	ProxyServer string `json:"proxyServer,omitempty"`
+	// Proxy bypass list, similar to the one passed to --proxy-bypass-list
+	//
+	// Experimental.
+	ProxyBypassList string `json:"proxyBypassList,omitempty"`
+	// An optional list of origins to grant unlimited cross-origin access to.
+	// Parts of the URL other than those constituting origin are ignored.
+	//
+	// Experimental.
+	OriginsWithUniversalNetworkAccess []string `json:"originsWithUniversalNetworkAccess,omitempty"`
+}
+
+// CreateBrowserContextResult holds the result of Target.createBrowserContext.
+type CreateBrowserContextResult struct {
+	// The id of the context created.
+	BrowserContextID string `json:"browserContextId"`
+}
+
+// CreateBrowserContext calls Target.createBrowserContext.
+//
+// Creates a new empty BrowserContext. Similar to an incognito profile but you can have more than
+// one.
+func CreateBrowserContext(ctx context.Context, c *internal.Client, sessionID string, params *CreateBrowserContextParams) (*CreateBrowserContextResult, error) {
+	if params == nil {
+		params = &CreateBrowserContextParams{}
+	}
+	var result CreateBrowserContextResult
+	err := c.Call(ctx, "Target.createBrowserContext", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetBrowserContextsResult holds the result of Target.getBrowserContexts.
+type GetBrowserContextsResult struct {
+	// An array of browser context ids.
+	BrowserContextIDs []string `json:"browserContextIds"`
+}
+
+// GetBrowserContexts calls Target.getBrowserContexts.
+//
+// Returns all browser contexts created with `Target.createBrowserContext` method.
+func GetBrowserContexts(ctx context.Context, c *internal.Client, sessionID string) (*GetBrowserContextsResult, error) {
+	var result GetBrowserContextsResult
+	err := c.Call(ctx, "Target.getBrowserContexts", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CreateTargetParams holds the parameters of Target.createTarget.
+type CreateTargetParams struct {
+	// The initial URL the page will be navigated to. An empty string indicates about:blank.
+	URL string `json:"url"`
+	// Frame width in DIP (headless chrome only).
+	Width *int64 `json:"width,omitempty"`
+	// Frame height in DIP (headless chrome only).
+	Height *int64 `json:"height,omitempty"`
+	// The browser context to create the page in.
+	//
+	// Experimental.
+	BrowserContextID string `json:"browserContextId,omitempty"`
+	// Whether BeginFrames for this target will be controlled via DevTools (headless chrome only,
+	// not supported on MacOS yet, false by default).
+	//
+	// Experimental.
+	EnableBeginFrameControl *bool `json:"enableBeginFrameControl,omitempty"`
+	// Whether to create a new Window or Tab (chrome-only, false by default).
+	NewWindow *bool `json:"newWindow,omitempty"`
+	// Whether to create the target in background or foreground (chrome-only,
+	// false by default).
+	Background *bool `json:"background,omitempty"`
+	// Whether to create the target of type "tab".
+	//
+	// Experimental.
+	ForTab *bool `json:"forTab,omitempty"`
+}
+
+// CreateTargetResult holds the result of Target.createTarget.
+type CreateTargetResult struct {
+	// The id of the page opened.
+	TargetID TargetID `json:"targetId"`
+}
+
+// CreateTarget calls Target.createTarget.
+//
+// Creates a new page.
+func CreateTarget(ctx context.Context, c *internal.Client, sessionID string, params *CreateTargetParams) (*CreateTargetResult, error) {
+	if params == nil {
+		params = &CreateTargetParams{}
+	}
+	var result CreateTargetResult
+	err := c.Call(ctx, "Target.createTarget", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DetachFromTargetParams holds the parameters of Target.detachFromTarget.
+type DetachFromTargetParams struct {
+	// Session to detach.
+	SessionID SessionID `json:"sessionId,omitempty"`
+	// Deprecated.
+	//
+	// Deprecated: this item is deprecated in the protocol.
+	TargetID TargetID `json:"targetId,omitempty"`
+}
+
+// DetachFromTarget calls Target.detachFromTarget.
+//
+// Detaches session with given id.
+func DetachFromTarget(ctx context.Context, c *internal.Client, sessionID string, params *DetachFromTargetParams) error {
+	if params == nil {
+		params = &DetachFromTargetParams{}
+	}
+	return c.Call(ctx, "Target.detachFromTarget", params, sessionID, nil)
+}
+
+// DisposeBrowserContextParams holds the parameters of Target.disposeBrowserContext.
+type DisposeBrowserContextParams struct {
+	BrowserContextID string `json:"browserContextId"`
+}
+
+// DisposeBrowserContext calls Target.disposeBrowserContext.
+//
+// Deletes a BrowserContext. All the belonging pages will be closed without calling their
+// beforeunload hooks.
+func DisposeBrowserContext(ctx context.Context, c *internal.Client, sessionID string, params *DisposeBrowserContextParams) error {
+	if params == nil {
+		params = &DisposeBrowserContextParams{}
+	}
+	return c.Call(ctx, "Target.disposeBrowserContext", params, sessionID, nil)
+}
+
+// GetTargetInfoParams holds the parameters of Target.getTargetInfo.
+type GetTargetInfoParams struct {
+	TargetID TargetID `json:"targetId,omitempty"`
+}
+
+// GetTargetInfoResult holds the result of Target.getTargetInfo.
+type GetTargetInfoResult struct {
+	TargetInfo TargetInfo `json:"targetInfo"`
+}
+
+// GetTargetInfo calls Target.getTargetInfo.
+//
+// Returns information about a target.
+//
+// Experimental.
+func GetTargetInfo(ctx context.Context, c *internal.Client, sessionID string, params *GetTargetInfoParams) (*GetTargetInfoResult, error) {
+	if params == nil {
+		params = &GetTargetInfoParams{}
+	}
+	var result GetTargetInfoResult
+	err := c.Call(ctx, "Target.getTargetInfo", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetTargetsParams holds the parameters of Target.getTargets.
+type GetTargetsParams struct {
+	// Only targets matching filter will be reported. If filter is not specified
+	// and target discovery is currently enabled, a filter used for target discovery
+	// is used for consistency.
+	//
+	// Experimental.
+	Filter TargetFilter `json:"filter,omitempty"`
+}
+
+// GetTargetsResult holds the result of Target.getTargets.
+type GetTargetsResult struct {
+	// The list of targets.
+	TargetInfos []TargetInfo `json:"targetInfos"`
+}
+
+// GetTargets calls Target.getTargets.
+//
+// Retrieves a list of available targets.
+func GetTargets(ctx context.Context, c *internal.Client, sessionID string, params *GetTargetsParams) (*GetTargetsResult, error) {
+	if params == nil {
+		params = &GetTargetsParams{}
+	}
+	var result GetTargetsResult
+	err := c.Call(ctx, "Target.getTargets", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SendMessageToTargetParams holds the parameters of Target.sendMessageToTarget.
+type SendMessageToTargetParams struct {
+	Message string `json:"message"`
+	// Identifier of the session.
+	SessionID SessionID `json:"sessionId,omitempty"`
+	// Deprecated.
+	//
+	// Deprecated: this item is deprecated in the protocol.
+	TargetID TargetID `json:"targetId,omitempty"`
+}
+
+// SendMessageToTarget calls Target.sendMessageToTarget.
+//
+// Sends protocol message over session with given id.
+// Consider using flat mode instead; see commands attachToTarget, setAutoAttach,
+// and crbug.com/991325.
+//
+// Deprecated: this item is deprecated in the protocol.
+func SendMessageToTarget(ctx context.Context, c *internal.Client, sessionID string, params *SendMessageToTargetParams) error {
+	if params == nil {
+		params = &SendMessageToTargetParams{}
+	}
+	return c.Call(ctx, "Target.sendMessageToTarget", params, sessionID, nil)
+}
+
+// SetAutoAttachParams holds the parameters of Target.setAutoAttach.
+type SetAutoAttachParams struct {
+	// Whether to auto-attach to related targets.
+	AutoAttach bool `json:"autoAttach"`
+	// Whether to pause new targets when attaching to them. Use `Runtime.runIfWaitingForDebugger`
+	// to run paused targets.
+	WaitForDebuggerOnStart bool `json:"waitForDebuggerOnStart"`
+	// Enables "flat" access to the session via specifying sessionId attribute in the commands.
+	// We plan to make this the default, deprecate non-flattened mode,
+	// and eventually retire it. See crbug.com/991325.
+	//
+	// Experimental.
+	Flatten *bool `json:"flatten,omitempty"`
+	// Only targets matching filter will be attached.
+	//
+	// Experimental.
+	Filter TargetFilter `json:"filter,omitempty"`
+}
+
+// SetAutoAttach calls Target.setAutoAttach.
+//
+// Controls whether to automatically attach to new targets which are considered to be related to
+// this one. When turned on, attaches to all existing related targets as well. When turned off,
+// automatically detaches from all currently attached targets.
+// This also clears all targets added by `autoAttachRelated` from the list of targets to watch
+// for creation of related targets.
+func SetAutoAttach(ctx context.Context, c *internal.Client, sessionID string, params *SetAutoAttachParams) error {
+	if params == nil {
+		params = &SetAutoAttachParams{}
+	}
+	return c.Call(ctx, "Target.setAutoAttach", params, sessionID, nil)
+}
+
+// AutoAttachRelatedParams holds the parameters of Target.autoAttachRelated.
+type AutoAttachRelatedParams struct {
+	TargetID TargetID `json:"targetId"`
+	// Whether to pause new targets when attaching to them. Use `Runtime.runIfWaitingForDebugger`
+	// to run paused targets.
+	WaitForDebuggerOnStart bool `json:"waitForDebuggerOnStart"`
+	// Only targets matching filter will be attached.
+	//
+	// Experimental.
+	Filter TargetFilter `json:"filter,omitempty"`
+}
+
+// AutoAttachRelated calls Target.autoAttachRelated.
+//
+// Adds the specified target to the list of targets that will be monitored for any related target
+// creation (such as child frames, child workers and new versions of service worker) and reported
+// through `attachedToTarget`. The specified target is also auto-attached.
+// This cancels the effect of any previous `setAutoAttach` and is also cancelled by subsequent
+// `setAutoAttach`. Only available at the Browser target.
+//
+// Experimental.
+func AutoAttachRelated(ctx context.Context, c *internal.Client, sessionID string, params *AutoAttachRelatedParams) error {
+	if params == nil {
+		params = &AutoAttachRelatedParams{}
+	}
+	return c.Call(ctx, "Target.autoAttachRelated", params, sessionID, nil)
+}
+
+// SetDiscoverTargetsParams holds the parameters of Target.setDiscoverTargets.
+type SetDiscoverTargetsParams struct {
+	// Whether to discover available targets.
+	Discover bool `json:"discover"`
+	// Only targets matching filter will be attached. If `discover` is false,
+	// `filter` must be omitted or empty.
+	//
+	// Experimental.
+	Filter TargetFilter `json:"filter,omitempty"`
+}
+
+// SetDiscoverTargets calls Target.setDiscoverTargets.
+//
+// Controls whether to discover available targets and notify via
+// `targetCreated/targetInfoChanged/targetDestroyed` events.
+func SetDiscoverTargets(ctx context.Context, c *internal.Client, sessionID string, params *SetDiscoverTargetsParams) error {
+	if params == nil {
+		params = &SetDiscoverTargetsParams{}
+	}
+	return c.Call(ctx, "Target.setDiscoverTargets", params, sessionID, nil)
+}
+
+// SetRemoteLocationsParams holds the parameters of Target.setRemoteLocations.
+type SetRemoteLocationsParams struct {
+	// List of remote locations.
+	Locations []RemoteLocation `json:"locations"`
+}
+
+// SetRemoteLocations calls Target.setRemoteLocations.
+//
+// Enables target discovery for the specified locations, when `setDiscoverTargets` was set to
+// `true`.
+//
+// Experimental.
+func SetRemoteLocations(ctx context.Context, c *internal.Client, sessionID string, params *SetRemoteLocationsParams) error {
+	if params == nil {
+		params = &SetRemoteLocationsParams{}
+	}
+	return c.Call(ctx, "Target.setRemoteLocations", params, sessionID, nil)
+}
+
+// EventAttachedToTarget is the method name of the Target.attachedToTarget event.
+const EventAttachedToTarget = "Target.attachedToTarget"
+
+// AttachedToTargetEvent is the payload of the Target.attachedToTarget event.
+//
+// Issued when attached to target because of auto-attach or `attachToTarget` command.
+//
+// Experimental.
+type AttachedToTargetEvent struct {
+	// Identifier assigned to the session used to send/receive messages.
+	SessionID          SessionID  `json:"sessionId"`
+	TargetInfo         TargetInfo `json:"targetInfo"`
+	WaitingForDebugger bool       `json:"waitingForDebugger"`
+}
+
+// EventDetachedFromTarget is the method name of the Target.detachedFromTarget event.
+const EventDetachedFromTarget = "Target.detachedFromTarget"
+
+// DetachedFromTargetEvent is the payload of the Target.detachedFromTarget event.
+//
+// Issued when detached from target for any reason (including `detachFromTarget` command). Can be
+// issued multiple times per target if multiple sessions have been attached to it.
+//
+// Experimental.
+type DetachedFromTargetEvent struct {
+	// Detached session identifier.
+	SessionID SessionID `json:"sessionId"`
+	// Deprecated.
+	//
+	// Deprecated: this item is deprecated in the protocol.
+	TargetID TargetID `json:"targetId,omitempty"`
+}
+
+// EventReceivedMessageFromTarget is the method name of the Target.receivedMessageFromTarget event.
+const EventReceivedMessageFromTarget = "Target.receivedMessageFromTarget"
+
+// ReceivedMessageFromTargetEvent is the payload of the Target.receivedMessageFromTarget event.
+//
+// Notifies about a new protocol message received from the session (as reported in
+// `attachedToTarget` event).
+type ReceivedMessageFromTargetEvent struct {
+	// Identifier of a session which sends a message.
+	SessionID SessionID `json:"sessionId"`
+	Message   string    `json:"message"`
+	// Deprecated.
+	//
+	// Deprecated: this item is deprecated in the protocol.
+	TargetID TargetID `json:"targetId,omitempty"`
+}
+
+// EventTargetCreated is the method name of the Target.targetCreated event.
+const EventTargetCreated = "Target.targetCreated"
+
+// TargetCreatedEvent is the payload of the Target.targetCreated event.
+//
+// Issued when a possible inspection target is created.
+type TargetCreatedEvent struct {
+	TargetInfo TargetInfo `json:"targetInfo"`
+}
+
+// EventTargetDestroyed is the method name of the Target.targetDestroyed event.
+const EventTargetDestroyed = "Target.targetDestroyed"
+
+// TargetDestroyedEvent is the payload of the Target.targetDestroyed event.
+//
+// Issued when a target is destroyed.
+type TargetDestroyedEvent struct {
+	TargetID TargetID `json:"targetId"`
+}
+
+// EventTargetCrashed is the method name of the Target.targetCrashed event.
+const EventTargetCrashed = "Target.targetCrashed"
+
+// TargetCrashedEvent is the payload of the Target.targetCrashed event.
+//
+// Issued when a target has crashed.
+type TargetCrashedEvent struct {
+	TargetID TargetID `json:"targetId"`
+	// Termination status type.
+	Status string `json:"status"`
+	// Termination error code.
+	ErrorCode int64 `json:"errorCode"`
+}
+
+// EventTargetInfoChanged is the method name of the Target.targetInfoChanged event.
+const EventTargetInfoChanged = "Target.targetInfoChanged"
+
+// TargetInfoChangedEvent is the payload of the Target.targetInfoChanged event.
+//
+// Issued when some information about a target has changed. This only happens between
+// `targetCreated` and `targetDestroyed`.
+type TargetInfoChangedEvent struct {
+	TargetInfo TargetInfo `json:"targetInfo"`
+}
+
+// ParseEvent decodes a Target event message into its typed event struct.
+func ParseEvent(msg *internal.CDPMessage) (any, error) {
+	var v any
+	switch msg.Method {
+	case EventAttachedToTarget:
+		v = &AttachedToTargetEvent{}
+	case EventDetachedFromTarget:
+		v = &DetachedFromTargetEvent{}
+	case EventReceivedMessageFromTarget:
+		v = &ReceivedMessageFromTargetEvent{}
+	case EventTargetCreated:
+		v = &TargetCreatedEvent{}
+	case EventTargetDestroyed:
+		v = &TargetDestroyedEvent{}
+	case EventTargetCrashed:
+		v = &TargetCrashedEvent{}
+	case EventTargetInfoChanged:
+		v = &TargetInfoChangedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Target event: %s", msg.Method)
+	}
+	if msg.Params == nil {
+		return v, nil
+	}
+	err := json.Unmarshal(msg.Params, v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,77 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package tethering provides typed bindings for the CDP Tethering domain.
+//
+// The Tethering domain defines methods and events for browser port binding.
+package tethering
+
+import (
+	"cdp/internal"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// BindParams holds the parameters of Tethering.bind.
+type BindParams struct {
+	// Port number to bind.
+	Port int64 `json:"port"`
+}
+
+// Bind calls Tethering.bind.
+//
+// Request browser port binding.
+func Bind(ctx context.Context, c *internal.Client, sessionID string, params *BindParams) error {
+	if params == nil {
+		params = &BindParams{}
+	}
+	return c.Call(ctx, "Tethering.bind", params, sessionID, nil)
+}
+
+// UnbindParams holds the parameters of Tethering.unbind.
+type UnbindParams struct {
+	// Port number to unbind.
+	Port int64 `json:"port"`
+}
+
+// Unbind calls Tethering.unbind.
+//
+// Request browser port unbinding.
+func Unbind(ctx context.Context, c *internal.Client, sessionID string, params *UnbindParams) error {
+	if params == nil {
+		params = &UnbindParams{}
+	}
+	return c.Call(ctx, "Tethering.unbind", params, sessionID, nil)
+}
+
+// EventAccepted is the method name of the Tethering.accepted event.
+const EventAccepted = "Tethering.accepted"
+
+// AcceptedEvent is the payload of the Tethering.accepted event.
+//
+// Informs that port was successfully bound and got a specified connection id.
+type AcceptedEvent struct {
+	// Port number that was successfully bound.
+	Port int64 `json:"port"`
+	// Connection id to be used.
+	ConnectionID string `json:"connectionId"`
+}
+
+// ParseEvent decodes a Tethering event message into its typed event struct.
+func ParseEvent(msg *internal.CDPMessage) (any, error) {
+	var v any
+	switch msg.Method {
+	case EventAccepted:
+		v = &AcceptedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Tethering event: %s", msg.Method)
+	}
+	if msg.Params == nil {
+		return v, nil
+	}
+	err := json.Unmarshal(msg.Params, v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
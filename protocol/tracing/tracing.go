@@ -0,0 +1,315 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package tracing provides typed bindings for the CDP Tracing domain.
+package tracing
+
+import (
+	"cdp/internal"
+	"cdp/protocol/io"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// MemoryDumpConfig is the Tracing.MemoryDumpConfig type.
+//
+// Configuration for memory dump. Used only when "memory-infra" category is enabled.
+//
+// Experimental.
+type MemoryDumpConfig map[string]any
+
+// TraceConfig is the Tracing.TraceConfig type.
+type TraceConfig struct {
+	// Controls how the trace buffer stores data.
+	//
+	// Experimental.
+	RecordMode string `json:"recordMode,omitempty"`
+	// Size of the trace buffer in kilobytes. If not specified or zero is passed, a default value
+	// of 200 MB would be used.
+	//
+	// Experimental.
+	TraceBufferSizeInKb *float64 `json:"traceBufferSizeInKb,omitempty"`
+	// Turns on JavaScript stack sampling.
+	//
+	// Experimental.
+	EnableSampling *bool `json:"enableSampling,omitempty"`
+	// Turns on system tracing.
+	//
+	// Experimental.
+	EnableSystrace *bool `json:"enableSystrace,omitempty"`
+	// Turns on argument filter.
+	//
+	// Experimental.
+	EnableArgumentFilter *bool `json:"enableArgumentFilter,omitempty"`
+	// Included category filters.
+	IncludedCategories []string `json:"includedCategories,omitempty"`
+	// Excluded category filters.
+	ExcludedCategories []string `json:"excludedCategories,omitempty"`
+	// Configuration to synthesize the delays in tracing.
+	//
+	// Experimental.
+	SyntheticDelays []string `json:"syntheticDelays,omitempty"`
+	// Configuration for memory dump triggers. Used only when "memory-infra" category is enabled.
+	//
+	// Experimental.
+	MemoryDumpConfig MemoryDumpConfig `json:"memoryDumpConfig,omitempty"`
+}
+
+// StreamFormat is the Tracing.StreamFormat type.
+//
+// Data format of a trace. Can be either the legacy JSON format or the
+// protocol buffer format. Note that the JSON format will be deprecated soon.
+//
+// Experimental.
+type StreamFormat string
+
+const (
+	StreamFormatJSON  StreamFormat = "json"
+	StreamFormatProto StreamFormat = "proto"
+)
+
+// StreamCompression is the Tracing.StreamCompression type.
+//
+// Compression type to use for traces returned via streams.
+//
+// Experimental.
+type StreamCompression string
+
+const (
+	StreamCompressionNone StreamCompression = "none"
+	StreamCompressionGzip StreamCompression = "gzip"
+)
+
+// MemoryDumpLevelOfDetail is the Tracing.MemoryDumpLevelOfDetail type.
+//
+// Details exposed when memory request explicitly declared.
+// Keep consistent with memory_dump_request_args.h and
+// memory_instrumentation.mojom
+//
+// Experimental.
+type MemoryDumpLevelOfDetail string
+
+const (
+	MemoryDumpLevelOfDetailBackground MemoryDumpLevelOfDetail = "background"
+	MemoryDumpLevelOfDetailLight      MemoryDumpLevelOfDetail = "light"
+	MemoryDumpLevelOfDetailDetailed   MemoryDumpLevelOfDetail = "detailed"
+)
+
+// TracingBackend is the Tracing.TracingBackend type.
+//
+// Backend type to use for tracing. `chrome` uses the Chrome-integrated
+// tracing service and is supported on all platforms. `system` is only
+// supported on Chrome OS and uses the Perfetto system tracing service.
+// `auto` chooses `system` when the perfettoConfig provided to Tracing.start
+// specifies at least one non-Chrome data source; otherwise uses `chrome`.
+//
+// Experimental.
+type TracingBackend string
+
+const (
+	TracingBackendAuto   TracingBackend = "auto"
+	TracingBackendChrome TracingBackend = "chrome"
+	TracingBackendSystem TracingBackend = "system"
+)
+
+// End calls Tracing.end.
+//
+// Stop trace events collection.
+func End(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "Tracing.end", nil, sessionID, nil)
+}
+
+// GetCategoriesResult holds the result of Tracing.getCategories.
+type GetCategoriesResult struct {
+	// A list of supported tracing categories.
+	Categories []string `json:"categories"`
+}
+
+// GetCategories calls Tracing.getCategories.
+//
+// Gets supported tracing categories.
+//
+// Experimental.
+func GetCategories(ctx context.Context, c *internal.Client, sessionID string) (*GetCategoriesResult, error) {
+	var result GetCategoriesResult
+	err := c.Call(ctx, "Tracing.getCategories", nil, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// RecordClockSyncMarkerParams holds the parameters of Tracing.recordClockSyncMarker.
+type RecordClockSyncMarkerParams struct {
+	// The ID of this clock sync marker
+	SyncID string `json:"syncId"`
+}
+
+// RecordClockSyncMarker calls Tracing.recordClockSyncMarker.
+//
+// Record a clock sync marker in the trace.
+//
+// Experimental.
+func RecordClockSyncMarker(ctx context.Context, c *internal.Client, sessionID string, params *RecordClockSyncMarkerParams) error {
+	if params == nil {
+		params = &RecordClockSyncMarkerParams{}
+	}
+	return c.Call(ctx, "Tracing.recordClockSyncMarker", params, sessionID, nil)
+}
+
+// RequestMemoryDumpParams holds the parameters of Tracing.requestMemoryDump.
+type RequestMemoryDumpParams struct {
+	// Enables more deterministic results by forcing garbage collection
+	Deterministic *bool `json:"deterministic,omitempty"`
+	// Specifies level of details in memory dump. Defaults to "detailed".
+	LevelOfDetail MemoryDumpLevelOfDetail `json:"levelOfDetail,omitempty"`
+}
+
+// RequestMemoryDumpResult holds the result of Tracing.requestMemoryDump.
+type RequestMemoryDumpResult struct {
+	// GUID of the resulting global memory dump.
+	DumpGuid string `json:"dumpGuid"`
+	// True iff the global memory dump succeeded.
+	Success bool `json:"success"`
+}
+
+// RequestMemoryDump calls Tracing.requestMemoryDump.
+//
+// Request a global memory dump.
+//
+// Experimental.
+func RequestMemoryDump(ctx context.Context, c *internal.Client, sessionID string, params *RequestMemoryDumpParams) (*RequestMemoryDumpResult, error) {
+	if params == nil {
+		params = &RequestMemoryDumpParams{}
+	}
+	var result RequestMemoryDumpResult
+	err := c.Call(ctx, "Tracing.requestMemoryDump", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// StartParams holds the parameters of Tracing.start.
+type StartParams struct {
+	// Category/tag filter
+	//
+	// Experimental.
+	//
+	// Deprecated: this item is deprecated in the protocol.
+	Categories string `json:"categories,omitempty"`
+	// Tracing options
+	//
+	// Experimental.
+	//
+	// Deprecated: this item is deprecated in the protocol.
+	Options string `json:"options,omitempty"`
+	// If set, the agent will issue bufferUsage events at this interval, specified in milliseconds
+	//
+	// Experimental.
+	BufferUsageReportingInterval *float64 `json:"bufferUsageReportingInterval,omitempty"`
+	// Whether to report trace events as series of dataCollected events or to save trace to a
+	// stream (defaults to `ReportEvents`).
+	TransferMode string `json:"transferMode,omitempty"`
+	// Trace data format to use. This only applies when using `ReturnAsStream`
+	// transfer mode (defaults to `json`).
+	StreamFormat StreamFormat `json:"streamFormat,omitempty"`
+	// Compression format to use. This only applies when using `ReturnAsStream`
+	// transfer mode (defaults to `none`)
+	//
+	// Experimental.
+	StreamCompression StreamCompression `json:"streamCompression,omitempty"`
+	TraceConfig       *TraceConfig      `json:"traceConfig,omitempty"`
+	// Base64-encoded serialized perfetto.protos.TraceConfig protobuf message
+	// When specified, the parameters `categories`, `options`, `traceConfig`
+	// are ignored. (Encoded as a base64 string when passed over JSON)
+	//
+	// Experimental.
+	PerfettoConfig string `json:"perfettoConfig,omitempty"`
+	// Backend type (defaults to `auto`)
+	//
+	// Experimental.
+	TracingBackend TracingBackend `json:"tracingBackend,omitempty"`
+}
+
+// Start calls Tracing.start.
+//
+// Start trace events collection.
+func Start(ctx context.Context, c *internal.Client, sessionID string, params *StartParams) error {
+	if params == nil {
+		params = &StartParams{}
+	}
+	return c.Call(ctx, "Tracing.start", params, sessionID, nil)
+}
+
+// EventBufferUsage is the method name of the Tracing.bufferUsage event.
+const EventBufferUsage = "Tracing.bufferUsage"
+
+// BufferUsageEvent is the payload of the Tracing.bufferUsage event.
+//
+// Experimental.
+type BufferUsageEvent struct {
+	// A number in range [0..1] that indicates the used size of event buffer as a fraction of its
+	// total size.
+	PercentFull *float64 `json:"percentFull,omitempty"`
+	// An approximate number of events in the trace log.
+	EventCount *float64 `json:"eventCount,omitempty"`
+	// A number in range [0..1] that indicates the used size of event buffer as a fraction of its
+	// total size.
+	Value *float64 `json:"value,omitempty"`
+}
+
+// EventDataCollected is the method name of the Tracing.dataCollected event.
+const EventDataCollected = "Tracing.dataCollected"
+
+// DataCollectedEvent is the payload of the Tracing.dataCollected event.
+//
+// Contains a bucket of collected trace events. When tracing is stopped collected events will be
+// sent as a sequence of dataCollected events followed by tracingComplete event.
+//
+// Experimental.
+type DataCollectedEvent struct {
+	Value []map[string]any `json:"value"`
+}
+
+// EventTracingComplete is the method name of the Tracing.tracingComplete event.
+const EventTracingComplete = "Tracing.tracingComplete"
+
+// TracingCompleteEvent is the payload of the Tracing.tracingComplete event.
+//
+// Signals that tracing is stopped and there is no trace buffers pending flush, all data were
+// delivered via dataCollected events.
+type TracingCompleteEvent struct {
+	// Indicates whether some trace data is known to have been lost, e.g. because the trace ring
+	// buffer wrapped around.
+	DataLossOccurred bool `json:"dataLossOccurred"`
+	// A handle of the stream that holds resulting trace data.
+	Stream io.StreamHandle `json:"stream,omitempty"`
+	// Trace data format of returned stream.
+	TraceFormat StreamFormat `json:"traceFormat,omitempty"`
+	// Compression format of returned stream.
+	StreamCompression StreamCompression `json:"streamCompression,omitempty"`
+}
+
+// ParseEvent decodes a Tracing event message into its typed event struct.
+func ParseEvent(msg *internal.CDPMessage) (any, error) {
+	var v any
+	switch msg.Method {
+	case EventBufferUsage:
+		v = &BufferUsageEvent{}
+	case EventDataCollected:
+		v = &DataCollectedEvent{}
+	case EventTracingComplete:
+		v = &TracingCompleteEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Tracing event: %s", msg.Method)
+	}
+	if msg.Params == nil {
+		return v, nil
+	}
+	err := json.Unmarshal(msg.Params, v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,379 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package webaudio provides typed bindings for the CDP WebAudio domain.
+//
+// This domain allows inspection of Web Audio API.
+// https://webaudio.github.io/web-audio-api/
+package webaudio
+
+import (
+	"cdp/internal"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// GraphObjectID is the WebAudio.GraphObjectId type.
+//
+// An unique ID for a graph object (AudioContext, AudioNode, AudioParam) in Web Audio API
+type GraphObjectID string
+
+// ContextType is the WebAudio.ContextType type.
+//
+// Enum of BaseAudioContext types
+type ContextType string
+
+const (
+	ContextTypeRealtime ContextType = "realtime"
+	ContextTypeOffline  ContextType = "offline"
+)
+
+// ContextState is the WebAudio.ContextState type.
+//
+// Enum of AudioContextState from the spec
+type ContextState string
+
+const (
+	ContextStateSuspended ContextState = "suspended"
+	ContextStateRunning   ContextState = "running"
+	ContextStateClosed    ContextState = "closed"
+)
+
+// NodeType is the WebAudio.NodeType type.
+//
+// Enum of AudioNode types
+type NodeType string
+
+// ChannelCountMode is the WebAudio.ChannelCountMode type.
+//
+// Enum of AudioNode::ChannelCountMode from the spec
+type ChannelCountMode string
+
+const (
+	ChannelCountModeClampedMax ChannelCountMode = "clamped-max"
+	ChannelCountModeExplicit   ChannelCountMode = "explicit"
+	ChannelCountModeMax        ChannelCountMode = "max"
+)
+
+// ChannelInterpretation is the WebAudio.ChannelInterpretation type.
+//
+// Enum of AudioNode::ChannelInterpretation from the spec
+type ChannelInterpretation string
+
+const (
+	ChannelInterpretationDiscrete ChannelInterpretation = "discrete"
+	ChannelInterpretationSpeakers ChannelInterpretation = "speakers"
+)
+
+// ParamType is the WebAudio.ParamType type.
+//
+// Enum of AudioParam types
+type ParamType string
+
+// AutomationRate is the WebAudio.AutomationRate type.
+//
+// Enum of AudioParam::AutomationRate from the spec
+type AutomationRate string
+
+const (
+	AutomationRateARate AutomationRate = "a-rate"
+	AutomationRateKRate AutomationRate = "k-rate"
+)
+
+// ContextRealtimeData is the WebAudio.ContextRealtimeData type.
+//
+// Fields in AudioContext that change in real-time.
+type ContextRealtimeData struct {
+	// The current context time in second in BaseAudioContext.
+	CurrentTime float64 `json:"currentTime"`
+	// The time spent on rendering graph divided by render quantum duration,
+	// and multiplied by 100. 100 means the audio renderer reached the full
+	// capacity and glitch may occur.
+	RenderCapacity float64 `json:"renderCapacity"`
+	// A running mean of callback interval.
+	CallbackIntervalMean float64 `json:"callbackIntervalMean"`
+	// A running variance of callback interval.
+	CallbackIntervalVariance float64 `json:"callbackIntervalVariance"`
+}
+
+// BaseAudioContext is the WebAudio.BaseAudioContext type.
+//
+// Protocol object for BaseAudioContext
+type BaseAudioContext struct {
+	ContextID    GraphObjectID        `json:"contextId"`
+	ContextType  ContextType          `json:"contextType"`
+	ContextState ContextState         `json:"contextState"`
+	RealtimeData *ContextRealtimeData `json:"realtimeData,omitempty"`
+	// Platform-dependent callback buffer size.
+	CallbackBufferSize float64 `json:"callbackBufferSize"`
+	// Number of output channels supported by audio hardware in use.
+	MaxOutputChannelCount float64 `json:"maxOutputChannelCount"`
+	// Context sample rate.
+	SampleRate float64 `json:"sampleRate"`
+}
+
+// AudioListener is the WebAudio.AudioListener type.
+//
+// Protocol object for AudioListener
+type AudioListener struct {
+	ListenerID GraphObjectID `json:"listenerId"`
+	ContextID  GraphObjectID `json:"contextId"`
+}
+
+// AudioNode is the WebAudio.AudioNode type.
+//
+// Protocol object for AudioNode
+type AudioNode struct {
+	NodeID                GraphObjectID         `json:"nodeId"`
+	ContextID             GraphObjectID         `json:"contextId"`
+	NodeType              NodeType              `json:"nodeType"`
+	NumberOfInputs        float64               `json:"numberOfInputs"`
+	NumberOfOutputs       float64               `json:"numberOfOutputs"`
+	ChannelCount          float64               `json:"channelCount"`
+	ChannelCountMode      ChannelCountMode      `json:"channelCountMode"`
+	ChannelInterpretation ChannelInterpretation `json:"channelInterpretation"`
+}
+
+// AudioParam is the WebAudio.AudioParam type.
+//
+// Protocol object for AudioParam
+type AudioParam struct {
+	ParamID      GraphObjectID  `json:"paramId"`
+	NodeID       GraphObjectID  `json:"nodeId"`
+	ContextID    GraphObjectID  `json:"contextId"`
+	ParamType    ParamType      `json:"paramType"`
+	Rate         AutomationRate `json:"rate"`
+	DefaultValue float64        `json:"defaultValue"`
+	MinValue     float64        `json:"minValue"`
+	MaxValue     float64        `json:"maxValue"`
+}
+
+// Enable calls WebAudio.enable.
+//
+// Enables the WebAudio domain and starts sending context lifetime events.
+func Enable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "WebAudio.enable", nil, sessionID, nil)
+}
+
+// Disable calls WebAudio.disable.
+//
+// Disables the WebAudio domain.
+func Disable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "WebAudio.disable", nil, sessionID, nil)
+}
+
+// GetRealtimeDataParams holds the parameters of WebAudio.getRealtimeData.
+type GetRealtimeDataParams struct {
+	ContextID GraphObjectID `json:"contextId"`
+}
+
+// GetRealtimeDataResult holds the result of WebAudio.getRealtimeData.
+type GetRealtimeDataResult struct {
+	RealtimeData ContextRealtimeData `json:"realtimeData"`
+}
+
+// GetRealtimeData calls WebAudio.getRealtimeData.
+//
+// Fetch the realtime data from the registered contexts.
+func GetRealtimeData(ctx context.Context, c *internal.Client, sessionID string, params *GetRealtimeDataParams) (*GetRealtimeDataResult, error) {
+	if params == nil {
+		params = &GetRealtimeDataParams{}
+	}
+	var result GetRealtimeDataResult
+	err := c.Call(ctx, "WebAudio.getRealtimeData", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// EventContextCreated is the method name of the WebAudio.contextCreated event.
+const EventContextCreated = "WebAudio.contextCreated"
+
+// ContextCreatedEvent is the payload of the WebAudio.contextCreated event.
+//
+// Notifies that a new BaseAudioContext has been created.
+type ContextCreatedEvent struct {
+	Context BaseAudioContext `json:"context"`
+}
+
+// EventContextWillBeDestroyed is the method name of the WebAudio.contextWillBeDestroyed event.
+const EventContextWillBeDestroyed = "WebAudio.contextWillBeDestroyed"
+
+// ContextWillBeDestroyedEvent is the payload of the WebAudio.contextWillBeDestroyed event.
+//
+// Notifies that an existing BaseAudioContext will be destroyed.
+type ContextWillBeDestroyedEvent struct {
+	ContextID GraphObjectID `json:"contextId"`
+}
+
+// EventContextChanged is the method name of the WebAudio.contextChanged event.
+const EventContextChanged = "WebAudio.contextChanged"
+
+// ContextChangedEvent is the payload of the WebAudio.contextChanged event.
+//
+// Notifies that existing BaseAudioContext has changed some properties (id stays the same)..
+type ContextChangedEvent struct {
+	Context BaseAudioContext `json:"context"`
+}
+
+// EventAudioListenerCreated is the method name of the WebAudio.audioListenerCreated event.
+const EventAudioListenerCreated = "WebAudio.audioListenerCreated"
+
+// AudioListenerCreatedEvent is the payload of the WebAudio.audioListenerCreated event.
+//
+// Notifies that the construction of an AudioListener has finished.
+type AudioListenerCreatedEvent struct {
+	Listener AudioListener `json:"listener"`
+}
+
+// EventAudioListenerWillBeDestroyed is the method name of the WebAudio.audioListenerWillBeDestroyed event.
+const EventAudioListenerWillBeDestroyed = "WebAudio.audioListenerWillBeDestroyed"
+
+// AudioListenerWillBeDestroyedEvent is the payload of the WebAudio.audioListenerWillBeDestroyed event.
+//
+// Notifies that a new AudioListener has been created.
+type AudioListenerWillBeDestroyedEvent struct {
+	ContextID  GraphObjectID `json:"contextId"`
+	ListenerID GraphObjectID `json:"listenerId"`
+}
+
+// EventAudioNodeCreated is the method name of the WebAudio.audioNodeCreated event.
+const EventAudioNodeCreated = "WebAudio.audioNodeCreated"
+
+// AudioNodeCreatedEvent is the payload of the WebAudio.audioNodeCreated event.
+//
+// Notifies that a new AudioNode has been created.
+type AudioNodeCreatedEvent struct {
+	Node AudioNode `json:"node"`
+}
+
+// EventAudioNodeWillBeDestroyed is the method name of the WebAudio.audioNodeWillBeDestroyed event.
+const EventAudioNodeWillBeDestroyed = "WebAudio.audioNodeWillBeDestroyed"
+
+// AudioNodeWillBeDestroyedEvent is the payload of the WebAudio.audioNodeWillBeDestroyed event.
+//
+// Notifies that an existing AudioNode has been destroyed.
+type AudioNodeWillBeDestroyedEvent struct {
+	ContextID GraphObjectID `json:"contextId"`
+	NodeID    GraphObjectID `json:"nodeId"`
+}
+
+// EventAudioParamCreated is the method name of the WebAudio.audioParamCreated event.
+const EventAudioParamCreated = "WebAudio.audioParamCreated"
+
+// AudioParamCreatedEvent is the payload of the WebAudio.audioParamCreated event.
+//
+// Notifies that a new AudioParam has been created.
+type AudioParamCreatedEvent struct {
+	Param AudioParam `json:"param"`
+}
+
+// EventAudioParamWillBeDestroyed is the method name of the WebAudio.audioParamWillBeDestroyed event.
+const EventAudioParamWillBeDestroyed = "WebAudio.audioParamWillBeDestroyed"
+
+// AudioParamWillBeDestroyedEvent is the payload of the WebAudio.audioParamWillBeDestroyed event.
+//
+// Notifies that an existing AudioParam has been destroyed.
+type AudioParamWillBeDestroyedEvent struct {
+	ContextID GraphObjectID `json:"contextId"`
+	NodeID    GraphObjectID `json:"nodeId"`
+	ParamID   GraphObjectID `json:"paramId"`
+}
+
+// EventNodesConnected is the method name of the WebAudio.nodesConnected event.
+const EventNodesConnected = "WebAudio.nodesConnected"
+
+// NodesConnectedEvent is the payload of the WebAudio.nodesConnected event.
+//
+// Notifies that two AudioNodes are connected.
+type NodesConnectedEvent struct {
+	ContextID             GraphObjectID `json:"contextId"`
+	SourceID              GraphObjectID `json:"sourceId"`
+	DestinationID         GraphObjectID `json:"destinationId"`
+	SourceOutputIndex     *float64      `json:"sourceOutputIndex,omitempty"`
+	DestinationInputIndex *float64      `json:"destinationInputIndex,omitempty"`
+}
+
+// EventNodesDisconnected is the method name of the WebAudio.nodesDisconnected event.
+const EventNodesDisconnected = "WebAudio.nodesDisconnected"
+
+// NodesDisconnectedEvent is the payload of the WebAudio.nodesDisconnected event.
+//
+// Notifies that AudioNodes are disconnected. The destination can be null, and it means all the outgoing connections from the source are disconnected.
+type NodesDisconnectedEvent struct {
+	ContextID             GraphObjectID `json:"contextId"`
+	SourceID              GraphObjectID `json:"sourceId"`
+	DestinationID         GraphObjectID `json:"destinationId"`
+	SourceOutputIndex     *float64      `json:"sourceOutputIndex,omitempty"`
+	DestinationInputIndex *float64      `json:"destinationInputIndex,omitempty"`
+}
+
+// EventNodeParamConnected is the method name of the WebAudio.nodeParamConnected event.
+const EventNodeParamConnected = "WebAudio.nodeParamConnected"
+
+// NodeParamConnectedEvent is the payload of the WebAudio.nodeParamConnected event.
+//
+// Notifies that an AudioNode is connected to an AudioParam.
+type NodeParamConnectedEvent struct {
+	ContextID         GraphObjectID `json:"contextId"`
+	SourceID          GraphObjectID `json:"sourceId"`
+	DestinationID     GraphObjectID `json:"destinationId"`
+	SourceOutputIndex *float64      `json:"sourceOutputIndex,omitempty"`
+}
+
+// EventNodeParamDisconnected is the method name of the WebAudio.nodeParamDisconnected event.
+const EventNodeParamDisconnected = "WebAudio.nodeParamDisconnected"
+
+// NodeParamDisconnectedEvent is the payload of the WebAudio.nodeParamDisconnected event.
+//
+// Notifies that an AudioNode is disconnected to an AudioParam.
+type NodeParamDisconnectedEvent struct {
+	ContextID         GraphObjectID `json:"contextId"`
+	SourceID          GraphObjectID `json:"sourceId"`
+	DestinationID     GraphObjectID `json:"destinationId"`
+	SourceOutputIndex *float64      `json:"sourceOutputIndex,omitempty"`
+}
+
+// ParseEvent decodes a WebAudio event message into its typed event struct.
+func ParseEvent(msg *internal.CDPMessage) (any, error) {
+	var v any
+	switch msg.Method {
+	case EventContextCreated:
+		v = &ContextCreatedEvent{}
+	case EventContextWillBeDestroyed:
+		v = &ContextWillBeDestroyedEvent{}
+	case EventContextChanged:
+		v = &ContextChangedEvent{}
+	case EventAudioListenerCreated:
+		v = &AudioListenerCreatedEvent{}
+	case EventAudioListenerWillBeDestroyed:
+		v = &AudioListenerWillBeDestroyedEvent{}
+	case EventAudioNodeCreated:
+		v = &AudioNodeCreatedEvent{}
+	case EventAudioNodeWillBeDestroyed:
+		v = &AudioNodeWillBeDestroyedEvent{}
+	case EventAudioParamCreated:
+		v = &AudioParamCreatedEvent{}
+	case EventAudioParamWillBeDestroyed:
+		v = &AudioParamWillBeDestroyedEvent{}
+	case EventNodesConnected:
+		v = &NodesConnectedEvent{}
+	case EventNodesDisconnected:
+		v = &NodesDisconnectedEvent{}
+	case EventNodeParamConnected:
+		v = &NodeParamConnectedEvent{}
+	case EventNodeParamDisconnected:
+		v = &NodeParamDisconnectedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown WebAudio event: %s", msg.Method)
+	}
+	if msg.Params == nil {
+		return v, nil
+	}
+	err := json.Unmarshal(msg.Params, v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,402 @@
+// Code generated by cdp/internal/protocol/gen. DO NOT EDIT.
+
+// Package webauthn provides typed bindings for the CDP WebAuthn domain.
+//
+// This domain allows configuring virtual authenticators to test the WebAuthn
+// API.
+package webauthn
+
+import (
+	"cdp/internal"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// AuthenticatorID is the WebAuthn.AuthenticatorId type.
+type AuthenticatorID string
+
+// AuthenticatorProtocol is the WebAuthn.AuthenticatorProtocol type.
+type AuthenticatorProtocol string
+
+const (
+	AuthenticatorProtocolU2f   AuthenticatorProtocol = "u2f"
+	AuthenticatorProtocolCtap2 AuthenticatorProtocol = "ctap2"
+)
+
+// Ctap2Version is the WebAuthn.Ctap2Version type.
+type Ctap2Version string
+
+const (
+	Ctap2VersionCtap20 Ctap2Version = "ctap2_0"
+	Ctap2VersionCtap21 Ctap2Version = "ctap2_1"
+)
+
+// AuthenticatorTransport is the WebAuthn.AuthenticatorTransport type.
+type AuthenticatorTransport string
+
+const (
+	AuthenticatorTransportUsb      AuthenticatorTransport = "usb"
+	AuthenticatorTransportNfc      AuthenticatorTransport = "nfc"
+	AuthenticatorTransportBle      AuthenticatorTransport = "ble"
+	AuthenticatorTransportCable    AuthenticatorTransport = "cable"
+	AuthenticatorTransportInternal AuthenticatorTransport = "internal"
+)
+
+// VirtualAuthenticatorOptions is the WebAuthn.VirtualAuthenticatorOptions type.
+type VirtualAuthenticatorOptions struct {
+	Protocol AuthenticatorProtocol `json:"protocol"`
+	// Defaults to ctap2_0. Ignored if |protocol| == u2f.
+	Ctap2Version Ctap2Version           `json:"ctap2Version,omitempty"`
+	Transport    AuthenticatorTransport `json:"transport"`
+	// Defaults to false.
+	HasResidentKey *bool `json:"hasResidentKey,omitempty"`
+	// Defaults to false.
+	HasUserVerification *bool `json:"hasUserVerification,omitempty"`
+	// If set to true, the authenticator will support the largeBlob extension.
+	// https://w3c.github.io/webauthn#largeBlob
+	// Defaults to false.
+	HasLargeBlob *bool `json:"hasLargeBlob,omitempty"`
+	// If set to true, the authenticator will support the credBlob extension.
+	// https://fidoalliance.org/specs/fido-v2.1-rd-20201208/fido-client-to-authenticator-protocol-v2.1-rd-20201208.html#sctn-credBlob-extension
+	// Defaults to false.
+	HasCredBlob *bool `json:"hasCredBlob,omitempty"`
+	// If set to true, the authenticator will support the minPinLength extension.
+	// https://fidoalliance.org/specs/fido-v2.1-ps-20210615/fido-client-to-authenticator-protocol-v2.1-ps-20210615.html#sctn-minpinlength-extension
+	// Defaults to false.
+	HasMinPinLength *bool `json:"hasMinPinLength,omitempty"`
+	// If set to true, the authenticator will support the prf extension.
+	// https://w3c.github.io/webauthn/#prf-extension
+	// Defaults to false.
+	HasPrf *bool `json:"hasPrf,omitempty"`
+	// If set to true, tests of user presence will succeed immediately.
+	// Otherwise, they will not be resolved. Defaults to true.
+	AutomaticPresenceSimulation *bool `json:"automaticPresenceSimulation,omitempty"`
+	// Sets whether User Verification succeeds or fails for an authenticator.
+	// Defaults to false.
+	IsUserVerified *bool `json:"isUserVerified,omitempty"`
+	// Credentials created by this authenticator will have the backup
+	// eligibility (BE) flag set to this value. Defaults to false.
+	// https://w3c.github.io/webauthn/#sctn-credential-backup
+	DefaultBackupEligibility *bool `json:"defaultBackupEligibility,omitempty"`
+	// Credentials created by this authenticator will have the backup state
+	// (BS) flag set to this value. Defaults to false.
+	// https://w3c.github.io/webauthn/#sctn-credential-backup
+	DefaultBackupState *bool `json:"defaultBackupState,omitempty"`
+}
+
+// Credential is the WebAuthn.Credential type.
+type Credential struct {
+	CredentialID         string `json:"credentialId"`
+	IsResidentCredential bool   `json:"isResidentCredential"`
+	// Relying Party ID the credential is scoped to. Must be set when adding a
+	// credential.
+	RpID string `json:"rpId,omitempty"`
+	// The ECDSA P-256 private key in PKCS#8 format. (Encoded as a base64 string when passed over JSON)
+	PrivateKey string `json:"privateKey"`
+	// An opaque byte sequence with a maximum size of 64 bytes mapping the
+	// credential to a specific user. (Encoded as a base64 string when passed over JSON)
+	UserHandle string `json:"userHandle,omitempty"`
+	// Signature counter. This is incremented by one for each successful
+	// assertion.
+	// See https://w3c.github.io/webauthn/#signature-counter
+	SignCount int64 `json:"signCount"`
+	// The large blob associated with the credential.
+	// See https://w3c.github.io/webauthn/#sctn-large-blob-extension (Encoded as a base64 string when passed over JSON)
+	LargeBlob string `json:"largeBlob,omitempty"`
+	// Assertions returned by this credential will have the backup eligibility
+	// (BE) flag set to this value. Defaults to the authenticator's
+	// defaultBackupEligibility value.
+	BackupEligibility *bool `json:"backupEligibility,omitempty"`
+	// Assertions returned by this credential will have the backup state (BS)
+	// flag set to this value. Defaults to the authenticator's
+	// defaultBackupState value.
+	BackupState *bool `json:"backupState,omitempty"`
+}
+
+// EnableParams holds the parameters of WebAuthn.enable.
+type EnableParams struct {
+	// Whether to enable the WebAuthn user interface. Enabling the UI is
+	// recommended for debugging and demo purposes, as it is closer to the real
+	// experience. Disabling the UI is recommended for automated testing.
+	// Supported at the embedder's discretion if UI is available.
+	// Defaults to false.
+	EnableUI *bool `json:"enableUI,omitempty"`
+}
+
+// Enable calls WebAuthn.enable.
+//
+// Enable the WebAuthn domain and start intercepting credential storage and
+// retrieval with a virtual authenticator.
+func Enable(ctx context.Context, c *internal.Client, sessionID string, params *EnableParams) error {
+	if params == nil {
+		params = &EnableParams{}
+	}
+	return c.Call(ctx, "WebAuthn.enable", params, sessionID, nil)
+}
+
+// Disable calls WebAuthn.disable.
+//
+// Disable the WebAuthn domain.
+func Disable(ctx context.Context, c *internal.Client, sessionID string) error {
+	return c.Call(ctx, "WebAuthn.disable", nil, sessionID, nil)
+}
+
+// AddVirtualAuthenticatorParams holds the parameters of WebAuthn.addVirtualAuthenticator.
+type AddVirtualAuthenticatorParams struct {
+	Options VirtualAuthenticatorOptions `json:"options"`
+}
+
+// AddVirtualAuthenticatorResult holds the result of WebAuthn.addVirtualAuthenticator.
+type AddVirtualAuthenticatorResult struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+}
+
+// AddVirtualAuthenticator calls WebAuthn.addVirtualAuthenticator.
+//
+// Creates and adds a virtual authenticator.
+func AddVirtualAuthenticator(ctx context.Context, c *internal.Client, sessionID string, params *AddVirtualAuthenticatorParams) (*AddVirtualAuthenticatorResult, error) {
+	if params == nil {
+		params = &AddVirtualAuthenticatorParams{}
+	}
+	var result AddVirtualAuthenticatorResult
+	err := c.Call(ctx, "WebAuthn.addVirtualAuthenticator", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SetResponseOverrideBitsParams holds the parameters of WebAuthn.setResponseOverrideBits.
+type SetResponseOverrideBitsParams struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+	// If isBogusSignature is set, overrides the signature in the authenticator response to be zero.
+	// Defaults to false.
+	IsBogusSignature *bool `json:"isBogusSignature,omitempty"`
+	// If isBadUV is set, overrides the UV bit in the flags in the authenticator response to
+	// be zero. Defaults to false.
+	IsBadUV *bool `json:"isBadUV,omitempty"`
+	// If isBadUP is set, overrides the UP bit in the flags in the authenticator response to
+	// be zero. Defaults to false.
+	IsBadUP *bool `json:"isBadUP,omitempty"`
+}
+
+// SetResponseOverrideBits calls WebAuthn.setResponseOverrideBits.
+//
+// Resets parameters isBogusSignature, isBadUV, isBadUP to false if they are not present.
+func SetResponseOverrideBits(ctx context.Context, c *internal.Client, sessionID string, params *SetResponseOverrideBitsParams) error {
+	if params == nil {
+		params = &SetResponseOverrideBitsParams{}
+	}
+	return c.Call(ctx, "WebAuthn.setResponseOverrideBits", params, sessionID, nil)
+}
+
+// RemoveVirtualAuthenticatorParams holds the parameters of WebAuthn.removeVirtualAuthenticator.
+type RemoveVirtualAuthenticatorParams struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+}
+
+// RemoveVirtualAuthenticator calls WebAuthn.removeVirtualAuthenticator.
+//
+// Removes the given authenticator.
+func RemoveVirtualAuthenticator(ctx context.Context, c *internal.Client, sessionID string, params *RemoveVirtualAuthenticatorParams) error {
+	if params == nil {
+		params = &RemoveVirtualAuthenticatorParams{}
+	}
+	return c.Call(ctx, "WebAuthn.removeVirtualAuthenticator", params, sessionID, nil)
+}
+
+// AddCredentialParams holds the parameters of WebAuthn.addCredential.
+type AddCredentialParams struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+	Credential      Credential      `json:"credential"`
+}
+
+// AddCredential calls WebAuthn.addCredential.
+//
+// Adds the credential to the specified authenticator.
+func AddCredential(ctx context.Context, c *internal.Client, sessionID string, params *AddCredentialParams) error {
+	if params == nil {
+		params = &AddCredentialParams{}
+	}
+	return c.Call(ctx, "WebAuthn.addCredential", params, sessionID, nil)
+}
+
+// GetCredentialParams holds the parameters of WebAuthn.getCredential.
+type GetCredentialParams struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+	CredentialID    string          `json:"credentialId"`
+}
+
+// GetCredentialResult holds the result of WebAuthn.getCredential.
+type GetCredentialResult struct {
+	Credential Credential `json:"credential"`
+}
+
+// GetCredential calls WebAuthn.getCredential.
+//
+// Returns a single credential stored in the given virtual authenticator that
+// matches the credential ID.
+func GetCredential(ctx context.Context, c *internal.Client, sessionID string, params *GetCredentialParams) (*GetCredentialResult, error) {
+	if params == nil {
+		params = &GetCredentialParams{}
+	}
+	var result GetCredentialResult
+	err := c.Call(ctx, "WebAuthn.getCredential", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetCredentialsParams holds the parameters of WebAuthn.getCredentials.
+type GetCredentialsParams struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+}
+
+// GetCredentialsResult holds the result of WebAuthn.getCredentials.
+type GetCredentialsResult struct {
+	Credentials []Credential `json:"credentials"`
+}
+
+// GetCredentials calls WebAuthn.getCredentials.
+//
+// Returns all the credentials stored in the given virtual authenticator.
+func GetCredentials(ctx context.Context, c *internal.Client, sessionID string, params *GetCredentialsParams) (*GetCredentialsResult, error) {
+	if params == nil {
+		params = &GetCredentialsParams{}
+	}
+	var result GetCredentialsResult
+	err := c.Call(ctx, "WebAuthn.getCredentials", params, sessionID, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// RemoveCredentialParams holds the parameters of WebAuthn.removeCredential.
+type RemoveCredentialParams struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+	CredentialID    string          `json:"credentialId"`
+}
+
+// RemoveCredential calls WebAuthn.removeCredential.
+//
+// Removes a credential from the authenticator.
+func RemoveCredential(ctx context.Context, c *internal.Client, sessionID string, params *RemoveCredentialParams) error {
+	if params == nil {
+		params = &RemoveCredentialParams{}
+	}
+	return c.Call(ctx, "WebAuthn.removeCredential", params, sessionID, nil)
+}
+
+// ClearCredentialsParams holds the parameters of WebAuthn.clearCredentials.
+type ClearCredentialsParams struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+}
+
+// ClearCredentials calls WebAuthn.clearCredentials.
+//
+// Clears all the credentials from the specified device.
+func ClearCredentials(ctx context.Context, c *internal.Client, sessionID string, params *ClearCredentialsParams) error {
+	if params == nil {
+		params = &ClearCredentialsParams{}
+	}
+	return c.Call(ctx, "WebAuthn.clearCredentials", params, sessionID, nil)
+}
+
+// SetUserVerifiedParams holds the parameters of WebAuthn.setUserVerified.
+type SetUserVerifiedParams struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+	IsUserVerified  bool            `json:"isUserVerified"`
+}
+
+// SetUserVerified calls WebAuthn.setUserVerified.
+//
+// Sets whether User Verification succeeds or fails for an authenticator.
+// The default is true.
+func SetUserVerified(ctx context.Context, c *internal.Client, sessionID string, params *SetUserVerifiedParams) error {
+	if params == nil {
+		params = &SetUserVerifiedParams{}
+	}
+	return c.Call(ctx, "WebAuthn.setUserVerified", params, sessionID, nil)
+}
+
+// SetAutomaticPresenceSimulationParams holds the parameters of WebAuthn.setAutomaticPresenceSimulation.
+type SetAutomaticPresenceSimulationParams struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+	Enabled         bool            `json:"enabled"`
+}
+
+// SetAutomaticPresenceSimulation calls WebAuthn.setAutomaticPresenceSimulation.
+//
+// Sets whether tests of user presence will succeed immediately (if true) or fail to resolve (if false) for an authenticator.
+// The default is true.
+func SetAutomaticPresenceSimulation(ctx context.Context, c *internal.Client, sessionID string, params *SetAutomaticPresenceSimulationParams) error {
+	if params == nil {
+		params = &SetAutomaticPresenceSimulationParams{}
+	}
+	return c.Call(ctx, "WebAuthn.setAutomaticPresenceSimulation", params, sessionID, nil)
+}
+
+// SetCredentialPropertiesParams holds the parameters of WebAuthn.setCredentialProperties.
+type SetCredentialPropertiesParams struct {
+	AuthenticatorID   AuthenticatorID `json:"authenticatorId"`
+	CredentialID      string          `json:"credentialId"`
+	BackupEligibility *bool           `json:"backupEligibility,omitempty"`
+	BackupState       *bool           `json:"backupState,omitempty"`
+}
+
+// SetCredentialProperties calls WebAuthn.setCredentialProperties.
+//
+// Allows setting credential properties.
+// https://w3c.github.io/webauthn/#sctn-automation-set-credential-properties
+func SetCredentialProperties(ctx context.Context, c *internal.Client, sessionID string, params *SetCredentialPropertiesParams) error {
+	if params == nil {
+		params = &SetCredentialPropertiesParams{}
+	}
+	return c.Call(ctx, "WebAuthn.setCredentialProperties", params, sessionID, nil)
+}
+
+// EventCredentialAdded is the method name of the WebAuthn.credentialAdded event.
+const EventCredentialAdded = "WebAuthn.credentialAdded"
+
+// CredentialAddedEvent is the payload of the WebAuthn.credentialAdded event.
+//
+// Triggered when a credential is added to an authenticator.
+type CredentialAddedEvent struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+	Credential      Credential      `json:"credential"`
+}
+
+// EventCredentialAsserted is the method name of the WebAuthn.credentialAsserted event.
+const EventCredentialAsserted = "WebAuthn.credentialAsserted"
+
+// CredentialAssertedEvent is the payload of the WebAuthn.credentialAsserted event.
+//
+// Triggered when a credential is used in a webauthn assertion.
+type CredentialAssertedEvent struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+	Credential      Credential      `json:"credential"`
+}
+
+// ParseEvent decodes a WebAuthn event message into its typed event struct.
+func ParseEvent(msg *internal.CDPMessage) (any, error) {
+	var v any
+	switch msg.Method {
+	case EventCredentialAdded:
+		v = &CredentialAddedEvent{}
+	case EventCredentialAsserted:
+		v = &CredentialAssertedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown WebAuthn event: %s", msg.Method)
+	}
+	if msg.Params == nil {
+		return v, nil
+	}
+	err := json.Unmarshal(msg.Params, v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}